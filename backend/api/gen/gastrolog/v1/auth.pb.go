@@ -281,6 +281,190 @@ func (x *LoginResponse) GetRefreshToken() string {
 	return ""
 }
 
+type BeginOIDCLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginOIDCLoginRequest) Reset() {
+	*x = BeginOIDCLoginRequest{}
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginOIDCLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginOIDCLoginRequest) ProtoMessage() {}
+
+func (x *BeginOIDCLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginOIDCLoginRequest.ProtoReflect.Descriptor instead.
+func (*BeginOIDCLoginRequest) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{5}
+}
+
+type BeginOIDCLoginResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationUrl string                 `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BeginOIDCLoginResponse) Reset() {
+	*x = BeginOIDCLoginResponse{}
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginOIDCLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginOIDCLoginResponse) ProtoMessage() {}
+
+func (x *BeginOIDCLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginOIDCLoginResponse.ProtoReflect.Descriptor instead.
+func (*BeginOIDCLoginResponse) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BeginOIDCLoginResponse) GetAuthorizationUrl() string {
+	if x != nil {
+		return x.AuthorizationUrl
+	}
+	return ""
+}
+
+type CompleteOIDCLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`   // "code" query parameter of the redirect
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"` // "state" query parameter of the redirect
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteOIDCLoginRequest) Reset() {
+	*x = CompleteOIDCLoginRequest{}
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteOIDCLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteOIDCLoginRequest) ProtoMessage() {}
+
+func (x *CompleteOIDCLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteOIDCLoginRequest.ProtoReflect.Descriptor instead.
+func (*CompleteOIDCLoginRequest) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CompleteOIDCLoginRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CompleteOIDCLoginRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type CompleteOIDCLoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *Token                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteOIDCLoginResponse) Reset() {
+	*x = CompleteOIDCLoginResponse{}
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteOIDCLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteOIDCLoginResponse) ProtoMessage() {}
+
+func (x *CompleteOIDCLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteOIDCLoginResponse.ProtoReflect.Descriptor instead.
+func (*CompleteOIDCLoginResponse) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CompleteOIDCLoginResponse) GetToken() *Token {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *CompleteOIDCLoginResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
 type RefreshTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
@@ -290,7 +474,7 @@ type RefreshTokenRequest struct {
 
 func (x *RefreshTokenRequest) Reset() {
 	*x = RefreshTokenRequest{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[5]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -302,7 +486,7 @@ func (x *RefreshTokenRequest) String() string {
 func (*RefreshTokenRequest) ProtoMessage() {}
 
 func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[5]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -315,7 +499,7 @@ func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
 func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{5}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RefreshTokenRequest) GetRefreshToken() string {
@@ -335,7 +519,7 @@ type RefreshTokenResponse struct {
 
 func (x *RefreshTokenResponse) Reset() {
 	*x = RefreshTokenResponse{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[6]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -347,7 +531,7 @@ func (x *RefreshTokenResponse) String() string {
 func (*RefreshTokenResponse) ProtoMessage() {}
 
 func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[6]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +544,7 @@ func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
 func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{6}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RefreshTokenResponse) GetToken() *Token {
@@ -388,7 +572,7 @@ type ChangePasswordRequest struct {
 
 func (x *ChangePasswordRequest) Reset() {
 	*x = ChangePasswordRequest{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[7]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -400,7 +584,7 @@ func (x *ChangePasswordRequest) String() string {
 func (*ChangePasswordRequest) ProtoMessage() {}
 
 func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[7]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -413,7 +597,7 @@ func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
 func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{7}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ChangePasswordRequest) GetUsername() string {
@@ -445,7 +629,7 @@ type ChangePasswordResponse struct {
 
 func (x *ChangePasswordResponse) Reset() {
 	*x = ChangePasswordResponse{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[8]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -457,7 +641,7 @@ func (x *ChangePasswordResponse) String() string {
 func (*ChangePasswordResponse) ProtoMessage() {}
 
 func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[8]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -470,7 +654,7 @@ func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
 func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{8}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{12}
 }
 
 type GetAuthStatusRequest struct {
@@ -481,7 +665,7 @@ type GetAuthStatusRequest struct {
 
 func (x *GetAuthStatusRequest) Reset() {
 	*x = GetAuthStatusRequest{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[9]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -493,7 +677,7 @@ func (x *GetAuthStatusRequest) String() string {
 func (*GetAuthStatusRequest) ProtoMessage() {}
 
 func (x *GetAuthStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[9]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -506,20 +690,21 @@ func (x *GetAuthStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuthStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetAuthStatusRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{9}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{13}
 }
 
 type GetAuthStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	NeedsSetup    bool                   `protobuf:"varint,1,opt,name=needs_setup,json=needsSetup,proto3" json:"needs_setup,omitempty"`
 	AuthDisabled  bool                   `protobuf:"varint,2,opt,name=auth_disabled,json=authDisabled,proto3" json:"auth_disabled,omitempty"`
+	OidcEnabled   bool                   `protobuf:"varint,3,opt,name=oidc_enabled,json=oidcEnabled,proto3" json:"oidc_enabled,omitempty"` // single sign-on is configured
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetAuthStatusResponse) Reset() {
 	*x = GetAuthStatusResponse{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[10]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -531,7 +716,7 @@ func (x *GetAuthStatusResponse) String() string {
 func (*GetAuthStatusResponse) ProtoMessage() {}
 
 func (x *GetAuthStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[10]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -544,7 +729,7 @@ func (x *GetAuthStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuthStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetAuthStatusResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{10}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetAuthStatusResponse) GetNeedsSetup() bool {
@@ -561,6 +746,13 @@ func (x *GetAuthStatusResponse) GetAuthDisabled() bool {
 	return false
 }
 
+func (x *GetAuthStatusResponse) GetOidcEnabled() bool {
+	if x != nil {
+		return x.OidcEnabled
+	}
+	return false
+}
+
 // UserInfo represents a user account without sensitive fields.
 type UserInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -569,13 +761,14 @@ type UserInfo struct {
 	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // unix timestamp
 	UpdatedAt     int64                  `protobuf:"varint,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // unix timestamp
 	Id            []byte                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	Sso           bool                   `protobuf:"varint,6,opt,name=sso,proto3" json:"sso,omitempty"` // provisioned by single sign-on; password login is disabled
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserInfo) Reset() {
 	*x = UserInfo{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[11]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -587,7 +780,7 @@ func (x *UserInfo) String() string {
 func (*UserInfo) ProtoMessage() {}
 
 func (x *UserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[11]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -600,7 +793,7 @@ func (x *UserInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
 func (*UserInfo) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{11}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *UserInfo) GetUsername() string {
@@ -638,18 +831,25 @@ func (x *UserInfo) GetId() []byte {
 	return nil
 }
 
+func (x *UserInfo) GetSso() bool {
+	if x != nil {
+		return x.Sso
+	}
+	return false
+}
+
 type CreateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // "admin" or "user"
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // "admin", "user", or a custom role name
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateUserRequest) Reset() {
 	*x = CreateUserRequest{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[12]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -661,7 +861,7 @@ func (x *CreateUserRequest) String() string {
 func (*CreateUserRequest) ProtoMessage() {}
 
 func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[12]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,7 +874,7 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{12}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CreateUserRequest) GetUsername() string {
@@ -707,7 +907,7 @@ type CreateUserResponse struct {
 
 func (x *CreateUserResponse) Reset() {
 	*x = CreateUserResponse{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[13]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -719,7 +919,7 @@ func (x *CreateUserResponse) String() string {
 func (*CreateUserResponse) ProtoMessage() {}
 
 func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[13]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -732,7 +932,7 @@ func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
 func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{13}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CreateUserResponse) GetUser() *UserInfo {
@@ -750,7 +950,7 @@ type ListUsersRequest struct {
 
 func (x *ListUsersRequest) Reset() {
 	*x = ListUsersRequest{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[14]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -762,7 +962,7 @@ func (x *ListUsersRequest) String() string {
 func (*ListUsersRequest) ProtoMessage() {}
 
 func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[14]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,7 +975,7 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
 func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{14}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{18}
 }
 
 type ListUsersResponse struct {
@@ -787,7 +987,7 @@ type ListUsersResponse struct {
 
 func (x *ListUsersResponse) Reset() {
 	*x = ListUsersResponse{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[15]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -799,7 +999,7 @@ func (x *ListUsersResponse) String() string {
 func (*ListUsersResponse) ProtoMessage() {}
 
 func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[15]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,7 +1012,7 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
 func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{15}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListUsersResponse) GetUsers() []*UserInfo {
@@ -825,14 +1025,14 @@ func (x *ListUsersResponse) GetUsers() []*UserInfo {
 type UpdateUserRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"` // "admin" or "user"
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"` // "admin", "user", or a custom role name
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateUserRoleRequest) Reset() {
 	*x = UpdateUserRoleRequest{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[16]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -844,7 +1044,7 @@ func (x *UpdateUserRoleRequest) String() string {
 func (*UpdateUserRoleRequest) ProtoMessage() {}
 
 func (x *UpdateUserRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[16]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,7 +1057,7 @@ func (x *UpdateUserRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRoleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserRoleRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{16}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UpdateUserRoleRequest) GetId() []byte {
@@ -883,7 +1083,7 @@ type UpdateUserRoleResponse struct {
 
 func (x *UpdateUserRoleResponse) Reset() {
 	*x = UpdateUserRoleResponse{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[17]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -895,7 +1095,7 @@ func (x *UpdateUserRoleResponse) String() string {
 func (*UpdateUserRoleResponse) ProtoMessage() {}
 
 func (x *UpdateUserRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[17]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -908,7 +1108,7 @@ func (x *UpdateUserRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRoleResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUserRoleResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{17}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *UpdateUserRoleResponse) GetUser() *UserInfo {
@@ -928,7 +1128,7 @@ type ResetPasswordRequest struct {
 
 func (x *ResetPasswordRequest) Reset() {
 	*x = ResetPasswordRequest{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[18]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -940,7 +1140,7 @@ func (x *ResetPasswordRequest) String() string {
 func (*ResetPasswordRequest) ProtoMessage() {}
 
 func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[18]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -953,7 +1153,7 @@ func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
 func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{18}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ResetPasswordRequest) GetId() []byte {
@@ -978,7 +1178,7 @@ type ResetPasswordResponse struct {
 
 func (x *ResetPasswordResponse) Reset() {
 	*x = ResetPasswordResponse{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[19]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -990,7 +1190,7 @@ func (x *ResetPasswordResponse) String() string {
 func (*ResetPasswordResponse) ProtoMessage() {}
 
 func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[19]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,7 +1203,7 @@ func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
 func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{19}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{23}
 }
 
 type RenameUserRequest struct {
@@ -1016,7 +1216,7 @@ type RenameUserRequest struct {
 
 func (x *RenameUserRequest) Reset() {
 	*x = RenameUserRequest{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[20]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1028,7 +1228,7 @@ func (x *RenameUserRequest) String() string {
 func (*RenameUserRequest) ProtoMessage() {}
 
 func (x *RenameUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[20]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1041,7 +1241,7 @@ func (x *RenameUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameUserRequest.ProtoReflect.Descriptor instead.
 func (*RenameUserRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{20}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *RenameUserRequest) GetId() []byte {
@@ -1067,7 +1267,7 @@ type RenameUserResponse struct {
 
 func (x *RenameUserResponse) Reset() {
 	*x = RenameUserResponse{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[21]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1079,7 +1279,7 @@ func (x *RenameUserResponse) String() string {
 func (*RenameUserResponse) ProtoMessage() {}
 
 func (x *RenameUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[21]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1092,7 +1292,7 @@ func (x *RenameUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameUserResponse.ProtoReflect.Descriptor instead.
 func (*RenameUserResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{21}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *RenameUserResponse) GetUser() *UserInfo {
@@ -1111,7 +1311,7 @@ type DeleteUserRequest struct {
 
 func (x *DeleteUserRequest) Reset() {
 	*x = DeleteUserRequest{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[22]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1123,7 +1323,7 @@ func (x *DeleteUserRequest) String() string {
 func (*DeleteUserRequest) ProtoMessage() {}
 
 func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[22]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1136,7 +1336,7 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{22}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *DeleteUserRequest) GetId() []byte {
@@ -1154,7 +1354,7 @@ type DeleteUserResponse struct {
 
 func (x *DeleteUserResponse) Reset() {
 	*x = DeleteUserResponse{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[23]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1166,7 +1366,7 @@ func (x *DeleteUserResponse) String() string {
 func (*DeleteUserResponse) ProtoMessage() {}
 
 func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[23]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1179,7 +1379,7 @@ func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
 func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{23}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{27}
 }
 
 type LogoutRequest struct {
@@ -1193,7 +1393,7 @@ type LogoutRequest struct {
 
 func (x *LogoutRequest) Reset() {
 	*x = LogoutRequest{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[24]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1205,7 +1405,7 @@ func (x *LogoutRequest) String() string {
 func (*LogoutRequest) ProtoMessage() {}
 
 func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[24]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1218,7 +1418,7 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
 func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{24}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *LogoutRequest) GetRefreshToken() string {
@@ -1236,7 +1436,7 @@ type LogoutResponse struct {
 
 func (x *LogoutResponse) Reset() {
 	*x = LogoutResponse{}
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[25]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1248,7 +1448,7 @@ func (x *LogoutResponse) String() string {
 func (*LogoutResponse) ProtoMessage() {}
 
 func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_auth_proto_msgTypes[25]
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1261,7 +1461,371 @@ func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
 func (*LogoutResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{25}
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{29}
+}
+
+type APIKeyInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"` // "query", "ingest", "admin"
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`      // unix timestamp
+	ExpiresAt     int64                  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`      // unix timestamp, 0 = never
+	LastUsedAt    int64                  `protobuf:"varint,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"` // unix timestamp, 0 = never used
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIKeyInfo) Reset() {
+	*x = APIKeyInfo{}
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKeyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKeyInfo) ProtoMessage() {}
+
+func (x *APIKeyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKeyInfo.ProtoReflect.Descriptor instead.
+func (*APIKeyInfo) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *APIKeyInfo) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *APIKeyInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKeyInfo) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *APIKeyInfo) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *APIKeyInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *APIKeyInfo) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *APIKeyInfo) GetLastUsedAt() int64 {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return 0
+}
+
+type CreateAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresIn     string                 `protobuf:"bytes,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // Go duration, e.g. "720h"; empty = never expires
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateAPIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyRequest) GetExpiresIn() string {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return ""
+}
+
+type CreateAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *APIKeyInfo            `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"` // the secret key, shown only once
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateAPIKeyResponse) GetApiKey() *APIKeyInfo {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ListAPIKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysRequest) ProtoMessage() {}
+
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{33}
+}
+
+type ListAPIKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeys       []*APIKeyInfo          `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListAPIKeysResponse) GetApiKeys() []*APIKeyInfo {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+type DeleteAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAPIKeyRequest) Reset() {
+	*x = DeleteAPIKeyRequest{}
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAPIKeyRequest) ProtoMessage() {}
+
+func (x *DeleteAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteAPIKeyRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type DeleteAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAPIKeyResponse) Reset() {
+	*x = DeleteAPIKeyResponse{}
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAPIKeyResponse) ProtoMessage() {}
+
+func (x *DeleteAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_auth_proto_rawDescGZIP(), []int{36}
 }
 
 var File_gastrolog_v1_auth_proto protoreflect.FileDescriptor
@@ -1284,6 +1848,15 @@ const file_gastrolog_v1_auth_proto_rawDesc = "" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"_\n" +
 	"\rLoginResponse\x12)\n" +
 	"\x05token\x18\x01 \x01(\v2\x13.gastrolog.v1.TokenR\x05token\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"\x17\n" +
+	"\x15BeginOIDCLoginRequest\"E\n" +
+	"\x16BeginOIDCLoginResponse\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\"D\n" +
+	"\x18CompleteOIDCLoginRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"k\n" +
+	"\x19CompleteOIDCLoginResponse\x12)\n" +
+	"\x05token\x18\x01 \x01(\v2\x13.gastrolog.v1.TokenR\x05token\x12#\n" +
 	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\":\n" +
 	"\x13RefreshTokenRequest\x12#\n" +
 	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"f\n" +
@@ -1295,11 +1868,12 @@ const file_gastrolog_v1_auth_proto_rawDesc = "" +
 	"\fold_password\x18\x02 \x01(\tR\voldPassword\x12!\n" +
 	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"\x18\n" +
 	"\x16ChangePasswordResponse\"\x16\n" +
-	"\x14GetAuthStatusRequest\"]\n" +
+	"\x14GetAuthStatusRequest\"\x80\x01\n" +
 	"\x15GetAuthStatusResponse\x12\x1f\n" +
 	"\vneeds_setup\x18\x01 \x01(\bR\n" +
 	"needsSetup\x12#\n" +
-	"\rauth_disabled\x18\x02 \x01(\bR\fauthDisabled\"\x88\x01\n" +
+	"\rauth_disabled\x18\x02 \x01(\bR\fauthDisabled\x12!\n" +
+	"\foidc_enabled\x18\x03 \x01(\bR\voidcEnabled\"\x9a\x01\n" +
 	"\bUserInfo\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x12\n" +
 	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1d\n" +
@@ -1307,7 +1881,8 @@ const file_gastrolog_v1_auth_proto_rawDesc = "" +
 	"created_at\x18\x03 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\x04 \x01(\x03R\tupdatedAt\x12\x0e\n" +
-	"\x02id\x18\x05 \x01(\fR\x02id\"_\n" +
+	"\x02id\x18\x05 \x01(\fR\x02id\x12\x10\n" +
+	"\x03sso\x18\x06 \x01(\bR\x03sso\"_\n" +
 	"\x11CreateUserRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x12\n" +
@@ -1336,10 +1911,39 @@ const file_gastrolog_v1_auth_proto_rawDesc = "" +
 	"\x12DeleteUserResponse\"4\n" +
 	"\rLogoutRequest\x12#\n" +
 	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"\x10\n" +
-	"\x0eLogoutResponse2\xe5\a\n" +
+	"\x0eLogoutResponse\"\xc7\x01\n" +
+	"\n" +
+	"APIKeyInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\fR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\x03R\texpiresAt\x12 \n" +
+	"\flast_used_at\x18\a \x01(\x03R\n" +
+	"lastUsedAt\"`\n" +
+	"\x13CreateAPIKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\tR\texpiresIn\"[\n" +
+	"\x14CreateAPIKeyResponse\x121\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x18.gastrolog.v1.APIKeyInfoR\x06apiKey\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"\x14\n" +
+	"\x12ListAPIKeysRequest\"J\n" +
+	"\x13ListAPIKeysResponse\x123\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\x18.gastrolog.v1.APIKeyInfoR\aapiKeys\"%\n" +
+	"\x13DeleteAPIKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\fR\x02id\"\x16\n" +
+	"\x14DeleteAPIKeyResponse2\xaa\v\n" +
 	"\vAuthService\x12I\n" +
 	"\bRegister\x12\x1d.gastrolog.v1.RegisterRequest\x1a\x1e.gastrolog.v1.RegisterResponse\x12@\n" +
-	"\x05Login\x12\x1a.gastrolog.v1.LoginRequest\x1a\x1b.gastrolog.v1.LoginResponse\x12U\n" +
+	"\x05Login\x12\x1a.gastrolog.v1.LoginRequest\x1a\x1b.gastrolog.v1.LoginResponse\x12[\n" +
+	"\x0eBeginOIDCLogin\x12#.gastrolog.v1.BeginOIDCLoginRequest\x1a$.gastrolog.v1.BeginOIDCLoginResponse\x12d\n" +
+	"\x11CompleteOIDCLogin\x12&.gastrolog.v1.CompleteOIDCLoginRequest\x1a'.gastrolog.v1.CompleteOIDCLoginResponse\x12U\n" +
 	"\fRefreshToken\x12!.gastrolog.v1.RefreshTokenRequest\x1a\".gastrolog.v1.RefreshTokenResponse\x12[\n" +
 	"\x0eChangePassword\x12#.gastrolog.v1.ChangePasswordRequest\x1a$.gastrolog.v1.ChangePasswordResponse\x12X\n" +
 	"\rGetAuthStatus\x12\".gastrolog.v1.GetAuthStatusRequest\x1a#.gastrolog.v1.GetAuthStatusResponse\x12O\n" +
@@ -1352,7 +1956,10 @@ const file_gastrolog_v1_auth_proto_rawDesc = "" +
 	"RenameUser\x12\x1f.gastrolog.v1.RenameUserRequest\x1a .gastrolog.v1.RenameUserResponse\x12O\n" +
 	"\n" +
 	"DeleteUser\x12\x1f.gastrolog.v1.DeleteUserRequest\x1a .gastrolog.v1.DeleteUserResponse\x12C\n" +
-	"\x06Logout\x12\x1b.gastrolog.v1.LogoutRequest\x1a\x1c.gastrolog.v1.LogoutResponseB,Z*gastrolog/api/gen/gastrolog/v1;gastrologv1b\x06proto3"
+	"\x06Logout\x12\x1b.gastrolog.v1.LogoutRequest\x1a\x1c.gastrolog.v1.LogoutResponse\x12U\n" +
+	"\fCreateAPIKey\x12!.gastrolog.v1.CreateAPIKeyRequest\x1a\".gastrolog.v1.CreateAPIKeyResponse\x12R\n" +
+	"\vListAPIKeys\x12 .gastrolog.v1.ListAPIKeysRequest\x1a!.gastrolog.v1.ListAPIKeysResponse\x12U\n" +
+	"\fDeleteAPIKey\x12!.gastrolog.v1.DeleteAPIKeyRequest\x1a\".gastrolog.v1.DeleteAPIKeyResponseB,Z*gastrolog/api/gen/gastrolog/v1;gastrologv1b\x06proto3"
 
 var (
 	file_gastrolog_v1_auth_proto_rawDescOnce sync.Once
@@ -1366,72 +1973,96 @@ func file_gastrolog_v1_auth_proto_rawDescGZIP() []byte {
 	return file_gastrolog_v1_auth_proto_rawDescData
 }
 
-var file_gastrolog_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_gastrolog_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_gastrolog_v1_auth_proto_goTypes = []any{
-	(*Token)(nil),                  // 0: gastrolog.v1.Token
-	(*RegisterRequest)(nil),        // 1: gastrolog.v1.RegisterRequest
-	(*RegisterResponse)(nil),       // 2: gastrolog.v1.RegisterResponse
-	(*LoginRequest)(nil),           // 3: gastrolog.v1.LoginRequest
-	(*LoginResponse)(nil),          // 4: gastrolog.v1.LoginResponse
-	(*RefreshTokenRequest)(nil),    // 5: gastrolog.v1.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil),   // 6: gastrolog.v1.RefreshTokenResponse
-	(*ChangePasswordRequest)(nil),  // 7: gastrolog.v1.ChangePasswordRequest
-	(*ChangePasswordResponse)(nil), // 8: gastrolog.v1.ChangePasswordResponse
-	(*GetAuthStatusRequest)(nil),   // 9: gastrolog.v1.GetAuthStatusRequest
-	(*GetAuthStatusResponse)(nil),  // 10: gastrolog.v1.GetAuthStatusResponse
-	(*UserInfo)(nil),               // 11: gastrolog.v1.UserInfo
-	(*CreateUserRequest)(nil),      // 12: gastrolog.v1.CreateUserRequest
-	(*CreateUserResponse)(nil),     // 13: gastrolog.v1.CreateUserResponse
-	(*ListUsersRequest)(nil),       // 14: gastrolog.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),      // 15: gastrolog.v1.ListUsersResponse
-	(*UpdateUserRoleRequest)(nil),  // 16: gastrolog.v1.UpdateUserRoleRequest
-	(*UpdateUserRoleResponse)(nil), // 17: gastrolog.v1.UpdateUserRoleResponse
-	(*ResetPasswordRequest)(nil),   // 18: gastrolog.v1.ResetPasswordRequest
-	(*ResetPasswordResponse)(nil),  // 19: gastrolog.v1.ResetPasswordResponse
-	(*RenameUserRequest)(nil),      // 20: gastrolog.v1.RenameUserRequest
-	(*RenameUserResponse)(nil),     // 21: gastrolog.v1.RenameUserResponse
-	(*DeleteUserRequest)(nil),      // 22: gastrolog.v1.DeleteUserRequest
-	(*DeleteUserResponse)(nil),     // 23: gastrolog.v1.DeleteUserResponse
-	(*LogoutRequest)(nil),          // 24: gastrolog.v1.LogoutRequest
-	(*LogoutResponse)(nil),         // 25: gastrolog.v1.LogoutResponse
+	(*Token)(nil),                     // 0: gastrolog.v1.Token
+	(*RegisterRequest)(nil),           // 1: gastrolog.v1.RegisterRequest
+	(*RegisterResponse)(nil),          // 2: gastrolog.v1.RegisterResponse
+	(*LoginRequest)(nil),              // 3: gastrolog.v1.LoginRequest
+	(*LoginResponse)(nil),             // 4: gastrolog.v1.LoginResponse
+	(*BeginOIDCLoginRequest)(nil),     // 5: gastrolog.v1.BeginOIDCLoginRequest
+	(*BeginOIDCLoginResponse)(nil),    // 6: gastrolog.v1.BeginOIDCLoginResponse
+	(*CompleteOIDCLoginRequest)(nil),  // 7: gastrolog.v1.CompleteOIDCLoginRequest
+	(*CompleteOIDCLoginResponse)(nil), // 8: gastrolog.v1.CompleteOIDCLoginResponse
+	(*RefreshTokenRequest)(nil),       // 9: gastrolog.v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),      // 10: gastrolog.v1.RefreshTokenResponse
+	(*ChangePasswordRequest)(nil),     // 11: gastrolog.v1.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),    // 12: gastrolog.v1.ChangePasswordResponse
+	(*GetAuthStatusRequest)(nil),      // 13: gastrolog.v1.GetAuthStatusRequest
+	(*GetAuthStatusResponse)(nil),     // 14: gastrolog.v1.GetAuthStatusResponse
+	(*UserInfo)(nil),                  // 15: gastrolog.v1.UserInfo
+	(*CreateUserRequest)(nil),         // 16: gastrolog.v1.CreateUserRequest
+	(*CreateUserResponse)(nil),        // 17: gastrolog.v1.CreateUserResponse
+	(*ListUsersRequest)(nil),          // 18: gastrolog.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),         // 19: gastrolog.v1.ListUsersResponse
+	(*UpdateUserRoleRequest)(nil),     // 20: gastrolog.v1.UpdateUserRoleRequest
+	(*UpdateUserRoleResponse)(nil),    // 21: gastrolog.v1.UpdateUserRoleResponse
+	(*ResetPasswordRequest)(nil),      // 22: gastrolog.v1.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),     // 23: gastrolog.v1.ResetPasswordResponse
+	(*RenameUserRequest)(nil),         // 24: gastrolog.v1.RenameUserRequest
+	(*RenameUserResponse)(nil),        // 25: gastrolog.v1.RenameUserResponse
+	(*DeleteUserRequest)(nil),         // 26: gastrolog.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),        // 27: gastrolog.v1.DeleteUserResponse
+	(*LogoutRequest)(nil),             // 28: gastrolog.v1.LogoutRequest
+	(*LogoutResponse)(nil),            // 29: gastrolog.v1.LogoutResponse
+	(*APIKeyInfo)(nil),                // 30: gastrolog.v1.APIKeyInfo
+	(*CreateAPIKeyRequest)(nil),       // 31: gastrolog.v1.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),      // 32: gastrolog.v1.CreateAPIKeyResponse
+	(*ListAPIKeysRequest)(nil),        // 33: gastrolog.v1.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),       // 34: gastrolog.v1.ListAPIKeysResponse
+	(*DeleteAPIKeyRequest)(nil),       // 35: gastrolog.v1.DeleteAPIKeyRequest
+	(*DeleteAPIKeyResponse)(nil),      // 36: gastrolog.v1.DeleteAPIKeyResponse
 }
 var file_gastrolog_v1_auth_proto_depIdxs = []int32{
 	0,  // 0: gastrolog.v1.RegisterResponse.token:type_name -> gastrolog.v1.Token
 	0,  // 1: gastrolog.v1.LoginResponse.token:type_name -> gastrolog.v1.Token
-	0,  // 2: gastrolog.v1.RefreshTokenResponse.token:type_name -> gastrolog.v1.Token
-	11, // 3: gastrolog.v1.CreateUserResponse.user:type_name -> gastrolog.v1.UserInfo
-	11, // 4: gastrolog.v1.ListUsersResponse.users:type_name -> gastrolog.v1.UserInfo
-	11, // 5: gastrolog.v1.UpdateUserRoleResponse.user:type_name -> gastrolog.v1.UserInfo
-	11, // 6: gastrolog.v1.RenameUserResponse.user:type_name -> gastrolog.v1.UserInfo
-	1,  // 7: gastrolog.v1.AuthService.Register:input_type -> gastrolog.v1.RegisterRequest
-	3,  // 8: gastrolog.v1.AuthService.Login:input_type -> gastrolog.v1.LoginRequest
-	5,  // 9: gastrolog.v1.AuthService.RefreshToken:input_type -> gastrolog.v1.RefreshTokenRequest
-	7,  // 10: gastrolog.v1.AuthService.ChangePassword:input_type -> gastrolog.v1.ChangePasswordRequest
-	9,  // 11: gastrolog.v1.AuthService.GetAuthStatus:input_type -> gastrolog.v1.GetAuthStatusRequest
-	12, // 12: gastrolog.v1.AuthService.CreateUser:input_type -> gastrolog.v1.CreateUserRequest
-	14, // 13: gastrolog.v1.AuthService.ListUsers:input_type -> gastrolog.v1.ListUsersRequest
-	16, // 14: gastrolog.v1.AuthService.UpdateUserRole:input_type -> gastrolog.v1.UpdateUserRoleRequest
-	18, // 15: gastrolog.v1.AuthService.ResetPassword:input_type -> gastrolog.v1.ResetPasswordRequest
-	20, // 16: gastrolog.v1.AuthService.RenameUser:input_type -> gastrolog.v1.RenameUserRequest
-	22, // 17: gastrolog.v1.AuthService.DeleteUser:input_type -> gastrolog.v1.DeleteUserRequest
-	24, // 18: gastrolog.v1.AuthService.Logout:input_type -> gastrolog.v1.LogoutRequest
-	2,  // 19: gastrolog.v1.AuthService.Register:output_type -> gastrolog.v1.RegisterResponse
-	4,  // 20: gastrolog.v1.AuthService.Login:output_type -> gastrolog.v1.LoginResponse
-	6,  // 21: gastrolog.v1.AuthService.RefreshToken:output_type -> gastrolog.v1.RefreshTokenResponse
-	8,  // 22: gastrolog.v1.AuthService.ChangePassword:output_type -> gastrolog.v1.ChangePasswordResponse
-	10, // 23: gastrolog.v1.AuthService.GetAuthStatus:output_type -> gastrolog.v1.GetAuthStatusResponse
-	13, // 24: gastrolog.v1.AuthService.CreateUser:output_type -> gastrolog.v1.CreateUserResponse
-	15, // 25: gastrolog.v1.AuthService.ListUsers:output_type -> gastrolog.v1.ListUsersResponse
-	17, // 26: gastrolog.v1.AuthService.UpdateUserRole:output_type -> gastrolog.v1.UpdateUserRoleResponse
-	19, // 27: gastrolog.v1.AuthService.ResetPassword:output_type -> gastrolog.v1.ResetPasswordResponse
-	21, // 28: gastrolog.v1.AuthService.RenameUser:output_type -> gastrolog.v1.RenameUserResponse
-	23, // 29: gastrolog.v1.AuthService.DeleteUser:output_type -> gastrolog.v1.DeleteUserResponse
-	25, // 30: gastrolog.v1.AuthService.Logout:output_type -> gastrolog.v1.LogoutResponse
-	19, // [19:31] is the sub-list for method output_type
-	7,  // [7:19] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	0,  // 2: gastrolog.v1.CompleteOIDCLoginResponse.token:type_name -> gastrolog.v1.Token
+	0,  // 3: gastrolog.v1.RefreshTokenResponse.token:type_name -> gastrolog.v1.Token
+	15, // 4: gastrolog.v1.CreateUserResponse.user:type_name -> gastrolog.v1.UserInfo
+	15, // 5: gastrolog.v1.ListUsersResponse.users:type_name -> gastrolog.v1.UserInfo
+	15, // 6: gastrolog.v1.UpdateUserRoleResponse.user:type_name -> gastrolog.v1.UserInfo
+	15, // 7: gastrolog.v1.RenameUserResponse.user:type_name -> gastrolog.v1.UserInfo
+	30, // 8: gastrolog.v1.CreateAPIKeyResponse.api_key:type_name -> gastrolog.v1.APIKeyInfo
+	30, // 9: gastrolog.v1.ListAPIKeysResponse.api_keys:type_name -> gastrolog.v1.APIKeyInfo
+	1,  // 10: gastrolog.v1.AuthService.Register:input_type -> gastrolog.v1.RegisterRequest
+	3,  // 11: gastrolog.v1.AuthService.Login:input_type -> gastrolog.v1.LoginRequest
+	5,  // 12: gastrolog.v1.AuthService.BeginOIDCLogin:input_type -> gastrolog.v1.BeginOIDCLoginRequest
+	7,  // 13: gastrolog.v1.AuthService.CompleteOIDCLogin:input_type -> gastrolog.v1.CompleteOIDCLoginRequest
+	9,  // 14: gastrolog.v1.AuthService.RefreshToken:input_type -> gastrolog.v1.RefreshTokenRequest
+	11, // 15: gastrolog.v1.AuthService.ChangePassword:input_type -> gastrolog.v1.ChangePasswordRequest
+	13, // 16: gastrolog.v1.AuthService.GetAuthStatus:input_type -> gastrolog.v1.GetAuthStatusRequest
+	16, // 17: gastrolog.v1.AuthService.CreateUser:input_type -> gastrolog.v1.CreateUserRequest
+	18, // 18: gastrolog.v1.AuthService.ListUsers:input_type -> gastrolog.v1.ListUsersRequest
+	20, // 19: gastrolog.v1.AuthService.UpdateUserRole:input_type -> gastrolog.v1.UpdateUserRoleRequest
+	22, // 20: gastrolog.v1.AuthService.ResetPassword:input_type -> gastrolog.v1.ResetPasswordRequest
+	24, // 21: gastrolog.v1.AuthService.RenameUser:input_type -> gastrolog.v1.RenameUserRequest
+	26, // 22: gastrolog.v1.AuthService.DeleteUser:input_type -> gastrolog.v1.DeleteUserRequest
+	28, // 23: gastrolog.v1.AuthService.Logout:input_type -> gastrolog.v1.LogoutRequest
+	31, // 24: gastrolog.v1.AuthService.CreateAPIKey:input_type -> gastrolog.v1.CreateAPIKeyRequest
+	33, // 25: gastrolog.v1.AuthService.ListAPIKeys:input_type -> gastrolog.v1.ListAPIKeysRequest
+	35, // 26: gastrolog.v1.AuthService.DeleteAPIKey:input_type -> gastrolog.v1.DeleteAPIKeyRequest
+	2,  // 27: gastrolog.v1.AuthService.Register:output_type -> gastrolog.v1.RegisterResponse
+	4,  // 28: gastrolog.v1.AuthService.Login:output_type -> gastrolog.v1.LoginResponse
+	6,  // 29: gastrolog.v1.AuthService.BeginOIDCLogin:output_type -> gastrolog.v1.BeginOIDCLoginResponse
+	8,  // 30: gastrolog.v1.AuthService.CompleteOIDCLogin:output_type -> gastrolog.v1.CompleteOIDCLoginResponse
+	10, // 31: gastrolog.v1.AuthService.RefreshToken:output_type -> gastrolog.v1.RefreshTokenResponse
+	12, // 32: gastrolog.v1.AuthService.ChangePassword:output_type -> gastrolog.v1.ChangePasswordResponse
+	14, // 33: gastrolog.v1.AuthService.GetAuthStatus:output_type -> gastrolog.v1.GetAuthStatusResponse
+	17, // 34: gastrolog.v1.AuthService.CreateUser:output_type -> gastrolog.v1.CreateUserResponse
+	19, // 35: gastrolog.v1.AuthService.ListUsers:output_type -> gastrolog.v1.ListUsersResponse
+	21, // 36: gastrolog.v1.AuthService.UpdateUserRole:output_type -> gastrolog.v1.UpdateUserRoleResponse
+	23, // 37: gastrolog.v1.AuthService.ResetPassword:output_type -> gastrolog.v1.ResetPasswordResponse
+	25, // 38: gastrolog.v1.AuthService.RenameUser:output_type -> gastrolog.v1.RenameUserResponse
+	27, // 39: gastrolog.v1.AuthService.DeleteUser:output_type -> gastrolog.v1.DeleteUserResponse
+	29, // 40: gastrolog.v1.AuthService.Logout:output_type -> gastrolog.v1.LogoutResponse
+	32, // 41: gastrolog.v1.AuthService.CreateAPIKey:output_type -> gastrolog.v1.CreateAPIKeyResponse
+	34, // 42: gastrolog.v1.AuthService.ListAPIKeys:output_type -> gastrolog.v1.ListAPIKeysResponse
+	36, // 43: gastrolog.v1.AuthService.DeleteAPIKey:output_type -> gastrolog.v1.DeleteAPIKeyResponse
+	27, // [27:44] is the sub-list for method output_type
+	10, // [10:27] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_gastrolog_v1_auth_proto_init() }
@@ -1445,7 +2076,7 @@ func file_gastrolog_v1_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gastrolog_v1_auth_proto_rawDesc), len(file_gastrolog_v1_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   26,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -69,6 +69,13 @@ type SystemCommand struct {
 	//	*SystemCommand_SetIngesterAlive
 	//	*SystemCommand_SetIngesterAssignment
 	//	*SystemCommand_SetIngesterCheckpoint
+	//	*SystemCommand_PutAlertRule
+	//	*SystemCommand_DeleteAlertRule
+	//	*SystemCommand_PutRole
+	//	*SystemCommand_DeleteRole
+	//	*SystemCommand_CreateApiKey
+	//	*SystemCommand_DeleteApiKey
+	//	*SystemCommand_TouchApiKey
 	Command       isSystemCommand_Command `protobuf_oneof:"command"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -480,6 +487,69 @@ func (x *SystemCommand) GetSetIngesterCheckpoint() *SetIngesterCheckpointCommand
 	return nil
 }
 
+func (x *SystemCommand) GetPutAlertRule() *PutAlertRuleCommand {
+	if x != nil {
+		if x, ok := x.Command.(*SystemCommand_PutAlertRule); ok {
+			return x.PutAlertRule
+		}
+	}
+	return nil
+}
+
+func (x *SystemCommand) GetDeleteAlertRule() *DeleteAlertRuleCommand {
+	if x != nil {
+		if x, ok := x.Command.(*SystemCommand_DeleteAlertRule); ok {
+			return x.DeleteAlertRule
+		}
+	}
+	return nil
+}
+
+func (x *SystemCommand) GetPutRole() *PutRoleCommand {
+	if x != nil {
+		if x, ok := x.Command.(*SystemCommand_PutRole); ok {
+			return x.PutRole
+		}
+	}
+	return nil
+}
+
+func (x *SystemCommand) GetDeleteRole() *DeleteRoleCommand {
+	if x != nil {
+		if x, ok := x.Command.(*SystemCommand_DeleteRole); ok {
+			return x.DeleteRole
+		}
+	}
+	return nil
+}
+
+func (x *SystemCommand) GetCreateApiKey() *CreateAPIKeyCommand {
+	if x != nil {
+		if x, ok := x.Command.(*SystemCommand_CreateApiKey); ok {
+			return x.CreateApiKey
+		}
+	}
+	return nil
+}
+
+func (x *SystemCommand) GetDeleteApiKey() *DeleteAPIKeyCommand {
+	if x != nil {
+		if x, ok := x.Command.(*SystemCommand_DeleteApiKey); ok {
+			return x.DeleteApiKey
+		}
+	}
+	return nil
+}
+
+func (x *SystemCommand) GetTouchApiKey() *TouchAPIKeyCommand {
+	if x != nil {
+		if x, ok := x.Command.(*SystemCommand_TouchApiKey); ok {
+			return x.TouchApiKey
+		}
+	}
+	return nil
+}
+
 type isSystemCommand_Command interface {
 	isSystemCommand_Command()
 }
@@ -648,6 +718,34 @@ type SystemCommand_SetIngesterCheckpoint struct {
 	SetIngesterCheckpoint *SetIngesterCheckpointCommand `protobuf:"bytes,41,opt,name=set_ingester_checkpoint,json=setIngesterCheckpoint,proto3,oneof"`
 }
 
+type SystemCommand_PutAlertRule struct {
+	PutAlertRule *PutAlertRuleCommand `protobuf:"bytes,42,opt,name=put_alert_rule,json=putAlertRule,proto3,oneof"`
+}
+
+type SystemCommand_DeleteAlertRule struct {
+	DeleteAlertRule *DeleteAlertRuleCommand `protobuf:"bytes,43,opt,name=delete_alert_rule,json=deleteAlertRule,proto3,oneof"`
+}
+
+type SystemCommand_PutRole struct {
+	PutRole *PutRoleCommand `protobuf:"bytes,44,opt,name=put_role,json=putRole,proto3,oneof"`
+}
+
+type SystemCommand_DeleteRole struct {
+	DeleteRole *DeleteRoleCommand `protobuf:"bytes,45,opt,name=delete_role,json=deleteRole,proto3,oneof"`
+}
+
+type SystemCommand_CreateApiKey struct {
+	CreateApiKey *CreateAPIKeyCommand `protobuf:"bytes,46,opt,name=create_api_key,json=createApiKey,proto3,oneof"`
+}
+
+type SystemCommand_DeleteApiKey struct {
+	DeleteApiKey *DeleteAPIKeyCommand `protobuf:"bytes,47,opt,name=delete_api_key,json=deleteApiKey,proto3,oneof"`
+}
+
+type SystemCommand_TouchApiKey struct {
+	TouchApiKey *TouchAPIKeyCommand `protobuf:"bytes,48,opt,name=touch_api_key,json=touchApiKey,proto3,oneof"`
+}
+
 func (*SystemCommand_PutFilter) isSystemCommand_Command() {}
 
 func (*SystemCommand_DeleteFilter) isSystemCommand_Command() {}
@@ -730,6 +828,20 @@ func (*SystemCommand_SetIngesterAssignment) isSystemCommand_Command() {}
 
 func (*SystemCommand_SetIngesterCheckpoint) isSystemCommand_Command() {}
 
+func (*SystemCommand_PutAlertRule) isSystemCommand_Command() {}
+
+func (*SystemCommand_DeleteAlertRule) isSystemCommand_Command() {}
+
+func (*SystemCommand_PutRole) isSystemCommand_Command() {}
+
+func (*SystemCommand_DeleteRole) isSystemCommand_Command() {}
+
+func (*SystemCommand_CreateApiKey) isSystemCommand_Command() {}
+
+func (*SystemCommand_DeleteApiKey) isSystemCommand_Command() {}
+
+func (*SystemCommand_TouchApiKey) isSystemCommand_Command() {}
+
 type PutFilterCommand struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1574,6 +1686,7 @@ type CreateUserCommand struct {
 	TokenInvalidatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=token_invalidated_at,json=tokenInvalidatedAt,proto3" json:"token_invalidated_at,omitempty"`
 	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ExternalId         string                 `protobuf:"bytes,9,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"` // "<issuer>|<subject>" for SSO users
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -1664,6 +1777,13 @@ func (x *CreateUserCommand) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CreateUserCommand) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
 type UpdatePasswordCommand struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2132,6 +2252,202 @@ func (x *DeleteUserRefreshTokensCommand) GetUserId() []byte {
 	return nil
 }
 
+type CreateAPIKeyCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	KeyHash       string                 `protobuf:"bytes,3,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // unset = never
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyCommand) Reset() {
+	*x = CreateAPIKeyCommand{}
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyCommand) ProtoMessage() {}
+
+func (x *CreateAPIKeyCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyCommand.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyCommand) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateAPIKeyCommand) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyCommand) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyCommand) GetKeyHash() string {
+	if x != nil {
+		return x.KeyHash
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyCommand) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyCommand) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyCommand) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyCommand) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyCommand) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type DeleteAPIKeyCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAPIKeyCommand) Reset() {
+	*x = DeleteAPIKeyCommand{}
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAPIKeyCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAPIKeyCommand) ProtoMessage() {}
+
+func (x *DeleteAPIKeyCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAPIKeyCommand.ProtoReflect.Descriptor instead.
+func (*DeleteAPIKeyCommand) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteAPIKeyCommand) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type TouchAPIKeyCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TouchAPIKeyCommand) Reset() {
+	*x = TouchAPIKeyCommand{}
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TouchAPIKeyCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TouchAPIKeyCommand) ProtoMessage() {}
+
+func (x *TouchAPIKeyCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TouchAPIKeyCommand.ProtoReflect.Descriptor instead.
+func (*TouchAPIKeyCommand) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TouchAPIKeyCommand) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *TouchAPIKeyCommand) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
 type PutNodeConfigCommand struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2142,7 +2458,7 @@ type PutNodeConfigCommand struct {
 
 func (x *PutNodeConfigCommand) Reset() {
 	*x = PutNodeConfigCommand{}
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[25]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2154,7 +2470,7 @@ func (x *PutNodeConfigCommand) String() string {
 func (*PutNodeConfigCommand) ProtoMessage() {}
 
 func (x *PutNodeConfigCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[25]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2167,7 +2483,7 @@ func (x *PutNodeConfigCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutNodeConfigCommand.ProtoReflect.Descriptor instead.
 func (*PutNodeConfigCommand) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{25}
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *PutNodeConfigCommand) GetId() []byte {
@@ -2193,7 +2509,7 @@ type DeleteNodeConfigCommand struct {
 
 func (x *DeleteNodeConfigCommand) Reset() {
 	*x = DeleteNodeConfigCommand{}
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[26]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2205,7 +2521,7 @@ func (x *DeleteNodeConfigCommand) String() string {
 func (*DeleteNodeConfigCommand) ProtoMessage() {}
 
 func (x *DeleteNodeConfigCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[26]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2218,7 +2534,7 @@ func (x *DeleteNodeConfigCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNodeConfigCommand.ProtoReflect.Descriptor instead.
 func (*DeleteNodeConfigCommand) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{26}
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DeleteNodeConfigCommand) GetId() []byte {
@@ -2241,7 +2557,7 @@ type PutClusterTLSCommand struct {
 
 func (x *PutClusterTLSCommand) Reset() {
 	*x = PutClusterTLSCommand{}
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[27]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2253,7 +2569,7 @@ func (x *PutClusterTLSCommand) String() string {
 func (*PutClusterTLSCommand) ProtoMessage() {}
 
 func (x *PutClusterTLSCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[27]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2266,7 +2582,7 @@ func (x *PutClusterTLSCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutClusterTLSCommand.ProtoReflect.Descriptor instead.
 func (*PutClusterTLSCommand) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{27}
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *PutClusterTLSCommand) GetCaCertPem() []byte {
@@ -2313,13 +2629,14 @@ type PutRouteCommand struct {
 	Distribution   string                 `protobuf:"bytes,5,opt,name=distribution,proto3" json:"distribution,omitempty"`
 	Enabled        bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	EjectOnly      bool                   `protobuf:"varint,7,opt,name=eject_only,json=ejectOnly,proto3" json:"eject_only,omitempty"`
+	Stages         []*RouteStage          `protobuf:"bytes,8,rep,name=stages,proto3" json:"stages,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PutRouteCommand) Reset() {
 	*x = PutRouteCommand{}
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[28]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2331,7 +2648,7 @@ func (x *PutRouteCommand) String() string {
 func (*PutRouteCommand) ProtoMessage() {}
 
 func (x *PutRouteCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[28]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2344,7 +2661,7 @@ func (x *PutRouteCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutRouteCommand.ProtoReflect.Descriptor instead.
 func (*PutRouteCommand) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{28}
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *PutRouteCommand) GetId() []byte {
@@ -2396,6 +2713,13 @@ func (x *PutRouteCommand) GetEjectOnly() bool {
 	return false
 }
 
+func (x *PutRouteCommand) GetStages() []*RouteStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
 type DeleteRouteCommand struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2405,7 +2729,7 @@ type DeleteRouteCommand struct {
 
 func (x *DeleteRouteCommand) Reset() {
 	*x = DeleteRouteCommand{}
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[29]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2417,7 +2741,7 @@ func (x *DeleteRouteCommand) String() string {
 func (*DeleteRouteCommand) ProtoMessage() {}
 
 func (x *DeleteRouteCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[29]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2430,7 +2754,7 @@ func (x *DeleteRouteCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRouteCommand.ProtoReflect.Descriptor instead.
 func (*DeleteRouteCommand) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{29}
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *DeleteRouteCommand) GetId() []byte {
@@ -2453,7 +2777,7 @@ type PutManagedFileCommand struct {
 
 func (x *PutManagedFileCommand) Reset() {
 	*x = PutManagedFileCommand{}
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[30]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2465,7 +2789,7 @@ func (x *PutManagedFileCommand) String() string {
 func (*PutManagedFileCommand) ProtoMessage() {}
 
 func (x *PutManagedFileCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[30]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2478,7 +2802,7 @@ func (x *PutManagedFileCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutManagedFileCommand.ProtoReflect.Descriptor instead.
 func (*PutManagedFileCommand) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{30}
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *PutManagedFileCommand) GetId() []byte {
@@ -2525,7 +2849,7 @@ type DeleteManagedFileCommand struct {
 
 func (x *DeleteManagedFileCommand) Reset() {
 	*x = DeleteManagedFileCommand{}
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[31]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2537,7 +2861,7 @@ func (x *DeleteManagedFileCommand) String() string {
 func (*DeleteManagedFileCommand) ProtoMessage() {}
 
 func (x *DeleteManagedFileCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[31]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2550,7 +2874,7 @@ func (x *DeleteManagedFileCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteManagedFileCommand.ProtoReflect.Descriptor instead.
 func (*DeleteManagedFileCommand) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{31}
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DeleteManagedFileCommand) GetId() []byte {
@@ -2572,7 +2896,7 @@ type PutCloudServiceCommand struct {
 
 func (x *PutCloudServiceCommand) Reset() {
 	*x = PutCloudServiceCommand{}
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[32]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2584,7 +2908,7 @@ func (x *PutCloudServiceCommand) String() string {
 func (*PutCloudServiceCommand) ProtoMessage() {}
 
 func (x *PutCloudServiceCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[32]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2597,7 +2921,7 @@ func (x *PutCloudServiceCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutCloudServiceCommand.ProtoReflect.Descriptor instead.
 func (*PutCloudServiceCommand) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{32}
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *PutCloudServiceCommand) GetCloudService() *CloudService {
@@ -2616,7 +2940,7 @@ type DeleteCloudServiceCommand struct {
 
 func (x *DeleteCloudServiceCommand) Reset() {
 	*x = DeleteCloudServiceCommand{}
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[33]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2628,7 +2952,7 @@ func (x *DeleteCloudServiceCommand) String() string {
 func (*DeleteCloudServiceCommand) ProtoMessage() {}
 
 func (x *DeleteCloudServiceCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[33]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2641,7 +2965,7 @@ func (x *DeleteCloudServiceCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCloudServiceCommand.ProtoReflect.Descriptor instead.
 func (*DeleteCloudServiceCommand) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{33}
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *DeleteCloudServiceCommand) GetId() []byte {
@@ -2661,7 +2985,7 @@ type SetNodeStorageConfigCommand struct {
 
 func (x *SetNodeStorageConfigCommand) Reset() {
 	*x = SetNodeStorageConfigCommand{}
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[34]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2673,7 +2997,7 @@ func (x *SetNodeStorageConfigCommand) String() string {
 func (*SetNodeStorageConfigCommand) ProtoMessage() {}
 
 func (x *SetNodeStorageConfigCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[34]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2686,7 +3010,7 @@ func (x *SetNodeStorageConfigCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetNodeStorageConfigCommand.ProtoReflect.Descriptor instead.
 func (*SetNodeStorageConfigCommand) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{34}
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *SetNodeStorageConfigCommand) GetNodeStorage() *NodeStorageConfig {
@@ -2706,7 +3030,7 @@ type PutTierCommand struct {
 
 func (x *PutTierCommand) Reset() {
 	*x = PutTierCommand{}
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[35]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2718,7 +3042,7 @@ func (x *PutTierCommand) String() string {
 func (*PutTierCommand) ProtoMessage() {}
 
 func (x *PutTierCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[35]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2731,7 +3055,7 @@ func (x *PutTierCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutTierCommand.ProtoReflect.Descriptor instead.
 func (*PutTierCommand) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{35}
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *PutTierCommand) GetTier() *TierConfig {
@@ -2751,7 +3075,7 @@ type DeleteTierCommand struct {
 
 func (x *DeleteTierCommand) Reset() {
 	*x = DeleteTierCommand{}
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[36]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2763,7 +3087,7 @@ func (x *DeleteTierCommand) String() string {
 func (*DeleteTierCommand) ProtoMessage() {}
 
 func (x *DeleteTierCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[36]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2776,7 +3100,7 @@ func (x *DeleteTierCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTierCommand.ProtoReflect.Descriptor instead.
 func (*DeleteTierCommand) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{36}
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *DeleteTierCommand) GetId() []byte {
@@ -2803,7 +3127,7 @@ type SetTierPlacementsCommand struct {
 
 func (x *SetTierPlacementsCommand) Reset() {
 	*x = SetTierPlacementsCommand{}
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[37]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2815,7 +3139,7 @@ func (x *SetTierPlacementsCommand) String() string {
 func (*SetTierPlacementsCommand) ProtoMessage() {}
 
 func (x *SetTierPlacementsCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[37]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2828,7 +3152,7 @@ func (x *SetTierPlacementsCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetTierPlacementsCommand.ProtoReflect.Descriptor instead.
 func (*SetTierPlacementsCommand) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{37}
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *SetTierPlacementsCommand) GetTierId() []byte {
@@ -2854,7 +3178,7 @@ type SetSetupWizardDismissedCommand struct {
 
 func (x *SetSetupWizardDismissedCommand) Reset() {
 	*x = SetSetupWizardDismissedCommand{}
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[38]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2866,7 +3190,7 @@ func (x *SetSetupWizardDismissedCommand) String() string {
 func (*SetSetupWizardDismissedCommand) ProtoMessage() {}
 
 func (x *SetSetupWizardDismissedCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[38]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2879,7 +3203,7 @@ func (x *SetSetupWizardDismissedCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetSetupWizardDismissedCommand.ProtoReflect.Descriptor instead.
 func (*SetSetupWizardDismissedCommand) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{38}
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *SetSetupWizardDismissedCommand) GetDismissed() bool {
@@ -2900,7 +3224,7 @@ type SetIngesterAliveCommand struct {
 
 func (x *SetIngesterAliveCommand) Reset() {
 	*x = SetIngesterAliveCommand{}
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[39]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2912,7 +3236,7 @@ func (x *SetIngesterAliveCommand) String() string {
 func (*SetIngesterAliveCommand) ProtoMessage() {}
 
 func (x *SetIngesterAliveCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[39]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2925,7 +3249,7 @@ func (x *SetIngesterAliveCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetIngesterAliveCommand.ProtoReflect.Descriptor instead.
 func (*SetIngesterAliveCommand) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{39}
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *SetIngesterAliveCommand) GetIngesterId() []byte {
@@ -2959,7 +3283,7 @@ type SetIngesterAssignmentCommand struct {
 
 func (x *SetIngesterAssignmentCommand) Reset() {
 	*x = SetIngesterAssignmentCommand{}
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[40]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2971,7 +3295,7 @@ func (x *SetIngesterAssignmentCommand) String() string {
 func (*SetIngesterAssignmentCommand) ProtoMessage() {}
 
 func (x *SetIngesterAssignmentCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[40]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2984,7 +3308,7 @@ func (x *SetIngesterAssignmentCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetIngesterAssignmentCommand.ProtoReflect.Descriptor instead.
 func (*SetIngesterAssignmentCommand) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{40}
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *SetIngesterAssignmentCommand) GetIngesterId() []byte {
@@ -3011,7 +3335,7 @@ type SetIngesterCheckpointCommand struct {
 
 func (x *SetIngesterCheckpointCommand) Reset() {
 	*x = SetIngesterCheckpointCommand{}
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[41]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3023,7 +3347,7 @@ func (x *SetIngesterCheckpointCommand) String() string {
 func (*SetIngesterCheckpointCommand) ProtoMessage() {}
 
 func (x *SetIngesterCheckpointCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[41]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3036,7 +3360,7 @@ func (x *SetIngesterCheckpointCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetIngesterCheckpointCommand.ProtoReflect.Descriptor instead.
 func (*SetIngesterCheckpointCommand) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{41}
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *SetIngesterCheckpointCommand) GetIngesterId() []byte {
@@ -3053,6 +3377,184 @@ func (x *SetIngesterCheckpointCommand) GetData() []byte {
 	return nil
 }
 
+// PutAlertRuleCommand carries the full AlertRuleConfig from system.proto.
+type PutAlertRuleCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AlertRule     *AlertRuleConfig       `protobuf:"bytes,1,opt,name=alert_rule,json=alertRule,proto3" json:"alert_rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutAlertRuleCommand) Reset() {
+	*x = PutAlertRuleCommand{}
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutAlertRuleCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutAlertRuleCommand) ProtoMessage() {}
+
+func (x *PutAlertRuleCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutAlertRuleCommand.ProtoReflect.Descriptor instead.
+func (*PutAlertRuleCommand) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PutAlertRuleCommand) GetAlertRule() *AlertRuleConfig {
+	if x != nil {
+		return x.AlertRule
+	}
+	return nil
+}
+
+type DeleteAlertRuleCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRuleCommand) Reset() {
+	*x = DeleteAlertRuleCommand{}
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRuleCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleCommand) ProtoMessage() {}
+
+func (x *DeleteAlertRuleCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleCommand.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleCommand) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeleteAlertRuleCommand) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// PutRoleCommand carries the full RoleConfig from system.proto.
+type PutRoleCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *RoleConfig            `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutRoleCommand) Reset() {
+	*x = PutRoleCommand{}
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutRoleCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutRoleCommand) ProtoMessage() {}
+
+func (x *PutRoleCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutRoleCommand.ProtoReflect.Descriptor instead.
+func (*PutRoleCommand) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PutRoleCommand) GetRole() *RoleConfig {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type DeleteRoleCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleCommand) Reset() {
+	*x = DeleteRoleCommand{}
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleCommand) ProtoMessage() {}
+
+func (x *DeleteRoleCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleCommand.ProtoReflect.Descriptor instead.
+func (*DeleteRoleCommand) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteRoleCommand) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
 // SystemSnapshot captures the full system state for FSM.Snapshot()/Restore().
 // Each repeated field contains one entry per entity, using the Put/Create
 // command messages to represent complete entity state.
@@ -3079,13 +3581,16 @@ type SystemSnapshot struct {
 	IngesterAlive        []*SetIngesterAliveCommand      `protobuf:"bytes,19,rep,name=ingester_alive,json=ingesterAlive,proto3" json:"ingester_alive,omitempty"`
 	IngesterAssignments  []*SetIngesterAssignmentCommand `protobuf:"bytes,20,rep,name=ingester_assignments,json=ingesterAssignments,proto3" json:"ingester_assignments,omitempty"`
 	IngesterCheckpoints  []*SetIngesterCheckpointCommand `protobuf:"bytes,21,rep,name=ingester_checkpoints,json=ingesterCheckpoints,proto3" json:"ingester_checkpoints,omitempty"`
+	AlertRules           []*PutAlertRuleCommand          `protobuf:"bytes,22,rep,name=alert_rules,json=alertRules,proto3" json:"alert_rules,omitempty"`
+	Roles                []*PutRoleCommand               `protobuf:"bytes,23,rep,name=roles,proto3" json:"roles,omitempty"`
+	ApiKeys              []*CreateAPIKeyCommand          `protobuf:"bytes,24,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
 
 func (x *SystemSnapshot) Reset() {
 	*x = SystemSnapshot{}
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[42]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3097,7 +3602,7 @@ func (x *SystemSnapshot) String() string {
 func (*SystemSnapshot) ProtoMessage() {}
 
 func (x *SystemSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_fsm_proto_msgTypes[42]
+	mi := &file_gastrolog_v1_fsm_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3110,7 +3615,7 @@ func (x *SystemSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemSnapshot.ProtoReflect.Descriptor instead.
 func (*SystemSnapshot) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{42}
+	return file_gastrolog_v1_fsm_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *SystemSnapshot) GetFilters() []*PutFilterCommand {
@@ -3260,11 +3765,32 @@ func (x *SystemSnapshot) GetIngesterCheckpoints() []*SetIngesterCheckpointComman
 	return nil
 }
 
+func (x *SystemSnapshot) GetAlertRules() []*PutAlertRuleCommand {
+	if x != nil {
+		return x.AlertRules
+	}
+	return nil
+}
+
+func (x *SystemSnapshot) GetRoles() []*PutRoleCommand {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *SystemSnapshot) GetApiKeys() []*CreateAPIKeyCommand {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
 var File_gastrolog_v1_fsm_proto protoreflect.FileDescriptor
 
 const file_gastrolog_v1_fsm_proto_rawDesc = "" +
 	"\n" +
-	"\x16gastrolog/v1/fsm.proto\x12\fgastrolog.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19gastrolog/v1/system.proto\x1a\x1agastrolog/v1/storage.proto\"\xef\x1a\n" +
+	"\x16gastrolog/v1/fsm.proto\x12\fgastrolog.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19gastrolog/v1/system.proto\x1a\x1agastrolog/v1/storage.proto\"\xeb\x1e\n" +
 	"\rSystemCommand\x12?\n" +
 	"\n" +
 	"put_filter\x18\x01 \x01(\v2\x1e.gastrolog.v1.PutFilterCommandH\x00R\tputFilter\x12H\n" +
@@ -3312,7 +3838,15 @@ const file_gastrolog_v1_fsm_proto_rawDesc = "" +
 	"\x1aset_setup_wizard_dismissed\x18& \x01(\v2,.gastrolog.v1.SetSetupWizardDismissedCommandH\x00R\x17setSetupWizardDismissed\x12U\n" +
 	"\x12set_ingester_alive\x18' \x01(\v2%.gastrolog.v1.SetIngesterAliveCommandH\x00R\x10setIngesterAlive\x12d\n" +
 	"\x17set_ingester_assignment\x18( \x01(\v2*.gastrolog.v1.SetIngesterAssignmentCommandH\x00R\x15setIngesterAssignment\x12d\n" +
-	"\x17set_ingester_checkpoint\x18) \x01(\v2*.gastrolog.v1.SetIngesterCheckpointCommandH\x00R\x15setIngesterCheckpointB\t\n" +
+	"\x17set_ingester_checkpoint\x18) \x01(\v2*.gastrolog.v1.SetIngesterCheckpointCommandH\x00R\x15setIngesterCheckpoint\x12I\n" +
+	"\x0eput_alert_rule\x18* \x01(\v2!.gastrolog.v1.PutAlertRuleCommandH\x00R\fputAlertRule\x12R\n" +
+	"\x11delete_alert_rule\x18+ \x01(\v2$.gastrolog.v1.DeleteAlertRuleCommandH\x00R\x0fdeleteAlertRule\x129\n" +
+	"\bput_role\x18, \x01(\v2\x1c.gastrolog.v1.PutRoleCommandH\x00R\aputRole\x12B\n" +
+	"\vdelete_role\x18- \x01(\v2\x1f.gastrolog.v1.DeleteRoleCommandH\x00R\n" +
+	"deleteRole\x12I\n" +
+	"\x0ecreate_api_key\x18. \x01(\v2!.gastrolog.v1.CreateAPIKeyCommandH\x00R\fcreateApiKey\x12I\n" +
+	"\x0edelete_api_key\x18/ \x01(\v2!.gastrolog.v1.DeleteAPIKeyCommandH\x00R\fdeleteApiKey\x12F\n" +
+	"\rtouch_api_key\x180 \x01(\v2 .gastrolog.v1.TouchAPIKeyCommandH\x00R\vtouchApiKeyB\t\n" +
 	"\acommand\"V\n" +
 	"\x10PutFilterCommand\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\fR\x02id\x12\x12\n" +
@@ -3388,7 +3922,7 @@ const file_gastrolog_v1_fsm_proto_rawDesc = "" +
 	"\tcert_file\x18\x05 \x01(\tR\bcertFile\x12\x19\n" +
 	"\bkey_file\x18\x06 \x01(\tR\akeyFile\"*\n" +
 	"\x18DeleteCertificateCommand\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\fR\x02id\"\xde\x02\n" +
+	"\x02id\x18\x01 \x01(\fR\x02id\"\xff\x02\n" +
 	"\x11CreateUserCommand\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\fR\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12#\n" +
@@ -3399,7 +3933,9 @@ const file_gastrolog_v1_fsm_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"L\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1f\n" +
+	"\vexternal_id\x18\t \x01(\tR\n" +
+	"externalId\"L\n" +
 	"\x15UpdatePasswordCommand\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\fR\x02id\x12#\n" +
 	"\rpassword_hash\x18\x02 \x01(\tR\fpasswordHash\";\n" +
@@ -3429,7 +3965,25 @@ const file_gastrolog_v1_fsm_proto_rawDesc = "" +
 	"\x19DeleteRefreshTokenCommand\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\fR\x02id\"9\n" +
 	"\x1eDeleteUserRefreshTokensCommand\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\fR\x06userId\":\n" +
+	"\auser_id\x18\x01 \x01(\fR\x06userId\"\xbf\x02\n" +
+	"\x13CreateAPIKeyCommand\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\fR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bkey_hash\x18\x03 \x01(\tR\akeyHash\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_used_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"%\n" +
+	"\x13DeleteAPIKeyCommand\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\fR\x02id\"P\n" +
+	"\x12TouchAPIKeyCommand\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\fR\x02id\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\":\n" +
 	"\x14PutNodeConfigCommand\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\fR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\")\n" +
@@ -3442,7 +3996,7 @@ const file_gastrolog_v1_fsm_proto_rawDesc = "" +
 	"\x10cluster_cert_pem\x18\x03 \x01(\fR\x0eclusterCertPem\x12&\n" +
 	"\x0fcluster_key_pem\x18\x04 \x01(\fR\rclusterKeyPem\x12\x1d\n" +
 	"\n" +
-	"join_token\x18\x05 \x01(\tR\tjoinToken\"\xd8\x01\n" +
+	"join_token\x18\x05 \x01(\tR\tjoinToken\"\x8a\x02\n" +
 	"\x0fPutRouteCommand\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\fR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
@@ -3451,7 +4005,8 @@ const file_gastrolog_v1_fsm_proto_rawDesc = "" +
 	"\fdistribution\x18\x05 \x01(\tR\fdistribution\x12\x18\n" +
 	"\aenabled\x18\x06 \x01(\bR\aenabled\x12\x1d\n" +
 	"\n" +
-	"eject_only\x18\a \x01(\bR\tejectOnly\"$\n" +
+	"eject_only\x18\a \x01(\bR\tejectOnly\x120\n" +
+	"\x06stages\x18\b \x03(\v2\x18.gastrolog.v1.RouteStageR\x06stages\"$\n" +
 	"\x12DeleteRouteCommand\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\fR\x02id\"\x88\x01\n" +
 	"\x15PutManagedFileCommand\x12\x0e\n" +
@@ -3493,7 +4048,16 @@ const file_gastrolog_v1_fsm_proto_rawDesc = "" +
 	"\x1cSetIngesterCheckpointCommand\x12\x1f\n" +
 	"\vingester_id\x18\x01 \x01(\fR\n" +
 	"ingesterId\x12\x12\n" +
-	"\x04data\x18\x02 \x01(\fR\x04data\"\xc1\f\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"S\n" +
+	"\x13PutAlertRuleCommand\x12<\n" +
+	"\n" +
+	"alert_rule\x18\x01 \x01(\v2\x1d.gastrolog.v1.AlertRuleConfigR\talertRule\"(\n" +
+	"\x16DeleteAlertRuleCommand\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\fR\x02id\">\n" +
+	"\x0ePutRoleCommand\x12,\n" +
+	"\x04role\x18\x01 \x01(\v2\x18.gastrolog.v1.RoleConfigR\x04role\"#\n" +
+	"\x11DeleteRoleCommand\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\fR\x02id\"\xf7\r\n" +
 	"\x0eSystemSnapshot\x128\n" +
 	"\afilters\x18\x01 \x03(\v2\x1e.gastrolog.v1.PutFilterCommandR\afilters\x12S\n" +
 	"\x11rotation_policies\x18\x02 \x03(\v2&.gastrolog.v1.PutRotationPolicyCommandR\x10rotationPolicies\x12V\n" +
@@ -3517,7 +4081,11 @@ const file_gastrolog_v1_fsm_proto_rawDesc = "" +
 	"\x16setup_wizard_dismissed\x18\x12 \x01(\bR\x14setupWizardDismissed\x12L\n" +
 	"\x0eingester_alive\x18\x13 \x03(\v2%.gastrolog.v1.SetIngesterAliveCommandR\ringesterAlive\x12]\n" +
 	"\x14ingester_assignments\x18\x14 \x03(\v2*.gastrolog.v1.SetIngesterAssignmentCommandR\x13ingesterAssignments\x12]\n" +
-	"\x14ingester_checkpoints\x18\x15 \x03(\v2*.gastrolog.v1.SetIngesterCheckpointCommandR\x13ingesterCheckpoints\x1a;\n" +
+	"\x14ingester_checkpoints\x18\x15 \x03(\v2*.gastrolog.v1.SetIngesterCheckpointCommandR\x13ingesterCheckpoints\x12B\n" +
+	"\valert_rules\x18\x16 \x03(\v2!.gastrolog.v1.PutAlertRuleCommandR\n" +
+	"alertRules\x122\n" +
+	"\x05roles\x18\x17 \x03(\v2\x1c.gastrolog.v1.PutRoleCommandR\x05roles\x12<\n" +
+	"\bapi_keys\x18\x18 \x03(\v2!.gastrolog.v1.CreateAPIKeyCommandR\aapiKeys\x1a;\n" +
 	"\rSettingsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B,Z*gastrolog/api/gen/gastrolog/v1;gastrologv1b\x06proto3"
@@ -3534,7 +4102,7 @@ func file_gastrolog_v1_fsm_proto_rawDescGZIP() []byte {
 	return file_gastrolog_v1_fsm_proto_rawDescData
 }
 
-var file_gastrolog_v1_fsm_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_gastrolog_v1_fsm_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_gastrolog_v1_fsm_proto_goTypes = []any{
 	(*SystemCommand)(nil),                  // 0: gastrolog.v1.SystemCommand
 	(*PutFilterCommand)(nil),               // 1: gastrolog.v1.PutFilterCommand
@@ -3561,32 +4129,42 @@ var file_gastrolog_v1_fsm_proto_goTypes = []any{
 	(*CreateRefreshTokenCommand)(nil),      // 22: gastrolog.v1.CreateRefreshTokenCommand
 	(*DeleteRefreshTokenCommand)(nil),      // 23: gastrolog.v1.DeleteRefreshTokenCommand
 	(*DeleteUserRefreshTokensCommand)(nil), // 24: gastrolog.v1.DeleteUserRefreshTokensCommand
-	(*PutNodeConfigCommand)(nil),           // 25: gastrolog.v1.PutNodeConfigCommand
-	(*DeleteNodeConfigCommand)(nil),        // 26: gastrolog.v1.DeleteNodeConfigCommand
-	(*PutClusterTLSCommand)(nil),           // 27: gastrolog.v1.PutClusterTLSCommand
-	(*PutRouteCommand)(nil),                // 28: gastrolog.v1.PutRouteCommand
-	(*DeleteRouteCommand)(nil),             // 29: gastrolog.v1.DeleteRouteCommand
-	(*PutManagedFileCommand)(nil),          // 30: gastrolog.v1.PutManagedFileCommand
-	(*DeleteManagedFileCommand)(nil),       // 31: gastrolog.v1.DeleteManagedFileCommand
-	(*PutCloudServiceCommand)(nil),         // 32: gastrolog.v1.PutCloudServiceCommand
-	(*DeleteCloudServiceCommand)(nil),      // 33: gastrolog.v1.DeleteCloudServiceCommand
-	(*SetNodeStorageConfigCommand)(nil),    // 34: gastrolog.v1.SetNodeStorageConfigCommand
-	(*PutTierCommand)(nil),                 // 35: gastrolog.v1.PutTierCommand
-	(*DeleteTierCommand)(nil),              // 36: gastrolog.v1.DeleteTierCommand
-	(*SetTierPlacementsCommand)(nil),       // 37: gastrolog.v1.SetTierPlacementsCommand
-	(*SetSetupWizardDismissedCommand)(nil), // 38: gastrolog.v1.SetSetupWizardDismissedCommand
-	(*SetIngesterAliveCommand)(nil),        // 39: gastrolog.v1.SetIngesterAliveCommand
-	(*SetIngesterAssignmentCommand)(nil),   // 40: gastrolog.v1.SetIngesterAssignmentCommand
-	(*SetIngesterCheckpointCommand)(nil),   // 41: gastrolog.v1.SetIngesterCheckpointCommand
-	(*SystemSnapshot)(nil),                 // 42: gastrolog.v1.SystemSnapshot
-	nil,                                    // 43: gastrolog.v1.PutIngesterCommand.ParamsEntry
-	nil,                                    // 44: gastrolog.v1.SystemSnapshot.SettingsEntry
-	(*VaultConfig)(nil),                    // 45: gastrolog.v1.VaultConfig
-	(*timestamppb.Timestamp)(nil),          // 46: google.protobuf.Timestamp
-	(*CloudService)(nil),                   // 47: gastrolog.v1.CloudService
-	(*NodeStorageConfig)(nil),              // 48: gastrolog.v1.NodeStorageConfig
-	(*TierConfig)(nil),                     // 49: gastrolog.v1.TierConfig
-	(*TierPlacement)(nil),                  // 50: gastrolog.v1.TierPlacement
+	(*CreateAPIKeyCommand)(nil),            // 25: gastrolog.v1.CreateAPIKeyCommand
+	(*DeleteAPIKeyCommand)(nil),            // 26: gastrolog.v1.DeleteAPIKeyCommand
+	(*TouchAPIKeyCommand)(nil),             // 27: gastrolog.v1.TouchAPIKeyCommand
+	(*PutNodeConfigCommand)(nil),           // 28: gastrolog.v1.PutNodeConfigCommand
+	(*DeleteNodeConfigCommand)(nil),        // 29: gastrolog.v1.DeleteNodeConfigCommand
+	(*PutClusterTLSCommand)(nil),           // 30: gastrolog.v1.PutClusterTLSCommand
+	(*PutRouteCommand)(nil),                // 31: gastrolog.v1.PutRouteCommand
+	(*DeleteRouteCommand)(nil),             // 32: gastrolog.v1.DeleteRouteCommand
+	(*PutManagedFileCommand)(nil),          // 33: gastrolog.v1.PutManagedFileCommand
+	(*DeleteManagedFileCommand)(nil),       // 34: gastrolog.v1.DeleteManagedFileCommand
+	(*PutCloudServiceCommand)(nil),         // 35: gastrolog.v1.PutCloudServiceCommand
+	(*DeleteCloudServiceCommand)(nil),      // 36: gastrolog.v1.DeleteCloudServiceCommand
+	(*SetNodeStorageConfigCommand)(nil),    // 37: gastrolog.v1.SetNodeStorageConfigCommand
+	(*PutTierCommand)(nil),                 // 38: gastrolog.v1.PutTierCommand
+	(*DeleteTierCommand)(nil),              // 39: gastrolog.v1.DeleteTierCommand
+	(*SetTierPlacementsCommand)(nil),       // 40: gastrolog.v1.SetTierPlacementsCommand
+	(*SetSetupWizardDismissedCommand)(nil), // 41: gastrolog.v1.SetSetupWizardDismissedCommand
+	(*SetIngesterAliveCommand)(nil),        // 42: gastrolog.v1.SetIngesterAliveCommand
+	(*SetIngesterAssignmentCommand)(nil),   // 43: gastrolog.v1.SetIngesterAssignmentCommand
+	(*SetIngesterCheckpointCommand)(nil),   // 44: gastrolog.v1.SetIngesterCheckpointCommand
+	(*PutAlertRuleCommand)(nil),            // 45: gastrolog.v1.PutAlertRuleCommand
+	(*DeleteAlertRuleCommand)(nil),         // 46: gastrolog.v1.DeleteAlertRuleCommand
+	(*PutRoleCommand)(nil),                 // 47: gastrolog.v1.PutRoleCommand
+	(*DeleteRoleCommand)(nil),              // 48: gastrolog.v1.DeleteRoleCommand
+	(*SystemSnapshot)(nil),                 // 49: gastrolog.v1.SystemSnapshot
+	nil,                                    // 50: gastrolog.v1.PutIngesterCommand.ParamsEntry
+	nil,                                    // 51: gastrolog.v1.SystemSnapshot.SettingsEntry
+	(*VaultConfig)(nil),                    // 52: gastrolog.v1.VaultConfig
+	(*timestamppb.Timestamp)(nil),          // 53: google.protobuf.Timestamp
+	(*RouteStage)(nil),                     // 54: gastrolog.v1.RouteStage
+	(*CloudService)(nil),                   // 55: gastrolog.v1.CloudService
+	(*NodeStorageConfig)(nil),              // 56: gastrolog.v1.NodeStorageConfig
+	(*TierConfig)(nil),                     // 57: gastrolog.v1.TierConfig
+	(*TierPlacement)(nil),                  // 58: gastrolog.v1.TierPlacement
+	(*AlertRuleConfig)(nil),                // 59: gastrolog.v1.AlertRuleConfig
+	(*RoleConfig)(nil),                     // 60: gastrolog.v1.RoleConfig
 }
 var file_gastrolog_v1_fsm_proto_depIdxs = []int32{
 	1,  // 0: gastrolog.v1.SystemCommand.put_filter:type_name -> gastrolog.v1.PutFilterCommand
@@ -3613,60 +4191,77 @@ var file_gastrolog_v1_fsm_proto_depIdxs = []int32{
 	22, // 21: gastrolog.v1.SystemCommand.create_refresh_token:type_name -> gastrolog.v1.CreateRefreshTokenCommand
 	23, // 22: gastrolog.v1.SystemCommand.delete_refresh_token:type_name -> gastrolog.v1.DeleteRefreshTokenCommand
 	24, // 23: gastrolog.v1.SystemCommand.delete_user_refresh_tokens:type_name -> gastrolog.v1.DeleteUserRefreshTokensCommand
-	25, // 24: gastrolog.v1.SystemCommand.put_node_config:type_name -> gastrolog.v1.PutNodeConfigCommand
-	26, // 25: gastrolog.v1.SystemCommand.delete_node_config:type_name -> gastrolog.v1.DeleteNodeConfigCommand
-	27, // 26: gastrolog.v1.SystemCommand.put_cluster_tls:type_name -> gastrolog.v1.PutClusterTLSCommand
-	28, // 27: gastrolog.v1.SystemCommand.put_route:type_name -> gastrolog.v1.PutRouteCommand
-	29, // 28: gastrolog.v1.SystemCommand.delete_route:type_name -> gastrolog.v1.DeleteRouteCommand
-	30, // 29: gastrolog.v1.SystemCommand.put_managed_file:type_name -> gastrolog.v1.PutManagedFileCommand
-	31, // 30: gastrolog.v1.SystemCommand.delete_managed_file:type_name -> gastrolog.v1.DeleteManagedFileCommand
-	32, // 31: gastrolog.v1.SystemCommand.put_cloud_service:type_name -> gastrolog.v1.PutCloudServiceCommand
-	33, // 32: gastrolog.v1.SystemCommand.delete_cloud_service:type_name -> gastrolog.v1.DeleteCloudServiceCommand
-	34, // 33: gastrolog.v1.SystemCommand.set_node_storage_config:type_name -> gastrolog.v1.SetNodeStorageConfigCommand
-	35, // 34: gastrolog.v1.SystemCommand.put_tier:type_name -> gastrolog.v1.PutTierCommand
-	36, // 35: gastrolog.v1.SystemCommand.delete_tier:type_name -> gastrolog.v1.DeleteTierCommand
-	37, // 36: gastrolog.v1.SystemCommand.set_tier_placements:type_name -> gastrolog.v1.SetTierPlacementsCommand
-	38, // 37: gastrolog.v1.SystemCommand.set_setup_wizard_dismissed:type_name -> gastrolog.v1.SetSetupWizardDismissedCommand
-	39, // 38: gastrolog.v1.SystemCommand.set_ingester_alive:type_name -> gastrolog.v1.SetIngesterAliveCommand
-	40, // 39: gastrolog.v1.SystemCommand.set_ingester_assignment:type_name -> gastrolog.v1.SetIngesterAssignmentCommand
-	41, // 40: gastrolog.v1.SystemCommand.set_ingester_checkpoint:type_name -> gastrolog.v1.SetIngesterCheckpointCommand
-	45, // 41: gastrolog.v1.PutVaultCommand.vault:type_name -> gastrolog.v1.VaultConfig
-	43, // 42: gastrolog.v1.PutIngesterCommand.params:type_name -> gastrolog.v1.PutIngesterCommand.ParamsEntry
-	46, // 43: gastrolog.v1.CreateUserCommand.token_invalidated_at:type_name -> google.protobuf.Timestamp
-	46, // 44: gastrolog.v1.CreateUserCommand.created_at:type_name -> google.protobuf.Timestamp
-	46, // 45: gastrolog.v1.CreateUserCommand.updated_at:type_name -> google.protobuf.Timestamp
-	46, // 46: gastrolog.v1.InvalidateTokensCommand.at:type_name -> google.protobuf.Timestamp
-	46, // 47: gastrolog.v1.CreateRefreshTokenCommand.expires_at:type_name -> google.protobuf.Timestamp
-	46, // 48: gastrolog.v1.CreateRefreshTokenCommand.created_at:type_name -> google.protobuf.Timestamp
-	47, // 49: gastrolog.v1.PutCloudServiceCommand.cloud_service:type_name -> gastrolog.v1.CloudService
-	48, // 50: gastrolog.v1.SetNodeStorageConfigCommand.node_storage:type_name -> gastrolog.v1.NodeStorageConfig
-	49, // 51: gastrolog.v1.PutTierCommand.tier:type_name -> gastrolog.v1.TierConfig
-	50, // 52: gastrolog.v1.SetTierPlacementsCommand.placements:type_name -> gastrolog.v1.TierPlacement
-	1,  // 53: gastrolog.v1.SystemSnapshot.filters:type_name -> gastrolog.v1.PutFilterCommand
-	3,  // 54: gastrolog.v1.SystemSnapshot.rotation_policies:type_name -> gastrolog.v1.PutRotationPolicyCommand
-	5,  // 55: gastrolog.v1.SystemSnapshot.retention_policies:type_name -> gastrolog.v1.PutRetentionPolicyCommand
-	7,  // 56: gastrolog.v1.SystemSnapshot.vaults:type_name -> gastrolog.v1.PutVaultCommand
-	9,  // 57: gastrolog.v1.SystemSnapshot.ingesters:type_name -> gastrolog.v1.PutIngesterCommand
-	44, // 58: gastrolog.v1.SystemSnapshot.settings:type_name -> gastrolog.v1.SystemSnapshot.SettingsEntry
-	13, // 59: gastrolog.v1.SystemSnapshot.certificates:type_name -> gastrolog.v1.PutCertificateCommand
-	15, // 60: gastrolog.v1.SystemSnapshot.users:type_name -> gastrolog.v1.CreateUserCommand
-	22, // 61: gastrolog.v1.SystemSnapshot.refresh_tokens:type_name -> gastrolog.v1.CreateRefreshTokenCommand
-	25, // 62: gastrolog.v1.SystemSnapshot.node_configs:type_name -> gastrolog.v1.PutNodeConfigCommand
-	27, // 63: gastrolog.v1.SystemSnapshot.cluster_tls:type_name -> gastrolog.v1.PutClusterTLSCommand
-	28, // 64: gastrolog.v1.SystemSnapshot.routes:type_name -> gastrolog.v1.PutRouteCommand
-	30, // 65: gastrolog.v1.SystemSnapshot.managed_files:type_name -> gastrolog.v1.PutManagedFileCommand
-	32, // 66: gastrolog.v1.SystemSnapshot.cloud_services:type_name -> gastrolog.v1.PutCloudServiceCommand
-	34, // 67: gastrolog.v1.SystemSnapshot.node_storage_configs:type_name -> gastrolog.v1.SetNodeStorageConfigCommand
-	35, // 68: gastrolog.v1.SystemSnapshot.tiers:type_name -> gastrolog.v1.PutTierCommand
-	37, // 69: gastrolog.v1.SystemSnapshot.tier_placements:type_name -> gastrolog.v1.SetTierPlacementsCommand
-	39, // 70: gastrolog.v1.SystemSnapshot.ingester_alive:type_name -> gastrolog.v1.SetIngesterAliveCommand
-	40, // 71: gastrolog.v1.SystemSnapshot.ingester_assignments:type_name -> gastrolog.v1.SetIngesterAssignmentCommand
-	41, // 72: gastrolog.v1.SystemSnapshot.ingester_checkpoints:type_name -> gastrolog.v1.SetIngesterCheckpointCommand
-	73, // [73:73] is the sub-list for method output_type
-	73, // [73:73] is the sub-list for method input_type
-	73, // [73:73] is the sub-list for extension type_name
-	73, // [73:73] is the sub-list for extension extendee
-	0,  // [0:73] is the sub-list for field type_name
+	28, // 24: gastrolog.v1.SystemCommand.put_node_config:type_name -> gastrolog.v1.PutNodeConfigCommand
+	29, // 25: gastrolog.v1.SystemCommand.delete_node_config:type_name -> gastrolog.v1.DeleteNodeConfigCommand
+	30, // 26: gastrolog.v1.SystemCommand.put_cluster_tls:type_name -> gastrolog.v1.PutClusterTLSCommand
+	31, // 27: gastrolog.v1.SystemCommand.put_route:type_name -> gastrolog.v1.PutRouteCommand
+	32, // 28: gastrolog.v1.SystemCommand.delete_route:type_name -> gastrolog.v1.DeleteRouteCommand
+	33, // 29: gastrolog.v1.SystemCommand.put_managed_file:type_name -> gastrolog.v1.PutManagedFileCommand
+	34, // 30: gastrolog.v1.SystemCommand.delete_managed_file:type_name -> gastrolog.v1.DeleteManagedFileCommand
+	35, // 31: gastrolog.v1.SystemCommand.put_cloud_service:type_name -> gastrolog.v1.PutCloudServiceCommand
+	36, // 32: gastrolog.v1.SystemCommand.delete_cloud_service:type_name -> gastrolog.v1.DeleteCloudServiceCommand
+	37, // 33: gastrolog.v1.SystemCommand.set_node_storage_config:type_name -> gastrolog.v1.SetNodeStorageConfigCommand
+	38, // 34: gastrolog.v1.SystemCommand.put_tier:type_name -> gastrolog.v1.PutTierCommand
+	39, // 35: gastrolog.v1.SystemCommand.delete_tier:type_name -> gastrolog.v1.DeleteTierCommand
+	40, // 36: gastrolog.v1.SystemCommand.set_tier_placements:type_name -> gastrolog.v1.SetTierPlacementsCommand
+	41, // 37: gastrolog.v1.SystemCommand.set_setup_wizard_dismissed:type_name -> gastrolog.v1.SetSetupWizardDismissedCommand
+	42, // 38: gastrolog.v1.SystemCommand.set_ingester_alive:type_name -> gastrolog.v1.SetIngesterAliveCommand
+	43, // 39: gastrolog.v1.SystemCommand.set_ingester_assignment:type_name -> gastrolog.v1.SetIngesterAssignmentCommand
+	44, // 40: gastrolog.v1.SystemCommand.set_ingester_checkpoint:type_name -> gastrolog.v1.SetIngesterCheckpointCommand
+	45, // 41: gastrolog.v1.SystemCommand.put_alert_rule:type_name -> gastrolog.v1.PutAlertRuleCommand
+	46, // 42: gastrolog.v1.SystemCommand.delete_alert_rule:type_name -> gastrolog.v1.DeleteAlertRuleCommand
+	47, // 43: gastrolog.v1.SystemCommand.put_role:type_name -> gastrolog.v1.PutRoleCommand
+	48, // 44: gastrolog.v1.SystemCommand.delete_role:type_name -> gastrolog.v1.DeleteRoleCommand
+	25, // 45: gastrolog.v1.SystemCommand.create_api_key:type_name -> gastrolog.v1.CreateAPIKeyCommand
+	26, // 46: gastrolog.v1.SystemCommand.delete_api_key:type_name -> gastrolog.v1.DeleteAPIKeyCommand
+	27, // 47: gastrolog.v1.SystemCommand.touch_api_key:type_name -> gastrolog.v1.TouchAPIKeyCommand
+	52, // 48: gastrolog.v1.PutVaultCommand.vault:type_name -> gastrolog.v1.VaultConfig
+	50, // 49: gastrolog.v1.PutIngesterCommand.params:type_name -> gastrolog.v1.PutIngesterCommand.ParamsEntry
+	53, // 50: gastrolog.v1.CreateUserCommand.token_invalidated_at:type_name -> google.protobuf.Timestamp
+	53, // 51: gastrolog.v1.CreateUserCommand.created_at:type_name -> google.protobuf.Timestamp
+	53, // 52: gastrolog.v1.CreateUserCommand.updated_at:type_name -> google.protobuf.Timestamp
+	53, // 53: gastrolog.v1.InvalidateTokensCommand.at:type_name -> google.protobuf.Timestamp
+	53, // 54: gastrolog.v1.CreateRefreshTokenCommand.expires_at:type_name -> google.protobuf.Timestamp
+	53, // 55: gastrolog.v1.CreateRefreshTokenCommand.created_at:type_name -> google.protobuf.Timestamp
+	53, // 56: gastrolog.v1.CreateAPIKeyCommand.expires_at:type_name -> google.protobuf.Timestamp
+	53, // 57: gastrolog.v1.CreateAPIKeyCommand.last_used_at:type_name -> google.protobuf.Timestamp
+	53, // 58: gastrolog.v1.CreateAPIKeyCommand.created_at:type_name -> google.protobuf.Timestamp
+	53, // 59: gastrolog.v1.TouchAPIKeyCommand.at:type_name -> google.protobuf.Timestamp
+	54, // 60: gastrolog.v1.PutRouteCommand.stages:type_name -> gastrolog.v1.RouteStage
+	55, // 61: gastrolog.v1.PutCloudServiceCommand.cloud_service:type_name -> gastrolog.v1.CloudService
+	56, // 62: gastrolog.v1.SetNodeStorageConfigCommand.node_storage:type_name -> gastrolog.v1.NodeStorageConfig
+	57, // 63: gastrolog.v1.PutTierCommand.tier:type_name -> gastrolog.v1.TierConfig
+	58, // 64: gastrolog.v1.SetTierPlacementsCommand.placements:type_name -> gastrolog.v1.TierPlacement
+	59, // 65: gastrolog.v1.PutAlertRuleCommand.alert_rule:type_name -> gastrolog.v1.AlertRuleConfig
+	60, // 66: gastrolog.v1.PutRoleCommand.role:type_name -> gastrolog.v1.RoleConfig
+	1,  // 67: gastrolog.v1.SystemSnapshot.filters:type_name -> gastrolog.v1.PutFilterCommand
+	3,  // 68: gastrolog.v1.SystemSnapshot.rotation_policies:type_name -> gastrolog.v1.PutRotationPolicyCommand
+	5,  // 69: gastrolog.v1.SystemSnapshot.retention_policies:type_name -> gastrolog.v1.PutRetentionPolicyCommand
+	7,  // 70: gastrolog.v1.SystemSnapshot.vaults:type_name -> gastrolog.v1.PutVaultCommand
+	9,  // 71: gastrolog.v1.SystemSnapshot.ingesters:type_name -> gastrolog.v1.PutIngesterCommand
+	51, // 72: gastrolog.v1.SystemSnapshot.settings:type_name -> gastrolog.v1.SystemSnapshot.SettingsEntry
+	13, // 73: gastrolog.v1.SystemSnapshot.certificates:type_name -> gastrolog.v1.PutCertificateCommand
+	15, // 74: gastrolog.v1.SystemSnapshot.users:type_name -> gastrolog.v1.CreateUserCommand
+	22, // 75: gastrolog.v1.SystemSnapshot.refresh_tokens:type_name -> gastrolog.v1.CreateRefreshTokenCommand
+	28, // 76: gastrolog.v1.SystemSnapshot.node_configs:type_name -> gastrolog.v1.PutNodeConfigCommand
+	30, // 77: gastrolog.v1.SystemSnapshot.cluster_tls:type_name -> gastrolog.v1.PutClusterTLSCommand
+	31, // 78: gastrolog.v1.SystemSnapshot.routes:type_name -> gastrolog.v1.PutRouteCommand
+	33, // 79: gastrolog.v1.SystemSnapshot.managed_files:type_name -> gastrolog.v1.PutManagedFileCommand
+	35, // 80: gastrolog.v1.SystemSnapshot.cloud_services:type_name -> gastrolog.v1.PutCloudServiceCommand
+	37, // 81: gastrolog.v1.SystemSnapshot.node_storage_configs:type_name -> gastrolog.v1.SetNodeStorageConfigCommand
+	38, // 82: gastrolog.v1.SystemSnapshot.tiers:type_name -> gastrolog.v1.PutTierCommand
+	40, // 83: gastrolog.v1.SystemSnapshot.tier_placements:type_name -> gastrolog.v1.SetTierPlacementsCommand
+	42, // 84: gastrolog.v1.SystemSnapshot.ingester_alive:type_name -> gastrolog.v1.SetIngesterAliveCommand
+	43, // 85: gastrolog.v1.SystemSnapshot.ingester_assignments:type_name -> gastrolog.v1.SetIngesterAssignmentCommand
+	44, // 86: gastrolog.v1.SystemSnapshot.ingester_checkpoints:type_name -> gastrolog.v1.SetIngesterCheckpointCommand
+	45, // 87: gastrolog.v1.SystemSnapshot.alert_rules:type_name -> gastrolog.v1.PutAlertRuleCommand
+	47, // 88: gastrolog.v1.SystemSnapshot.roles:type_name -> gastrolog.v1.PutRoleCommand
+	25, // 89: gastrolog.v1.SystemSnapshot.api_keys:type_name -> gastrolog.v1.CreateAPIKeyCommand
+	90, // [90:90] is the sub-list for method output_type
+	90, // [90:90] is the sub-list for method input_type
+	90, // [90:90] is the sub-list for extension type_name
+	90, // [90:90] is the sub-list for extension extendee
+	0,  // [0:90] is the sub-list for field type_name
 }
 
 func init() { file_gastrolog_v1_fsm_proto_init() }
@@ -3718,6 +4313,13 @@ func file_gastrolog_v1_fsm_proto_init() {
 		(*SystemCommand_SetIngesterAlive)(nil),
 		(*SystemCommand_SetIngesterAssignment)(nil),
 		(*SystemCommand_SetIngesterCheckpoint)(nil),
+		(*SystemCommand_PutAlertRule)(nil),
+		(*SystemCommand_DeleteAlertRule)(nil),
+		(*SystemCommand_PutRole)(nil),
+		(*SystemCommand_DeleteRole)(nil),
+		(*SystemCommand_CreateApiKey)(nil),
+		(*SystemCommand_DeleteApiKey)(nil),
+		(*SystemCommand_TouchApiKey)(nil),
 	}
 	file_gastrolog_v1_fsm_proto_msgTypes[3].OneofWrappers = []any{}
 	file_gastrolog_v1_fsm_proto_msgTypes[5].OneofWrappers = []any{}
@@ -3728,7 +4330,7 @@ func file_gastrolog_v1_fsm_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gastrolog_v1_fsm_proto_rawDesc), len(file_gastrolog_v1_fsm_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   45,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
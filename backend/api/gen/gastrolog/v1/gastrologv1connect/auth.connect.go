@@ -37,6 +37,12 @@ const (
 	AuthServiceRegisterProcedure = "/gastrolog.v1.AuthService/Register"
 	// AuthServiceLoginProcedure is the fully-qualified name of the AuthService's Login RPC.
 	AuthServiceLoginProcedure = "/gastrolog.v1.AuthService/Login"
+	// AuthServiceBeginOIDCLoginProcedure is the fully-qualified name of the AuthService's
+	// BeginOIDCLogin RPC.
+	AuthServiceBeginOIDCLoginProcedure = "/gastrolog.v1.AuthService/BeginOIDCLogin"
+	// AuthServiceCompleteOIDCLoginProcedure is the fully-qualified name of the AuthService's
+	// CompleteOIDCLogin RPC.
+	AuthServiceCompleteOIDCLoginProcedure = "/gastrolog.v1.AuthService/CompleteOIDCLogin"
 	// AuthServiceRefreshTokenProcedure is the fully-qualified name of the AuthService's RefreshToken
 	// RPC.
 	AuthServiceRefreshTokenProcedure = "/gastrolog.v1.AuthService/RefreshToken"
@@ -62,6 +68,14 @@ const (
 	AuthServiceDeleteUserProcedure = "/gastrolog.v1.AuthService/DeleteUser"
 	// AuthServiceLogoutProcedure is the fully-qualified name of the AuthService's Logout RPC.
 	AuthServiceLogoutProcedure = "/gastrolog.v1.AuthService/Logout"
+	// AuthServiceCreateAPIKeyProcedure is the fully-qualified name of the AuthService's CreateAPIKey
+	// RPC.
+	AuthServiceCreateAPIKeyProcedure = "/gastrolog.v1.AuthService/CreateAPIKey"
+	// AuthServiceListAPIKeysProcedure is the fully-qualified name of the AuthService's ListAPIKeys RPC.
+	AuthServiceListAPIKeysProcedure = "/gastrolog.v1.AuthService/ListAPIKeys"
+	// AuthServiceDeleteAPIKeyProcedure is the fully-qualified name of the AuthService's DeleteAPIKey
+	// RPC.
+	AuthServiceDeleteAPIKeyProcedure = "/gastrolog.v1.AuthService/DeleteAPIKey"
 )
 
 // AuthServiceClient is a client for the gastrolog.v1.AuthService service.
@@ -71,6 +85,13 @@ type AuthServiceClient interface {
 	Register(context.Context, *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error)
 	// Login authenticates a user and returns a token.
 	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
+	// BeginOIDCLogin starts single sign-on. It returns the identity
+	// provider's authorization URL and sets a short-lived state cookie that
+	// CompleteOIDCLogin checks.
+	BeginOIDCLogin(context.Context, *connect.Request[v1.BeginOIDCLoginRequest]) (*connect.Response[v1.BeginOIDCLoginResponse], error)
+	// CompleteOIDCLogin exchanges the authorization code from the identity
+	// provider's redirect for a token, provisioning the user on first login.
+	CompleteOIDCLogin(context.Context, *connect.Request[v1.CompleteOIDCLoginRequest]) (*connect.Response[v1.CompleteOIDCLoginResponse], error)
 	// RefreshToken exchanges a valid refresh token for a new access + refresh token pair.
 	RefreshToken(context.Context, *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.RefreshTokenResponse], error)
 	// ChangePassword updates the authenticated user's password.
@@ -91,6 +112,13 @@ type AuthServiceClient interface {
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
 	// Logout invalidates the current user's token.
 	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
+	// CreateAPIKey issues a long-lived API key. The key is returned once and
+	// only its hash is stored. Admin only.
+	CreateAPIKey(context.Context, *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error)
+	// ListAPIKeys returns all API keys (without the keys themselves). Admin only.
+	ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error)
+	// DeleteAPIKey revokes an API key. Admin only.
+	DeleteAPIKey(context.Context, *connect.Request[v1.DeleteAPIKeyRequest]) (*connect.Response[v1.DeleteAPIKeyResponse], error)
 }
 
 // NewAuthServiceClient constructs a client for the gastrolog.v1.AuthService service. By default, it
@@ -116,6 +144,18 @@ func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(authServiceMethods.ByName("Login")),
 			connect.WithClientOptions(opts...),
 		),
+		beginOIDCLogin: connect.NewClient[v1.BeginOIDCLoginRequest, v1.BeginOIDCLoginResponse](
+			httpClient,
+			baseURL+AuthServiceBeginOIDCLoginProcedure,
+			connect.WithSchema(authServiceMethods.ByName("BeginOIDCLogin")),
+			connect.WithClientOptions(opts...),
+		),
+		completeOIDCLogin: connect.NewClient[v1.CompleteOIDCLoginRequest, v1.CompleteOIDCLoginResponse](
+			httpClient,
+			baseURL+AuthServiceCompleteOIDCLoginProcedure,
+			connect.WithSchema(authServiceMethods.ByName("CompleteOIDCLogin")),
+			connect.WithClientOptions(opts...),
+		),
 		refreshToken: connect.NewClient[v1.RefreshTokenRequest, v1.RefreshTokenResponse](
 			httpClient,
 			baseURL+AuthServiceRefreshTokenProcedure,
@@ -176,23 +216,46 @@ func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(authServiceMethods.ByName("Logout")),
 			connect.WithClientOptions(opts...),
 		),
+		createAPIKey: connect.NewClient[v1.CreateAPIKeyRequest, v1.CreateAPIKeyResponse](
+			httpClient,
+			baseURL+AuthServiceCreateAPIKeyProcedure,
+			connect.WithSchema(authServiceMethods.ByName("CreateAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		listAPIKeys: connect.NewClient[v1.ListAPIKeysRequest, v1.ListAPIKeysResponse](
+			httpClient,
+			baseURL+AuthServiceListAPIKeysProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ListAPIKeys")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAPIKey: connect.NewClient[v1.DeleteAPIKeyRequest, v1.DeleteAPIKeyResponse](
+			httpClient,
+			baseURL+AuthServiceDeleteAPIKeyProcedure,
+			connect.WithSchema(authServiceMethods.ByName("DeleteAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // authServiceClient implements AuthServiceClient.
 type authServiceClient struct {
-	register       *connect.Client[v1.RegisterRequest, v1.RegisterResponse]
-	login          *connect.Client[v1.LoginRequest, v1.LoginResponse]
-	refreshToken   *connect.Client[v1.RefreshTokenRequest, v1.RefreshTokenResponse]
-	changePassword *connect.Client[v1.ChangePasswordRequest, v1.ChangePasswordResponse]
-	getAuthStatus  *connect.Client[v1.GetAuthStatusRequest, v1.GetAuthStatusResponse]
-	createUser     *connect.Client[v1.CreateUserRequest, v1.CreateUserResponse]
-	listUsers      *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
-	updateUserRole *connect.Client[v1.UpdateUserRoleRequest, v1.UpdateUserRoleResponse]
-	resetPassword  *connect.Client[v1.ResetPasswordRequest, v1.ResetPasswordResponse]
-	renameUser     *connect.Client[v1.RenameUserRequest, v1.RenameUserResponse]
-	deleteUser     *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
-	logout         *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
+	register          *connect.Client[v1.RegisterRequest, v1.RegisterResponse]
+	login             *connect.Client[v1.LoginRequest, v1.LoginResponse]
+	beginOIDCLogin    *connect.Client[v1.BeginOIDCLoginRequest, v1.BeginOIDCLoginResponse]
+	completeOIDCLogin *connect.Client[v1.CompleteOIDCLoginRequest, v1.CompleteOIDCLoginResponse]
+	refreshToken      *connect.Client[v1.RefreshTokenRequest, v1.RefreshTokenResponse]
+	changePassword    *connect.Client[v1.ChangePasswordRequest, v1.ChangePasswordResponse]
+	getAuthStatus     *connect.Client[v1.GetAuthStatusRequest, v1.GetAuthStatusResponse]
+	createUser        *connect.Client[v1.CreateUserRequest, v1.CreateUserResponse]
+	listUsers         *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	updateUserRole    *connect.Client[v1.UpdateUserRoleRequest, v1.UpdateUserRoleResponse]
+	resetPassword     *connect.Client[v1.ResetPasswordRequest, v1.ResetPasswordResponse]
+	renameUser        *connect.Client[v1.RenameUserRequest, v1.RenameUserResponse]
+	deleteUser        *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
+	logout            *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
+	createAPIKey      *connect.Client[v1.CreateAPIKeyRequest, v1.CreateAPIKeyResponse]
+	listAPIKeys       *connect.Client[v1.ListAPIKeysRequest, v1.ListAPIKeysResponse]
+	deleteAPIKey      *connect.Client[v1.DeleteAPIKeyRequest, v1.DeleteAPIKeyResponse]
 }
 
 // Register calls gastrolog.v1.AuthService.Register.
@@ -205,6 +268,16 @@ func (c *authServiceClient) Login(ctx context.Context, req *connect.Request[v1.L
 	return c.login.CallUnary(ctx, req)
 }
 
+// BeginOIDCLogin calls gastrolog.v1.AuthService.BeginOIDCLogin.
+func (c *authServiceClient) BeginOIDCLogin(ctx context.Context, req *connect.Request[v1.BeginOIDCLoginRequest]) (*connect.Response[v1.BeginOIDCLoginResponse], error) {
+	return c.beginOIDCLogin.CallUnary(ctx, req)
+}
+
+// CompleteOIDCLogin calls gastrolog.v1.AuthService.CompleteOIDCLogin.
+func (c *authServiceClient) CompleteOIDCLogin(ctx context.Context, req *connect.Request[v1.CompleteOIDCLoginRequest]) (*connect.Response[v1.CompleteOIDCLoginResponse], error) {
+	return c.completeOIDCLogin.CallUnary(ctx, req)
+}
+
 // RefreshToken calls gastrolog.v1.AuthService.RefreshToken.
 func (c *authServiceClient) RefreshToken(ctx context.Context, req *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.RefreshTokenResponse], error) {
 	return c.refreshToken.CallUnary(ctx, req)
@@ -255,6 +328,21 @@ func (c *authServiceClient) Logout(ctx context.Context, req *connect.Request[v1.
 	return c.logout.CallUnary(ctx, req)
 }
 
+// CreateAPIKey calls gastrolog.v1.AuthService.CreateAPIKey.
+func (c *authServiceClient) CreateAPIKey(ctx context.Context, req *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error) {
+	return c.createAPIKey.CallUnary(ctx, req)
+}
+
+// ListAPIKeys calls gastrolog.v1.AuthService.ListAPIKeys.
+func (c *authServiceClient) ListAPIKeys(ctx context.Context, req *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error) {
+	return c.listAPIKeys.CallUnary(ctx, req)
+}
+
+// DeleteAPIKey calls gastrolog.v1.AuthService.DeleteAPIKey.
+func (c *authServiceClient) DeleteAPIKey(ctx context.Context, req *connect.Request[v1.DeleteAPIKeyRequest]) (*connect.Response[v1.DeleteAPIKeyResponse], error) {
+	return c.deleteAPIKey.CallUnary(ctx, req)
+}
+
 // AuthServiceHandler is an implementation of the gastrolog.v1.AuthService service.
 type AuthServiceHandler interface {
 	// Register creates the first user account during initial setup.
@@ -262,6 +350,13 @@ type AuthServiceHandler interface {
 	Register(context.Context, *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error)
 	// Login authenticates a user and returns a token.
 	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
+	// BeginOIDCLogin starts single sign-on. It returns the identity
+	// provider's authorization URL and sets a short-lived state cookie that
+	// CompleteOIDCLogin checks.
+	BeginOIDCLogin(context.Context, *connect.Request[v1.BeginOIDCLoginRequest]) (*connect.Response[v1.BeginOIDCLoginResponse], error)
+	// CompleteOIDCLogin exchanges the authorization code from the identity
+	// provider's redirect for a token, provisioning the user on first login.
+	CompleteOIDCLogin(context.Context, *connect.Request[v1.CompleteOIDCLoginRequest]) (*connect.Response[v1.CompleteOIDCLoginResponse], error)
 	// RefreshToken exchanges a valid refresh token for a new access + refresh token pair.
 	RefreshToken(context.Context, *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.RefreshTokenResponse], error)
 	// ChangePassword updates the authenticated user's password.
@@ -282,6 +377,13 @@ type AuthServiceHandler interface {
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
 	// Logout invalidates the current user's token.
 	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
+	// CreateAPIKey issues a long-lived API key. The key is returned once and
+	// only its hash is stored. Admin only.
+	CreateAPIKey(context.Context, *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error)
+	// ListAPIKeys returns all API keys (without the keys themselves). Admin only.
+	ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error)
+	// DeleteAPIKey revokes an API key. Admin only.
+	DeleteAPIKey(context.Context, *connect.Request[v1.DeleteAPIKeyRequest]) (*connect.Response[v1.DeleteAPIKeyResponse], error)
 }
 
 // NewAuthServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -303,6 +405,18 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(authServiceMethods.ByName("Login")),
 		connect.WithHandlerOptions(opts...),
 	)
+	authServiceBeginOIDCLoginHandler := connect.NewUnaryHandler(
+		AuthServiceBeginOIDCLoginProcedure,
+		svc.BeginOIDCLogin,
+		connect.WithSchema(authServiceMethods.ByName("BeginOIDCLogin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceCompleteOIDCLoginHandler := connect.NewUnaryHandler(
+		AuthServiceCompleteOIDCLoginProcedure,
+		svc.CompleteOIDCLogin,
+		connect.WithSchema(authServiceMethods.ByName("CompleteOIDCLogin")),
+		connect.WithHandlerOptions(opts...),
+	)
 	authServiceRefreshTokenHandler := connect.NewUnaryHandler(
 		AuthServiceRefreshTokenProcedure,
 		svc.RefreshToken,
@@ -363,12 +477,34 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(authServiceMethods.ByName("Logout")),
 		connect.WithHandlerOptions(opts...),
 	)
+	authServiceCreateAPIKeyHandler := connect.NewUnaryHandler(
+		AuthServiceCreateAPIKeyProcedure,
+		svc.CreateAPIKey,
+		connect.WithSchema(authServiceMethods.ByName("CreateAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceListAPIKeysHandler := connect.NewUnaryHandler(
+		AuthServiceListAPIKeysProcedure,
+		svc.ListAPIKeys,
+		connect.WithSchema(authServiceMethods.ByName("ListAPIKeys")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceDeleteAPIKeyHandler := connect.NewUnaryHandler(
+		AuthServiceDeleteAPIKeyProcedure,
+		svc.DeleteAPIKey,
+		connect.WithSchema(authServiceMethods.ByName("DeleteAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/gastrolog.v1.AuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AuthServiceRegisterProcedure:
 			authServiceRegisterHandler.ServeHTTP(w, r)
 		case AuthServiceLoginProcedure:
 			authServiceLoginHandler.ServeHTTP(w, r)
+		case AuthServiceBeginOIDCLoginProcedure:
+			authServiceBeginOIDCLoginHandler.ServeHTTP(w, r)
+		case AuthServiceCompleteOIDCLoginProcedure:
+			authServiceCompleteOIDCLoginHandler.ServeHTTP(w, r)
 		case AuthServiceRefreshTokenProcedure:
 			authServiceRefreshTokenHandler.ServeHTTP(w, r)
 		case AuthServiceChangePasswordProcedure:
@@ -389,6 +525,12 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 			authServiceDeleteUserHandler.ServeHTTP(w, r)
 		case AuthServiceLogoutProcedure:
 			authServiceLogoutHandler.ServeHTTP(w, r)
+		case AuthServiceCreateAPIKeyProcedure:
+			authServiceCreateAPIKeyHandler.ServeHTTP(w, r)
+		case AuthServiceListAPIKeysProcedure:
+			authServiceListAPIKeysHandler.ServeHTTP(w, r)
+		case AuthServiceDeleteAPIKeyProcedure:
+			authServiceDeleteAPIKeyHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -406,6 +548,14 @@ func (UnimplementedAuthServiceHandler) Login(context.Context, *connect.Request[v
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastrolog.v1.AuthService.Login is not implemented"))
 }
 
+func (UnimplementedAuthServiceHandler) BeginOIDCLogin(context.Context, *connect.Request[v1.BeginOIDCLoginRequest]) (*connect.Response[v1.BeginOIDCLoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastrolog.v1.AuthService.BeginOIDCLogin is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) CompleteOIDCLogin(context.Context, *connect.Request[v1.CompleteOIDCLoginRequest]) (*connect.Response[v1.CompleteOIDCLoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastrolog.v1.AuthService.CompleteOIDCLogin is not implemented"))
+}
+
 func (UnimplementedAuthServiceHandler) RefreshToken(context.Context, *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.RefreshTokenResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastrolog.v1.AuthService.RefreshToken is not implemented"))
 }
@@ -445,3 +595,15 @@ func (UnimplementedAuthServiceHandler) DeleteUser(context.Context, *connect.Requ
 func (UnimplementedAuthServiceHandler) Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastrolog.v1.AuthService.Logout is not implemented"))
 }
+
+func (UnimplementedAuthServiceHandler) CreateAPIKey(context.Context, *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastrolog.v1.AuthService.CreateAPIKey is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastrolog.v1.AuthService.ListAPIKeys is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) DeleteAPIKey(context.Context, *connect.Request[v1.DeleteAPIKeyRequest]) (*connect.Response[v1.DeleteAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastrolog.v1.AuthService.DeleteAPIKey is not implemented"))
+}
@@ -137,6 +137,9 @@ const (
 	// SystemServiceDeleteRouteProcedure is the fully-qualified name of the SystemService's DeleteRoute
 	// RPC.
 	SystemServiceDeleteRouteProcedure = "/gastrolog.v1.SystemService/DeleteRoute"
+	// SystemServicePreviewRoutePipelineProcedure is the fully-qualified name of the SystemService's
+	// PreviewRoutePipeline RPC.
+	SystemServicePreviewRoutePipelineProcedure = "/gastrolog.v1.SystemService/PreviewRoutePipeline"
 	// SystemServiceGenerateNameProcedure is the fully-qualified name of the SystemService's
 	// GenerateName RPC.
 	SystemServiceGenerateNameProcedure = "/gastrolog.v1.SystemService/GenerateName"
@@ -187,6 +190,20 @@ const (
 	// SystemServiceDeleteLookupProcedure is the fully-qualified name of the SystemService's
 	// DeleteLookup RPC.
 	SystemServiceDeleteLookupProcedure = "/gastrolog.v1.SystemService/DeleteLookup"
+	// SystemServicePutAlertRuleProcedure is the fully-qualified name of the SystemService's
+	// PutAlertRule RPC.
+	SystemServicePutAlertRuleProcedure = "/gastrolog.v1.SystemService/PutAlertRule"
+	// SystemServiceDeleteAlertRuleProcedure is the fully-qualified name of the SystemService's
+	// DeleteAlertRule RPC.
+	SystemServiceDeleteAlertRuleProcedure = "/gastrolog.v1.SystemService/DeleteAlertRule"
+	// SystemServiceGetAlertRuleStatusProcedure is the fully-qualified name of the SystemService's
+	// GetAlertRuleStatus RPC.
+	SystemServiceGetAlertRuleStatusProcedure = "/gastrolog.v1.SystemService/GetAlertRuleStatus"
+	// SystemServicePutRoleProcedure is the fully-qualified name of the SystemService's PutRole RPC.
+	SystemServicePutRoleProcedure = "/gastrolog.v1.SystemService/PutRole"
+	// SystemServiceDeleteRoleProcedure is the fully-qualified name of the SystemService's DeleteRole
+	// RPC.
+	SystemServiceDeleteRoleProcedure = "/gastrolog.v1.SystemService/DeleteRole"
 )
 
 // SystemServiceClient is a client for the gastrolog.v1.SystemService service.
@@ -266,6 +283,9 @@ type SystemServiceClient interface {
 	PutRoute(context.Context, *connect.Request[v1.PutRouteRequest]) (*connect.Response[v1.PutRouteResponse], error)
 	// DeleteRoute removes a route.
 	DeleteRoute(context.Context, *connect.Request[v1.DeleteRouteRequest]) (*connect.Response[v1.DeleteRouteResponse], error)
+	// PreviewRoutePipeline runs route pipeline stages against sample lines
+	// and returns the resulting records. Nothing is stored.
+	PreviewRoutePipeline(context.Context, *connect.Request[v1.PreviewRoutePipelineRequest]) (*connect.Response[v1.PreviewRoutePipelineResponse], error)
 	// GenerateName returns a random petname for use as a default entity name.
 	GenerateName(context.Context, *connect.Request[v1.GenerateNameRequest]) (*connect.Response[v1.GenerateNameResponse], error)
 	// WatchConfig streams a notification whenever configuration changes.
@@ -300,6 +320,15 @@ type SystemServiceClient interface {
 	DeleteTier(context.Context, *connect.Request[v1.DeleteTierRequest]) (*connect.Response[v1.DeleteTierResponse], error)
 	// DeleteLookup removes a lookup table by name (any type).
 	DeleteLookup(context.Context, *connect.Request[v1.DeleteLookupRequest]) (*connect.Response[v1.DeleteLookupResponse], error)
+	// Alert rules
+	PutAlertRule(context.Context, *connect.Request[v1.PutAlertRuleRequest]) (*connect.Response[v1.PutAlertRuleResponse], error)
+	DeleteAlertRule(context.Context, *connect.Request[v1.DeleteAlertRuleRequest]) (*connect.Response[v1.DeleteAlertRuleResponse], error)
+	// GetAlertRuleStatus returns the evaluation state of every alert rule.
+	// Served by the system Raft leader, which is the only node that evaluates.
+	GetAlertRuleStatus(context.Context, *connect.Request[v1.GetAlertRuleStatusRequest]) (*connect.Response[v1.GetAlertRuleStatusResponse], error)
+	// Roles. Built-in roles ("admin", "user") are implicit and not listed.
+	PutRole(context.Context, *connect.Request[v1.PutRoleRequest]) (*connect.Response[v1.PutRoleResponse], error)
+	DeleteRole(context.Context, *connect.Request[v1.DeleteRoleRequest]) (*connect.Response[v1.DeleteRoleResponse], error)
 }
 
 // NewSystemServiceClient constructs a client for the gastrolog.v1.SystemService service. By
@@ -529,6 +558,12 @@ func NewSystemServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(systemServiceMethods.ByName("DeleteRoute")),
 			connect.WithClientOptions(opts...),
 		),
+		previewRoutePipeline: connect.NewClient[v1.PreviewRoutePipelineRequest, v1.PreviewRoutePipelineResponse](
+			httpClient,
+			baseURL+SystemServicePreviewRoutePipelineProcedure,
+			connect.WithSchema(systemServiceMethods.ByName("PreviewRoutePipeline")),
+			connect.WithClientOptions(opts...),
+		),
 		generateName: connect.NewClient[v1.GenerateNameRequest, v1.GenerateNameResponse](
 			httpClient,
 			baseURL+SystemServiceGenerateNameProcedure,
@@ -631,6 +666,36 @@ func NewSystemServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(systemServiceMethods.ByName("DeleteLookup")),
 			connect.WithClientOptions(opts...),
 		),
+		putAlertRule: connect.NewClient[v1.PutAlertRuleRequest, v1.PutAlertRuleResponse](
+			httpClient,
+			baseURL+SystemServicePutAlertRuleProcedure,
+			connect.WithSchema(systemServiceMethods.ByName("PutAlertRule")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAlertRule: connect.NewClient[v1.DeleteAlertRuleRequest, v1.DeleteAlertRuleResponse](
+			httpClient,
+			baseURL+SystemServiceDeleteAlertRuleProcedure,
+			connect.WithSchema(systemServiceMethods.ByName("DeleteAlertRule")),
+			connect.WithClientOptions(opts...),
+		),
+		getAlertRuleStatus: connect.NewClient[v1.GetAlertRuleStatusRequest, v1.GetAlertRuleStatusResponse](
+			httpClient,
+			baseURL+SystemServiceGetAlertRuleStatusProcedure,
+			connect.WithSchema(systemServiceMethods.ByName("GetAlertRuleStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		putRole: connect.NewClient[v1.PutRoleRequest, v1.PutRoleResponse](
+			httpClient,
+			baseURL+SystemServicePutRoleProcedure,
+			connect.WithSchema(systemServiceMethods.ByName("PutRole")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteRole: connect.NewClient[v1.DeleteRoleRequest, v1.DeleteRoleResponse](
+			httpClient,
+			baseURL+SystemServiceDeleteRoleProcedure,
+			connect.WithSchema(systemServiceMethods.ByName("DeleteRole")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -672,6 +737,7 @@ type systemServiceClient struct {
 	putNodeConfig         *connect.Client[v1.PutNodeConfigRequest, v1.PutNodeConfigResponse]
 	putRoute              *connect.Client[v1.PutRouteRequest, v1.PutRouteResponse]
 	deleteRoute           *connect.Client[v1.DeleteRouteRequest, v1.DeleteRouteResponse]
+	previewRoutePipeline  *connect.Client[v1.PreviewRoutePipelineRequest, v1.PreviewRoutePipelineResponse]
 	generateName          *connect.Client[v1.GenerateNameRequest, v1.GenerateNameResponse]
 	watchSystem           *connect.Client[v1.WatchSystemRequest, v1.WatchSystemResponse]
 	getRouteStats         *connect.Client[v1.GetRouteStatsRequest, v1.GetRouteStatsResponse]
@@ -689,6 +755,11 @@ type systemServiceClient struct {
 	putTier               *connect.Client[v1.PutTierRequest, v1.PutTierResponse]
 	deleteTier            *connect.Client[v1.DeleteTierRequest, v1.DeleteTierResponse]
 	deleteLookup          *connect.Client[v1.DeleteLookupRequest, v1.DeleteLookupResponse]
+	putAlertRule          *connect.Client[v1.PutAlertRuleRequest, v1.PutAlertRuleResponse]
+	deleteAlertRule       *connect.Client[v1.DeleteAlertRuleRequest, v1.DeleteAlertRuleResponse]
+	getAlertRuleStatus    *connect.Client[v1.GetAlertRuleStatusRequest, v1.GetAlertRuleStatusResponse]
+	putRole               *connect.Client[v1.PutRoleRequest, v1.PutRoleResponse]
+	deleteRole            *connect.Client[v1.DeleteRoleRequest, v1.DeleteRoleResponse]
 }
 
 // GetSystem calls gastrolog.v1.SystemService.GetSystem.
@@ -871,6 +942,11 @@ func (c *systemServiceClient) DeleteRoute(ctx context.Context, req *connect.Requ
 	return c.deleteRoute.CallUnary(ctx, req)
 }
 
+// PreviewRoutePipeline calls gastrolog.v1.SystemService.PreviewRoutePipeline.
+func (c *systemServiceClient) PreviewRoutePipeline(ctx context.Context, req *connect.Request[v1.PreviewRoutePipelineRequest]) (*connect.Response[v1.PreviewRoutePipelineResponse], error) {
+	return c.previewRoutePipeline.CallUnary(ctx, req)
+}
+
 // GenerateName calls gastrolog.v1.SystemService.GenerateName.
 func (c *systemServiceClient) GenerateName(ctx context.Context, req *connect.Request[v1.GenerateNameRequest]) (*connect.Response[v1.GenerateNameResponse], error) {
 	return c.generateName.CallUnary(ctx, req)
@@ -956,6 +1032,31 @@ func (c *systemServiceClient) DeleteLookup(ctx context.Context, req *connect.Req
 	return c.deleteLookup.CallUnary(ctx, req)
 }
 
+// PutAlertRule calls gastrolog.v1.SystemService.PutAlertRule.
+func (c *systemServiceClient) PutAlertRule(ctx context.Context, req *connect.Request[v1.PutAlertRuleRequest]) (*connect.Response[v1.PutAlertRuleResponse], error) {
+	return c.putAlertRule.CallUnary(ctx, req)
+}
+
+// DeleteAlertRule calls gastrolog.v1.SystemService.DeleteAlertRule.
+func (c *systemServiceClient) DeleteAlertRule(ctx context.Context, req *connect.Request[v1.DeleteAlertRuleRequest]) (*connect.Response[v1.DeleteAlertRuleResponse], error) {
+	return c.deleteAlertRule.CallUnary(ctx, req)
+}
+
+// GetAlertRuleStatus calls gastrolog.v1.SystemService.GetAlertRuleStatus.
+func (c *systemServiceClient) GetAlertRuleStatus(ctx context.Context, req *connect.Request[v1.GetAlertRuleStatusRequest]) (*connect.Response[v1.GetAlertRuleStatusResponse], error) {
+	return c.getAlertRuleStatus.CallUnary(ctx, req)
+}
+
+// PutRole calls gastrolog.v1.SystemService.PutRole.
+func (c *systemServiceClient) PutRole(ctx context.Context, req *connect.Request[v1.PutRoleRequest]) (*connect.Response[v1.PutRoleResponse], error) {
+	return c.putRole.CallUnary(ctx, req)
+}
+
+// DeleteRole calls gastrolog.v1.SystemService.DeleteRole.
+func (c *systemServiceClient) DeleteRole(ctx context.Context, req *connect.Request[v1.DeleteRoleRequest]) (*connect.Response[v1.DeleteRoleResponse], error) {
+	return c.deleteRole.CallUnary(ctx, req)
+}
+
 // SystemServiceHandler is an implementation of the gastrolog.v1.SystemService service.
 type SystemServiceHandler interface {
 	// GetConfig returns the current configuration.
@@ -1033,6 +1134,9 @@ type SystemServiceHandler interface {
 	PutRoute(context.Context, *connect.Request[v1.PutRouteRequest]) (*connect.Response[v1.PutRouteResponse], error)
 	// DeleteRoute removes a route.
 	DeleteRoute(context.Context, *connect.Request[v1.DeleteRouteRequest]) (*connect.Response[v1.DeleteRouteResponse], error)
+	// PreviewRoutePipeline runs route pipeline stages against sample lines
+	// and returns the resulting records. Nothing is stored.
+	PreviewRoutePipeline(context.Context, *connect.Request[v1.PreviewRoutePipelineRequest]) (*connect.Response[v1.PreviewRoutePipelineResponse], error)
 	// GenerateName returns a random petname for use as a default entity name.
 	GenerateName(context.Context, *connect.Request[v1.GenerateNameRequest]) (*connect.Response[v1.GenerateNameResponse], error)
 	// WatchConfig streams a notification whenever configuration changes.
@@ -1067,6 +1171,15 @@ type SystemServiceHandler interface {
 	DeleteTier(context.Context, *connect.Request[v1.DeleteTierRequest]) (*connect.Response[v1.DeleteTierResponse], error)
 	// DeleteLookup removes a lookup table by name (any type).
 	DeleteLookup(context.Context, *connect.Request[v1.DeleteLookupRequest]) (*connect.Response[v1.DeleteLookupResponse], error)
+	// Alert rules
+	PutAlertRule(context.Context, *connect.Request[v1.PutAlertRuleRequest]) (*connect.Response[v1.PutAlertRuleResponse], error)
+	DeleteAlertRule(context.Context, *connect.Request[v1.DeleteAlertRuleRequest]) (*connect.Response[v1.DeleteAlertRuleResponse], error)
+	// GetAlertRuleStatus returns the evaluation state of every alert rule.
+	// Served by the system Raft leader, which is the only node that evaluates.
+	GetAlertRuleStatus(context.Context, *connect.Request[v1.GetAlertRuleStatusRequest]) (*connect.Response[v1.GetAlertRuleStatusResponse], error)
+	// Roles. Built-in roles ("admin", "user") are implicit and not listed.
+	PutRole(context.Context, *connect.Request[v1.PutRoleRequest]) (*connect.Response[v1.PutRoleResponse], error)
+	DeleteRole(context.Context, *connect.Request[v1.DeleteRoleRequest]) (*connect.Response[v1.DeleteRoleResponse], error)
 }
 
 // NewSystemServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -1292,6 +1405,12 @@ func NewSystemServiceHandler(svc SystemServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(systemServiceMethods.ByName("DeleteRoute")),
 		connect.WithHandlerOptions(opts...),
 	)
+	systemServicePreviewRoutePipelineHandler := connect.NewUnaryHandler(
+		SystemServicePreviewRoutePipelineProcedure,
+		svc.PreviewRoutePipeline,
+		connect.WithSchema(systemServiceMethods.ByName("PreviewRoutePipeline")),
+		connect.WithHandlerOptions(opts...),
+	)
 	systemServiceGenerateNameHandler := connect.NewUnaryHandler(
 		SystemServiceGenerateNameProcedure,
 		svc.GenerateName,
@@ -1394,6 +1513,36 @@ func NewSystemServiceHandler(svc SystemServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(systemServiceMethods.ByName("DeleteLookup")),
 		connect.WithHandlerOptions(opts...),
 	)
+	systemServicePutAlertRuleHandler := connect.NewUnaryHandler(
+		SystemServicePutAlertRuleProcedure,
+		svc.PutAlertRule,
+		connect.WithSchema(systemServiceMethods.ByName("PutAlertRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	systemServiceDeleteAlertRuleHandler := connect.NewUnaryHandler(
+		SystemServiceDeleteAlertRuleProcedure,
+		svc.DeleteAlertRule,
+		connect.WithSchema(systemServiceMethods.ByName("DeleteAlertRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	systemServiceGetAlertRuleStatusHandler := connect.NewUnaryHandler(
+		SystemServiceGetAlertRuleStatusProcedure,
+		svc.GetAlertRuleStatus,
+		connect.WithSchema(systemServiceMethods.ByName("GetAlertRuleStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	systemServicePutRoleHandler := connect.NewUnaryHandler(
+		SystemServicePutRoleProcedure,
+		svc.PutRole,
+		connect.WithSchema(systemServiceMethods.ByName("PutRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	systemServiceDeleteRoleHandler := connect.NewUnaryHandler(
+		SystemServiceDeleteRoleProcedure,
+		svc.DeleteRole,
+		connect.WithSchema(systemServiceMethods.ByName("DeleteRole")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/gastrolog.v1.SystemService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SystemServiceGetSystemProcedure:
@@ -1468,6 +1617,8 @@ func NewSystemServiceHandler(svc SystemServiceHandler, opts ...connect.HandlerOp
 			systemServicePutRouteHandler.ServeHTTP(w, r)
 		case SystemServiceDeleteRouteProcedure:
 			systemServiceDeleteRouteHandler.ServeHTTP(w, r)
+		case SystemServicePreviewRoutePipelineProcedure:
+			systemServicePreviewRoutePipelineHandler.ServeHTTP(w, r)
 		case SystemServiceGenerateNameProcedure:
 			systemServiceGenerateNameHandler.ServeHTTP(w, r)
 		case SystemServiceWatchSystemProcedure:
@@ -1502,6 +1653,16 @@ func NewSystemServiceHandler(svc SystemServiceHandler, opts ...connect.HandlerOp
 			systemServiceDeleteTierHandler.ServeHTTP(w, r)
 		case SystemServiceDeleteLookupProcedure:
 			systemServiceDeleteLookupHandler.ServeHTTP(w, r)
+		case SystemServicePutAlertRuleProcedure:
+			systemServicePutAlertRuleHandler.ServeHTTP(w, r)
+		case SystemServiceDeleteAlertRuleProcedure:
+			systemServiceDeleteAlertRuleHandler.ServeHTTP(w, r)
+		case SystemServiceGetAlertRuleStatusProcedure:
+			systemServiceGetAlertRuleStatusHandler.ServeHTTP(w, r)
+		case SystemServicePutRoleProcedure:
+			systemServicePutRoleHandler.ServeHTTP(w, r)
+		case SystemServiceDeleteRoleProcedure:
+			systemServiceDeleteRoleHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1655,6 +1816,10 @@ func (UnimplementedSystemServiceHandler) DeleteRoute(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastrolog.v1.SystemService.DeleteRoute is not implemented"))
 }
 
+func (UnimplementedSystemServiceHandler) PreviewRoutePipeline(context.Context, *connect.Request[v1.PreviewRoutePipelineRequest]) (*connect.Response[v1.PreviewRoutePipelineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastrolog.v1.SystemService.PreviewRoutePipeline is not implemented"))
+}
+
 func (UnimplementedSystemServiceHandler) GenerateName(context.Context, *connect.Request[v1.GenerateNameRequest]) (*connect.Response[v1.GenerateNameResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastrolog.v1.SystemService.GenerateName is not implemented"))
 }
@@ -1722,3 +1887,23 @@ func (UnimplementedSystemServiceHandler) DeleteTier(context.Context, *connect.Re
 func (UnimplementedSystemServiceHandler) DeleteLookup(context.Context, *connect.Request[v1.DeleteLookupRequest]) (*connect.Response[v1.DeleteLookupResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastrolog.v1.SystemService.DeleteLookup is not implemented"))
 }
+
+func (UnimplementedSystemServiceHandler) PutAlertRule(context.Context, *connect.Request[v1.PutAlertRuleRequest]) (*connect.Response[v1.PutAlertRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastrolog.v1.SystemService.PutAlertRule is not implemented"))
+}
+
+func (UnimplementedSystemServiceHandler) DeleteAlertRule(context.Context, *connect.Request[v1.DeleteAlertRuleRequest]) (*connect.Response[v1.DeleteAlertRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastrolog.v1.SystemService.DeleteAlertRule is not implemented"))
+}
+
+func (UnimplementedSystemServiceHandler) GetAlertRuleStatus(context.Context, *connect.Request[v1.GetAlertRuleStatusRequest]) (*connect.Response[v1.GetAlertRuleStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastrolog.v1.SystemService.GetAlertRuleStatus is not implemented"))
+}
+
+func (UnimplementedSystemServiceHandler) PutRole(context.Context, *connect.Request[v1.PutRoleRequest]) (*connect.Response[v1.PutRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastrolog.v1.SystemService.PutRole is not implemented"))
+}
+
+func (UnimplementedSystemServiceHandler) DeleteRole(context.Context, *connect.Request[v1.DeleteRoleRequest]) (*connect.Response[v1.DeleteRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastrolog.v1.SystemService.DeleteRole is not implemented"))
+}
@@ -9,6 +9,7 @@ package gastrologv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -240,6 +241,8 @@ type GetSystemResponse struct {
 	CloudServices      []*CloudService      `protobuf:"bytes,10,rep,name=cloud_services,json=cloudServices,proto3" json:"cloud_services,omitempty"`
 	NodeStorageConfigs []*NodeStorageConfig `protobuf:"bytes,11,rep,name=node_storage_configs,json=nodeStorageConfigs,proto3" json:"node_storage_configs,omitempty"`
 	Tiers              []*TierConfig        `protobuf:"bytes,12,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	AlertRules         []*AlertRuleConfig   `protobuf:"bytes,13,rep,name=alert_rules,json=alertRules,proto3" json:"alert_rules,omitempty"`
+	Roles              []*RoleConfig        `protobuf:"bytes,14,rep,name=roles,proto3" json:"roles,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -358,6 +361,20 @@ func (x *GetSystemResponse) GetTiers() []*TierConfig {
 	return nil
 }
 
+func (x *GetSystemResponse) GetAlertRules() []*AlertRuleConfig {
+	if x != nil {
+		return x.AlertRules
+	}
+	return nil
+}
+
+func (x *GetSystemResponse) GetRoles() []*RoleConfig {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
 type RetentionRule struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	RetentionPolicyId   []byte                 `protobuf:"bytes,1,opt,name=retention_policy_id,json=retentionPolicyId,proto3" json:"retention_policy_id,omitempty"`
@@ -708,6 +725,7 @@ type RouteConfig struct {
 	Distribution  string                 `protobuf:"bytes,5,opt,name=distribution,proto3" json:"distribution,omitempty"` // "fanout" (default), "round-robin", or "failover"
 	Enabled       bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	EjectOnly     bool                   `protobuf:"varint,7,opt,name=eject_only,json=ejectOnly,proto3" json:"eject_only,omitempty"` // when true, excluded from live ingestion FilterSet; usable only as eject target
+	Stages        []*RouteStage          `protobuf:"bytes,8,rep,name=stages,proto3" json:"stages,omitempty"`                         // ingest pipeline, applied in order to every matched record
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -791,6 +809,66 @@ func (x *RouteConfig) GetEjectOnly() bool {
 	return false
 }
 
+func (x *RouteConfig) GetStages() []*RouteStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+// RouteStage is one step of a route's ingest pipeline.
+type RouteStage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                                                                               // "regex", "grok", "set", "rename", "delete", "hash", "mask", "drop"
+	Params        map[string]string      `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // type-specific parameters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteStage) Reset() {
+	*x = RouteStage{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteStage) ProtoMessage() {}
+
+func (x *RouteStage) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteStage.ProtoReflect.Descriptor instead.
+func (*RouteStage) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RouteStage) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RouteStage) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
 type IngesterConfig struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	Id      []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -808,7 +886,7 @@ type IngesterConfig struct {
 
 func (x *IngesterConfig) Reset() {
 	*x = IngesterConfig{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[7]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -820,7 +898,7 @@ func (x *IngesterConfig) String() string {
 func (*IngesterConfig) ProtoMessage() {}
 
 func (x *IngesterConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[7]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -833,7 +911,7 @@ func (x *IngesterConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IngesterConfig.ProtoReflect.Descriptor instead.
 func (*IngesterConfig) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{7}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *IngesterConfig) GetId() []byte {
@@ -904,7 +982,7 @@ type FilterConfig struct {
 
 func (x *FilterConfig) Reset() {
 	*x = FilterConfig{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[8]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -916,7 +994,7 @@ func (x *FilterConfig) String() string {
 func (*FilterConfig) ProtoMessage() {}
 
 func (x *FilterConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[8]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -929,7 +1007,7 @@ func (x *FilterConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterConfig.ProtoReflect.Descriptor instead.
 func (*FilterConfig) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{8}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *FilterConfig) GetExpression() string {
@@ -967,7 +1045,7 @@ type RotationPolicyConfig struct {
 
 func (x *RotationPolicyConfig) Reset() {
 	*x = RotationPolicyConfig{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[9]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -979,7 +1057,7 @@ func (x *RotationPolicyConfig) String() string {
 func (*RotationPolicyConfig) ProtoMessage() {}
 
 func (x *RotationPolicyConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[9]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -992,7 +1070,7 @@ func (x *RotationPolicyConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RotationPolicyConfig.ProtoReflect.Descriptor instead.
 func (*RotationPolicyConfig) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{9}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RotationPolicyConfig) GetMaxBytes() int64 {
@@ -1050,7 +1128,7 @@ type RetentionPolicyConfig struct {
 
 func (x *RetentionPolicyConfig) Reset() {
 	*x = RetentionPolicyConfig{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[10]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1062,7 +1140,7 @@ func (x *RetentionPolicyConfig) String() string {
 func (*RetentionPolicyConfig) ProtoMessage() {}
 
 func (x *RetentionPolicyConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[10]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1075,7 +1153,7 @@ func (x *RetentionPolicyConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetentionPolicyConfig.ProtoReflect.Descriptor instead.
 func (*RetentionPolicyConfig) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{10}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RetentionPolicyConfig) GetMaxAgeSeconds() int64 {
@@ -1121,7 +1199,7 @@ type ListIngestersRequest struct {
 
 func (x *ListIngestersRequest) Reset() {
 	*x = ListIngestersRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[11]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1133,7 +1211,7 @@ func (x *ListIngestersRequest) String() string {
 func (*ListIngestersRequest) ProtoMessage() {}
 
 func (x *ListIngestersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[11]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1146,7 +1224,7 @@ func (x *ListIngestersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIngestersRequest.ProtoReflect.Descriptor instead.
 func (*ListIngestersRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{11}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{12}
 }
 
 type ListIngestersResponse struct {
@@ -1158,7 +1236,7 @@ type ListIngestersResponse struct {
 
 func (x *ListIngestersResponse) Reset() {
 	*x = ListIngestersResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[12]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1170,7 +1248,7 @@ func (x *ListIngestersResponse) String() string {
 func (*ListIngestersResponse) ProtoMessage() {}
 
 func (x *ListIngestersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[12]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1183,7 +1261,7 @@ func (x *ListIngestersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIngestersResponse.ProtoReflect.Descriptor instead.
 func (*ListIngestersResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{12}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListIngestersResponse) GetIngesters() []*IngesterInfo {
@@ -1211,7 +1289,7 @@ type IngesterInfo struct {
 
 func (x *IngesterInfo) Reset() {
 	*x = IngesterInfo{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[13]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1223,7 +1301,7 @@ func (x *IngesterInfo) String() string {
 func (*IngesterInfo) ProtoMessage() {}
 
 func (x *IngesterInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[13]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1236,7 +1314,7 @@ func (x *IngesterInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IngesterInfo.ProtoReflect.Descriptor instead.
 func (*IngesterInfo) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{13}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *IngesterInfo) GetId() []byte {
@@ -1312,7 +1390,7 @@ type GetIngesterStatusRequest struct {
 
 func (x *GetIngesterStatusRequest) Reset() {
 	*x = GetIngesterStatusRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[14]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1324,7 +1402,7 @@ func (x *GetIngesterStatusRequest) String() string {
 func (*GetIngesterStatusRequest) ProtoMessage() {}
 
 func (x *GetIngesterStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[14]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1337,7 +1415,7 @@ func (x *GetIngesterStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetIngesterStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetIngesterStatusRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{14}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetIngesterStatusRequest) GetId() []byte {
@@ -1361,7 +1439,7 @@ type GetIngesterStatusResponse struct {
 
 func (x *GetIngesterStatusResponse) Reset() {
 	*x = GetIngesterStatusResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[15]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1373,7 +1451,7 @@ func (x *GetIngesterStatusResponse) String() string {
 func (*GetIngesterStatusResponse) ProtoMessage() {}
 
 func (x *GetIngesterStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[15]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1386,7 +1464,7 @@ func (x *GetIngesterStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetIngesterStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetIngesterStatusResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{15}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetIngesterStatusResponse) GetId() []byte {
@@ -1441,7 +1519,7 @@ type WatchIngesterStatusRequest struct {
 
 func (x *WatchIngesterStatusRequest) Reset() {
 	*x = WatchIngesterStatusRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[16]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1453,7 +1531,7 @@ func (x *WatchIngesterStatusRequest) String() string {
 func (*WatchIngesterStatusRequest) ProtoMessage() {}
 
 func (x *WatchIngesterStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[16]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1466,7 +1544,7 @@ func (x *WatchIngesterStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchIngesterStatusRequest.ProtoReflect.Descriptor instead.
 func (*WatchIngesterStatusRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{16}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *WatchIngesterStatusRequest) GetId() []byte {
@@ -1492,7 +1570,7 @@ type WatchIngesterStatusResponse struct {
 
 func (x *WatchIngesterStatusResponse) Reset() {
 	*x = WatchIngesterStatusResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[17]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1504,7 +1582,7 @@ func (x *WatchIngesterStatusResponse) String() string {
 func (*WatchIngesterStatusResponse) ProtoMessage() {}
 
 func (x *WatchIngesterStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[17]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1517,7 +1595,7 @@ func (x *WatchIngesterStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchIngesterStatusResponse.ProtoReflect.Descriptor instead.
 func (*WatchIngesterStatusResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{17}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *WatchIngesterStatusResponse) GetId() []byte {
@@ -1571,7 +1649,7 @@ type PutFilterRequest struct {
 
 func (x *PutFilterRequest) Reset() {
 	*x = PutFilterRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[18]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1583,7 +1661,7 @@ func (x *PutFilterRequest) String() string {
 func (*PutFilterRequest) ProtoMessage() {}
 
 func (x *PutFilterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[18]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1596,7 +1674,7 @@ func (x *PutFilterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutFilterRequest.ProtoReflect.Descriptor instead.
 func (*PutFilterRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{18}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PutFilterRequest) GetConfig() *FilterConfig {
@@ -1615,7 +1693,7 @@ type PutFilterResponse struct {
 
 func (x *PutFilterResponse) Reset() {
 	*x = PutFilterResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[19]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1627,7 +1705,7 @@ func (x *PutFilterResponse) String() string {
 func (*PutFilterResponse) ProtoMessage() {}
 
 func (x *PutFilterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[19]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1640,7 +1718,7 @@ func (x *PutFilterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutFilterResponse.ProtoReflect.Descriptor instead.
 func (*PutFilterResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{19}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *PutFilterResponse) GetSystem() *GetSystemResponse {
@@ -1659,7 +1737,7 @@ type DeleteFilterRequest struct {
 
 func (x *DeleteFilterRequest) Reset() {
 	*x = DeleteFilterRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[20]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1671,7 +1749,7 @@ func (x *DeleteFilterRequest) String() string {
 func (*DeleteFilterRequest) ProtoMessage() {}
 
 func (x *DeleteFilterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[20]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1684,7 +1762,7 @@ func (x *DeleteFilterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFilterRequest.ProtoReflect.Descriptor instead.
 func (*DeleteFilterRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{20}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DeleteFilterRequest) GetId() []byte {
@@ -1703,7 +1781,7 @@ type DeleteFilterResponse struct {
 
 func (x *DeleteFilterResponse) Reset() {
 	*x = DeleteFilterResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[21]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1715,7 +1793,7 @@ func (x *DeleteFilterResponse) String() string {
 func (*DeleteFilterResponse) ProtoMessage() {}
 
 func (x *DeleteFilterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[21]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1728,7 +1806,7 @@ func (x *DeleteFilterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFilterResponse.ProtoReflect.Descriptor instead.
 func (*DeleteFilterResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{21}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DeleteFilterResponse) GetSystem() *GetSystemResponse {
@@ -1747,7 +1825,7 @@ type PutRotationPolicyRequest struct {
 
 func (x *PutRotationPolicyRequest) Reset() {
 	*x = PutRotationPolicyRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[22]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1759,7 +1837,7 @@ func (x *PutRotationPolicyRequest) String() string {
 func (*PutRotationPolicyRequest) ProtoMessage() {}
 
 func (x *PutRotationPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[22]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1772,7 +1850,7 @@ func (x *PutRotationPolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutRotationPolicyRequest.ProtoReflect.Descriptor instead.
 func (*PutRotationPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{22}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *PutRotationPolicyRequest) GetConfig() *RotationPolicyConfig {
@@ -1791,7 +1869,7 @@ type PutRotationPolicyResponse struct {
 
 func (x *PutRotationPolicyResponse) Reset() {
 	*x = PutRotationPolicyResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[23]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1803,7 +1881,7 @@ func (x *PutRotationPolicyResponse) String() string {
 func (*PutRotationPolicyResponse) ProtoMessage() {}
 
 func (x *PutRotationPolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[23]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1816,7 +1894,7 @@ func (x *PutRotationPolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutRotationPolicyResponse.ProtoReflect.Descriptor instead.
 func (*PutRotationPolicyResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{23}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *PutRotationPolicyResponse) GetSystem() *GetSystemResponse {
@@ -1835,7 +1913,7 @@ type DeleteRotationPolicyRequest struct {
 
 func (x *DeleteRotationPolicyRequest) Reset() {
 	*x = DeleteRotationPolicyRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[24]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1847,7 +1925,7 @@ func (x *DeleteRotationPolicyRequest) String() string {
 func (*DeleteRotationPolicyRequest) ProtoMessage() {}
 
 func (x *DeleteRotationPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[24]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1860,7 +1938,7 @@ func (x *DeleteRotationPolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRotationPolicyRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRotationPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{24}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *DeleteRotationPolicyRequest) GetId() []byte {
@@ -1879,7 +1957,7 @@ type DeleteRotationPolicyResponse struct {
 
 func (x *DeleteRotationPolicyResponse) Reset() {
 	*x = DeleteRotationPolicyResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[25]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1891,7 +1969,7 @@ func (x *DeleteRotationPolicyResponse) String() string {
 func (*DeleteRotationPolicyResponse) ProtoMessage() {}
 
 func (x *DeleteRotationPolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[25]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1904,7 +1982,7 @@ func (x *DeleteRotationPolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRotationPolicyResponse.ProtoReflect.Descriptor instead.
 func (*DeleteRotationPolicyResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{25}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *DeleteRotationPolicyResponse) GetSystem() *GetSystemResponse {
@@ -1923,7 +2001,7 @@ type PutRetentionPolicyRequest struct {
 
 func (x *PutRetentionPolicyRequest) Reset() {
 	*x = PutRetentionPolicyRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[26]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1935,7 +2013,7 @@ func (x *PutRetentionPolicyRequest) String() string {
 func (*PutRetentionPolicyRequest) ProtoMessage() {}
 
 func (x *PutRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[26]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1948,7 +2026,7 @@ func (x *PutRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutRetentionPolicyRequest.ProtoReflect.Descriptor instead.
 func (*PutRetentionPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{26}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *PutRetentionPolicyRequest) GetConfig() *RetentionPolicyConfig {
@@ -1967,7 +2045,7 @@ type PutRetentionPolicyResponse struct {
 
 func (x *PutRetentionPolicyResponse) Reset() {
 	*x = PutRetentionPolicyResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[27]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1979,7 +2057,7 @@ func (x *PutRetentionPolicyResponse) String() string {
 func (*PutRetentionPolicyResponse) ProtoMessage() {}
 
 func (x *PutRetentionPolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[27]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1992,7 +2070,7 @@ func (x *PutRetentionPolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutRetentionPolicyResponse.ProtoReflect.Descriptor instead.
 func (*PutRetentionPolicyResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{27}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *PutRetentionPolicyResponse) GetSystem() *GetSystemResponse {
@@ -2011,7 +2089,7 @@ type DeleteRetentionPolicyRequest struct {
 
 func (x *DeleteRetentionPolicyRequest) Reset() {
 	*x = DeleteRetentionPolicyRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[28]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2023,7 +2101,7 @@ func (x *DeleteRetentionPolicyRequest) String() string {
 func (*DeleteRetentionPolicyRequest) ProtoMessage() {}
 
 func (x *DeleteRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[28]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2036,7 +2114,7 @@ func (x *DeleteRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRetentionPolicyRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRetentionPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{28}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DeleteRetentionPolicyRequest) GetId() []byte {
@@ -2055,7 +2133,7 @@ type DeleteRetentionPolicyResponse struct {
 
 func (x *DeleteRetentionPolicyResponse) Reset() {
 	*x = DeleteRetentionPolicyResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[29]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2067,7 +2145,7 @@ func (x *DeleteRetentionPolicyResponse) String() string {
 func (*DeleteRetentionPolicyResponse) ProtoMessage() {}
 
 func (x *DeleteRetentionPolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[29]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2080,7 +2158,7 @@ func (x *DeleteRetentionPolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRetentionPolicyResponse.ProtoReflect.Descriptor instead.
 func (*DeleteRetentionPolicyResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{29}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *DeleteRetentionPolicyResponse) GetSystem() *GetSystemResponse {
@@ -2099,7 +2177,7 @@ type PutVaultRequest struct {
 
 func (x *PutVaultRequest) Reset() {
 	*x = PutVaultRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[30]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2111,7 +2189,7 @@ func (x *PutVaultRequest) String() string {
 func (*PutVaultRequest) ProtoMessage() {}
 
 func (x *PutVaultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[30]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2124,7 +2202,7 @@ func (x *PutVaultRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutVaultRequest.ProtoReflect.Descriptor instead.
 func (*PutVaultRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{30}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *PutVaultRequest) GetConfig() *VaultConfig {
@@ -2143,7 +2221,7 @@ type PutVaultResponse struct {
 
 func (x *PutVaultResponse) Reset() {
 	*x = PutVaultResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[31]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2155,7 +2233,7 @@ func (x *PutVaultResponse) String() string {
 func (*PutVaultResponse) ProtoMessage() {}
 
 func (x *PutVaultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[31]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2168,7 +2246,7 @@ func (x *PutVaultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutVaultResponse.ProtoReflect.Descriptor instead.
 func (*PutVaultResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{31}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *PutVaultResponse) GetSystem() *GetSystemResponse {
@@ -2191,7 +2269,7 @@ type DeleteVaultRequest struct {
 
 func (x *DeleteVaultRequest) Reset() {
 	*x = DeleteVaultRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[32]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2203,7 +2281,7 @@ func (x *DeleteVaultRequest) String() string {
 func (*DeleteVaultRequest) ProtoMessage() {}
 
 func (x *DeleteVaultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[32]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2216,7 +2294,7 @@ func (x *DeleteVaultRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVaultRequest.ProtoReflect.Descriptor instead.
 func (*DeleteVaultRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{32}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *DeleteVaultRequest) GetId() []byte {
@@ -2249,7 +2327,7 @@ type DeleteVaultResponse struct {
 
 func (x *DeleteVaultResponse) Reset() {
 	*x = DeleteVaultResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[33]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2261,7 +2339,7 @@ func (x *DeleteVaultResponse) String() string {
 func (*DeleteVaultResponse) ProtoMessage() {}
 
 func (x *DeleteVaultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[33]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2274,7 +2352,7 @@ func (x *DeleteVaultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVaultResponse.ProtoReflect.Descriptor instead.
 func (*DeleteVaultResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{33}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DeleteVaultResponse) GetSystem() *GetSystemResponse {
@@ -2293,7 +2371,7 @@ type PutRouteRequest struct {
 
 func (x *PutRouteRequest) Reset() {
 	*x = PutRouteRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[34]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2305,7 +2383,7 @@ func (x *PutRouteRequest) String() string {
 func (*PutRouteRequest) ProtoMessage() {}
 
 func (x *PutRouteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[34]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2318,7 +2396,7 @@ func (x *PutRouteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutRouteRequest.ProtoReflect.Descriptor instead.
 func (*PutRouteRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{34}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *PutRouteRequest) GetConfig() *RouteConfig {
@@ -2337,7 +2415,7 @@ type PutRouteResponse struct {
 
 func (x *PutRouteResponse) Reset() {
 	*x = PutRouteResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[35]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2349,7 +2427,7 @@ func (x *PutRouteResponse) String() string {
 func (*PutRouteResponse) ProtoMessage() {}
 
 func (x *PutRouteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[35]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2362,7 +2440,7 @@ func (x *PutRouteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutRouteResponse.ProtoReflect.Descriptor instead.
 func (*PutRouteResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{35}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *PutRouteResponse) GetSystem() *GetSystemResponse {
@@ -2381,7 +2459,7 @@ type DeleteRouteRequest struct {
 
 func (x *DeleteRouteRequest) Reset() {
 	*x = DeleteRouteRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[36]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2393,7 +2471,7 @@ func (x *DeleteRouteRequest) String() string {
 func (*DeleteRouteRequest) ProtoMessage() {}
 
 func (x *DeleteRouteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[36]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2406,7 +2484,7 @@ func (x *DeleteRouteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRouteRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRouteRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{36}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *DeleteRouteRequest) GetId() []byte {
@@ -2425,7 +2503,7 @@ type DeleteRouteResponse struct {
 
 func (x *DeleteRouteResponse) Reset() {
 	*x = DeleteRouteResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[37]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2437,7 +2515,7 @@ func (x *DeleteRouteResponse) String() string {
 func (*DeleteRouteResponse) ProtoMessage() {}
 
 func (x *DeleteRouteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[37]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2450,7 +2528,7 @@ func (x *DeleteRouteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRouteResponse.ProtoReflect.Descriptor instead.
 func (*DeleteRouteResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{37}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *DeleteRouteResponse) GetSystem() *GetSystemResponse {
@@ -2460,28 +2538,30 @@ func (x *DeleteRouteResponse) GetSystem() *GetSystemResponse {
 	return nil
 }
 
-type PutIngesterRequest struct {
+type PreviewRoutePipelineRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Config        *IngesterConfig        `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Stages        []*RouteStage          `protobuf:"bytes,1,rep,name=stages,proto3" json:"stages,omitempty"`
+	Lines         []string               `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`                                                                           // sample raw messages, one record per line
+	Attrs         map[string]string      `protobuf:"bytes,3,rep,name=attrs,proto3" json:"attrs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // attributes set on every sample before the first stage
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PutIngesterRequest) Reset() {
-	*x = PutIngesterRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[38]
+func (x *PreviewRoutePipelineRequest) Reset() {
+	*x = PreviewRoutePipelineRequest{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PutIngesterRequest) String() string {
+func (x *PreviewRoutePipelineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PutIngesterRequest) ProtoMessage() {}
+func (*PreviewRoutePipelineRequest) ProtoMessage() {}
 
-func (x *PutIngesterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[38]
+func (x *PreviewRoutePipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2492,9 +2572,1040 @@ func (x *PutIngesterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PutIngesterRequest.ProtoReflect.Descriptor instead.
-func (*PutIngesterRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use PreviewRoutePipelineRequest.ProtoReflect.Descriptor instead.
+func (*PreviewRoutePipelineRequest) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PreviewRoutePipelineRequest) GetStages() []*RouteStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *PreviewRoutePipelineRequest) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *PreviewRoutePipelineRequest) GetAttrs() map[string]string {
+	if x != nil {
+		return x.Attrs
+	}
+	return nil
+}
+
+type PreviewRoutePipelineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*RoutePipelineResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // one per input line, in order
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`     // non-empty when the stages are invalid
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewRoutePipelineResponse) Reset() {
+	*x = PreviewRoutePipelineResponse{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewRoutePipelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewRoutePipelineResponse) ProtoMessage() {}
+
+func (x *PreviewRoutePipelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewRoutePipelineResponse.ProtoReflect.Descriptor instead.
+func (*PreviewRoutePipelineResponse) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PreviewRoutePipelineResponse) GetResults() []*RoutePipelineResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *PreviewRoutePipelineResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RoutePipelineResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dropped       bool                   `protobuf:"varint,1,opt,name=dropped,proto3" json:"dropped,omitempty"`                                                                      // a drop stage discarded the record
+	Raw           string                 `protobuf:"bytes,2,opt,name=raw,proto3" json:"raw,omitempty"`                                                                               // raw message after the pipeline
+	Attrs         map[string]string      `protobuf:"bytes,3,rep,name=attrs,proto3" json:"attrs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // attributes after the pipeline
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoutePipelineResult) Reset() {
+	*x = RoutePipelineResult{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutePipelineResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutePipelineResult) ProtoMessage() {}
+
+func (x *RoutePipelineResult) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoutePipelineResult.ProtoReflect.Descriptor instead.
+func (*RoutePipelineResult) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RoutePipelineResult) GetDropped() bool {
+	if x != nil {
+		return x.Dropped
+	}
+	return false
+}
+
+func (x *RoutePipelineResult) GetRaw() string {
+	if x != nil {
+		return x.Raw
+	}
+	return ""
+}
+
+func (x *RoutePipelineResult) GetAttrs() map[string]string {
+	if x != nil {
+		return x.Attrs
+	}
+	return nil
+}
+
+// AlertCondition compares a value derived from the query result against a
+// threshold. An empty column means the row count (records, or table rows for
+// an aggregating pipeline); otherwise the rule fires if any table row's
+// column value satisfies the comparison.
+type AlertCondition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Column        string                 `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	Op            string                 `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"` // ">", ">=", "<", "<=", "==", "!="
+	Threshold     float64                `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertCondition) Reset() {
+	*x = AlertCondition{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertCondition) ProtoMessage() {}
+
+func (x *AlertCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertCondition.ProtoReflect.Descriptor instead.
+func (*AlertCondition) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AlertCondition) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *AlertCondition) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *AlertCondition) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+// AlertSink is a notification target. Params are type-specific:
+//
+//	webhook: url, header.<Name>
+//	smtp:    addr, from, to (comma-separated), username, password
+//	vault:   vault_id
+type AlertSink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // "webhook", "smtp", or "vault"
+	Params        map[string]string      `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertSink) Reset() {
+	*x = AlertSink{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertSink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertSink) ProtoMessage() {}
+
+func (x *AlertSink) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertSink.ProtoReflect.Descriptor instead.
+func (*AlertSink) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AlertSink) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AlertSink) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// AlertRuleConfig is a saved search evaluated on a cron schedule.
+type AlertRuleConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	Schedule      string                 `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"` // 5- or 6-field cron expression
+	Condition     *AlertCondition        `protobuf:"bytes,5,opt,name=condition,proto3" json:"condition,omitempty"`
+	Severity      string                 `protobuf:"bytes,6,opt,name=severity,proto3" json:"severity,omitempty"` // "warning" (default) or "error"
+	Sinks         []*AlertSink           `protobuf:"bytes,7,rep,name=sinks,proto3" json:"sinks,omitempty"`
+	Enabled       bool                   `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertRuleConfig) Reset() {
+	*x = AlertRuleConfig{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRuleConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRuleConfig) ProtoMessage() {}
+
+func (x *AlertRuleConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRuleConfig.ProtoReflect.Descriptor instead.
+func (*AlertRuleConfig) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AlertRuleConfig) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *AlertRuleConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AlertRuleConfig) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *AlertRuleConfig) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *AlertRuleConfig) GetCondition() *AlertCondition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *AlertRuleConfig) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *AlertRuleConfig) GetSinks() []*AlertSink {
+	if x != nil {
+		return x.Sinks
+	}
+	return nil
+}
+
+func (x *AlertRuleConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type PutAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *AlertRuleConfig       `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutAlertRuleRequest) Reset() {
+	*x = PutAlertRuleRequest{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutAlertRuleRequest) ProtoMessage() {}
+
+func (x *PutAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*PutAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PutAlertRuleRequest) GetConfig() *AlertRuleConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type PutAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	System        *GetSystemResponse     `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutAlertRuleResponse) Reset() {
+	*x = PutAlertRuleResponse{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutAlertRuleResponse) ProtoMessage() {}
+
+func (x *PutAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*PutAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PutAlertRuleResponse) GetSystem() *GetSystemResponse {
+	if x != nil {
+		return x.System
+	}
+	return nil
+}
+
+type DeleteAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRuleRequest) Reset() {
+	*x = DeleteAlertRuleRequest{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleRequest) ProtoMessage() {}
+
+func (x *DeleteAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteAlertRuleRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type DeleteAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	System        *GetSystemResponse     `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRuleResponse) Reset() {
+	*x = DeleteAlertRuleResponse{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleResponse) ProtoMessage() {}
+
+func (x *DeleteAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteAlertRuleResponse) GetSystem() *GetSystemResponse {
+	if x != nil {
+		return x.System
+	}
+	return nil
+}
+
+type GetAlertRuleStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAlertRuleStatusRequest) Reset() {
+	*x = GetAlertRuleStatusRequest{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlertRuleStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlertRuleStatusRequest) ProtoMessage() {}
+
+func (x *GetAlertRuleStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlertRuleStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetAlertRuleStatusRequest) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{49}
+}
+
+// AlertRuleStatus is the leader's view of a rule's most recent evaluation.
+type AlertRuleStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        []byte                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`   // "ok", "firing", or "error"
+	Value         float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"` // value compared against the threshold
+	LastEvaluated *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_evaluated,json=lastEvaluated,proto3" json:"last_evaluated,omitempty"`
+	FiringSince   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=firing_since,json=firingSince,proto3" json:"firing_since,omitempty"` // unset unless the rule is firing
+	LastResolved  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_resolved,json=lastResolved,proto3" json:"last_resolved,omitempty"`
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"` // last evaluation error, if any
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertRuleStatus) Reset() {
+	*x = AlertRuleStatus{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRuleStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRuleStatus) ProtoMessage() {}
+
+func (x *AlertRuleStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRuleStatus.ProtoReflect.Descriptor instead.
+func (*AlertRuleStatus) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AlertRuleStatus) GetRuleId() []byte {
+	if x != nil {
+		return x.RuleId
+	}
+	return nil
+}
+
+func (x *AlertRuleStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *AlertRuleStatus) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *AlertRuleStatus) GetLastEvaluated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastEvaluated
+	}
+	return nil
+}
+
+func (x *AlertRuleStatus) GetFiringSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FiringSince
+	}
+	return nil
+}
+
+func (x *AlertRuleStatus) GetLastResolved() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastResolved
+	}
+	return nil
+}
+
+func (x *AlertRuleStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetAlertRuleStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*AlertRuleStatus     `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAlertRuleStatusResponse) Reset() {
+	*x = GetAlertRuleStatusResponse{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlertRuleStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlertRuleStatusResponse) ProtoMessage() {}
+
+func (x *GetAlertRuleStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlertRuleStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetAlertRuleStatusResponse) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetAlertRuleStatusResponse) GetStatuses() []*AlertRuleStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// VaultGrant gives a role a set of actions on one vault.
+type VaultGrant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VaultId       []byte                 `protobuf:"bytes,1,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
+	Actions       []string               `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"` // "search", "follow", "export"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VaultGrant) Reset() {
+	*x = VaultGrant{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaultGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultGrant) ProtoMessage() {}
+
+func (x *VaultGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultGrant.ProtoReflect.Descriptor instead.
+func (*VaultGrant) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *VaultGrant) GetVaultId() []byte {
+	if x != nil {
+		return x.VaultId
+	}
+	return nil
+}
+
+func (x *VaultGrant) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+// RoleConfig is a custom role assigned to users by name.
+type RoleConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Grants        []*VaultGrant          `protobuf:"bytes,3,rep,name=grants,proto3" json:"grants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleConfig) Reset() {
+	*x = RoleConfig{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleConfig) ProtoMessage() {}
+
+func (x *RoleConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleConfig.ProtoReflect.Descriptor instead.
+func (*RoleConfig) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RoleConfig) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *RoleConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoleConfig) GetGrants() []*VaultGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+type PutRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *RoleConfig            `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutRoleRequest) Reset() {
+	*x = PutRoleRequest{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutRoleRequest) ProtoMessage() {}
+
+func (x *PutRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutRoleRequest.ProtoReflect.Descriptor instead.
+func (*PutRoleRequest) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PutRoleRequest) GetConfig() *RoleConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type PutRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	System        *GetSystemResponse     `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutRoleResponse) Reset() {
+	*x = PutRoleResponse{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutRoleResponse) ProtoMessage() {}
+
+func (x *PutRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutRoleResponse.ProtoReflect.Descriptor instead.
+func (*PutRoleResponse) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PutRoleResponse) GetSystem() *GetSystemResponse {
+	if x != nil {
+		return x.System
+	}
+	return nil
+}
+
+type DeleteRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleRequest) Reset() {
+	*x = DeleteRoleRequest{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleRequest) ProtoMessage() {}
+
+func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DeleteRoleRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type DeleteRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	System        *GetSystemResponse     `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleResponse) Reset() {
+	*x = DeleteRoleResponse{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleResponse) ProtoMessage() {}
+
+func (x *DeleteRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoleResponse) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeleteRoleResponse) GetSystem() *GetSystemResponse {
+	if x != nil {
+		return x.System
+	}
+	return nil
+}
+
+type PutIngesterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *IngesterConfig        `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutIngesterRequest) Reset() {
+	*x = PutIngesterRequest{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutIngesterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutIngesterRequest) ProtoMessage() {}
+
+func (x *PutIngesterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutIngesterRequest.ProtoReflect.Descriptor instead.
+func (*PutIngesterRequest) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *PutIngesterRequest) GetConfig() *IngesterConfig {
@@ -2513,7 +3624,7 @@ type PutIngesterResponse struct {
 
 func (x *PutIngesterResponse) Reset() {
 	*x = PutIngesterResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[39]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2525,7 +3636,7 @@ func (x *PutIngesterResponse) String() string {
 func (*PutIngesterResponse) ProtoMessage() {}
 
 func (x *PutIngesterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[39]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2538,7 +3649,7 @@ func (x *PutIngesterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutIngesterResponse.ProtoReflect.Descriptor instead.
 func (*PutIngesterResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{39}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *PutIngesterResponse) GetSystem() *GetSystemResponse {
@@ -2557,7 +3668,7 @@ type DeleteIngesterRequest struct {
 
 func (x *DeleteIngesterRequest) Reset() {
 	*x = DeleteIngesterRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[40]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2569,7 +3680,7 @@ func (x *DeleteIngesterRequest) String() string {
 func (*DeleteIngesterRequest) ProtoMessage() {}
 
 func (x *DeleteIngesterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[40]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2582,7 +3693,7 @@ func (x *DeleteIngesterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteIngesterRequest.ProtoReflect.Descriptor instead.
 func (*DeleteIngesterRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{40}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *DeleteIngesterRequest) GetId() []byte {
@@ -2601,7 +3712,7 @@ type DeleteIngesterResponse struct {
 
 func (x *DeleteIngesterResponse) Reset() {
 	*x = DeleteIngesterResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[41]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2613,7 +3724,7 @@ func (x *DeleteIngesterResponse) String() string {
 func (*DeleteIngesterResponse) ProtoMessage() {}
 
 func (x *DeleteIngesterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[41]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2626,7 +3737,7 @@ func (x *DeleteIngesterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteIngesterResponse.ProtoReflect.Descriptor instead.
 func (*DeleteIngesterResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{41}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *DeleteIngesterResponse) GetSystem() *GetSystemResponse {
@@ -2645,7 +3756,7 @@ type GetSettingsRequest struct {
 
 func (x *GetSettingsRequest) Reset() {
 	*x = GetSettingsRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[42]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2657,7 +3768,7 @@ func (x *GetSettingsRequest) String() string {
 func (*GetSettingsRequest) ProtoMessage() {}
 
 func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[42]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2670,7 +3781,7 @@ func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSettingsRequest.ProtoReflect.Descriptor instead.
 func (*GetSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{42}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *GetSettingsRequest) GetIncludeSecrets() bool {
@@ -2694,7 +3805,7 @@ type PasswordPolicySettings struct {
 
 func (x *PasswordPolicySettings) Reset() {
 	*x = PasswordPolicySettings{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[43]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2706,7 +3817,7 @@ func (x *PasswordPolicySettings) String() string {
 func (*PasswordPolicySettings) ProtoMessage() {}
 
 func (x *PasswordPolicySettings) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[43]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2719,7 +3830,7 @@ func (x *PasswordPolicySettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PasswordPolicySettings.ProtoReflect.Descriptor instead.
 func (*PasswordPolicySettings) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{43}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *PasswordPolicySettings) GetMinLength() int32 {
@@ -2775,21 +3886,179 @@ type MaxMindSettings struct {
 	sizeCache         protoimpl.SizeCache
 }
 
-func (x *MaxMindSettings) Reset() {
-	*x = MaxMindSettings{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[44]
+func (x *MaxMindSettings) Reset() {
+	*x = MaxMindSettings{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaxMindSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaxMindSettings) ProtoMessage() {}
+
+func (x *MaxMindSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaxMindSettings.ProtoReflect.Descriptor instead.
+func (*MaxMindSettings) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *MaxMindSettings) GetAutoDownload() bool {
+	if x != nil {
+		return x.AutoDownload
+	}
+	return false
+}
+
+func (x *MaxMindSettings) GetLicenseConfigured() bool {
+	if x != nil {
+		return x.LicenseConfigured
+	}
+	return false
+}
+
+func (x *MaxMindSettings) GetLastUpdate() string {
+	if x != nil {
+		return x.LastUpdate
+	}
+	return ""
+}
+
+func (x *MaxMindSettings) GetAccountId() []byte {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+func (x *MaxMindSettings) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+type AuthSettings struct {
+	state                protoimpl.MessageState  `protogen:"open.v1"`
+	TokenDuration        string                  `protobuf:"bytes,1,opt,name=token_duration,json=tokenDuration,proto3" json:"token_duration,omitempty"`
+	JwtSecretConfigured  bool                    `protobuf:"varint,2,opt,name=jwt_secret_configured,json=jwtSecretConfigured,proto3" json:"jwt_secret_configured,omitempty"` // read-only: true when a JWT secret exists
+	RefreshTokenDuration string                  `protobuf:"bytes,3,opt,name=refresh_token_duration,json=refreshTokenDuration,proto3" json:"refresh_token_duration,omitempty"`
+	PasswordPolicy       *PasswordPolicySettings `protobuf:"bytes,4,opt,name=password_policy,json=passwordPolicy,proto3" json:"password_policy,omitempty"`
+	Oidc                 *OIDCSettings           `protobuf:"bytes,5,opt,name=oidc,proto3" json:"oidc,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AuthSettings) Reset() {
+	*x = AuthSettings{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthSettings) ProtoMessage() {}
+
+func (x *AuthSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthSettings.ProtoReflect.Descriptor instead.
+func (*AuthSettings) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AuthSettings) GetTokenDuration() string {
+	if x != nil {
+		return x.TokenDuration
+	}
+	return ""
+}
+
+func (x *AuthSettings) GetJwtSecretConfigured() bool {
+	if x != nil {
+		return x.JwtSecretConfigured
+	}
+	return false
+}
+
+func (x *AuthSettings) GetRefreshTokenDuration() string {
+	if x != nil {
+		return x.RefreshTokenDuration
+	}
+	return ""
+}
+
+func (x *AuthSettings) GetPasswordPolicy() *PasswordPolicySettings {
+	if x != nil {
+		return x.PasswordPolicy
+	}
+	return nil
+}
+
+func (x *AuthSettings) GetOidc() *OIDCSettings {
+	if x != nil {
+		return x.Oidc
+	}
+	return nil
+}
+
+type OIDCSettings struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Enabled                bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	IssuerUrl              string                 `protobuf:"bytes,2,opt,name=issuer_url,json=issuerUrl,proto3" json:"issuer_url,omitempty"`
+	ClientId               string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecretConfigured bool                   `protobuf:"varint,4,opt,name=client_secret_configured,json=clientSecretConfigured,proto3" json:"client_secret_configured,omitempty"` // read-only
+	RedirectUrl            string                 `protobuf:"bytes,5,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	Scopes                 string                 `protobuf:"bytes,6,opt,name=scopes,proto3" json:"scopes,omitempty"` // space-separated, requested in addition to "openid"
+	UsernameClaim          string                 `protobuf:"bytes,7,opt,name=username_claim,json=usernameClaim,proto3" json:"username_claim,omitempty"`
+	GroupsClaim            string                 `protobuf:"bytes,8,opt,name=groups_claim,json=groupsClaim,proto3" json:"groups_claim,omitempty"`
+	RoleMappings           string                 `protobuf:"bytes,9,opt,name=role_mappings,json=roleMappings,proto3" json:"role_mappings,omitempty"`  // "group=role,group=role", first match wins
+	DefaultRole            string                 `protobuf:"bytes,10,opt,name=default_role,json=defaultRole,proto3" json:"default_role,omitempty"`    // role when no group matches; empty = deny login
+	ClientSecret           string                 `protobuf:"bytes,11,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"` // only populated when include_secrets
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *OIDCSettings) Reset() {
+	*x = OIDCSettings{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MaxMindSettings) String() string {
+func (x *OIDCSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaxMindSettings) ProtoMessage() {}
+func (*OIDCSettings) ProtoMessage() {}
 
-func (x *MaxMindSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[44]
+func (x *OIDCSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2800,112 +4069,86 @@ func (x *MaxMindSettings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaxMindSettings.ProtoReflect.Descriptor instead.
-func (*MaxMindSettings) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use OIDCSettings.ProtoReflect.Descriptor instead.
+func (*OIDCSettings) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *MaxMindSettings) GetAutoDownload() bool {
+func (x *OIDCSettings) GetEnabled() bool {
 	if x != nil {
-		return x.AutoDownload
+		return x.Enabled
 	}
 	return false
 }
 
-func (x *MaxMindSettings) GetLicenseConfigured() bool {
+func (x *OIDCSettings) GetIssuerUrl() string {
 	if x != nil {
-		return x.LicenseConfigured
+		return x.IssuerUrl
 	}
-	return false
+	return ""
 }
 
-func (x *MaxMindSettings) GetLastUpdate() string {
+func (x *OIDCSettings) GetClientId() string {
 	if x != nil {
-		return x.LastUpdate
+		return x.ClientId
 	}
 	return ""
 }
 
-func (x *MaxMindSettings) GetAccountId() []byte {
+func (x *OIDCSettings) GetClientSecretConfigured() bool {
 	if x != nil {
-		return x.AccountId
+		return x.ClientSecretConfigured
 	}
-	return nil
+	return false
 }
 
-func (x *MaxMindSettings) GetLicenseKey() string {
+func (x *OIDCSettings) GetRedirectUrl() string {
 	if x != nil {
-		return x.LicenseKey
+		return x.RedirectUrl
 	}
 	return ""
 }
 
-type AuthSettings struct {
-	state                protoimpl.MessageState  `protogen:"open.v1"`
-	TokenDuration        string                  `protobuf:"bytes,1,opt,name=token_duration,json=tokenDuration,proto3" json:"token_duration,omitempty"`
-	JwtSecretConfigured  bool                    `protobuf:"varint,2,opt,name=jwt_secret_configured,json=jwtSecretConfigured,proto3" json:"jwt_secret_configured,omitempty"` // read-only: true when a JWT secret exists
-	RefreshTokenDuration string                  `protobuf:"bytes,3,opt,name=refresh_token_duration,json=refreshTokenDuration,proto3" json:"refresh_token_duration,omitempty"`
-	PasswordPolicy       *PasswordPolicySettings `protobuf:"bytes,4,opt,name=password_policy,json=passwordPolicy,proto3" json:"password_policy,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
-}
-
-func (x *AuthSettings) Reset() {
-	*x = AuthSettings{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[45]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *AuthSettings) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AuthSettings) ProtoMessage() {}
-
-func (x *AuthSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[45]
+func (x *OIDCSettings) GetScopes() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Scopes
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AuthSettings.ProtoReflect.Descriptor instead.
-func (*AuthSettings) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{45}
+func (x *OIDCSettings) GetUsernameClaim() string {
+	if x != nil {
+		return x.UsernameClaim
+	}
+	return ""
 }
 
-func (x *AuthSettings) GetTokenDuration() string {
+func (x *OIDCSettings) GetGroupsClaim() string {
 	if x != nil {
-		return x.TokenDuration
+		return x.GroupsClaim
 	}
 	return ""
 }
 
-func (x *AuthSettings) GetJwtSecretConfigured() bool {
+func (x *OIDCSettings) GetRoleMappings() string {
 	if x != nil {
-		return x.JwtSecretConfigured
+		return x.RoleMappings
 	}
-	return false
+	return ""
 }
 
-func (x *AuthSettings) GetRefreshTokenDuration() string {
+func (x *OIDCSettings) GetDefaultRole() string {
 	if x != nil {
-		return x.RefreshTokenDuration
+		return x.DefaultRole
 	}
 	return ""
 }
 
-func (x *AuthSettings) GetPasswordPolicy() *PasswordPolicySettings {
+func (x *OIDCSettings) GetClientSecret() string {
 	if x != nil {
-		return x.PasswordPolicy
+		return x.ClientSecret
 	}
-	return nil
+	return ""
 }
 
 type QuerySettings struct {
@@ -2919,7 +4162,7 @@ type QuerySettings struct {
 
 func (x *QuerySettings) Reset() {
 	*x = QuerySettings{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[46]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2931,7 +4174,7 @@ func (x *QuerySettings) String() string {
 func (*QuerySettings) ProtoMessage() {}
 
 func (x *QuerySettings) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[46]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2944,7 +4187,7 @@ func (x *QuerySettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuerySettings.ProtoReflect.Descriptor instead.
 func (*QuerySettings) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{46}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *QuerySettings) GetTimeout() string {
@@ -2977,7 +4220,7 @@ type SchedulerSettings struct {
 
 func (x *SchedulerSettings) Reset() {
 	*x = SchedulerSettings{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[47]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2989,7 +4232,7 @@ func (x *SchedulerSettings) String() string {
 func (*SchedulerSettings) ProtoMessage() {}
 
 func (x *SchedulerSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[47]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3002,7 +4245,7 @@ func (x *SchedulerSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SchedulerSettings.ProtoReflect.Descriptor instead.
 func (*SchedulerSettings) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{47}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *SchedulerSettings) GetMaxConcurrentJobs() int32 {
@@ -3024,7 +4267,7 @@ type TLSSettings struct {
 
 func (x *TLSSettings) Reset() {
 	*x = TLSSettings{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[48]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3036,7 +4279,7 @@ func (x *TLSSettings) String() string {
 func (*TLSSettings) ProtoMessage() {}
 
 func (x *TLSSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[48]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3049,7 +4292,7 @@ func (x *TLSSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TLSSettings.ProtoReflect.Descriptor instead.
 func (*TLSSettings) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{48}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *TLSSettings) GetDefaultCert() string {
@@ -3094,7 +4337,7 @@ type LookupSettings struct {
 
 func (x *LookupSettings) Reset() {
 	*x = LookupSettings{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[49]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3106,7 +4349,7 @@ func (x *LookupSettings) String() string {
 func (*LookupSettings) ProtoMessage() {}
 
 func (x *LookupSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[49]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3119,7 +4362,7 @@ func (x *LookupSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LookupSettings.ProtoReflect.Descriptor instead.
 func (*LookupSettings) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{49}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *LookupSettings) GetHttpLookups() []*HTTPLookupEntry {
@@ -3176,7 +4419,7 @@ type MMDBLookupEntry struct {
 
 func (x *MMDBLookupEntry) Reset() {
 	*x = MMDBLookupEntry{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[50]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3188,7 +4431,7 @@ func (x *MMDBLookupEntry) String() string {
 func (*MMDBLookupEntry) ProtoMessage() {}
 
 func (x *MMDBLookupEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[50]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3201,7 +4444,7 @@ func (x *MMDBLookupEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MMDBLookupEntry.ProtoReflect.Descriptor instead.
 func (*MMDBLookupEntry) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{50}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *MMDBLookupEntry) GetName() string {
@@ -3237,7 +4480,7 @@ type HTTPLookupParam struct {
 
 func (x *HTTPLookupParam) Reset() {
 	*x = HTTPLookupParam{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[51]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3249,7 +4492,7 @@ func (x *HTTPLookupParam) String() string {
 func (*HTTPLookupParam) ProtoMessage() {}
 
 func (x *HTTPLookupParam) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[51]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3262,7 +4505,7 @@ func (x *HTTPLookupParam) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPLookupParam.ProtoReflect.Descriptor instead.
 func (*HTTPLookupParam) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{51}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *HTTPLookupParam) GetName() string {
@@ -3296,7 +4539,7 @@ type HTTPLookupEntry struct {
 
 func (x *HTTPLookupEntry) Reset() {
 	*x = HTTPLookupEntry{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[52]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3308,7 +4551,7 @@ func (x *HTTPLookupEntry) String() string {
 func (*HTTPLookupEntry) ProtoMessage() {}
 
 func (x *HTTPLookupEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[52]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3321,7 +4564,7 @@ func (x *HTTPLookupEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPLookupEntry.ProtoReflect.Descriptor instead.
 func (*HTTPLookupEntry) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{52}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *HTTPLookupEntry) GetName() string {
@@ -3396,7 +4639,7 @@ type JSONFileLookupEntry struct {
 
 func (x *JSONFileLookupEntry) Reset() {
 	*x = JSONFileLookupEntry{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[53]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3408,7 +4651,7 @@ func (x *JSONFileLookupEntry) String() string {
 func (*JSONFileLookupEntry) ProtoMessage() {}
 
 func (x *JSONFileLookupEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[53]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3421,7 +4664,7 @@ func (x *JSONFileLookupEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JSONFileLookupEntry.ProtoReflect.Descriptor instead.
 func (*JSONFileLookupEntry) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{53}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *JSONFileLookupEntry) GetName() string {
@@ -3475,7 +4718,7 @@ type YAMLFileLookupEntry struct {
 
 func (x *YAMLFileLookupEntry) Reset() {
 	*x = YAMLFileLookupEntry{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[54]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3487,7 +4730,7 @@ func (x *YAMLFileLookupEntry) String() string {
 func (*YAMLFileLookupEntry) ProtoMessage() {}
 
 func (x *YAMLFileLookupEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[54]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3500,7 +4743,7 @@ func (x *YAMLFileLookupEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use YAMLFileLookupEntry.ProtoReflect.Descriptor instead.
 func (*YAMLFileLookupEntry) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{54}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *YAMLFileLookupEntry) GetName() string {
@@ -3552,7 +4795,7 @@ type CSVLookupEntry struct {
 
 func (x *CSVLookupEntry) Reset() {
 	*x = CSVLookupEntry{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[55]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3564,7 +4807,7 @@ func (x *CSVLookupEntry) String() string {
 func (*CSVLookupEntry) ProtoMessage() {}
 
 func (x *CSVLookupEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[55]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3577,7 +4820,7 @@ func (x *CSVLookupEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CSVLookupEntry.ProtoReflect.Descriptor instead.
 func (*CSVLookupEntry) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{55}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *CSVLookupEntry) GetName() string {
@@ -3623,7 +4866,7 @@ type StaticLookupEntry struct {
 
 func (x *StaticLookupEntry) Reset() {
 	*x = StaticLookupEntry{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[56]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3635,7 +4878,7 @@ func (x *StaticLookupEntry) String() string {
 func (*StaticLookupEntry) ProtoMessage() {}
 
 func (x *StaticLookupEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[56]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3648,7 +4891,7 @@ func (x *StaticLookupEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StaticLookupEntry.ProtoReflect.Descriptor instead.
 func (*StaticLookupEntry) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{56}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *StaticLookupEntry) GetName() string {
@@ -3688,7 +4931,7 @@ type StaticLookupRow struct {
 
 func (x *StaticLookupRow) Reset() {
 	*x = StaticLookupRow{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[57]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3700,7 +4943,7 @@ func (x *StaticLookupRow) String() string {
 func (*StaticLookupRow) ProtoMessage() {}
 
 func (x *StaticLookupRow) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[57]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3713,7 +4956,7 @@ func (x *StaticLookupRow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StaticLookupRow.ProtoReflect.Descriptor instead.
 func (*StaticLookupRow) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{57}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *StaticLookupRow) GetValues() map[string]string {
@@ -3733,7 +4976,7 @@ type ClusterSettings struct {
 
 func (x *ClusterSettings) Reset() {
 	*x = ClusterSettings{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[58]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3745,7 +4988,7 @@ func (x *ClusterSettings) String() string {
 func (*ClusterSettings) ProtoMessage() {}
 
 func (x *ClusterSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[58]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3758,7 +5001,7 @@ func (x *ClusterSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterSettings.ProtoReflect.Descriptor instead.
 func (*ClusterSettings) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{58}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *ClusterSettings) GetBroadcastInterval() string {
@@ -3793,7 +5036,7 @@ type GetSettingsResponse struct {
 
 func (x *GetSettingsResponse) Reset() {
 	*x = GetSettingsResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[59]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3805,7 +5048,7 @@ func (x *GetSettingsResponse) String() string {
 func (*GetSettingsResponse) ProtoMessage() {}
 
 func (x *GetSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[59]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3818,7 +5061,7 @@ func (x *GetSettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSettingsResponse.ProtoReflect.Descriptor instead.
 func (*GetSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{59}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *GetSettingsResponse) GetAuth() *AuthSettings {
@@ -3905,7 +5148,7 @@ type PutPasswordPolicySettings struct {
 
 func (x *PutPasswordPolicySettings) Reset() {
 	*x = PutPasswordPolicySettings{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[60]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3917,7 +5160,7 @@ func (x *PutPasswordPolicySettings) String() string {
 func (*PutPasswordPolicySettings) ProtoMessage() {}
 
 func (x *PutPasswordPolicySettings) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[60]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3930,7 +5173,7 @@ func (x *PutPasswordPolicySettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutPasswordPolicySettings.ProtoReflect.Descriptor instead.
 func (*PutPasswordPolicySettings) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{60}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *PutPasswordPolicySettings) GetMinLength() int32 {
@@ -3980,13 +5223,14 @@ type PutAuthSettings struct {
 	TokenDuration        *string                    `protobuf:"bytes,1,opt,name=token_duration,json=tokenDuration,proto3,oneof" json:"token_duration,omitempty"`
 	RefreshTokenDuration *string                    `protobuf:"bytes,2,opt,name=refresh_token_duration,json=refreshTokenDuration,proto3,oneof" json:"refresh_token_duration,omitempty"`
 	PasswordPolicy       *PutPasswordPolicySettings `protobuf:"bytes,3,opt,name=password_policy,json=passwordPolicy,proto3" json:"password_policy,omitempty"`
+	Oidc                 *PutOIDCSettings           `protobuf:"bytes,4,opt,name=oidc,proto3" json:"oidc,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
 
 func (x *PutAuthSettings) Reset() {
 	*x = PutAuthSettings{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[61]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3998,7 +5242,7 @@ func (x *PutAuthSettings) String() string {
 func (*PutAuthSettings) ProtoMessage() {}
 
 func (x *PutAuthSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[61]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4011,7 +5255,7 @@ func (x *PutAuthSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutAuthSettings.ProtoReflect.Descriptor instead.
 func (*PutAuthSettings) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{61}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *PutAuthSettings) GetTokenDuration() string {
@@ -4028,11 +5272,134 @@ func (x *PutAuthSettings) GetRefreshTokenDuration() string {
 	return ""
 }
 
-func (x *PutAuthSettings) GetPasswordPolicy() *PutPasswordPolicySettings {
-	if x != nil {
-		return x.PasswordPolicy
+func (x *PutAuthSettings) GetPasswordPolicy() *PutPasswordPolicySettings {
+	if x != nil {
+		return x.PasswordPolicy
+	}
+	return nil
+}
+
+func (x *PutAuthSettings) GetOidc() *PutOIDCSettings {
+	if x != nil {
+		return x.Oidc
+	}
+	return nil
+}
+
+type PutOIDCSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       *bool                  `protobuf:"varint,1,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	IssuerUrl     *string                `protobuf:"bytes,2,opt,name=issuer_url,json=issuerUrl,proto3,oneof" json:"issuer_url,omitempty"`
+	ClientId      *string                `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ClientSecret  *string                `protobuf:"bytes,4,opt,name=client_secret,json=clientSecret,proto3,oneof" json:"client_secret,omitempty"`
+	RedirectUrl   *string                `protobuf:"bytes,5,opt,name=redirect_url,json=redirectUrl,proto3,oneof" json:"redirect_url,omitempty"`
+	Scopes        *string                `protobuf:"bytes,6,opt,name=scopes,proto3,oneof" json:"scopes,omitempty"` // space-separated
+	UsernameClaim *string                `protobuf:"bytes,7,opt,name=username_claim,json=usernameClaim,proto3,oneof" json:"username_claim,omitempty"`
+	GroupsClaim   *string                `protobuf:"bytes,8,opt,name=groups_claim,json=groupsClaim,proto3,oneof" json:"groups_claim,omitempty"`
+	RoleMappings  *string                `protobuf:"bytes,9,opt,name=role_mappings,json=roleMappings,proto3,oneof" json:"role_mappings,omitempty"` // "group=role,group=role"; replaces the list
+	DefaultRole   *string                `protobuf:"bytes,10,opt,name=default_role,json=defaultRole,proto3,oneof" json:"default_role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutOIDCSettings) Reset() {
+	*x = PutOIDCSettings{}
+	mi := &file_gastrolog_v1_system_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutOIDCSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutOIDCSettings) ProtoMessage() {}
+
+func (x *PutOIDCSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_gastrolog_v1_system_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutOIDCSettings.ProtoReflect.Descriptor instead.
+func (*PutOIDCSettings) Descriptor() ([]byte, []int) {
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *PutOIDCSettings) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *PutOIDCSettings) GetIssuerUrl() string {
+	if x != nil && x.IssuerUrl != nil {
+		return *x.IssuerUrl
+	}
+	return ""
+}
+
+func (x *PutOIDCSettings) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *PutOIDCSettings) GetClientSecret() string {
+	if x != nil && x.ClientSecret != nil {
+		return *x.ClientSecret
+	}
+	return ""
+}
+
+func (x *PutOIDCSettings) GetRedirectUrl() string {
+	if x != nil && x.RedirectUrl != nil {
+		return *x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *PutOIDCSettings) GetScopes() string {
+	if x != nil && x.Scopes != nil {
+		return *x.Scopes
+	}
+	return ""
+}
+
+func (x *PutOIDCSettings) GetUsernameClaim() string {
+	if x != nil && x.UsernameClaim != nil {
+		return *x.UsernameClaim
+	}
+	return ""
+}
+
+func (x *PutOIDCSettings) GetGroupsClaim() string {
+	if x != nil && x.GroupsClaim != nil {
+		return *x.GroupsClaim
+	}
+	return ""
+}
+
+func (x *PutOIDCSettings) GetRoleMappings() string {
+	if x != nil && x.RoleMappings != nil {
+		return *x.RoleMappings
+	}
+	return ""
+}
+
+func (x *PutOIDCSettings) GetDefaultRole() string {
+	if x != nil && x.DefaultRole != nil {
+		return *x.DefaultRole
 	}
-	return nil
+	return ""
 }
 
 type PutQuerySettings struct {
@@ -4046,7 +5413,7 @@ type PutQuerySettings struct {
 
 func (x *PutQuerySettings) Reset() {
 	*x = PutQuerySettings{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[62]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4058,7 +5425,7 @@ func (x *PutQuerySettings) String() string {
 func (*PutQuerySettings) ProtoMessage() {}
 
 func (x *PutQuerySettings) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[62]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4071,7 +5438,7 @@ func (x *PutQuerySettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutQuerySettings.ProtoReflect.Descriptor instead.
 func (*PutQuerySettings) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{62}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *PutQuerySettings) GetTimeout() string {
@@ -4104,7 +5471,7 @@ type PutSchedulerSettings struct {
 
 func (x *PutSchedulerSettings) Reset() {
 	*x = PutSchedulerSettings{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[63]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4116,7 +5483,7 @@ func (x *PutSchedulerSettings) String() string {
 func (*PutSchedulerSettings) ProtoMessage() {}
 
 func (x *PutSchedulerSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[63]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4129,7 +5496,7 @@ func (x *PutSchedulerSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutSchedulerSettings.ProtoReflect.Descriptor instead.
 func (*PutSchedulerSettings) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{63}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *PutSchedulerSettings) GetMaxConcurrentJobs() int32 {
@@ -4151,7 +5518,7 @@ type PutTLSSettings struct {
 
 func (x *PutTLSSettings) Reset() {
 	*x = PutTLSSettings{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[64]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4163,7 +5530,7 @@ func (x *PutTLSSettings) String() string {
 func (*PutTLSSettings) ProtoMessage() {}
 
 func (x *PutTLSSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[64]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4176,7 +5543,7 @@ func (x *PutTLSSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutTLSSettings.ProtoReflect.Descriptor instead.
 func (*PutTLSSettings) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{64}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *PutTLSSettings) GetDefaultCert() string {
@@ -4218,7 +5585,7 @@ type PutMaxMindSettings struct {
 
 func (x *PutMaxMindSettings) Reset() {
 	*x = PutMaxMindSettings{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[65]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4230,7 +5597,7 @@ func (x *PutMaxMindSettings) String() string {
 func (*PutMaxMindSettings) ProtoMessage() {}
 
 func (x *PutMaxMindSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[65]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4243,7 +5610,7 @@ func (x *PutMaxMindSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutMaxMindSettings.ProtoReflect.Descriptor instead.
 func (*PutMaxMindSettings) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{65}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *PutMaxMindSettings) GetAutoDownload() bool {
@@ -4281,7 +5648,7 @@ type PutLookupSettings struct {
 
 func (x *PutLookupSettings) Reset() {
 	*x = PutLookupSettings{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[66]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4293,7 +5660,7 @@ func (x *PutLookupSettings) String() string {
 func (*PutLookupSettings) ProtoMessage() {}
 
 func (x *PutLookupSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[66]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4306,7 +5673,7 @@ func (x *PutLookupSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutLookupSettings.ProtoReflect.Descriptor instead.
 func (*PutLookupSettings) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{66}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *PutLookupSettings) GetHttpLookups() []*HTTPLookupEntry {
@@ -4361,7 +5728,7 @@ type PutClusterSettings struct {
 
 func (x *PutClusterSettings) Reset() {
 	*x = PutClusterSettings{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[67]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4373,7 +5740,7 @@ func (x *PutClusterSettings) String() string {
 func (*PutClusterSettings) ProtoMessage() {}
 
 func (x *PutClusterSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[67]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4386,7 +5753,7 @@ func (x *PutClusterSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutClusterSettings.ProtoReflect.Descriptor instead.
 func (*PutClusterSettings) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{67}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *PutClusterSettings) GetBroadcastInterval() string {
@@ -4416,7 +5783,7 @@ type PutServiceSettingsRequest struct {
 
 func (x *PutServiceSettingsRequest) Reset() {
 	*x = PutServiceSettingsRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[68]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4428,7 +5795,7 @@ func (x *PutServiceSettingsRequest) String() string {
 func (*PutServiceSettingsRequest) ProtoMessage() {}
 
 func (x *PutServiceSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[68]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4441,7 +5808,7 @@ func (x *PutServiceSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutServiceSettingsRequest.ProtoReflect.Descriptor instead.
 func (*PutServiceSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{68}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *PutServiceSettingsRequest) GetAuth() *PutAuthSettings {
@@ -4492,7 +5859,7 @@ type SettingsMutationEcho struct {
 
 func (x *SettingsMutationEcho) Reset() {
 	*x = SettingsMutationEcho{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[69]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4504,7 +5871,7 @@ func (x *SettingsMutationEcho) String() string {
 func (*SettingsMutationEcho) ProtoMessage() {}
 
 func (x *SettingsMutationEcho) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[69]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4517,7 +5884,7 @@ func (x *SettingsMutationEcho) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SettingsMutationEcho.ProtoReflect.Descriptor instead.
 func (*SettingsMutationEcho) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{69}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *SettingsMutationEcho) GetSettings() *GetSettingsResponse {
@@ -4543,7 +5910,7 @@ type PutServiceSettingsResponse struct {
 
 func (x *PutServiceSettingsResponse) Reset() {
 	*x = PutServiceSettingsResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[70]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4555,7 +5922,7 @@ func (x *PutServiceSettingsResponse) String() string {
 func (*PutServiceSettingsResponse) ProtoMessage() {}
 
 func (x *PutServiceSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[70]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4568,7 +5935,7 @@ func (x *PutServiceSettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutServiceSettingsResponse.ProtoReflect.Descriptor instead.
 func (*PutServiceSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{70}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *PutServiceSettingsResponse) GetEcho() *SettingsMutationEcho {
@@ -4587,7 +5954,7 @@ type PutLookupSettingsRequest struct {
 
 func (x *PutLookupSettingsRequest) Reset() {
 	*x = PutLookupSettingsRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[71]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4599,7 +5966,7 @@ func (x *PutLookupSettingsRequest) String() string {
 func (*PutLookupSettingsRequest) ProtoMessage() {}
 
 func (x *PutLookupSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[71]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4612,7 +5979,7 @@ func (x *PutLookupSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutLookupSettingsRequest.ProtoReflect.Descriptor instead.
 func (*PutLookupSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{71}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *PutLookupSettingsRequest) GetLookup() *PutLookupSettings {
@@ -4631,7 +5998,7 @@ type PutLookupSettingsResponse struct {
 
 func (x *PutLookupSettingsResponse) Reset() {
 	*x = PutLookupSettingsResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[72]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4643,7 +6010,7 @@ func (x *PutLookupSettingsResponse) String() string {
 func (*PutLookupSettingsResponse) ProtoMessage() {}
 
 func (x *PutLookupSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[72]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4656,7 +6023,7 @@ func (x *PutLookupSettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutLookupSettingsResponse.ProtoReflect.Descriptor instead.
 func (*PutLookupSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{72}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *PutLookupSettingsResponse) GetEcho() *SettingsMutationEcho {
@@ -4675,7 +6042,7 @@ type PutMaxMindSettingsRequest struct {
 
 func (x *PutMaxMindSettingsRequest) Reset() {
 	*x = PutMaxMindSettingsRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[73]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4687,7 +6054,7 @@ func (x *PutMaxMindSettingsRequest) String() string {
 func (*PutMaxMindSettingsRequest) ProtoMessage() {}
 
 func (x *PutMaxMindSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[73]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4700,7 +6067,7 @@ func (x *PutMaxMindSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutMaxMindSettingsRequest.ProtoReflect.Descriptor instead.
 func (*PutMaxMindSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{73}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *PutMaxMindSettingsRequest) GetMaxmind() *PutMaxMindSettings {
@@ -4719,7 +6086,7 @@ type PutMaxMindSettingsResponse struct {
 
 func (x *PutMaxMindSettingsResponse) Reset() {
 	*x = PutMaxMindSettingsResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[74]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4731,7 +6098,7 @@ func (x *PutMaxMindSettingsResponse) String() string {
 func (*PutMaxMindSettingsResponse) ProtoMessage() {}
 
 func (x *PutMaxMindSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[74]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4744,7 +6111,7 @@ func (x *PutMaxMindSettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutMaxMindSettingsResponse.ProtoReflect.Descriptor instead.
 func (*PutMaxMindSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{74}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *PutMaxMindSettingsResponse) GetEcho() *SettingsMutationEcho {
@@ -4763,7 +6130,7 @@ type PutSetupSettingsRequest struct {
 
 func (x *PutSetupSettingsRequest) Reset() {
 	*x = PutSetupSettingsRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[75]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4775,7 +6142,7 @@ func (x *PutSetupSettingsRequest) String() string {
 func (*PutSetupSettingsRequest) ProtoMessage() {}
 
 func (x *PutSetupSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[75]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4788,7 +6155,7 @@ func (x *PutSetupSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutSetupSettingsRequest.ProtoReflect.Descriptor instead.
 func (*PutSetupSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{75}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *PutSetupSettingsRequest) GetSetupWizardDismissed() bool {
@@ -4807,7 +6174,7 @@ type PutSetupSettingsResponse struct {
 
 func (x *PutSetupSettingsResponse) Reset() {
 	*x = PutSetupSettingsResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[76]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4819,7 +6186,7 @@ func (x *PutSetupSettingsResponse) String() string {
 func (*PutSetupSettingsResponse) ProtoMessage() {}
 
 func (x *PutSetupSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[76]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4832,7 +6199,7 @@ func (x *PutSetupSettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutSetupSettingsResponse.ProtoReflect.Descriptor instead.
 func (*PutSetupSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{76}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *PutSetupSettingsResponse) GetEcho() *SettingsMutationEcho {
@@ -4850,7 +6217,7 @@ type RegenerateJwtSecretRequest struct {
 
 func (x *RegenerateJwtSecretRequest) Reset() {
 	*x = RegenerateJwtSecretRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[77]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4862,7 +6229,7 @@ func (x *RegenerateJwtSecretRequest) String() string {
 func (*RegenerateJwtSecretRequest) ProtoMessage() {}
 
 func (x *RegenerateJwtSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[77]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4875,7 +6242,7 @@ func (x *RegenerateJwtSecretRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegenerateJwtSecretRequest.ProtoReflect.Descriptor instead.
 func (*RegenerateJwtSecretRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{77}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{99}
 }
 
 type RegenerateJwtSecretResponse struct {
@@ -4887,7 +6254,7 @@ type RegenerateJwtSecretResponse struct {
 
 func (x *RegenerateJwtSecretResponse) Reset() {
 	*x = RegenerateJwtSecretResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[78]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4899,7 +6266,7 @@ func (x *RegenerateJwtSecretResponse) String() string {
 func (*RegenerateJwtSecretResponse) ProtoMessage() {}
 
 func (x *RegenerateJwtSecretResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[78]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4912,7 +6279,7 @@ func (x *RegenerateJwtSecretResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegenerateJwtSecretResponse.ProtoReflect.Descriptor instead.
 func (*RegenerateJwtSecretResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{78}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *RegenerateJwtSecretResponse) GetEcho() *SettingsMutationEcho {
@@ -4936,7 +6303,7 @@ type MmdbValidation struct {
 
 func (x *MmdbValidation) Reset() {
 	*x = MmdbValidation{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[79]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4948,7 +6315,7 @@ func (x *MmdbValidation) String() string {
 func (*MmdbValidation) ProtoMessage() {}
 
 func (x *MmdbValidation) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[79]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4961,7 +6328,7 @@ func (x *MmdbValidation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MmdbValidation.ProtoReflect.Descriptor instead.
 func (*MmdbValidation) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{79}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *MmdbValidation) GetValid() bool {
@@ -5007,7 +6374,7 @@ type GetPreferencesRequest struct {
 
 func (x *GetPreferencesRequest) Reset() {
 	*x = GetPreferencesRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[80]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5019,7 +6386,7 @@ func (x *GetPreferencesRequest) String() string {
 func (*GetPreferencesRequest) ProtoMessage() {}
 
 func (x *GetPreferencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[80]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5032,7 +6399,7 @@ func (x *GetPreferencesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPreferencesRequest.ProtoReflect.Descriptor instead.
 func (*GetPreferencesRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{80}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{102}
 }
 
 type GetPreferencesResponse struct {
@@ -5046,7 +6413,7 @@ type GetPreferencesResponse struct {
 
 func (x *GetPreferencesResponse) Reset() {
 	*x = GetPreferencesResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[81]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5058,7 +6425,7 @@ func (x *GetPreferencesResponse) String() string {
 func (*GetPreferencesResponse) ProtoMessage() {}
 
 func (x *GetPreferencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[81]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5071,7 +6438,7 @@ func (x *GetPreferencesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPreferencesResponse.ProtoReflect.Descriptor instead.
 func (*GetPreferencesResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{81}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *GetPreferencesResponse) GetTheme() string {
@@ -5106,7 +6473,7 @@ type PutPreferencesRequest struct {
 
 func (x *PutPreferencesRequest) Reset() {
 	*x = PutPreferencesRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[82]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5118,7 +6485,7 @@ func (x *PutPreferencesRequest) String() string {
 func (*PutPreferencesRequest) ProtoMessage() {}
 
 func (x *PutPreferencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[82]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5131,7 +6498,7 @@ func (x *PutPreferencesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutPreferencesRequest.ProtoReflect.Descriptor instead.
 func (*PutPreferencesRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{82}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *PutPreferencesRequest) GetTheme() string {
@@ -5164,7 +6531,7 @@ type PutPreferencesResponse struct {
 
 func (x *PutPreferencesResponse) Reset() {
 	*x = PutPreferencesResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[83]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5176,7 +6543,7 @@ func (x *PutPreferencesResponse) String() string {
 func (*PutPreferencesResponse) ProtoMessage() {}
 
 func (x *PutPreferencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[83]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5189,7 +6556,7 @@ func (x *PutPreferencesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutPreferencesResponse.ProtoReflect.Descriptor instead.
 func (*PutPreferencesResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{83}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *PutPreferencesResponse) GetPreferences() *GetPreferencesResponse {
@@ -5209,7 +6576,7 @@ type SavedQuery struct {
 
 func (x *SavedQuery) Reset() {
 	*x = SavedQuery{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[84]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5221,7 +6588,7 @@ func (x *SavedQuery) String() string {
 func (*SavedQuery) ProtoMessage() {}
 
 func (x *SavedQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[84]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5234,7 +6601,7 @@ func (x *SavedQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SavedQuery.ProtoReflect.Descriptor instead.
 func (*SavedQuery) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{84}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *SavedQuery) GetName() string {
@@ -5259,7 +6626,7 @@ type GetSavedQueriesRequest struct {
 
 func (x *GetSavedQueriesRequest) Reset() {
 	*x = GetSavedQueriesRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[85]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5271,7 +6638,7 @@ func (x *GetSavedQueriesRequest) String() string {
 func (*GetSavedQueriesRequest) ProtoMessage() {}
 
 func (x *GetSavedQueriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[85]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5284,7 +6651,7 @@ func (x *GetSavedQueriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSavedQueriesRequest.ProtoReflect.Descriptor instead.
 func (*GetSavedQueriesRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{85}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{107}
 }
 
 type GetSavedQueriesResponse struct {
@@ -5296,7 +6663,7 @@ type GetSavedQueriesResponse struct {
 
 func (x *GetSavedQueriesResponse) Reset() {
 	*x = GetSavedQueriesResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[86]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5308,7 +6675,7 @@ func (x *GetSavedQueriesResponse) String() string {
 func (*GetSavedQueriesResponse) ProtoMessage() {}
 
 func (x *GetSavedQueriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[86]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5321,7 +6688,7 @@ func (x *GetSavedQueriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSavedQueriesResponse.ProtoReflect.Descriptor instead.
 func (*GetSavedQueriesResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{86}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *GetSavedQueriesResponse) GetQueries() []*SavedQuery {
@@ -5340,7 +6707,7 @@ type PutSavedQueryRequest struct {
 
 func (x *PutSavedQueryRequest) Reset() {
 	*x = PutSavedQueryRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[87]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5352,7 +6719,7 @@ func (x *PutSavedQueryRequest) String() string {
 func (*PutSavedQueryRequest) ProtoMessage() {}
 
 func (x *PutSavedQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[87]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5365,7 +6732,7 @@ func (x *PutSavedQueryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutSavedQueryRequest.ProtoReflect.Descriptor instead.
 func (*PutSavedQueryRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{87}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *PutSavedQueryRequest) GetQuery() *SavedQuery {
@@ -5384,7 +6751,7 @@ type PutSavedQueryResponse struct {
 
 func (x *PutSavedQueryResponse) Reset() {
 	*x = PutSavedQueryResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[88]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5396,7 +6763,7 @@ func (x *PutSavedQueryResponse) String() string {
 func (*PutSavedQueryResponse) ProtoMessage() {}
 
 func (x *PutSavedQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[88]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5409,7 +6776,7 @@ func (x *PutSavedQueryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutSavedQueryResponse.ProtoReflect.Descriptor instead.
 func (*PutSavedQueryResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{88}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *PutSavedQueryResponse) GetSavedQueries() *GetSavedQueriesResponse {
@@ -5428,7 +6795,7 @@ type DeleteSavedQueryRequest struct {
 
 func (x *DeleteSavedQueryRequest) Reset() {
 	*x = DeleteSavedQueryRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[89]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5440,7 +6807,7 @@ func (x *DeleteSavedQueryRequest) String() string {
 func (*DeleteSavedQueryRequest) ProtoMessage() {}
 
 func (x *DeleteSavedQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[89]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5453,7 +6820,7 @@ func (x *DeleteSavedQueryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSavedQueryRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSavedQueryRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{89}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *DeleteSavedQueryRequest) GetName() string {
@@ -5472,7 +6839,7 @@ type DeleteSavedQueryResponse struct {
 
 func (x *DeleteSavedQueryResponse) Reset() {
 	*x = DeleteSavedQueryResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[90]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5484,7 +6851,7 @@ func (x *DeleteSavedQueryResponse) String() string {
 func (*DeleteSavedQueryResponse) ProtoMessage() {}
 
 func (x *DeleteSavedQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[90]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5497,7 +6864,7 @@ func (x *DeleteSavedQueryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSavedQueryResponse.ProtoReflect.Descriptor instead.
 func (*DeleteSavedQueryResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{90}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *DeleteSavedQueryResponse) GetSavedQueries() *GetSavedQueriesResponse {
@@ -5515,7 +6882,7 @@ type ListCertificatesRequest struct {
 
 func (x *ListCertificatesRequest) Reset() {
 	*x = ListCertificatesRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[91]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5527,7 +6894,7 @@ func (x *ListCertificatesRequest) String() string {
 func (*ListCertificatesRequest) ProtoMessage() {}
 
 func (x *ListCertificatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[91]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5540,7 +6907,7 @@ func (x *ListCertificatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCertificatesRequest.ProtoReflect.Descriptor instead.
 func (*ListCertificatesRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{91}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{113}
 }
 
 type ListCertificatesResponse struct {
@@ -5552,7 +6919,7 @@ type ListCertificatesResponse struct {
 
 func (x *ListCertificatesResponse) Reset() {
 	*x = ListCertificatesResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[92]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5564,7 +6931,7 @@ func (x *ListCertificatesResponse) String() string {
 func (*ListCertificatesResponse) ProtoMessage() {}
 
 func (x *ListCertificatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[92]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5577,7 +6944,7 @@ func (x *ListCertificatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCertificatesResponse.ProtoReflect.Descriptor instead.
 func (*ListCertificatesResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{92}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *ListCertificatesResponse) GetCertificates() []*CertificateInfo {
@@ -5597,7 +6964,7 @@ type CertificateInfo struct {
 
 func (x *CertificateInfo) Reset() {
 	*x = CertificateInfo{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[93]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5609,7 +6976,7 @@ func (x *CertificateInfo) String() string {
 func (*CertificateInfo) ProtoMessage() {}
 
 func (x *CertificateInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[93]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5622,7 +6989,7 @@ func (x *CertificateInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CertificateInfo.ProtoReflect.Descriptor instead.
 func (*CertificateInfo) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{93}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *CertificateInfo) GetId() []byte {
@@ -5648,7 +7015,7 @@ type GetCertificateRequest struct {
 
 func (x *GetCertificateRequest) Reset() {
 	*x = GetCertificateRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[94]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5660,7 +7027,7 @@ func (x *GetCertificateRequest) String() string {
 func (*GetCertificateRequest) ProtoMessage() {}
 
 func (x *GetCertificateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[94]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5673,7 +7040,7 @@ func (x *GetCertificateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCertificateRequest.ProtoReflect.Descriptor instead.
 func (*GetCertificateRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{94}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *GetCertificateRequest) GetId() []byte {
@@ -5698,7 +7065,7 @@ type GetCertificateResponse struct {
 
 func (x *GetCertificateResponse) Reset() {
 	*x = GetCertificateResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[95]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5710,7 +7077,7 @@ func (x *GetCertificateResponse) String() string {
 func (*GetCertificateResponse) ProtoMessage() {}
 
 func (x *GetCertificateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[95]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5723,7 +7090,7 @@ func (x *GetCertificateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCertificateResponse.ProtoReflect.Descriptor instead.
 func (*GetCertificateResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{95}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *GetCertificateResponse) GetId() []byte {
@@ -5783,7 +7150,7 @@ type PutCertificateRequest struct {
 
 func (x *PutCertificateRequest) Reset() {
 	*x = PutCertificateRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[96]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5795,7 +7162,7 @@ func (x *PutCertificateRequest) String() string {
 func (*PutCertificateRequest) ProtoMessage() {}
 
 func (x *PutCertificateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[96]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5808,7 +7175,7 @@ func (x *PutCertificateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutCertificateRequest.ProtoReflect.Descriptor instead.
 func (*PutCertificateRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{96}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *PutCertificateRequest) GetId() []byte {
@@ -5869,7 +7236,7 @@ type PutCertificateResponse struct {
 
 func (x *PutCertificateResponse) Reset() {
 	*x = PutCertificateResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[97]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5881,7 +7248,7 @@ func (x *PutCertificateResponse) String() string {
 func (*PutCertificateResponse) ProtoMessage() {}
 
 func (x *PutCertificateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[97]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5894,7 +7261,7 @@ func (x *PutCertificateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutCertificateResponse.ProtoReflect.Descriptor instead.
 func (*PutCertificateResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{97}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *PutCertificateResponse) GetSystem() *GetSystemResponse {
@@ -5913,7 +7280,7 @@ type DeleteCertificateRequest struct {
 
 func (x *DeleteCertificateRequest) Reset() {
 	*x = DeleteCertificateRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[98]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5925,7 +7292,7 @@ func (x *DeleteCertificateRequest) String() string {
 func (*DeleteCertificateRequest) ProtoMessage() {}
 
 func (x *DeleteCertificateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[98]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5938,7 +7305,7 @@ func (x *DeleteCertificateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCertificateRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCertificateRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{98}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *DeleteCertificateRequest) GetId() []byte {
@@ -5957,7 +7324,7 @@ type DeleteCertificateResponse struct {
 
 func (x *DeleteCertificateResponse) Reset() {
 	*x = DeleteCertificateResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[99]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5969,7 +7336,7 @@ func (x *DeleteCertificateResponse) String() string {
 func (*DeleteCertificateResponse) ProtoMessage() {}
 
 func (x *DeleteCertificateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[99]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5982,7 +7349,7 @@ func (x *DeleteCertificateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCertificateResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCertificateResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{99}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{121}
 }
 
 func (x *DeleteCertificateResponse) GetSystem() *GetSystemResponse {
@@ -6001,7 +7368,7 @@ type PauseVaultRequest struct {
 
 func (x *PauseVaultRequest) Reset() {
 	*x = PauseVaultRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[100]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6013,7 +7380,7 @@ func (x *PauseVaultRequest) String() string {
 func (*PauseVaultRequest) ProtoMessage() {}
 
 func (x *PauseVaultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[100]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6026,7 +7393,7 @@ func (x *PauseVaultRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PauseVaultRequest.ProtoReflect.Descriptor instead.
 func (*PauseVaultRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{100}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *PauseVaultRequest) GetId() []byte {
@@ -6045,7 +7412,7 @@ type PauseVaultResponse struct {
 
 func (x *PauseVaultResponse) Reset() {
 	*x = PauseVaultResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[101]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6057,7 +7424,7 @@ func (x *PauseVaultResponse) String() string {
 func (*PauseVaultResponse) ProtoMessage() {}
 
 func (x *PauseVaultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[101]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6070,7 +7437,7 @@ func (x *PauseVaultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PauseVaultResponse.ProtoReflect.Descriptor instead.
 func (*PauseVaultResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{101}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *PauseVaultResponse) GetSystem() *GetSystemResponse {
@@ -6089,7 +7456,7 @@ type ResumeVaultRequest struct {
 
 func (x *ResumeVaultRequest) Reset() {
 	*x = ResumeVaultRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[102]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6101,7 +7468,7 @@ func (x *ResumeVaultRequest) String() string {
 func (*ResumeVaultRequest) ProtoMessage() {}
 
 func (x *ResumeVaultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[102]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6114,7 +7481,7 @@ func (x *ResumeVaultRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResumeVaultRequest.ProtoReflect.Descriptor instead.
 func (*ResumeVaultRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{102}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{124}
 }
 
 func (x *ResumeVaultRequest) GetId() []byte {
@@ -6133,7 +7500,7 @@ type ResumeVaultResponse struct {
 
 func (x *ResumeVaultResponse) Reset() {
 	*x = ResumeVaultResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[103]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6145,7 +7512,7 @@ func (x *ResumeVaultResponse) String() string {
 func (*ResumeVaultResponse) ProtoMessage() {}
 
 func (x *ResumeVaultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[103]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6158,7 +7525,7 @@ func (x *ResumeVaultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResumeVaultResponse.ProtoReflect.Descriptor instead.
 func (*ResumeVaultResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{103}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{125}
 }
 
 func (x *ResumeVaultResponse) GetSystem() *GetSystemResponse {
@@ -6181,7 +7548,7 @@ type TestIngesterRequest struct {
 
 func (x *TestIngesterRequest) Reset() {
 	*x = TestIngesterRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[104]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6193,7 +7560,7 @@ func (x *TestIngesterRequest) String() string {
 func (*TestIngesterRequest) ProtoMessage() {}
 
 func (x *TestIngesterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[104]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6206,7 +7573,7 @@ func (x *TestIngesterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestIngesterRequest.ProtoReflect.Descriptor instead.
 func (*TestIngesterRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{104}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{126}
 }
 
 func (x *TestIngesterRequest) GetType() string {
@@ -6240,7 +7607,7 @@ type TestIngesterResponse struct {
 
 func (x *TestIngesterResponse) Reset() {
 	*x = TestIngesterResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[105]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6252,7 +7619,7 @@ func (x *TestIngesterResponse) String() string {
 func (*TestIngesterResponse) ProtoMessage() {}
 
 func (x *TestIngesterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[105]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6265,7 +7632,7 @@ func (x *TestIngesterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestIngesterResponse.ProtoReflect.Descriptor instead.
 func (*TestIngesterResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{105}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{127}
 }
 
 func (x *TestIngesterResponse) GetSuccess() bool {
@@ -6291,7 +7658,7 @@ type TriggerIngesterRequest struct {
 
 func (x *TriggerIngesterRequest) Reset() {
 	*x = TriggerIngesterRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[106]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6303,7 +7670,7 @@ func (x *TriggerIngesterRequest) String() string {
 func (*TriggerIngesterRequest) ProtoMessage() {}
 
 func (x *TriggerIngesterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[106]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6316,7 +7683,7 @@ func (x *TriggerIngesterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerIngesterRequest.ProtoReflect.Descriptor instead.
 func (*TriggerIngesterRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{106}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{128}
 }
 
 func (x *TriggerIngesterRequest) GetId() []byte {
@@ -6334,7 +7701,7 @@ type TriggerIngesterResponse struct {
 
 func (x *TriggerIngesterResponse) Reset() {
 	*x = TriggerIngesterResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[107]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6346,7 +7713,7 @@ func (x *TriggerIngesterResponse) String() string {
 func (*TriggerIngesterResponse) ProtoMessage() {}
 
 func (x *TriggerIngesterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[107]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6359,7 +7726,7 @@ func (x *TriggerIngesterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerIngesterResponse.ProtoReflect.Descriptor instead.
 func (*TriggerIngesterResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{107}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{129}
 }
 
 type TestCloudServiceRequest struct {
@@ -6372,7 +7739,7 @@ type TestCloudServiceRequest struct {
 
 func (x *TestCloudServiceRequest) Reset() {
 	*x = TestCloudServiceRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[108]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6384,7 +7751,7 @@ func (x *TestCloudServiceRequest) String() string {
 func (*TestCloudServiceRequest) ProtoMessage() {}
 
 func (x *TestCloudServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[108]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6397,7 +7764,7 @@ func (x *TestCloudServiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestCloudServiceRequest.ProtoReflect.Descriptor instead.
 func (*TestCloudServiceRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{108}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{130}
 }
 
 func (x *TestCloudServiceRequest) GetType() string {
@@ -6424,7 +7791,7 @@ type TestCloudServiceResponse struct {
 
 func (x *TestCloudServiceResponse) Reset() {
 	*x = TestCloudServiceResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[109]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6436,7 +7803,7 @@ func (x *TestCloudServiceResponse) String() string {
 func (*TestCloudServiceResponse) ProtoMessage() {}
 
 func (x *TestCloudServiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[109]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6449,7 +7816,7 @@ func (x *TestCloudServiceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestCloudServiceResponse.ProtoReflect.Descriptor instead.
 func (*TestCloudServiceResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{109}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{131}
 }
 
 func (x *TestCloudServiceResponse) GetSuccess() bool {
@@ -6474,7 +7841,7 @@ type GetIngesterDefaultsRequest struct {
 
 func (x *GetIngesterDefaultsRequest) Reset() {
 	*x = GetIngesterDefaultsRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[110]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6486,7 +7853,7 @@ func (x *GetIngesterDefaultsRequest) String() string {
 func (*GetIngesterDefaultsRequest) ProtoMessage() {}
 
 func (x *GetIngesterDefaultsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[110]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6499,7 +7866,7 @@ func (x *GetIngesterDefaultsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetIngesterDefaultsRequest.ProtoReflect.Descriptor instead.
 func (*GetIngesterDefaultsRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{110}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{132}
 }
 
 type IngesterTypeDefaults struct {
@@ -6513,7 +7880,7 @@ type IngesterTypeDefaults struct {
 
 func (x *IngesterTypeDefaults) Reset() {
 	*x = IngesterTypeDefaults{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[111]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6525,7 +7892,7 @@ func (x *IngesterTypeDefaults) String() string {
 func (*IngesterTypeDefaults) ProtoMessage() {}
 
 func (x *IngesterTypeDefaults) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[111]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6538,7 +7905,7 @@ func (x *IngesterTypeDefaults) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IngesterTypeDefaults.ProtoReflect.Descriptor instead.
 func (*IngesterTypeDefaults) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{111}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *IngesterTypeDefaults) GetParams() map[string]string {
@@ -6571,7 +7938,7 @@ type GetIngesterDefaultsResponse struct {
 
 func (x *GetIngesterDefaultsResponse) Reset() {
 	*x = GetIngesterDefaultsResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[112]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6583,7 +7950,7 @@ func (x *GetIngesterDefaultsResponse) String() string {
 func (*GetIngesterDefaultsResponse) ProtoMessage() {}
 
 func (x *GetIngesterDefaultsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[112]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6596,7 +7963,7 @@ func (x *GetIngesterDefaultsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetIngesterDefaultsResponse.ProtoReflect.Descriptor instead.
 func (*GetIngesterDefaultsResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{112}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *GetIngesterDefaultsResponse) GetTypes() map[string]*IngesterTypeDefaults {
@@ -6616,7 +7983,7 @@ type NodeConfig struct {
 
 func (x *NodeConfig) Reset() {
 	*x = NodeConfig{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[113]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6628,7 +7995,7 @@ func (x *NodeConfig) String() string {
 func (*NodeConfig) ProtoMessage() {}
 
 func (x *NodeConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[113]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6641,7 +8008,7 @@ func (x *NodeConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeConfig.ProtoReflect.Descriptor instead.
 func (*NodeConfig) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{113}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *NodeConfig) GetId() []byte {
@@ -6684,7 +8051,7 @@ type TierConfig struct {
 
 func (x *TierConfig) Reset() {
 	*x = TierConfig{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[114]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6696,7 +8063,7 @@ func (x *TierConfig) String() string {
 func (*TierConfig) ProtoMessage() {}
 
 func (x *TierConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[114]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6709,7 +8076,7 @@ func (x *TierConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TierConfig.ProtoReflect.Descriptor instead.
 func (*TierConfig) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{114}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *TierConfig) GetId() []byte {
@@ -6836,7 +8203,7 @@ type TierPlacement struct {
 
 func (x *TierPlacement) Reset() {
 	*x = TierPlacement{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[115]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6848,7 +8215,7 @@ func (x *TierPlacement) String() string {
 func (*TierPlacement) ProtoMessage() {}
 
 func (x *TierPlacement) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[115]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6861,7 +8228,7 @@ func (x *TierPlacement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TierPlacement.ProtoReflect.Descriptor instead.
 func (*TierPlacement) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{115}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{137}
 }
 
 func (x *TierPlacement) GetStorageId() []byte {
@@ -6887,7 +8254,7 @@ type PutNodeConfigRequest struct {
 
 func (x *PutNodeConfigRequest) Reset() {
 	*x = PutNodeConfigRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[116]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6899,7 +8266,7 @@ func (x *PutNodeConfigRequest) String() string {
 func (*PutNodeConfigRequest) ProtoMessage() {}
 
 func (x *PutNodeConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[116]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6912,7 +8279,7 @@ func (x *PutNodeConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutNodeConfigRequest.ProtoReflect.Descriptor instead.
 func (*PutNodeConfigRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{116}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *PutNodeConfigRequest) GetConfig() *NodeConfig {
@@ -6931,7 +8298,7 @@ type PutNodeConfigResponse struct {
 
 func (x *PutNodeConfigResponse) Reset() {
 	*x = PutNodeConfigResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[117]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6943,7 +8310,7 @@ func (x *PutNodeConfigResponse) String() string {
 func (*PutNodeConfigResponse) ProtoMessage() {}
 
 func (x *PutNodeConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[117]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6956,7 +8323,7 @@ func (x *PutNodeConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutNodeConfigResponse.ProtoReflect.Descriptor instead.
 func (*PutNodeConfigResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{117}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{139}
 }
 
 func (x *PutNodeConfigResponse) GetSystem() *GetSystemResponse {
@@ -6974,7 +8341,7 @@ type GenerateNameRequest struct {
 
 func (x *GenerateNameRequest) Reset() {
 	*x = GenerateNameRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[118]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6986,7 +8353,7 @@ func (x *GenerateNameRequest) String() string {
 func (*GenerateNameRequest) ProtoMessage() {}
 
 func (x *GenerateNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[118]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6999,7 +8366,7 @@ func (x *GenerateNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateNameRequest.ProtoReflect.Descriptor instead.
 func (*GenerateNameRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{118}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{140}
 }
 
 type GenerateNameResponse struct {
@@ -7011,7 +8378,7 @@ type GenerateNameResponse struct {
 
 func (x *GenerateNameResponse) Reset() {
 	*x = GenerateNameResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[119]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7023,7 +8390,7 @@ func (x *GenerateNameResponse) String() string {
 func (*GenerateNameResponse) ProtoMessage() {}
 
 func (x *GenerateNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[119]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7036,7 +8403,7 @@ func (x *GenerateNameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateNameResponse.ProtoReflect.Descriptor instead.
 func (*GenerateNameResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{119}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{141}
 }
 
 func (x *GenerateNameResponse) GetName() string {
@@ -7054,7 +8421,7 @@ type WatchSystemRequest struct {
 
 func (x *WatchSystemRequest) Reset() {
 	*x = WatchSystemRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[120]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7066,7 +8433,7 @@ func (x *WatchSystemRequest) String() string {
 func (*WatchSystemRequest) ProtoMessage() {}
 
 func (x *WatchSystemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[120]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7079,7 +8446,7 @@ func (x *WatchSystemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchSystemRequest.ProtoReflect.Descriptor instead.
 func (*WatchSystemRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{120}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{142}
 }
 
 type WatchSystemResponse struct {
@@ -7094,7 +8461,7 @@ type WatchSystemResponse struct {
 
 func (x *WatchSystemResponse) Reset() {
 	*x = WatchSystemResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[121]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7106,7 +8473,7 @@ func (x *WatchSystemResponse) String() string {
 func (*WatchSystemResponse) ProtoMessage() {}
 
 func (x *WatchSystemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[121]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7119,7 +8486,7 @@ func (x *WatchSystemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchSystemResponse.ProtoReflect.Descriptor instead.
 func (*WatchSystemResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{121}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{143}
 }
 
 func (x *WatchSystemResponse) GetSystemRaftIndex() uint64 {
@@ -7137,7 +8504,7 @@ type GetRouteStatsRequest struct {
 
 func (x *GetRouteStatsRequest) Reset() {
 	*x = GetRouteStatsRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[122]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7149,7 +8516,7 @@ func (x *GetRouteStatsRequest) String() string {
 func (*GetRouteStatsRequest) ProtoMessage() {}
 
 func (x *GetRouteStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[122]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7162,7 +8529,7 @@ func (x *GetRouteStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRouteStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetRouteStatsRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{122}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{144}
 }
 
 type GetRouteStatsResponse struct {
@@ -7182,7 +8549,7 @@ type GetRouteStatsResponse struct {
 
 func (x *GetRouteStatsResponse) Reset() {
 	*x = GetRouteStatsResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[123]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7194,7 +8561,7 @@ func (x *GetRouteStatsResponse) String() string {
 func (*GetRouteStatsResponse) ProtoMessage() {}
 
 func (x *GetRouteStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[123]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7207,7 +8574,7 @@ func (x *GetRouteStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRouteStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetRouteStatsResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{123}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{145}
 }
 
 func (x *GetRouteStatsResponse) GetTotalIngested() int64 {
@@ -7263,7 +8630,7 @@ type VaultRouteStats struct {
 
 func (x *VaultRouteStats) Reset() {
 	*x = VaultRouteStats{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[124]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7275,7 +8642,7 @@ func (x *VaultRouteStats) String() string {
 func (*VaultRouteStats) ProtoMessage() {}
 
 func (x *VaultRouteStats) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[124]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7288,7 +8655,7 @@ func (x *VaultRouteStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VaultRouteStats.ProtoReflect.Descriptor instead.
 func (*VaultRouteStats) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{124}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{146}
 }
 
 func (x *VaultRouteStats) GetVaultId() []byte {
@@ -7317,13 +8684,14 @@ type PerRouteStats struct {
 	RouteId          []byte                 `protobuf:"bytes,1,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
 	RecordsMatched   int64                  `protobuf:"varint,2,opt,name=records_matched,json=recordsMatched,proto3" json:"records_matched,omitempty"`       // records matched by this route
 	RecordsForwarded int64                  `protobuf:"varint,3,opt,name=records_forwarded,json=recordsForwarded,proto3" json:"records_forwarded,omitempty"` // subset sent to remote nodes
+	RecordsDropped   int64                  `protobuf:"varint,4,opt,name=records_dropped,json=recordsDropped,proto3" json:"records_dropped,omitempty"`       // records discarded by the route's pipeline
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *PerRouteStats) Reset() {
 	*x = PerRouteStats{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[125]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7335,7 +8703,7 @@ func (x *PerRouteStats) String() string {
 func (*PerRouteStats) ProtoMessage() {}
 
 func (x *PerRouteStats) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[125]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7348,7 +8716,7 @@ func (x *PerRouteStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PerRouteStats.ProtoReflect.Descriptor instead.
 func (*PerRouteStats) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{125}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{147}
 }
 
 func (x *PerRouteStats) GetRouteId() []byte {
@@ -7372,6 +8740,13 @@ func (x *PerRouteStats) GetRecordsForwarded() int64 {
 	return 0
 }
 
+func (x *PerRouteStats) GetRecordsDropped() int64 {
+	if x != nil {
+		return x.RecordsDropped
+	}
+	return 0
+}
+
 type ManagedFileInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -7385,7 +8760,7 @@ type ManagedFileInfo struct {
 
 func (x *ManagedFileInfo) Reset() {
 	*x = ManagedFileInfo{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[126]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7397,7 +8772,7 @@ func (x *ManagedFileInfo) String() string {
 func (*ManagedFileInfo) ProtoMessage() {}
 
 func (x *ManagedFileInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[126]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7410,7 +8785,7 @@ func (x *ManagedFileInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ManagedFileInfo.ProtoReflect.Descriptor instead.
 func (*ManagedFileInfo) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{126}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{148}
 }
 
 func (x *ManagedFileInfo) GetId() []byte {
@@ -7456,7 +8831,7 @@ type ListManagedFilesRequest struct {
 
 func (x *ListManagedFilesRequest) Reset() {
 	*x = ListManagedFilesRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[127]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7468,7 +8843,7 @@ func (x *ListManagedFilesRequest) String() string {
 func (*ListManagedFilesRequest) ProtoMessage() {}
 
 func (x *ListManagedFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[127]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7481,7 +8856,7 @@ func (x *ListManagedFilesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListManagedFilesRequest.ProtoReflect.Descriptor instead.
 func (*ListManagedFilesRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{127}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{149}
 }
 
 type ListManagedFilesResponse struct {
@@ -7493,7 +8868,7 @@ type ListManagedFilesResponse struct {
 
 func (x *ListManagedFilesResponse) Reset() {
 	*x = ListManagedFilesResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[128]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7505,7 +8880,7 @@ func (x *ListManagedFilesResponse) String() string {
 func (*ListManagedFilesResponse) ProtoMessage() {}
 
 func (x *ListManagedFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[128]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7518,7 +8893,7 @@ func (x *ListManagedFilesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListManagedFilesResponse.ProtoReflect.Descriptor instead.
 func (*ListManagedFilesResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{128}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{150}
 }
 
 func (x *ListManagedFilesResponse) GetFiles() []*ManagedFileInfo {
@@ -7537,7 +8912,7 @@ type DeleteManagedFileRequest struct {
 
 func (x *DeleteManagedFileRequest) Reset() {
 	*x = DeleteManagedFileRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[129]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7549,7 +8924,7 @@ func (x *DeleteManagedFileRequest) String() string {
 func (*DeleteManagedFileRequest) ProtoMessage() {}
 
 func (x *DeleteManagedFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[129]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7562,7 +8937,7 @@ func (x *DeleteManagedFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteManagedFileRequest.ProtoReflect.Descriptor instead.
 func (*DeleteManagedFileRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{129}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{151}
 }
 
 func (x *DeleteManagedFileRequest) GetId() []byte {
@@ -7580,7 +8955,7 @@ type DeleteManagedFileResponse struct {
 
 func (x *DeleteManagedFileResponse) Reset() {
 	*x = DeleteManagedFileResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[130]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7592,7 +8967,7 @@ func (x *DeleteManagedFileResponse) String() string {
 func (*DeleteManagedFileResponse) ProtoMessage() {}
 
 func (x *DeleteManagedFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[130]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7605,7 +8980,7 @@ func (x *DeleteManagedFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteManagedFileResponse.ProtoReflect.Descriptor instead.
 func (*DeleteManagedFileResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{130}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{152}
 }
 
 type TestHTTPLookupRequest struct {
@@ -7618,7 +8993,7 @@ type TestHTTPLookupRequest struct {
 
 func (x *TestHTTPLookupRequest) Reset() {
 	*x = TestHTTPLookupRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[131]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7630,7 +9005,7 @@ func (x *TestHTTPLookupRequest) String() string {
 func (*TestHTTPLookupRequest) ProtoMessage() {}
 
 func (x *TestHTTPLookupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[131]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7643,7 +9018,7 @@ func (x *TestHTTPLookupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestHTTPLookupRequest.ProtoReflect.Descriptor instead.
 func (*TestHTTPLookupRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{131}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{153}
 }
 
 func (x *TestHTTPLookupRequest) GetConfig() *HTTPLookupEntry {
@@ -7671,7 +9046,7 @@ type TestHTTPLookupResponse struct {
 
 func (x *TestHTTPLookupResponse) Reset() {
 	*x = TestHTTPLookupResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[132]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7683,7 +9058,7 @@ func (x *TestHTTPLookupResponse) String() string {
 func (*TestHTTPLookupResponse) ProtoMessage() {}
 
 func (x *TestHTTPLookupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[132]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7696,7 +9071,7 @@ func (x *TestHTTPLookupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestHTTPLookupResponse.ProtoReflect.Descriptor instead.
 func (*TestHTTPLookupResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{132}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{154}
 }
 
 func (x *TestHTTPLookupResponse) GetSuccess() bool {
@@ -7731,7 +9106,7 @@ type TestHTTPLookupResult struct {
 
 func (x *TestHTTPLookupResult) Reset() {
 	*x = TestHTTPLookupResult{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[133]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7743,7 +9118,7 @@ func (x *TestHTTPLookupResult) String() string {
 func (*TestHTTPLookupResult) ProtoMessage() {}
 
 func (x *TestHTTPLookupResult) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[133]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7756,7 +9131,7 @@ func (x *TestHTTPLookupResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestHTTPLookupResult.ProtoReflect.Descriptor instead.
 func (*TestHTTPLookupResult) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{133}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{155}
 }
 
 func (x *TestHTTPLookupResult) GetLabel() string {
@@ -7792,7 +9167,7 @@ type PreviewCSVLookupRequest struct {
 
 func (x *PreviewCSVLookupRequest) Reset() {
 	*x = PreviewCSVLookupRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[134]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7804,7 +9179,7 @@ func (x *PreviewCSVLookupRequest) String() string {
 func (*PreviewCSVLookupRequest) ProtoMessage() {}
 
 func (x *PreviewCSVLookupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[134]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7817,7 +9192,7 @@ func (x *PreviewCSVLookupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PreviewCSVLookupRequest.ProtoReflect.Descriptor instead.
 func (*PreviewCSVLookupRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{134}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{156}
 }
 
 func (x *PreviewCSVLookupRequest) GetFileId() []byte {
@@ -7861,7 +9236,7 @@ type PreviewCSVLookupResponse struct {
 
 func (x *PreviewCSVLookupResponse) Reset() {
 	*x = PreviewCSVLookupResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[135]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7873,7 +9248,7 @@ func (x *PreviewCSVLookupResponse) String() string {
 func (*PreviewCSVLookupResponse) ProtoMessage() {}
 
 func (x *PreviewCSVLookupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[135]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7886,7 +9261,7 @@ func (x *PreviewCSVLookupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PreviewCSVLookupResponse.ProtoReflect.Descriptor instead.
 func (*PreviewCSVLookupResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{135}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{157}
 }
 
 func (x *PreviewCSVLookupResponse) GetColumns() []string {
@@ -7933,7 +9308,7 @@ type CSVPreviewRow struct {
 
 func (x *CSVPreviewRow) Reset() {
 	*x = CSVPreviewRow{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[136]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7945,7 +9320,7 @@ func (x *CSVPreviewRow) String() string {
 func (*CSVPreviewRow) ProtoMessage() {}
 
 func (x *CSVPreviewRow) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[136]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7958,7 +9333,7 @@ func (x *CSVPreviewRow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CSVPreviewRow.ProtoReflect.Descriptor instead.
 func (*CSVPreviewRow) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{136}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{158}
 }
 
 func (x *CSVPreviewRow) GetValues() []string {
@@ -7980,7 +9355,7 @@ type PreviewJSONLookupRequest struct {
 
 func (x *PreviewJSONLookupRequest) Reset() {
 	*x = PreviewJSONLookupRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[137]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7992,7 +9367,7 @@ func (x *PreviewJSONLookupRequest) String() string {
 func (*PreviewJSONLookupRequest) ProtoMessage() {}
 
 func (x *PreviewJSONLookupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[137]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8005,7 +9380,7 @@ func (x *PreviewJSONLookupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PreviewJSONLookupRequest.ProtoReflect.Descriptor instead.
 func (*PreviewJSONLookupRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{137}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{159}
 }
 
 func (x *PreviewJSONLookupRequest) GetFileId() []byte {
@@ -8050,7 +9425,7 @@ type PreviewJSONLookupResponse struct {
 
 func (x *PreviewJSONLookupResponse) Reset() {
 	*x = PreviewJSONLookupResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[138]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8062,7 +9437,7 @@ func (x *PreviewJSONLookupResponse) String() string {
 func (*PreviewJSONLookupResponse) ProtoMessage() {}
 
 func (x *PreviewJSONLookupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[138]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8075,7 +9450,7 @@ func (x *PreviewJSONLookupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PreviewJSONLookupResponse.ProtoReflect.Descriptor instead.
 func (*PreviewJSONLookupResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{138}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{160}
 }
 
 func (x *PreviewJSONLookupResponse) GetContent() string {
@@ -8132,7 +9507,7 @@ type PreviewYAMLLookupRequest struct {
 
 func (x *PreviewYAMLLookupRequest) Reset() {
 	*x = PreviewYAMLLookupRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[139]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8144,7 +9519,7 @@ func (x *PreviewYAMLLookupRequest) String() string {
 func (*PreviewYAMLLookupRequest) ProtoMessage() {}
 
 func (x *PreviewYAMLLookupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[139]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8157,7 +9532,7 @@ func (x *PreviewYAMLLookupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PreviewYAMLLookupRequest.ProtoReflect.Descriptor instead.
 func (*PreviewYAMLLookupRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{139}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{161}
 }
 
 func (x *PreviewYAMLLookupRequest) GetFileId() []byte {
@@ -8202,7 +9577,7 @@ type PreviewYAMLLookupResponse struct {
 
 func (x *PreviewYAMLLookupResponse) Reset() {
 	*x = PreviewYAMLLookupResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[140]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8214,7 +9589,7 @@ func (x *PreviewYAMLLookupResponse) String() string {
 func (*PreviewYAMLLookupResponse) ProtoMessage() {}
 
 func (x *PreviewYAMLLookupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[140]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8227,7 +9602,7 @@ func (x *PreviewYAMLLookupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PreviewYAMLLookupResponse.ProtoReflect.Descriptor instead.
 func (*PreviewYAMLLookupResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{140}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{162}
 }
 
 func (x *PreviewYAMLLookupResponse) GetContent() string {
@@ -8281,7 +9656,7 @@ type PutCloudServiceRequest struct {
 
 func (x *PutCloudServiceRequest) Reset() {
 	*x = PutCloudServiceRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[141]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8293,7 +9668,7 @@ func (x *PutCloudServiceRequest) String() string {
 func (*PutCloudServiceRequest) ProtoMessage() {}
 
 func (x *PutCloudServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[141]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8306,7 +9681,7 @@ func (x *PutCloudServiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutCloudServiceRequest.ProtoReflect.Descriptor instead.
 func (*PutCloudServiceRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{141}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{163}
 }
 
 func (x *PutCloudServiceRequest) GetConfig() *CloudService {
@@ -8325,7 +9700,7 @@ type PutCloudServiceResponse struct {
 
 func (x *PutCloudServiceResponse) Reset() {
 	*x = PutCloudServiceResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[142]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8337,7 +9712,7 @@ func (x *PutCloudServiceResponse) String() string {
 func (*PutCloudServiceResponse) ProtoMessage() {}
 
 func (x *PutCloudServiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[142]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8350,7 +9725,7 @@ func (x *PutCloudServiceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutCloudServiceResponse.ProtoReflect.Descriptor instead.
 func (*PutCloudServiceResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{142}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{164}
 }
 
 func (x *PutCloudServiceResponse) GetSystem() *GetSystemResponse {
@@ -8369,7 +9744,7 @@ type DeleteCloudServiceRequest struct {
 
 func (x *DeleteCloudServiceRequest) Reset() {
 	*x = DeleteCloudServiceRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[143]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8381,7 +9756,7 @@ func (x *DeleteCloudServiceRequest) String() string {
 func (*DeleteCloudServiceRequest) ProtoMessage() {}
 
 func (x *DeleteCloudServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[143]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8394,7 +9769,7 @@ func (x *DeleteCloudServiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCloudServiceRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCloudServiceRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{143}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{165}
 }
 
 func (x *DeleteCloudServiceRequest) GetId() []byte {
@@ -8413,7 +9788,7 @@ type DeleteCloudServiceResponse struct {
 
 func (x *DeleteCloudServiceResponse) Reset() {
 	*x = DeleteCloudServiceResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[144]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8425,7 +9800,7 @@ func (x *DeleteCloudServiceResponse) String() string {
 func (*DeleteCloudServiceResponse) ProtoMessage() {}
 
 func (x *DeleteCloudServiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[144]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8438,7 +9813,7 @@ func (x *DeleteCloudServiceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCloudServiceResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCloudServiceResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{144}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{166}
 }
 
 func (x *DeleteCloudServiceResponse) GetSystem() *GetSystemResponse {
@@ -8457,7 +9832,7 @@ type SetNodeStorageConfigRequest struct {
 
 func (x *SetNodeStorageConfigRequest) Reset() {
 	*x = SetNodeStorageConfigRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[145]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8469,7 +9844,7 @@ func (x *SetNodeStorageConfigRequest) String() string {
 func (*SetNodeStorageConfigRequest) ProtoMessage() {}
 
 func (x *SetNodeStorageConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[145]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8482,7 +9857,7 @@ func (x *SetNodeStorageConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetNodeStorageConfigRequest.ProtoReflect.Descriptor instead.
 func (*SetNodeStorageConfigRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{145}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{167}
 }
 
 func (x *SetNodeStorageConfigRequest) GetConfig() *NodeStorageConfig {
@@ -8501,7 +9876,7 @@ type SetNodeStorageConfigResponse struct {
 
 func (x *SetNodeStorageConfigResponse) Reset() {
 	*x = SetNodeStorageConfigResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[146]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8513,7 +9888,7 @@ func (x *SetNodeStorageConfigResponse) String() string {
 func (*SetNodeStorageConfigResponse) ProtoMessage() {}
 
 func (x *SetNodeStorageConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[146]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8526,7 +9901,7 @@ func (x *SetNodeStorageConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetNodeStorageConfigResponse.ProtoReflect.Descriptor instead.
 func (*SetNodeStorageConfigResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{146}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{168}
 }
 
 func (x *SetNodeStorageConfigResponse) GetSystem() *GetSystemResponse {
@@ -8545,7 +9920,7 @@ type PutTierRequest struct {
 
 func (x *PutTierRequest) Reset() {
 	*x = PutTierRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[147]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8557,7 +9932,7 @@ func (x *PutTierRequest) String() string {
 func (*PutTierRequest) ProtoMessage() {}
 
 func (x *PutTierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[147]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8570,7 +9945,7 @@ func (x *PutTierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutTierRequest.ProtoReflect.Descriptor instead.
 func (*PutTierRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{147}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{169}
 }
 
 func (x *PutTierRequest) GetConfig() *TierConfig {
@@ -8589,7 +9964,7 @@ type PutTierResponse struct {
 
 func (x *PutTierResponse) Reset() {
 	*x = PutTierResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[148]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8601,7 +9976,7 @@ func (x *PutTierResponse) String() string {
 func (*PutTierResponse) ProtoMessage() {}
 
 func (x *PutTierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[148]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8614,7 +9989,7 @@ func (x *PutTierResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutTierResponse.ProtoReflect.Descriptor instead.
 func (*PutTierResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{148}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{170}
 }
 
 func (x *PutTierResponse) GetSystem() *GetSystemResponse {
@@ -8634,7 +10009,7 @@ type DeleteTierRequest struct {
 
 func (x *DeleteTierRequest) Reset() {
 	*x = DeleteTierRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[149]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8646,7 +10021,7 @@ func (x *DeleteTierRequest) String() string {
 func (*DeleteTierRequest) ProtoMessage() {}
 
 func (x *DeleteTierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[149]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8659,7 +10034,7 @@ func (x *DeleteTierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTierRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTierRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{149}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{171}
 }
 
 func (x *DeleteTierRequest) GetId() []byte {
@@ -8685,7 +10060,7 @@ type DeleteTierResponse struct {
 
 func (x *DeleteTierResponse) Reset() {
 	*x = DeleteTierResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[150]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8697,7 +10072,7 @@ func (x *DeleteTierResponse) String() string {
 func (*DeleteTierResponse) ProtoMessage() {}
 
 func (x *DeleteTierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[150]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8710,7 +10085,7 @@ func (x *DeleteTierResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTierResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTierResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{150}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{172}
 }
 
 func (x *DeleteTierResponse) GetSystem() *GetSystemResponse {
@@ -8729,7 +10104,7 @@ type DeleteLookupRequest struct {
 
 func (x *DeleteLookupRequest) Reset() {
 	*x = DeleteLookupRequest{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[151]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8741,7 +10116,7 @@ func (x *DeleteLookupRequest) String() string {
 func (*DeleteLookupRequest) ProtoMessage() {}
 
 func (x *DeleteLookupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[151]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8754,7 +10129,7 @@ func (x *DeleteLookupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLookupRequest.ProtoReflect.Descriptor instead.
 func (*DeleteLookupRequest) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{151}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{173}
 }
 
 func (x *DeleteLookupRequest) GetName() string {
@@ -8773,7 +10148,7 @@ type DeleteLookupResponse struct {
 
 func (x *DeleteLookupResponse) Reset() {
 	*x = DeleteLookupResponse{}
-	mi := &file_gastrolog_v1_system_proto_msgTypes[152]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8785,7 +10160,7 @@ func (x *DeleteLookupResponse) String() string {
 func (*DeleteLookupResponse) ProtoMessage() {}
 
 func (x *DeleteLookupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastrolog_v1_system_proto_msgTypes[152]
+	mi := &file_gastrolog_v1_system_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8798,7 +10173,7 @@ func (x *DeleteLookupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLookupResponse.ProtoReflect.Descriptor instead.
 func (*DeleteLookupResponse) Descriptor() ([]byte, []int) {
-	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{152}
+	return file_gastrolog_v1_system_proto_rawDescGZIP(), []int{174}
 }
 
 func (x *DeleteLookupResponse) GetEcho() *SettingsMutationEcho {
@@ -8812,8 +10187,8 @@ var File_gastrolog_v1_system_proto protoreflect.FileDescriptor
 
 const file_gastrolog_v1_system_proto_rawDesc = "" +
 	"\n" +
-	"\x19gastrolog/v1/system.proto\x12\fgastrolog.v1\x1a\x1agastrolog/v1/storage.proto\"\x12\n" +
-	"\x10GetSystemRequest\"\x83\x06\n" +
+	"\x19gastrolog/v1/system.proto\x12\fgastrolog.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1agastrolog/v1/storage.proto\"\x12\n" +
+	"\x10GetSystemRequest\"\xf3\x06\n" +
 	"\x11GetSystemResponse\x121\n" +
 	"\x06vaults\x18\x01 \x03(\v2\x19.gastrolog.v1.VaultConfigR\x06vaults\x12:\n" +
 	"\tingesters\x18\x02 \x03(\v2\x1c.gastrolog.v1.IngesterConfigR\tingesters\x12O\n" +
@@ -8827,7 +10202,10 @@ const file_gastrolog_v1_system_proto_rawDesc = "" +
 	"\x0ecloud_services\x18\n" +
 	" \x03(\v2\x1a.gastrolog.v1.CloudServiceR\rcloudServices\x12Q\n" +
 	"\x14node_storage_configs\x18\v \x03(\v2\x1f.gastrolog.v1.NodeStorageConfigR\x12nodeStorageConfigs\x12.\n" +
-	"\x05tiers\x18\f \x03(\v2\x18.gastrolog.v1.TierConfigR\x05tiers\"\xda\x01\n" +
+	"\x05tiers\x18\f \x03(\v2\x18.gastrolog.v1.TierConfigR\x05tiers\x12>\n" +
+	"\valert_rules\x18\r \x03(\v2\x1d.gastrolog.v1.AlertRuleConfigR\n" +
+	"alertRules\x12.\n" +
+	"\x05roles\x18\x0e \x03(\v2\x18.gastrolog.v1.RoleConfigR\x05roles\"\xda\x01\n" +
 	"\rRetentionRule\x12.\n" +
 	"\x13retention_policy_id\x18\x01 \x01(\fR\x11retentionPolicyId\x12\x16\n" +
 	"\x06action\x18\x02 \x01(\tR\x06action\x12%\n" +
@@ -8858,7 +10236,7 @@ const file_gastrolog_v1_system_proto_rawDesc = "" +
 	"\fcache_budget\x18\x0e \x01(\tR\vcacheBudget\x12\x1b\n" +
 	"\tcache_ttl\x18\x0f \x01(\tR\bcacheTtl\"-\n" +
 	"\x10RouteDestination\x12\x19\n" +
-	"\bvault_id\x18\x01 \x01(\fR\avaultId\"\xef\x01\n" +
+	"\bvault_id\x18\x01 \x01(\fR\avaultId\"\xa1\x02\n" +
 	"\vRouteConfig\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\fR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
@@ -8867,7 +10245,15 @@ const file_gastrolog_v1_system_proto_rawDesc = "" +
 	"\fdistribution\x18\x05 \x01(\tR\fdistribution\x12\x18\n" +
 	"\aenabled\x18\x06 \x01(\bR\aenabled\x12\x1d\n" +
 	"\n" +
-	"eject_only\x18\a \x01(\bR\tejectOnly\"\xb5\x02\n" +
+	"eject_only\x18\a \x01(\bR\tejectOnly\x120\n" +
+	"\x06stages\x18\b \x03(\v2\x18.gastrolog.v1.RouteStageR\x06stages\"\x99\x01\n" +
+	"\n" +
+	"RouteStage\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12<\n" +
+	"\x06params\x18\x02 \x03(\v2$.gastrolog.v1.RouteStage.ParamsEntryR\x06params\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb5\x02\n" +
 	"\x0eIngesterConfig\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\fR\x02id\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12@\n" +
@@ -8979,6 +10365,80 @@ const file_gastrolog_v1_system_proto_rawDesc = "" +
 	"\x12DeleteRouteRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\fR\x02id\"N\n" +
 	"\x13DeleteRouteResponse\x127\n" +
+	"\x06system\x18\x01 \x01(\v2\x1f.gastrolog.v1.GetSystemResponseR\x06system\"\xeb\x01\n" +
+	"\x1bPreviewRoutePipelineRequest\x120\n" +
+	"\x06stages\x18\x01 \x03(\v2\x18.gastrolog.v1.RouteStageR\x06stages\x12\x14\n" +
+	"\x05lines\x18\x02 \x03(\tR\x05lines\x12J\n" +
+	"\x05attrs\x18\x03 \x03(\v24.gastrolog.v1.PreviewRoutePipelineRequest.AttrsEntryR\x05attrs\x1a8\n" +
+	"\n" +
+	"AttrsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"q\n" +
+	"\x1cPreviewRoutePipelineResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.gastrolog.v1.RoutePipelineResultR\aresults\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xbf\x01\n" +
+	"\x13RoutePipelineResult\x12\x18\n" +
+	"\adropped\x18\x01 \x01(\bR\adropped\x12\x10\n" +
+	"\x03raw\x18\x02 \x01(\tR\x03raw\x12B\n" +
+	"\x05attrs\x18\x03 \x03(\v2,.gastrolog.v1.RoutePipelineResult.AttrsEntryR\x05attrs\x1a8\n" +
+	"\n" +
+	"AttrsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"V\n" +
+	"\x0eAlertCondition\x12\x16\n" +
+	"\x06column\x18\x01 \x01(\tR\x06column\x12\x0e\n" +
+	"\x02op\x18\x02 \x01(\tR\x02op\x12\x1c\n" +
+	"\tthreshold\x18\x03 \x01(\x01R\tthreshold\"\x97\x01\n" +
+	"\tAlertSink\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12;\n" +
+	"\x06params\x18\x02 \x03(\v2#.gastrolog.v1.AlertSink.ParamsEntryR\x06params\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x88\x02\n" +
+	"\x0fAlertRuleConfig\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\fR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12\x1a\n" +
+	"\bschedule\x18\x04 \x01(\tR\bschedule\x12:\n" +
+	"\tcondition\x18\x05 \x01(\v2\x1c.gastrolog.v1.AlertConditionR\tcondition\x12\x1a\n" +
+	"\bseverity\x18\x06 \x01(\tR\bseverity\x12-\n" +
+	"\x05sinks\x18\a \x03(\v2\x17.gastrolog.v1.AlertSinkR\x05sinks\x12\x18\n" +
+	"\aenabled\x18\b \x01(\bR\aenabled\"L\n" +
+	"\x13PutAlertRuleRequest\x125\n" +
+	"\x06config\x18\x01 \x01(\v2\x1d.gastrolog.v1.AlertRuleConfigR\x06config\"O\n" +
+	"\x14PutAlertRuleResponse\x127\n" +
+	"\x06system\x18\x01 \x01(\v2\x1f.gastrolog.v1.GetSystemResponseR\x06system\"(\n" +
+	"\x16DeleteAlertRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\fR\x02id\"R\n" +
+	"\x17DeleteAlertRuleResponse\x127\n" +
+	"\x06system\x18\x01 \x01(\v2\x1f.gastrolog.v1.GetSystemResponseR\x06system\"\x1b\n" +
+	"\x19GetAlertRuleStatusRequest\"\xaf\x02\n" +
+	"\x0fAlertRuleStatus\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\fR\x06ruleId\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\x12A\n" +
+	"\x0elast_evaluated\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastEvaluated\x12=\n" +
+	"\ffiring_since\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vfiringSince\x12?\n" +
+	"\rlast_resolved\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\flastResolved\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"W\n" +
+	"\x1aGetAlertRuleStatusResponse\x129\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x1d.gastrolog.v1.AlertRuleStatusR\bstatuses\"A\n" +
+	"\n" +
+	"VaultGrant\x12\x19\n" +
+	"\bvault_id\x18\x01 \x01(\fR\avaultId\x12\x18\n" +
+	"\aactions\x18\x02 \x03(\tR\aactions\"b\n" +
+	"\n" +
+	"RoleConfig\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\fR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x120\n" +
+	"\x06grants\x18\x03 \x03(\v2\x18.gastrolog.v1.VaultGrantR\x06grants\"B\n" +
+	"\x0ePutRoleRequest\x120\n" +
+	"\x06config\x18\x01 \x01(\v2\x18.gastrolog.v1.RoleConfigR\x06config\"J\n" +
+	"\x0fPutRoleResponse\x127\n" +
+	"\x06system\x18\x01 \x01(\v2\x1f.gastrolog.v1.GetSystemResponseR\x06system\"#\n" +
+	"\x11DeleteRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\fR\x02id\"M\n" +
+	"\x12DeleteRoleResponse\x127\n" +
 	"\x06system\x18\x01 \x01(\v2\x1f.gastrolog.v1.GetSystemResponseR\x06system\"J\n" +
 	"\x12PutIngesterRequest\x124\n" +
 	"\x06config\x18\x01 \x01(\v2\x1c.gastrolog.v1.IngesterConfigR\x06config\"N\n" +
@@ -9006,12 +10466,27 @@ const file_gastrolog_v1_system_proto_rawDesc = "" +
 	"\n" +
 	"account_id\x18\x04 \x01(\fR\taccountId\x12\x1f\n" +
 	"\vlicense_key\x18\x05 \x01(\tR\n" +
-	"licenseKey\"\xee\x01\n" +
+	"licenseKey\"\x9e\x02\n" +
 	"\fAuthSettings\x12%\n" +
 	"\x0etoken_duration\x18\x01 \x01(\tR\rtokenDuration\x122\n" +
 	"\x15jwt_secret_configured\x18\x02 \x01(\bR\x13jwtSecretConfigured\x124\n" +
 	"\x16refresh_token_duration\x18\x03 \x01(\tR\x14refreshTokenDuration\x12M\n" +
-	"\x0fpassword_policy\x18\x04 \x01(\v2$.gastrolog.v1.PasswordPolicySettingsR\x0epasswordPolicy\"\x83\x01\n" +
+	"\x0fpassword_policy\x18\x04 \x01(\v2$.gastrolog.v1.PasswordPolicySettingsR\x0epasswordPolicy\x12.\n" +
+	"\x04oidc\x18\x05 \x01(\v2\x1a.gastrolog.v1.OIDCSettingsR\x04oidc\"\x90\x03\n" +
+	"\fOIDCSettings\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1d\n" +
+	"\n" +
+	"issuer_url\x18\x02 \x01(\tR\tissuerUrl\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x128\n" +
+	"\x18client_secret_configured\x18\x04 \x01(\bR\x16clientSecretConfigured\x12!\n" +
+	"\fredirect_url\x18\x05 \x01(\tR\vredirectUrl\x12\x16\n" +
+	"\x06scopes\x18\x06 \x01(\tR\x06scopes\x12%\n" +
+	"\x0eusername_claim\x18\a \x01(\tR\rusernameClaim\x12!\n" +
+	"\fgroups_claim\x18\b \x01(\tR\vgroupsClaim\x12#\n" +
+	"\rrole_mappings\x18\t \x01(\tR\froleMappings\x12!\n" +
+	"\fdefault_role\x18\n" +
+	" \x01(\tR\vdefaultRole\x12#\n" +
+	"\rclient_secret\x18\v \x01(\tR\fclientSecret\"\x83\x01\n" +
 	"\rQuerySettings\x12\x18\n" +
 	"\atimeout\x18\x01 \x01(\tR\atimeout\x12.\n" +
 	"\x13max_follow_duration\x18\x02 \x01(\tR\x11maxFollowDuration\x12(\n" +
@@ -9113,13 +10588,39 @@ const file_gastrolog_v1_system_proto_rawDesc = "" +
 	"\x0e_require_digitB\x12\n" +
 	"\x10_require_specialB\x1a\n" +
 	"\x18_max_consecutive_repeatsB\x16\n" +
-	"\x14_forbid_animal_noise\"\xf8\x01\n" +
+	"\x14_forbid_animal_noise\"\xab\x02\n" +
 	"\x0fPutAuthSettings\x12*\n" +
 	"\x0etoken_duration\x18\x01 \x01(\tH\x00R\rtokenDuration\x88\x01\x01\x129\n" +
 	"\x16refresh_token_duration\x18\x02 \x01(\tH\x01R\x14refreshTokenDuration\x88\x01\x01\x12P\n" +
-	"\x0fpassword_policy\x18\x03 \x01(\v2'.gastrolog.v1.PutPasswordPolicySettingsR\x0epasswordPolicyB\x11\n" +
+	"\x0fpassword_policy\x18\x03 \x01(\v2'.gastrolog.v1.PutPasswordPolicySettingsR\x0epasswordPolicy\x121\n" +
+	"\x04oidc\x18\x04 \x01(\v2\x1d.gastrolog.v1.PutOIDCSettingsR\x04oidcB\x11\n" +
 	"\x0f_token_durationB\x19\n" +
-	"\x17_refresh_token_duration\"\xce\x01\n" +
+	"\x17_refresh_token_duration\"\xa9\x04\n" +
+	"\x0fPutOIDCSettings\x12\x1d\n" +
+	"\aenabled\x18\x01 \x01(\bH\x00R\aenabled\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"issuer_url\x18\x02 \x01(\tH\x01R\tissuerUrl\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x03 \x01(\tH\x02R\bclientId\x88\x01\x01\x12(\n" +
+	"\rclient_secret\x18\x04 \x01(\tH\x03R\fclientSecret\x88\x01\x01\x12&\n" +
+	"\fredirect_url\x18\x05 \x01(\tH\x04R\vredirectUrl\x88\x01\x01\x12\x1b\n" +
+	"\x06scopes\x18\x06 \x01(\tH\x05R\x06scopes\x88\x01\x01\x12*\n" +
+	"\x0eusername_claim\x18\a \x01(\tH\x06R\rusernameClaim\x88\x01\x01\x12&\n" +
+	"\fgroups_claim\x18\b \x01(\tH\aR\vgroupsClaim\x88\x01\x01\x12(\n" +
+	"\rrole_mappings\x18\t \x01(\tH\bR\froleMappings\x88\x01\x01\x12&\n" +
+	"\fdefault_role\x18\n" +
+	" \x01(\tH\tR\vdefaultRole\x88\x01\x01B\n" +
+	"\n" +
+	"\b_enabledB\r\n" +
+	"\v_issuer_urlB\f\n" +
+	"\n" +
+	"_client_idB\x10\n" +
+	"\x0e_client_secretB\x0f\n" +
+	"\r_redirect_urlB\t\n" +
+	"\a_scopesB\x11\n" +
+	"\x0f_username_claimB\x0f\n" +
+	"\r_groups_claimB\x10\n" +
+	"\x0e_role_mappingsB\x0f\n" +
+	"\r_default_role\"\xce\x01\n" +
 	"\x10PutQuerySettings\x12\x1d\n" +
 	"\atimeout\x18\x01 \x01(\tH\x00R\atimeout\x88\x01\x01\x123\n" +
 	"\x13max_follow_duration\x18\x02 \x01(\tH\x01R\x11maxFollowDuration\x88\x01\x01\x12-\n" +
@@ -9350,11 +10851,12 @@ const file_gastrolog_v1_system_proto_rawDesc = "" +
 	"\x0fVaultRouteStats\x12\x19\n" +
 	"\bvault_id\x18\x01 \x01(\fR\avaultId\x12'\n" +
 	"\x0frecords_matched\x18\x02 \x01(\x03R\x0erecordsMatched\x12+\n" +
-	"\x11records_forwarded\x18\x03 \x01(\x03R\x10recordsForwarded\"\x80\x01\n" +
+	"\x11records_forwarded\x18\x03 \x01(\x03R\x10recordsForwarded\"\xa9\x01\n" +
 	"\rPerRouteStats\x12\x19\n" +
 	"\broute_id\x18\x01 \x01(\fR\arouteId\x12'\n" +
 	"\x0frecords_matched\x18\x02 \x01(\x03R\x0erecordsMatched\x12+\n" +
-	"\x11records_forwarded\x18\x03 \x01(\x03R\x10recordsForwarded\"\x82\x01\n" +
+	"\x11records_forwarded\x18\x03 \x01(\x03R\x10recordsForwarded\x12'\n" +
+	"\x0frecords_dropped\x18\x04 \x01(\x03R\x0erecordsDropped\"\x82\x01\n" +
 	"\x0fManagedFileInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\fR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
@@ -9477,7 +10979,7 @@ const file_gastrolog_v1_system_proto_rawDesc = "" +
 	"\x15TIER_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10TIER_TYPE_MEMORY\x10\x01\x12\x12\n" +
 	"\x0eTIER_TYPE_FILE\x10\x02\x12\x13\n" +
-	"\x0fTIER_TYPE_JSONL\x10\x032\x8d'\n" +
+	"\x0fTIER_TYPE_JSONL\x10\x032\xb5+\n" +
 	"\rSystemService\x12L\n" +
 	"\tGetSystem\x12\x1e.gastrolog.v1.GetSystemRequest\x1a\x1f.gastrolog.v1.GetSystemResponse\x12X\n" +
 	"\rListIngesters\x12\".gastrolog.v1.ListIngestersRequest\x1a#.gastrolog.v1.ListIngestersResponse\x12d\n" +
@@ -9515,7 +11017,8 @@ const file_gastrolog_v1_system_proto_rawDesc = "" +
 	"\x0fTriggerIngester\x12$.gastrolog.v1.TriggerIngesterRequest\x1a%.gastrolog.v1.TriggerIngesterResponse\x12X\n" +
 	"\rPutNodeConfig\x12\".gastrolog.v1.PutNodeConfigRequest\x1a#.gastrolog.v1.PutNodeConfigResponse\x12I\n" +
 	"\bPutRoute\x12\x1d.gastrolog.v1.PutRouteRequest\x1a\x1e.gastrolog.v1.PutRouteResponse\x12R\n" +
-	"\vDeleteRoute\x12 .gastrolog.v1.DeleteRouteRequest\x1a!.gastrolog.v1.DeleteRouteResponse\x12U\n" +
+	"\vDeleteRoute\x12 .gastrolog.v1.DeleteRouteRequest\x1a!.gastrolog.v1.DeleteRouteResponse\x12m\n" +
+	"\x14PreviewRoutePipeline\x12).gastrolog.v1.PreviewRoutePipelineRequest\x1a*.gastrolog.v1.PreviewRoutePipelineResponse\x12U\n" +
 	"\fGenerateName\x12!.gastrolog.v1.GenerateNameRequest\x1a\".gastrolog.v1.GenerateNameResponse\x12T\n" +
 	"\vWatchSystem\x12 .gastrolog.v1.WatchSystemRequest\x1a!.gastrolog.v1.WatchSystemResponse0\x01\x12X\n" +
 	"\rGetRouteStats\x12\".gastrolog.v1.GetRouteStatsRequest\x1a#.gastrolog.v1.GetRouteStatsResponse\x12a\n" +
@@ -9533,7 +11036,13 @@ const file_gastrolog_v1_system_proto_rawDesc = "" +
 	"\aPutTier\x12\x1c.gastrolog.v1.PutTierRequest\x1a\x1d.gastrolog.v1.PutTierResponse\x12O\n" +
 	"\n" +
 	"DeleteTier\x12\x1f.gastrolog.v1.DeleteTierRequest\x1a .gastrolog.v1.DeleteTierResponse\x12U\n" +
-	"\fDeleteLookup\x12!.gastrolog.v1.DeleteLookupRequest\x1a\".gastrolog.v1.DeleteLookupResponseB,Z*gastrolog/api/gen/gastrolog/v1;gastrologv1b\x06proto3"
+	"\fDeleteLookup\x12!.gastrolog.v1.DeleteLookupRequest\x1a\".gastrolog.v1.DeleteLookupResponse\x12U\n" +
+	"\fPutAlertRule\x12!.gastrolog.v1.PutAlertRuleRequest\x1a\".gastrolog.v1.PutAlertRuleResponse\x12^\n" +
+	"\x0fDeleteAlertRule\x12$.gastrolog.v1.DeleteAlertRuleRequest\x1a%.gastrolog.v1.DeleteAlertRuleResponse\x12g\n" +
+	"\x12GetAlertRuleStatus\x12'.gastrolog.v1.GetAlertRuleStatusRequest\x1a(.gastrolog.v1.GetAlertRuleStatusResponse\x12F\n" +
+	"\aPutRole\x12\x1c.gastrolog.v1.PutRoleRequest\x1a\x1d.gastrolog.v1.PutRoleResponse\x12O\n" +
+	"\n" +
+	"DeleteRole\x12\x1f.gastrolog.v1.DeleteRoleRequest\x1a .gastrolog.v1.DeleteRoleResponseB,Z*gastrolog/api/gen/gastrolog/v1;gastrologv1b\x06proto3"
 
 var (
 	file_gastrolog_v1_system_proto_rawDescOnce sync.Once
@@ -9548,7 +11057,7 @@ func file_gastrolog_v1_system_proto_rawDescGZIP() []byte {
 }
 
 var file_gastrolog_v1_system_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_gastrolog_v1_system_proto_msgTypes = make([]protoimpl.MessageInfo, 165)
+var file_gastrolog_v1_system_proto_msgTypes = make([]protoimpl.MessageInfo, 191)
 var file_gastrolog_v1_system_proto_goTypes = []any{
 	(VaultType)(0),                        // 0: gastrolog.v1.VaultType
 	(IngesterMode)(0),                     // 1: gastrolog.v1.IngesterMode
@@ -9560,393 +11069,456 @@ var file_gastrolog_v1_system_proto_goTypes = []any{
 	(*VaultConfig)(nil),                   // 7: gastrolog.v1.VaultConfig
 	(*RouteDestination)(nil),              // 8: gastrolog.v1.RouteDestination
 	(*RouteConfig)(nil),                   // 9: gastrolog.v1.RouteConfig
-	(*IngesterConfig)(nil),                // 10: gastrolog.v1.IngesterConfig
-	(*FilterConfig)(nil),                  // 11: gastrolog.v1.FilterConfig
-	(*RotationPolicyConfig)(nil),          // 12: gastrolog.v1.RotationPolicyConfig
-	(*RetentionPolicyConfig)(nil),         // 13: gastrolog.v1.RetentionPolicyConfig
-	(*ListIngestersRequest)(nil),          // 14: gastrolog.v1.ListIngestersRequest
-	(*ListIngestersResponse)(nil),         // 15: gastrolog.v1.ListIngestersResponse
-	(*IngesterInfo)(nil),                  // 16: gastrolog.v1.IngesterInfo
-	(*GetIngesterStatusRequest)(nil),      // 17: gastrolog.v1.GetIngesterStatusRequest
-	(*GetIngesterStatusResponse)(nil),     // 18: gastrolog.v1.GetIngesterStatusResponse
-	(*WatchIngesterStatusRequest)(nil),    // 19: gastrolog.v1.WatchIngesterStatusRequest
-	(*WatchIngesterStatusResponse)(nil),   // 20: gastrolog.v1.WatchIngesterStatusResponse
-	(*PutFilterRequest)(nil),              // 21: gastrolog.v1.PutFilterRequest
-	(*PutFilterResponse)(nil),             // 22: gastrolog.v1.PutFilterResponse
-	(*DeleteFilterRequest)(nil),           // 23: gastrolog.v1.DeleteFilterRequest
-	(*DeleteFilterResponse)(nil),          // 24: gastrolog.v1.DeleteFilterResponse
-	(*PutRotationPolicyRequest)(nil),      // 25: gastrolog.v1.PutRotationPolicyRequest
-	(*PutRotationPolicyResponse)(nil),     // 26: gastrolog.v1.PutRotationPolicyResponse
-	(*DeleteRotationPolicyRequest)(nil),   // 27: gastrolog.v1.DeleteRotationPolicyRequest
-	(*DeleteRotationPolicyResponse)(nil),  // 28: gastrolog.v1.DeleteRotationPolicyResponse
-	(*PutRetentionPolicyRequest)(nil),     // 29: gastrolog.v1.PutRetentionPolicyRequest
-	(*PutRetentionPolicyResponse)(nil),    // 30: gastrolog.v1.PutRetentionPolicyResponse
-	(*DeleteRetentionPolicyRequest)(nil),  // 31: gastrolog.v1.DeleteRetentionPolicyRequest
-	(*DeleteRetentionPolicyResponse)(nil), // 32: gastrolog.v1.DeleteRetentionPolicyResponse
-	(*PutVaultRequest)(nil),               // 33: gastrolog.v1.PutVaultRequest
-	(*PutVaultResponse)(nil),              // 34: gastrolog.v1.PutVaultResponse
-	(*DeleteVaultRequest)(nil),            // 35: gastrolog.v1.DeleteVaultRequest
-	(*DeleteVaultResponse)(nil),           // 36: gastrolog.v1.DeleteVaultResponse
-	(*PutRouteRequest)(nil),               // 37: gastrolog.v1.PutRouteRequest
-	(*PutRouteResponse)(nil),              // 38: gastrolog.v1.PutRouteResponse
-	(*DeleteRouteRequest)(nil),            // 39: gastrolog.v1.DeleteRouteRequest
-	(*DeleteRouteResponse)(nil),           // 40: gastrolog.v1.DeleteRouteResponse
-	(*PutIngesterRequest)(nil),            // 41: gastrolog.v1.PutIngesterRequest
-	(*PutIngesterResponse)(nil),           // 42: gastrolog.v1.PutIngesterResponse
-	(*DeleteIngesterRequest)(nil),         // 43: gastrolog.v1.DeleteIngesterRequest
-	(*DeleteIngesterResponse)(nil),        // 44: gastrolog.v1.DeleteIngesterResponse
-	(*GetSettingsRequest)(nil),            // 45: gastrolog.v1.GetSettingsRequest
-	(*PasswordPolicySettings)(nil),        // 46: gastrolog.v1.PasswordPolicySettings
-	(*MaxMindSettings)(nil),               // 47: gastrolog.v1.MaxMindSettings
-	(*AuthSettings)(nil),                  // 48: gastrolog.v1.AuthSettings
-	(*QuerySettings)(nil),                 // 49: gastrolog.v1.QuerySettings
-	(*SchedulerSettings)(nil),             // 50: gastrolog.v1.SchedulerSettings
-	(*TLSSettings)(nil),                   // 51: gastrolog.v1.TLSSettings
-	(*LookupSettings)(nil),                // 52: gastrolog.v1.LookupSettings
-	(*MMDBLookupEntry)(nil),               // 53: gastrolog.v1.MMDBLookupEntry
-	(*HTTPLookupParam)(nil),               // 54: gastrolog.v1.HTTPLookupParam
-	(*HTTPLookupEntry)(nil),               // 55: gastrolog.v1.HTTPLookupEntry
-	(*JSONFileLookupEntry)(nil),           // 56: gastrolog.v1.JSONFileLookupEntry
-	(*YAMLFileLookupEntry)(nil),           // 57: gastrolog.v1.YAMLFileLookupEntry
-	(*CSVLookupEntry)(nil),                // 58: gastrolog.v1.CSVLookupEntry
-	(*StaticLookupEntry)(nil),             // 59: gastrolog.v1.StaticLookupEntry
-	(*StaticLookupRow)(nil),               // 60: gastrolog.v1.StaticLookupRow
-	(*ClusterSettings)(nil),               // 61: gastrolog.v1.ClusterSettings
-	(*GetSettingsResponse)(nil),           // 62: gastrolog.v1.GetSettingsResponse
-	(*PutPasswordPolicySettings)(nil),     // 63: gastrolog.v1.PutPasswordPolicySettings
-	(*PutAuthSettings)(nil),               // 64: gastrolog.v1.PutAuthSettings
-	(*PutQuerySettings)(nil),              // 65: gastrolog.v1.PutQuerySettings
-	(*PutSchedulerSettings)(nil),          // 66: gastrolog.v1.PutSchedulerSettings
-	(*PutTLSSettings)(nil),                // 67: gastrolog.v1.PutTLSSettings
-	(*PutMaxMindSettings)(nil),            // 68: gastrolog.v1.PutMaxMindSettings
-	(*PutLookupSettings)(nil),             // 69: gastrolog.v1.PutLookupSettings
-	(*PutClusterSettings)(nil),            // 70: gastrolog.v1.PutClusterSettings
-	(*PutServiceSettingsRequest)(nil),     // 71: gastrolog.v1.PutServiceSettingsRequest
-	(*SettingsMutationEcho)(nil),          // 72: gastrolog.v1.SettingsMutationEcho
-	(*PutServiceSettingsResponse)(nil),    // 73: gastrolog.v1.PutServiceSettingsResponse
-	(*PutLookupSettingsRequest)(nil),      // 74: gastrolog.v1.PutLookupSettingsRequest
-	(*PutLookupSettingsResponse)(nil),     // 75: gastrolog.v1.PutLookupSettingsResponse
-	(*PutMaxMindSettingsRequest)(nil),     // 76: gastrolog.v1.PutMaxMindSettingsRequest
-	(*PutMaxMindSettingsResponse)(nil),    // 77: gastrolog.v1.PutMaxMindSettingsResponse
-	(*PutSetupSettingsRequest)(nil),       // 78: gastrolog.v1.PutSetupSettingsRequest
-	(*PutSetupSettingsResponse)(nil),      // 79: gastrolog.v1.PutSetupSettingsResponse
-	(*RegenerateJwtSecretRequest)(nil),    // 80: gastrolog.v1.RegenerateJwtSecretRequest
-	(*RegenerateJwtSecretResponse)(nil),   // 81: gastrolog.v1.RegenerateJwtSecretResponse
-	(*MmdbValidation)(nil),                // 82: gastrolog.v1.MmdbValidation
-	(*GetPreferencesRequest)(nil),         // 83: gastrolog.v1.GetPreferencesRequest
-	(*GetPreferencesResponse)(nil),        // 84: gastrolog.v1.GetPreferencesResponse
-	(*PutPreferencesRequest)(nil),         // 85: gastrolog.v1.PutPreferencesRequest
-	(*PutPreferencesResponse)(nil),        // 86: gastrolog.v1.PutPreferencesResponse
-	(*SavedQuery)(nil),                    // 87: gastrolog.v1.SavedQuery
-	(*GetSavedQueriesRequest)(nil),        // 88: gastrolog.v1.GetSavedQueriesRequest
-	(*GetSavedQueriesResponse)(nil),       // 89: gastrolog.v1.GetSavedQueriesResponse
-	(*PutSavedQueryRequest)(nil),          // 90: gastrolog.v1.PutSavedQueryRequest
-	(*PutSavedQueryResponse)(nil),         // 91: gastrolog.v1.PutSavedQueryResponse
-	(*DeleteSavedQueryRequest)(nil),       // 92: gastrolog.v1.DeleteSavedQueryRequest
-	(*DeleteSavedQueryResponse)(nil),      // 93: gastrolog.v1.DeleteSavedQueryResponse
-	(*ListCertificatesRequest)(nil),       // 94: gastrolog.v1.ListCertificatesRequest
-	(*ListCertificatesResponse)(nil),      // 95: gastrolog.v1.ListCertificatesResponse
-	(*CertificateInfo)(nil),               // 96: gastrolog.v1.CertificateInfo
-	(*GetCertificateRequest)(nil),         // 97: gastrolog.v1.GetCertificateRequest
-	(*GetCertificateResponse)(nil),        // 98: gastrolog.v1.GetCertificateResponse
-	(*PutCertificateRequest)(nil),         // 99: gastrolog.v1.PutCertificateRequest
-	(*PutCertificateResponse)(nil),        // 100: gastrolog.v1.PutCertificateResponse
-	(*DeleteCertificateRequest)(nil),      // 101: gastrolog.v1.DeleteCertificateRequest
-	(*DeleteCertificateResponse)(nil),     // 102: gastrolog.v1.DeleteCertificateResponse
-	(*PauseVaultRequest)(nil),             // 103: gastrolog.v1.PauseVaultRequest
-	(*PauseVaultResponse)(nil),            // 104: gastrolog.v1.PauseVaultResponse
-	(*ResumeVaultRequest)(nil),            // 105: gastrolog.v1.ResumeVaultRequest
-	(*ResumeVaultResponse)(nil),           // 106: gastrolog.v1.ResumeVaultResponse
-	(*TestIngesterRequest)(nil),           // 107: gastrolog.v1.TestIngesterRequest
-	(*TestIngesterResponse)(nil),          // 108: gastrolog.v1.TestIngesterResponse
-	(*TriggerIngesterRequest)(nil),        // 109: gastrolog.v1.TriggerIngesterRequest
-	(*TriggerIngesterResponse)(nil),       // 110: gastrolog.v1.TriggerIngesterResponse
-	(*TestCloudServiceRequest)(nil),       // 111: gastrolog.v1.TestCloudServiceRequest
-	(*TestCloudServiceResponse)(nil),      // 112: gastrolog.v1.TestCloudServiceResponse
-	(*GetIngesterDefaultsRequest)(nil),    // 113: gastrolog.v1.GetIngesterDefaultsRequest
-	(*IngesterTypeDefaults)(nil),          // 114: gastrolog.v1.IngesterTypeDefaults
-	(*GetIngesterDefaultsResponse)(nil),   // 115: gastrolog.v1.GetIngesterDefaultsResponse
-	(*NodeConfig)(nil),                    // 116: gastrolog.v1.NodeConfig
-	(*TierConfig)(nil),                    // 117: gastrolog.v1.TierConfig
-	(*TierPlacement)(nil),                 // 118: gastrolog.v1.TierPlacement
-	(*PutNodeConfigRequest)(nil),          // 119: gastrolog.v1.PutNodeConfigRequest
-	(*PutNodeConfigResponse)(nil),         // 120: gastrolog.v1.PutNodeConfigResponse
-	(*GenerateNameRequest)(nil),           // 121: gastrolog.v1.GenerateNameRequest
-	(*GenerateNameResponse)(nil),          // 122: gastrolog.v1.GenerateNameResponse
-	(*WatchSystemRequest)(nil),            // 123: gastrolog.v1.WatchSystemRequest
-	(*WatchSystemResponse)(nil),           // 124: gastrolog.v1.WatchSystemResponse
-	(*GetRouteStatsRequest)(nil),          // 125: gastrolog.v1.GetRouteStatsRequest
-	(*GetRouteStatsResponse)(nil),         // 126: gastrolog.v1.GetRouteStatsResponse
-	(*VaultRouteStats)(nil),               // 127: gastrolog.v1.VaultRouteStats
-	(*PerRouteStats)(nil),                 // 128: gastrolog.v1.PerRouteStats
-	(*ManagedFileInfo)(nil),               // 129: gastrolog.v1.ManagedFileInfo
-	(*ListManagedFilesRequest)(nil),       // 130: gastrolog.v1.ListManagedFilesRequest
-	(*ListManagedFilesResponse)(nil),      // 131: gastrolog.v1.ListManagedFilesResponse
-	(*DeleteManagedFileRequest)(nil),      // 132: gastrolog.v1.DeleteManagedFileRequest
-	(*DeleteManagedFileResponse)(nil),     // 133: gastrolog.v1.DeleteManagedFileResponse
-	(*TestHTTPLookupRequest)(nil),         // 134: gastrolog.v1.TestHTTPLookupRequest
-	(*TestHTTPLookupResponse)(nil),        // 135: gastrolog.v1.TestHTTPLookupResponse
-	(*TestHTTPLookupResult)(nil),          // 136: gastrolog.v1.TestHTTPLookupResult
-	(*PreviewCSVLookupRequest)(nil),       // 137: gastrolog.v1.PreviewCSVLookupRequest
-	(*PreviewCSVLookupResponse)(nil),      // 138: gastrolog.v1.PreviewCSVLookupResponse
-	(*CSVPreviewRow)(nil),                 // 139: gastrolog.v1.CSVPreviewRow
-	(*PreviewJSONLookupRequest)(nil),      // 140: gastrolog.v1.PreviewJSONLookupRequest
-	(*PreviewJSONLookupResponse)(nil),     // 141: gastrolog.v1.PreviewJSONLookupResponse
-	(*PreviewYAMLLookupRequest)(nil),      // 142: gastrolog.v1.PreviewYAMLLookupRequest
-	(*PreviewYAMLLookupResponse)(nil),     // 143: gastrolog.v1.PreviewYAMLLookupResponse
-	(*PutCloudServiceRequest)(nil),        // 144: gastrolog.v1.PutCloudServiceRequest
-	(*PutCloudServiceResponse)(nil),       // 145: gastrolog.v1.PutCloudServiceResponse
-	(*DeleteCloudServiceRequest)(nil),     // 146: gastrolog.v1.DeleteCloudServiceRequest
-	(*DeleteCloudServiceResponse)(nil),    // 147: gastrolog.v1.DeleteCloudServiceResponse
-	(*SetNodeStorageConfigRequest)(nil),   // 148: gastrolog.v1.SetNodeStorageConfigRequest
-	(*SetNodeStorageConfigResponse)(nil),  // 149: gastrolog.v1.SetNodeStorageConfigResponse
-	(*PutTierRequest)(nil),                // 150: gastrolog.v1.PutTierRequest
-	(*PutTierResponse)(nil),               // 151: gastrolog.v1.PutTierResponse
-	(*DeleteTierRequest)(nil),             // 152: gastrolog.v1.DeleteTierRequest
-	(*DeleteTierResponse)(nil),            // 153: gastrolog.v1.DeleteTierResponse
-	(*DeleteLookupRequest)(nil),           // 154: gastrolog.v1.DeleteLookupRequest
-	(*DeleteLookupResponse)(nil),          // 155: gastrolog.v1.DeleteLookupResponse
-	nil,                                   // 156: gastrolog.v1.IngesterConfig.ParamsEntry
-	nil,                                   // 157: gastrolog.v1.IngesterInfo.NodeStatusEntry
-	nil,                                   // 158: gastrolog.v1.HTTPLookupEntry.HeadersEntry
-	nil,                                   // 159: gastrolog.v1.StaticLookupRow.ValuesEntry
-	nil,                                   // 160: gastrolog.v1.TestIngesterRequest.ParamsEntry
-	nil,                                   // 161: gastrolog.v1.TestCloudServiceRequest.ParamsEntry
-	nil,                                   // 162: gastrolog.v1.IngesterTypeDefaults.ParamsEntry
-	nil,                                   // 163: gastrolog.v1.GetIngesterDefaultsResponse.TypesEntry
-	nil,                                   // 164: gastrolog.v1.TestHTTPLookupRequest.ValuesEntry
-	nil,                                   // 165: gastrolog.v1.TestHTTPLookupResult.FieldsEntry
-	nil,                                   // 166: gastrolog.v1.PreviewJSONLookupRequest.ParametersEntry
-	nil,                                   // 167: gastrolog.v1.PreviewYAMLLookupRequest.ParametersEntry
-	(*CloudService)(nil),                  // 168: gastrolog.v1.CloudService
-	(*NodeStorageConfig)(nil),             // 169: gastrolog.v1.NodeStorageConfig
+	(*RouteStage)(nil),                    // 10: gastrolog.v1.RouteStage
+	(*IngesterConfig)(nil),                // 11: gastrolog.v1.IngesterConfig
+	(*FilterConfig)(nil),                  // 12: gastrolog.v1.FilterConfig
+	(*RotationPolicyConfig)(nil),          // 13: gastrolog.v1.RotationPolicyConfig
+	(*RetentionPolicyConfig)(nil),         // 14: gastrolog.v1.RetentionPolicyConfig
+	(*ListIngestersRequest)(nil),          // 15: gastrolog.v1.ListIngestersRequest
+	(*ListIngestersResponse)(nil),         // 16: gastrolog.v1.ListIngestersResponse
+	(*IngesterInfo)(nil),                  // 17: gastrolog.v1.IngesterInfo
+	(*GetIngesterStatusRequest)(nil),      // 18: gastrolog.v1.GetIngesterStatusRequest
+	(*GetIngesterStatusResponse)(nil),     // 19: gastrolog.v1.GetIngesterStatusResponse
+	(*WatchIngesterStatusRequest)(nil),    // 20: gastrolog.v1.WatchIngesterStatusRequest
+	(*WatchIngesterStatusResponse)(nil),   // 21: gastrolog.v1.WatchIngesterStatusResponse
+	(*PutFilterRequest)(nil),              // 22: gastrolog.v1.PutFilterRequest
+	(*PutFilterResponse)(nil),             // 23: gastrolog.v1.PutFilterResponse
+	(*DeleteFilterRequest)(nil),           // 24: gastrolog.v1.DeleteFilterRequest
+	(*DeleteFilterResponse)(nil),          // 25: gastrolog.v1.DeleteFilterResponse
+	(*PutRotationPolicyRequest)(nil),      // 26: gastrolog.v1.PutRotationPolicyRequest
+	(*PutRotationPolicyResponse)(nil),     // 27: gastrolog.v1.PutRotationPolicyResponse
+	(*DeleteRotationPolicyRequest)(nil),   // 28: gastrolog.v1.DeleteRotationPolicyRequest
+	(*DeleteRotationPolicyResponse)(nil),  // 29: gastrolog.v1.DeleteRotationPolicyResponse
+	(*PutRetentionPolicyRequest)(nil),     // 30: gastrolog.v1.PutRetentionPolicyRequest
+	(*PutRetentionPolicyResponse)(nil),    // 31: gastrolog.v1.PutRetentionPolicyResponse
+	(*DeleteRetentionPolicyRequest)(nil),  // 32: gastrolog.v1.DeleteRetentionPolicyRequest
+	(*DeleteRetentionPolicyResponse)(nil), // 33: gastrolog.v1.DeleteRetentionPolicyResponse
+	(*PutVaultRequest)(nil),               // 34: gastrolog.v1.PutVaultRequest
+	(*PutVaultResponse)(nil),              // 35: gastrolog.v1.PutVaultResponse
+	(*DeleteVaultRequest)(nil),            // 36: gastrolog.v1.DeleteVaultRequest
+	(*DeleteVaultResponse)(nil),           // 37: gastrolog.v1.DeleteVaultResponse
+	(*PutRouteRequest)(nil),               // 38: gastrolog.v1.PutRouteRequest
+	(*PutRouteResponse)(nil),              // 39: gastrolog.v1.PutRouteResponse
+	(*DeleteRouteRequest)(nil),            // 40: gastrolog.v1.DeleteRouteRequest
+	(*DeleteRouteResponse)(nil),           // 41: gastrolog.v1.DeleteRouteResponse
+	(*PreviewRoutePipelineRequest)(nil),   // 42: gastrolog.v1.PreviewRoutePipelineRequest
+	(*PreviewRoutePipelineResponse)(nil),  // 43: gastrolog.v1.PreviewRoutePipelineResponse
+	(*RoutePipelineResult)(nil),           // 44: gastrolog.v1.RoutePipelineResult
+	(*AlertCondition)(nil),                // 45: gastrolog.v1.AlertCondition
+	(*AlertSink)(nil),                     // 46: gastrolog.v1.AlertSink
+	(*AlertRuleConfig)(nil),               // 47: gastrolog.v1.AlertRuleConfig
+	(*PutAlertRuleRequest)(nil),           // 48: gastrolog.v1.PutAlertRuleRequest
+	(*PutAlertRuleResponse)(nil),          // 49: gastrolog.v1.PutAlertRuleResponse
+	(*DeleteAlertRuleRequest)(nil),        // 50: gastrolog.v1.DeleteAlertRuleRequest
+	(*DeleteAlertRuleResponse)(nil),       // 51: gastrolog.v1.DeleteAlertRuleResponse
+	(*GetAlertRuleStatusRequest)(nil),     // 52: gastrolog.v1.GetAlertRuleStatusRequest
+	(*AlertRuleStatus)(nil),               // 53: gastrolog.v1.AlertRuleStatus
+	(*GetAlertRuleStatusResponse)(nil),    // 54: gastrolog.v1.GetAlertRuleStatusResponse
+	(*VaultGrant)(nil),                    // 55: gastrolog.v1.VaultGrant
+	(*RoleConfig)(nil),                    // 56: gastrolog.v1.RoleConfig
+	(*PutRoleRequest)(nil),                // 57: gastrolog.v1.PutRoleRequest
+	(*PutRoleResponse)(nil),               // 58: gastrolog.v1.PutRoleResponse
+	(*DeleteRoleRequest)(nil),             // 59: gastrolog.v1.DeleteRoleRequest
+	(*DeleteRoleResponse)(nil),            // 60: gastrolog.v1.DeleteRoleResponse
+	(*PutIngesterRequest)(nil),            // 61: gastrolog.v1.PutIngesterRequest
+	(*PutIngesterResponse)(nil),           // 62: gastrolog.v1.PutIngesterResponse
+	(*DeleteIngesterRequest)(nil),         // 63: gastrolog.v1.DeleteIngesterRequest
+	(*DeleteIngesterResponse)(nil),        // 64: gastrolog.v1.DeleteIngesterResponse
+	(*GetSettingsRequest)(nil),            // 65: gastrolog.v1.GetSettingsRequest
+	(*PasswordPolicySettings)(nil),        // 66: gastrolog.v1.PasswordPolicySettings
+	(*MaxMindSettings)(nil),               // 67: gastrolog.v1.MaxMindSettings
+	(*AuthSettings)(nil),                  // 68: gastrolog.v1.AuthSettings
+	(*OIDCSettings)(nil),                  // 69: gastrolog.v1.OIDCSettings
+	(*QuerySettings)(nil),                 // 70: gastrolog.v1.QuerySettings
+	(*SchedulerSettings)(nil),             // 71: gastrolog.v1.SchedulerSettings
+	(*TLSSettings)(nil),                   // 72: gastrolog.v1.TLSSettings
+	(*LookupSettings)(nil),                // 73: gastrolog.v1.LookupSettings
+	(*MMDBLookupEntry)(nil),               // 74: gastrolog.v1.MMDBLookupEntry
+	(*HTTPLookupParam)(nil),               // 75: gastrolog.v1.HTTPLookupParam
+	(*HTTPLookupEntry)(nil),               // 76: gastrolog.v1.HTTPLookupEntry
+	(*JSONFileLookupEntry)(nil),           // 77: gastrolog.v1.JSONFileLookupEntry
+	(*YAMLFileLookupEntry)(nil),           // 78: gastrolog.v1.YAMLFileLookupEntry
+	(*CSVLookupEntry)(nil),                // 79: gastrolog.v1.CSVLookupEntry
+	(*StaticLookupEntry)(nil),             // 80: gastrolog.v1.StaticLookupEntry
+	(*StaticLookupRow)(nil),               // 81: gastrolog.v1.StaticLookupRow
+	(*ClusterSettings)(nil),               // 82: gastrolog.v1.ClusterSettings
+	(*GetSettingsResponse)(nil),           // 83: gastrolog.v1.GetSettingsResponse
+	(*PutPasswordPolicySettings)(nil),     // 84: gastrolog.v1.PutPasswordPolicySettings
+	(*PutAuthSettings)(nil),               // 85: gastrolog.v1.PutAuthSettings
+	(*PutOIDCSettings)(nil),               // 86: gastrolog.v1.PutOIDCSettings
+	(*PutQuerySettings)(nil),              // 87: gastrolog.v1.PutQuerySettings
+	(*PutSchedulerSettings)(nil),          // 88: gastrolog.v1.PutSchedulerSettings
+	(*PutTLSSettings)(nil),                // 89: gastrolog.v1.PutTLSSettings
+	(*PutMaxMindSettings)(nil),            // 90: gastrolog.v1.PutMaxMindSettings
+	(*PutLookupSettings)(nil),             // 91: gastrolog.v1.PutLookupSettings
+	(*PutClusterSettings)(nil),            // 92: gastrolog.v1.PutClusterSettings
+	(*PutServiceSettingsRequest)(nil),     // 93: gastrolog.v1.PutServiceSettingsRequest
+	(*SettingsMutationEcho)(nil),          // 94: gastrolog.v1.SettingsMutationEcho
+	(*PutServiceSettingsResponse)(nil),    // 95: gastrolog.v1.PutServiceSettingsResponse
+	(*PutLookupSettingsRequest)(nil),      // 96: gastrolog.v1.PutLookupSettingsRequest
+	(*PutLookupSettingsResponse)(nil),     // 97: gastrolog.v1.PutLookupSettingsResponse
+	(*PutMaxMindSettingsRequest)(nil),     // 98: gastrolog.v1.PutMaxMindSettingsRequest
+	(*PutMaxMindSettingsResponse)(nil),    // 99: gastrolog.v1.PutMaxMindSettingsResponse
+	(*PutSetupSettingsRequest)(nil),       // 100: gastrolog.v1.PutSetupSettingsRequest
+	(*PutSetupSettingsResponse)(nil),      // 101: gastrolog.v1.PutSetupSettingsResponse
+	(*RegenerateJwtSecretRequest)(nil),    // 102: gastrolog.v1.RegenerateJwtSecretRequest
+	(*RegenerateJwtSecretResponse)(nil),   // 103: gastrolog.v1.RegenerateJwtSecretResponse
+	(*MmdbValidation)(nil),                // 104: gastrolog.v1.MmdbValidation
+	(*GetPreferencesRequest)(nil),         // 105: gastrolog.v1.GetPreferencesRequest
+	(*GetPreferencesResponse)(nil),        // 106: gastrolog.v1.GetPreferencesResponse
+	(*PutPreferencesRequest)(nil),         // 107: gastrolog.v1.PutPreferencesRequest
+	(*PutPreferencesResponse)(nil),        // 108: gastrolog.v1.PutPreferencesResponse
+	(*SavedQuery)(nil),                    // 109: gastrolog.v1.SavedQuery
+	(*GetSavedQueriesRequest)(nil),        // 110: gastrolog.v1.GetSavedQueriesRequest
+	(*GetSavedQueriesResponse)(nil),       // 111: gastrolog.v1.GetSavedQueriesResponse
+	(*PutSavedQueryRequest)(nil),          // 112: gastrolog.v1.PutSavedQueryRequest
+	(*PutSavedQueryResponse)(nil),         // 113: gastrolog.v1.PutSavedQueryResponse
+	(*DeleteSavedQueryRequest)(nil),       // 114: gastrolog.v1.DeleteSavedQueryRequest
+	(*DeleteSavedQueryResponse)(nil),      // 115: gastrolog.v1.DeleteSavedQueryResponse
+	(*ListCertificatesRequest)(nil),       // 116: gastrolog.v1.ListCertificatesRequest
+	(*ListCertificatesResponse)(nil),      // 117: gastrolog.v1.ListCertificatesResponse
+	(*CertificateInfo)(nil),               // 118: gastrolog.v1.CertificateInfo
+	(*GetCertificateRequest)(nil),         // 119: gastrolog.v1.GetCertificateRequest
+	(*GetCertificateResponse)(nil),        // 120: gastrolog.v1.GetCertificateResponse
+	(*PutCertificateRequest)(nil),         // 121: gastrolog.v1.PutCertificateRequest
+	(*PutCertificateResponse)(nil),        // 122: gastrolog.v1.PutCertificateResponse
+	(*DeleteCertificateRequest)(nil),      // 123: gastrolog.v1.DeleteCertificateRequest
+	(*DeleteCertificateResponse)(nil),     // 124: gastrolog.v1.DeleteCertificateResponse
+	(*PauseVaultRequest)(nil),             // 125: gastrolog.v1.PauseVaultRequest
+	(*PauseVaultResponse)(nil),            // 126: gastrolog.v1.PauseVaultResponse
+	(*ResumeVaultRequest)(nil),            // 127: gastrolog.v1.ResumeVaultRequest
+	(*ResumeVaultResponse)(nil),           // 128: gastrolog.v1.ResumeVaultResponse
+	(*TestIngesterRequest)(nil),           // 129: gastrolog.v1.TestIngesterRequest
+	(*TestIngesterResponse)(nil),          // 130: gastrolog.v1.TestIngesterResponse
+	(*TriggerIngesterRequest)(nil),        // 131: gastrolog.v1.TriggerIngesterRequest
+	(*TriggerIngesterResponse)(nil),       // 132: gastrolog.v1.TriggerIngesterResponse
+	(*TestCloudServiceRequest)(nil),       // 133: gastrolog.v1.TestCloudServiceRequest
+	(*TestCloudServiceResponse)(nil),      // 134: gastrolog.v1.TestCloudServiceResponse
+	(*GetIngesterDefaultsRequest)(nil),    // 135: gastrolog.v1.GetIngesterDefaultsRequest
+	(*IngesterTypeDefaults)(nil),          // 136: gastrolog.v1.IngesterTypeDefaults
+	(*GetIngesterDefaultsResponse)(nil),   // 137: gastrolog.v1.GetIngesterDefaultsResponse
+	(*NodeConfig)(nil),                    // 138: gastrolog.v1.NodeConfig
+	(*TierConfig)(nil),                    // 139: gastrolog.v1.TierConfig
+	(*TierPlacement)(nil),                 // 140: gastrolog.v1.TierPlacement
+	(*PutNodeConfigRequest)(nil),          // 141: gastrolog.v1.PutNodeConfigRequest
+	(*PutNodeConfigResponse)(nil),         // 142: gastrolog.v1.PutNodeConfigResponse
+	(*GenerateNameRequest)(nil),           // 143: gastrolog.v1.GenerateNameRequest
+	(*GenerateNameResponse)(nil),          // 144: gastrolog.v1.GenerateNameResponse
+	(*WatchSystemRequest)(nil),            // 145: gastrolog.v1.WatchSystemRequest
+	(*WatchSystemResponse)(nil),           // 146: gastrolog.v1.WatchSystemResponse
+	(*GetRouteStatsRequest)(nil),          // 147: gastrolog.v1.GetRouteStatsRequest
+	(*GetRouteStatsResponse)(nil),         // 148: gastrolog.v1.GetRouteStatsResponse
+	(*VaultRouteStats)(nil),               // 149: gastrolog.v1.VaultRouteStats
+	(*PerRouteStats)(nil),                 // 150: gastrolog.v1.PerRouteStats
+	(*ManagedFileInfo)(nil),               // 151: gastrolog.v1.ManagedFileInfo
+	(*ListManagedFilesRequest)(nil),       // 152: gastrolog.v1.ListManagedFilesRequest
+	(*ListManagedFilesResponse)(nil),      // 153: gastrolog.v1.ListManagedFilesResponse
+	(*DeleteManagedFileRequest)(nil),      // 154: gastrolog.v1.DeleteManagedFileRequest
+	(*DeleteManagedFileResponse)(nil),     // 155: gastrolog.v1.DeleteManagedFileResponse
+	(*TestHTTPLookupRequest)(nil),         // 156: gastrolog.v1.TestHTTPLookupRequest
+	(*TestHTTPLookupResponse)(nil),        // 157: gastrolog.v1.TestHTTPLookupResponse
+	(*TestHTTPLookupResult)(nil),          // 158: gastrolog.v1.TestHTTPLookupResult
+	(*PreviewCSVLookupRequest)(nil),       // 159: gastrolog.v1.PreviewCSVLookupRequest
+	(*PreviewCSVLookupResponse)(nil),      // 160: gastrolog.v1.PreviewCSVLookupResponse
+	(*CSVPreviewRow)(nil),                 // 161: gastrolog.v1.CSVPreviewRow
+	(*PreviewJSONLookupRequest)(nil),      // 162: gastrolog.v1.PreviewJSONLookupRequest
+	(*PreviewJSONLookupResponse)(nil),     // 163: gastrolog.v1.PreviewJSONLookupResponse
+	(*PreviewYAMLLookupRequest)(nil),      // 164: gastrolog.v1.PreviewYAMLLookupRequest
+	(*PreviewYAMLLookupResponse)(nil),     // 165: gastrolog.v1.PreviewYAMLLookupResponse
+	(*PutCloudServiceRequest)(nil),        // 166: gastrolog.v1.PutCloudServiceRequest
+	(*PutCloudServiceResponse)(nil),       // 167: gastrolog.v1.PutCloudServiceResponse
+	(*DeleteCloudServiceRequest)(nil),     // 168: gastrolog.v1.DeleteCloudServiceRequest
+	(*DeleteCloudServiceResponse)(nil),    // 169: gastrolog.v1.DeleteCloudServiceResponse
+	(*SetNodeStorageConfigRequest)(nil),   // 170: gastrolog.v1.SetNodeStorageConfigRequest
+	(*SetNodeStorageConfigResponse)(nil),  // 171: gastrolog.v1.SetNodeStorageConfigResponse
+	(*PutTierRequest)(nil),                // 172: gastrolog.v1.PutTierRequest
+	(*PutTierResponse)(nil),               // 173: gastrolog.v1.PutTierResponse
+	(*DeleteTierRequest)(nil),             // 174: gastrolog.v1.DeleteTierRequest
+	(*DeleteTierResponse)(nil),            // 175: gastrolog.v1.DeleteTierResponse
+	(*DeleteLookupRequest)(nil),           // 176: gastrolog.v1.DeleteLookupRequest
+	(*DeleteLookupResponse)(nil),          // 177: gastrolog.v1.DeleteLookupResponse
+	nil,                                   // 178: gastrolog.v1.RouteStage.ParamsEntry
+	nil,                                   // 179: gastrolog.v1.IngesterConfig.ParamsEntry
+	nil,                                   // 180: gastrolog.v1.IngesterInfo.NodeStatusEntry
+	nil,                                   // 181: gastrolog.v1.PreviewRoutePipelineRequest.AttrsEntry
+	nil,                                   // 182: gastrolog.v1.RoutePipelineResult.AttrsEntry
+	nil,                                   // 183: gastrolog.v1.AlertSink.ParamsEntry
+	nil,                                   // 184: gastrolog.v1.HTTPLookupEntry.HeadersEntry
+	nil,                                   // 185: gastrolog.v1.StaticLookupRow.ValuesEntry
+	nil,                                   // 186: gastrolog.v1.TestIngesterRequest.ParamsEntry
+	nil,                                   // 187: gastrolog.v1.TestCloudServiceRequest.ParamsEntry
+	nil,                                   // 188: gastrolog.v1.IngesterTypeDefaults.ParamsEntry
+	nil,                                   // 189: gastrolog.v1.GetIngesterDefaultsResponse.TypesEntry
+	nil,                                   // 190: gastrolog.v1.TestHTTPLookupRequest.ValuesEntry
+	nil,                                   // 191: gastrolog.v1.TestHTTPLookupResult.FieldsEntry
+	nil,                                   // 192: gastrolog.v1.PreviewJSONLookupRequest.ParametersEntry
+	nil,                                   // 193: gastrolog.v1.PreviewYAMLLookupRequest.ParametersEntry
+	(*CloudService)(nil),                  // 194: gastrolog.v1.CloudService
+	(*NodeStorageConfig)(nil),             // 195: gastrolog.v1.NodeStorageConfig
+	(*timestamppb.Timestamp)(nil),         // 196: google.protobuf.Timestamp
 }
 var file_gastrolog_v1_system_proto_depIdxs = []int32{
 	7,   // 0: gastrolog.v1.GetSystemResponse.vaults:type_name -> gastrolog.v1.VaultConfig
-	10,  // 1: gastrolog.v1.GetSystemResponse.ingesters:type_name -> gastrolog.v1.IngesterConfig
-	12,  // 2: gastrolog.v1.GetSystemResponse.rotation_policies:type_name -> gastrolog.v1.RotationPolicyConfig
-	11,  // 3: gastrolog.v1.GetSystemResponse.filters:type_name -> gastrolog.v1.FilterConfig
-	13,  // 4: gastrolog.v1.GetSystemResponse.retention_policies:type_name -> gastrolog.v1.RetentionPolicyConfig
-	116, // 5: gastrolog.v1.GetSystemResponse.node_configs:type_name -> gastrolog.v1.NodeConfig
+	11,  // 1: gastrolog.v1.GetSystemResponse.ingesters:type_name -> gastrolog.v1.IngesterConfig
+	13,  // 2: gastrolog.v1.GetSystemResponse.rotation_policies:type_name -> gastrolog.v1.RotationPolicyConfig
+	12,  // 3: gastrolog.v1.GetSystemResponse.filters:type_name -> gastrolog.v1.FilterConfig
+	14,  // 4: gastrolog.v1.GetSystemResponse.retention_policies:type_name -> gastrolog.v1.RetentionPolicyConfig
+	138, // 5: gastrolog.v1.GetSystemResponse.node_configs:type_name -> gastrolog.v1.NodeConfig
 	9,   // 6: gastrolog.v1.GetSystemResponse.routes:type_name -> gastrolog.v1.RouteConfig
-	129, // 7: gastrolog.v1.GetSystemResponse.managed_files:type_name -> gastrolog.v1.ManagedFileInfo
-	168, // 8: gastrolog.v1.GetSystemResponse.cloud_services:type_name -> gastrolog.v1.CloudService
-	169, // 9: gastrolog.v1.GetSystemResponse.node_storage_configs:type_name -> gastrolog.v1.NodeStorageConfig
-	117, // 10: gastrolog.v1.GetSystemResponse.tiers:type_name -> gastrolog.v1.TierConfig
-	0,   // 11: gastrolog.v1.VaultConfig.type:type_name -> gastrolog.v1.VaultType
-	5,   // 12: gastrolog.v1.VaultConfig.retention_rules:type_name -> gastrolog.v1.RetentionRule
-	6,   // 13: gastrolog.v1.VaultConfig.placements:type_name -> gastrolog.v1.VaultPlacement
-	8,   // 14: gastrolog.v1.RouteConfig.destinations:type_name -> gastrolog.v1.RouteDestination
-	156, // 15: gastrolog.v1.IngesterConfig.params:type_name -> gastrolog.v1.IngesterConfig.ParamsEntry
-	16,  // 16: gastrolog.v1.ListIngestersResponse.ingesters:type_name -> gastrolog.v1.IngesterInfo
-	157, // 17: gastrolog.v1.IngesterInfo.node_status:type_name -> gastrolog.v1.IngesterInfo.NodeStatusEntry
-	11,  // 18: gastrolog.v1.PutFilterRequest.config:type_name -> gastrolog.v1.FilterConfig
-	4,   // 19: gastrolog.v1.PutFilterResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	4,   // 20: gastrolog.v1.DeleteFilterResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	12,  // 21: gastrolog.v1.PutRotationPolicyRequest.config:type_name -> gastrolog.v1.RotationPolicyConfig
-	4,   // 22: gastrolog.v1.PutRotationPolicyResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	4,   // 23: gastrolog.v1.DeleteRotationPolicyResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	13,  // 24: gastrolog.v1.PutRetentionPolicyRequest.config:type_name -> gastrolog.v1.RetentionPolicyConfig
-	4,   // 25: gastrolog.v1.PutRetentionPolicyResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	4,   // 26: gastrolog.v1.DeleteRetentionPolicyResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	7,   // 27: gastrolog.v1.PutVaultRequest.config:type_name -> gastrolog.v1.VaultConfig
-	4,   // 28: gastrolog.v1.PutVaultResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	4,   // 29: gastrolog.v1.DeleteVaultResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	9,   // 30: gastrolog.v1.PutRouteRequest.config:type_name -> gastrolog.v1.RouteConfig
-	4,   // 31: gastrolog.v1.PutRouteResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	4,   // 32: gastrolog.v1.DeleteRouteResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	10,  // 33: gastrolog.v1.PutIngesterRequest.config:type_name -> gastrolog.v1.IngesterConfig
-	4,   // 34: gastrolog.v1.PutIngesterResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	4,   // 35: gastrolog.v1.DeleteIngesterResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	46,  // 36: gastrolog.v1.AuthSettings.password_policy:type_name -> gastrolog.v1.PasswordPolicySettings
-	55,  // 37: gastrolog.v1.LookupSettings.http_lookups:type_name -> gastrolog.v1.HTTPLookupEntry
-	56,  // 38: gastrolog.v1.LookupSettings.json_file_lookups:type_name -> gastrolog.v1.JSONFileLookupEntry
-	53,  // 39: gastrolog.v1.LookupSettings.mmdb_lookups:type_name -> gastrolog.v1.MMDBLookupEntry
-	58,  // 40: gastrolog.v1.LookupSettings.csv_lookups:type_name -> gastrolog.v1.CSVLookupEntry
-	59,  // 41: gastrolog.v1.LookupSettings.static_lookups:type_name -> gastrolog.v1.StaticLookupEntry
-	57,  // 42: gastrolog.v1.LookupSettings.yaml_file_lookups:type_name -> gastrolog.v1.YAMLFileLookupEntry
-	158, // 43: gastrolog.v1.HTTPLookupEntry.headers:type_name -> gastrolog.v1.HTTPLookupEntry.HeadersEntry
-	54,  // 44: gastrolog.v1.HTTPLookupEntry.parameters:type_name -> gastrolog.v1.HTTPLookupParam
-	60,  // 45: gastrolog.v1.StaticLookupEntry.rows:type_name -> gastrolog.v1.StaticLookupRow
-	159, // 46: gastrolog.v1.StaticLookupRow.values:type_name -> gastrolog.v1.StaticLookupRow.ValuesEntry
-	48,  // 47: gastrolog.v1.GetSettingsResponse.auth:type_name -> gastrolog.v1.AuthSettings
-	49,  // 48: gastrolog.v1.GetSettingsResponse.query:type_name -> gastrolog.v1.QuerySettings
-	50,  // 49: gastrolog.v1.GetSettingsResponse.scheduler:type_name -> gastrolog.v1.SchedulerSettings
-	51,  // 50: gastrolog.v1.GetSettingsResponse.tls:type_name -> gastrolog.v1.TLSSettings
-	52,  // 51: gastrolog.v1.GetSettingsResponse.lookup:type_name -> gastrolog.v1.LookupSettings
-	61,  // 52: gastrolog.v1.GetSettingsResponse.cluster:type_name -> gastrolog.v1.ClusterSettings
-	47,  // 53: gastrolog.v1.GetSettingsResponse.maxmind:type_name -> gastrolog.v1.MaxMindSettings
-	63,  // 54: gastrolog.v1.PutAuthSettings.password_policy:type_name -> gastrolog.v1.PutPasswordPolicySettings
-	55,  // 55: gastrolog.v1.PutLookupSettings.http_lookups:type_name -> gastrolog.v1.HTTPLookupEntry
-	56,  // 56: gastrolog.v1.PutLookupSettings.json_file_lookups:type_name -> gastrolog.v1.JSONFileLookupEntry
-	53,  // 57: gastrolog.v1.PutLookupSettings.mmdb_lookups:type_name -> gastrolog.v1.MMDBLookupEntry
-	58,  // 58: gastrolog.v1.PutLookupSettings.csv_lookups:type_name -> gastrolog.v1.CSVLookupEntry
-	59,  // 59: gastrolog.v1.PutLookupSettings.static_lookups:type_name -> gastrolog.v1.StaticLookupEntry
-	57,  // 60: gastrolog.v1.PutLookupSettings.yaml_file_lookups:type_name -> gastrolog.v1.YAMLFileLookupEntry
-	64,  // 61: gastrolog.v1.PutServiceSettingsRequest.auth:type_name -> gastrolog.v1.PutAuthSettings
-	65,  // 62: gastrolog.v1.PutServiceSettingsRequest.query:type_name -> gastrolog.v1.PutQuerySettings
-	66,  // 63: gastrolog.v1.PutServiceSettingsRequest.scheduler:type_name -> gastrolog.v1.PutSchedulerSettings
-	67,  // 64: gastrolog.v1.PutServiceSettingsRequest.tls:type_name -> gastrolog.v1.PutTLSSettings
-	70,  // 65: gastrolog.v1.PutServiceSettingsRequest.cluster:type_name -> gastrolog.v1.PutClusterSettings
-	62,  // 66: gastrolog.v1.SettingsMutationEcho.settings:type_name -> gastrolog.v1.GetSettingsResponse
-	72,  // 67: gastrolog.v1.PutServiceSettingsResponse.echo:type_name -> gastrolog.v1.SettingsMutationEcho
-	69,  // 68: gastrolog.v1.PutLookupSettingsRequest.lookup:type_name -> gastrolog.v1.PutLookupSettings
-	72,  // 69: gastrolog.v1.PutLookupSettingsResponse.echo:type_name -> gastrolog.v1.SettingsMutationEcho
-	68,  // 70: gastrolog.v1.PutMaxMindSettingsRequest.maxmind:type_name -> gastrolog.v1.PutMaxMindSettings
-	72,  // 71: gastrolog.v1.PutMaxMindSettingsResponse.echo:type_name -> gastrolog.v1.SettingsMutationEcho
-	72,  // 72: gastrolog.v1.PutSetupSettingsResponse.echo:type_name -> gastrolog.v1.SettingsMutationEcho
-	72,  // 73: gastrolog.v1.RegenerateJwtSecretResponse.echo:type_name -> gastrolog.v1.SettingsMutationEcho
-	84,  // 74: gastrolog.v1.PutPreferencesResponse.preferences:type_name -> gastrolog.v1.GetPreferencesResponse
-	87,  // 75: gastrolog.v1.GetSavedQueriesResponse.queries:type_name -> gastrolog.v1.SavedQuery
-	87,  // 76: gastrolog.v1.PutSavedQueryRequest.query:type_name -> gastrolog.v1.SavedQuery
-	89,  // 77: gastrolog.v1.PutSavedQueryResponse.saved_queries:type_name -> gastrolog.v1.GetSavedQueriesResponse
-	89,  // 78: gastrolog.v1.DeleteSavedQueryResponse.saved_queries:type_name -> gastrolog.v1.GetSavedQueriesResponse
-	96,  // 79: gastrolog.v1.ListCertificatesResponse.certificates:type_name -> gastrolog.v1.CertificateInfo
-	4,   // 80: gastrolog.v1.PutCertificateResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	4,   // 81: gastrolog.v1.DeleteCertificateResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	4,   // 82: gastrolog.v1.PauseVaultResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	4,   // 83: gastrolog.v1.ResumeVaultResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	160, // 84: gastrolog.v1.TestIngesterRequest.params:type_name -> gastrolog.v1.TestIngesterRequest.ParamsEntry
-	161, // 85: gastrolog.v1.TestCloudServiceRequest.params:type_name -> gastrolog.v1.TestCloudServiceRequest.ParamsEntry
-	162, // 86: gastrolog.v1.IngesterTypeDefaults.params:type_name -> gastrolog.v1.IngesterTypeDefaults.ParamsEntry
-	1,   // 87: gastrolog.v1.IngesterTypeDefaults.mode:type_name -> gastrolog.v1.IngesterMode
-	163, // 88: gastrolog.v1.GetIngesterDefaultsResponse.types:type_name -> gastrolog.v1.GetIngesterDefaultsResponse.TypesEntry
-	2,   // 89: gastrolog.v1.TierConfig.type:type_name -> gastrolog.v1.TierType
-	5,   // 90: gastrolog.v1.TierConfig.retention_rules:type_name -> gastrolog.v1.RetentionRule
-	118, // 91: gastrolog.v1.TierConfig.placements:type_name -> gastrolog.v1.TierPlacement
-	116, // 92: gastrolog.v1.PutNodeConfigRequest.config:type_name -> gastrolog.v1.NodeConfig
-	4,   // 93: gastrolog.v1.PutNodeConfigResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	127, // 94: gastrolog.v1.GetRouteStatsResponse.vault_stats:type_name -> gastrolog.v1.VaultRouteStats
-	128, // 95: gastrolog.v1.GetRouteStatsResponse.route_stats:type_name -> gastrolog.v1.PerRouteStats
-	129, // 96: gastrolog.v1.ListManagedFilesResponse.files:type_name -> gastrolog.v1.ManagedFileInfo
-	55,  // 97: gastrolog.v1.TestHTTPLookupRequest.config:type_name -> gastrolog.v1.HTTPLookupEntry
-	164, // 98: gastrolog.v1.TestHTTPLookupRequest.values:type_name -> gastrolog.v1.TestHTTPLookupRequest.ValuesEntry
-	136, // 99: gastrolog.v1.TestHTTPLookupResponse.results:type_name -> gastrolog.v1.TestHTTPLookupResult
-	165, // 100: gastrolog.v1.TestHTTPLookupResult.fields:type_name -> gastrolog.v1.TestHTTPLookupResult.FieldsEntry
-	139, // 101: gastrolog.v1.PreviewCSVLookupResponse.rows:type_name -> gastrolog.v1.CSVPreviewRow
-	166, // 102: gastrolog.v1.PreviewJSONLookupRequest.parameters:type_name -> gastrolog.v1.PreviewJSONLookupRequest.ParametersEntry
-	167, // 103: gastrolog.v1.PreviewYAMLLookupRequest.parameters:type_name -> gastrolog.v1.PreviewYAMLLookupRequest.ParametersEntry
-	168, // 104: gastrolog.v1.PutCloudServiceRequest.config:type_name -> gastrolog.v1.CloudService
-	4,   // 105: gastrolog.v1.PutCloudServiceResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	4,   // 106: gastrolog.v1.DeleteCloudServiceResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	169, // 107: gastrolog.v1.SetNodeStorageConfigRequest.config:type_name -> gastrolog.v1.NodeStorageConfig
-	4,   // 108: gastrolog.v1.SetNodeStorageConfigResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	117, // 109: gastrolog.v1.PutTierRequest.config:type_name -> gastrolog.v1.TierConfig
-	4,   // 110: gastrolog.v1.PutTierResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	4,   // 111: gastrolog.v1.DeleteTierResponse.system:type_name -> gastrolog.v1.GetSystemResponse
-	72,  // 112: gastrolog.v1.DeleteLookupResponse.echo:type_name -> gastrolog.v1.SettingsMutationEcho
-	114, // 113: gastrolog.v1.GetIngesterDefaultsResponse.TypesEntry.value:type_name -> gastrolog.v1.IngesterTypeDefaults
-	3,   // 114: gastrolog.v1.SystemService.GetSystem:input_type -> gastrolog.v1.GetSystemRequest
-	14,  // 115: gastrolog.v1.SystemService.ListIngesters:input_type -> gastrolog.v1.ListIngestersRequest
-	17,  // 116: gastrolog.v1.SystemService.GetIngesterStatus:input_type -> gastrolog.v1.GetIngesterStatusRequest
-	21,  // 117: gastrolog.v1.SystemService.PutFilter:input_type -> gastrolog.v1.PutFilterRequest
-	23,  // 118: gastrolog.v1.SystemService.DeleteFilter:input_type -> gastrolog.v1.DeleteFilterRequest
-	25,  // 119: gastrolog.v1.SystemService.PutRotationPolicy:input_type -> gastrolog.v1.PutRotationPolicyRequest
-	27,  // 120: gastrolog.v1.SystemService.DeleteRotationPolicy:input_type -> gastrolog.v1.DeleteRotationPolicyRequest
-	29,  // 121: gastrolog.v1.SystemService.PutRetentionPolicy:input_type -> gastrolog.v1.PutRetentionPolicyRequest
-	31,  // 122: gastrolog.v1.SystemService.DeleteRetentionPolicy:input_type -> gastrolog.v1.DeleteRetentionPolicyRequest
-	33,  // 123: gastrolog.v1.SystemService.PutVault:input_type -> gastrolog.v1.PutVaultRequest
-	35,  // 124: gastrolog.v1.SystemService.DeleteVault:input_type -> gastrolog.v1.DeleteVaultRequest
-	41,  // 125: gastrolog.v1.SystemService.PutIngester:input_type -> gastrolog.v1.PutIngesterRequest
-	43,  // 126: gastrolog.v1.SystemService.DeleteIngester:input_type -> gastrolog.v1.DeleteIngesterRequest
-	45,  // 127: gastrolog.v1.SystemService.GetSettings:input_type -> gastrolog.v1.GetSettingsRequest
-	71,  // 128: gastrolog.v1.SystemService.PutServiceSettings:input_type -> gastrolog.v1.PutServiceSettingsRequest
-	74,  // 129: gastrolog.v1.SystemService.PutLookupSettings:input_type -> gastrolog.v1.PutLookupSettingsRequest
-	76,  // 130: gastrolog.v1.SystemService.PutMaxMindSettings:input_type -> gastrolog.v1.PutMaxMindSettingsRequest
-	78,  // 131: gastrolog.v1.SystemService.PutSetupSettings:input_type -> gastrolog.v1.PutSetupSettingsRequest
-	80,  // 132: gastrolog.v1.SystemService.RegenerateJwtSecret:input_type -> gastrolog.v1.RegenerateJwtSecretRequest
-	83,  // 133: gastrolog.v1.SystemService.GetPreferences:input_type -> gastrolog.v1.GetPreferencesRequest
-	85,  // 134: gastrolog.v1.SystemService.PutPreferences:input_type -> gastrolog.v1.PutPreferencesRequest
-	88,  // 135: gastrolog.v1.SystemService.GetSavedQueries:input_type -> gastrolog.v1.GetSavedQueriesRequest
-	90,  // 136: gastrolog.v1.SystemService.PutSavedQuery:input_type -> gastrolog.v1.PutSavedQueryRequest
-	92,  // 137: gastrolog.v1.SystemService.DeleteSavedQuery:input_type -> gastrolog.v1.DeleteSavedQueryRequest
-	94,  // 138: gastrolog.v1.SystemService.ListCertificates:input_type -> gastrolog.v1.ListCertificatesRequest
-	97,  // 139: gastrolog.v1.SystemService.GetCertificate:input_type -> gastrolog.v1.GetCertificateRequest
-	99,  // 140: gastrolog.v1.SystemService.PutCertificate:input_type -> gastrolog.v1.PutCertificateRequest
-	101, // 141: gastrolog.v1.SystemService.DeleteCertificate:input_type -> gastrolog.v1.DeleteCertificateRequest
-	103, // 142: gastrolog.v1.SystemService.PauseVault:input_type -> gastrolog.v1.PauseVaultRequest
-	105, // 143: gastrolog.v1.SystemService.ResumeVault:input_type -> gastrolog.v1.ResumeVaultRequest
-	107, // 144: gastrolog.v1.SystemService.TestIngester:input_type -> gastrolog.v1.TestIngesterRequest
-	113, // 145: gastrolog.v1.SystemService.GetIngesterDefaults:input_type -> gastrolog.v1.GetIngesterDefaultsRequest
-	109, // 146: gastrolog.v1.SystemService.TriggerIngester:input_type -> gastrolog.v1.TriggerIngesterRequest
-	119, // 147: gastrolog.v1.SystemService.PutNodeConfig:input_type -> gastrolog.v1.PutNodeConfigRequest
-	37,  // 148: gastrolog.v1.SystemService.PutRoute:input_type -> gastrolog.v1.PutRouteRequest
-	39,  // 149: gastrolog.v1.SystemService.DeleteRoute:input_type -> gastrolog.v1.DeleteRouteRequest
-	121, // 150: gastrolog.v1.SystemService.GenerateName:input_type -> gastrolog.v1.GenerateNameRequest
-	123, // 151: gastrolog.v1.SystemService.WatchSystem:input_type -> gastrolog.v1.WatchSystemRequest
-	125, // 152: gastrolog.v1.SystemService.GetRouteStats:input_type -> gastrolog.v1.GetRouteStatsRequest
-	130, // 153: gastrolog.v1.SystemService.ListManagedFiles:input_type -> gastrolog.v1.ListManagedFilesRequest
-	132, // 154: gastrolog.v1.SystemService.DeleteManagedFile:input_type -> gastrolog.v1.DeleteManagedFileRequest
-	111, // 155: gastrolog.v1.SystemService.TestCloudService:input_type -> gastrolog.v1.TestCloudServiceRequest
-	134, // 156: gastrolog.v1.SystemService.TestHTTPLookup:input_type -> gastrolog.v1.TestHTTPLookupRequest
-	137, // 157: gastrolog.v1.SystemService.PreviewCSVLookup:input_type -> gastrolog.v1.PreviewCSVLookupRequest
-	140, // 158: gastrolog.v1.SystemService.PreviewJSONLookup:input_type -> gastrolog.v1.PreviewJSONLookupRequest
-	142, // 159: gastrolog.v1.SystemService.PreviewYAMLLookup:input_type -> gastrolog.v1.PreviewYAMLLookupRequest
-	19,  // 160: gastrolog.v1.SystemService.WatchIngesterStatus:input_type -> gastrolog.v1.WatchIngesterStatusRequest
-	144, // 161: gastrolog.v1.SystemService.PutCloudService:input_type -> gastrolog.v1.PutCloudServiceRequest
-	146, // 162: gastrolog.v1.SystemService.DeleteCloudService:input_type -> gastrolog.v1.DeleteCloudServiceRequest
-	148, // 163: gastrolog.v1.SystemService.SetNodeStorageConfig:input_type -> gastrolog.v1.SetNodeStorageConfigRequest
-	150, // 164: gastrolog.v1.SystemService.PutTier:input_type -> gastrolog.v1.PutTierRequest
-	152, // 165: gastrolog.v1.SystemService.DeleteTier:input_type -> gastrolog.v1.DeleteTierRequest
-	154, // 166: gastrolog.v1.SystemService.DeleteLookup:input_type -> gastrolog.v1.DeleteLookupRequest
-	4,   // 167: gastrolog.v1.SystemService.GetSystem:output_type -> gastrolog.v1.GetSystemResponse
-	15,  // 168: gastrolog.v1.SystemService.ListIngesters:output_type -> gastrolog.v1.ListIngestersResponse
-	18,  // 169: gastrolog.v1.SystemService.GetIngesterStatus:output_type -> gastrolog.v1.GetIngesterStatusResponse
-	22,  // 170: gastrolog.v1.SystemService.PutFilter:output_type -> gastrolog.v1.PutFilterResponse
-	24,  // 171: gastrolog.v1.SystemService.DeleteFilter:output_type -> gastrolog.v1.DeleteFilterResponse
-	26,  // 172: gastrolog.v1.SystemService.PutRotationPolicy:output_type -> gastrolog.v1.PutRotationPolicyResponse
-	28,  // 173: gastrolog.v1.SystemService.DeleteRotationPolicy:output_type -> gastrolog.v1.DeleteRotationPolicyResponse
-	30,  // 174: gastrolog.v1.SystemService.PutRetentionPolicy:output_type -> gastrolog.v1.PutRetentionPolicyResponse
-	32,  // 175: gastrolog.v1.SystemService.DeleteRetentionPolicy:output_type -> gastrolog.v1.DeleteRetentionPolicyResponse
-	34,  // 176: gastrolog.v1.SystemService.PutVault:output_type -> gastrolog.v1.PutVaultResponse
-	36,  // 177: gastrolog.v1.SystemService.DeleteVault:output_type -> gastrolog.v1.DeleteVaultResponse
-	42,  // 178: gastrolog.v1.SystemService.PutIngester:output_type -> gastrolog.v1.PutIngesterResponse
-	44,  // 179: gastrolog.v1.SystemService.DeleteIngester:output_type -> gastrolog.v1.DeleteIngesterResponse
-	62,  // 180: gastrolog.v1.SystemService.GetSettings:output_type -> gastrolog.v1.GetSettingsResponse
-	73,  // 181: gastrolog.v1.SystemService.PutServiceSettings:output_type -> gastrolog.v1.PutServiceSettingsResponse
-	75,  // 182: gastrolog.v1.SystemService.PutLookupSettings:output_type -> gastrolog.v1.PutLookupSettingsResponse
-	77,  // 183: gastrolog.v1.SystemService.PutMaxMindSettings:output_type -> gastrolog.v1.PutMaxMindSettingsResponse
-	79,  // 184: gastrolog.v1.SystemService.PutSetupSettings:output_type -> gastrolog.v1.PutSetupSettingsResponse
-	81,  // 185: gastrolog.v1.SystemService.RegenerateJwtSecret:output_type -> gastrolog.v1.RegenerateJwtSecretResponse
-	84,  // 186: gastrolog.v1.SystemService.GetPreferences:output_type -> gastrolog.v1.GetPreferencesResponse
-	86,  // 187: gastrolog.v1.SystemService.PutPreferences:output_type -> gastrolog.v1.PutPreferencesResponse
-	89,  // 188: gastrolog.v1.SystemService.GetSavedQueries:output_type -> gastrolog.v1.GetSavedQueriesResponse
-	91,  // 189: gastrolog.v1.SystemService.PutSavedQuery:output_type -> gastrolog.v1.PutSavedQueryResponse
-	93,  // 190: gastrolog.v1.SystemService.DeleteSavedQuery:output_type -> gastrolog.v1.DeleteSavedQueryResponse
-	95,  // 191: gastrolog.v1.SystemService.ListCertificates:output_type -> gastrolog.v1.ListCertificatesResponse
-	98,  // 192: gastrolog.v1.SystemService.GetCertificate:output_type -> gastrolog.v1.GetCertificateResponse
-	100, // 193: gastrolog.v1.SystemService.PutCertificate:output_type -> gastrolog.v1.PutCertificateResponse
-	102, // 194: gastrolog.v1.SystemService.DeleteCertificate:output_type -> gastrolog.v1.DeleteCertificateResponse
-	104, // 195: gastrolog.v1.SystemService.PauseVault:output_type -> gastrolog.v1.PauseVaultResponse
-	106, // 196: gastrolog.v1.SystemService.ResumeVault:output_type -> gastrolog.v1.ResumeVaultResponse
-	108, // 197: gastrolog.v1.SystemService.TestIngester:output_type -> gastrolog.v1.TestIngesterResponse
-	115, // 198: gastrolog.v1.SystemService.GetIngesterDefaults:output_type -> gastrolog.v1.GetIngesterDefaultsResponse
-	110, // 199: gastrolog.v1.SystemService.TriggerIngester:output_type -> gastrolog.v1.TriggerIngesterResponse
-	120, // 200: gastrolog.v1.SystemService.PutNodeConfig:output_type -> gastrolog.v1.PutNodeConfigResponse
-	38,  // 201: gastrolog.v1.SystemService.PutRoute:output_type -> gastrolog.v1.PutRouteResponse
-	40,  // 202: gastrolog.v1.SystemService.DeleteRoute:output_type -> gastrolog.v1.DeleteRouteResponse
-	122, // 203: gastrolog.v1.SystemService.GenerateName:output_type -> gastrolog.v1.GenerateNameResponse
-	124, // 204: gastrolog.v1.SystemService.WatchSystem:output_type -> gastrolog.v1.WatchSystemResponse
-	126, // 205: gastrolog.v1.SystemService.GetRouteStats:output_type -> gastrolog.v1.GetRouteStatsResponse
-	131, // 206: gastrolog.v1.SystemService.ListManagedFiles:output_type -> gastrolog.v1.ListManagedFilesResponse
-	133, // 207: gastrolog.v1.SystemService.DeleteManagedFile:output_type -> gastrolog.v1.DeleteManagedFileResponse
-	112, // 208: gastrolog.v1.SystemService.TestCloudService:output_type -> gastrolog.v1.TestCloudServiceResponse
-	135, // 209: gastrolog.v1.SystemService.TestHTTPLookup:output_type -> gastrolog.v1.TestHTTPLookupResponse
-	138, // 210: gastrolog.v1.SystemService.PreviewCSVLookup:output_type -> gastrolog.v1.PreviewCSVLookupResponse
-	141, // 211: gastrolog.v1.SystemService.PreviewJSONLookup:output_type -> gastrolog.v1.PreviewJSONLookupResponse
-	143, // 212: gastrolog.v1.SystemService.PreviewYAMLLookup:output_type -> gastrolog.v1.PreviewYAMLLookupResponse
-	20,  // 213: gastrolog.v1.SystemService.WatchIngesterStatus:output_type -> gastrolog.v1.WatchIngesterStatusResponse
-	145, // 214: gastrolog.v1.SystemService.PutCloudService:output_type -> gastrolog.v1.PutCloudServiceResponse
-	147, // 215: gastrolog.v1.SystemService.DeleteCloudService:output_type -> gastrolog.v1.DeleteCloudServiceResponse
-	149, // 216: gastrolog.v1.SystemService.SetNodeStorageConfig:output_type -> gastrolog.v1.SetNodeStorageConfigResponse
-	151, // 217: gastrolog.v1.SystemService.PutTier:output_type -> gastrolog.v1.PutTierResponse
-	153, // 218: gastrolog.v1.SystemService.DeleteTier:output_type -> gastrolog.v1.DeleteTierResponse
-	155, // 219: gastrolog.v1.SystemService.DeleteLookup:output_type -> gastrolog.v1.DeleteLookupResponse
-	167, // [167:220] is the sub-list for method output_type
-	114, // [114:167] is the sub-list for method input_type
-	114, // [114:114] is the sub-list for extension type_name
-	114, // [114:114] is the sub-list for extension extendee
-	0,   // [0:114] is the sub-list for field type_name
+	151, // 7: gastrolog.v1.GetSystemResponse.managed_files:type_name -> gastrolog.v1.ManagedFileInfo
+	194, // 8: gastrolog.v1.GetSystemResponse.cloud_services:type_name -> gastrolog.v1.CloudService
+	195, // 9: gastrolog.v1.GetSystemResponse.node_storage_configs:type_name -> gastrolog.v1.NodeStorageConfig
+	139, // 10: gastrolog.v1.GetSystemResponse.tiers:type_name -> gastrolog.v1.TierConfig
+	47,  // 11: gastrolog.v1.GetSystemResponse.alert_rules:type_name -> gastrolog.v1.AlertRuleConfig
+	56,  // 12: gastrolog.v1.GetSystemResponse.roles:type_name -> gastrolog.v1.RoleConfig
+	0,   // 13: gastrolog.v1.VaultConfig.type:type_name -> gastrolog.v1.VaultType
+	5,   // 14: gastrolog.v1.VaultConfig.retention_rules:type_name -> gastrolog.v1.RetentionRule
+	6,   // 15: gastrolog.v1.VaultConfig.placements:type_name -> gastrolog.v1.VaultPlacement
+	8,   // 16: gastrolog.v1.RouteConfig.destinations:type_name -> gastrolog.v1.RouteDestination
+	10,  // 17: gastrolog.v1.RouteConfig.stages:type_name -> gastrolog.v1.RouteStage
+	178, // 18: gastrolog.v1.RouteStage.params:type_name -> gastrolog.v1.RouteStage.ParamsEntry
+	179, // 19: gastrolog.v1.IngesterConfig.params:type_name -> gastrolog.v1.IngesterConfig.ParamsEntry
+	17,  // 20: gastrolog.v1.ListIngestersResponse.ingesters:type_name -> gastrolog.v1.IngesterInfo
+	180, // 21: gastrolog.v1.IngesterInfo.node_status:type_name -> gastrolog.v1.IngesterInfo.NodeStatusEntry
+	12,  // 22: gastrolog.v1.PutFilterRequest.config:type_name -> gastrolog.v1.FilterConfig
+	4,   // 23: gastrolog.v1.PutFilterResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	4,   // 24: gastrolog.v1.DeleteFilterResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	13,  // 25: gastrolog.v1.PutRotationPolicyRequest.config:type_name -> gastrolog.v1.RotationPolicyConfig
+	4,   // 26: gastrolog.v1.PutRotationPolicyResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	4,   // 27: gastrolog.v1.DeleteRotationPolicyResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	14,  // 28: gastrolog.v1.PutRetentionPolicyRequest.config:type_name -> gastrolog.v1.RetentionPolicyConfig
+	4,   // 29: gastrolog.v1.PutRetentionPolicyResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	4,   // 30: gastrolog.v1.DeleteRetentionPolicyResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	7,   // 31: gastrolog.v1.PutVaultRequest.config:type_name -> gastrolog.v1.VaultConfig
+	4,   // 32: gastrolog.v1.PutVaultResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	4,   // 33: gastrolog.v1.DeleteVaultResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	9,   // 34: gastrolog.v1.PutRouteRequest.config:type_name -> gastrolog.v1.RouteConfig
+	4,   // 35: gastrolog.v1.PutRouteResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	4,   // 36: gastrolog.v1.DeleteRouteResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	10,  // 37: gastrolog.v1.PreviewRoutePipelineRequest.stages:type_name -> gastrolog.v1.RouteStage
+	181, // 38: gastrolog.v1.PreviewRoutePipelineRequest.attrs:type_name -> gastrolog.v1.PreviewRoutePipelineRequest.AttrsEntry
+	44,  // 39: gastrolog.v1.PreviewRoutePipelineResponse.results:type_name -> gastrolog.v1.RoutePipelineResult
+	182, // 40: gastrolog.v1.RoutePipelineResult.attrs:type_name -> gastrolog.v1.RoutePipelineResult.AttrsEntry
+	183, // 41: gastrolog.v1.AlertSink.params:type_name -> gastrolog.v1.AlertSink.ParamsEntry
+	45,  // 42: gastrolog.v1.AlertRuleConfig.condition:type_name -> gastrolog.v1.AlertCondition
+	46,  // 43: gastrolog.v1.AlertRuleConfig.sinks:type_name -> gastrolog.v1.AlertSink
+	47,  // 44: gastrolog.v1.PutAlertRuleRequest.config:type_name -> gastrolog.v1.AlertRuleConfig
+	4,   // 45: gastrolog.v1.PutAlertRuleResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	4,   // 46: gastrolog.v1.DeleteAlertRuleResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	196, // 47: gastrolog.v1.AlertRuleStatus.last_evaluated:type_name -> google.protobuf.Timestamp
+	196, // 48: gastrolog.v1.AlertRuleStatus.firing_since:type_name -> google.protobuf.Timestamp
+	196, // 49: gastrolog.v1.AlertRuleStatus.last_resolved:type_name -> google.protobuf.Timestamp
+	53,  // 50: gastrolog.v1.GetAlertRuleStatusResponse.statuses:type_name -> gastrolog.v1.AlertRuleStatus
+	55,  // 51: gastrolog.v1.RoleConfig.grants:type_name -> gastrolog.v1.VaultGrant
+	56,  // 52: gastrolog.v1.PutRoleRequest.config:type_name -> gastrolog.v1.RoleConfig
+	4,   // 53: gastrolog.v1.PutRoleResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	4,   // 54: gastrolog.v1.DeleteRoleResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	11,  // 55: gastrolog.v1.PutIngesterRequest.config:type_name -> gastrolog.v1.IngesterConfig
+	4,   // 56: gastrolog.v1.PutIngesterResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	4,   // 57: gastrolog.v1.DeleteIngesterResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	66,  // 58: gastrolog.v1.AuthSettings.password_policy:type_name -> gastrolog.v1.PasswordPolicySettings
+	69,  // 59: gastrolog.v1.AuthSettings.oidc:type_name -> gastrolog.v1.OIDCSettings
+	76,  // 60: gastrolog.v1.LookupSettings.http_lookups:type_name -> gastrolog.v1.HTTPLookupEntry
+	77,  // 61: gastrolog.v1.LookupSettings.json_file_lookups:type_name -> gastrolog.v1.JSONFileLookupEntry
+	74,  // 62: gastrolog.v1.LookupSettings.mmdb_lookups:type_name -> gastrolog.v1.MMDBLookupEntry
+	79,  // 63: gastrolog.v1.LookupSettings.csv_lookups:type_name -> gastrolog.v1.CSVLookupEntry
+	80,  // 64: gastrolog.v1.LookupSettings.static_lookups:type_name -> gastrolog.v1.StaticLookupEntry
+	78,  // 65: gastrolog.v1.LookupSettings.yaml_file_lookups:type_name -> gastrolog.v1.YAMLFileLookupEntry
+	184, // 66: gastrolog.v1.HTTPLookupEntry.headers:type_name -> gastrolog.v1.HTTPLookupEntry.HeadersEntry
+	75,  // 67: gastrolog.v1.HTTPLookupEntry.parameters:type_name -> gastrolog.v1.HTTPLookupParam
+	81,  // 68: gastrolog.v1.StaticLookupEntry.rows:type_name -> gastrolog.v1.StaticLookupRow
+	185, // 69: gastrolog.v1.StaticLookupRow.values:type_name -> gastrolog.v1.StaticLookupRow.ValuesEntry
+	68,  // 70: gastrolog.v1.GetSettingsResponse.auth:type_name -> gastrolog.v1.AuthSettings
+	70,  // 71: gastrolog.v1.GetSettingsResponse.query:type_name -> gastrolog.v1.QuerySettings
+	71,  // 72: gastrolog.v1.GetSettingsResponse.scheduler:type_name -> gastrolog.v1.SchedulerSettings
+	72,  // 73: gastrolog.v1.GetSettingsResponse.tls:type_name -> gastrolog.v1.TLSSettings
+	73,  // 74: gastrolog.v1.GetSettingsResponse.lookup:type_name -> gastrolog.v1.LookupSettings
+	82,  // 75: gastrolog.v1.GetSettingsResponse.cluster:type_name -> gastrolog.v1.ClusterSettings
+	67,  // 76: gastrolog.v1.GetSettingsResponse.maxmind:type_name -> gastrolog.v1.MaxMindSettings
+	84,  // 77: gastrolog.v1.PutAuthSettings.password_policy:type_name -> gastrolog.v1.PutPasswordPolicySettings
+	86,  // 78: gastrolog.v1.PutAuthSettings.oidc:type_name -> gastrolog.v1.PutOIDCSettings
+	76,  // 79: gastrolog.v1.PutLookupSettings.http_lookups:type_name -> gastrolog.v1.HTTPLookupEntry
+	77,  // 80: gastrolog.v1.PutLookupSettings.json_file_lookups:type_name -> gastrolog.v1.JSONFileLookupEntry
+	74,  // 81: gastrolog.v1.PutLookupSettings.mmdb_lookups:type_name -> gastrolog.v1.MMDBLookupEntry
+	79,  // 82: gastrolog.v1.PutLookupSettings.csv_lookups:type_name -> gastrolog.v1.CSVLookupEntry
+	80,  // 83: gastrolog.v1.PutLookupSettings.static_lookups:type_name -> gastrolog.v1.StaticLookupEntry
+	78,  // 84: gastrolog.v1.PutLookupSettings.yaml_file_lookups:type_name -> gastrolog.v1.YAMLFileLookupEntry
+	85,  // 85: gastrolog.v1.PutServiceSettingsRequest.auth:type_name -> gastrolog.v1.PutAuthSettings
+	87,  // 86: gastrolog.v1.PutServiceSettingsRequest.query:type_name -> gastrolog.v1.PutQuerySettings
+	88,  // 87: gastrolog.v1.PutServiceSettingsRequest.scheduler:type_name -> gastrolog.v1.PutSchedulerSettings
+	89,  // 88: gastrolog.v1.PutServiceSettingsRequest.tls:type_name -> gastrolog.v1.PutTLSSettings
+	92,  // 89: gastrolog.v1.PutServiceSettingsRequest.cluster:type_name -> gastrolog.v1.PutClusterSettings
+	83,  // 90: gastrolog.v1.SettingsMutationEcho.settings:type_name -> gastrolog.v1.GetSettingsResponse
+	94,  // 91: gastrolog.v1.PutServiceSettingsResponse.echo:type_name -> gastrolog.v1.SettingsMutationEcho
+	91,  // 92: gastrolog.v1.PutLookupSettingsRequest.lookup:type_name -> gastrolog.v1.PutLookupSettings
+	94,  // 93: gastrolog.v1.PutLookupSettingsResponse.echo:type_name -> gastrolog.v1.SettingsMutationEcho
+	90,  // 94: gastrolog.v1.PutMaxMindSettingsRequest.maxmind:type_name -> gastrolog.v1.PutMaxMindSettings
+	94,  // 95: gastrolog.v1.PutMaxMindSettingsResponse.echo:type_name -> gastrolog.v1.SettingsMutationEcho
+	94,  // 96: gastrolog.v1.PutSetupSettingsResponse.echo:type_name -> gastrolog.v1.SettingsMutationEcho
+	94,  // 97: gastrolog.v1.RegenerateJwtSecretResponse.echo:type_name -> gastrolog.v1.SettingsMutationEcho
+	106, // 98: gastrolog.v1.PutPreferencesResponse.preferences:type_name -> gastrolog.v1.GetPreferencesResponse
+	109, // 99: gastrolog.v1.GetSavedQueriesResponse.queries:type_name -> gastrolog.v1.SavedQuery
+	109, // 100: gastrolog.v1.PutSavedQueryRequest.query:type_name -> gastrolog.v1.SavedQuery
+	111, // 101: gastrolog.v1.PutSavedQueryResponse.saved_queries:type_name -> gastrolog.v1.GetSavedQueriesResponse
+	111, // 102: gastrolog.v1.DeleteSavedQueryResponse.saved_queries:type_name -> gastrolog.v1.GetSavedQueriesResponse
+	118, // 103: gastrolog.v1.ListCertificatesResponse.certificates:type_name -> gastrolog.v1.CertificateInfo
+	4,   // 104: gastrolog.v1.PutCertificateResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	4,   // 105: gastrolog.v1.DeleteCertificateResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	4,   // 106: gastrolog.v1.PauseVaultResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	4,   // 107: gastrolog.v1.ResumeVaultResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	186, // 108: gastrolog.v1.TestIngesterRequest.params:type_name -> gastrolog.v1.TestIngesterRequest.ParamsEntry
+	187, // 109: gastrolog.v1.TestCloudServiceRequest.params:type_name -> gastrolog.v1.TestCloudServiceRequest.ParamsEntry
+	188, // 110: gastrolog.v1.IngesterTypeDefaults.params:type_name -> gastrolog.v1.IngesterTypeDefaults.ParamsEntry
+	1,   // 111: gastrolog.v1.IngesterTypeDefaults.mode:type_name -> gastrolog.v1.IngesterMode
+	189, // 112: gastrolog.v1.GetIngesterDefaultsResponse.types:type_name -> gastrolog.v1.GetIngesterDefaultsResponse.TypesEntry
+	2,   // 113: gastrolog.v1.TierConfig.type:type_name -> gastrolog.v1.TierType
+	5,   // 114: gastrolog.v1.TierConfig.retention_rules:type_name -> gastrolog.v1.RetentionRule
+	140, // 115: gastrolog.v1.TierConfig.placements:type_name -> gastrolog.v1.TierPlacement
+	138, // 116: gastrolog.v1.PutNodeConfigRequest.config:type_name -> gastrolog.v1.NodeConfig
+	4,   // 117: gastrolog.v1.PutNodeConfigResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	149, // 118: gastrolog.v1.GetRouteStatsResponse.vault_stats:type_name -> gastrolog.v1.VaultRouteStats
+	150, // 119: gastrolog.v1.GetRouteStatsResponse.route_stats:type_name -> gastrolog.v1.PerRouteStats
+	151, // 120: gastrolog.v1.ListManagedFilesResponse.files:type_name -> gastrolog.v1.ManagedFileInfo
+	76,  // 121: gastrolog.v1.TestHTTPLookupRequest.config:type_name -> gastrolog.v1.HTTPLookupEntry
+	190, // 122: gastrolog.v1.TestHTTPLookupRequest.values:type_name -> gastrolog.v1.TestHTTPLookupRequest.ValuesEntry
+	158, // 123: gastrolog.v1.TestHTTPLookupResponse.results:type_name -> gastrolog.v1.TestHTTPLookupResult
+	191, // 124: gastrolog.v1.TestHTTPLookupResult.fields:type_name -> gastrolog.v1.TestHTTPLookupResult.FieldsEntry
+	161, // 125: gastrolog.v1.PreviewCSVLookupResponse.rows:type_name -> gastrolog.v1.CSVPreviewRow
+	192, // 126: gastrolog.v1.PreviewJSONLookupRequest.parameters:type_name -> gastrolog.v1.PreviewJSONLookupRequest.ParametersEntry
+	193, // 127: gastrolog.v1.PreviewYAMLLookupRequest.parameters:type_name -> gastrolog.v1.PreviewYAMLLookupRequest.ParametersEntry
+	194, // 128: gastrolog.v1.PutCloudServiceRequest.config:type_name -> gastrolog.v1.CloudService
+	4,   // 129: gastrolog.v1.PutCloudServiceResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	4,   // 130: gastrolog.v1.DeleteCloudServiceResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	195, // 131: gastrolog.v1.SetNodeStorageConfigRequest.config:type_name -> gastrolog.v1.NodeStorageConfig
+	4,   // 132: gastrolog.v1.SetNodeStorageConfigResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	139, // 133: gastrolog.v1.PutTierRequest.config:type_name -> gastrolog.v1.TierConfig
+	4,   // 134: gastrolog.v1.PutTierResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	4,   // 135: gastrolog.v1.DeleteTierResponse.system:type_name -> gastrolog.v1.GetSystemResponse
+	94,  // 136: gastrolog.v1.DeleteLookupResponse.echo:type_name -> gastrolog.v1.SettingsMutationEcho
+	136, // 137: gastrolog.v1.GetIngesterDefaultsResponse.TypesEntry.value:type_name -> gastrolog.v1.IngesterTypeDefaults
+	3,   // 138: gastrolog.v1.SystemService.GetSystem:input_type -> gastrolog.v1.GetSystemRequest
+	15,  // 139: gastrolog.v1.SystemService.ListIngesters:input_type -> gastrolog.v1.ListIngestersRequest
+	18,  // 140: gastrolog.v1.SystemService.GetIngesterStatus:input_type -> gastrolog.v1.GetIngesterStatusRequest
+	22,  // 141: gastrolog.v1.SystemService.PutFilter:input_type -> gastrolog.v1.PutFilterRequest
+	24,  // 142: gastrolog.v1.SystemService.DeleteFilter:input_type -> gastrolog.v1.DeleteFilterRequest
+	26,  // 143: gastrolog.v1.SystemService.PutRotationPolicy:input_type -> gastrolog.v1.PutRotationPolicyRequest
+	28,  // 144: gastrolog.v1.SystemService.DeleteRotationPolicy:input_type -> gastrolog.v1.DeleteRotationPolicyRequest
+	30,  // 145: gastrolog.v1.SystemService.PutRetentionPolicy:input_type -> gastrolog.v1.PutRetentionPolicyRequest
+	32,  // 146: gastrolog.v1.SystemService.DeleteRetentionPolicy:input_type -> gastrolog.v1.DeleteRetentionPolicyRequest
+	34,  // 147: gastrolog.v1.SystemService.PutVault:input_type -> gastrolog.v1.PutVaultRequest
+	36,  // 148: gastrolog.v1.SystemService.DeleteVault:input_type -> gastrolog.v1.DeleteVaultRequest
+	61,  // 149: gastrolog.v1.SystemService.PutIngester:input_type -> gastrolog.v1.PutIngesterRequest
+	63,  // 150: gastrolog.v1.SystemService.DeleteIngester:input_type -> gastrolog.v1.DeleteIngesterRequest
+	65,  // 151: gastrolog.v1.SystemService.GetSettings:input_type -> gastrolog.v1.GetSettingsRequest
+	93,  // 152: gastrolog.v1.SystemService.PutServiceSettings:input_type -> gastrolog.v1.PutServiceSettingsRequest
+	96,  // 153: gastrolog.v1.SystemService.PutLookupSettings:input_type -> gastrolog.v1.PutLookupSettingsRequest
+	98,  // 154: gastrolog.v1.SystemService.PutMaxMindSettings:input_type -> gastrolog.v1.PutMaxMindSettingsRequest
+	100, // 155: gastrolog.v1.SystemService.PutSetupSettings:input_type -> gastrolog.v1.PutSetupSettingsRequest
+	102, // 156: gastrolog.v1.SystemService.RegenerateJwtSecret:input_type -> gastrolog.v1.RegenerateJwtSecretRequest
+	105, // 157: gastrolog.v1.SystemService.GetPreferences:input_type -> gastrolog.v1.GetPreferencesRequest
+	107, // 158: gastrolog.v1.SystemService.PutPreferences:input_type -> gastrolog.v1.PutPreferencesRequest
+	110, // 159: gastrolog.v1.SystemService.GetSavedQueries:input_type -> gastrolog.v1.GetSavedQueriesRequest
+	112, // 160: gastrolog.v1.SystemService.PutSavedQuery:input_type -> gastrolog.v1.PutSavedQueryRequest
+	114, // 161: gastrolog.v1.SystemService.DeleteSavedQuery:input_type -> gastrolog.v1.DeleteSavedQueryRequest
+	116, // 162: gastrolog.v1.SystemService.ListCertificates:input_type -> gastrolog.v1.ListCertificatesRequest
+	119, // 163: gastrolog.v1.SystemService.GetCertificate:input_type -> gastrolog.v1.GetCertificateRequest
+	121, // 164: gastrolog.v1.SystemService.PutCertificate:input_type -> gastrolog.v1.PutCertificateRequest
+	123, // 165: gastrolog.v1.SystemService.DeleteCertificate:input_type -> gastrolog.v1.DeleteCertificateRequest
+	125, // 166: gastrolog.v1.SystemService.PauseVault:input_type -> gastrolog.v1.PauseVaultRequest
+	127, // 167: gastrolog.v1.SystemService.ResumeVault:input_type -> gastrolog.v1.ResumeVaultRequest
+	129, // 168: gastrolog.v1.SystemService.TestIngester:input_type -> gastrolog.v1.TestIngesterRequest
+	135, // 169: gastrolog.v1.SystemService.GetIngesterDefaults:input_type -> gastrolog.v1.GetIngesterDefaultsRequest
+	131, // 170: gastrolog.v1.SystemService.TriggerIngester:input_type -> gastrolog.v1.TriggerIngesterRequest
+	141, // 171: gastrolog.v1.SystemService.PutNodeConfig:input_type -> gastrolog.v1.PutNodeConfigRequest
+	38,  // 172: gastrolog.v1.SystemService.PutRoute:input_type -> gastrolog.v1.PutRouteRequest
+	40,  // 173: gastrolog.v1.SystemService.DeleteRoute:input_type -> gastrolog.v1.DeleteRouteRequest
+	42,  // 174: gastrolog.v1.SystemService.PreviewRoutePipeline:input_type -> gastrolog.v1.PreviewRoutePipelineRequest
+	143, // 175: gastrolog.v1.SystemService.GenerateName:input_type -> gastrolog.v1.GenerateNameRequest
+	145, // 176: gastrolog.v1.SystemService.WatchSystem:input_type -> gastrolog.v1.WatchSystemRequest
+	147, // 177: gastrolog.v1.SystemService.GetRouteStats:input_type -> gastrolog.v1.GetRouteStatsRequest
+	152, // 178: gastrolog.v1.SystemService.ListManagedFiles:input_type -> gastrolog.v1.ListManagedFilesRequest
+	154, // 179: gastrolog.v1.SystemService.DeleteManagedFile:input_type -> gastrolog.v1.DeleteManagedFileRequest
+	133, // 180: gastrolog.v1.SystemService.TestCloudService:input_type -> gastrolog.v1.TestCloudServiceRequest
+	156, // 181: gastrolog.v1.SystemService.TestHTTPLookup:input_type -> gastrolog.v1.TestHTTPLookupRequest
+	159, // 182: gastrolog.v1.SystemService.PreviewCSVLookup:input_type -> gastrolog.v1.PreviewCSVLookupRequest
+	162, // 183: gastrolog.v1.SystemService.PreviewJSONLookup:input_type -> gastrolog.v1.PreviewJSONLookupRequest
+	164, // 184: gastrolog.v1.SystemService.PreviewYAMLLookup:input_type -> gastrolog.v1.PreviewYAMLLookupRequest
+	20,  // 185: gastrolog.v1.SystemService.WatchIngesterStatus:input_type -> gastrolog.v1.WatchIngesterStatusRequest
+	166, // 186: gastrolog.v1.SystemService.PutCloudService:input_type -> gastrolog.v1.PutCloudServiceRequest
+	168, // 187: gastrolog.v1.SystemService.DeleteCloudService:input_type -> gastrolog.v1.DeleteCloudServiceRequest
+	170, // 188: gastrolog.v1.SystemService.SetNodeStorageConfig:input_type -> gastrolog.v1.SetNodeStorageConfigRequest
+	172, // 189: gastrolog.v1.SystemService.PutTier:input_type -> gastrolog.v1.PutTierRequest
+	174, // 190: gastrolog.v1.SystemService.DeleteTier:input_type -> gastrolog.v1.DeleteTierRequest
+	176, // 191: gastrolog.v1.SystemService.DeleteLookup:input_type -> gastrolog.v1.DeleteLookupRequest
+	48,  // 192: gastrolog.v1.SystemService.PutAlertRule:input_type -> gastrolog.v1.PutAlertRuleRequest
+	50,  // 193: gastrolog.v1.SystemService.DeleteAlertRule:input_type -> gastrolog.v1.DeleteAlertRuleRequest
+	52,  // 194: gastrolog.v1.SystemService.GetAlertRuleStatus:input_type -> gastrolog.v1.GetAlertRuleStatusRequest
+	57,  // 195: gastrolog.v1.SystemService.PutRole:input_type -> gastrolog.v1.PutRoleRequest
+	59,  // 196: gastrolog.v1.SystemService.DeleteRole:input_type -> gastrolog.v1.DeleteRoleRequest
+	4,   // 197: gastrolog.v1.SystemService.GetSystem:output_type -> gastrolog.v1.GetSystemResponse
+	16,  // 198: gastrolog.v1.SystemService.ListIngesters:output_type -> gastrolog.v1.ListIngestersResponse
+	19,  // 199: gastrolog.v1.SystemService.GetIngesterStatus:output_type -> gastrolog.v1.GetIngesterStatusResponse
+	23,  // 200: gastrolog.v1.SystemService.PutFilter:output_type -> gastrolog.v1.PutFilterResponse
+	25,  // 201: gastrolog.v1.SystemService.DeleteFilter:output_type -> gastrolog.v1.DeleteFilterResponse
+	27,  // 202: gastrolog.v1.SystemService.PutRotationPolicy:output_type -> gastrolog.v1.PutRotationPolicyResponse
+	29,  // 203: gastrolog.v1.SystemService.DeleteRotationPolicy:output_type -> gastrolog.v1.DeleteRotationPolicyResponse
+	31,  // 204: gastrolog.v1.SystemService.PutRetentionPolicy:output_type -> gastrolog.v1.PutRetentionPolicyResponse
+	33,  // 205: gastrolog.v1.SystemService.DeleteRetentionPolicy:output_type -> gastrolog.v1.DeleteRetentionPolicyResponse
+	35,  // 206: gastrolog.v1.SystemService.PutVault:output_type -> gastrolog.v1.PutVaultResponse
+	37,  // 207: gastrolog.v1.SystemService.DeleteVault:output_type -> gastrolog.v1.DeleteVaultResponse
+	62,  // 208: gastrolog.v1.SystemService.PutIngester:output_type -> gastrolog.v1.PutIngesterResponse
+	64,  // 209: gastrolog.v1.SystemService.DeleteIngester:output_type -> gastrolog.v1.DeleteIngesterResponse
+	83,  // 210: gastrolog.v1.SystemService.GetSettings:output_type -> gastrolog.v1.GetSettingsResponse
+	95,  // 211: gastrolog.v1.SystemService.PutServiceSettings:output_type -> gastrolog.v1.PutServiceSettingsResponse
+	97,  // 212: gastrolog.v1.SystemService.PutLookupSettings:output_type -> gastrolog.v1.PutLookupSettingsResponse
+	99,  // 213: gastrolog.v1.SystemService.PutMaxMindSettings:output_type -> gastrolog.v1.PutMaxMindSettingsResponse
+	101, // 214: gastrolog.v1.SystemService.PutSetupSettings:output_type -> gastrolog.v1.PutSetupSettingsResponse
+	103, // 215: gastrolog.v1.SystemService.RegenerateJwtSecret:output_type -> gastrolog.v1.RegenerateJwtSecretResponse
+	106, // 216: gastrolog.v1.SystemService.GetPreferences:output_type -> gastrolog.v1.GetPreferencesResponse
+	108, // 217: gastrolog.v1.SystemService.PutPreferences:output_type -> gastrolog.v1.PutPreferencesResponse
+	111, // 218: gastrolog.v1.SystemService.GetSavedQueries:output_type -> gastrolog.v1.GetSavedQueriesResponse
+	113, // 219: gastrolog.v1.SystemService.PutSavedQuery:output_type -> gastrolog.v1.PutSavedQueryResponse
+	115, // 220: gastrolog.v1.SystemService.DeleteSavedQuery:output_type -> gastrolog.v1.DeleteSavedQueryResponse
+	117, // 221: gastrolog.v1.SystemService.ListCertificates:output_type -> gastrolog.v1.ListCertificatesResponse
+	120, // 222: gastrolog.v1.SystemService.GetCertificate:output_type -> gastrolog.v1.GetCertificateResponse
+	122, // 223: gastrolog.v1.SystemService.PutCertificate:output_type -> gastrolog.v1.PutCertificateResponse
+	124, // 224: gastrolog.v1.SystemService.DeleteCertificate:output_type -> gastrolog.v1.DeleteCertificateResponse
+	126, // 225: gastrolog.v1.SystemService.PauseVault:output_type -> gastrolog.v1.PauseVaultResponse
+	128, // 226: gastrolog.v1.SystemService.ResumeVault:output_type -> gastrolog.v1.ResumeVaultResponse
+	130, // 227: gastrolog.v1.SystemService.TestIngester:output_type -> gastrolog.v1.TestIngesterResponse
+	137, // 228: gastrolog.v1.SystemService.GetIngesterDefaults:output_type -> gastrolog.v1.GetIngesterDefaultsResponse
+	132, // 229: gastrolog.v1.SystemService.TriggerIngester:output_type -> gastrolog.v1.TriggerIngesterResponse
+	142, // 230: gastrolog.v1.SystemService.PutNodeConfig:output_type -> gastrolog.v1.PutNodeConfigResponse
+	39,  // 231: gastrolog.v1.SystemService.PutRoute:output_type -> gastrolog.v1.PutRouteResponse
+	41,  // 232: gastrolog.v1.SystemService.DeleteRoute:output_type -> gastrolog.v1.DeleteRouteResponse
+	43,  // 233: gastrolog.v1.SystemService.PreviewRoutePipeline:output_type -> gastrolog.v1.PreviewRoutePipelineResponse
+	144, // 234: gastrolog.v1.SystemService.GenerateName:output_type -> gastrolog.v1.GenerateNameResponse
+	146, // 235: gastrolog.v1.SystemService.WatchSystem:output_type -> gastrolog.v1.WatchSystemResponse
+	148, // 236: gastrolog.v1.SystemService.GetRouteStats:output_type -> gastrolog.v1.GetRouteStatsResponse
+	153, // 237: gastrolog.v1.SystemService.ListManagedFiles:output_type -> gastrolog.v1.ListManagedFilesResponse
+	155, // 238: gastrolog.v1.SystemService.DeleteManagedFile:output_type -> gastrolog.v1.DeleteManagedFileResponse
+	134, // 239: gastrolog.v1.SystemService.TestCloudService:output_type -> gastrolog.v1.TestCloudServiceResponse
+	157, // 240: gastrolog.v1.SystemService.TestHTTPLookup:output_type -> gastrolog.v1.TestHTTPLookupResponse
+	160, // 241: gastrolog.v1.SystemService.PreviewCSVLookup:output_type -> gastrolog.v1.PreviewCSVLookupResponse
+	163, // 242: gastrolog.v1.SystemService.PreviewJSONLookup:output_type -> gastrolog.v1.PreviewJSONLookupResponse
+	165, // 243: gastrolog.v1.SystemService.PreviewYAMLLookup:output_type -> gastrolog.v1.PreviewYAMLLookupResponse
+	21,  // 244: gastrolog.v1.SystemService.WatchIngesterStatus:output_type -> gastrolog.v1.WatchIngesterStatusResponse
+	167, // 245: gastrolog.v1.SystemService.PutCloudService:output_type -> gastrolog.v1.PutCloudServiceResponse
+	169, // 246: gastrolog.v1.SystemService.DeleteCloudService:output_type -> gastrolog.v1.DeleteCloudServiceResponse
+	171, // 247: gastrolog.v1.SystemService.SetNodeStorageConfig:output_type -> gastrolog.v1.SetNodeStorageConfigResponse
+	173, // 248: gastrolog.v1.SystemService.PutTier:output_type -> gastrolog.v1.PutTierResponse
+	175, // 249: gastrolog.v1.SystemService.DeleteTier:output_type -> gastrolog.v1.DeleteTierResponse
+	177, // 250: gastrolog.v1.SystemService.DeleteLookup:output_type -> gastrolog.v1.DeleteLookupResponse
+	49,  // 251: gastrolog.v1.SystemService.PutAlertRule:output_type -> gastrolog.v1.PutAlertRuleResponse
+	51,  // 252: gastrolog.v1.SystemService.DeleteAlertRule:output_type -> gastrolog.v1.DeleteAlertRuleResponse
+	54,  // 253: gastrolog.v1.SystemService.GetAlertRuleStatus:output_type -> gastrolog.v1.GetAlertRuleStatusResponse
+	58,  // 254: gastrolog.v1.SystemService.PutRole:output_type -> gastrolog.v1.PutRoleResponse
+	60,  // 255: gastrolog.v1.SystemService.DeleteRole:output_type -> gastrolog.v1.DeleteRoleResponse
+	197, // [197:256] is the sub-list for method output_type
+	138, // [138:197] is the sub-list for method input_type
+	138, // [138:138] is the sub-list for extension type_name
+	138, // [138:138] is the sub-list for extension extendee
+	0,   // [0:138] is the sub-list for field type_name
 }
 
 func init() { file_gastrolog_v1_system_proto_init() }
@@ -9955,21 +11527,22 @@ func file_gastrolog_v1_system_proto_init() {
 		return
 	}
 	file_gastrolog_v1_storage_proto_init()
-	file_gastrolog_v1_system_proto_msgTypes[60].OneofWrappers = []any{}
-	file_gastrolog_v1_system_proto_msgTypes[61].OneofWrappers = []any{}
-	file_gastrolog_v1_system_proto_msgTypes[62].OneofWrappers = []any{}
-	file_gastrolog_v1_system_proto_msgTypes[63].OneofWrappers = []any{}
-	file_gastrolog_v1_system_proto_msgTypes[64].OneofWrappers = []any{}
-	file_gastrolog_v1_system_proto_msgTypes[65].OneofWrappers = []any{}
-	file_gastrolog_v1_system_proto_msgTypes[67].OneofWrappers = []any{}
-	file_gastrolog_v1_system_proto_msgTypes[75].OneofWrappers = []any{}
+	file_gastrolog_v1_system_proto_msgTypes[81].OneofWrappers = []any{}
+	file_gastrolog_v1_system_proto_msgTypes[82].OneofWrappers = []any{}
+	file_gastrolog_v1_system_proto_msgTypes[83].OneofWrappers = []any{}
+	file_gastrolog_v1_system_proto_msgTypes[84].OneofWrappers = []any{}
+	file_gastrolog_v1_system_proto_msgTypes[85].OneofWrappers = []any{}
+	file_gastrolog_v1_system_proto_msgTypes[86].OneofWrappers = []any{}
+	file_gastrolog_v1_system_proto_msgTypes[87].OneofWrappers = []any{}
+	file_gastrolog_v1_system_proto_msgTypes[89].OneofWrappers = []any{}
+	file_gastrolog_v1_system_proto_msgTypes[97].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gastrolog_v1_system_proto_rawDesc), len(file_gastrolog_v1_system_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   165,
+			NumMessages:   191,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
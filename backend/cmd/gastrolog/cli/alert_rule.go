@@ -0,0 +1,342 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gastrolog/internal/glid"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	v1 "gastrolog/api/gen/gastrolog/v1"
+)
+
+func newAlertRuleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "alert-rule",
+		Aliases: []string{"alert-rules"},
+		Short:   "Manage alert rules",
+	}
+	cmd.AddCommand(
+		newAlertRuleListCmd(),
+		newAlertRuleGetCmd(),
+		newAlertRuleCreateCmd(),
+		newAlertRuleDeleteCmd(),
+		newAlertRuleStatusCmd(),
+	)
+	return cmd
+}
+
+func newAlertRuleListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List all alert rules",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := clientFromCmd(cmd)
+			resp, err := client.System.GetSystem(context.Background(), connect.NewRequest(&v1.GetSystemRequest{}))
+			if err != nil {
+				return err
+			}
+			p := newPrinter(outputFormat(cmd))
+			if outputFormat(cmd) == "json" {
+				return p.json(resp.Msg.AlertRules)
+			}
+			var rows [][]string
+			for _, r := range resp.Msg.AlertRules {
+				rows = append(rows, []string{
+					glid.FromBytes(r.Id).String(), r.Name, r.Schedule,
+					formatAlertCondition(r.Condition),
+					strconv.Itoa(len(r.Sinks)),
+					strconv.FormatBool(r.Enabled),
+				})
+			}
+			p.table([]string{"ID", "NAME", "SCHEDULE", "CONDITION", "SINKS", "ENABLED"}, rows)
+			return nil
+		},
+	}
+}
+
+func newAlertRuleGetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <name-or-id>",
+		Short: "Get alert rule details",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := clientFromCmd(cmd)
+			resp, err := client.System.GetSystem(context.Background(), connect.NewRequest(&v1.GetSystemRequest{}))
+			if err != nil {
+				return err
+			}
+			r, err := newResolver(context.Background(), client)
+			if err != nil {
+				return err
+			}
+			id, err := resolve(args[0], r.alertRules, "alert rule")
+			if err != nil {
+				return err
+			}
+			for _, ar := range resp.Msg.AlertRules {
+				if glid.FromBytes(ar.Id).String() != id {
+					continue
+				}
+				p := newPrinter(outputFormat(cmd))
+				if outputFormat(cmd) == "json" {
+					return p.json(ar)
+				}
+				severity := ar.Severity
+				if severity == "" {
+					severity = "warning"
+				}
+				sinks := make([]string, len(ar.Sinks))
+				for i, s := range ar.Sinks {
+					sinks[i] = formatAlertSink(s)
+				}
+				p.kv([][2]string{
+					{"ID", glid.FromBytes(ar.Id).String()},
+					{"Name", ar.Name},
+					{"Query", ar.Query},
+					{"Schedule", ar.Schedule},
+					{"Condition", formatAlertCondition(ar.Condition)},
+					{"Severity", severity},
+					{"Sinks", strings.Join(sinks, "; ")},
+					{"Enabled", strconv.FormatBool(ar.Enabled)},
+				})
+				return nil
+			}
+			return fmt.Errorf("alert rule %q not found", args[0])
+		},
+	}
+}
+
+func newAlertRuleCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create or update an alert rule",
+		Long: `Create or update an alert rule.
+
+The condition is "[column] <op> <threshold>". Without a column the rule
+compares the number of matching records (or table rows, for aggregating
+queries); with a column it fires when any result row satisfies it:
+
+  --condition "> 100"
+  --condition "count >= 50"
+
+Sinks are "<type> key=value ...", repeatable:
+
+  --sink "webhook url=https://hooks.example.com/x header.X-Token=secret"
+  --sink "smtp addr=mail:25 from=gastrolog@example.com to=ops@example.com"
+  --sink "vault vault_id=<vault-id>"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, _ := cmd.Flags().GetString("name")
+
+			client := clientFromCmd(cmd)
+			ctx := context.Background()
+
+			cfg := &v1.AlertRuleConfig{
+				Id:        glid.New().ToProto(),
+				Name:      name,
+				Condition: &v1.AlertCondition{Op: ">", Threshold: 0},
+				Enabled:   true,
+			}
+			verb := "Created"
+			resp, err := client.System.GetSystem(ctx, connect.NewRequest(&v1.GetSystemRequest{}))
+			if err != nil {
+				return err
+			}
+			for _, ar := range resp.Msg.AlertRules {
+				if ar.Name == name {
+					cfg = ar
+					verb = "Updated"
+					break
+				}
+			}
+
+			if cmd.Flags().Changed("query") {
+				cfg.Query, _ = cmd.Flags().GetString("query")
+			}
+			if cmd.Flags().Changed("schedule") {
+				cfg.Schedule, _ = cmd.Flags().GetString("schedule")
+			}
+			if cmd.Flags().Changed("condition") {
+				s, _ := cmd.Flags().GetString("condition")
+				cond, err := parseAlertCondition(s)
+				if err != nil {
+					return err
+				}
+				cfg.Condition = cond
+			}
+			if cmd.Flags().Changed("severity") {
+				cfg.Severity, _ = cmd.Flags().GetString("severity")
+			}
+			if cmd.Flags().Changed("sink") {
+				specs, _ := cmd.Flags().GetStringArray("sink")
+				cfg.Sinks = nil
+				for _, spec := range specs {
+					sink, err := parseAlertSink(spec)
+					if err != nil {
+						return err
+					}
+					cfg.Sinks = append(cfg.Sinks, sink)
+				}
+			}
+			if cmd.Flags().Changed("enabled") {
+				cfg.Enabled, _ = cmd.Flags().GetBool("enabled")
+			}
+
+			_, err = client.System.PutAlertRule(ctx, connect.NewRequest(&v1.PutAlertRuleRequest{
+				Config: cfg,
+			}))
+			if err != nil {
+				return err
+			}
+			if outputFormat(cmd) == "json" {
+				return newPrinter("json").json(cfg)
+			}
+			fmt.Printf("%s alert rule %q (%s)\n", verb, name, glid.FromBytes(cfg.Id))
+			return nil
+		},
+	}
+	cmd.Flags().String("name", "", "alert rule name (required)")
+	cmd.Flags().String("query", "", "search expression, e.g. \"last=5m level=error\"")
+	cmd.Flags().String("schedule", "", "cron expression, e.g. \"*/5 * * * *\"")
+	cmd.Flags().String("condition", "> 0", "threshold condition: \"[column] <op> <threshold>\"")
+	cmd.Flags().String("severity", "warning", "severity: warning or error")
+	cmd.Flags().StringArray("sink", nil, "notification sink \"<type> key=value ...\" (repeatable)")
+	cmd.Flags().Bool("enabled", true, "enable the alert rule")
+	_ = cmd.MarkFlagRequired("name")
+	return cmd
+}
+
+func newAlertRuleDeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <name-or-id>",
+		Short: "Delete an alert rule",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := clientFromCmd(cmd)
+			r, err := newResolver(context.Background(), client)
+			if err != nil {
+				return err
+			}
+			idBytes, err := resolveToProto(args[0], r.alertRules, "alert rule")
+			if err != nil {
+				return err
+			}
+			_, err = client.System.DeleteAlertRule(context.Background(), connect.NewRequest(&v1.DeleteAlertRuleRequest{Id: idBytes}))
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Deleted alert rule %s\n", args[0])
+			return nil
+		},
+	}
+}
+
+func newAlertRuleStatusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show the evaluation state of every alert rule",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := clientFromCmd(cmd)
+			ctx := context.Background()
+			resp, err := client.System.GetAlertRuleStatus(ctx, connect.NewRequest(&v1.GetAlertRuleStatusRequest{}))
+			if err != nil {
+				return err
+			}
+			p := newPrinter(outputFormat(cmd))
+			if outputFormat(cmd) == "json" {
+				return p.json(resp.Msg.Statuses)
+			}
+			r, err := newResolver(ctx, client)
+			if err != nil {
+				return err
+			}
+			names := make(map[string]string, len(r.alertRules))
+			for name, id := range r.alertRules {
+				names[id] = name
+			}
+			var rows [][]string
+			for _, st := range resp.Msg.Statuses {
+				id := glid.FromBytes(st.RuleId).String()
+				var lastEval, since string
+				if st.LastEvaluated != nil {
+					lastEval = st.LastEvaluated.AsTime().Local().Format(time.DateTime)
+				}
+				if st.FiringSince != nil {
+					since = st.FiringSince.AsTime().Local().Format(time.DateTime)
+				}
+				rows = append(rows, []string{
+					id, names[id], st.State,
+					strconv.FormatFloat(st.Value, 'f', -1, 64),
+					lastEval, since, st.Error,
+				})
+			}
+			p.table([]string{"ID", "NAME", "STATE", "VALUE", "LAST EVALUATED", "FIRING SINCE", "ERROR"}, rows)
+			return nil
+		},
+	}
+}
+
+// parseAlertCondition parses "[column] <op> <threshold>".
+func parseAlertCondition(s string) (*v1.AlertCondition, error) {
+	fields := strings.Fields(s)
+	var column string
+	switch len(fields) {
+	case 2:
+	case 3:
+		column, fields = fields[0], fields[1:]
+	default:
+		return nil, fmt.Errorf("invalid condition %q: expected \"[column] <op> <threshold>\"", s)
+	}
+	threshold, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid condition threshold %q: %w", fields[1], err)
+	}
+	return &v1.AlertCondition{Column: column, Op: fields[0], Threshold: threshold}, nil
+}
+
+// parseAlertSink parses "<type> key=value ...".
+func parseAlertSink(s string) (*v1.AlertSink, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return nil, errors.New("empty sink spec")
+	}
+	sink := &v1.AlertSink{Type: fields[0], Params: make(map[string]string)}
+	for _, kv := range fields[1:] {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid sink param %q: expected key=value", kv)
+		}
+		sink.Params[k] = v
+	}
+	return sink, nil
+}
+
+func formatAlertCondition(c *v1.AlertCondition) string {
+	if c == nil {
+		return ""
+	}
+	subject := "count"
+	if c.Column != "" {
+		subject = c.Column
+	}
+	return fmt.Sprintf("%s %s %s", subject, c.Op, strconv.FormatFloat(c.Threshold, 'f', -1, 64))
+}
+
+func formatAlertSink(s *v1.AlertSink) string {
+	parts := []string{s.Type}
+	for _, k := range slices.Sorted(maps.Keys(s.Params)) {
+		v := s.Params[k]
+		if k == "password" || strings.HasPrefix(k, "header.") {
+			v = "****"
+		}
+		parts = append(parts, k+"="+v)
+	}
+	return strings.Join(parts, " ")
+}
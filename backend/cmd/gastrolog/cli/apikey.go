@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"gastrolog/internal/glid"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	v1 "gastrolog/api/gen/gastrolog/v1"
+)
+
+func newAPIKeyListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List all API keys",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := clientFromCmd(cmd)
+			resp, err := client.Auth.ListAPIKeys(context.Background(), connect.NewRequest(&v1.ListAPIKeysRequest{}))
+			if err != nil {
+				return err
+			}
+			p := newPrinter(outputFormat(cmd))
+			if outputFormat(cmd) == "json" {
+				return p.json(resp.Msg.ApiKeys)
+			}
+			var rows [][]string
+			for _, k := range resp.Msg.ApiKeys {
+				rows = append(rows, []string{
+					glid.FromBytes(k.Id).String(), k.Name, strings.Join(k.Scopes, ","),
+					formatTimestamp(k.ExpiresAt), formatTimestamp(k.LastUsedAt),
+				})
+			}
+			p.table([]string{"ID", "NAME", "SCOPES", "EXPIRES", "LAST USED"}, rows)
+			return nil
+		},
+	}
+}
+
+func newAPIKeyCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an API key",
+		Long: `Create an API key and print it. The key is shown only once; the
+server stores its hash.
+
+Scopes are repeatable:
+  query   search, follow, explain, export jobs (QueryService, JobService)
+  ingest  push records (VaultService.ImportRecords)
+  admin   everything an admin user can do
+
+Clients send the key in the X-API-Key header, or pass it to this CLI
+with --api-key (or GASTROLOG_API_KEY).`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, _ := cmd.Flags().GetString("name")
+			scopes, _ := cmd.Flags().GetStringArray("scope")
+			expiresIn, _ := cmd.Flags().GetString("expires-in")
+
+			client := clientFromCmd(cmd)
+			resp, err := client.Auth.CreateAPIKey(context.Background(), connect.NewRequest(&v1.CreateAPIKeyRequest{
+				Name:      name,
+				Scopes:    scopes,
+				ExpiresIn: expiresIn,
+			}))
+			if err != nil {
+				return err
+			}
+			if outputFormat(cmd) == "json" {
+				return newPrinter("json").json(resp.Msg)
+			}
+			k := resp.Msg.ApiKey
+			fmt.Printf("Created API key %q (%s)\n", k.Name, glid.FromBytes(k.Id))
+			fmt.Printf("Key: %s\n", resp.Msg.Key)
+			fmt.Println("Store it now; it cannot be shown again.")
+			return nil
+		},
+	}
+	cmd.Flags().String("name", "", "key name (required)")
+	cmd.Flags().StringArray("scope", nil, "scope: query, ingest or admin (repeatable, required)")
+	cmd.Flags().String("expires-in", "", "lifetime as a Go duration, e.g. 720h (default: never expires)")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("scope")
+	return cmd
+}
+
+func newAPIKeyRevokeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "revoke <name-or-id>",
+		Aliases: []string{"delete"},
+		Short:   "Revoke an API key",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := clientFromCmd(cmd)
+			resp, err := client.Auth.ListAPIKeys(context.Background(), connect.NewRequest(&v1.ListAPIKeysRequest{}))
+			if err != nil {
+				return err
+			}
+			names := make(map[string]string, len(resp.Msg.ApiKeys))
+			for _, k := range resp.Msg.ApiKeys {
+				names[strings.ToLower(k.Name)] = glid.FromBytes(k.Id).String()
+			}
+			idBytes, err := resolveToProto(args[0], names, "api key")
+			if err != nil {
+				return err
+			}
+			_, err = client.Auth.DeleteAPIKey(context.Background(), connect.NewRequest(&v1.DeleteAPIKeyRequest{Id: idBytes}))
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Revoked API key %s\n", args[0])
+			return nil
+		},
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"os"
 
 	"connectrpc.com/connect"
+
+	"gastrolog/internal/auth"
 )
 
 // authInterceptor adds a Bearer token to every outgoing request.
@@ -31,7 +33,36 @@ func (a *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc
 	return next
 }
 
+// apiKeyInterceptor adds an API key header to every outgoing request.
+type apiKeyInterceptor struct {
+	key string
+}
+
+func newAPIKeyInterceptor(key string) *apiKeyInterceptor {
+	return &apiKeyInterceptor{key: key}
+}
+
+func (a *apiKeyInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		req.Header().Set(auth.APIKeyHeader, a.key)
+		return next(ctx, req)
+	}
+}
+
+func (a *apiKeyInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (a *apiKeyInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
 // envToken reads the token from GASTROLOG_TOKEN if set.
 func envToken() string {
 	return os.Getenv("GASTROLOG_TOKEN")
 }
+
+// envAPIKey reads the API key from GASTROLOG_API_KEY if set.
+func envAPIKey() string {
+	return os.Getenv("GASTROLOG_API_KEY")
+}
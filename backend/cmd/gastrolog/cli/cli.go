@@ -22,6 +22,7 @@ import (
 func AddClientFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().String("addr", "http://localhost:4564", "server address (http://host:port or unix:///path/to/sock)")
 	cmd.PersistentFlags().String("token", "", "authentication token (or GASTROLOG_TOKEN env)")
+	cmd.PersistentFlags().String("api-key", "", "API key, used instead of --token (or GASTROLOG_API_KEY env)")
 	cmd.PersistentFlags().StringP("output", "o", "table", "output format: table or json")
 }
 
@@ -41,6 +42,8 @@ func NewConfigCommand() *cobra.Command {
 		newVaultCmd(),
 		newIngesterCmd(),
 		newRouteCmd(),
+		newAlertRuleCmd(),
+		newRoleCmd(),
 		newFileCmd(),
 		newNodeCmd(),
 		newCertCmd(),
@@ -78,6 +81,22 @@ func NewUserCommand() *cobra.Command {
 		newUserCreateCmd(),
 		newUserDeleteCmd(),
 		newUserResetPasswordCmd(),
+		newUserSetRoleCmd(),
+	)
+	return cmd
+}
+
+// NewAPIKeyCommand returns the "apikey" command for managing API keys.
+func NewAPIKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "apikey",
+		Aliases: []string{"apikeys"},
+		Short:   "Manage API keys for scripts and automation",
+	}
+	cmd.AddCommand(
+		newAPIKeyListCmd(),
+		newAPIKeyCreateCmd(),
+		newAPIKeyRevokeCmd(),
 	)
 	return cmd
 }
@@ -101,13 +120,17 @@ func NewRegisterCommand() *cobra.Command {
 //  2. --addr pointing at a .sock file → direct unix socket (bare path, backwards compat)
 //  3. --home → <home>/gastrolog.sock
 //  4. Platform default home → <default>/gastrolog.sock
-//  5. --addr as HTTP endpoint (with optional --token)
+//  5. --addr as HTTP endpoint (with optional --token or --api-key)
 func clientFromCmd(cmd *cobra.Command) *server.Client {
 	addr, _ := cmd.Flags().GetString("addr")
 	token, _ := cmd.Flags().GetString("token")
 	if token == "" {
 		token = envToken()
 	}
+	apiKey, _ := cmd.Flags().GetString("api-key")
+	if apiKey == "" {
+		apiKey = envAPIKey()
+	}
 
 	addrChanged := cmd.Flags().Changed("addr")
 
@@ -123,8 +146,8 @@ func clientFromCmd(cmd *cobra.Command) *server.Client {
 		return newUnixClient(addr)
 	}
 
-	// If no explicit token and addr wasn't overridden, try the unix socket.
-	if token == "" && !addrChanged {
+	// If no explicit credentials and addr wasn't overridden, try the unix socket.
+	if token == "" && apiKey == "" && !addrChanged {
 		homeFlag, _ := cmd.Flags().GetString("home")
 		if client, ok := tryUnixSocket(homeFlag); ok {
 			return client
@@ -132,7 +155,10 @@ func clientFromCmd(cmd *cobra.Command) *server.Client {
 	}
 
 	var opts []connect.ClientOption
-	if token != "" {
+	switch {
+	case apiKey != "":
+		opts = append(opts, connect.WithInterceptors(newAPIKeyInterceptor(apiKey)))
+	case token != "":
 		opts = append(opts, connect.WithInterceptors(newAuthInterceptor(token)))
 	}
 	return server.NewClient(addr, opts...)
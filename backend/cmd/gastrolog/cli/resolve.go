@@ -25,6 +25,8 @@ type resolver struct {
 	certs             map[string]string
 	routes            map[string]string
 	cloudServices     map[string]string
+	alertRules        map[string]string
+	roles             map[string]string
 }
 
 // newResolver fetches the full config and user list, building name→ID maps.
@@ -46,6 +48,8 @@ func newResolver(ctx context.Context, client *server.Client) (*resolver, error)
 		certs:             make(map[string]string),
 		routes:            make(map[string]string),
 		cloudServices:     make(map[string]string),
+		alertRules:        make(map[string]string),
+		roles:             make(map[string]string),
 	}
 
 	cfg := resp.Msg
@@ -76,6 +80,12 @@ func newResolver(ctx context.Context, client *server.Client) (*resolver, error)
 	for _, cs := range cfg.CloudServices {
 		r.cloudServices[strings.ToLower(cs.Name)] = glid.FromBytes(cs.Id).String()
 	}
+	for _, ar := range cfg.AlertRules {
+		r.alertRules[strings.ToLower(ar.Name)] = glid.FromBytes(ar.Id).String()
+	}
+	for _, rl := range cfg.Roles {
+		r.roles[strings.ToLower(rl.Name)] = glid.FromBytes(rl.Id).String()
+	}
 
 	// Certs via ListCertificates.
 	certResp, err := client.System.ListCertificates(ctx, connect.NewRequest(&v1.ListCertificatesRequest{}))
@@ -0,0 +1,223 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"gastrolog/internal/glid"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	v1 "gastrolog/api/gen/gastrolog/v1"
+)
+
+func newRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "role",
+		Aliases: []string{"roles"},
+		Short:   "Manage custom roles with per-vault grants",
+	}
+	cmd.AddCommand(
+		newRoleListCmd(),
+		newRoleGetCmd(),
+		newRoleCreateCmd(),
+		newRoleDeleteCmd(),
+	)
+	return cmd
+}
+
+func newRoleListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List all custom roles",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := clientFromCmd(cmd)
+			resp, err := client.System.GetSystem(context.Background(), connect.NewRequest(&v1.GetSystemRequest{}))
+			if err != nil {
+				return err
+			}
+			p := newPrinter(outputFormat(cmd))
+			if outputFormat(cmd) == "json" {
+				return p.json(resp.Msg.Roles)
+			}
+			var rows [][]string
+			for _, r := range resp.Msg.Roles {
+				rows = append(rows, []string{
+					glid.FromBytes(r.Id).String(), r.Name, strconv.Itoa(len(r.Grants)),
+				})
+			}
+			p.table([]string{"ID", "NAME", "GRANTS"}, rows)
+			return nil
+		},
+	}
+}
+
+func newRoleGetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <name-or-id>",
+		Short: "Get role details",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := clientFromCmd(cmd)
+			resp, err := client.System.GetSystem(context.Background(), connect.NewRequest(&v1.GetSystemRequest{}))
+			if err != nil {
+				return err
+			}
+			r, err := newResolver(context.Background(), client)
+			if err != nil {
+				return err
+			}
+			id, err := resolve(args[0], r.roles, "role")
+			if err != nil {
+				return err
+			}
+			vaultNames := make(map[string]string, len(resp.Msg.Vaults))
+			for _, v := range resp.Msg.Vaults {
+				vaultNames[glid.FromBytes(v.Id).String()] = v.Name
+			}
+			for _, rl := range resp.Msg.Roles {
+				if glid.FromBytes(rl.Id).String() != id {
+					continue
+				}
+				p := newPrinter(outputFormat(cmd))
+				if outputFormat(cmd) == "json" {
+					return p.json(rl)
+				}
+				pairs := [][2]string{
+					{"ID", glid.FromBytes(rl.Id).String()},
+					{"Name", rl.Name},
+				}
+				for _, g := range rl.Grants {
+					vid := glid.FromBytes(g.VaultId).String()
+					label := vid
+					if name := vaultNames[vid]; name != "" {
+						label = name + " (" + vid + ")"
+					}
+					pairs = append(pairs, [2]string{"Grant", label + ": " + strings.Join(g.Actions, ",")})
+				}
+				p.kv(pairs)
+				return nil
+			}
+			return fmt.Errorf("role %q not found", args[0])
+		},
+	}
+}
+
+func newRoleCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create or update a custom role",
+		Long: `Create or update a custom role.
+
+Grants are "<vault>:<action>[,<action>...]", repeatable. The vault is a
+name or ID; actions are search, follow and export:
+
+  --grant web:search,follow
+  --grant audit:search,export
+
+Users with a custom role see only the vaults it grants. Assign the role
+with "gastrolog user create --role <name>" or "gastrolog user set-role".`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, _ := cmd.Flags().GetString("name")
+
+			client := clientFromCmd(cmd)
+			ctx := context.Background()
+
+			cfg := &v1.RoleConfig{
+				Id:   glid.New().ToProto(),
+				Name: name,
+			}
+			verb := "Created"
+			resp, err := client.System.GetSystem(ctx, connect.NewRequest(&v1.GetSystemRequest{}))
+			if err != nil {
+				return err
+			}
+			for _, rl := range resp.Msg.Roles {
+				if rl.Name == name {
+					cfg = rl
+					verb = "Updated"
+					break
+				}
+			}
+
+			if cmd.Flags().Changed("grant") {
+				r, err := newResolver(ctx, client)
+				if err != nil {
+					return err
+				}
+				specs, _ := cmd.Flags().GetStringArray("grant")
+				cfg.Grants = nil
+				for _, spec := range specs {
+					g, err := parseRoleGrant(spec, r.vaults)
+					if err != nil {
+						return err
+					}
+					cfg.Grants = append(cfg.Grants, g)
+				}
+			}
+
+			_, err = client.System.PutRole(ctx, connect.NewRequest(&v1.PutRoleRequest{
+				Config: cfg,
+			}))
+			if err != nil {
+				return err
+			}
+			if outputFormat(cmd) == "json" {
+				return newPrinter("json").json(cfg)
+			}
+			fmt.Printf("%s role %q (%s)\n", verb, name, glid.FromBytes(cfg.Id))
+			return nil
+		},
+	}
+	cmd.Flags().String("name", "", "role name (required)")
+	cmd.Flags().StringArray("grant", nil, "vault grant \"<vault>:<action>[,<action>...]\" (repeatable)")
+	_ = cmd.MarkFlagRequired("name")
+	return cmd
+}
+
+func newRoleDeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <name-or-id>",
+		Short: "Delete a custom role",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := clientFromCmd(cmd)
+			r, err := newResolver(context.Background(), client)
+			if err != nil {
+				return err
+			}
+			idBytes, err := resolveToProto(args[0], r.roles, "role")
+			if err != nil {
+				return err
+			}
+			_, err = client.System.DeleteRole(context.Background(), connect.NewRequest(&v1.DeleteRoleRequest{Id: idBytes}))
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Deleted role %s\n", args[0])
+			return nil
+		},
+	}
+}
+
+// parseRoleGrant parses "<vault>:<action>[,<action>...]", resolving the
+// vault name through vaults. The last colon separates the actions.
+func parseRoleGrant(spec string, vaults map[string]string) (*v1.VaultGrant, error) {
+	i := strings.LastIndex(spec, ":")
+	if i <= 0 || i == len(spec)-1 {
+		return nil, fmt.Errorf("invalid grant %q: expected \"<vault>:<action>[,<action>...]\"", spec)
+	}
+	vaultID, err := resolveToProto(spec[:i], vaults, "vault")
+	if err != nil {
+		return nil, err
+	}
+	var actions []string
+	for a := range strings.SplitSeq(spec[i+1:], ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			actions = append(actions, a)
+		}
+	}
+	return &v1.VaultGrant{VaultId: vaultID, Actions: actions}, nil
+}
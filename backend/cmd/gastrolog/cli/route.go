@@ -1,9 +1,14 @@
 package cli
 
 import (
+	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"gastrolog/internal/glid"
+	"maps"
+	"os"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -25,6 +30,7 @@ func newRouteCmd() *cobra.Command {
 		newRouteGetCmd(),
 		newRouteCreateCmd(),
 		newRouteDeleteCmd(),
+		newRoutePreviewCmd(),
 	)
 	return cmd
 }
@@ -99,6 +105,9 @@ func newRouteGetCmd() *cobra.Command {
 						{"Distribution", rt.Distribution},
 						{"Enabled", strconv.FormatBool(rt.Enabled)},
 					})
+					for i, st := range rt.Stages {
+						fmt.Printf("  stage %d: %s\n", i+1, formatRouteStage(st))
+					}
 					return nil
 				}
 			}
@@ -146,6 +155,15 @@ func newRouteCreateCmd() *cobra.Command {
 			if cmd.Flags().Changed("enabled") {
 				cfg.Enabled, _ = cmd.Flags().GetBool("enabled")
 			}
+			if cmd.Flags().Changed("stage") {
+				specs, _ := cmd.Flags().GetStringArray("stage")
+				if cfg.Stages, err = parseRouteStages(specs); err != nil {
+					return err
+				}
+			}
+			if clear, _ := cmd.Flags().GetBool("clear-stages"); clear {
+				cfg.Stages = nil
+			}
 
 			_, err = client.System.PutRoute(ctx, connect.NewRequest(&v1.PutRouteRequest{
 				Config: cfg,
@@ -165,7 +183,10 @@ func newRouteCreateCmd() *cobra.Command {
 	cmd.Flags().StringSlice("destination", nil, "destination vault name or ID (repeatable)")
 	cmd.Flags().String("distribution", "fanout", "distribution: fanout, round-robin, or failover")
 	cmd.Flags().Bool("enabled", true, "enable the route")
+	cmd.Flags().StringArray("stage", nil, "pipeline stage \"<type> key=value ...\" (repeatable, replaces existing stages)")
+	cmd.Flags().Bool("clear-stages", false, "remove all pipeline stages")
 	_ = cmd.MarkFlagRequired("name")
+	cmd.MarkFlagsMutuallyExclusive("stage", "clear-stages")
 	return cmd
 }
 
@@ -230,3 +251,188 @@ func newRouteDeleteCmd() *cobra.Command {
 		},
 	}
 }
+
+func newRoutePreviewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preview [name-or-id]",
+		Short: "Run pipeline stages against sample lines",
+		Long: `Run a route's pipeline stages, or the stages given with --stage, against
+sample log lines. Lines come from --line, or from stdin when --line is not set.
+Nothing is stored.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := clientFromCmd(cmd)
+			ctx := context.Background()
+
+			req := &v1.PreviewRoutePipelineRequest{}
+			specs, _ := cmd.Flags().GetStringArray("stage")
+			switch {
+			case len(args) == 1 && len(specs) > 0:
+				return errors.New("give a route or --stage, not both")
+			case len(args) == 1:
+				stages, err := routeStages(ctx, client, args[0])
+				if err != nil {
+					return err
+				}
+				req.Stages = stages
+			default:
+				stages, err := parseRouteStages(specs)
+				if err != nil {
+					return err
+				}
+				req.Stages = stages
+			}
+
+			attrs, _ := cmd.Flags().GetStringToString("attr")
+			req.Attrs = attrs
+			req.Lines, _ = cmd.Flags().GetStringArray("line")
+			if len(req.Lines) == 0 {
+				sc := bufio.NewScanner(os.Stdin)
+				for sc.Scan() {
+					req.Lines = append(req.Lines, sc.Text())
+				}
+				if err := sc.Err(); err != nil {
+					return err
+				}
+			}
+
+			resp, err := client.System.PreviewRoutePipeline(ctx, connect.NewRequest(req))
+			if err != nil {
+				return err
+			}
+			if resp.Msg.Error != "" {
+				return errors.New(resp.Msg.Error)
+			}
+			p := newPrinter(outputFormat(cmd))
+			if outputFormat(cmd) == "json" {
+				return p.json(resp.Msg.Results)
+			}
+			for i, r := range resp.Msg.Results {
+				if r.Dropped {
+					fmt.Printf("[%d] dropped\n", i+1)
+					continue
+				}
+				fmt.Printf("[%d] %s\n", i+1, r.Raw)
+				for _, k := range slices.Sorted(maps.Keys(r.Attrs)) {
+					fmt.Printf("    %s=%s\n", k, r.Attrs[k])
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringArray("stage", nil, "pipeline stage \"<type> key=value ...\" (repeatable)")
+	cmd.Flags().StringArray("line", nil, "sample log line (repeatable; default: read stdin)")
+	cmd.Flags().StringToString("attr", nil, "attribute set on every sample (key=value, repeatable)")
+	return cmd
+}
+
+// routeStages returns the pipeline stages of the named route.
+func routeStages(ctx context.Context, client *server.Client, nameOrID string) ([]*v1.RouteStage, error) {
+	r, err := newResolver(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	id, err := resolve(nameOrID, r.routes, "route")
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.System.GetSystem(ctx, connect.NewRequest(&v1.GetSystemRequest{}))
+	if err != nil {
+		return nil, err
+	}
+	for _, rt := range resp.Msg.Routes {
+		if glid.FromBytes(rt.Id).String() == id {
+			return rt.Stages, nil
+		}
+	}
+	return nil, fmt.Errorf("route %q not found", nameOrID)
+}
+
+func parseRouteStages(specs []string) ([]*v1.RouteStage, error) {
+	stages := make([]*v1.RouteStage, 0, len(specs))
+	for _, spec := range specs {
+		st, err := parseRouteStage(spec)
+		if err != nil {
+			return nil, err
+		}
+		stages = append(stages, st)
+	}
+	return stages, nil
+}
+
+// parseRouteStage parses "<type> key=value ...". Values containing spaces
+// are quoted: grok pattern="%{IP:client} %{WORD:method}".
+func parseRouteStage(s string) (*v1.RouteStage, error) {
+	fields, err := splitQuoted(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid stage %q: %w", s, err)
+	}
+	if len(fields) == 0 {
+		return nil, errors.New("empty stage spec")
+	}
+	st := &v1.RouteStage{Type: fields[0], Params: make(map[string]string)}
+	for _, kv := range fields[1:] {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid stage param %q: expected key=value", kv)
+		}
+		st.Params[k] = v
+	}
+	return st, nil
+}
+
+// splitQuoted splits s on whitespace, keeping double- or single-quoted
+// runs together. Inside double quotes, \" and \\ are escapes; any other
+// backslash is kept, so regex escapes like \d pass through unchanged.
+func splitQuoted(s string) ([]string, error) {
+	var (
+		fields []string
+		cur    strings.Builder
+		inWord bool
+		quote  byte
+	)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote == '"' && c == '\\' && i+1 < len(s) && (s[i+1] == '"' || s[i+1] == '\\'):
+			i++
+			cur.WriteByte(s[i])
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote != 0:
+			cur.WriteByte(c)
+		case c == '"' || c == '\'':
+			quote, inWord = c, true
+		case c == ' ' || c == '\t':
+			if inWord {
+				fields = append(fields, cur.String())
+				cur.Reset()
+				inWord = false
+			}
+		default:
+			cur.WriteByte(c)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, errors.New("unterminated quote")
+	}
+	if inWord {
+		fields = append(fields, cur.String())
+	}
+	return fields, nil
+}
+
+func formatRouteStage(st *v1.RouteStage) string {
+	parts := []string{st.Type}
+	for _, k := range slices.Sorted(maps.Keys(st.Params)) {
+		v := st.Params[k]
+		if k == "salt" {
+			v = "****"
+		} else if strings.ContainsAny(v, " \t\"'") || v == "" {
+			v = strconv.Quote(v)
+		}
+		parts = append(parts, k+"="+v)
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestParseRouteStage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		spec       string
+		wantType   string
+		wantParams map[string]string
+		wantErr    bool
+	}{
+		{"set", "set key=env value=prod", "set", map[string]string{"key": "env", "value": "prod"}, false},
+		{"quoted grok", `grok pattern="%{IP:client} %{WORD:method}"`, "grok", map[string]string{"pattern": "%{IP:client} %{WORD:method}"}, false},
+		{"regex escapes kept", `regex pattern="user=(?P<user>\w+) \"x\""`, "regex", map[string]string{"pattern": `user=(?P<user>\w+) "x"`}, false},
+		{"single quotes", `drop expression='level=debug OR healthcheck'`, "drop", map[string]string{"expression": "level=debug OR healthcheck"}, false},
+		{"empty value", `mask pattern=\d+ replacement=""`, "mask", map[string]string{"pattern": `\d+`, "replacement": ""}, false},
+		{"missing equals", "delete keys", "", nil, true},
+		{"unterminated", `grok pattern="%{IP:client}`, "", nil, true},
+		{"empty", "  ", "", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, err := parseRouteStage(tt.spec)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseRouteStage(%q) expected error, got %+v", tt.spec, st)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseRouteStage(%q): %v", tt.spec, err)
+			}
+			if st.Type != tt.wantType {
+				t.Errorf("type = %q, want %q", st.Type, tt.wantType)
+			}
+			if !maps.Equal(st.Params, tt.wantParams) {
+				t.Errorf("params = %v, want %v", st.Params, tt.wantParams)
+			}
+		})
+	}
+}
@@ -60,6 +60,18 @@ var settingsGroups = []settingsGroup{
 		{flag: "max-consecutive-repeats", label: "max_consecutive_repeats", getKey: "max_consecutive_repeats", setKey: "max_consecutive_repeats", desc: "Max consecutive repeated characters (0 = no limit)"},
 		{flag: "forbid-animal-noise", label: "forbid_animal_noise", getKey: "forbid_animal_noise", setKey: "forbid_animal_noise", desc: "Forbid animal noises as passwords"},
 	}},
+	{name: "oidc", short: "Configure OIDC single sign-on", putRoot: "service", getPath: []string{"auth", "oidc"}, setPath: []string{"auth", "oidc"}, fields: []settingsField{
+		{flag: "enabled", label: "enabled", getKey: "enabled", setKey: "enabled", desc: "Enable \"Sign in with SSO\""},
+		{flag: "issuer-url", label: "issuer_url", getKey: "issuer_url", setKey: "issuer_url", desc: "OIDC issuer URL (discovery is fetched from <issuer>/.well-known/openid-configuration)"},
+		{flag: "client-id", label: "client_id", getKey: "client_id", setKey: "client_id", desc: "OAuth2 client ID"},
+		{flag: "client-secret", label: "client_secret", getKey: "client_secret_configured", setKey: "client_secret", desc: "OAuth2 client secret", secret: true},
+		{flag: "redirect-url", label: "redirect_url", getKey: "redirect_url", setKey: "redirect_url", desc: "Callback URL registered at the IdP (e.g. \"https://logs.example.com/auth/callback\")"},
+		{flag: "scopes", label: "scopes", getKey: "scopes", setKey: "scopes", desc: "Space-separated scopes requested in addition to openid (e.g. \"profile groups\")"},
+		{flag: "username-claim", label: "username_claim", getKey: "username_claim", setKey: "username_claim", desc: "ID token claim used as the username (empty = preferred_username)"},
+		{flag: "groups-claim", label: "groups_claim", getKey: "groups_claim", setKey: "groups_claim", desc: "ID token claim holding the user's groups (empty = groups)"},
+		{flag: "role-mappings", label: "role_mappings", getKey: "role_mappings", setKey: "role_mappings", desc: "Group to role mappings, first match wins (e.g. \"ops=admin,dev=user\")"},
+		{flag: "default-role", label: "default_role", getKey: "default_role", setKey: "default_role", desc: "Role when no group matches (empty = deny login)"},
+	}},
 	{name: "query", short: "Configure query engine", putRoot: "service", getPath: []string{"query"}, setPath: []string{"query"}, fields: []settingsField{
 		{flag: "timeout", label: "timeout", getKey: "timeout", setKey: "timeout", desc: "Query timeout (e.g. \"30s\", \"1m\")"},
 		{flag: "max-follow-duration", label: "max_follow_duration", getKey: "max_follow_duration", setKey: "max_follow_duration", desc: "Max Follow stream lifetime (e.g. \"4h\")"},
@@ -87,6 +99,7 @@ var settingsGroups = []settingsGroup{
 func newAuthCmd() *cobra.Command {
 	cmd := newGroupCmd("auth")
 	cmd.AddCommand(newGroupCmd("password-policy"))
+	cmd.AddCommand(newGroupCmd("oidc"))
 	return cmd
 }
 
@@ -122,6 +122,7 @@ func newUserGetCmd() *cobra.Command {
 						{"ID", glid.FromBytes(u.Id).String()},
 						{"Username", u.Username},
 						{"Role", u.Role},
+						{"SSO", fmt.Sprint(u.Sso)},
 						{"Created", formatTimestamp(u.CreatedAt)},
 						{"Updated", formatTimestamp(u.UpdatedAt)},
 					})
@@ -157,7 +158,7 @@ func newUserCreateCmd() *cobra.Command {
 	}
 	cmd.Flags().String("username", "", "username (required)")
 	cmd.Flags().String("password", "", "password (required)")
-	cmd.Flags().String("role", "user", "role: admin or user")
+	cmd.Flags().String("role", "user", "role: admin, user, or a custom role name")
 	_ = cmd.MarkFlagRequired("username")
 	_ = cmd.MarkFlagRequired("password")
 	return cmd
@@ -221,6 +222,34 @@ func newUserResetPasswordCmd() *cobra.Command {
 	return cmd
 }
 
+func newUserSetRoleCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-role <username-or-id> <role>",
+		Short: "Change a user's role (admin, user, or a custom role name)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := clientFromCmd(cmd)
+			r, err := newResolver(context.Background(), client)
+			if err != nil {
+				return err
+			}
+			idBytes, err := resolveToProto(args[0], r.users, "user")
+			if err != nil {
+				return err
+			}
+			_, err = client.Auth.UpdateUserRole(context.Background(), connect.NewRequest(&v1.UpdateUserRoleRequest{
+				Id:   idBytes,
+				Role: args[1],
+			}))
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Set role of user %s to %s\n", args[0], args[1])
+			return nil
+		},
+	}
+}
+
 func formatTimestamp(unix int64) string {
 	if unix == 0 {
 		return "-"
@@ -88,6 +88,8 @@ func main() {
 				JoinToken:   mustString(cmd, "join-token"),
 				NodeName:    mustString(cmd, "name"),
 				PprofAddr:   mustString(cmd, "pprof"),
+				IngestQueueSize: mustString(cmd, "ingest-queue-size"),
+				IngestQueueSync: mustString(cmd, "ingest-queue-sync"),
 				SlogCapture:        slogCaptureCh,
 				SlogCaptureHandler: captureHandler,
 			}
@@ -107,6 +109,8 @@ func main() {
 	serverCmd.Flags().String("join-addr", "", "leader's cluster address to join an existing cluster")
 	serverCmd.Flags().String("join-token", "", "join token for cluster enrollment (from cluster-init node)")
 	serverCmd.Flags().String("name", "", "node name (default: random petname)")
+	serverCmd.Flags().String("ingest-queue-size", "", "size of the disk-backed ingest queue, e.g. 1GB (default: no disk queue)")
+	serverCmd.Flags().String("ingest-queue-sync", "interval", "ingest queue fsync policy: always, interval or none")
 
 	versionCmd := &cobra.Command{
 		Use:   "version",
@@ -124,6 +128,7 @@ func main() {
 		cli.NewClusterCommand(),
 		cli.NewJobCommand(),
 		cli.NewUserCommand(),
+		cli.NewAPIKeyCommand(),
 		cli.NewLoginCommand(),
 		cli.NewRegisterCommand(),
 		cli.NewQueryCommand(),
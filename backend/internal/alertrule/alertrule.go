@@ -0,0 +1,409 @@
+// Package alertrule evaluates user-defined alert rules: saved searches run on
+// a cron schedule whose result is compared against a threshold.
+//
+// Rules live in the system Raft config store, so every node sees the same
+// set. Every node registers a scheduler job per enabled rule, but a job only
+// evaluates when the local node is the system Raft leader — which gives
+// exactly-once evaluation per tick and free failover when leadership moves.
+// A node that loses leadership drops its per-rule state and clears the
+// alerts it raised on the next tick.
+//
+// Firing rules are raised in the shared alert.Collector (source "alert-rule")
+// so they appear next to the system alerts; resolution clears them. Every
+// transition is also delivered to the rule's sinks.
+package alertrule
+
+import (
+	"context"
+	"fmt"
+	"gastrolog/internal/glid"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"gastrolog/internal/alert"
+	"gastrolog/internal/chunk"
+	"gastrolog/internal/logging"
+	"gastrolog/internal/system"
+)
+
+// alertSource is the alert.Collector source for rule alerts.
+const alertSource = "alert-rule"
+
+// defaultEvalTimeout bounds a single rule evaluation (query + sinks).
+const defaultEvalTimeout = 2 * time.Minute
+
+// State is the evaluation state of a rule.
+type State string
+
+const (
+	StateOK     State = "ok"
+	StateFiring State = "firing"
+	StateError  State = "error"
+
+	// StateResolved only appears on sink events, marking a firing → ok
+	// transition. A rule's Status is never "resolved".
+	StateResolved State = "resolved"
+)
+
+// Result is the outcome of running a rule's query. Columns and Rows are set
+// when the query produced a table (an aggregating pipeline); Count is the
+// record count for plain searches and the row count for tables.
+type Result struct {
+	Count   int64
+	Columns []string
+	Rows    [][]string
+}
+
+// SearchFunc runs a query expression cluster-wide and returns its result.
+type SearchFunc func(ctx context.Context, expression string) (Result, error)
+
+// RuleLister is the subset of system.Store used to read rules.
+type RuleLister interface {
+	ListAlertRules(ctx context.Context) ([]system.AlertRuleConfig, error)
+}
+
+// Scheduler is the subset of orchestrator.Scheduler used to register
+// rule evaluation jobs. Defined at the consumer site so tests can supply
+// a fake.
+type Scheduler interface {
+	AddJob(name, cronExpr string, taskFn any, args ...any) error
+	RemoveJob(name string)
+	Describe(name, description string)
+}
+
+// AlertCollector is the subset of alert.Collector used to surface firing rules.
+type AlertCollector interface {
+	Set(id string, severity alert.Severity, source, message string)
+	Clear(id string)
+}
+
+// Config holds the dependencies for a Manager.
+type Config struct {
+	Rules     RuleLister
+	Scheduler Scheduler
+	Alerts    AlertCollector
+
+	// Search runs rule queries. May be set later via SetSearch; rules that
+	// fire before it is set are recorded as evaluation errors.
+	Search SearchFunc
+
+	// IsLeader reports whether this node should evaluate rules. Nil means
+	// always (single-node mode).
+	IsLeader func() bool
+
+	// Append writes a record to a local or forwarded vault. Used by the
+	// vault sink. Nil disables vault sinks.
+	Append func(vaultID glid.GLID, rec chunk.Record) error
+
+	// EvalTimeout bounds a single evaluation. Zero uses the default.
+	EvalTimeout time.Duration
+
+	Logger *slog.Logger
+
+	// Now is the clock. Nil uses time.Now.
+	Now func() time.Time
+}
+
+// Status is a rule's most recent evaluation outcome on this node.
+type Status struct {
+	RuleID        glid.GLID
+	State         State
+	Value         float64
+	LastEvaluated time.Time
+	FiringSince   time.Time
+	LastResolved  time.Time
+	Error         string
+}
+
+// Manager schedules and evaluates alert rules.
+type Manager struct {
+	cfg    Config
+	logger *slog.Logger
+
+	// reconcileMu serializes Reconcile: the scheduler rejects duplicate
+	// job names, so two concurrent passes must not both add the same rule.
+	reconcileMu sync.Mutex
+
+	mu        sync.Mutex
+	search    SearchFunc
+	rules     map[glid.GLID]system.AlertRuleConfig
+	schedules map[glid.GLID]string // ruleID → cron expression currently registered
+	status    map[glid.GLID]*Status
+}
+
+// New creates a Manager. Call Reconcile to register the current rules.
+func New(cfg Config) *Manager {
+	if cfg.EvalTimeout <= 0 {
+		cfg.EvalTimeout = defaultEvalTimeout
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	return &Manager{
+		cfg:       cfg,
+		logger:    logging.Default(cfg.Logger).With("component", "alert-rules"),
+		search:    cfg.Search,
+		rules:     make(map[glid.GLID]system.AlertRuleConfig),
+		schedules: make(map[glid.GLID]string),
+		status:    make(map[glid.GLID]*Status),
+	}
+}
+
+// SetSearch wires the query executor. The server owns query execution and
+// is constructed after the manager, so the app sets this once it exists.
+func (m *Manager) SetSearch(fn SearchFunc) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.search = fn
+}
+
+// jobName returns the scheduler job name for a rule.
+func jobName(id glid.GLID) string {
+	return "alert-rule:" + id.String()
+}
+
+// alertID returns the alert.Collector ID for a firing rule.
+func alertID(id glid.GLID) string {
+	return "alert-rule:" + id.String()
+}
+
+// errorAlertID returns the alert.Collector ID for a rule whose evaluation fails.
+func errorAlertID(id glid.GLID) string {
+	return "alert-rule-error:" + id.String()
+}
+
+// Reconcile reads the rules from the store and brings the scheduler jobs in
+// line: new or rescheduled rules get (re)registered, disabled or deleted
+// rules are removed and their alerts cleared. Safe to call repeatedly.
+func (m *Manager) Reconcile(ctx context.Context) {
+	m.reconcileMu.Lock()
+	defer m.reconcileMu.Unlock()
+
+	rules, err := m.cfg.Rules.ListAlertRules(ctx)
+	if err != nil {
+		m.logger.Error("list alert rules", "error", err)
+		return
+	}
+
+	active := make(map[glid.GLID]bool, len(rules))
+	for _, r := range rules {
+		if !r.Enabled {
+			continue
+		}
+		active[r.ID] = true
+		m.ensure(r)
+	}
+
+	m.mu.Lock()
+	var stale []glid.GLID
+	for id := range m.rules {
+		if !active[id] {
+			stale = append(stale, id)
+		}
+	}
+	m.mu.Unlock()
+	for _, id := range stale {
+		m.remove(id)
+	}
+}
+
+// ensure registers or updates the job for an enabled rule.
+func (m *Manager) ensure(r system.AlertRuleConfig) {
+	m.mu.Lock()
+	m.rules[r.ID] = r
+	existing, scheduled := m.schedules[r.ID]
+	m.mu.Unlock()
+
+	if scheduled && existing == r.Schedule {
+		return
+	}
+	name := jobName(r.ID)
+	if scheduled {
+		m.cfg.Scheduler.RemoveJob(name)
+	}
+	if err := m.cfg.Scheduler.AddJob(name, r.Schedule, m.evaluate, r.ID); err != nil {
+		m.logger.Error("alert rule: failed to add job", "rule", r.Name, "cron", r.Schedule, "error", err)
+		m.mu.Lock()
+		delete(m.schedules, r.ID)
+		m.mu.Unlock()
+		return
+	}
+	m.cfg.Scheduler.Describe(name, fmt.Sprintf("Evaluate alert rule '%s'", r.Name))
+
+	m.mu.Lock()
+	m.schedules[r.ID] = r.Schedule
+	m.mu.Unlock()
+}
+
+// remove unregisters a rule's job and forgets its state.
+func (m *Manager) remove(id glid.GLID) {
+	m.mu.Lock()
+	_, scheduled := m.schedules[id]
+	delete(m.schedules, id)
+	delete(m.rules, id)
+	m.mu.Unlock()
+
+	if scheduled {
+		m.cfg.Scheduler.RemoveJob(jobName(id))
+	}
+	m.forget(id)
+}
+
+// forget drops a rule's evaluation state and clears any alert it raised.
+func (m *Manager) forget(id glid.GLID) {
+	m.mu.Lock()
+	_, had := m.status[id]
+	delete(m.status, id)
+	m.mu.Unlock()
+	if had && m.cfg.Alerts != nil {
+		m.cfg.Alerts.Clear(alertID(id))
+		m.cfg.Alerts.Clear(errorAlertID(id))
+	}
+}
+
+// Statuses returns a snapshot of every tracked rule's status, ordered by rule ID.
+func (m *Manager) Statuses() []Status {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make([]Status, 0, len(m.status))
+	for _, st := range m.status {
+		out = append(out, *st)
+	}
+	slices.SortFunc(out, func(a, b Status) int { return strings.Compare(a.RuleID.String(), b.RuleID.String()) })
+	return out
+}
+
+// evaluate is the scheduler task for one rule.
+func (m *Manager) evaluate(id glid.GLID) {
+	if m.cfg.IsLeader != nil && !m.cfg.IsLeader() {
+		m.forget(id)
+		return
+	}
+
+	m.mu.Lock()
+	rule, ok := m.rules[id]
+	search := m.search
+	m.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.cfg.EvalTimeout)
+	defer cancel()
+
+	var (
+		value  float64
+		firing bool
+		err    error
+	)
+	if search == nil {
+		err = fmt.Errorf("query execution not available on this node")
+	} else {
+		var res Result
+		res, err = search(ctx, rule.Query)
+		if err == nil {
+			value, firing, err = Evaluate(rule.Condition, res)
+		}
+	}
+
+	now := m.cfg.Now()
+	ev, notify := m.transition(rule, now, value, firing, err)
+	if notify {
+		m.deliver(ctx, rule, ev)
+	}
+}
+
+// transition records the evaluation outcome, updates the alert collector,
+// and returns the event to deliver when the firing state changed.
+func (m *Manager) transition(rule system.AlertRuleConfig, now time.Time, value float64, firing bool, evalErr error) (Event, bool) {
+	m.mu.Lock()
+	st, ok := m.status[rule.ID]
+	if !ok {
+		st = &Status{RuleID: rule.ID, State: StateOK}
+		m.status[rule.ID] = st
+	}
+	wasFiring := !st.FiringSince.IsZero()
+	st.LastEvaluated = now
+
+	if evalErr != nil {
+		// Keep the previous firing state: an evaluation error says nothing
+		// about whether the condition still holds.
+		st.State = StateError
+		st.Error = evalErr.Error()
+		m.mu.Unlock()
+		m.logger.Warn("alert rule evaluation failed", "rule", rule.Name, "error", evalErr)
+		if m.cfg.Alerts != nil {
+			m.cfg.Alerts.Set(errorAlertID(rule.ID), alert.Warning, alertSource,
+				fmt.Sprintf("Alert rule '%s' failed to evaluate: %v", rule.Name, evalErr))
+		}
+		return Event{}, false
+	}
+
+	st.Error = ""
+	st.Value = value
+	var (
+		ev     Event
+		notify bool
+	)
+	switch {
+	case firing && !wasFiring:
+		st.FiringSince = now
+		ev, notify = newEvent(rule, StateFiring, value, now), true
+	case !firing && wasFiring:
+		st.FiringSince = time.Time{}
+		st.LastResolved = now
+		ev, notify = newEvent(rule, StateResolved, value, now), true
+	}
+	if firing {
+		st.State = StateFiring
+	} else {
+		st.State = StateOK
+	}
+	m.mu.Unlock()
+
+	if m.cfg.Alerts != nil {
+		m.cfg.Alerts.Clear(errorAlertID(rule.ID))
+		if firing {
+			m.cfg.Alerts.Set(alertID(rule.ID), severity(rule.Severity), alertSource, describe(rule, value))
+		} else {
+			m.cfg.Alerts.Clear(alertID(rule.ID))
+		}
+	}
+	return ev, notify
+}
+
+// deliver sends an event to every sink of the rule. Sink failures are
+// logged; they don't affect the rule's state.
+func (m *Manager) deliver(ctx context.Context, rule system.AlertRuleConfig, ev Event) {
+	for i, sc := range rule.Sinks {
+		sink, err := NewSink(sc, m.cfg.Append)
+		if err == nil {
+			err = sink.Notify(ctx, ev)
+		}
+		if err != nil {
+			m.logger.Warn("alert rule sink failed",
+				"rule", rule.Name, "sink", i, "type", sc.Type, "error", err)
+		}
+	}
+}
+
+// severity maps a rule's severity string to an alert.Severity.
+func severity(s string) alert.Severity {
+	if s == "error" {
+		return alert.Error
+	}
+	return alert.Warning
+}
+
+// describe renders the alert message for a firing rule.
+func describe(rule system.AlertRuleConfig, value float64) string {
+	subject := "row count"
+	if rule.Condition.Column != "" {
+		subject = rule.Condition.Column
+	}
+	return fmt.Sprintf("Alert rule '%s': %s is %s (%s %s)",
+		rule.Name, subject, formatValue(value), rule.Condition.Op, formatValue(rule.Condition.Threshold))
+}
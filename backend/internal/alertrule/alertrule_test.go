@@ -0,0 +1,356 @@
+package alertrule
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"gastrolog/internal/glid"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"gastrolog/internal/alert"
+	"gastrolog/internal/chunk"
+	"gastrolog/internal/system"
+)
+
+// fakeRules implements RuleLister over a mutable slice.
+type fakeRules struct {
+	mu    sync.Mutex
+	rules []system.AlertRuleConfig
+}
+
+func (f *fakeRules) set(rules ...system.AlertRuleConfig) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rules = rules
+}
+
+func (f *fakeRules) ListAlertRules(context.Context) ([]system.AlertRuleConfig, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]system.AlertRuleConfig(nil), f.rules...), nil
+}
+
+// fakeScheduler records registered jobs so tests can fire them by hand.
+type fakeScheduler struct {
+	mu   sync.Mutex
+	jobs map[string]fakeJob
+	adds int
+}
+
+type fakeJob struct {
+	cron string
+	fn   any
+	args []any
+}
+
+func newFakeScheduler() *fakeScheduler {
+	return &fakeScheduler{jobs: make(map[string]fakeJob)}
+}
+
+func (f *fakeScheduler) AddJob(name, cronExpr string, taskFn any, args ...any) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.jobs[name] = fakeJob{cron: cronExpr, fn: taskFn, args: args}
+	f.adds++
+	return nil
+}
+
+func (f *fakeScheduler) RemoveJob(name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.jobs, name)
+}
+
+func (f *fakeScheduler) Describe(string, string) {}
+
+func (f *fakeScheduler) has(name string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, ok := f.jobs[name]
+	return ok
+}
+
+// run invokes a registered job synchronously.
+func (f *fakeScheduler) run(t *testing.T, name string) {
+	t.Helper()
+	f.mu.Lock()
+	j, ok := f.jobs[name]
+	f.mu.Unlock()
+	if !ok {
+		t.Fatalf("job %q not registered", name)
+	}
+	j.fn.(func(glid.GLID))(j.args[0].(glid.GLID))
+}
+
+type testEnv struct {
+	rules  *fakeRules
+	sched  *fakeScheduler
+	alerts *alert.Collector
+	mgr    *Manager
+	result Result
+	err    error
+	leader bool
+}
+
+func newTestEnv() *testEnv {
+	env := &testEnv{
+		rules:  &fakeRules{},
+		sched:  newFakeScheduler(),
+		alerts: alert.New(),
+		leader: true,
+	}
+	env.mgr = New(Config{
+		Rules:     env.rules,
+		Scheduler: env.sched,
+		Alerts:    env.alerts,
+		Search: func(context.Context, string) (Result, error) {
+			return env.result, env.err
+		},
+		IsLeader: func() bool { return env.leader },
+	})
+	return env
+}
+
+func countRule(id glid.GLID, threshold float64) system.AlertRuleConfig {
+	return system.AlertRuleConfig{
+		ID:        id,
+		Name:      "errors",
+		Query:     "last=5m level=error",
+		Schedule:  "* * * * *",
+		Condition: system.AlertCondition{Op: system.AlertOpGT, Threshold: threshold},
+		Enabled:   true,
+	}
+}
+
+func TestReconcileRegistersAndPrunes(t *testing.T) {
+	env := newTestEnv()
+	a, b := glid.New(), glid.New()
+	disabled := countRule(b, 1)
+	disabled.Enabled = false
+	env.rules.set(countRule(a, 1), disabled)
+
+	env.mgr.Reconcile(context.Background())
+	if !env.sched.has(jobName(a)) {
+		t.Fatal("enabled rule not scheduled")
+	}
+	if env.sched.has(jobName(b)) {
+		t.Fatal("disabled rule scheduled")
+	}
+
+	// Unchanged schedule: no re-add.
+	env.mgr.Reconcile(context.Background())
+	if env.sched.adds != 1 {
+		t.Fatalf("adds = %d, want 1", env.sched.adds)
+	}
+
+	// Rescheduled: re-added.
+	r := countRule(a, 1)
+	r.Schedule = "*/5 * * * *"
+	env.rules.set(r)
+	env.mgr.Reconcile(context.Background())
+	if env.sched.adds != 2 || env.sched.jobs[jobName(a)].cron != "*/5 * * * *" {
+		t.Fatalf("reschedule not applied: adds=%d cron=%q", env.sched.adds, env.sched.jobs[jobName(a)].cron)
+	}
+
+	// Deleted: removed.
+	env.rules.set()
+	env.mgr.Reconcile(context.Background())
+	if env.sched.has(jobName(a)) {
+		t.Fatal("deleted rule still scheduled")
+	}
+}
+
+func TestFiringAndResolved(t *testing.T) {
+	env := newTestEnv()
+	id := glid.New()
+	env.rules.set(countRule(id, 10))
+	env.mgr.Reconcile(context.Background())
+
+	env.result = Result{Count: 3}
+	env.sched.run(t, jobName(id))
+	if env.alerts.Count() != 0 {
+		t.Fatalf("alert raised below threshold: %v", env.alerts.Active())
+	}
+
+	env.result = Result{Count: 42}
+	env.sched.run(t, jobName(id))
+	active := env.alerts.Active()
+	if len(active) != 1 || active[0].ID != alertID(id) || active[0].Source != alertSource {
+		t.Fatalf("expected firing alert, got %+v", active)
+	}
+	if active[0].Severity != alert.Warning {
+		t.Errorf("severity = %v, want Warning", active[0].Severity)
+	}
+	st := env.mgr.Statuses()
+	if len(st) != 1 || st[0].State != StateFiring || st[0].Value != 42 || st[0].FiringSince.IsZero() {
+		t.Fatalf("status = %+v", st)
+	}
+
+	env.result = Result{Count: 0}
+	env.sched.run(t, jobName(id))
+	if env.alerts.Count() != 0 {
+		t.Fatalf("alert not cleared on resolve: %v", env.alerts.Active())
+	}
+	st = env.mgr.Statuses()
+	if st[0].State != StateOK || !st[0].FiringSince.IsZero() || st[0].LastResolved.IsZero() {
+		t.Fatalf("status after resolve = %+v", st[0])
+	}
+}
+
+func TestEvaluationError(t *testing.T) {
+	env := newTestEnv()
+	id := glid.New()
+	env.rules.set(countRule(id, 10))
+	env.mgr.Reconcile(context.Background())
+
+	env.err = errors.New("boom")
+	env.sched.run(t, jobName(id))
+	active := env.alerts.Active()
+	if len(active) != 1 || active[0].ID != errorAlertID(id) {
+		t.Fatalf("expected error alert, got %+v", active)
+	}
+	if st := env.mgr.Statuses(); st[0].State != StateError || st[0].Error != "boom" {
+		t.Fatalf("status = %+v", st[0])
+	}
+
+	env.err = nil
+	env.result = Result{Count: 1}
+	env.sched.run(t, jobName(id))
+	if env.alerts.Count() != 0 {
+		t.Fatalf("error alert not cleared: %v", env.alerts.Active())
+	}
+}
+
+func TestNonLeaderDoesNotEvaluate(t *testing.T) {
+	env := newTestEnv()
+	id := glid.New()
+	env.rules.set(countRule(id, 10))
+	env.mgr.Reconcile(context.Background())
+
+	env.result = Result{Count: 42}
+	env.sched.run(t, jobName(id))
+	if env.alerts.Count() != 1 {
+		t.Fatal("leader did not fire")
+	}
+
+	// Leadership moved: the next tick drops local state and the alert.
+	env.leader = false
+	env.sched.run(t, jobName(id))
+	if env.alerts.Count() != 0 {
+		t.Fatalf("former leader kept alert: %v", env.alerts.Active())
+	}
+	if st := env.mgr.Statuses(); len(st) != 0 {
+		t.Fatalf("former leader kept status: %+v", st)
+	}
+}
+
+func TestEvaluateColumn(t *testing.T) {
+	res := Result{
+		Count:   2,
+		Columns: []string{"host", "count"},
+		Rows:    [][]string{{"a", "3"}, {"b", "17"}},
+	}
+	cond := system.AlertCondition{Column: "count", Op: system.AlertOpGE, Threshold: 10}
+	v, firing, err := Evaluate(cond, res)
+	if err != nil || !firing || v != 17 {
+		t.Fatalf("Evaluate = %v, %v, %v; want 17, true, nil", v, firing, err)
+	}
+
+	cond.Threshold = 100
+	v, firing, err = Evaluate(cond, res)
+	if err != nil || firing || v != 3 {
+		t.Fatalf("Evaluate = %v, %v, %v; want 3, false, nil", v, firing, err)
+	}
+
+	cond.Column = "missing"
+	if _, _, err := Evaluate(cond, res); err == nil {
+		t.Fatal("expected error for missing column")
+	}
+	if _, _, err := Evaluate(cond, Result{Count: 5}); err == nil {
+		t.Fatal("expected error for column on non-table result")
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		got  []Event
+		auth string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ev Event
+		if err := json.NewDecoder(r.Body).Decode(&ev); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		got = append(got, ev)
+		auth = r.Header.Get("Authorization")
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	env := newTestEnv()
+	id := glid.New()
+	rule := countRule(id, 10)
+	rule.Sinks = []system.AlertSink{{
+		Type:   system.AlertSinkWebhook,
+		Params: map[string]string{"url": srv.URL, "header.Authorization": "Bearer x"},
+	}}
+	env.rules.set(rule)
+	env.mgr.Reconcile(context.Background())
+
+	env.result = Result{Count: 42}
+	env.sched.run(t, jobName(id))
+	env.sched.run(t, jobName(id)) // still firing: no second notification
+	env.result = Result{Count: 0}
+	env.sched.run(t, jobName(id))
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(got) != 2 {
+		t.Fatalf("got %d webhook calls, want 2", len(got))
+	}
+	if got[0].State != StateFiring || got[0].Value != 42 || got[0].RuleID != id.String() {
+		t.Errorf("firing event = %+v", got[0])
+	}
+	if got[1].State != StateResolved {
+		t.Errorf("resolved event = %+v", got[1])
+	}
+	if auth != "Bearer x" {
+		t.Errorf("Authorization = %q", auth)
+	}
+}
+
+func TestVaultSink(t *testing.T) {
+	vaultID := glid.New()
+	var (
+		gotVault glid.GLID
+		gotRec   chunk.Record
+	)
+	sink, err := NewSink(system.AlertSink{
+		Type:   system.AlertSinkVault,
+		Params: map[string]string{"vault_id": vaultID.String()},
+	}, func(id glid.GLID, rec chunk.Record) error {
+		gotVault, gotRec = id, rec
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	ev := newEvent(countRule(glid.New(), 10), StateFiring, 42, now)
+	if err := sink.Notify(context.Background(), ev); err != nil {
+		t.Fatal(err)
+	}
+	if gotVault != vaultID {
+		t.Errorf("vault = %s, want %s", gotVault, vaultID)
+	}
+	if gotRec.Attrs["alert_state"] != "firing" || gotRec.Attrs["alert_rule"] != "errors" || !gotRec.SourceTS.Equal(now) {
+		t.Errorf("record = %+v", gotRec)
+	}
+}
@@ -0,0 +1,62 @@
+package alertrule
+
+import (
+	"fmt"
+	"strconv"
+
+	"gastrolog/internal/system"
+)
+
+// Evaluate derives the rule value from a query result and reports whether
+// the condition holds.
+//
+// With no column, the value is res.Count. With a column, every table row is
+// checked; the rule fires if any row satisfies the comparison, and the
+// reported value is the first matching row's value (or the first row's value
+// when none match). Rows whose cell is not numeric are skipped.
+func Evaluate(c system.AlertCondition, res Result) (float64, bool, error) {
+	if c.Column == "" {
+		v := float64(res.Count)
+		return v, c.Compare(v), nil
+	}
+
+	idx := -1
+	for i, col := range res.Columns {
+		if col == c.Column {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		if len(res.Columns) == 0 {
+			return 0, false, fmt.Errorf("condition column %q requires an aggregating query (e.g. | stats)", c.Column)
+		}
+		return 0, false, fmt.Errorf("condition column %q not in query result", c.Column)
+	}
+
+	var (
+		first    float64
+		hasFirst bool
+	)
+	for _, row := range res.Rows {
+		if idx >= len(row) {
+			continue
+		}
+		v, err := strconv.ParseFloat(row[idx], 64)
+		if err != nil {
+			continue
+		}
+		if c.Compare(v) {
+			return v, true, nil
+		}
+		if !hasFirst {
+			first, hasFirst = v, true
+		}
+	}
+	return first, false, nil
+}
+
+// formatValue renders a float without trailing zeros.
+func formatValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
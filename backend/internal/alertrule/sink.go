@@ -0,0 +1,208 @@
+package alertrule
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gastrolog/internal/glid"
+	"io"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"gastrolog/internal/chunk"
+	"gastrolog/internal/system"
+)
+
+// webhookTimeout bounds a single webhook delivery.
+const webhookTimeout = 10 * time.Second
+
+// Event is a firing or resolved transition delivered to sinks.
+type Event struct {
+	RuleID    string    `json:"rule_id"`
+	RuleName  string    `json:"rule_name"`
+	Query     string    `json:"query"`
+	State     State     `json:"state"` // "firing" or "resolved"
+	Severity  string    `json:"severity"`
+	Column    string    `json:"column,omitempty"`
+	Op        string    `json:"op"`
+	Threshold float64   `json:"threshold"`
+	Value     float64   `json:"value"`
+	Time      time.Time `json:"time"`
+}
+
+func newEvent(rule system.AlertRuleConfig, state State, value float64, now time.Time) Event {
+	sev := rule.Severity
+	if sev == "" {
+		sev = "warning"
+	}
+	return Event{
+		RuleID:    rule.ID.String(),
+		RuleName:  rule.Name,
+		Query:     rule.Query,
+		State:     state,
+		Severity:  sev,
+		Column:    rule.Condition.Column,
+		Op:        string(rule.Condition.Op),
+		Threshold: rule.Condition.Threshold,
+		Value:     value,
+		Time:      now,
+	}
+}
+
+// Summary renders a one-line human description of the event.
+func (e Event) Summary() string {
+	subject := "row count"
+	if e.Column != "" {
+		subject = e.Column
+	}
+	verb := "FIRING"
+	if e.State != StateFiring {
+		verb = "RESOLVED"
+	}
+	return fmt.Sprintf("[%s] %s: %s is %s (threshold %s %s)",
+		verb, e.RuleName, subject, formatValue(e.Value), e.Op, formatValue(e.Threshold))
+}
+
+// Sink delivers alert events to an external target.
+type Sink interface {
+	Notify(ctx context.Context, ev Event) error
+}
+
+// NewSink builds a sink from its config. appendFn backs the vault sink and
+// may be nil, in which case vault sinks return an error.
+func NewSink(cfg system.AlertSink, appendFn func(glid.GLID, chunk.Record) error) (Sink, error) {
+	switch cfg.Type {
+	case system.AlertSinkWebhook:
+		url := cfg.Params["url"]
+		if url == "" {
+			return nil, errors.New("webhook: url is required")
+		}
+		headers := make(map[string]string)
+		for k, v := range cfg.Params {
+			if name, ok := strings.CutPrefix(k, "header."); ok && name != "" {
+				headers[name] = v
+			}
+		}
+		return &webhookSink{url: url, headers: headers, client: &http.Client{Timeout: webhookTimeout}}, nil
+
+	case system.AlertSinkSMTP:
+		s := &smtpSink{
+			addr:     cfg.Params["addr"],
+			from:     cfg.Params["from"],
+			username: cfg.Params["username"],
+			password: cfg.Params["password"],
+			send:     smtp.SendMail,
+		}
+		for to := range strings.SplitSeq(cfg.Params["to"], ",") {
+			if to = strings.TrimSpace(to); to != "" {
+				s.to = append(s.to, to)
+			}
+		}
+		if s.addr == "" || s.from == "" || len(s.to) == 0 {
+			return nil, errors.New("smtp: addr, from and to are required")
+		}
+		return s, nil
+
+	case system.AlertSinkVault:
+		id, err := glid.ParseUUID(cfg.Params["vault_id"])
+		if err != nil {
+			return nil, fmt.Errorf("vault: invalid vault_id: %w", err)
+		}
+		if appendFn == nil {
+			return nil, errors.New("vault: append not available")
+		}
+		return &vaultSink{vaultID: id, append: appendFn}, nil
+	}
+	return nil, fmt.Errorf("unknown sink type %q", cfg.Type)
+}
+
+// webhookSink POSTs the event as JSON.
+type webhookSink struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func (s *webhookSink) Notify(ctx context.Context, ev Event) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook: unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// smtpSink sends a plain-text email. send is smtp.SendMail, swappable in tests.
+type smtpSink struct {
+	addr     string
+	from     string
+	to       []string
+	username string
+	password string
+	send     func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func (s *smtpSink) Notify(_ context.Context, ev Event) error {
+	var auth smtp.Auth
+	if s.username != "" {
+		host, _, _ := strings.Cut(s.addr, ":")
+		auth = smtp.PlainAuth("", s.username, s.password, host)
+	}
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", s.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(s.to, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", ev.Summary())
+	fmt.Fprintf(&msg, "Date: %s\r\n", ev.Time.Format(time.RFC1123Z))
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	fmt.Fprintf(&msg, "%s\r\n\r\nQuery: %s\r\nTime: %s\r\n", ev.Summary(), ev.Query, ev.Time.Format(time.RFC3339))
+	if err := s.send(s.addr, auth, s.from, s.to, msg.Bytes()); err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	return nil
+}
+
+// vaultSink writes the event as a log record, so alert history is itself
+// searchable.
+type vaultSink struct {
+	vaultID glid.GLID
+	append  func(glid.GLID, chunk.Record) error
+}
+
+func (s *vaultSink) Notify(_ context.Context, ev Event) error {
+	rec := chunk.Record{
+		SourceTS: ev.Time,
+		IngestTS: ev.Time,
+		Raw:      []byte(ev.Summary()),
+		Attrs: chunk.Attributes{
+			"alert_rule":    ev.RuleName,
+			"alert_rule_id": ev.RuleID,
+			"alert_state":   string(ev.State),
+			"severity":      ev.Severity,
+			"value":         formatValue(ev.Value),
+		},
+	}
+	if err := s.append(s.vaultID, rec); err != nil {
+		return fmt.Errorf("vault: %w", err)
+	}
+	return nil
+}
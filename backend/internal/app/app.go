@@ -11,6 +11,7 @@ import (
 	"gastrolog/internal/glid"
 	"io"
 	"log/slog"
+	"math"
 	"path/filepath"
 	"sync"
 	"time"
@@ -19,6 +20,7 @@ import (
 
 	gastrologv1 "gastrolog/api/gen/gastrolog/v1"
 	"gastrolog/internal/alert"
+	"gastrolog/internal/alertrule"
 	"gastrolog/internal/auth"
 	"gastrolog/internal/cert"
 	"gastrolog/internal/chanwatch"
@@ -37,7 +39,9 @@ import (
 	"gastrolog/internal/ingester/chatterbox"
 	ingestdocker "gastrolog/internal/ingester/docker"
 	ingestfluentfwd "gastrolog/internal/ingester/fluentfwd"
+	ingestgelf "gastrolog/internal/ingester/gelf"
 	ingesthttp "gastrolog/internal/ingester/http"
+	ingestjournal "gastrolog/internal/ingester/journal"
 	ingestkafka "gastrolog/internal/ingester/kafka"
 	ingestmetrics "gastrolog/internal/ingester/metrics"
 	ingestmqtt "gastrolog/internal/ingester/mqtt"
@@ -47,6 +51,7 @@ import (
 	ingestself "gastrolog/internal/ingester/self"
 	ingestsyslog "gastrolog/internal/ingester/syslog"
 	ingesttail "gastrolog/internal/ingester/tail"
+	"gastrolog/internal/ingestqueue"
 	"gastrolog/internal/lifecycle"
 	"gastrolog/internal/logging"
 	"gastrolog/internal/notify"
@@ -79,6 +84,14 @@ type RunConfig struct {
 	// Empty if pprof is disabled. Advertised to cluster peers via broadcast.
 	PprofAddr string
 
+	// IngestQueueSize bounds the disk-backed ingest queue (e.g. "1GB").
+	// Empty or zero runs without the queue.
+	IngestQueueSize string
+
+	// IngestQueueSync is the ingest queue's fsync policy: "always",
+	// "interval" or "none". Empty means "interval".
+	IngestQueueSync string
+
 	// SlogCapture receives copies of slog records for the "self" ingester.
 	// Created by main and shared with the CaptureHandler. Nil disables capture.
 	SlogCapture <-chan logging.CapturedRecord
@@ -96,6 +109,11 @@ func Run(ctx context.Context, logger *slog.Logger, cfg RunConfig) error {
 		return fmt.Errorf("resolve home directory: %w", err)
 	}
 
+	ingestQueue, err := ingestQueueConfig(cfg, hd)
+	if err != nil {
+		return err
+	}
+
 	nodeID, err := resolveIdentity(logger, cfg, hd)
 	if err != nil {
 		return err
@@ -160,6 +178,7 @@ func Run(ctx context.Context, logger *slog.Logger, cfg RunConfig) error {
 
 	orch, err := orchestrator.New(orchestrator.Config{
 		Logger:            logger,
+		IngestQueue:       ingestQueue,
 		MaxConcurrentJobs: loadMaxConcurrentJobs(ctx, cfgStore),
 		SystemLoader:      cfgStore,
 		LocalNodeID:       nodeID,
@@ -302,6 +321,8 @@ func Run(ctx context.Context, logger *slog.Logger, cfg RunConfig) error {
 		go pm.Run(ctx)
 	}
 
+	alertRules := setupAlertRules(ctx, logger, cfgStore, orch, clusterSrv, alertCollector, disp)
+
 	// For replication cases: block until server settings replicate from the leader.
 	if err := awaitReplication(ctx, appSys, cfg.ConfigType, cfgStore, logger); err != nil {
 		return err
@@ -356,7 +377,33 @@ func Run(ctx context.Context, logger *slog.Logger, cfg RunConfig) error {
 		WAL:                 tierWAL,
 		ConfigStore:         proxy,
 		PlacementReconcile:  placementReconcileFn,
+		AlertRules:          alertRules,
+	})
+}
+
+// setupAlertRules creates the alert rule manager, registers the currently
+// configured rules, and wires the dispatcher so rule changes re-sync the
+// scheduler. Every node schedules the rules; only the system Raft leader
+// evaluates them. The query executor is wired later by the server.
+func setupAlertRules(ctx context.Context, logger *slog.Logger, cfgStore system.Store, orch *orchestrator.Orchestrator, clusterSrv *cluster.Server, alerts *alert.Collector, disp *configDispatcher) *alertrule.Manager {
+	var isLeader func() bool
+	if clusterSrv != nil {
+		isLeader = clusterSrv.IsLeader
+	}
+	mgr := alertrule.New(alertrule.Config{
+		Rules:     cfgStore,
+		Scheduler: orch.Scheduler(),
+		Alerts:    alerts,
+		IsLeader:  isLeader,
+		Append: func(vaultID glid.GLID, rec chunk.Record) error {
+			_, _, err := orch.Append(vaultID, rec)
+			return err
+		},
+		Logger: logger,
 	})
+	disp.alertRuleReconcile = mgr.Reconcile
+	mgr.Reconcile(ctx)
+	return mgr
 }
 
 // makeTierDrainCompleteHandler returns a callback that deletes the drained tier
@@ -962,6 +1009,7 @@ type serverDeps struct {
 	WAL                 *raftwal.WAL // tier-group WAL (same file as system raft when cluster mode); nil = per-group boltdb
 	ConfigStore         io.Closer    // rawStore — closed before gRPC for clean Raft shutdown
 	PlacementReconcile  func(ctx context.Context)
+	AlertRules          *alertrule.Manager
 }
 
 func serveAndAwaitShutdown(ctx context.Context, deps serverDeps) error {
@@ -984,7 +1032,11 @@ func serveAndAwaitShutdown(ctx context.Context, deps serverDeps) error {
 				"file": chunkcloud.NewConnectionTester(),
 			},
 			PlacementReconcile: deps.PlacementReconcile,
+			AlertRuleStatus:    deps.AlertRules,
 		})
+		// Alert rules evaluate through the server's query path so they see
+		// the same cluster-wide results as an interactive search.
+		deps.AlertRules.SetSearch(srv.AlertQueryFunc())
 		// Provide the cluster's ForwardRPC handler with the internal mux.
 		// NoAuthInterceptor + no routing interceptor prevents loops.
 		if deps.ClusterSrv != nil {
@@ -1153,7 +1205,7 @@ func buildFactories(logger *slog.Logger, homeDir, vaultsDir string, cfgStore sys
 	// SingletonSupported table (see gastrolog-2kcw4):
 	//   chatterbox / scatterbox  — synthetic, both parallel and singleton-with-failover are legitimate
 	//   kafka / mqtt             — depends on broker setup (consumer group / shared subscription)
-	//   docker / self / tail / metrics — per-node-local source, singleton would hide 3/4 of cluster data
+	//   docker / self / tail / journal / metrics — per-node-local source, singleton would hide 3/4 of cluster data
 	//   listeners                — OS-level port coordination, concept doesn't apply
 	ingesterTypes := map[string]orchestrator.IngesterRegistration{
 		"chatterbox": regHA(chatterbox.NewIngester, chatterbox.ParamDefaults, nil),
@@ -1162,14 +1214,16 @@ func buildFactories(logger *slog.Logger, homeDir, vaultsDir string, cfgStore sys
 			func(ctx context.Context, params map[string]string) (string, error) {
 				return ingestdocker.TestConnection(ctx, params, cfgStore)
 			}),
-		"fluentfwd": listen(ingestfluentfwd.NewFactory(), ingestfluentfwd.ParamDefaults, ingestfluentfwd.ListenAddrs),
-		"http":      listen(ingesthttp.NewFactory(), ingesthttp.ParamDefaults, ingesthttp.ListenAddrs),
+		"fluentfwd": listen(ingestfluentfwd.NewFactory(certMgr), ingestfluentfwd.ParamDefaults, ingestfluentfwd.ListenAddrs),
+		"gelf":      listen(ingestgelf.NewFactory(certMgr), ingestgelf.ParamDefaults, ingestgelf.ListenAddrs),
+		"http":      listen(ingesthttp.NewFactory(certMgr), ingesthttp.ParamDefaults, ingesthttp.ListenAddrs),
+		"journal":   reg(ingestjournal.NewFactory(), ingestjournal.ParamDefaults, nil),
 		"kafka":     regHA(ingestkafka.NewFactory(), ingestkafka.ParamDefaults, ingestkafka.TestConnection),
 		"mqtt":      regHA(ingestmqtt.NewFactory(), ingestmqtt.ParamDefaults, ingestmqtt.TestConnection),
 		"metrics":   reg(ingestmetrics.NewFactory(orch), ingestmetrics.ParamDefaults, nil),
-		"otlp":      listen(ingestotlp.NewFactory(), ingestotlp.ParamDefaults, ingestotlp.ListenAddrs),
+		"otlp":      listen(ingestotlp.NewFactory(certMgr), ingestotlp.ParamDefaults, ingestotlp.ListenAddrs),
 		"relp":      listen(ingestrelp.NewFactory(certMgr), ingestrelp.ParamDefaults, ingestrelp.ListenAddrs),
-		"syslog":    listen(ingestsyslog.NewFactory(), ingestsyslog.ParamDefaults, ingestsyslog.ListenAddrs),
+		"syslog":    listen(ingestsyslog.NewFactory(certMgr), ingestsyslog.ParamDefaults, ingestsyslog.ListenAddrs),
 		"tail":      reg(ingesttail.NewFactory(), ingesttail.ParamDefaults, nil),
 	}
 	if slogCh != nil {
@@ -1215,6 +1269,35 @@ func resolveHome(flagValue string) (home.Dir, error) {
 	return home.Default()
 }
 
+// ingestQueueConfig builds the orchestrator's disk queue settings from the
+// server flags. The zero Config (no Dir) leaves the queue disabled.
+func ingestQueueConfig(cfg RunConfig, hd home.Dir) (ingestqueue.Config, error) {
+	if cfg.IngestQueueSize == "" {
+		return ingestqueue.Config{}, nil
+	}
+	size, err := system.ParseSize(cfg.IngestQueueSize)
+	if err != nil {
+		return ingestqueue.Config{}, fmt.Errorf("invalid ingest queue size: %w", err)
+	}
+	if size == 0 {
+		return ingestqueue.Config{}, nil
+	}
+	if size > math.MaxInt64 {
+		return ingestqueue.Config{}, fmt.Errorf("invalid ingest queue size: %q is too large", cfg.IngestQueueSize)
+	}
+	policy := ingestqueue.SyncInterval
+	if cfg.IngestQueueSync != "" {
+		if policy, err = ingestqueue.ParseSyncPolicy(cfg.IngestQueueSync); err != nil {
+			return ingestqueue.Config{}, err
+		}
+	}
+	return ingestqueue.Config{
+		Dir:      hd.IngestQueueDir(),
+		MaxBytes: int64(size),
+		Sync:     policy,
+	}, nil
+}
+
 func buildTokenService(ctx context.Context, cfgStore system.Store) (*auth.TokenService, error) {
 	ss, err := cfgStore.LoadServerSettings(ctx)
 	if err != nil {
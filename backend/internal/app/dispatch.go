@@ -71,6 +71,7 @@ type configDispatcher struct {
 	managedFileHandler ManagedFileHandler                               // nil for single-node or before wiring
 	catchupScheduler   func(vaultID, tierID glid.GLID, followerNodeIDs []string) // nil until orch is wired
 	placementTrigger   func()                                           // triggers immediate placement reconcile; nil for single-node
+	alertRuleReconcile func(ctx context.Context)                        // re-syncs alert rule jobs; nil until wired
 }
 
 // Handle dispatches a single FSM notification to the appropriate orchestrator
@@ -131,7 +132,12 @@ func (d *configDispatcher) Handle(n raftfsm.Notification) {
 		// failure mode that left node3 with only 1 of 3 tiers after
 		// a snapshot/replication catchup. See gastrolog-51gme.
 		d.handleTierPut(ctx, n.ID)
+	case raftfsm.NotifyAlertRulePut, raftfsm.NotifyAlertRuleDeleted:
+		if d.alertRuleReconcile != nil {
+			d.alertRuleReconcile(ctx)
+		}
 	case raftfsm.NotifyCloudServicePut, raftfsm.NotifyCloudServiceDeleted,
+		raftfsm.NotifyRolePut, raftfsm.NotifyRoleDeleted,
 		raftfsm.NotifyNodeStorageConfigSet, raftfsm.NotifySetupWizardDismissedSet,
 		raftfsm.NotifyIngesterAliveSet,
 		raftfsm.NotifyIngesterCheckpointSet:
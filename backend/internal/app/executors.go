@@ -27,6 +27,9 @@ type orchStatsAdapter struct {
 
 func (a *orchStatsAdapter) IngestQueueDepth() int    { return a.orch.IngestQueueDepth() }
 func (a *orchStatsAdapter) IngestQueueCapacity() int { return a.orch.IngestQueueCapacity() }
+func (a *orchStatsAdapter) IngestDiskQueueStats() (int64, int64, int64) {
+	return a.orch.IngestDiskQueueStats()
+}
 
 func (a *orchStatsAdapter) VaultSnapshots() []cluster.StatsVaultSnapshot {
 	snaps := a.orch.VaultSnapshots()
@@ -85,6 +88,7 @@ func (a *orchStatsAdapter) RouteStats() cluster.StatsRouteSnapshot {
 			RouteID:   routeID.String(),
 			Matched:   rs.Matched.Load(),
 			Forwarded: rs.Forwarded.Load(),
+			Dropped:   rs.Dropped.Load(),
 		})
 	}
 	return snap
@@ -129,10 +133,19 @@ func forwardSearchAfterParse(
 	eng *query.Engine,
 	q query.Query,
 	pipeline *querylang.Pipeline,
+	partialStats bool,
 	resumeTokenData []byte,
 ) (iter.Seq2[chunk.Record, error], func() []byte, *gastrologv1.TableResult, []*gastrologv1.HistogramBucket, error) {
 	histogram := server.HistogramToProto(eng.ComputeHistogram(ctx, q, 50))
 
+	if partialStats && pipeline != nil {
+		table, err := eng.RunPipelinePartial(ctx, q, pipeline)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		return nil, nil, server.TableResultToBasicProto(table), histogram, nil
+	}
+
 	if pipeline != nil && len(pipeline.Pipes) > 0 && !query.CanStreamPipeline(pipeline) {
 		result, err := eng.RunPipeline(ctx, q, pipeline)
 		if err != nil {
@@ -174,10 +187,11 @@ func forwardSearchAfterParse(
 // newSearchExecutor creates a cluster.SearchExecutor that runs local vault
 // searches for ForwardSearch RPCs received from peer nodes. When the query
 // contains a pipeline (stats, timechart), runs RunPipeline and returns the
-// TableResult instead of individual records. For regular searches, returns
-// the iterator directly — the streaming handler sends records as it iterates.
+// TableResult instead of individual records (or RunPipelinePartial when the
+// coordinator asks for partial stats). For regular searches, returns the
+// iterator directly — the streaming handler sends records as it iterates.
 func newSearchExecutor(o *orchestrator.Orchestrator) cluster.SearchExecutor {
-	return func(ctx context.Context, vaultID glid.GLID, queryExpr string, resumeTokenData []byte) (iter.Seq2[chunk.Record, error], func() []byte, *gastrologv1.TableResult, []*gastrologv1.HistogramBucket, error) {
+	return func(ctx context.Context, vaultID glid.GLID, queryExpr string, partialStats bool, resumeTokenData []byte) (iter.Seq2[chunk.Record, error], func() []byte, *gastrologv1.TableResult, []*gastrologv1.HistogramBucket, error) {
 		// Don't add vault_id= scope — the engine is already scoped to this
 		// vault's leader tiers. Adding vault_id= would fail because the
 		// engine uses tier IDs, not vault IDs.
@@ -194,7 +208,7 @@ func newSearchExecutor(o *orchestrator.Orchestrator) cluster.SearchExecutor {
 			return nil, nil, nil, nil, nil // no leader tiers for this vault
 		}
 
-		return forwardSearchAfterParse(ctx, eng, q, pipeline, resumeTokenData)
+		return forwardSearchAfterParse(ctx, eng, q, pipeline, partialStats, resumeTokenData)
 	}
 }
 
@@ -0,0 +1,43 @@
+package app
+
+import (
+	"testing"
+
+	"gastrolog/internal/home"
+	"gastrolog/internal/ingestqueue"
+)
+
+func TestIngestQueueConfig(t *testing.T) {
+	t.Parallel()
+	hd := home.New("/data")
+
+	for _, size := range []string{"", "0"} {
+		cfg, err := ingestQueueConfig(RunConfig{IngestQueueSize: size}, hd)
+		if err != nil || cfg.Dir != "" {
+			t.Errorf("size %q: got %+v, %v; want the queue disabled", size, cfg, err)
+		}
+	}
+
+	cfg, err := ingestQueueConfig(RunConfig{IngestQueueSize: "512MiB", IngestQueueSync: "always"}, hd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ingestqueue.Config{Dir: hd.IngestQueueDir(), MaxBytes: 512 << 20, Sync: ingestqueue.SyncAlways}
+	if cfg != want {
+		t.Errorf("got %+v, want %+v", cfg, want)
+	}
+
+	cfg, err = ingestQueueConfig(RunConfig{IngestQueueSize: "1GB"}, hd)
+	if err != nil || cfg.Sync != ingestqueue.SyncInterval {
+		t.Errorf("default sync: got %+v, %v", cfg, err)
+	}
+
+	for _, rc := range []RunConfig{
+		{IngestQueueSize: "lots"},
+		{IngestQueueSize: "1GB", IngestQueueSync: "sometimes"},
+	} {
+		if _, err := ingestQueueConfig(rc, hd); err == nil {
+			t.Errorf("%+v: expected error", rc)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gastrolog/api/gen/gastrolog/v1/gastrologv1connect"
+)
+
+// APIKeyHeader carries an API key. Requests that set it are authenticated
+// by the key alone; the Authorization header is ignored.
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyPrefix marks generated keys so they are easy to spot in scripts,
+// logs, and secret scanners.
+const APIKeyPrefix = "glk_"
+
+// API key scopes, mirrored from system.APIKeyScope* (auth does not import system).
+const (
+	scopeQuery  = "query"
+	scopeIngest = "ingest"
+	scopeAdmin  = "admin"
+)
+
+// APIKeyPrincipal is the identity behind a valid API key.
+type APIKeyPrincipal struct {
+	ID     string
+	Name   string
+	Scopes []string
+}
+
+// APIKeyValidator resolves the SHA-256 hash of an API key to its principal.
+// It returns nil (and no error) for unknown or expired keys.
+type APIKeyValidator interface {
+	ValidateAPIKey(ctx context.Context, keyHash string) (*APIKeyPrincipal, error)
+}
+
+// GenerateAPIKey creates a new API key and its SHA-256 hash.
+// The key is APIKeyPrefix followed by 32 random bytes encoded as base64url.
+func GenerateAPIKey() (key string, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("generate api key: %w", err)
+	}
+	key = APIKeyPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return key, HashAPIKey(key), nil
+}
+
+// HashAPIKey returns the hex-encoded SHA-256 hash of an API key.
+func HashAPIKey(key string) string {
+	return HashRefreshToken(key)
+}
+
+// apiKeyAllows reports whether scopes permit calling procedure.
+// The admin scope permits everything. The query scope covers QueryService
+// and JobService (to poll exports); the ingest scope covers ImportRecords.
+func apiKeyAllows(scopes []string, procedure string) bool {
+	if slices.Contains(scopes, scopeAdmin) {
+		return true
+	}
+	if slices.Contains(scopes, scopeQuery) &&
+		(strings.HasPrefix(procedure, "/"+gastrologv1connect.QueryServiceName+"/") ||
+			strings.HasPrefix(procedure, "/"+gastrologv1connect.JobServiceName+"/")) {
+		return true
+	}
+	return slices.Contains(scopes, scopeIngest) &&
+		procedure == gastrologv1connect.VaultServiceImportRecordsProcedure
+}
+
+// apiKeyClaims builds the claims attached to a request authenticated by an
+// API key. Keys with the admin scope act as admin; all others as user, so
+// handlers that check the role (e.g. export) treat them like a regular user.
+func apiKeyClaims(p *APIKeyPrincipal) *Claims {
+	role := "user"
+	if slices.Contains(p.Scopes, scopeAdmin) {
+		role = "admin"
+	}
+	c := &Claims{Role: role, APIKeyID: p.ID}
+	c.Subject = "apikey:" + p.Name
+	return c
+}
@@ -0,0 +1,151 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	apiv1 "gastrolog/api/gen/gastrolog/v1"
+	"gastrolog/api/gen/gastrolog/v1/gastrologv1connect"
+	"gastrolog/internal/auth"
+)
+
+// stubAPIKeys implements auth.APIKeyValidator over a fixed key set.
+type stubAPIKeys map[string]*auth.APIKeyPrincipal // hash → principal
+
+func (s stubAPIKeys) ValidateAPIKey(ctx context.Context, keyHash string) (*auth.APIKeyPrincipal, error) {
+	return s[keyHash], nil
+}
+
+// newAPIKeyTestSetup creates a test server that accepts the given keys.
+func newAPIKeyTestSetup(t *testing.T, keys map[string][]string) *testSetup {
+	t.Helper()
+
+	valid := stubAPIKeys{}
+	for key, scopes := range keys {
+		valid[auth.HashAPIKey(key)] = &auth.APIKeyPrincipal{ID: "id-" + key, Name: key, Scopes: scopes}
+	}
+
+	tokens := auth.NewTokenService([]byte("test-secret-key-32-bytes-long!!"), 7*24*time.Hour)
+	interceptor := auth.NewAuthInterceptor(tokens, &mockCounter{count: 1}, nil)
+	interceptor.SetAPIKeyValidator(valid)
+	opts := connect.WithInterceptors(interceptor)
+
+	mux := http.NewServeMux()
+	mux.Handle(gastrologv1connect.NewAuthServiceHandler(&stubAuthService{}, opts))
+	mux.Handle(gastrologv1connect.NewSystemServiceHandler(&stubSystemService{}, opts))
+	mux.Handle(gastrologv1connect.NewQueryServiceHandler(&stubQueryService{}, opts))
+
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+
+	return &testSetup{server: ts}
+}
+
+func withAPIKey(key string) connect.ClientOption {
+	return connect.WithInterceptors(&apiKeyInterceptor{key: key})
+}
+
+type apiKeyInterceptor struct {
+	key string
+}
+
+func (a *apiKeyInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		req.Header().Set(auth.APIKeyHeader, a.key)
+		return next(ctx, req)
+	}
+}
+
+func (a *apiKeyInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (a *apiKeyInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+func TestGenerateAPIKey(t *testing.T) {
+	t.Parallel()
+	key, hash, err := auth.GenerateAPIKey()
+	if err != nil {
+		t.Fatalf("GenerateAPIKey: %v", err)
+	}
+	if !strings.HasPrefix(key, auth.APIKeyPrefix) {
+		t.Errorf("key %q missing prefix %q", key, auth.APIKeyPrefix)
+	}
+	if hash != auth.HashAPIKey(key) {
+		t.Error("hash does not match HashAPIKey(key)")
+	}
+	other, _, err := auth.GenerateAPIKey()
+	if err != nil {
+		t.Fatalf("GenerateAPIKey: %v", err)
+	}
+	if other == key {
+		t.Error("two generated keys are equal")
+	}
+}
+
+func TestAPIKey_QueryScope(t *testing.T) {
+	t.Parallel()
+	s := newAPIKeyTestSetup(t, map[string][]string{"dash": {"query"}})
+
+	query := gastrologv1connect.NewQueryServiceClient(http.DefaultClient, s.server.URL, withAPIKey("dash"))
+	if _, err := query.Explain(context.Background(), connect.NewRequest(&apiv1.ExplainRequest{})); err != nil {
+		t.Fatalf("query scope should allow Explain: %v", err)
+	}
+
+	sys := gastrologv1connect.NewSystemServiceClient(http.DefaultClient, s.server.URL, withAPIKey("dash"))
+	_, err := sys.GetSystem(context.Background(), connect.NewRequest(&apiv1.GetSystemRequest{}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("query scope on GetSystem: expected PermissionDenied, got %v", err)
+	}
+}
+
+func TestAPIKey_IngestScopeDeniesQuery(t *testing.T) {
+	t.Parallel()
+	s := newAPIKeyTestSetup(t, map[string][]string{"ci": {"ingest"}})
+
+	query := gastrologv1connect.NewQueryServiceClient(http.DefaultClient, s.server.URL, withAPIKey("ci"))
+	_, err := query.Explain(context.Background(), connect.NewRequest(&apiv1.ExplainRequest{}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("ingest scope on Explain: expected PermissionDenied, got %v", err)
+	}
+}
+
+func TestAPIKey_AdminScope(t *testing.T) {
+	t.Parallel()
+	s := newAPIKeyTestSetup(t, map[string][]string{"ops": {"admin"}})
+
+	sys := gastrologv1connect.NewSystemServiceClient(http.DefaultClient, s.server.URL, withAPIKey("ops"))
+	if _, err := sys.GetSystem(context.Background(), connect.NewRequest(&apiv1.GetSystemRequest{})); err != nil {
+		t.Fatalf("admin scope should allow GetSystem: %v", err)
+	}
+}
+
+func TestAPIKey_Unknown(t *testing.T) {
+	t.Parallel()
+	s := newAPIKeyTestSetup(t, map[string][]string{"dash": {"query"}})
+
+	query := gastrologv1connect.NewQueryServiceClient(http.DefaultClient, s.server.URL, withAPIKey("nope"))
+	_, err := query.Explain(context.Background(), connect.NewRequest(&apiv1.ExplainRequest{}))
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("unknown key: expected Unauthenticated, got %v", err)
+	}
+}
+
+func TestAPIKey_NotEnabled(t *testing.T) {
+	t.Parallel()
+	s := newTestSetup(t, &mockCounter{count: 1})
+
+	client := gastrologv1connect.NewQueryServiceClient(http.DefaultClient, s.server.URL, withAPIKey("dash"))
+	_, err := client.Explain(context.Background(), connect.NewRequest(&apiv1.ExplainRequest{}))
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("no validator: expected Unauthenticated, got %v", err)
+	}
+}
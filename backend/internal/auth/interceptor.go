@@ -66,6 +66,7 @@ type AuthInterceptor struct {
 	tokens    *TokenService
 	counter   UserCounter
 	validator TokenValidator
+	apiKeys   APIKeyValidator
 	public    map[string]bool
 	admin     map[string]bool
 }
@@ -85,6 +86,8 @@ func NewAuthInterceptor(tokens *TokenService, counter UserCounter, validator Tok
 			gastrologv1connect.AuthServiceLoginProcedure:             true,
 			gastrologv1connect.AuthServiceRegisterProcedure:          true, // self-guards after first user
 			gastrologv1connect.AuthServiceRefreshTokenProcedure:      true, // uses opaque token, not JWT
+			gastrologv1connect.AuthServiceBeginOIDCLoginProcedure:    true,
+			gastrologv1connect.AuthServiceCompleteOIDCLoginProcedure: true, // verified by state cookie + IdP code
 			gastrologv1connect.SystemServiceGetSettingsProcedure: true, // password policy needed on register page
 		},
 		admin: map[string]bool{
@@ -95,6 +98,10 @@ func NewAuthInterceptor(tokens *TokenService, counter UserCounter, validator Tok
 			gastrologv1connect.AuthServiceResetPasswordProcedure:  true,
 			gastrologv1connect.AuthServiceDeleteUserProcedure:     true,
 			gastrologv1connect.AuthServiceRenameUserProcedure:     true,
+			// API keys
+			gastrologv1connect.AuthServiceCreateAPIKeyProcedure: true,
+			gastrologv1connect.AuthServiceListAPIKeysProcedure:  true,
+			gastrologv1connect.AuthServiceDeleteAPIKeyProcedure: true,
 			// Lifecycle + cluster
 			gastrologv1connect.LifecycleServiceShutdownProcedure:        true,
 			gastrologv1connect.LifecycleServiceGetClusterStatusProcedure: true,
@@ -137,9 +144,17 @@ func NewAuthInterceptor(tokens *TokenService, counter UserCounter, validator Tok
 			gastrologv1connect.SystemServicePutNodeConfigProcedure:         true,
 			gastrologv1connect.SystemServicePutRouteProcedure:              true,
 			gastrologv1connect.SystemServiceDeleteRouteProcedure:           true,
+			gastrologv1connect.SystemServicePreviewRoutePipelineProcedure: true,
 			gastrologv1connect.SystemServicePauseVaultProcedure:            true,
 			gastrologv1connect.SystemServiceResumeVaultProcedure:           true,
 			gastrologv1connect.SystemServiceTriggerIngesterProcedure:       true,
+			// ConfigService — alert rules
+			gastrologv1connect.SystemServicePutAlertRuleProcedure:       true,
+			gastrologv1connect.SystemServiceDeleteAlertRuleProcedure:    true,
+			gastrologv1connect.SystemServiceGetAlertRuleStatusProcedure: true,
+			// ConfigService — roles
+			gastrologv1connect.SystemServicePutRoleProcedure:    true,
+			gastrologv1connect.SystemServiceDeleteRoleProcedure: true,
 			// ConfigService — certificates
 			gastrologv1connect.SystemServiceListCertificatesProcedure:  true,
 			gastrologv1connect.SystemServiceGetCertificateProcedure:    true,
@@ -148,12 +163,18 @@ func NewAuthInterceptor(tokens *TokenService, counter UserCounter, validator Tok
 			// ConfigService — managed files
 			gastrologv1connect.SystemServiceListManagedFilesProcedure:  true,
 			gastrologv1connect.SystemServiceDeleteManagedFileProcedure: true,
-			// QueryService — destructive
-			gastrologv1connect.QueryServiceExportToVaultProcedure: true,
+			// QueryService.ExportToVault is not listed: the handler checks
+			// export grants per vault, so custom roles may export too.
 		},
 	}
 }
 
+// SetAPIKeyValidator enables authentication via the X-API-Key header.
+// Without a validator, requests carrying an API key are rejected.
+func (i *AuthInterceptor) SetAPIKeyValidator(v APIKeyValidator) {
+	i.apiKeys = v
+}
+
 // WrapUnary implements connect.Interceptor for unary RPCs.
 func (i *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
@@ -204,6 +225,18 @@ func (i *AuthInterceptor) authenticate(ctx context.Context, procedure string, he
 		return ctx, connect.NewError(connect.CodeUnauthenticated, errors.New("no users registered; call Register to create the first user"))
 	}
 
+	// API keys are authorized by scope rather than by role.
+	if key := headers.Get(APIKeyHeader); key != "" {
+		claims, scopes, err := i.apiKeyClaims(ctx, key)
+		if err != nil {
+			return ctx, err
+		}
+		if !apiKeyAllows(scopes, procedure) {
+			return ctx, connect.NewError(connect.CodePermissionDenied, errors.New("api key scope does not permit this procedure"))
+		}
+		return WithClaims(ctx, claims), nil
+	}
+
 	// Extract, verify, and validate the token.
 	claims, err := i.verifiedClaims(ctx, headers)
 	if err != nil {
@@ -245,10 +278,32 @@ func (i *AuthInterceptor) verifiedClaims(ctx context.Context, headers interface{
 	return claims, nil
 }
 
-// bestEffortClaims tries to extract and verify claims from the Authorization
-// header. On any failure (missing header, bad token, expired, revoked) it
+// apiKeyClaims resolves an API key to claims and its scopes.
+func (i *AuthInterceptor) apiKeyClaims(ctx context.Context, key string) (*Claims, []string, error) {
+	if i.apiKeys == nil {
+		return nil, nil, connect.NewError(connect.CodeUnauthenticated, errors.New("api keys are not enabled"))
+	}
+	p, err := i.apiKeys.ValidateAPIKey(ctx, HashAPIKey(key))
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, fmt.Errorf("validate api key: %w", err))
+	}
+	if p == nil {
+		return nil, nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid or expired api key"))
+	}
+	return apiKeyClaims(p), p.Scopes, nil
+}
+
+// bestEffortClaims tries to extract and verify claims from the API key or
+// Authorization header. On any failure (missing header, bad token, expired, revoked) it
 // returns the original context unchanged — the caller proceeds as anonymous.
 func (i *AuthInterceptor) bestEffortClaims(ctx context.Context, headers interface{ Get(string) string }) context.Context {
+	if key := headers.Get(APIKeyHeader); key != "" {
+		claims, _, err := i.apiKeyClaims(ctx, key)
+		if err != nil {
+			return ctx
+		}
+		return WithClaims(ctx, claims)
+	}
 	claims, err := i.verifiedClaims(ctx, headers)
 	if err != nil {
 		return ctx
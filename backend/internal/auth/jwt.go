@@ -15,6 +15,9 @@ import (
 type Claims struct {
 	Role   string `json:"role"`
 	UserID string `json:"uid,omitempty"`
+	// APIKeyID is set instead of UserID when the request was authenticated
+	// with an API key. It is never part of a signed token.
+	APIKeyID string `json:"-"`
 	jwt.RegisteredClaims
 }
 
@@ -0,0 +1,383 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// OIDCStateCookie binds an SSO login to the browser that started it. It
+// holds a signed state/nonce pair and is checked when the login completes.
+const OIDCStateCookie = "gastrolog_oidc"
+
+// OIDCStateTTL is how long a user has to finish logging in at the IdP.
+const OIDCStateTTL = 10 * time.Minute
+
+// OIDCProviderConfig identifies an OpenID Connect provider and this
+// server's client registration with it.
+type OIDCProviderConfig struct {
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string // requested in addition to "openid"
+}
+
+// OIDCClient runs the authorization-code flow against one provider.
+// The discovery document and signing keys are fetched on first use and
+// cached; unknown key IDs trigger a JWKS refresh to follow key rotation.
+type OIDCClient struct {
+	cfg  OIDCProviderConfig
+	http *http.Client
+
+	mu        sync.Mutex
+	discovery *oidcDiscovery
+	keys      map[string]any // kid → *rsa.PublicKey or *ecdsa.PublicKey
+}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// NewOIDCClient creates a client for cfg. httpClient may be nil.
+func NewOIDCClient(cfg OIDCProviderConfig, httpClient *http.Client) *OIDCClient {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &OIDCClient{cfg: cfg, http: httpClient}
+}
+
+// AuthCodeURL returns the provider URL the browser is sent to.
+func (c *OIDCClient) AuthCodeURL(ctx context.Context, state, nonce string) (string, error) {
+	d, err := c.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(d.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("oidc: invalid authorization endpoint: %w", err)
+	}
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", c.cfg.ClientID)
+	q.Set("redirect_uri", c.cfg.RedirectURL)
+	q.Set("scope", strings.Join(append([]string{"openid"}, c.cfg.Scopes...), " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// Exchange redeems an authorization code at the token endpoint and returns
+// the verified ID token claims. nonce must match the one sent in AuthCodeURL.
+func (c *OIDCClient) Exchange(ctx context.Context, code, nonce string) (jwt.MapClaims, error) {
+	d, err := c.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {c.cfg.RedirectURL},
+		"client_id":    {c.cfg.ClientID},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("oidc: token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if c.cfg.ClientSecret != "" {
+		// client_secret_basic: RFC 6749 §2.3.1 requires form-encoding both parts.
+		req.SetBasicAuth(url.QueryEscape(c.cfg.ClientID), url.QueryEscape(c.cfg.ClientSecret))
+	}
+
+	var tok struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	status, err := c.doJSON(req, &tok)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: token exchange: %w", err)
+	}
+	if tok.Error != "" {
+		return nil, fmt.Errorf("oidc: token exchange: %s: %s", tok.Error, tok.ErrorDescription)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("oidc: token exchange: status %d", status)
+	}
+	if tok.IDToken == "" {
+		return nil, errors.New("oidc: token response has no id_token")
+	}
+	return c.verifyIDToken(ctx, d, tok.IDToken, nonce)
+}
+
+func (c *OIDCClient) verifyIDToken(ctx context.Context, d *oidcDiscovery, raw, nonce string) (jwt.MapClaims, error) {
+	parser := jwt.NewParser(
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(d.Issuer),
+		jwt.WithAudience(c.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	claims := jwt.MapClaims{}
+	_, err := parser.ParseWithClaims(raw, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return c.key(ctx, d, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("oidc: verify id_token: %w", err)
+	}
+	if got, _ := claims["nonce"].(string); got == "" || !hmac.Equal([]byte(got), []byte(nonce)) {
+		return nil, errors.New("oidc: id_token nonce mismatch")
+	}
+	return claims, nil
+}
+
+// discover fetches and caches the provider's discovery document.
+func (c *OIDCClient) discover(ctx context.Context) (*oidcDiscovery, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.discovery != nil {
+		return c.discovery, nil
+	}
+
+	issuer := strings.TrimSuffix(c.cfg.IssuerURL, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: discovery request: %w", err)
+	}
+	var d oidcDiscovery
+	status, err := c.doJSON(req, &d)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: discovery: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("oidc: discovery: status %d", status)
+	}
+	if strings.TrimSuffix(d.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("oidc: discovery issuer %q does not match %q", d.Issuer, c.cfg.IssuerURL)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JWKSURI == "" {
+		return nil, errors.New("oidc: discovery document is missing endpoints")
+	}
+	c.discovery = &d
+	return c.discovery, nil
+}
+
+// key returns the verification key for kid, refreshing the JWKS once when
+// kid is unknown. An empty kid matches when the set holds a single key.
+func (c *OIDCClient) key(ctx context.Context, d *oidcDiscovery, kid string) (any, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if k := pickKey(c.keys, kid); k != nil {
+		return k, nil
+	}
+	keys, err := c.fetchJWKS(ctx, d.JWKSURI)
+	if err != nil {
+		return nil, err
+	}
+	c.keys = keys
+	if k := pickKey(keys, kid); k != nil {
+		return k, nil
+	}
+	return nil, fmt.Errorf("no signing key %q in JWKS", kid)
+}
+
+func pickKey(keys map[string]any, kid string) any {
+	if kid == "" && len(keys) == 1 {
+		for _, k := range keys {
+			return k
+		}
+	}
+	return keys[kid]
+}
+
+func (c *OIDCClient) fetchJWKS(ctx context.Context, uri string) (map[string]any, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("jwks request: %w", err)
+	}
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	status, err := c.doJSON(req, &set)
+	if err != nil {
+		return nil, fmt.Errorf("fetch jwks: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("fetch jwks: status %d", status)
+	}
+	keys := make(map[string]any, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		k, err := jwk.publicKey()
+		if err != nil {
+			continue // skip key types we cannot use
+		}
+		keys[jwk.Kid] = k
+	}
+	return keys, nil
+}
+
+// doJSON performs req and decodes a JSON body into v, returning the status.
+func (c *OIDCClient) doJSON(req *http.Request, v any) (int, error) {
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if err := json.Unmarshal(body, v); err != nil && resp.StatusCode == http.StatusOK {
+		return resp.StatusCode, fmt.Errorf("decode response: %w", err)
+	}
+	return resp.StatusCode, nil
+}
+
+// jsonWebKey is the subset of RFC 7517 needed for RSA and EC signing keys.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		exp := new(big.Int).SetBytes(e)
+		if !exp.IsInt64() || exp.Int64() > 1<<31-1 {
+			return nil, errors.New("rsa exponent too large")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exp.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// oidcStateClaims is the payload of the OIDCStateCookie.
+type oidcStateClaims struct {
+	State string `json:"state"`
+	Nonce string `json:"nonce"`
+	jwt.RegisteredClaims
+}
+
+// oidcStateKey derives the cookie signing key from the JWT secret, so a
+// state cookie can never verify as an access token or vice versa.
+func oidcStateKey(secret []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("gastrolog oidc state"))
+	return mac.Sum(nil)
+}
+
+// IssueOIDCState creates a random state and nonce for a new SSO login and
+// the signed cookie value that carries them back to VerifyOIDCState.
+func (ts *TokenService) IssueOIDCState() (state, nonce, cookie string, err error) {
+	state, err = randomToken()
+	if err != nil {
+		return "", "", "", err
+	}
+	nonce, err = randomToken()
+	if err != nil {
+		return "", "", "", err
+	}
+	now := time.Now().UTC()
+	claims := oidcStateClaims{
+		State: state,
+		Nonce: nonce,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(OIDCStateTTL)),
+		},
+	}
+	cookie, err = jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(oidcStateKey(ts.state.Load().secret))
+	if err != nil {
+		return "", "", "", fmt.Errorf("sign oidc state: %w", err)
+	}
+	return state, nonce, cookie, nil
+}
+
+// VerifyOIDCState checks that cookie was issued by IssueOIDCState for state
+// and has not expired, and returns the nonce to expect in the ID token.
+func (ts *TokenService) VerifyOIDCState(cookie, state string) (string, error) {
+	key := oidcStateKey(ts.state.Load().secret)
+	claims := &oidcStateClaims{}
+	_, err := jwt.ParseWithClaims(cookie, claims, func(t *jwt.Token) (any, error) {
+		return key, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return "", fmt.Errorf("invalid login state: %w", err)
+	}
+	if state == "" || !hmac.Equal([]byte(claims.State), []byte(state)) {
+		return "", errors.New("login state mismatch")
+	}
+	return claims.Nonce, nil
+}
+
+func randomToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate random token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
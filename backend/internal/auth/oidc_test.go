@@ -0,0 +1,136 @@
+package auth_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"gastrolog/internal/auth"
+	"gastrolog/internal/auth/oidctest"
+)
+
+const testRedirect = "https://logs.example.com/auth/callback"
+
+func newOIDCClient(idp *oidctest.Provider, secret string) *auth.OIDCClient {
+	return auth.NewOIDCClient(auth.OIDCProviderConfig{
+		IssuerURL:    idp.URL,
+		ClientID:     idp.ClientID,
+		ClientSecret: secret,
+		RedirectURL:  testRedirect,
+		Scopes:       []string{"profile", "groups"},
+	}, nil)
+}
+
+func TestOIDC_AuthorizationCodeFlow(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	idp := oidctest.New(t, "gastrolog", "s3cret")
+	client := newOIDCClient(idp, "s3cret")
+
+	authURL, err := client.AuthCodeURL(ctx, "state-1", "nonce-1")
+	if err != nil {
+		t.Fatalf("AuthCodeURL: %v", err)
+	}
+	if !strings.Contains(authURL, "scope=openid+profile+groups") {
+		t.Errorf("scope missing from %s", authURL)
+	}
+	code, state, err := idp.Authorize(authURL, map[string]any{
+		"sub":                "u-123",
+		"preferred_username": "alice",
+		"groups":             []string{"ops"},
+	})
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+	if state != "state-1" {
+		t.Errorf("state = %q, want state-1", state)
+	}
+
+	claims, err := client.Exchange(ctx, code, "nonce-1")
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	if claims["sub"] != "u-123" || claims["preferred_username"] != "alice" {
+		t.Errorf("unexpected claims: %v", claims)
+	}
+
+	// Codes are single-use.
+	if _, err := client.Exchange(ctx, code, "nonce-1"); err == nil {
+		t.Error("expected error redeeming a code twice")
+	}
+}
+
+func TestOIDC_NonceMismatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	idp := oidctest.New(t, "gastrolog", "s3cret")
+	client := newOIDCClient(idp, "s3cret")
+
+	authURL, err := client.AuthCodeURL(ctx, "state", "nonce-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, _, err := idp.Authorize(authURL, map[string]any{"sub": "u-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Exchange(ctx, code, "nonce-b"); err == nil || !strings.Contains(err.Error(), "nonce") {
+		t.Errorf("expected nonce error, got %v", err)
+	}
+}
+
+func TestOIDC_WrongClientSecret(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	idp := oidctest.New(t, "gastrolog", "s3cret")
+	client := newOIDCClient(idp, "wrong")
+
+	authURL, err := client.AuthCodeURL(ctx, "state", "nonce")
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, _, err := idp.Authorize(authURL, map[string]any{"sub": "u-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Exchange(ctx, code, "nonce"); err == nil || !strings.Contains(err.Error(), "invalid_client") {
+		t.Errorf("expected invalid_client, got %v", err)
+	}
+}
+
+func TestOIDCState(t *testing.T) {
+	t.Parallel()
+	tokens := auth.NewTokenService([]byte("test-secret-key-32-bytes-long!!"), time.Hour)
+
+	state, nonce, cookie, err := tokens.IssueOIDCState()
+	if err != nil {
+		t.Fatalf("IssueOIDCState: %v", err)
+	}
+	got, err := tokens.VerifyOIDCState(cookie, state)
+	if err != nil {
+		t.Fatalf("VerifyOIDCState: %v", err)
+	}
+	if got != nonce {
+		t.Errorf("nonce = %q, want %q", got, nonce)
+	}
+
+	if _, err := tokens.VerifyOIDCState(cookie, "other-state"); err == nil {
+		t.Error("expected error for mismatched state")
+	}
+	if _, err := tokens.VerifyOIDCState(cookie+"x", state); err == nil {
+		t.Error("expected error for tampered cookie")
+	}
+
+	// Access tokens and state cookies are signed with different keys.
+	access, _, err := tokens.Issue("uid", "alice", "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tokens.VerifyOIDCState(access, ""); err == nil {
+		t.Error("access token must not verify as a state cookie")
+	}
+	if _, err := tokens.Verify(cookie); err == nil {
+		t.Error("state cookie must not verify as an access token")
+	}
+}
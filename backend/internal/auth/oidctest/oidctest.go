@@ -0,0 +1,195 @@
+// Package oidctest provides a stub OpenID Connect identity provider for
+// exercising the SSO login flow without a real IdP. It serves discovery,
+// JWKS and token endpoints and signs ID tokens with a throwaway RSA key.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const keyID = "oidctest"
+
+// Provider is a running stub IdP. URL is its issuer URL.
+type Provider struct {
+	URL          string
+	ClientID     string
+	ClientSecret string
+
+	key *rsa.PrivateKey
+
+	mu    sync.Mutex
+	codes map[string]grant // authorization code → pending grant
+}
+
+type grant struct {
+	nonce       string
+	redirectURI string
+	claims      map[string]any
+}
+
+// New starts a provider that accepts the given client credentials.
+// The server is closed when t finishes.
+func New(t testing.TB, clientID, clientSecret string) *Provider {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("oidctest: generate key: %v", err)
+	}
+	p := &Provider{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		key:          key,
+		codes:        make(map[string]grant),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", p.handleDiscovery)
+	mux.HandleFunc("GET /jwks", p.handleJWKS)
+	mux.HandleFunc("POST /token", p.handleToken)
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	p.URL = srv.URL
+	return p
+}
+
+// Authorize plays the user's part at the IdP: it checks authURL (as
+// returned by OIDCClient.AuthCodeURL) and returns the code and state the
+// IdP would append to the redirect. claims are added to the ID token
+// issued for the code, e.g. sub, preferred_username and groups.
+func (p *Provider) Authorize(authURL string, claims map[string]any) (code, state string, err error) {
+	u, err := url.Parse(authURL)
+	if err != nil {
+		return "", "", err
+	}
+	if !strings.HasPrefix(authURL, p.URL+"/authorize") {
+		return "", "", fmt.Errorf("authorization URL %q is not this provider's", authURL)
+	}
+	q := u.Query()
+	if q.Get("response_type") != "code" {
+		return "", "", fmt.Errorf("response_type = %q, want code", q.Get("response_type"))
+	}
+	if q.Get("client_id") != p.ClientID {
+		return "", "", fmt.Errorf("client_id = %q, want %q", q.Get("client_id"), p.ClientID)
+	}
+	if !strings.Contains(" "+q.Get("scope")+" ", " openid ") {
+		return "", "", fmt.Errorf("scope %q lacks openid", q.Get("scope"))
+	}
+
+	code = rand.Text()
+	p.mu.Lock()
+	p.codes[code] = grant{nonce: q.Get("nonce"), redirectURI: q.Get("redirect_uri"), claims: claims}
+	p.mu.Unlock()
+	return code, q.Get("state"), nil
+}
+
+// IDToken signs an ID token for this provider's client with the given
+// claims on top of iss, aud, iat and exp.
+func (p *Provider) IDToken(claims map[string]any) (string, error) {
+	now := time.Now()
+	mc := jwt.MapClaims{
+		"iss": p.URL,
+		"aud": p.ClientID,
+		"iat": now.Unix(),
+		"exp": now.Add(5 * time.Minute).Unix(),
+	}
+	for k, v := range claims {
+		mc[k] = v
+	}
+	tok := jwt.NewWithClaims(jwt.SigningMethodRS256, mc)
+	tok.Header["kid"] = keyID
+	return tok.SignedString(p.key)
+}
+
+func (p *Provider) handleDiscovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                 p.URL,
+		"authorization_endpoint": p.URL + "/authorize",
+		"token_endpoint":         p.URL + "/token",
+		"jwks_uri":               p.URL + "/jwks",
+	})
+}
+
+func (p *Provider) handleJWKS(w http.ResponseWriter, r *http.Request) {
+	pub := p.key.PublicKey
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": keyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
+
+func (p *Provider) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := p.checkClient(r); err != nil {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client", "error_description": err.Error()})
+		return
+	}
+	if r.PostFormValue("grant_type") != "authorization_code" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+
+	p.mu.Lock()
+	g, ok := p.codes[r.PostFormValue("code")]
+	delete(p.codes, r.PostFormValue("code")) // codes are single-use
+	p.mu.Unlock()
+	if !ok || g.redirectURI != r.PostFormValue("redirect_uri") {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	claims := map[string]any{"nonce": g.nonce}
+	for k, v := range g.claims {
+		claims[k] = v
+	}
+	idToken, err := p.IDToken(claims)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error", "error_description": err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": rand.Text(),
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     idToken,
+	})
+}
+
+func (p *Provider) checkClient(r *http.Request) error {
+	id, secret, ok := r.BasicAuth()
+	if ok {
+		id, _ = url.QueryUnescape(id)
+		secret, _ = url.QueryUnescape(secret)
+	} else {
+		id, secret = r.PostFormValue("client_id"), r.PostFormValue("client_secret")
+	}
+	if id != p.ClientID || secret != p.ClientSecret {
+		return errors.New("unknown client or wrong secret")
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
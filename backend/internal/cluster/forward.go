@@ -11,6 +11,7 @@ import (
 
 	"gastrolog/internal/chunk"
 	"gastrolog/internal/convert"
+	"gastrolog/internal/query"
 
 	gastrologv1 "gastrolog/api/gen/gastrolog/v1"
 
@@ -35,7 +36,9 @@ type RecordTierAppender func(ctx context.Context, vaultID, tierID glid.GLID, lea
 // Used by the ForwardSearch handler to serve remote search requests.
 // The resumeToken parameter allows resuming a paginated search. The returned
 // getToken function returns a resume token for the next page (nil if exhausted).
-type SearchExecutor func(ctx context.Context, vaultID glid.GLID, queryExpr string, resumeToken []byte) (iter.Seq2[chunk.Record, error], func() []byte, *gastrologv1.TableResult, []*gastrologv1.HistogramBucket, error)
+// When partialStats is set, a stats pipeline returns its mergeable
+// accumulator state (see query.RunPipelinePartial) instead of final values.
+type SearchExecutor func(ctx context.Context, vaultID glid.GLID, queryExpr string, partialStats bool, resumeToken []byte) (iter.Seq2[chunk.Record, error], func() []byte, *gastrologv1.TableResult, []*gastrologv1.HistogramBucket, error)
 
 // ContextExecutor fetches records surrounding a specific position in a local vault.
 // Used by the ForwardGetContext handler to serve remote context requests.
@@ -398,9 +401,16 @@ func forwardFollowStreamHandler(srv any, stream grpc.ServerStream) error {
 		return status.Errorf(codes.InvalidArgument, "receive request: %v", err)
 	}
 
-	vaultIDs := glid.SliceFromProto(req.GetVaultIds())
+	// The follow engine is keyed by vault ID, so the access set also goes
+	// on the context: it stops vault_id= predicates in the query from
+	// reaching vaults outside the requested, permitted set.
+	access := vaultAccessFromProto(req.GetAccess())
+	vaultIDs := access.Restrict(glid.SliceFromProto(req.GetVaultIds()))
+	if len(vaultIDs) == 0 {
+		return nil
+	}
 
-	records, err := s.followExecutor(stream.Context(), vaultIDs, req.GetQuery())
+	records, err := s.followExecutor(query.WithVaultAccess(stream.Context(), access), vaultIDs, req.GetQuery())
 	if err != nil {
 		return status.Errorf(codes.Internal, "follow: %v", err)
 	}
@@ -438,8 +448,11 @@ func forwardSearchStreamHandler(srv any, stream grpc.ServerStream) error {
 	if err != nil {
 		return err
 	}
+	if !vaultAccessFromProto(req.GetAccess()).Allows(vaultID) {
+		return status.Errorf(codes.PermissionDenied, "vault %s: access denied", vaultID)
+	}
 
-	searchIter, getToken, tableResult, histogram, err := s.searchExecutor(stream.Context(), vaultID, req.GetQuery(), req.GetResumeToken())
+	searchIter, getToken, tableResult, histogram, err := s.searchExecutor(stream.Context(), vaultID, req.GetQuery(), req.GetPartialStats(), req.GetResumeToken())
 	if err != nil {
 		return status.Errorf(codes.Internal, "search: %v", err)
 	}
@@ -520,6 +533,9 @@ func (s *Server) forwardGetContext(ctx context.Context, req *gastrologv1.Forward
 	if err != nil {
 		return nil, err
 	}
+	if !vaultAccessFromProto(req.GetAccess()).Allows(vaultID) {
+		return nil, status.Errorf(codes.PermissionDenied, "vault %s: access denied", vaultID)
+	}
 	chunkID, err := parseChunkID(req.GetChunkId())
 	if err != nil {
 		return nil, err
@@ -719,6 +735,7 @@ func (s *Server) forwardExplain(ctx context.Context, req *gastrologv1.ForwardExp
 		vid := glid.FromBytes(vs)
 		vaultIDs = append(vaultIDs, vid)
 	}
+	vaultIDs = vaultAccessFromProto(req.GetAccess()).Restrict(vaultIDs)
 	chunks, totalChunks, err := s.explainExecutor(ctx, vaultIDs, req.GetQuery())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "explain: %v", err)
@@ -171,10 +171,12 @@ func (p *PeerState) AggregateRouteStats() (ingested, dropped, routed int64, filt
 					RouteId:          rs.RouteId,
 					RecordsMatched:   rs.RecordsMatched,
 					RecordsForwarded: rs.RecordsForwarded,
+					RecordsDropped:   rs.RecordsDropped,
 				}
 			} else {
 				existing.RecordsMatched += rs.RecordsMatched
 				existing.RecordsForwarded += rs.RecordsForwarded
+				existing.RecordsDropped += rs.RecordsDropped
 			}
 		}
 	}
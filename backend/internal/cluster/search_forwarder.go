@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"gastrolog/internal/glid"
 	"io"
 
 	gastrologv1 "gastrolog/api/gen/gastrolog/v1"
+	"gastrolog/internal/query"
 
 	"google.golang.org/grpc"
 )
@@ -23,10 +25,32 @@ func NewSearchForwarder(peers *PeerConns) *SearchForwarder {
 	return &SearchForwarder{peers: peers}
 }
 
+// vaultAccessToProto captures the caller's vault access from ctx so the
+// remote node enforces the same restriction. Returns nil when ctx is
+// unrestricted, which the remote side treats as full access.
+func vaultAccessToProto(ctx context.Context) *gastrologv1.VaultAccess {
+	a := query.VaultAccessFromContext(ctx)
+	if a == nil {
+		return nil
+	}
+	return &gastrologv1.VaultAccess{VaultIds: glid.SliceToProto(a.IDs())}
+}
+
+// vaultAccessFromProto is the receiving side of vaultAccessToProto.
+func vaultAccessFromProto(p *gastrologv1.VaultAccess) *query.VaultAccess {
+	if p == nil {
+		return nil
+	}
+	return query.NewVaultAccess(glid.SliceFromProto(p.GetVaultIds()))
+}
+
 // Search sends a ForwardSearch RPC to the given node and collects the full
 // streamed response into a single ForwardSearchResponse. Used by
 // collectRemotePipeline which needs the complete TableResult.
 func (sf *SearchForwarder) Search(ctx context.Context, nodeID string, req *gastrologv1.ForwardSearchRequest) (*gastrologv1.ForwardSearchResponse, error) {
+	if req.Access == nil {
+		req.Access = vaultAccessToProto(ctx)
+	}
 	conn, err := sf.peers.Conn(nodeID)
 	if err != nil {
 		return nil, fmt.Errorf("dial node %s: %w", nodeID, err)
@@ -87,6 +111,9 @@ func (sf *SearchForwarder) SearchStream(ctx context.Context, nodeID string, req
 ) {
 	var resumeToken []byte
 	getResumeToken = func() []byte { return resumeToken }
+	if req.Access == nil {
+		req.Access = vaultAccessToProto(ctx)
+	}
 	recCh := make(chan []*gastrologv1.ExportRecord, 16)
 	eCh := make(chan error, 1)
 
@@ -186,6 +213,9 @@ func (sf *SearchForwarder) SearchStream(ctx context.Context, nodeID string, req
 
 // GetContext sends a ForwardGetContext RPC to the given node.
 func (sf *SearchForwarder) GetContext(ctx context.Context, nodeID string, req *gastrologv1.ForwardGetContextRequest) (*gastrologv1.ForwardGetContextResponse, error) {
+	if req.Access == nil {
+		req.Access = vaultAccessToProto(ctx)
+	}
 	conn, err := sf.peers.Conn(nodeID)
 	if err != nil {
 		return nil, fmt.Errorf("dial node %s: %w", nodeID, err)
@@ -298,6 +328,9 @@ func (sf *SearchForwarder) ReindexVault(ctx context.Context, nodeID string, req
 
 // Explain sends a ForwardExplain RPC to the given node and returns the response.
 func (sf *SearchForwarder) Explain(ctx context.Context, nodeID string, req *gastrologv1.ForwardExplainRequest) (*gastrologv1.ForwardExplainResponse, error) {
+	if req.Access == nil {
+		req.Access = vaultAccessToProto(ctx)
+	}
 	conn, err := sf.peers.Conn(nodeID)
 	if err != nil {
 		return nil, fmt.Errorf("dial node %s: %w", nodeID, err)
@@ -316,6 +349,9 @@ func (sf *SearchForwarder) Explain(ctx context.Context, nodeID string, req *gast
 func (sf *SearchForwarder) Follow(ctx context.Context, nodeID string, req *gastrologv1.ForwardFollowRequest) (<-chan *gastrologv1.ExportRecord, <-chan error) {
 	recCh := make(chan *gastrologv1.ExportRecord, 64)
 	errCh := make(chan error, 1)
+	if req.Access == nil {
+		req.Access = vaultAccessToProto(ctx)
+	}
 
 	go func() {
 		defer close(recCh)
@@ -50,6 +50,7 @@ type StatsPerRouteSnapshot struct {
 	RouteID   string
 	Matched   int64
 	Forwarded int64
+	Dropped   int64
 }
 
 // StatsProvider abstracts the orchestrator for stats collection.
@@ -57,6 +58,7 @@ type StatsPerRouteSnapshot struct {
 type StatsProvider interface {
 	IngestQueueDepth() int
 	IngestQueueCapacity() int
+	IngestDiskQueueStats() (entries, bytes, maxBytes int64)
 	VaultSnapshots() []StatsVaultSnapshot
 	IngesterIDs() []string
 	IngesterStats(id string) (name string, messages, bytes, errors int64, running bool)
@@ -229,6 +231,10 @@ func (c *StatsCollector) collectLocal(now time.Time, stepWindows bool) *gastrolo
 	if c.cfg.Stats != nil {
 		stats.IngestQueueDepth = uint32(c.cfg.Stats.IngestQueueDepth())       //nolint:gosec
 		stats.IngestQueueCapacity = uint32(c.cfg.Stats.IngestQueueCapacity()) //nolint:gosec
+		entries, bytes, maxBytes := c.cfg.Stats.IngestDiskQueueStats()
+		stats.IngestDiskQueueEntries = uint64(entries)   //nolint:gosec // never negative
+		stats.IngestDiskQueueBytes = uint64(bytes)       //nolint:gosec // never negative
+		stats.IngestDiskQueueMaxBytes = uint64(maxBytes) //nolint:gosec // never negative
 
 		// Vault snapshots.
 		for _, v := range c.cfg.Stats.VaultSnapshots() {
@@ -274,6 +280,7 @@ func (c *StatsCollector) collectLocal(now time.Time, stepWindows bool) *gastrolo
 				RouteId:          []byte(ps.RouteID),
 				RecordsMatched:   ps.Matched,
 				RecordsForwarded: ps.Forwarded,
+				RecordsDropped:   ps.Dropped,
 			})
 		}
 	}
@@ -1,12 +1,14 @@
 package convert
 
 // system.go provides canonical converters between config domain types and
-// their protobuf representations for CloudService, NodeStorageConfig, and
-// TierConfig. Both the server RPC handlers and the Raft FSM command
-// package call these functions — there is exactly one source of truth for
+// their protobuf representations for CloudService, NodeStorageConfig,
+// TierConfig, VaultConfig, AlertRuleConfig, RouteStage, and RoleConfig. Both the server RPC handlers
+// and the Raft FSM command package call these functions — there is exactly one source of truth for
 // each field mapping. See gastrolog-2f8et.
 
 import (
+	"maps"
+
 	gastrologv1 "gastrolog/api/gen/gastrolog/v1"
 	"gastrolog/internal/glid"
 	"gastrolog/internal/system"
@@ -368,3 +370,136 @@ func VaultTypeFromProto(t gastrologv1.VaultType) system.TierType {
 		return ""
 	}
 }
+
+// ---------------------------------------------------------------------------
+// AlertRuleConfig
+// ---------------------------------------------------------------------------
+
+// AlertRuleConfigToProto converts a system.AlertRuleConfig to its proto representation.
+func AlertRuleConfigToProto(r system.AlertRuleConfig) *gastrologv1.AlertRuleConfig {
+	sinks := make([]*gastrologv1.AlertSink, len(r.Sinks))
+	for i, s := range r.Sinks {
+		sinks[i] = &gastrologv1.AlertSink{
+			Type:   string(s.Type),
+			Params: maps.Clone(s.Params),
+		}
+	}
+	return &gastrologv1.AlertRuleConfig{
+		Id:       r.ID.ToProto(),
+		Name:     r.Name,
+		Query:    r.Query,
+		Schedule: r.Schedule,
+		Condition: &gastrologv1.AlertCondition{
+			Column:    r.Condition.Column,
+			Op:        string(r.Condition.Op),
+			Threshold: r.Condition.Threshold,
+		},
+		Severity: r.Severity,
+		Sinks:    sinks,
+		Enabled:  r.Enabled,
+	}
+}
+
+// AlertRuleConfigFromProto converts a proto AlertRuleConfig to system.AlertRuleConfig.
+func AlertRuleConfigFromProto(p *gastrologv1.AlertRuleConfig) system.AlertRuleConfig {
+	if p == nil {
+		return system.AlertRuleConfig{}
+	}
+	cfg := system.AlertRuleConfig{
+		ID:       glid.FromBytes(p.GetId()),
+		Name:     p.GetName(),
+		Query:    p.GetQuery(),
+		Schedule: p.GetSchedule(),
+		Condition: system.AlertCondition{
+			Column:    p.GetCondition().GetColumn(),
+			Op:        system.AlertConditionOp(p.GetCondition().GetOp()),
+			Threshold: p.GetCondition().GetThreshold(),
+		},
+		Severity: p.GetSeverity(),
+		Enabled:  p.GetEnabled(),
+	}
+	for _, s := range p.GetSinks() {
+		cfg.Sinks = append(cfg.Sinks, system.AlertSink{
+			Type:   system.AlertSinkType(s.GetType()),
+			Params: maps.Clone(s.GetParams()),
+		})
+	}
+	return cfg
+}
+
+// ---------------------------------------------------------------------------
+// RouteStage
+// ---------------------------------------------------------------------------
+
+// RouteStagesToProto converts route pipeline stages to their proto representation.
+func RouteStagesToProto(stages []system.RouteStage) []*gastrologv1.RouteStage {
+	if len(stages) == 0 {
+		return nil
+	}
+	out := make([]*gastrologv1.RouteStage, len(stages))
+	for i, s := range stages {
+		out[i] = &gastrologv1.RouteStage{
+			Type:   string(s.Type),
+			Params: maps.Clone(s.Params),
+		}
+	}
+	return out
+}
+
+// RouteStagesFromProto converts proto route pipeline stages to system.RouteStage.
+func RouteStagesFromProto(stages []*gastrologv1.RouteStage) []system.RouteStage {
+	if len(stages) == 0 {
+		return nil
+	}
+	out := make([]system.RouteStage, len(stages))
+	for i, s := range stages {
+		out[i] = system.RouteStage{
+			Type:   system.RouteStageType(s.GetType()),
+			Params: maps.Clone(s.GetParams()),
+		}
+	}
+	return out
+}
+
+// ---------------------------------------------------------------------------
+// RoleConfig
+// ---------------------------------------------------------------------------
+
+// RoleConfigToProto converts a system.RoleConfig to its proto representation.
+func RoleConfigToProto(r system.RoleConfig) *gastrologv1.RoleConfig {
+	grants := make([]*gastrologv1.VaultGrant, len(r.Grants))
+	for i, g := range r.Grants {
+		actions := make([]string, len(g.Actions))
+		for j, a := range g.Actions {
+			actions[j] = string(a)
+		}
+		grants[i] = &gastrologv1.VaultGrant{
+			VaultId: g.VaultID.ToProto(),
+			Actions: actions,
+		}
+	}
+	return &gastrologv1.RoleConfig{
+		Id:     r.ID.ToProto(),
+		Name:   r.Name,
+		Grants: grants,
+	}
+}
+
+// RoleConfigFromProto converts a proto RoleConfig to system.RoleConfig.
+func RoleConfigFromProto(p *gastrologv1.RoleConfig) system.RoleConfig {
+	if p == nil {
+		return system.RoleConfig{}
+	}
+	cfg := system.RoleConfig{
+		ID:   glid.FromBytes(p.GetId()),
+		Name: p.GetName(),
+	}
+	for _, g := range p.GetGrants() {
+		grant := system.VaultGrant{VaultID: glid.FromBytes(g.GetVaultId())}
+		for _, a := range g.GetActions() {
+			grant.Actions = append(grant.Actions, system.VaultAction(a))
+		}
+		cfg.Grants = append(cfg.Grants, grant)
+	}
+	return cfg
+}
@@ -94,6 +94,11 @@ func (d Dir) ClusterTLSPath() string {
 	return filepath.Join(d.root, "cluster-tls.json")
 }
 
+// IngestQueueDir returns the directory for the disk-backed ingest queue.
+func (d Dir) IngestQueueDir() string {
+	return filepath.Join(d.root, "ingest-queue")
+}
+
 // ManagedFilesDir returns the directory for uploaded managed files.
 func (d Dir) ManagedFilesDir() string {
 	return filepath.Join(d.root, "managed-files")
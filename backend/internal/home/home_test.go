@@ -40,6 +40,14 @@ func TestVaultDir(t *testing.T) {
 	}
 }
 
+func TestIngestQueueDir(t *testing.T) {
+	t.Parallel()
+	d := New("/data")
+	if got := d.IngestQueueDir(); got != "/data/ingest-queue" {
+		t.Errorf("got %s", got)
+	}
+}
+
 func TestEnsureExists(t *testing.T) {
 	t.Parallel()
 	root := filepath.Join(t.TempDir(), "nested", "gastrolog")
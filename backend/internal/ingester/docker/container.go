@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"gastrolog/internal/chanwatch"
+	"gastrolog/internal/ingester/multiline"
 	"gastrolog/internal/orchestrator"
 )
 
@@ -26,13 +27,15 @@ func containerAttrs(info containerInfo) map[string]string {
 // to the output channel. It blocks until the context is cancelled or the stream
 // ends. It handles reconnection with backoff on stream errors.
 //
-// gate may be nil to disable backpressure throttling.
+// gate may be nil to disable backpressure throttling, and ml nil to emit
+// one message per log line.
 func streamContainer(
 	ctx context.Context,
 	client dockerClient,
 	info containerInfo,
 	since time.Time,
 	stdout, stderr bool,
+	ml *multiline.Config,
 	ingesterID string,
 	logger *slog.Logger,
 	out chan<- orchestrator.IngestMessage,
@@ -46,7 +49,7 @@ func streamContainer(
 	maxBackoff := 30 * time.Second
 
 	for {
-		err := streamOnce(ctx, client, info, since, stdout, stderr, ingesterID, logger, out, onTimestamp, &since, gate)
+		err := streamOnce(ctx, client, info, since, stdout, stderr, ml, ingesterID, logger, out, onTimestamp, &since, gate)
 		if ctx.Err() != nil {
 			logger.Info("container log stream stopped")
 			return
@@ -66,12 +69,14 @@ func streamContainer(
 }
 
 // streamOnce opens a single log stream and reads until EOF or error.
+// Lines are assembled into multiline events per stream (stdout, stderr).
 func streamOnce(
 	ctx context.Context,
 	client dockerClient,
 	info containerInfo,
 	since time.Time,
 	stdout, stderr bool,
+	ml *multiline.Config,
 	ingesterID string,
 	logger *slog.Logger,
 	out chan<- orchestrator.IngestMessage,
@@ -104,6 +109,53 @@ func streamOnce(
 		"image":          info.Image,
 	}
 
+	// Line metadata is the Docker timestamp: an event takes its first
+	// line's, and the checkpoint advances to its last line's.
+	asms := make(map[string]*multiline.Assembler[time.Time])
+	emit := func(stream string, ev multiline.Event[time.Time]) error {
+		msgAttrs := make(map[string]string, len(attrs)+1)
+		maps.Copy(msgAttrs, attrs)
+		msgAttrs["stream"] = stream
+
+		msg := buildMessage(msgAttrs, ev.Raw, ingesterID, time.Now())
+		if !ev.First.IsZero() {
+			msg.SourceTS = ev.First
+		}
+		if ts := checkpointTS(asms, ev.Last); !ts.IsZero() {
+			*lastTS = ts
+			if onTimestamp != nil {
+				onTimestamp(info.ID, ts)
+			}
+		}
+
+		select {
+		case out <- msg:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	flushAll := func(expiredAt time.Time) error {
+		for stream, asm := range asms {
+			if !expiredAt.IsZero() && !asm.Expired(expiredAt) {
+				continue
+			}
+			if ev, ok := asm.Flush(); ok {
+				if err := emit(stream, ev); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	var flushCh <-chan time.Time
+	if ml != nil {
+		ticker := time.NewTicker(max(ml.FlushTimeout/2, time.Millisecond))
+		defer ticker.Stop()
+		flushCh = ticker.C
+	}
+
 	for {
 		// Backpressure: pause before consuming the next entry from the
 		// local channel. When this blocks, readRaw/readMultiplexed block
@@ -119,9 +171,17 @@ func streamOnce(
 		case <-ctx.Done():
 			return ctx.Err()
 
+		case now := <-flushCh:
+			if err := flushAll(now); err != nil {
+				return err
+			}
+
 		case entry, ok := <-entries:
 			if !ok {
-				// Stream ended, check for error.
+				// Stream ended: emit pending events, then check for error.
+				if err := flushAll(time.Time{}); err != nil {
+					return err
+				}
 				select {
 				case err := <-streamErr:
 					return err
@@ -130,24 +190,30 @@ func streamOnce(
 				}
 			}
 
-			msgAttrs := make(map[string]string, len(attrs)+1)
-			maps.Copy(msgAttrs, attrs)
-			msgAttrs["stream"] = entry.Stream
-
-			msg := buildMessage(msgAttrs, entry.Line, ingesterID, time.Now())
-			if !entry.Timestamp.IsZero() {
-				msg.SourceTS = entry.Timestamp
-				*lastTS = entry.Timestamp
-				if onTimestamp != nil {
-					onTimestamp(info.ID, entry.Timestamp)
+			asm := asms[entry.Stream]
+			if asm == nil {
+				asm = multiline.NewAssembler[time.Time](ml)
+				asms[entry.Stream] = asm
+			}
+			if ev, ok := asm.Add(entry.Line, entry.Timestamp, time.Now()); ok {
+				if err := emit(entry.Stream, ev); err != nil {
+					return err
 				}
 			}
+		}
+	}
+}
 
-			select {
-			case out <- msg:
-			case <-ctx.Done():
-				return ctx.Err()
-			}
+// checkpointTS returns the timestamp to resume from after emitting an event
+// ending at last. Streams resume just after the checkpoint, so while another
+// stream still has an earlier event pending, the checkpoint stays just
+// before that event's first line.
+func checkpointTS(asms map[string]*multiline.Assembler[time.Time], last time.Time) time.Time {
+	ts := last
+	for _, asm := range asms {
+		if first, ok := asm.Pending(); ok && !first.IsZero() && first.Add(-1).Before(ts) {
+			ts = first.Add(-1)
 		}
 	}
+	return ts
 }
@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"gastrolog/internal/glid"
 	"log/slog"
+	"maps"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"gastrolog/internal/ingester/multiline"
 	"gastrolog/internal/logging"
 	"gastrolog/internal/orchestrator"
 	"gastrolog/internal/querylang"
@@ -20,12 +22,14 @@ import (
 // The _host_examples key carries comma-separated clickable socket paths
 // resolved at runtime (not a real param — consumed by the UI only).
 func ParamDefaults() map[string]string {
-	return map[string]string{
+	defaults := map[string]string{
 		"poll_interval":  "30s",
 		"stdout":         "true",
 		"stderr":         "true",
 		"_host_examples": strings.Join(DockerSocketExamples(), ","),
 	}
+	maps.Copy(defaults, multiline.ParamDefaults())
+	return defaults
 }
 
 // DockerSocketExamples returns well-known Docker socket paths for this host.
@@ -63,6 +67,7 @@ type ingesterConfig struct {
 	Stdout       bool
 	Stderr       bool
 	StateFile    string
+	Multiline    *multiline.Config // nil = one record per line
 	Logger       *slog.Logger
 }
 
@@ -125,6 +130,11 @@ func parseConfig(id string, params map[string]string, cfgStore system.Store, log
 		return ingesterConfig{}, fmt.Errorf("docker ingester %q: at least one of stdout or stderr must be enabled", id)
 	}
 
+	ml, err := multiline.ParseParams(params)
+	if err != nil {
+		return ingesterConfig{}, fmt.Errorf("docker ingester %q: %w", id, err)
+	}
+
 	// State file.
 	var stateFile string
 	if stateDir := params["_state_dir"]; stateDir != "" {
@@ -141,6 +151,7 @@ func parseConfig(id string, params map[string]string, cfgStore system.Store, log
 		Stdout:       stdout,
 		Stderr:       stderr,
 		StateFile:    stateFile,
+		Multiline:    ml,
 		Logger:       logging.Default(logger).With("component", "ingester", "type", "docker", "instance", id),
 	}, nil
 }
@@ -264,6 +275,7 @@ func newIngesterWithClient(cfg ingesterConfig, client dockerClient) *ingester {
 		stdout:       cfg.Stdout,
 		stderr:       cfg.Stderr,
 		stateFile:    cfg.StateFile,
+		multiline:    cfg.Multiline,
 		logger:       cfg.Logger,
 		containers:   make(map[string]*trackedContainer),
 		lastTS:       make(map[string]time.Time),
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"gastrolog/internal/chanwatch"
+	"gastrolog/internal/ingester/multiline"
 	"gastrolog/internal/orchestrator"
 	"gastrolog/internal/querylang"
 )
@@ -28,6 +29,7 @@ type ingester struct {
 	stdout       bool
 	stderr       bool
 	stateFile    string
+	multiline    *multiline.Config
 	logger       *slog.Logger
 
 	mu         sync.Mutex
@@ -208,7 +210,7 @@ func (ing *ingester) startContainer(ctx context.Context, info containerInfo, out
 	logger := ing.logger
 	gate := ing.pressureGate
 	wg.Go(func() {
-		streamContainer(cctx, ing.client, info, since, ing.stdout, ing.stderr, ing.id, logger, out, ing.updateTimestamp, gate)
+		streamContainer(cctx, ing.client, info, since, ing.stdout, ing.stderr, ing.multiline, ing.id, logger, out, ing.updateTimestamp, gate)
 		ing.mu.Lock()
 		delete(ing.containers, info.ID)
 		ing.mu.Unlock()
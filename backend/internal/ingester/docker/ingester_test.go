@@ -7,14 +7,17 @@ import (
 	"fmt"
 	"gastrolog/internal/glid"
 	"io"
+	"maps"
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"gastrolog/internal/ingester/multiline"
 	"gastrolog/internal/logging"
 	"gastrolog/internal/orchestrator"
 	"gastrolog/internal/querylang"
@@ -1161,3 +1164,82 @@ func TestDockerCheckpointOverwritesPreviousState(t *testing.T) {
 		t.Fatal("container-bbb should be in state after loading data2")
 	}
 }
+
+func TestMultilinePerStream(t *testing.T) {
+	t.Parallel()
+	client := newFakeClient()
+	ts := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	container := containerInfo{ID: "0123456789ab0123456789ab", Name: "java-app", Image: "java:21"}
+	client.addContainer(container)
+
+	// stderr output interleaves with the stdout stack trace but must not
+	// break it up.
+	var buf bytes.Buffer
+	buf.Write(makeMultiplexedFrame(streamStdout, ts, "Exception in thread main"))
+	buf.Write(makeMultiplexedFrame(streamStderr, ts.Add(time.Millisecond), "warning"))
+	buf.Write(makeMultiplexedFrame(streamStdout, ts.Add(2*time.Millisecond), "\tat Main.run(Main.java:5)"))
+	buf.Write(makeMultiplexedFrame(streamStdout, ts.Add(3*time.Millisecond), "recovered"))
+	client.setLogStream(container.ID, buf.Bytes(), false)
+
+	ml, err := multiline.ParseParams(map[string]string{"multiline_indent": "true"})
+	if err != nil {
+		t.Fatalf("ParseParams: %v", err)
+	}
+	ing := newIngesterWithClient(ingesterConfig{
+		ID:        "test-docker",
+		Stdout:    true,
+		Stderr:    true,
+		Multiline: ml,
+		Logger:    logging.Discard(),
+	}, client)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	out := make(chan orchestrator.IngestMessage, 10)
+	go ing.Run(ctx, out)
+
+	msgs := collectMessages(ctx, out, 3, 3*time.Second)
+	cancel()
+
+	if len(msgs) < 3 {
+		t.Fatalf("expected 3 messages, got %d", len(msgs))
+	}
+	got := make(map[string]orchestrator.IngestMessage)
+	for _, m := range msgs {
+		got[string(m.Raw)] = m
+	}
+	trace, ok := got["Exception in thread main\n\tat Main.run(Main.java:5)"]
+	if !ok {
+		t.Fatalf("stack trace not assembled, got %q", slices.Collect(maps.Keys(got)))
+	}
+	if trace.Attrs["stream"] != "stdout" || !trace.SourceTS.Equal(ts) {
+		t.Errorf("trace stream = %q, SourceTS = %v; want stdout, %v", trace.Attrs["stream"], trace.SourceTS, ts)
+	}
+	for _, raw := range []string{"warning", "recovered"} {
+		if _, ok := got[raw]; !ok {
+			t.Errorf("missing message %q", raw)
+		}
+	}
+}
+
+func TestMultilineCheckpointStaysBeforePending(t *testing.T) {
+	t.Parallel()
+	ml, _ := multiline.ParseParams(map[string]string{"multiline_indent": "true"})
+	ts := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	asms := map[string]*multiline.Assembler[time.Time]{
+		"stdout": multiline.NewAssembler[time.Time](ml),
+		"stderr": multiline.NewAssembler[time.Time](ml),
+	}
+	asms["stderr"].Add([]byte("pending"), ts, time.Now())
+
+	// An stdout event ending after the pending stderr line must not move
+	// the checkpoint past it, or a restart would lose the stderr event.
+	if got, want := checkpointTS(asms, ts.Add(time.Second)), ts.Add(-1); !got.Equal(want) {
+		t.Errorf("checkpoint = %v, want %v", got, want)
+	}
+	asms["stderr"].Flush()
+	if got, want := checkpointTS(asms, ts.Add(time.Second)), ts.Add(time.Second); !got.Equal(want) {
+		t.Errorf("checkpoint = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,162 @@
+package fluentfwd
+
+import (
+	"bytes"
+	"crypto/tls"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/vmihailenco/msgpack/v5"
+
+	"gastrolog/internal/glid"
+	"gastrolog/internal/ingester/tlstest"
+)
+
+// clientHandshake plays the client side of the shared_key handshake and
+// returns the decoded PONG.
+func clientHandshake(t *testing.T, conn net.Conn, hostname, sharedKey string) []any {
+	t.Helper()
+	_ = conn.SetDeadline(time.Now().Add(2 * time.Second))
+	dec := msgpack.NewDecoder(conn)
+
+	var helo []any
+	if err := dec.Decode(&helo); err != nil {
+		t.Fatalf("decode HELO: %v", err)
+	}
+	if len(helo) != 2 || helo[0] != "HELO" {
+		t.Fatalf("expected HELO, got %v", helo)
+	}
+	opts, _ := helo[1].(map[string]any)
+	nonce, ok := asBytes(opts["nonce"])
+	if !ok || len(nonce) == 0 {
+		t.Fatalf("HELO without nonce: %v", opts)
+	}
+
+	salt := []byte("salty")
+	digest := sharedKeyDigest(salt, hostname, nonce, sharedKey)
+	data, _ := msgpack.Marshal([]any{"PING", hostname, salt, digest, "", ""})
+	if _, err := conn.Write(data); err != nil {
+		t.Fatalf("write PING: %v", err)
+	}
+
+	var pong []any
+	if err := dec.Decode(&pong); err != nil {
+		t.Fatalf("decode PONG: %v", err)
+	}
+	if len(pong) != 5 || pong[0] != "PONG" {
+		t.Fatalf("expected PONG, got %v", pong)
+	}
+	if pong[1] == true {
+		want := sharedKeyDigest(salt, pong[3].(string), nonce, sharedKey)
+		if pong[4] != want {
+			t.Errorf("PONG digest: got %v, want %s", pong[4], want)
+		}
+	}
+	_ = conn.SetDeadline(time.Time{})
+	return pong
+}
+
+func encodeMessage(t *testing.T, tag, message string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	enc := msgpack.NewEncoder(&buf)
+	_ = enc.EncodeArrayLen(3)
+	_ = enc.EncodeString(tag)
+	_ = enc.EncodeInt(1700000000)
+	_ = enc.EncodeMap(map[string]any{"message": message})
+	return buf.Bytes()
+}
+
+func TestFluentFwdSharedKey(t *testing.T) {
+	t.Parallel()
+	addr, out := startIngester(t, Config{ID: "test-fwd", SharedKey: "s3cret", SelfHostname: "collector"}, 10)
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+
+	pong := clientHandshake(t, conn, "client-host", "s3cret")
+	if pong[1] != true {
+		t.Fatalf("expected successful PONG, got %v", pong)
+	}
+	if pong[3] != "collector" {
+		t.Errorf("PONG hostname: got %v, want collector", pong[3])
+	}
+
+	if _, err := conn.Write(encodeMessage(t, "auth.tag", "authenticated")); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if msg := recv(t, out); string(msg.Raw) != "authenticated" {
+		t.Errorf("raw: got %q", msg.Raw)
+	}
+}
+
+func TestFluentFwdSharedKeyMismatch(t *testing.T) {
+	t.Parallel()
+	addr, out := startIngester(t, Config{ID: "test-fwd", SharedKey: "s3cret", SelfHostname: "collector"}, 10)
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+
+	pong := clientHandshake(t, conn, "client-host", "wrong")
+	if pong[1] != false {
+		t.Fatalf("expected failed PONG, got %v", pong)
+	}
+	if pong[2] != "shared_key mismatch" {
+		t.Errorf("PONG reason: got %v", pong[2])
+	}
+
+	// The server closes the connection; nothing sent afterwards is ingested.
+	_, _ = conn.Write(encodeMessage(t, "auth.tag", "rejected"))
+	select {
+	case msg := <-out:
+		t.Fatalf("unexpected message after failed auth: %q", msg.Raw)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestFluentFwdTLS(t *testing.T) {
+	t.Parallel()
+	ca := tlstest.NewCA(t)
+	factory := NewFactory(ca.Manager(t, "fwd"))
+
+	ing, err := factory(glid.New(), map[string]string{"tls": "true", "tls_cert": "fwd"}, nil)
+	if err != nil {
+		t.Fatalf("factory: %v", err)
+	}
+	addr, out := startIngester(t, Config{
+		ID:        "test-fwd",
+		TLSConfig: ing.(*Ingester).tlsConfig,
+		SharedKey: "s3cret",
+	}, 10)
+
+	conn, err := tls.Dial("tcp", addr, &tls.Config{RootCAs: ca.Pool, ServerName: "localhost"})
+	if err != nil {
+		t.Fatalf("tls dial: %v", err)
+	}
+	defer conn.Close()
+
+	if pong := clientHandshake(t, conn, "client-host", "s3cret"); pong[1] != true {
+		t.Fatalf("expected successful PONG, got %v", pong)
+	}
+	if _, err := conn.Write(encodeMessage(t, "tls.tag", "over tls")); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if msg := recv(t, out); string(msg.Raw) != "over tls" {
+		t.Errorf("raw: got %q", msg.Raw)
+	}
+}
+
+func TestFluentFwdFactoryTLSErrors(t *testing.T) {
+	t.Parallel()
+	factory := NewFactory(nil)
+	if _, err := factory(glid.New(), map[string]string{"tls": "true", "tls_cert": "missing"}, nil); err == nil {
+		t.Error("expected error when TLS is enabled without a cert manager")
+	}
+}
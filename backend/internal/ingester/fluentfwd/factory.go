@@ -6,6 +6,8 @@ import (
 	"gastrolog/internal/glid"
 	"log/slog"
 
+	"gastrolog/internal/cert"
+	"gastrolog/internal/ingester/listentls"
 	"gastrolog/internal/orchestrator"
 )
 
@@ -17,7 +19,8 @@ func ParamDefaults() map[string]string {
 }
 
 // NewFactory returns an IngesterFactory for Fluent Forward ingesters.
-func NewFactory() orchestrator.IngesterFactory {
+// The cert manager is used to resolve TLS certificate names.
+func NewFactory(certMgr *cert.Manager) orchestrator.IngesterFactory {
 	return func(id glid.GLID, params map[string]string, logger *slog.Logger) (orchestrator.Ingester, error) {
 		addr := cmp.Or(params["addr"], ":24224")
 
@@ -35,10 +38,18 @@ func NewFactory() orchestrator.IngesterFactory {
 			}
 		}
 
+		tlsCfg, err := listentls.BuildConfig(params, certMgr)
+		if err != nil {
+			return nil, fmt.Errorf("fluentfwd ingester: %w", err)
+		}
+
 		return New(Config{
-			ID:     id.String(),
-			Addr:   addr,
-			Logger: logger,
+			ID:           id.String(),
+			Addr:         addr,
+			TLSConfig:    tlsCfg,
+			SharedKey:    params["shared_key"],
+			SelfHostname: params["self_hostname"],
+			Logger:       logger,
 		}), nil
 	}
 }
@@ -0,0 +1,119 @@
+package fluentfwd
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// handshakeTimeout bounds the HELO/PING/PONG exchange so an idle client
+// cannot hold a connection open without authenticating.
+const handshakeTimeout = 10 * time.Second
+
+// handshake performs the Forward protocol shared_key authentication:
+//
+//	server → ["HELO", {"nonce": nonce, "auth": "", "keepalive": true}]
+//	client → ["PING", hostname, salt, hex(sha512(salt+hostname+nonce+key)), username, password_digest]
+//	server → ["PONG", ok, reason, self_hostname, hex(sha512(salt+self_hostname+nonce+key))]
+//
+// User authentication (the "auth" salt) is not supported; username and
+// password in PING are ignored. Returns an error if the client fails to
+// authenticate, after telling it why in the PONG.
+func (ing *Ingester) handshake(conn net.Conn, dec *msgpack.Decoder) error {
+	_ = conn.SetDeadline(time.Now().Add(handshakeTimeout))
+	defer func() { _ = conn.SetDeadline(time.Time{}) }()
+
+	nonce := make([]byte, 16)
+	_, _ = rand.Read(nonce)
+
+	enc := msgpack.NewEncoder(conn)
+	if err := enc.Encode([]any{"HELO", map[string]any{
+		"nonce":     nonce,
+		"auth":      "",
+		"keepalive": true,
+	}}); err != nil {
+		return fmt.Errorf("send HELO: %w", err)
+	}
+
+	ping, err := decodePing(dec)
+	if err != nil {
+		return err
+	}
+
+	reason := ""
+	switch {
+	case ping.hostname == ing.selfHostname:
+		reason = "same hostname between input and output: invalid configuration"
+	case subtle.ConstantTimeCompare([]byte(ping.digest), []byte(sharedKeyDigest(ping.salt, ping.hostname, nonce, ing.sharedKey))) != 1:
+		reason = "shared_key mismatch"
+	}
+
+	pong := []any{"PONG", reason == "", reason, ing.selfHostname, ""}
+	if reason == "" {
+		pong[4] = sharedKeyDigest(ping.salt, ing.selfHostname, nonce, ing.sharedKey)
+	}
+	if err := enc.Encode(pong); err != nil {
+		return fmt.Errorf("send PONG: %w", err)
+	}
+	if reason != "" {
+		return fmt.Errorf("authentication failed for %q: %s", ping.hostname, reason)
+	}
+	return nil
+}
+
+// ping holds the fields of a PING message used for shared_key auth.
+type ping struct {
+	hostname string
+	salt     []byte
+	digest   string
+}
+
+func decodePing(dec *msgpack.Decoder) (ping, error) {
+	var fields []any
+	if err := dec.Decode(&fields); err != nil {
+		return ping{}, fmt.Errorf("decode PING: %w", err)
+	}
+	if len(fields) < 4 {
+		return ping{}, fmt.Errorf("PING: expected at least 4 elements, got %d", len(fields))
+	}
+	if typ, _ := fields[0].(string); typ != "PING" {
+		return ping{}, fmt.Errorf("expected PING, got %v", fields[0])
+	}
+	hostname, ok1 := asBytes(fields[1])
+	salt, ok2 := asBytes(fields[2])
+	digest, ok3 := asBytes(fields[3])
+	if !ok1 || !ok2 || !ok3 {
+		return ping{}, errors.New("PING: hostname, salt and digest must be strings")
+	}
+	return ping{hostname: string(hostname), salt: salt, digest: string(digest)}, nil
+}
+
+// asBytes accepts a msgpack str or bin value; clients differ in which they
+// use for the salt and digest.
+func asBytes(v any) ([]byte, bool) {
+	switch s := v.(type) {
+	case string:
+		return []byte(s), true
+	case []byte:
+		return s, true
+	default:
+		return nil, false
+	}
+}
+
+// sharedKeyDigest computes hex(sha512(salt + hostname + nonce + sharedKey)).
+func sharedKeyDigest(salt []byte, hostname string, nonce []byte, sharedKey string) string {
+	h := sha512.New()
+	h.Write(salt)
+	h.Write([]byte(hostname))
+	h.Write(nonce)
+	h.Write([]byte(sharedKey))
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/tls"
 	"encoding/binary"
 	"encoding/json"
 	"errors"
@@ -13,6 +14,7 @@ import (
 	"io"
 	"log/slog"
 	"net"
+	"os"
 	"sync"
 	"time"
 
@@ -26,10 +28,13 @@ import (
 
 // Ingester accepts messages via the Fluent Forward protocol over TCP.
 type Ingester struct {
-	id     string
-	addr   string
-	out    chan<- orchestrator.IngestMessage
-	logger *slog.Logger
+	id           string
+	addr         string
+	tlsConfig    *tls.Config
+	sharedKey    string
+	selfHostname string
+	out          chan<- orchestrator.IngestMessage
+	logger       *slog.Logger
 
 	// pressureGate throttles msgpack reads when the ingest pipeline is backed
 	// up. Pausing before DecodeArrayLen stops reads from the TCP socket, so
@@ -46,17 +51,36 @@ func (ing *Ingester) SetPressureGate(gate *chanwatch.PressureGate) {
 
 // Config holds Fluent Forward ingester configuration.
 type Config struct {
-	ID     string
-	Addr   string // e.g. ":24224"
+	ID   string
+	Addr string // e.g. ":24224"
+
+	// TLSConfig, if set, wraps accepted connections with TLS.
+	TLSConfig *tls.Config
+
+	// SharedKey, if set, requires clients to authenticate with the
+	// HELO/PING/PONG handshake before sending events.
+	SharedKey string
+
+	// SelfHostname is the server hostname sent in PONG. Defaults to
+	// os.Hostname().
+	SelfHostname string
+
 	Logger *slog.Logger
 }
 
 // New creates a new Fluent Forward ingester.
 func New(cfg Config) *Ingester {
+	selfHostname := cfg.SelfHostname
+	if selfHostname == "" {
+		selfHostname, _ = os.Hostname()
+	}
 	return &Ingester{
-		id:     cfg.ID,
-		addr:   cfg.Addr,
-		logger: logging.Default(cfg.Logger).With("component", "ingester", "type", "fluentfwd"),
+		id:           cfg.ID,
+		addr:         cfg.Addr,
+		tlsConfig:    cfg.TLSConfig,
+		sharedKey:    cfg.SharedKey,
+		selfHostname: selfHostname,
+		logger:       logging.Default(cfg.Logger).With("component", "ingester", "type", "fluentfwd"),
 	}
 }
 
@@ -98,7 +122,8 @@ func (ing *Ingester) Run(ctx context.Context, out chan<- orchestrator.IngestMess
 		return fmt.Errorf("fluentfwd listen: %w", err)
 	}
 
-	ing.logger.Info("fluent forward listening", "addr", ln.Addr().String())
+	ing.logger.Info("fluent forward listening", "addr", ln.Addr().String(),
+		"tls", ing.tlsConfig != nil, "shared_key", ing.sharedKey != "")
 
 	var wg sync.WaitGroup
 	defer func() {
@@ -136,8 +161,24 @@ func (ing *Ingester) handleConn(ctx context.Context, conn net.Conn) {
 	remote := conn.RemoteAddr().String()
 	ing.logger.Debug("connection accepted", "remote", remote)
 
+	if ing.tlsConfig != nil {
+		tlsConn := tls.Server(conn, ing.tlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			ing.logger.Debug("TLS handshake failed", "remote", remote, "error", err)
+			return
+		}
+		conn = tlsConn
+	}
+
 	dec := msgpack.NewDecoder(conn)
 
+	if ing.sharedKey != "" {
+		if err := ing.handshake(conn, dec); err != nil {
+			ing.logger.Warn("handshake failed", "remote", remote, "error", err)
+			return
+		}
+	}
+
 	for {
 		if ctx.Err() != nil {
 			return
@@ -187,21 +228,37 @@ func (ing *Ingester) handleOneMessage(ctx context.Context, dec *msgpack.Decoder,
 		return nil, false
 	}
 
+	var (
+		entries []entry
+		option  map[string]any
+		ok      bool
+	)
 	switch {
 	case code == msgpcode.Bin8 || code == msgpcode.Bin16 || code == msgpcode.Bin32:
-		return ing.handlePackedForward(ctx, dec, tag, arrLen, remote)
+		entries, option, ok = ing.decodePackedForward(dec, arrLen, remote)
 	case isArrayCode(code):
-		return ing.handleForward(ctx, dec, tag, arrLen, remote)
+		entries, option, ok = ing.decodeForward(dec, arrLen, remote)
 	default:
-		return ing.handleMessage(ctx, dec, tag, arrLen, remote)
+		entries, option, ok = ing.decodeMessage(dec, arrLen, remote)
+	}
+	if !ok {
+		return nil, false
 	}
+
+	if err := ing.processEntries(ctx, tag, entries, requiresAck(option)); err != nil {
+		if ctx.Err() == nil {
+			ing.logger.Warn("process entries", "remote", remote, "error", err)
+		}
+		return nil, false
+	}
+	return option, true
 }
 
-func (ing *Ingester) handlePackedForward(ctx context.Context, dec *msgpack.Decoder, tag string, arrLen int, remote string) (map[string]any, bool) {
+func (ing *Ingester) decodePackedForward(dec *msgpack.Decoder, arrLen int, remote string) ([]entry, map[string]any, bool) {
 	binData, err := dec.DecodeBytes()
 	if err != nil {
 		ing.logger.Warn("decode packed entries", "remote", remote, "error", err)
-		return nil, false
+		return nil, nil, false
 	}
 
 	var option map[string]any
@@ -213,65 +270,57 @@ func (ing *Ingester) handlePackedForward(ctx context.Context, dec *msgpack.Decod
 		binData, err = gunzip(binData)
 		if err != nil {
 			ing.logger.Warn("decompress error", "remote", remote, "error", err)
-			return nil, false
+			return nil, nil, false
 		}
 	}
 
-	if err := ing.processPackedEntries(ctx, tag, binData); err != nil {
-		if ctx.Err() == nil {
-			ing.logger.Warn("process packed entries", "remote", remote, "error", err)
-		}
-		return nil, false
+	entries, err := decodePackedEntries(binData)
+	if err != nil {
+		ing.logger.Warn("decode packed entries", "remote", remote, "error", err)
+		return nil, nil, false
 	}
-	return option, true
+	return entries, option, true
 }
 
-func (ing *Ingester) handleForward(ctx context.Context, dec *msgpack.Decoder, tag string, arrLen int, remote string) (map[string]any, bool) {
+func (ing *Ingester) decodeForward(dec *msgpack.Decoder, arrLen int, remote string) ([]entry, map[string]any, bool) {
 	entries, err := ing.decodeEntries(dec)
 	if err != nil {
 		ing.logger.Warn("decode entries", "remote", remote, "error", err)
-		return nil, false
+		return nil, nil, false
 	}
 
 	var option map[string]any
 	if arrLen >= 3 {
 		option, _ = decodeOption(dec)
 	}
-
-	if err := ing.processEntries(ctx, tag, entries); err != nil {
-		if ctx.Err() == nil {
-			ing.logger.Warn("process entries", "remote", remote, "error", err)
-		}
-		return nil, false
-	}
-	return option, true
+	return entries, option, true
 }
 
-func (ing *Ingester) handleMessage(ctx context.Context, dec *msgpack.Decoder, tag string, arrLen int, remote string) (map[string]any, bool) {
+func (ing *Ingester) decodeMessage(dec *msgpack.Decoder, arrLen int, remote string) ([]entry, map[string]any, bool) {
 	ts, err := decodeTime(dec)
 	if err != nil {
 		ing.logger.Warn("decode time", "remote", remote, "error", err)
-		return nil, false
+		return nil, nil, false
 	}
 
 	record, err := decodeRecord(dec)
 	if err != nil {
 		ing.logger.Warn("decode record", "remote", remote, "error", err)
-		return nil, false
+		return nil, nil, false
 	}
 
 	var option map[string]any
 	if arrLen >= 4 {
 		option, _ = decodeOption(dec)
 	}
+	return []entry{{ts: ts, record: record}}, option, true
+}
 
-	if err := ing.processRecord(ctx, tag, ts, record); err != nil {
-		if ctx.Err() == nil {
-			ing.logger.Warn("process record", "remote", remote, "error", err)
-		}
-		return nil, false
-	}
-	return option, true
+// requiresAck reports whether the client set require_ack_response, which
+// fluentd and Fluent Bit signal by including a "chunk" id in the option.
+func requiresAck(option map[string]any) bool {
+	_, ok := option["chunk"].(string)
+	return ok
 }
 
 func sendAck(conn net.Conn, option map[string]any) {
@@ -333,53 +382,74 @@ func (ing *Ingester) decodeEntries(dec *msgpack.Decoder) ([]entry, error) {
 	return entries, nil
 }
 
-// processPackedEntries decodes concatenated msgpack [time, record] entries.
-func (ing *Ingester) processPackedEntries(ctx context.Context, tag string, data []byte) error {
+// decodePackedEntries decodes concatenated msgpack [time, record] entries.
+func decodePackedEntries(data []byte) ([]entry, error) {
 	dec := msgpack.NewDecoder(bytes.NewReader(data))
+	var entries []entry
 	for {
 		arrLen, err := dec.DecodeArrayLen()
 		if err != nil {
 			if errors.Is(err, io.EOF) {
-				return nil
+				return entries, nil
 			}
-			return err
+			return nil, err
 		}
 		if arrLen < 2 {
-			return fmt.Errorf("packed entry too short: %d", arrLen)
+			return nil, fmt.Errorf("packed entry too short: %d", arrLen)
 		}
 
 		ts, err := decodeTime(dec)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		record, err := decodeRecord(dec)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		for range arrLen - 2 {
 			if err := dec.Skip(); err != nil {
-				return err
+				return nil, err
 			}
 		}
 
-		if err := ing.processRecord(ctx, tag, ts, record); err != nil {
-			return err
-		}
+		entries = append(entries, entry{ts: ts, record: record})
 	}
 }
 
-// processEntries sends a batch of entries.
-func (ing *Ingester) processEntries(ctx context.Context, tag string, entries []entry) error {
+// processEntries sends a batch of entries. When waitAck is set
+// (require_ack_response), it returns only after every record has been
+// persisted, so the ack sent back to the client is durable. A write error
+// fails the whole chunk; the connection is dropped without an ack and the
+// client resends it.
+func (ing *Ingester) processEntries(ctx context.Context, tag string, entries []entry, waitAck bool) error {
+	var ack chan error
+	if waitAck {
+		ack = make(chan error, len(entries))
+	}
 	for _, e := range entries {
-		if err := ing.processRecord(ctx, tag, e.ts, e.record); err != nil {
+		if err := ing.processRecord(ctx, tag, e.ts, e.record, ack); err != nil {
 			return err
 		}
 	}
+	if ack == nil {
+		return nil
+	}
+	for range entries {
+		select {
+		case err := <-ack:
+			if err != nil {
+				return fmt.Errorf("write: %w", err)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
 	return nil
 }
 
 // processRecord converts a single record to an IngestMessage and sends it.
-func (ing *Ingester) processRecord(ctx context.Context, tag string, ts time.Time, record map[string]any) error {
+// ack, if non-nil, receives the write result.
+func (ing *Ingester) processRecord(ctx context.Context, tag string, ts time.Time, record map[string]any, ack chan<- error) error {
 	attrs := make(map[string]string, len(record)+4)
 	attrs["tag"] = tag
 	attrs["ingester_type"] = "fluentfwd"
@@ -409,6 +479,7 @@ func (ing *Ingester) processRecord(ctx context.Context, tag string, ts time.Time
 		SourceTS:   ts,
 		IngestTS:   time.Now(),
 		IngesterID: ing.id,
+		Ack:        ack,
 	}
 
 	select {
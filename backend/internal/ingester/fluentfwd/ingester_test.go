@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"encoding/binary"
+	"errors"
 	"gastrolog/internal/glid"
 	"net"
 	"runtime"
@@ -18,6 +19,12 @@ import (
 // dialIngester starts a Fluent Forward ingester and returns the TCP address and output channel.
 func dialIngester(t *testing.T, chanSize int) (string, chan orchestrator.IngestMessage) {
 	t.Helper()
+	return startIngester(t, Config{ID: "test-fwd"}, chanSize)
+}
+
+// startIngester starts an ingester with cfg on a free loopback port.
+func startIngester(t *testing.T, cfg Config, chanSize int) (string, chan orchestrator.IngestMessage) {
+	t.Helper()
 
 	// Find a free port.
 	ln, err := net.Listen("tcp", "127.0.0.1:0")
@@ -28,10 +35,8 @@ func dialIngester(t *testing.T, chanSize int) (string, chan orchestrator.IngestM
 	ln.Close()
 
 	out := make(chan orchestrator.IngestMessage, chanSize)
-	ing := New(Config{
-		ID:   "test-fwd",
-		Addr: addr,
-	})
+	cfg.Addr = addr
+	ing := New(cfg)
 
 	ctx := t.Context()
 	go ing.Run(ctx, out)
@@ -363,11 +368,15 @@ func TestFluentFwdAck(t *testing.T) {
 	conn := sendMsgpack(t, addr, buf.Bytes())
 	defer conn.Close()
 
-	// Receive the message.
+	// Receive the message and confirm the write.
 	msg := recv(t, out)
 	if string(msg.Raw) != "ack test" {
 		t.Errorf("raw: expected %q, got %q", "ack test", msg.Raw)
 	}
+	if msg.Ack == nil {
+		t.Fatal("expected Ack channel when chunk is set")
+	}
+	msg.Ack <- nil
 
 	// Read the ack response from the connection.
 	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
@@ -381,6 +390,45 @@ func TestFluentFwdAck(t *testing.T) {
 	}
 }
 
+func TestFluentFwdAckWaitsForWrite(t *testing.T) {
+	t.Parallel()
+	addr, out := dialIngester(t, 10)
+
+	// Forward mode with two entries and a chunk id.
+	var buf bytes.Buffer
+	enc := msgpack.NewEncoder(&buf)
+	enc.EncodeArrayLen(3)
+	enc.EncodeString("ack.tag")
+	enc.EncodeArrayLen(2)
+	for _, m := range []string{"first", "second"} {
+		enc.EncodeArrayLen(2)
+		enc.EncodeInt(int64(1700000000))
+		enc.EncodeMap(map[string]any{"message": m})
+	}
+	enc.EncodeMap(map[string]any{"chunk": "chunk-1"})
+
+	conn := sendMsgpack(t, addr, buf.Bytes())
+	defer conn.Close()
+
+	first, second := recv(t, out), recv(t, out)
+
+	// Nothing is acked while a write is outstanding.
+	first.Ack <- nil
+	conn.SetReadDeadline(time.Now().Add(200 * time.Millisecond))
+	oneByte := make([]byte, 1)
+	if _, err := conn.Read(oneByte); err == nil {
+		t.Fatal("ack sent before all records were written")
+	}
+
+	// A failed write drops the connection without acking so the client retries.
+	second.Ack <- errors.New("disk full")
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	n, err := conn.Read(oneByte)
+	if n != 0 || err == nil {
+		t.Fatalf("expected connection close without ack, got n=%d err=%v", n, err)
+	}
+}
+
 func TestFluentFwdNoAckWithoutChunk(t *testing.T) {
 	t.Parallel()
 	addr, out := dialIngester(t, 10)
@@ -517,7 +565,7 @@ func TestEventTimeInvalidLength(t *testing.T) {
 
 func TestFluentFwdFactory(t *testing.T) {
 	t.Parallel()
-	factory := NewFactory()
+	factory := NewFactory(nil)
 
 	// Default addr.
 	ing, err := factory(glid.New(), nil, nil)
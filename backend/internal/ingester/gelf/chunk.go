@@ -0,0 +1,137 @@
+package gelf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// GELF UDP chunking: a message too large for one datagram is split into up
+// to 128 chunks, each prefixed with a 12-byte header:
+//
+//	0x1e 0x0f | message ID (8 bytes) | sequence number | sequence count
+//
+// Chunks may arrive in any order. A message whose chunks do not all arrive
+// within the timeout is discarded.
+const (
+	chunkHeaderLen = 12
+	maxChunks      = 128
+
+	// DefaultChunkTimeout is how long to wait for the remaining chunks of a
+	// message. The GELF spec recommends five seconds.
+	DefaultChunkTimeout = 5 * time.Second
+
+	// DefaultMaxChunkedMessages caps the number of partially received
+	// messages held in memory. When full, the oldest is discarded.
+	DefaultMaxChunkedMessages = 1000
+)
+
+var chunkMagic = []byte{0x1e, 0x0f}
+
+// isChunked reports whether a datagram is a GELF chunk.
+func isChunked(pkt []byte) bool {
+	return bytes.HasPrefix(pkt, chunkMagic)
+}
+
+// partial is a message whose chunks are still arriving.
+type partial struct {
+	chunks   [][]byte
+	received int
+	size     int
+	first    time.Time
+}
+
+// chunkAssembler reassembles chunked messages. It is not safe for
+// concurrent use; the UDP read loop owns it.
+type chunkAssembler struct {
+	timeout    time.Duration
+	maxPending int
+	maxSize    int
+	pending    map[[8]byte]*partial
+
+	// expired and evicted count discarded incomplete messages.
+	expired int
+	evicted int
+}
+
+func newChunkAssembler(timeout time.Duration, maxPending, maxSize int) *chunkAssembler {
+	return &chunkAssembler{
+		timeout:    timeout,
+		maxPending: maxPending,
+		maxSize:    maxSize,
+		pending:    make(map[[8]byte]*partial),
+	}
+}
+
+// add stores one chunk. It returns the reassembled payload once the last
+// chunk of a message arrives, and nil otherwise. pkt is copied.
+func (a *chunkAssembler) add(pkt []byte, now time.Time) ([]byte, error) {
+	if len(pkt) < chunkHeaderLen {
+		return nil, errors.New("chunk shorter than header")
+	}
+	var id [8]byte
+	copy(id[:], pkt[2:10])
+	seq, count := int(pkt[10]), int(pkt[11])
+	if count == 0 || count > maxChunks {
+		return nil, fmt.Errorf("invalid chunk count %d", count)
+	}
+	if seq >= count {
+		return nil, fmt.Errorf("chunk sequence %d out of range (count %d)", seq, count)
+	}
+
+	p, ok := a.pending[id]
+	if !ok {
+		if len(a.pending) >= a.maxPending {
+			a.evictOldest()
+		}
+		p = &partial{chunks: make([][]byte, count), first: now}
+		a.pending[id] = p
+	}
+	if len(p.chunks) != count {
+		delete(a.pending, id)
+		return nil, fmt.Errorf("chunk count changed from %d to %d", len(p.chunks), count)
+	}
+	if p.chunks[seq] != nil {
+		return nil, nil // duplicate
+	}
+
+	data := pkt[chunkHeaderLen:]
+	if p.size+len(data) > a.maxSize {
+		delete(a.pending, id)
+		return nil, fmt.Errorf("chunked message exceeds %d bytes", a.maxSize)
+	}
+	p.chunks[seq] = bytes.Clone(data)
+	p.received++
+	p.size += len(data)
+	if p.received < count {
+		return nil, nil
+	}
+
+	delete(a.pending, id)
+	return bytes.Join(p.chunks, nil), nil
+}
+
+// expire discards messages older than the timeout.
+func (a *chunkAssembler) expire(now time.Time) {
+	for id, p := range a.pending {
+		if now.Sub(p.first) > a.timeout {
+			delete(a.pending, id)
+			a.expired++
+		}
+	}
+}
+
+func (a *chunkAssembler) evictOldest() {
+	var oldestID [8]byte
+	var oldest *partial
+	for id, p := range a.pending {
+		if oldest == nil || p.first.Before(oldest.first) {
+			oldestID, oldest = id, p
+		}
+	}
+	if oldest != nil {
+		delete(a.pending, oldestID)
+		a.evicted++
+	}
+}
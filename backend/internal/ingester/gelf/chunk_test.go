@@ -0,0 +1,92 @@
+package gelf
+
+import (
+	"testing"
+	"time"
+)
+
+func TestChunkAssemblerReassembles(t *testing.T) {
+	a := newChunkAssembler(time.Second, 10, maxMessageSize)
+	now := time.Now()
+
+	if out, err := a.add(chunk(1, 1, 2, []byte("world")), now); out != nil || err != nil {
+		t.Fatalf("first chunk: out %q, err %v", out, err)
+	}
+	// Duplicates are ignored.
+	if out, err := a.add(chunk(1, 1, 2, []byte("world")), now); out != nil || err != nil {
+		t.Fatalf("duplicate chunk: out %q, err %v", out, err)
+	}
+	out, err := a.add(chunk(1, 0, 2, []byte("hello ")), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != "hello world" {
+		t.Fatalf("reassembled %q", out)
+	}
+	if len(a.pending) != 0 {
+		t.Errorf("pending = %d after completion", len(a.pending))
+	}
+}
+
+func TestChunkAssemblerExpire(t *testing.T) {
+	a := newChunkAssembler(time.Second, 10, maxMessageSize)
+	start := time.Now()
+
+	a.add(chunk(1, 0, 2, []byte("a")), start)
+	a.add(chunk(2, 0, 2, []byte("b")), start.Add(900*time.Millisecond))
+
+	a.expire(start.Add(1500 * time.Millisecond))
+	if a.expired != 1 || len(a.pending) != 1 {
+		t.Fatalf("expired = %d, pending = %d", a.expired, len(a.pending))
+	}
+
+	// The late chunk of the expired message starts a new, incomplete one.
+	if out, _ := a.add(chunk(1, 1, 2, []byte("a")), start.Add(1600*time.Millisecond)); out != nil {
+		t.Fatalf("expired message completed: %q", out)
+	}
+	if out, _ := a.add(chunk(2, 1, 2, []byte("b")), start.Add(1600*time.Millisecond)); string(out) != "bb" {
+		t.Fatalf("live message: %q", out)
+	}
+}
+
+func TestChunkAssemblerEvictsOldest(t *testing.T) {
+	a := newChunkAssembler(time.Minute, 2, maxMessageSize)
+	now := time.Now()
+
+	a.add(chunk(1, 0, 2, []byte("1")), now)
+	a.add(chunk(2, 0, 2, []byte("2")), now.Add(time.Millisecond))
+	a.add(chunk(3, 0, 2, []byte("3")), now.Add(2*time.Millisecond))
+
+	if a.evicted != 1 || len(a.pending) != 2 {
+		t.Fatalf("evicted = %d, pending = %d", a.evicted, len(a.pending))
+	}
+	if _, ok := a.pending[[8]byte{0, 0, 0, 0, 0, 0, 0, 1}]; ok {
+		t.Error("oldest message was not evicted")
+	}
+}
+
+func TestChunkAssemblerInvalid(t *testing.T) {
+	a := newChunkAssembler(time.Minute, 10, 8)
+	now := time.Now()
+
+	for name, pkt := range map[string][]byte{
+		"short header": {0x1e, 0x0f, 1, 2},
+		"zero count":   chunk(1, 0, 0, nil),
+		"count > 128":  chunk(1, 0, 129, nil),
+		"seq >= count": chunk(1, 2, 2, nil),
+		"too large":    chunk(1, 0, 2, []byte("123456789")),
+	} {
+		if _, err := a.add(pkt, now); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+
+	// A changed sequence count drops the message.
+	a.add(chunk(5, 0, 2, []byte("x")), now)
+	if _, err := a.add(chunk(5, 1, 3, []byte("y")), now); err == nil {
+		t.Error("changed count: expected error")
+	}
+	if len(a.pending) != 0 {
+		t.Errorf("pending = %d, want 0", len(a.pending))
+	}
+}
@@ -0,0 +1,80 @@
+package gelf
+
+import (
+	"errors"
+	"fmt"
+	"gastrolog/internal/glid"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"gastrolog/internal/cert"
+	"gastrolog/internal/ingester/httpauth"
+	"gastrolog/internal/ingester/listentls"
+	"gastrolog/internal/orchestrator"
+)
+
+// ParamDefaults returns the default parameter values for a GELF ingester.
+func ParamDefaults() map[string]string {
+	return map[string]string{
+		"chunk_timeout":        DefaultChunkTimeout.String(),
+		"max_chunked_messages": strconv.Itoa(DefaultMaxChunkedMessages),
+	}
+}
+
+// NewFactory returns a IngesterFactory for GELF ingesters.
+// The cert manager is used to resolve TLS certificate names.
+func NewFactory(certMgr *cert.Manager) orchestrator.IngesterFactory {
+	return func(id glid.GLID, params map[string]string, logger *slog.Logger) (orchestrator.Ingester, error) {
+		udpAddr := params["udp_addr"]
+		tcpAddr := params["tcp_addr"]
+		httpAddr := params["http_addr"]
+
+		if udpAddr == "" && tcpAddr == "" && httpAddr == "" {
+			return nil, errors.New("gelf ingester: at least one of udp_addr, tcp_addr or http_addr is required")
+		}
+
+		tlsCfg, err := listentls.BuildConfig(params, certMgr)
+		if err != nil {
+			return nil, fmt.Errorf("gelf ingester: %w", err)
+		}
+		if tlsCfg != nil && tcpAddr == "" && httpAddr == "" {
+			return nil, errors.New("gelf ingester: TLS requires tcp_addr or http_addr")
+		}
+
+		auth, err := httpauth.ParseParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("gelf ingester: %w", err)
+		}
+
+		chunkTimeout := DefaultChunkTimeout
+		if v := params["chunk_timeout"]; v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil || d <= 0 {
+				return nil, fmt.Errorf("gelf ingester: invalid chunk_timeout %q: must be a positive duration", v)
+			}
+			chunkTimeout = d
+		}
+
+		maxChunked := DefaultMaxChunkedMessages
+		if v := params["max_chunked_messages"]; v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("gelf ingester: invalid max_chunked_messages %q: must be a positive integer", v)
+			}
+			maxChunked = n
+		}
+
+		return New(Config{
+			ID:                 id.String(),
+			UDPAddr:            udpAddr,
+			TCPAddr:            tcpAddr,
+			HTTPAddr:           httpAddr,
+			TLSConfig:          tlsCfg,
+			Auth:               auth,
+			ChunkTimeout:       chunkTimeout,
+			MaxChunkedMessages: maxChunked,
+			Logger:             logger,
+		}), nil
+	}
+}
@@ -0,0 +1,61 @@
+package gelf
+
+import (
+	"bytes"
+	"gastrolog/internal/glid"
+	"log/slog"
+	"testing"
+)
+
+func FuzzNewFactory(f *testing.F) {
+	logger := slog.New(slog.NewTextHandler(&bytes.Buffer{}, nil))
+	factory := NewFactory(nil)
+	id := glid.New()
+
+	// Seed: valid params.
+	f.Add([]byte("udp_addr\x00:12201\x00tcp_addr\x00:12201\x00http_addr\x00:12202"))
+	f.Add([]byte("udp_addr\x00:12201\x00chunk_timeout\x002s\x00max_chunked_messages\x00100"))
+	// Seed: invalid values.
+	f.Add([]byte("udp_addr\x00:12201\x00chunk_timeout\x00-1s"))
+	f.Add([]byte("http_addr\x00:12202\x00auth\x00basic"))
+	// Seed: empty (all missing → error).
+	f.Add([]byte(""))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		params := splitParams(data)
+		ing, err := factory(id, params, logger)
+		if err != nil {
+			return
+		}
+		if ing == nil {
+			t.Fatal("nil ingester without error")
+		}
+	})
+}
+
+// FuzzParseMessage feeds arbitrary payloads through decompression and
+// parsing; neither may panic.
+func FuzzParseMessage(f *testing.F) {
+	f.Add([]byte(dockerMsg))
+	f.Add([]byte(`{"short_message":"x","timestamp":1e999,"level":"7"}`))
+	f.Add([]byte{0x1f, 0x8b, 0x08})
+	f.Add([]byte{0x78, 0x9c, 0x00})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		payload, err := decompress(data)
+		if err != nil {
+			return
+		}
+		_, _ = parseMessage(payload)
+	})
+}
+
+// splitParams splits fuzz bytes on null into alternating key/value pairs.
+func splitParams(data []byte) map[string]string {
+	parts := bytes.Split(data, []byte{0})
+	m := make(map[string]string, len(parts)/2)
+	for i := 0; i+1 < len(parts); i += 2 {
+		m[string(parts[i])] = string(parts[i+1])
+	}
+	return m
+}
@@ -0,0 +1,539 @@
+// Package gelf provides a Graylog Extended Log Format ingester that accepts
+// messages via UDP (with chunking), TCP and HTTP.
+package gelf
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"gastrolog/internal/chanwatch"
+	"gastrolog/internal/ingester/httpauth"
+	"gastrolog/internal/logging"
+	"gastrolog/internal/orchestrator"
+)
+
+// Ingester accepts GELF messages via UDP, TCP and/or HTTP.
+// It implements orchestrator.Ingester.
+//
+// This is what Docker's gelf log driver, Graylog sidecars and the GELF
+// outputs of Logstash, Fluent Bit and Vector send. UDP payloads may be
+// chunked and zlib- or gzip-compressed; TCP frames are JSON documents
+// terminated by a null byte; HTTP accepts one document per POST /gelf.
+type Ingester struct {
+	id             string
+	udpAddr        string
+	tcpAddr        string
+	httpAddr       string
+	tlsConfig      *tls.Config
+	auth           *httpauth.Config
+	chunkTimeout   time.Duration
+	maxChunkedMsgs int
+	out            chan<- orchestrator.IngestMessage
+	logger         *slog.Logger
+
+	mu           sync.Mutex
+	udpConn      *net.UDPConn
+	tcpListener  net.Listener
+	httpListener net.Listener
+	httpServer   *http.Server
+	stopped      bool // set by shutdown; listeners bound afterwards close at once
+
+	// pressureGate throttles UDP and TCP reads while the ingest pipeline is
+	// backed up, and makes the HTTP endpoint answer 429. Injected by the
+	// orchestrator before Run; nil means no throttling. See gastrolog-4fguu.
+	pressureGate *chanwatch.PressureGate
+}
+
+// SetPressureGate wires the orchestrator's pressure gate into the ingester.
+// Implements orchestrator.PressureAware.
+func (r *Ingester) SetPressureGate(gate *chanwatch.PressureGate) {
+	r.pressureGate = gate
+}
+
+// Config holds GELF ingester configuration.
+type Config struct {
+	// ID is the ingester's config identifier.
+	ID string
+
+	// UDPAddr is the UDP address to listen on (e.g., ":12201").
+	// Empty string disables UDP.
+	UDPAddr string
+
+	// TCPAddr is the TCP address to listen on (e.g., ":12201").
+	// Empty string disables TCP.
+	TCPAddr string
+
+	// HTTPAddr is the HTTP address to listen on (e.g., ":12202").
+	// Empty string disables HTTP.
+	HTTPAddr string
+
+	// TLSConfig, if non-nil, wraps TCP connections with TLS and serves
+	// HTTPS. UDP is unaffected.
+	TLSConfig *tls.Config
+
+	// Auth, if non-nil, requires bearer or basic credentials on HTTP
+	// requests.
+	Auth *httpauth.Config
+
+	// ChunkTimeout is how long to wait for all chunks of a UDP message.
+	// Zero means DefaultChunkTimeout.
+	ChunkTimeout time.Duration
+
+	// MaxChunkedMessages caps the number of incomplete chunked messages
+	// held at once. Zero means DefaultMaxChunkedMessages.
+	MaxChunkedMessages int
+
+	// Logger for structured logging.
+	Logger *slog.Logger
+}
+
+// New creates a new GELF ingester.
+func New(cfg Config) *Ingester {
+	if cfg.ChunkTimeout <= 0 {
+		cfg.ChunkTimeout = DefaultChunkTimeout
+	}
+	if cfg.MaxChunkedMessages <= 0 {
+		cfg.MaxChunkedMessages = DefaultMaxChunkedMessages
+	}
+	return &Ingester{
+		id:             cfg.ID,
+		udpAddr:        cfg.UDPAddr,
+		tcpAddr:        cfg.TCPAddr,
+		httpAddr:       cfg.HTTPAddr,
+		tlsConfig:      cfg.TLSConfig,
+		auth:           cfg.Auth,
+		chunkTimeout:   cfg.ChunkTimeout,
+		maxChunkedMsgs: cfg.MaxChunkedMessages,
+		logger:         logging.Default(cfg.Logger).With("component", "ingester", "type", "gelf"),
+	}
+}
+
+// Run starts the GELF listeners and blocks until ctx is cancelled.
+func (r *Ingester) Run(ctx context.Context, out chan<- orchestrator.IngestMessage) error {
+	r.out = out
+
+	if r.udpAddr == "" && r.tcpAddr == "" && r.httpAddr == "" {
+		return errors.New("gelf ingester: no UDP, TCP or HTTP address configured")
+	}
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, 3)
+	for _, run := range []struct {
+		addr string
+		fn   func(context.Context) error
+	}{
+		{r.udpAddr, r.runUDP},
+		{r.tcpAddr, r.runTCP},
+		{r.httpAddr, r.runHTTP},
+	} {
+		if run.addr == "" {
+			continue
+		}
+		wg.Go(func() {
+			if err := run.fn(ctx); err != nil {
+				errCh <- err
+			}
+		})
+	}
+
+	// Wait for context cancellation or error.
+	select {
+	case <-ctx.Done():
+		r.logger.Info("gelf ingester stopping")
+		r.shutdown()
+		wg.Wait()
+		return nil
+	case err := <-errCh:
+		r.logger.Info("gelf ingester stopping", "error", err)
+		r.shutdown()
+		wg.Wait()
+		return err
+	}
+}
+
+// shutdown closes all listeners.
+func (r *Ingester) shutdown() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.stopped = true
+	if r.udpConn != nil {
+		_ = r.udpConn.Close()
+		r.udpConn = nil
+	}
+	if r.tcpListener != nil {
+		_ = r.tcpListener.Close()
+		r.tcpListener = nil
+	}
+	if r.httpServer != nil {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = r.httpServer.Shutdown(shutdownCtx)
+		r.httpServer = nil
+	}
+}
+
+// runUDP handles GELF datagrams, reassembling chunked messages.
+func (r *Ingester) runUDP(ctx context.Context) error {
+	addr, err := net.ResolveUDPAddr("udp", r.udpAddr)
+	if err != nil {
+		return err
+	}
+
+	conn, err := net.ListenUDP("udp", addr)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.udpConn = conn
+	r.mu.Unlock()
+
+	r.logger.Info("gelf UDP listener starting", "addr", conn.LocalAddr().String())
+
+	chunks := newChunkAssembler(r.chunkTimeout, r.maxChunkedMsgs, maxMessageSize)
+	lastExpire := time.Now()
+
+	buf := make([]byte, 65536) // Max UDP packet size
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		// Drop chunked messages that will never complete. Checked at most
+		// once a second; the read deadline below guarantees we get here.
+		if now := time.Now(); now.Sub(lastExpire) >= time.Second {
+			expired, evicted := chunks.expired, chunks.evicted
+			chunks.expire(now)
+			if chunks.expired > expired || chunks.evicted > evicted {
+				r.logger.Debug("incomplete chunked messages dropped",
+					"expired", chunks.expired-expired, "evicted", chunks.evicted-evicted)
+			}
+			lastExpire = now
+		}
+
+		// Backpressure: pause reads while the pipeline is backed up. UDP has
+		// no flow control, so kernel-side packet loss is the expected outcome.
+		if r.pressureGate != nil {
+			_ = r.pressureGate.Wait(ctx)
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+			}
+		}
+
+		// Set read deadline to allow checking context.
+		_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+
+		n, remoteAddr, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			if isTimeout(err) {
+				continue
+			}
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			r.logger.Warn("UDP read error", "error", err)
+			continue
+		}
+
+		if n == 0 {
+			continue
+		}
+
+		payload := buf[:n]
+		if isChunked(payload) {
+			payload, err = chunks.add(payload, time.Now())
+			if err != nil {
+				r.logger.Debug("invalid GELF chunk", "error", err, "remote", remoteAddr.String())
+				continue
+			}
+			if payload == nil {
+				continue // waiting for more chunks
+			}
+		}
+
+		msg, err := r.buildMessage(payload, remoteAddr.IP.String())
+		if err != nil {
+			r.logger.Debug("invalid GELF message", "error", err, "remote", remoteAddr.String())
+			continue
+		}
+		select {
+		case r.out <- msg:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// runTCP handles GELF TCP connections.
+func (r *Ingester) runTCP(ctx context.Context) error {
+	listener, err := net.Listen("tcp", r.tcpAddr)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.tcpListener = listener
+	r.mu.Unlock()
+
+	proto := "TCP"
+	if r.tlsConfig != nil {
+		proto = "TLS"
+	}
+	r.logger.Info("gelf TCP listener starting", "addr", listener.Addr().String(), "proto", proto)
+
+	var wg sync.WaitGroup
+	for {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return nil
+		default:
+		}
+
+		// Set accept deadline to allow checking context.
+		_ = listener.(*net.TCPListener).SetDeadline(time.Now().Add(time.Second))
+
+		conn, err := listener.Accept()
+		if err != nil {
+			if isTimeout(err) {
+				continue
+			}
+			if errors.Is(err, net.ErrClosed) {
+				wg.Wait()
+				return nil
+			}
+			r.logger.Warn("TCP accept error", "error", err)
+			continue
+		}
+
+		wg.Go(func() {
+			defer func() { _ = conn.Close() }()
+			if r.tlsConfig != nil {
+				tlsConn := tls.Server(conn, r.tlsConfig)
+				if err := tlsConn.HandshakeContext(ctx); err != nil {
+					r.logger.Debug("gelf TLS handshake failed", "error", err, "remote", conn.RemoteAddr().String())
+					return
+				}
+				r.handleTCPConn(ctx, tlsConn)
+				return
+			}
+			r.handleTCPConn(ctx, conn)
+		})
+	}
+}
+
+// handleTCPConn handles a single TCP connection. GELF over TCP is
+// uncompressed JSON, one document per frame, each terminated by a null byte.
+func (r *Ingester) handleTCPConn(ctx context.Context, conn net.Conn) {
+	remoteIP := ""
+	if tcpAddr, ok := conn.RemoteAddr().(*net.TCPAddr); ok {
+		remoteIP = tcpAddr.IP.String()
+	}
+
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxMessageSize)
+	scanner.Split(splitNull)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		// Backpressure: pause reads while the pipeline is backed up. TCP's
+		// sliding window does the rest — senders block on a closed window.
+		if r.pressureGate != nil {
+			if err := r.pressureGate.Wait(ctx); err != nil {
+				return
+			}
+		}
+
+		_ = conn.SetReadDeadline(time.Now().Add(30 * time.Second))
+
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil && !errors.Is(err, net.ErrClosed) && !isTimeout(err) {
+				r.logger.Debug("TCP read error", "error", err)
+			}
+			return
+		}
+
+		frame := bytes.TrimSpace(scanner.Bytes())
+		if len(frame) == 0 {
+			continue
+		}
+
+		msg, err := r.buildMessage(frame, remoteIP)
+		if err != nil {
+			r.logger.Debug("invalid GELF message", "error", err, "remote", conn.RemoteAddr().String())
+			continue
+		}
+		select {
+		case r.out <- msg:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// splitNull is a bufio.SplitFunc for null-byte terminated frames. A final
+// frame without a terminator is returned at EOF.
+func splitNull(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if i := bytes.IndexByte(data, 0); i >= 0 {
+		return i + 1, data[:i], nil
+	}
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// runHTTP serves POST /gelf.
+func (r *Ingester) runHTTP(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.Handle("POST /gelf", r.auth.Wrap(http.HandlerFunc(r.handleHTTP)))
+
+	listener, err := net.Listen("tcp", r.httpAddr)
+	if err != nil {
+		return err
+	}
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+
+	r.mu.Lock()
+	if r.stopped {
+		r.mu.Unlock()
+		_ = listener.Close()
+		return nil
+	}
+	r.httpListener = listener
+	r.httpServer = server
+	r.mu.Unlock()
+
+	proto := "HTTP"
+	ln := listener
+	if r.tlsConfig != nil {
+		proto = "HTTPS"
+		ln = tls.NewListener(ln, r.tlsConfig)
+	}
+	r.logger.Info("gelf HTTP listener starting", "addr", listener.Addr().String(), "proto", proto)
+
+	if err := server.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// handleHTTP handles one GELF document per request. The body may be
+// gzip- or zlib-compressed, with or without a Content-Encoding header.
+func (r *Ingester) handleHTTP(w http.ResponseWriter, req *http.Request) {
+	if r.overloaded() {
+		w.Header().Set("Retry-After", "1")
+		http.Error(w, "ingestion queue full, retry later", http.StatusTooManyRequests)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxMessageSize+1))
+	if err != nil {
+		http.Error(w, "failed to read body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxMessageSize {
+		http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	remoteIP, _, _ := net.SplitHostPort(req.RemoteAddr)
+	msg, err := r.buildMessage(body, remoteIP)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	select {
+	case r.out <- msg:
+		w.WriteHeader(http.StatusAccepted)
+	case <-req.Context().Done():
+		http.Error(w, "request cancelled", http.StatusServiceUnavailable)
+	}
+}
+
+// overloaded reports whether HTTP requests should be rejected with 429.
+// The pressure gate has hysteresis; without one, fall back to 90% of the
+// output channel's capacity.
+func (r *Ingester) overloaded() bool {
+	if r.pressureGate != nil {
+		return !r.pressureGate.IsNormal()
+	}
+	c := cap(r.out)
+	return c > 0 && len(r.out) >= c*9/10
+}
+
+func isTimeout(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// UDPAddr returns the UDP listener address. Only valid after Run() has started.
+func (r *Ingester) UDPAddr() net.Addr {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.udpConn == nil {
+		return nil
+	}
+	return r.udpConn.LocalAddr()
+}
+
+// TCPAddr returns the TCP listener address. Only valid after Run() has started.
+func (r *Ingester) TCPAddr() net.Addr {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.tcpListener == nil {
+		return nil
+	}
+	return r.tcpListener.Addr()
+}
+
+// HTTPAddr returns the HTTP listener address. Only valid after Run() has started.
+func (r *Ingester) HTTPAddr() net.Addr {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.httpListener == nil {
+		return nil
+	}
+	return r.httpListener.Addr()
+}
+
+// buildMessage decompresses and parses a GELF payload.
+func (r *Ingester) buildMessage(payload []byte, remoteIP string) (orchestrator.IngestMessage, error) {
+	data, err := decompress(payload)
+	if err != nil {
+		return orchestrator.IngestMessage{}, err
+	}
+	msg, err := parseMessage(data)
+	if err != nil {
+		return orchestrator.IngestMessage{}, err
+	}
+	if remoteIP != "" {
+		msg.Attrs["remote_ip"] = remoteIP
+	}
+	msg.IngesterID = r.id
+	return msg, nil
+}
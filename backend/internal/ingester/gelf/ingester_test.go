@@ -0,0 +1,272 @@
+package gelf
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/binary"
+	"net"
+	"net/http"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"gastrolog/internal/orchestrator"
+)
+
+// waitAddr polls f until it returns a non-nil address or the timeout expires.
+func waitAddr(t *testing.T, f func() net.Addr) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for f() == nil {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for listener to bind")
+		}
+		runtime.Gosched()
+	}
+}
+
+func receive(t *testing.T, out <-chan orchestrator.IngestMessage) orchestrator.IngestMessage {
+	t.Helper()
+	select {
+	case m := <-out:
+		return m
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for message")
+		return orchestrator.IngestMessage{}
+	}
+}
+
+func gzipBytes(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func zlibBytes(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zlib.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// chunk builds one GELF chunk datagram.
+func chunk(id uint64, seq, count byte, data []byte) []byte {
+	pkt := []byte{0x1e, 0x0f}
+	pkt = binary.BigEndian.AppendUint64(pkt, id)
+	pkt = append(pkt, seq, count)
+	return append(pkt, data...)
+}
+
+const dockerMsg = `{"version":"1.1","host":"web01","short_message":"GET /healthz 200",` +
+	`"timestamp":1700000000.123,"level":6,"_container_name":"api","_image_name":"acme/api:1.4",` +
+	`"_tag":"api"}`
+
+func TestGELFUDP(t *testing.T) {
+	out := make(chan orchestrator.IngestMessage, 10)
+	recv := New(Config{UDPAddr: "127.0.0.1:0"})
+	go recv.Run(t.Context(), out)
+	waitAddr(t, recv.UDPAddr)
+
+	conn, err := net.Dial("udp", recv.UDPAddr().String())
+	if err != nil {
+		t.Fatalf("dial failed: %v", err)
+	}
+	defer conn.Close()
+
+	for name, payload := range map[string][]byte{
+		"plain": []byte(dockerMsg),
+		"gzip":  gzipBytes(t, []byte(dockerMsg)),
+		"zlib":  zlibBytes(t, []byte(dockerMsg)),
+	} {
+		if _, err := conn.Write(payload); err != nil {
+			t.Fatalf("%s: write: %v", name, err)
+		}
+		m := receive(t, out)
+		if string(m.Raw) != "GET /healthz 200" {
+			t.Errorf("%s: raw = %q", name, m.Raw)
+		}
+		for k, want := range map[string]string{
+			"host":           "web01",
+			"container_name": "api",
+			"image_name":     "acme/api:1.4",
+			"tag":            "api",
+			"level":          "info",
+			"severity":       "6",
+			"ingester_type":  "gelf",
+			"remote_ip":      "127.0.0.1",
+		} {
+			if got := m.Attrs[k]; got != want {
+				t.Errorf("%s: attr %s = %q, want %q", name, k, got, want)
+			}
+		}
+		if want := time.UnixMilli(1700000000123); !m.SourceTS.Equal(want) {
+			t.Errorf("%s: SourceTS = %v, want %v", name, m.SourceTS, want)
+		}
+	}
+}
+
+func TestGELFUDPChunked(t *testing.T) {
+	out := make(chan orchestrator.IngestMessage, 10)
+	recv := New(Config{UDPAddr: "127.0.0.1:0"})
+	go recv.Run(t.Context(), out)
+	waitAddr(t, recv.UDPAddr)
+
+	conn, err := net.Dial("udp", recv.UDPAddr().String())
+	if err != nil {
+		t.Fatalf("dial failed: %v", err)
+	}
+	defer conn.Close()
+
+	// Compress, then split into three chunks sent out of order — the way
+	// Docker's gelf driver chunks large compressed messages.
+	long := strings.Repeat("x", 4000)
+	payload := gzipBytes(t, []byte(`{"version":"1.1","host":"h","short_message":"`+long+`"}`))
+	third := len(payload) / 3
+	parts := [][]byte{payload[:third], payload[third : 2*third], payload[2*third:]}
+	for _, seq := range []byte{2, 0, 1} {
+		if _, err := conn.Write(chunk(42, seq, 3, parts[seq])); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+
+	m := receive(t, out)
+	if string(m.Raw) != long {
+		t.Errorf("raw length = %d, want %d", len(m.Raw), len(long))
+	}
+}
+
+func TestGELFTCP(t *testing.T) {
+	out := make(chan orchestrator.IngestMessage, 10)
+	recv := New(Config{TCPAddr: "127.0.0.1:0"})
+	go recv.Run(t.Context(), out)
+	waitAddr(t, recv.TCPAddr)
+
+	conn, err := net.Dial("tcp", recv.TCPAddr().String())
+	if err != nil {
+		t.Fatalf("dial failed: %v", err)
+	}
+	defer conn.Close()
+
+	// Two null-terminated frames, an invalid one between them (skipped),
+	// and a trailing newline some clients add after the terminator.
+	conn.Write([]byte(`{"version":"1.1","host":"a","short_message":"first"}` + "\x00" +
+		`not json` + "\x00" +
+		`{"version":"1.1","host":"b","short_message":"second","full_message":"second\nwith trace"}` + "\x00\n"))
+
+	m := receive(t, out)
+	if string(m.Raw) != "first" || m.Attrs["host"] != "a" {
+		t.Errorf("first message: raw %q, host %q", m.Raw, m.Attrs["host"])
+	}
+	m = receive(t, out)
+	if string(m.Raw) != "second\nwith trace" || m.Attrs["host"] != "b" {
+		t.Errorf("second message: raw %q, host %q", m.Raw, m.Attrs["host"])
+	}
+}
+
+func TestGELFHTTP(t *testing.T) {
+	out := make(chan orchestrator.IngestMessage, 10)
+	recv := New(Config{HTTPAddr: "127.0.0.1:0"})
+	go recv.Run(t.Context(), out)
+	waitAddr(t, recv.HTTPAddr)
+	url := "http://" + recv.HTTPAddr().String() + "/gelf"
+
+	resp, err := http.Post(url, "application/json", strings.NewReader(dockerMsg))
+	if err != nil {
+		t.Fatalf("post: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("status = %d, want 202", resp.StatusCode)
+	}
+	if m := receive(t, out); m.Attrs["container_name"] != "api" {
+		t.Errorf("container_name = %q", m.Attrs["container_name"])
+	}
+
+	// Compressed body with Content-Encoding.
+	req, _ := http.NewRequest(http.MethodPost, url, bytes.NewReader(gzipBytes(t, []byte(dockerMsg))))
+	req.Header.Set("Content-Encoding", "gzip")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("post: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("gzip status = %d, want 202", resp.StatusCode)
+	}
+	receive(t, out)
+
+	for _, body := range []string{`not json`, `{"version":"1.1","host":"h"}`} {
+		resp, err := http.Post(url, "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("post: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("body %q: status = %d, want 400", body, resp.StatusCode)
+		}
+	}
+}
+
+func TestGELFHTTPOverloaded(t *testing.T) {
+	out := make(chan orchestrator.IngestMessage, 1)
+	out <- orchestrator.IngestMessage{}
+	recv := New(Config{HTTPAddr: "127.0.0.1:0"})
+	go recv.Run(t.Context(), out)
+	waitAddr(t, recv.HTTPAddr)
+
+	resp, err := http.Post("http://"+recv.HTTPAddr().String()+"/gelf", "application/json", strings.NewReader(dockerMsg))
+	if err != nil {
+		t.Fatalf("post: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, want 429", resp.StatusCode)
+	}
+}
+
+func TestGELFFactoryParams(t *testing.T) {
+	factory := NewFactory(nil)
+	id := [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+
+	_, err := factory(id, map[string]string{}, nil)
+	if err == nil || err.Error() != "gelf ingester: at least one of udp_addr, tcp_addr or http_addr is required" {
+		t.Fatalf("missing addr: err = %v", err)
+	}
+
+	for _, params := range []map[string]string{
+		{"udp_addr": ":12201", "chunk_timeout": "0s"},
+		{"udp_addr": ":12201", "chunk_timeout": "soon"},
+		{"udp_addr": ":12201", "max_chunked_messages": "-1"},
+		{"udp_addr": ":12201", "auth": "bearer"},
+	} {
+		if _, err := factory(id, params, nil); err == nil {
+			t.Errorf("params %v: expected error", params)
+		}
+	}
+
+	ing, err := factory(id, map[string]string{
+		"udp_addr": ":12201", "chunk_timeout": "2s", "max_chunked_messages": "10",
+	}, nil)
+	if err != nil {
+		t.Fatalf("valid params: %v", err)
+	}
+	g := ing.(*Ingester)
+	if g.chunkTimeout != 2*time.Second || g.maxChunkedMsgs != 10 {
+		t.Errorf("chunkTimeout = %v, maxChunkedMsgs = %d", g.chunkTimeout, g.maxChunkedMsgs)
+	}
+}
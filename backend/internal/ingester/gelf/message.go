@@ -0,0 +1,172 @@
+package gelf
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"gastrolog/internal/orchestrator"
+)
+
+// maxMessageSize caps a GELF payload after decompression, and a reassembled
+// chunked message before it. 128 chunks of a jumbo datagram fit well
+// within it; anything larger is a gzip bomb or a misbehaving client.
+const maxMessageSize = 8 << 20
+
+// decompress inflates a payload by its magic bytes: gzip (1f 8b), zlib
+// (78 xx) or uncompressed JSON.
+func decompress(payload []byte) ([]byte, error) {
+	var r io.ReadCloser
+	var err error
+	switch {
+	case len(payload) >= 2 && payload[0] == 0x1f && payload[1] == 0x8b:
+		r, err = gzip.NewReader(bytes.NewReader(payload))
+	case len(payload) >= 2 && payload[0] == 0x78 && (uint16(payload[0])<<8|uint16(payload[1]))%31 == 0:
+		r, err = zlib.NewReader(bytes.NewReader(payload))
+	default:
+		return payload, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = r.Close() }()
+
+	data, err := io.ReadAll(io.LimitReader(r, maxMessageSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxMessageSize {
+		return nil, fmt.Errorf("decompressed message exceeds %d bytes", maxMessageSize)
+	}
+	return data, nil
+}
+
+// parseMessage converts one (decompressed) GELF JSON document into an
+// IngestMessage.
+//
+// The raw line is full_message if present, otherwise short_message.
+// Additional fields ("_"-prefixed) become attributes without the
+// underscore; host, level, facility, file and line are mapped explicitly
+// and win over additional fields of the same name.
+func parseMessage(data []byte) (orchestrator.IngestMessage, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc map[string]any
+	if err := dec.Decode(&doc); err != nil {
+		return orchestrator.IngestMessage{}, fmt.Errorf("invalid GELF JSON: %w", err)
+	}
+	if doc == nil {
+		return orchestrator.IngestMessage{}, errors.New("invalid GELF JSON: not an object")
+	}
+
+	short, _ := doc["short_message"].(string)
+	full, _ := doc["full_message"].(string)
+	raw := full
+	if raw == "" {
+		raw = short
+	}
+	if raw == "" {
+		return orchestrator.IngestMessage{}, errors.New("missing short_message")
+	}
+
+	attrs := make(map[string]string, len(doc)+2)
+	for k, v := range doc {
+		name, ok := strings.CutPrefix(k, "_")
+		if !ok || name == "" || name == "id" {
+			continue // "_id" is reserved by the spec
+		}
+		if s, ok := fieldString(v); ok {
+			attrs[name] = s
+		}
+	}
+	for _, k := range []string{"host", "facility", "file", "line"} {
+		if s, ok := fieldString(doc[k]); ok && s != "" {
+			attrs[k] = s
+		}
+	}
+	if lvl, ok := fieldString(doc["level"]); ok {
+		if n, err := strconv.Atoi(lvl); err == nil {
+			attrs["level"] = levelName(n)
+			attrs["severity"] = lvl
+		}
+	}
+	attrs["ingester_type"] = "gelf"
+
+	var sourceTS time.Time
+	if ts, ok := doc["timestamp"].(json.Number); ok {
+		sourceTS = parseTimestamp(ts.String())
+	}
+
+	return orchestrator.IngestMessage{
+		Attrs:    attrs,
+		Raw:      []byte(raw),
+		SourceTS: sourceTS,
+		IngestTS: time.Now(),
+	}, nil
+}
+
+// fieldString stringifies a GELF field value. Strings and numbers are the
+// only types the spec allows; booleans are accepted, anything else is
+// JSON-encoded.
+func fieldString(v any) (string, bool) {
+	switch x := v.(type) {
+	case nil:
+		return "", false
+	case string:
+		return x, true
+	case json.Number:
+		return x.String(), true
+	case bool:
+		return strconv.FormatBool(x), true
+	default:
+		b, err := json.Marshal(x)
+		return string(b), err == nil
+	}
+}
+
+// levelName maps a syslog severity (GELF's level) to the normalized level
+// used by the level digester.
+func levelName(severity int) string {
+	switch {
+	case severity <= 3: // emerg, alert, crit, err
+		return "error"
+	case severity == 4: // warning
+		return "warn"
+	case severity <= 6: // notice, info
+		return "info"
+	default:
+		return "debug"
+	}
+}
+
+// parseTimestamp parses GELF's timestamp: seconds since the epoch with an
+// optional decimal fraction. The common "1700000000.123" form is parsed
+// digit by digit so milliseconds survive exactly; exponent forms fall back
+// to float parsing. Returns the zero time if unparsable.
+func parseTimestamp(s string) time.Time {
+	secStr, fracStr, _ := strings.Cut(s, ".")
+	sec, err := strconv.ParseInt(secStr, 10, 64)
+	if err == nil && len(fracStr) <= 9 {
+		var nsec int64
+		if fracStr != "" {
+			nsec, err = strconv.ParseInt(fracStr+strings.Repeat("0", 9-len(fracStr)), 10, 64)
+		}
+		if err == nil && nsec >= 0 {
+			return time.Unix(sec, nsec)
+		}
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) || math.Abs(f) > 1<<62 {
+		return time.Time{}
+	}
+	whole, frac := math.Modf(f)
+	return time.Unix(int64(whole), int64(frac*1e9))
+}
@@ -0,0 +1,79 @@
+package gelf
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseMessage(t *testing.T) {
+	m, err := parseMessage([]byte(`{"version":"1.1","host":"db1","short_message":"short",` +
+		`"full_message":"full\ntrace","level":3,"facility":"postgres","line":42,"file":"main.c",` +
+		`"_id":"ignored","_user_id":9001,"_ok":true,"_tags":["a","b"],"_host":"overridden"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(m.Raw) != "full\ntrace" {
+		t.Errorf("raw = %q", m.Raw)
+	}
+	want := map[string]string{
+		"host":          "db1",
+		"level":         "error",
+		"severity":      "3",
+		"facility":      "postgres",
+		"line":          "42",
+		"file":          "main.c",
+		"user_id":       "9001",
+		"ok":            "true",
+		"tags":          `["a","b"]`,
+		"ingester_type": "gelf",
+	}
+	for k, v := range want {
+		if m.Attrs[k] != v {
+			t.Errorf("attr %s = %q, want %q", k, m.Attrs[k], v)
+		}
+	}
+	if _, ok := m.Attrs["id"]; ok {
+		t.Error("reserved _id became an attribute")
+	}
+	if len(m.Attrs) != len(want) {
+		t.Errorf("attrs = %v", m.Attrs)
+	}
+	if !m.SourceTS.IsZero() {
+		t.Errorf("SourceTS = %v, want zero without timestamp", m.SourceTS)
+	}
+}
+
+func TestParseMessageErrors(t *testing.T) {
+	for _, in := range []string{``, `null`, `[]`, `{"host":"h"}`, `{"short_message":""}`, `{"short_message":5}`} {
+		if _, err := parseMessage([]byte(in)); err == nil {
+			t.Errorf("%q: expected error", in)
+		}
+	}
+}
+
+func TestLevelName(t *testing.T) {
+	for sev, want := range []string{"error", "error", "error", "error", "warn", "info", "info", "debug"} {
+		if got := levelName(sev); got != want {
+			t.Errorf("levelName(%d) = %q, want %q", sev, got, want)
+		}
+	}
+}
+
+func TestParseTimestamp(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"1700000000", time.Unix(1700000000, 0)},
+		{"1700000000.5", time.Unix(1700000000, 500_000_000)},
+		{"1700000000.123456", time.Unix(1700000000, 123_456_000)},
+		{"1.7e9", time.Unix(1700000000, 0)},
+		{"NaN", time.Time{}},
+		{"1e300", time.Time{}},
+	}
+	for _, tt := range tests {
+		if got := parseTimestamp(tt.in); !got.Equal(tt.want) {
+			t.Errorf("parseTimestamp(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
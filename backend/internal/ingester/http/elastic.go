@@ -0,0 +1,278 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"gastrolog/internal/glid"
+	"gastrolog/internal/ingester/bodyutil"
+	"gastrolog/internal/orchestrator"
+)
+
+// Elasticsearch _bulk compatibility.
+//
+// Filebeat, Logstash, Vector, Fluent Bit and other Elasticsearch outputs
+// ship batches as NDJSON to POST /_bulk or POST /{index}/_bulk: an action
+// line followed by a source document. Each index or create action becomes
+// one record whose raw line is the document as sent; the target index
+// becomes the "index" attribute and @timestamp the source timestamp.
+// update and delete actions are answered with a per-item error.
+
+const (
+	// esVersion is reported by GET /. Clients check the major version
+	// before choosing a wire format.
+	esVersion = "8.11.0"
+
+	// maxBatchBodySize caps decompressed ES and HEC request bodies. It sits
+	// above the default batch sizes of Logstash (20 MiB) and Vector (10 MiB).
+	maxBatchBodySize = 32 << 20
+)
+
+// esHeaders sets the headers Elasticsearch clients (8.x) require on every
+// response before they accept the server as Elasticsearch.
+func esHeaders(w http.ResponseWriter) {
+	w.Header().Set("X-Elastic-Product", "Elasticsearch")
+	w.Header().Set("Content-Type", "application/json")
+}
+
+func writeESJSON(w http.ResponseWriter, status int, v any) {
+	esHeaders(w)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeESError writes a request-level error in Elasticsearch's format.
+func writeESError(w http.ResponseWriter, status int, errType, reason string) {
+	writeESJSON(w, status, map[string]any{
+		"error":  map[string]any{"type": errType, "reason": reason},
+		"status": status,
+	})
+}
+
+// handleESInfo handles GET / (and HEAD /), which clients call to detect the
+// server version.
+func (r *Ingester) handleESInfo(w http.ResponseWriter, _ *http.Request) {
+	writeESJSON(w, http.StatusOK, map[string]any{
+		"name":         "gastrolog",
+		"cluster_name": "gastrolog",
+		"version": map[string]any{
+			"number":       esVersion,
+			"build_flavor": "default",
+		},
+		"tagline": "You Know, for Search",
+	})
+}
+
+// handleESHealth handles GET /_cluster/health, used by Vector's healthcheck.
+func (r *Ingester) handleESHealth(w http.ResponseWriter, _ *http.Request) {
+	writeESJSON(w, http.StatusOK, map[string]any{
+		"cluster_name": "gastrolog",
+		"status":       "green",
+		"timed_out":    false,
+	})
+}
+
+// bulkItem tracks one action of a bulk request and its outcome.
+type bulkItem struct {
+	action  string // "index", "create", "update" or "delete"
+	index   string
+	id      string
+	msg     int // position in the message batch, or -1 if not ingested
+	status  int
+	errType string
+	reason  string
+}
+
+func (it *bulkItem) fail(status int, errType, reason string) {
+	it.msg = -1
+	it.status = status
+	it.errType = errType
+	it.reason = reason
+}
+
+// handleESBulk handles POST /_bulk and POST /{index}/_bulk.
+func (r *Ingester) handleESBulk(w http.ResponseWriter, req *http.Request) {
+	start := time.Now()
+
+	if r.overloaded() {
+		w.Header().Set("Retry-After", "1")
+		writeESError(w, http.StatusTooManyRequests, "es_rejected_execution_exception", "queue full, retry later")
+		return
+	}
+
+	data, err := bodyutil.ReadBody(req.Body, req.Header.Get("Content-Encoding"), maxBatchBodySize+1)
+	if err != nil {
+		writeESError(w, http.StatusBadRequest, "parse_exception", "failed to read body: "+err.Error())
+		return
+	}
+	if len(data) > maxBatchBodySize {
+		writeESError(w, http.StatusRequestEntityTooLarge, "content_too_long_exception",
+			fmt.Sprintf("request body exceeds %d bytes", maxBatchBodySize))
+		return
+	}
+
+	items, messages, err := r.parseBulk(data, req.PathValue("index"))
+	if err != nil {
+		writeESError(w, http.StatusBadRequest, "illegal_argument_exception", err.Error())
+		return
+	}
+	if len(items) == 0 {
+		writeESError(w, http.StatusBadRequest, "action_request_validation_exception",
+			"Validation Failed: 1: no requests added;")
+		return
+	}
+
+	results, err := r.enqueue(req.Context(), messages, req.Header.Get("X-Wait-Ack") == "true")
+	if err != nil {
+		writeESError(w, http.StatusServiceUnavailable, "exception", "request cancelled")
+		return
+	}
+	for i := range items {
+		if it := &items[i]; it.msg >= 0 && results[it.msg] != nil {
+			it.fail(http.StatusInternalServerError, "exception", results[it.msg].Error())
+		}
+	}
+
+	writeESJSON(w, http.StatusOK, bulkResponse(items, time.Since(start)))
+}
+
+// parseBulk splits an NDJSON bulk body into items and the messages to
+// ingest. Malformed action lines fail the whole request, as in
+// Elasticsearch; bad documents fail only their item.
+func (r *Ingester) parseBulk(data []byte, defaultIndex string) ([]bulkItem, []orchestrator.IngestMessage, error) {
+	var (
+		items    []bulkItem
+		messages []orchestrator.IngestMessage
+		now      = time.Now()
+	)
+
+	lines := bytes.Split(data, []byte("\n"))
+	for n := 0; n < len(lines); n++ {
+		line := bytes.TrimSpace(lines[n])
+		if len(line) == 0 {
+			continue
+		}
+
+		var action map[string]struct {
+			Index string `json:"_index"`
+			ID    string `json:"_id"`
+		}
+		if err := json.Unmarshal(line, &action); err != nil || len(action) != 1 {
+			return nil, nil, fmt.Errorf("malformed action/metadata line [%d]", n+1)
+		}
+
+		var it bulkItem
+		for name, meta := range action {
+			it = bulkItem{action: name, index: meta.Index, id: meta.ID, msg: -1}
+		}
+		if it.index == "" {
+			it.index = defaultIndex
+		}
+
+		switch it.action {
+		case "delete":
+			it.fail(http.StatusBadRequest, "illegal_argument_exception", "delete is not supported")
+			items = append(items, it)
+			continue
+		case "index", "create", "update":
+		default:
+			return nil, nil, fmt.Errorf("malformed action/metadata line [%d], unknown action %q", n+1, it.action)
+		}
+
+		// index, create and update are followed by a source line.
+		n++
+		if n >= len(lines) || len(bytes.TrimSpace(lines[n])) == 0 {
+			return nil, nil, fmt.Errorf("action/metadata line [%d] is missing its source line", n)
+		}
+		source := bytes.TrimSpace(lines[n])
+
+		if it.id == "" {
+			it.id = glid.New().String()
+		}
+		switch {
+		case it.action == "update":
+			it.fail(http.StatusBadRequest, "illegal_argument_exception", "update is not supported")
+		case it.index == "":
+			it.fail(http.StatusBadRequest, "action_request_validation_exception", "index is missing")
+		default:
+			msg, err := r.esMessage(it.index, source, now)
+			if err != nil {
+				it.fail(http.StatusBadRequest, "document_parsing_exception", err.Error())
+				break
+			}
+			it.msg = len(messages)
+			it.status = http.StatusCreated
+			messages = append(messages, msg)
+		}
+		items = append(items, it)
+	}
+	return items, messages, nil
+}
+
+// esMessage converts a bulk source document to an IngestMessage.
+func (r *Ingester) esMessage(index string, source []byte, now time.Time) (orchestrator.IngestMessage, error) {
+	var doc map[string]any
+	if err := json.Unmarshal(source, &doc); err != nil || doc == nil {
+		return orchestrator.IngestMessage{}, errors.New("failed to parse document: not a JSON object")
+	}
+
+	attrs := make(map[string]string, 2)
+	if err := addAttr(attrs, "index", index); err != nil {
+		return orchestrator.IngestMessage{}, fmt.Errorf("index: %w", err)
+	}
+	attrs["ingester_type"] = "http"
+
+	return orchestrator.IngestMessage{
+		Attrs:      attrs,
+		Raw:        source,
+		SourceTS:   esTimestamp(doc["@timestamp"]),
+		IngestTS:   now,
+		IngesterID: r.id,
+	}, nil
+}
+
+// esTimestamp reads @timestamp as an RFC 3339 string or epoch milliseconds.
+// Returns the zero time if absent or unparsable; the timestamp digester can
+// still extract one from the document.
+func esTimestamp(v any) time.Time {
+	switch ts := v.(type) {
+	case string:
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			return t
+		}
+	case float64:
+		return time.UnixMilli(int64(ts))
+	}
+	return time.Time{}
+}
+
+// bulkResponse builds the _bulk response body.
+func bulkResponse(items []bulkItem, took time.Duration) map[string]any {
+	out := make([]map[string]any, len(items))
+	hasErrors := false
+	for i, it := range items {
+		result := map[string]any{
+			"_index": it.index,
+			"_id":    it.id,
+			"status": it.status,
+		}
+		if it.errType != "" {
+			hasErrors = true
+			result["error"] = map[string]any{"type": it.errType, "reason": it.reason}
+		} else {
+			result["_version"] = 1
+			result["result"] = "created"
+			result["_shards"] = map[string]int{"total": 1, "successful": 1, "failed": 0}
+		}
+		out[i] = map[string]any{it.action: result}
+	}
+	return map[string]any{
+		"took":   took.Milliseconds(),
+		"errors": hasErrors,
+		"items":  out,
+	}
+}
@@ -0,0 +1,223 @@
+package http
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"gastrolog/internal/orchestrator"
+)
+
+// post sends body to path on a running ingester and decodes the JSON reply.
+func post(t *testing.T, recv *Ingester, path, body string, header http.Header) (int, map[string]any) {
+	t.Helper()
+	req, _ := http.NewRequest(http.MethodPost, "http://"+recv.Addr().String()+path, strings.NewReader(body))
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("POST %s: %v", path, err)
+	}
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(resp.Body)
+	var out map[string]any
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("POST %s: invalid JSON response %q: %v", path, data, err)
+	}
+	return resp.StatusCode, out
+}
+
+func drain(out chan orchestrator.IngestMessage) []orchestrator.IngestMessage {
+	var msgs []orchestrator.IngestMessage
+	for {
+		select {
+		case msg := <-out:
+			msgs = append(msgs, msg)
+		case <-time.After(100 * time.Millisecond):
+			return msgs
+		}
+	}
+}
+
+func TestESInfo(t *testing.T) {
+	t.Parallel()
+	recv := New(Config{Addr: "127.0.0.1:0"})
+	go recv.Run(t.Context(), make(chan orchestrator.IngestMessage, 1))
+
+	resp, err := http.Get("http://" + recv.Addr().String() + "/")
+	if err != nil {
+		t.Fatalf("GET failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if got := resp.Header.Get("X-Elastic-Product"); got != "Elasticsearch" {
+		t.Errorf("X-Elastic-Product: got %q", got)
+	}
+	var info struct {
+		Version struct {
+			Number string `json:"number"`
+		} `json:"version"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if !strings.HasPrefix(info.Version.Number, "8.") {
+		t.Errorf("version: got %q, want 8.x", info.Version.Number)
+	}
+}
+
+func TestESBulk(t *testing.T) {
+	t.Parallel()
+	out := make(chan orchestrator.IngestMessage, 10)
+	recv := New(Config{ID: "es", Addr: "127.0.0.1:0"})
+	go recv.Run(t.Context(), out)
+
+	body := `{"index":{"_index":"filebeat-8.11.0","_id":"a1"}}
+{"@timestamp":"2024-01-02T03:04:05.678Z","message":"first","host":{"name":"web1"}}
+{"create":{}}
+{"@timestamp":1700000000123,"message":"second"}
+`
+	code, resp := post(t, recv, "/logs-app/_bulk", body, http.Header{"Content-Type": {"application/x-ndjson"}})
+	if code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %v", code, resp)
+	}
+	if resp["errors"] != false {
+		t.Errorf("errors: got %v", resp["errors"])
+	}
+	items := resp["items"].([]any)
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(items))
+	}
+	first := items[0].(map[string]any)["index"].(map[string]any)
+	if first["_id"] != "a1" || first["status"] != float64(201) || first["_index"] != "filebeat-8.11.0" {
+		t.Errorf("first item: %v", first)
+	}
+	second := items[1].(map[string]any)["create"].(map[string]any)
+	if second["_index"] != "logs-app" || second["_id"] == "" {
+		t.Errorf("second item should use the URL index and a generated id: %v", second)
+	}
+
+	msgs := drain(out)
+	if len(msgs) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(msgs))
+	}
+	if !strings.Contains(string(msgs[0].Raw), `"message":"first"`) {
+		t.Errorf("raw should be the source document, got %q", msgs[0].Raw)
+	}
+	if msgs[0].Attrs["index"] != "filebeat-8.11.0" || msgs[1].Attrs["index"] != "logs-app" {
+		t.Errorf("index attrs: %v, %v", msgs[0].Attrs, msgs[1].Attrs)
+	}
+	want := time.Date(2024, 1, 2, 3, 4, 5, 678_000_000, time.UTC)
+	if !msgs[0].SourceTS.Equal(want) {
+		t.Errorf("SourceTS: got %v, want %v", msgs[0].SourceTS, want)
+	}
+	if msgs[1].SourceTS.UnixMilli() != 1700000000123 {
+		t.Errorf("SourceTS from epoch millis: got %v", msgs[1].SourceTS)
+	}
+	if msgs[0].IngesterID != "es" {
+		t.Errorf("IngesterID: got %q", msgs[0].IngesterID)
+	}
+}
+
+func TestESBulkItemErrors(t *testing.T) {
+	t.Parallel()
+	out := make(chan orchestrator.IngestMessage, 10)
+	recv := New(Config{Addr: "127.0.0.1:0"})
+	go recv.Run(t.Context(), out)
+
+	body := `{"index":{"_index":"logs"}}
+{"message":"ok"}
+{"index":{"_index":"logs"}}
+not json
+{"delete":{"_index":"logs","_id":"x"}}
+{"update":{"_index":"logs","_id":"y"}}
+{"doc":{"a":1}}
+{"index":{}}
+{"message":"no index"}
+`
+	code, resp := post(t, recv, "/_bulk", body, nil)
+	if code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %v", code, resp)
+	}
+	if resp["errors"] != true {
+		t.Errorf("errors: got %v", resp["errors"])
+	}
+	wantStatus := []struct {
+		action string
+		status float64
+	}{{"index", 201}, {"index", 400}, {"delete", 400}, {"update", 400}, {"index", 400}}
+	items := resp["items"].([]any)
+	if len(items) != len(wantStatus) {
+		t.Fatalf("expected %d items, got %d: %v", len(wantStatus), len(items), items)
+	}
+	for i, w := range wantStatus {
+		item := items[i].(map[string]any)[w.action].(map[string]any)
+		if item["status"] != w.status {
+			t.Errorf("item %d: status %v, want %v", i, item["status"], w.status)
+		}
+		if w.status != 201 && item["error"] == nil {
+			t.Errorf("item %d: missing error", i)
+		}
+	}
+	if msgs := drain(out); len(msgs) != 1 {
+		t.Errorf("only the valid document should be ingested, got %d", len(msgs))
+	}
+}
+
+func TestESBulkMalformed(t *testing.T) {
+	t.Parallel()
+	recv := New(Config{Addr: "127.0.0.1:0"})
+	go recv.Run(t.Context(), make(chan orchestrator.IngestMessage, 10))
+
+	for name, body := range map[string]string{
+		"empty":          "",
+		"bad action":     "{oops\n{}\n",
+		"missing source": `{"index":{"_index":"logs"}}` + "\n",
+	} {
+		code, resp := post(t, recv, "/_bulk", body, nil)
+		if code != http.StatusBadRequest {
+			t.Errorf("%s: expected 400, got %d", name, code)
+		}
+		if resp["error"] == nil {
+			t.Errorf("%s: expected error object, got %v", name, resp)
+		}
+	}
+}
+
+func TestESBulkWaitAckError(t *testing.T) {
+	t.Parallel()
+	out := make(chan orchestrator.IngestMessage, 10)
+	recv := New(Config{Addr: "127.0.0.1:0"})
+	go recv.Run(t.Context(), out)
+
+	go func() {
+		for range 2 {
+			msg := <-out
+			if strings.Contains(string(msg.Raw), "fail") {
+				msg.Ack <- io.ErrShortWrite
+			} else {
+				msg.Ack <- nil
+			}
+		}
+	}()
+
+	body := `{"index":{"_index":"logs"}}
+{"message":"ok"}
+{"index":{"_index":"logs"}}
+{"message":"fail"}
+`
+	code, resp := post(t, recv, "/_bulk", body, http.Header{"X-Wait-Ack": {"true"}})
+	if code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+	items := resp["items"].([]any)
+	if s := items[0].(map[string]any)["index"].(map[string]any)["status"]; s != float64(201) {
+		t.Errorf("item 0: status %v", s)
+	}
+	if s := items[1].(map[string]any)["index"].(map[string]any)["status"]; s != float64(500) {
+		t.Errorf("item 1: status %v, want 500 so the client retries", s)
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"gastrolog/internal/glid"
 	"log/slog"
 
+	"gastrolog/internal/cert"
+	"gastrolog/internal/ingester/httpauth"
+	"gastrolog/internal/ingester/listentls"
 	"gastrolog/internal/orchestrator"
 )
 
@@ -17,7 +20,8 @@ func ParamDefaults() map[string]string {
 }
 
 // NewFactory returns a IngesterFactory for HTTP ingesters.
-func NewFactory() orchestrator.IngesterFactory {
+// The cert manager is used to resolve TLS certificate names.
+func NewFactory(certMgr *cert.Manager) orchestrator.IngesterFactory {
 	return func(id glid.GLID, params map[string]string, logger *slog.Logger) (orchestrator.Ingester, error) {
 		addr := cmp.Or(params["addr"], ":3100") // Loki's default port
 
@@ -36,10 +40,21 @@ func NewFactory() orchestrator.IngesterFactory {
 			}
 		}
 
+		tlsCfg, err := listentls.BuildConfig(params, certMgr)
+		if err != nil {
+			return nil, fmt.Errorf("http ingester: %w", err)
+		}
+		auth, err := httpauth.ParseParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("http ingester: %w", err)
+		}
+
 		return New(Config{
-			ID:     id.String(),
-			Addr:   addr,
-			Logger: logger,
+			ID:        id.String(),
+			Addr:      addr,
+			TLSConfig: tlsCfg,
+			Auth:      auth,
+			Logger:    logger,
 		}), nil
 	}
 }
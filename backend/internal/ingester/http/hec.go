@@ -0,0 +1,341 @@
+package http
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"gastrolog/internal/ingester/bodyutil"
+	"gastrolog/internal/orchestrator"
+)
+
+// Splunk HTTP Event Collector (HEC) compatibility.
+//
+// POST /services/collector/event accepts a stream of JSON event objects
+// ({"time", "host", "source", "sourcetype", "index", "event", "fields"});
+// POST /services/collector/raw accepts newline-separated text, one event
+// per line. host, source, sourcetype and index become attributes, as do
+// indexed "fields". Query parameters of the same names set defaults for
+// every event in the request. Indexer acknowledgement (the ack endpoint and
+// channels) is not implemented; X-Wait-Ack works as on the Loki endpoint.
+
+// HEC status codes, as returned in the "code" field.
+const (
+	hecSuccess       = 0
+	hecTokenRequired = 2
+	hecInvalidToken  = 4
+	hecNoData        = 5
+	hecInvalidFormat = 6
+	hecServerError   = 8
+	hecServerBusy    = 9
+	hecEventRequired = 12
+	hecEventBlank    = 13
+	hecInvalidFields = 15
+	hecHealthy       = 17
+)
+
+// hecResponse is the HEC response body.
+type hecResponse struct {
+	Text               string `json:"text"`
+	Code               int    `json:"code"`
+	InvalidEventNumber *int   `json:"invalid-event-number,omitempty"`
+}
+
+func writeHEC(w http.ResponseWriter, status int, resp hecResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// hecEventError rejects the event at position n (0-based).
+type hecEventError struct {
+	n    int
+	code int
+	text string
+}
+
+func (e *hecEventError) Error() string { return fmt.Sprintf("event %d: %s", e.n, e.text) }
+
+// hecAuth applies the configured auth to HEC endpoints. HEC clients send
+// "Authorization: Splunk <token>", which is checked as a bearer token.
+// Failures are reported in HEC's format.
+func (r *Ingester) hecAuth(next http.HandlerFunc) http.Handler {
+	if r.auth == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		header := req.Header.Get("Authorization")
+		if scheme, token, ok := strings.Cut(header, " "); ok && strings.EqualFold(scheme, "Splunk") {
+			header = "Bearer " + token
+		}
+		switch {
+		case header == "":
+			writeHEC(w, http.StatusUnauthorized, hecResponse{Text: "Token is required", Code: hecTokenRequired})
+		case !r.auth.Authorized(header):
+			writeHEC(w, http.StatusForbidden, hecResponse{Text: "Invalid token", Code: hecInvalidToken})
+		default:
+			next(w, req)
+		}
+	})
+}
+
+// handleHECHealth handles GET /services/collector/health.
+func (r *Ingester) handleHECHealth(w http.ResponseWriter, _ *http.Request) {
+	if r.overloaded() {
+		writeHEC(w, http.StatusServiceUnavailable, hecResponse{Text: "HEC is unhealthy, queues are full", Code: hecServerBusy})
+		return
+	}
+	writeHEC(w, http.StatusOK, hecResponse{Text: "HEC is healthy", Code: hecHealthy})
+}
+
+// handleHECEvent handles POST /services/collector/event.
+func (r *Ingester) handleHECEvent(w http.ResponseWriter, req *http.Request) {
+	r.handleHEC(w, req, r.parseHECEvents)
+}
+
+// handleHECRaw handles POST /services/collector/raw.
+func (r *Ingester) handleHECRaw(w http.ResponseWriter, req *http.Request) {
+	r.handleHEC(w, req, r.parseHECRaw)
+}
+
+// hecParser turns a request body into messages. On a bad event it returns
+// the messages before it together with a *hecEventError, matching Splunk,
+// which indexes the events preceding the first invalid one.
+type hecParser func(data []byte, defaults map[string]string) ([]orchestrator.IngestMessage, error)
+
+func (r *Ingester) handleHEC(w http.ResponseWriter, req *http.Request, parse hecParser) {
+	if r.overloaded() {
+		w.Header().Set("Retry-After", "1")
+		writeHEC(w, http.StatusServiceUnavailable, hecResponse{Text: "Server is busy", Code: hecServerBusy})
+		return
+	}
+
+	data, err := bodyutil.ReadBody(req.Body, req.Header.Get("Content-Encoding"), maxBatchBodySize+1)
+	if err != nil {
+		writeHEC(w, http.StatusBadRequest, hecResponse{Text: "Invalid data format", Code: hecInvalidFormat})
+		return
+	}
+	if len(data) > maxBatchBodySize {
+		writeHEC(w, http.StatusRequestEntityTooLarge, hecResponse{Text: "Content too large", Code: hecInvalidFormat})
+		return
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		writeHEC(w, http.StatusBadRequest, hecResponse{Text: "No data", Code: hecNoData})
+		return
+	}
+
+	defaults := make(map[string]string, 4)
+	q := req.URL.Query()
+	for _, key := range []string{"host", "source", "sourcetype", "index"} {
+		if v := q.Get(key); v != "" {
+			defaults[key] = v
+		}
+	}
+
+	messages, parseErr := parse(data, defaults)
+
+	results, err := r.enqueue(req.Context(), messages, req.Header.Get("X-Wait-Ack") == "true")
+	if err != nil {
+		writeHEC(w, http.StatusServiceUnavailable, hecResponse{Text: "Request cancelled", Code: hecServerError})
+		return
+	}
+	if err := errors.Join(results...); err != nil {
+		r.logger.Warn("hec write failed", "error", err)
+		writeHEC(w, http.StatusInternalServerError, hecResponse{Text: "Internal server error", Code: hecServerError})
+		return
+	}
+
+	var evErr *hecEventError
+	if errors.As(parseErr, &evErr) {
+		writeHEC(w, http.StatusBadRequest, hecResponse{Text: evErr.text, Code: evErr.code, InvalidEventNumber: &evErr.n})
+		return
+	}
+	writeHEC(w, http.StatusOK, hecResponse{Text: "Success", Code: hecSuccess})
+}
+
+// hecEvent is one event of the /event endpoint.
+type hecEvent struct {
+	Time       json.RawMessage            `json:"time"`
+	Host       string                     `json:"host"`
+	Source     string                     `json:"source"`
+	Sourcetype string                     `json:"sourcetype"`
+	Index      string                     `json:"index"`
+	Event      json.RawMessage            `json:"event"`
+	Fields     map[string]json.RawMessage `json:"fields"`
+}
+
+// parseHECEvents decodes concatenated JSON event objects. Splunk does not
+// require any separator between them.
+func (r *Ingester) parseHECEvents(data []byte, defaults map[string]string) ([]orchestrator.IngestMessage, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	now := time.Now()
+
+	var messages []orchestrator.IngestMessage
+	for n := 0; ; n++ {
+		var ev hecEvent
+		if err := dec.Decode(&ev); err != nil {
+			if errors.Is(err, io.EOF) {
+				return messages, nil
+			}
+			return messages, &hecEventError{n: n, code: hecInvalidFormat, text: "Invalid data format"}
+		}
+		msg, err := r.hecEventMessage(&ev, defaults, now)
+		if err != nil {
+			err.n = n
+			return messages, err
+		}
+		messages = append(messages, msg)
+	}
+}
+
+func (r *Ingester) hecEventMessage(ev *hecEvent, defaults map[string]string, now time.Time) (orchestrator.IngestMessage, *hecEventError) {
+	if len(ev.Event) == 0 {
+		return orchestrator.IngestMessage{}, &hecEventError{code: hecEventRequired, text: "Event field is required"}
+	}
+	var raw []byte
+	var s string
+	if err := json.Unmarshal(ev.Event, &s); err == nil {
+		raw = []byte(s)
+	} else if !bytes.Equal(ev.Event, []byte("null")) {
+		raw = ev.Event
+	}
+	if len(raw) == 0 {
+		return orchestrator.IngestMessage{}, &hecEventError{code: hecEventBlank, text: "Event field cannot be blank"}
+	}
+
+	var sourceTS time.Time
+	if len(ev.Time) > 0 && string(ev.Time) != "null" {
+		ts, err := parseEpochSeconds(strings.Trim(string(ev.Time), `"`))
+		if err != nil {
+			return orchestrator.IngestMessage{}, &hecEventError{code: hecInvalidFormat, text: "Invalid data format"}
+		}
+		sourceTS = ts
+	}
+
+	attrs := make(map[string]string, len(defaults)+len(ev.Fields)+1)
+	for k, v := range map[string]string{
+		"host":       cmp.Or(ev.Host, defaults["host"]),
+		"source":     cmp.Or(ev.Source, defaults["source"]),
+		"sourcetype": cmp.Or(ev.Sourcetype, defaults["sourcetype"]),
+		"index":      cmp.Or(ev.Index, defaults["index"]),
+	} {
+		if v == "" {
+			continue
+		}
+		if err := addAttr(attrs, k, v); err != nil {
+			return orchestrator.IngestMessage{}, &hecEventError{code: hecInvalidFormat, text: "Invalid data format"}
+		}
+	}
+	for k, v := range ev.Fields {
+		value, ok := hecFieldValue(v)
+		if !ok {
+			return orchestrator.IngestMessage{}, &hecEventError{code: hecInvalidFields, text: "Error in handling indexed fields"}
+		}
+		if err := addAttr(attrs, k, value); err != nil {
+			return orchestrator.IngestMessage{}, &hecEventError{code: hecInvalidFields, text: "Error in handling indexed fields"}
+		}
+	}
+	attrs["ingester_type"] = "http"
+
+	return orchestrator.IngestMessage{
+		Attrs:      attrs,
+		Raw:        raw,
+		SourceTS:   sourceTS,
+		IngestTS:   now,
+		IngesterID: r.id,
+	}, nil
+}
+
+// parseHECRaw splits a raw body into one event per non-empty line. Raw
+// events carry no timestamp; the timestamp digester extracts one.
+func (r *Ingester) parseHECRaw(data []byte, defaults map[string]string) ([]orchestrator.IngestMessage, error) {
+	now := time.Now()
+	var messages []orchestrator.IngestMessage
+	for line := range bytes.SplitSeq(data, []byte("\n")) {
+		line = bytes.TrimRight(line, "\r")
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		attrs := make(map[string]string, len(defaults)+1)
+		for k, v := range defaults {
+			if err := addAttr(attrs, k, v); err != nil {
+				return nil, &hecEventError{n: len(messages), code: hecInvalidFormat, text: "Invalid data format"}
+			}
+		}
+		attrs["ingester_type"] = "http"
+		messages = append(messages, orchestrator.IngestMessage{
+			Attrs:      attrs,
+			Raw:        line,
+			IngestTS:   now,
+			IngesterID: r.id,
+		})
+	}
+	return messages, nil
+}
+
+// hecFieldValue stringifies an indexed field value: a string, number or
+// boolean, or an array of those (a multi-value field, joined with commas).
+func hecFieldValue(v json.RawMessage) (string, bool) {
+	var x any
+	if err := json.Unmarshal(v, &x); err != nil {
+		return "", false
+	}
+	if arr, ok := x.([]any); ok {
+		parts := make([]string, len(arr))
+		for i, e := range arr {
+			s, ok := scalarString(e)
+			if !ok {
+				return "", false
+			}
+			parts[i] = s
+		}
+		return strings.Join(parts, ","), true
+	}
+	return scalarString(x)
+}
+
+func scalarString(x any) (string, bool) {
+	switch v := x.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		return "", false
+	}
+}
+
+// parseEpochSeconds parses HEC's "time": epoch seconds with an optional
+// fractional part. The fraction is parsed as decimal digits rather than via
+// float64 so millisecond and microsecond values survive exactly.
+func parseEpochSeconds(s string) (time.Time, error) {
+	secStr, fracStr, _ := strings.Cut(s, ".")
+	sec, err := strconv.ParseInt(secStr, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q", s)
+	}
+	var nsec int64
+	if fracStr != "" {
+		if len(fracStr) > 9 {
+			fracStr = fracStr[:9]
+		}
+		f, err := strconv.ParseInt(fracStr, 10, 64)
+		if err != nil || f < 0 {
+			return time.Time{}, fmt.Errorf("invalid time %q", s)
+		}
+		for range 9 - len(fracStr) {
+			f *= 10
+		}
+		nsec = f
+	}
+	return time.Unix(sec, nsec), nil
+}
@@ -0,0 +1,145 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"gastrolog/internal/ingester/httpauth"
+	"gastrolog/internal/orchestrator"
+)
+
+func TestHECEvent(t *testing.T) {
+	t.Parallel()
+	out := make(chan orchestrator.IngestMessage, 10)
+	recv := New(Config{ID: "hec", Addr: "127.0.0.1:0"})
+	go recv.Run(t.Context(), out)
+
+	// Events are concatenated without separators, as Splunk allows.
+	body := `{"time":1700000000.123,"host":"web1","sourcetype":"access_combined","event":"GET /"}` +
+		`{"time":"1700000001","event":{"user":"bob","action":"login"},"fields":{"env":"prod","tags":["a","b"],"n":3}}`
+	code, resp := post(t, recv, "/services/collector/event?index=main&source=app", body, nil)
+	if code != http.StatusOK || resp["code"] != float64(0) {
+		t.Fatalf("expected success, got %d: %v", code, resp)
+	}
+
+	msgs := drain(out)
+	if len(msgs) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(msgs))
+	}
+	first, second := msgs[0], msgs[1]
+	if string(first.Raw) != "GET /" {
+		t.Errorf("raw: got %q", first.Raw)
+	}
+	if want := time.Unix(1700000000, 123_000_000); !first.SourceTS.Equal(want) {
+		t.Errorf("SourceTS: got %v, want %v", first.SourceTS, want)
+	}
+	for k, want := range map[string]string{"host": "web1", "sourcetype": "access_combined", "index": "main", "source": "app"} {
+		if first.Attrs[k] != want {
+			t.Errorf("first %s: got %q, want %q", k, first.Attrs[k], want)
+		}
+	}
+	if string(second.Raw) != `{"user":"bob","action":"login"}` {
+		t.Errorf("object event should be stored as JSON, got %q", second.Raw)
+	}
+	if second.SourceTS.Unix() != 1700000001 {
+		t.Errorf("string time: got %v", second.SourceTS)
+	}
+	for k, want := range map[string]string{"env": "prod", "tags": "a,b", "n": "3"} {
+		if second.Attrs[k] != want {
+			t.Errorf("field %s: got %q, want %q", k, second.Attrs[k], want)
+		}
+	}
+}
+
+func TestHECEventErrors(t *testing.T) {
+	t.Parallel()
+	out := make(chan orchestrator.IngestMessage, 10)
+	recv := New(Config{Addr: "127.0.0.1:0"})
+	go recv.Run(t.Context(), out)
+
+	cases := []struct {
+		name    string
+		body    string
+		code    float64
+		invalid float64
+		queued  int
+	}{
+		{"no data", "  ", 5, -1, 0},
+		{"missing event", `{"event":"ok"}{"host":"h"}`, 12, 1, 1},
+		{"blank event", `{"event":""}`, 13, 0, 0},
+		{"bad json", `{"event":"ok"}{"event":`, 6, 1, 1},
+		{"nested field", `{"event":"x","fields":{"a":{"b":1}}}`, 15, 0, 0},
+	}
+	for _, tc := range cases {
+		status, resp := post(t, recv, "/services/collector/event", tc.body, nil)
+		if status != http.StatusBadRequest || resp["code"] != tc.code {
+			t.Errorf("%s: got %d %v, want 400 code %v", tc.name, status, resp, tc.code)
+		}
+		if tc.invalid >= 0 && resp["invalid-event-number"] != tc.invalid {
+			t.Errorf("%s: invalid-event-number %v, want %v", tc.name, resp["invalid-event-number"], tc.invalid)
+		}
+		if got := len(drain(out)); got != tc.queued {
+			t.Errorf("%s: %d events ingested, want %d", tc.name, got, tc.queued)
+		}
+	}
+}
+
+func TestHECRaw(t *testing.T) {
+	t.Parallel()
+	out := make(chan orchestrator.IngestMessage, 10)
+	recv := New(Config{Addr: "127.0.0.1:0"})
+	go recv.Run(t.Context(), out)
+
+	code, resp := post(t, recv, "/services/collector/raw?sourcetype=syslog&host=db1", "line one\r\n\nline two\n", nil)
+	if code != http.StatusOK || resp["text"] != "Success" {
+		t.Fatalf("expected success, got %d: %v", code, resp)
+	}
+	msgs := drain(out)
+	if len(msgs) != 2 || string(msgs[0].Raw) != "line one" || string(msgs[1].Raw) != "line two" {
+		t.Fatalf("unexpected messages: %v", msgs)
+	}
+	if msgs[0].Attrs["sourcetype"] != "syslog" || msgs[0].Attrs["host"] != "db1" {
+		t.Errorf("attrs: %v", msgs[0].Attrs)
+	}
+	if !msgs[0].SourceTS.IsZero() {
+		t.Errorf("raw events carry no SourceTS, got %v", msgs[0].SourceTS)
+	}
+}
+
+func TestHECAuth(t *testing.T) {
+	t.Parallel()
+	auth, err := httpauth.ParseParams(map[string]string{"auth": "bearer", "auth_token": "tok"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := make(chan orchestrator.IngestMessage, 10)
+	recv := New(Config{Addr: "127.0.0.1:0", Auth: auth})
+	go recv.Run(t.Context(), out)
+
+	body := `{"event":"hello"}`
+	if code, resp := post(t, recv, "/services/collector/event", body, nil); code != http.StatusUnauthorized || resp["code"] != float64(2) {
+		t.Errorf("no token: got %d %v", code, resp)
+	}
+	if code, resp := post(t, recv, "/services/collector/event", body, http.Header{"Authorization": {"Splunk nope"}}); code != http.StatusForbidden || resp["code"] != float64(4) {
+		t.Errorf("wrong token: got %d %v", code, resp)
+	}
+	if code, _ := post(t, recv, "/services/collector/event", body, http.Header{"Authorization": {"Splunk tok"}}); code != http.StatusOK {
+		t.Errorf("Splunk token: got %d", code)
+	}
+	if code, _ := post(t, recv, "/services/collector/event", body, http.Header{"Authorization": {"Bearer tok"}}); code != http.StatusOK {
+		t.Errorf("bearer token: got %d", code)
+	}
+	if got := len(drain(out)); got != 2 {
+		t.Errorf("expected 2 authorized events, got %d", got)
+	}
+
+	resp, err := http.Get("http://" + recv.Addr().String() + "/services/collector/health")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("health should stay open, got %d", resp.StatusCode)
+	}
+}
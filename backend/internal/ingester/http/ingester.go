@@ -1,8 +1,11 @@
-// Package http provides an HTTP ingester that accepts log messages via the Loki Push API.
+// Package http provides an HTTP ingester that accepts log messages via the
+// Loki Push API, the Elasticsearch _bulk API and the Splunk HTTP Event
+// Collector.
 package http
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -14,6 +17,7 @@ import (
 
 	"gastrolog/internal/chanwatch"
 	"gastrolog/internal/ingester/bodyutil"
+	"gastrolog/internal/ingester/httpauth"
 	"gastrolog/internal/logging"
 	"gastrolog/internal/orchestrator"
 )
@@ -37,13 +41,15 @@ const (
 //
 // Note: X-Wait-Ack is a GastroLog extension not part of the Loki API.
 type Ingester struct {
-	id       string
-	addr     string
-	listener net.Listener
-	server   *http.Server
-	out      chan<- orchestrator.IngestMessage
-	logger   *slog.Logger
-	ready    chan struct{} // closed once listener is bound
+	id        string
+	addr      string
+	tlsConfig *tls.Config
+	auth      *httpauth.Config
+	listener  net.Listener
+	server    *http.Server
+	out       chan<- orchestrator.IngestMessage
+	logger    *slog.Logger
+	ready     chan struct{} // closed once listener is bound
 
 	// pressureGate is consulted non-blockingly by handlePush to decide
 	// whether to reject push requests with 429. Hysteresis in the gate
@@ -66,6 +72,14 @@ type Config struct {
 	// Addr is the address to listen on (e.g., ":3100", "127.0.0.1:3100").
 	Addr string
 
+	// TLSConfig, if non-nil, serves HTTPS. For mutual TLS, set ClientAuth
+	// and ClientCAs on the config.
+	TLSConfig *tls.Config
+
+	// Auth, if non-nil, requires bearer or basic credentials on push
+	// requests. The /ready endpoint stays open for load balancers.
+	Auth *httpauth.Config
+
 	// Logger for structured logging.
 	Logger *slog.Logger
 }
@@ -73,10 +87,12 @@ type Config struct {
 // New creates a new HTTP ingester.
 func New(cfg Config) *Ingester {
 	return &Ingester{
-		id:     cfg.ID,
-		addr:   cfg.Addr,
-		logger: logging.Default(cfg.Logger).With("component", "ingester", "type", "http"),
-		ready:  make(chan struct{}),
+		id:        cfg.ID,
+		addr:      cfg.Addr,
+		tlsConfig: cfg.TLSConfig,
+		auth:      cfg.Auth,
+		logger:    logging.Default(cfg.Logger).With("component", "ingester", "type", "http"),
+		ready:     make(chan struct{}),
 	}
 }
 
@@ -84,10 +100,31 @@ func New(cfg Config) *Ingester {
 func (r *Ingester) Run(ctx context.Context, out chan<- orchestrator.IngestMessage) error {
 	r.out = out
 
+	push := r.auth.Wrap(http.HandlerFunc(r.handlePush))
 	mux := http.NewServeMux()
-	mux.HandleFunc("POST /loki/api/v1/push", r.handlePush)
+	mux.Handle("POST /loki/api/v1/push", push)
 	// Also support the legacy endpoint.
-	mux.HandleFunc("POST /api/prom/push", r.handlePush)
+	mux.Handle("POST /api/prom/push", push)
+
+	// Elasticsearch _bulk API.
+	mux.Handle("GET /{$}", r.auth.Wrap(http.HandlerFunc(r.handleESInfo)))
+	mux.Handle("GET /_cluster/health", r.auth.Wrap(http.HandlerFunc(r.handleESHealth)))
+	bulk := r.auth.Wrap(http.HandlerFunc(r.handleESBulk))
+	for _, pattern := range []string{"/_bulk", "/{index}/_bulk"} {
+		mux.Handle("POST "+pattern, bulk)
+		mux.Handle("PUT "+pattern, bulk)
+	}
+
+	// Splunk HTTP Event Collector.
+	hecEvent, hecRaw := r.hecAuth(r.handleHECEvent), r.hecAuth(r.handleHECRaw)
+	mux.Handle("POST /services/collector", hecEvent)
+	mux.Handle("POST /services/collector/event", hecEvent)
+	mux.Handle("POST /services/collector/event/1.0", hecEvent)
+	mux.Handle("POST /services/collector/raw", hecRaw)
+	mux.Handle("POST /services/collector/raw/1.0", hecRaw)
+	mux.HandleFunc("GET /services/collector/health", r.handleHECHealth)
+	mux.HandleFunc("GET /services/collector/health/1.0", r.handleHECHealth)
+
 	// Health check for load balancers.
 	mux.HandleFunc("GET /ready", func(w http.ResponseWriter, req *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -106,12 +143,18 @@ func (r *Ingester) Run(ctx context.Context, out chan<- orchestrator.IngestMessag
 	}
 	close(r.ready) // signal that Addr() can be called safely
 
-	r.logger.Info("http ingester starting", "addr", r.listener.Addr().String())
+	proto := "HTTP"
+	ln := r.listener
+	if r.tlsConfig != nil {
+		proto = "HTTPS"
+		ln = tls.NewListener(ln, r.tlsConfig)
+	}
+	r.logger.Info("http ingester starting", "addr", r.listener.Addr().String(), "proto", proto)
 
 	// Run server in background.
 	errCh := make(chan error, 1)
 	go func() {
-		if err := r.server.Serve(r.listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		if err := r.server.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			errCh <- err
 		}
 		close(errCh)
@@ -153,16 +196,7 @@ type Value []json.RawMessage
 
 // handlePush handles POST /loki/api/v1/push requests.
 func (r *Ingester) handlePush(w http.ResponseWriter, req *http.Request) {
-	// Non-blocking backpressure check. With a pressure gate, use the
-	// hysteresis gate. Without one (standalone/test construction), fall
-	// back to an ad-hoc 90% fill check on the output channel.
-	var rejected bool
-	if r.pressureGate != nil {
-		rejected = !r.pressureGate.IsNormal()
-	} else if c := cap(r.out); c > 0 && len(r.out) >= c*9/10 {
-		rejected = true
-	}
-	if rejected {
+	if r.overloaded() {
 		w.Header().Set("Retry-After", "1")
 		http.Error(w, "queue full, retry later", http.StatusTooManyRequests)
 		return
@@ -185,6 +219,18 @@ func (r *Ingester) handlePush(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// overloaded is the non-blocking backpressure check shared by all push
+// endpoints. With a pressure gate, use the hysteresis gate. Without one
+// (standalone/test construction), fall back to an ad-hoc 90% fill check on
+// the output channel.
+func (r *Ingester) overloaded() bool {
+	if r.pressureGate != nil {
+		return !r.pressureGate.IsNormal()
+	}
+	c := cap(r.out)
+	return c > 0 && len(r.out) >= c*9/10
+}
+
 func (r *Ingester) decodePushBody(w http.ResponseWriter, req *http.Request) ([]orchestrator.IngestMessage, bool) {
 	data, err := bodyutil.ReadBody(req.Body, req.Header.Get("Content-Encoding"), 10<<20)
 	if err != nil {
@@ -263,6 +309,38 @@ func (r *Ingester) sendAll(w http.ResponseWriter, req *http.Request, messages []
 	return true
 }
 
+// enqueue sends messages to the pipeline for the Elasticsearch and HEC
+// endpoints, which report results per event. With waitAck it also waits for
+// each write and returns its result; otherwise every result is nil once
+// queued. The error is non-nil only if ctx ends first.
+func (r *Ingester) enqueue(ctx context.Context, messages []orchestrator.IngestMessage, waitAck bool) ([]error, error) {
+	var acks []chan error
+	if waitAck {
+		acks = make([]chan error, len(messages))
+		for i := range messages {
+			acks[i] = make(chan error, 1)
+			messages[i].Ack = acks[i]
+		}
+	}
+	for _, msg := range messages {
+		select {
+		case r.out <- msg:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	results := make([]error, len(messages))
+	for i, ack := range acks {
+		select {
+		case results[i] = <-ack:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return results, nil
+}
+
 // parseValue converts a Loki value to an IngestMessage.
 // Value format: ["timestamp_ns", "line"] or ["timestamp_ns", "line", {metadata}]
 func (r *Ingester) parseValue(val Value, streamLabels map[string]string) (orchestrator.IngestMessage, error) {
@@ -3,6 +3,7 @@ package http
 import (
 	"bytes"
 	"compress/gzip"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -14,6 +15,9 @@ import (
 
 	"github.com/klauspost/compress/zstd"
 
+	"gastrolog/internal/glid"
+	"gastrolog/internal/ingester/httpauth"
+	"gastrolog/internal/ingester/tlstest"
 	"gastrolog/internal/orchestrator"
 )
 
@@ -622,3 +626,91 @@ func TestLokiPushAttrValueTooLong(t *testing.T) {
 		t.Errorf("expected 400 for value too long, got %d", resp.StatusCode)
 	}
 }
+
+func TestLokiPushAuth(t *testing.T) {
+	t.Parallel()
+	auth, err := httpauth.ParseParams(map[string]string{"auth": "basic", "auth_username": "promtail", "auth_password": "pw"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := make(chan orchestrator.IngestMessage, 10)
+	recv := New(Config{Addr: "127.0.0.1:0", Auth: auth})
+
+	go recv.Run(t.Context(), out)
+
+	base := "http://" + recv.Addr().String()
+	body := `{"streams":[{"stream":{"job":"app"},"values":[["1700000000000000000","secret line"]]}]}`
+
+	push := func(user, pass string) int {
+		req, _ := http.NewRequest(http.MethodPost, base+"/loki/api/v1/push", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if user != "" {
+			req.SetBasicAuth(user, pass)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("POST failed: %v", err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	if code := push("", ""); code != http.StatusUnauthorized {
+		t.Errorf("no credentials: expected 401, got %d", code)
+	}
+	if code := push("promtail", "wrong"); code != http.StatusUnauthorized {
+		t.Errorf("wrong password: expected 401, got %d", code)
+	}
+	if len(out) != 0 {
+		t.Fatalf("rejected pushes must not be ingested, got %d messages", len(out))
+	}
+	if code := push("promtail", "pw"); code != http.StatusNoContent {
+		t.Errorf("valid credentials: expected 204, got %d", code)
+	}
+
+	// The health check stays open for load balancers.
+	resp, err := http.Get(base + "/ready")
+	if err != nil {
+		t.Fatalf("GET failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("ready: expected 200, got %d", resp.StatusCode)
+	}
+}
+
+func TestLokiPushTLS(t *testing.T) {
+	t.Parallel()
+	ca := tlstest.NewCA(t)
+	factory := NewFactory(ca.Manager(t, "loki"))
+	ing, err := factory(glid.New(), map[string]string{"addr": "127.0.0.1:0", "tls": "true", "tls_cert": "loki"}, nil)
+	if err != nil {
+		t.Fatalf("factory: %v", err)
+	}
+	recv := ing.(*Ingester)
+	out := make(chan orchestrator.IngestMessage, 10)
+
+	go recv.Run(t.Context(), out)
+
+	client := &http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{RootCAs: ca.Pool, MinVersion: tls.VersionTLS12},
+	}}
+	body := `{"streams":[{"stream":{"job":"app"},"values":[["1700000000000000000","over https"]]}]}`
+	resp, err := client.Post("https://"+recv.Addr().String()+"/loki/api/v1/push", "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("POST failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", resp.StatusCode)
+	}
+
+	select {
+	case msg := <-out:
+		if string(msg.Raw) != "over https" {
+			t.Errorf("expected 'over https', got %q", msg.Raw)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for message")
+	}
+}
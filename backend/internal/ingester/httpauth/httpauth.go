@@ -0,0 +1,80 @@
+// Package httpauth checks request credentials for HTTP-based listener
+// ingesters (the Loki-compatible HTTP ingester and OTLP over HTTP and gRPC).
+//
+// Settings are read from ingester params:
+//
+//	auth           "bearer", "basic", or empty/"none" to accept any request
+//	auth_token     expected bearer token (auth=bearer)
+//	auth_username  expected username (auth=basic)
+//	auth_password  expected password (auth=basic)
+package httpauth
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Config holds the expected credentials. A nil *Config accepts every request.
+type Config struct {
+	scheme string // "Bearer" or "Basic"
+	secret string // token, or base64("username:password")
+}
+
+// ParseParams reads auth settings from ingester params. It returns nil when
+// auth is not configured.
+func ParseParams(params map[string]string) (*Config, error) {
+	switch mode := params["auth"]; mode {
+	case "", "none":
+		return nil, nil
+	case "bearer":
+		token := params["auth_token"]
+		if token == "" {
+			return nil, errors.New("auth=bearer requires auth_token")
+		}
+		return &Config{scheme: "Bearer", secret: token}, nil
+	case "basic":
+		user, pass := params["auth_username"], params["auth_password"]
+		if user == "" {
+			return nil, errors.New("auth=basic requires auth_username")
+		}
+		if strings.Contains(user, ":") {
+			return nil, errors.New("auth_username must not contain ':'")
+		}
+		return &Config{scheme: "Basic", secret: base64.StdEncoding.EncodeToString([]byte(user + ":" + pass))}, nil
+	default:
+		return nil, fmt.Errorf("invalid auth %q: must be none, bearer or basic", mode)
+	}
+}
+
+// Authorized reports whether an Authorization header value carries the
+// expected credentials. Always true for a nil Config.
+func (c *Config) Authorized(header string) bool {
+	if c == nil {
+		return true
+	}
+	scheme, cred, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, c.scheme) {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimSpace(cred)), []byte(c.secret)) == 1
+}
+
+// Wrap returns a handler that rejects requests without the expected
+// credentials with 401 before calling next. A nil Config returns next.
+func (c *Config) Wrap(next http.Handler) http.Handler {
+	if c == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !c.Authorized(req.Header.Get("Authorization")) {
+			w.Header().Set("WWW-Authenticate", c.scheme+` realm="gastrolog"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
@@ -0,0 +1,74 @@
+package httpauth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseParams(t *testing.T) {
+	for _, params := range []map[string]string{{}, {"auth": "none"}} {
+		cfg, err := ParseParams(params)
+		if err != nil || cfg != nil {
+			t.Errorf("ParseParams(%v) = %v, %v; want nil, nil", params, cfg, err)
+		}
+	}
+	for _, params := range []map[string]string{
+		{"auth": "bearer"},
+		{"auth": "basic", "auth_password": "x"},
+		{"auth": "basic", "auth_username": "a:b"},
+		{"auth": "digest"},
+	} {
+		if _, err := ParseParams(params); err == nil {
+			t.Errorf("ParseParams(%v): expected error", params)
+		}
+	}
+}
+
+func TestAuthorized(t *testing.T) {
+	bearer, _ := ParseParams(map[string]string{"auth": "bearer", "auth_token": "s3cret"})
+	basic, _ := ParseParams(map[string]string{"auth": "basic", "auth_username": "promtail", "auth_password": "pw"})
+
+	for _, tc := range []struct {
+		cfg    *Config
+		header string
+		want   bool
+	}{
+		{bearer, "Bearer s3cret", true},
+		{bearer, "bearer s3cret", true},
+		{bearer, "Bearer wrong", false},
+		{bearer, "Basic s3cret", false},
+		{bearer, "", false},
+		{basic, "Basic cHJvbXRhaWw6cHc=", true}, // promtail:pw
+		{basic, "Basic cHJvbXRhaWw6eHg=", false},
+		{nil, "", true},
+	} {
+		if got := tc.cfg.Authorized(tc.header); got != tc.want {
+			t.Errorf("Authorized(%q) = %v, want %v", tc.header, got, tc.want)
+		}
+	}
+}
+
+func TestWrap(t *testing.T) {
+	cfg, _ := ParseParams(map[string]string{"auth": "bearer", "auth_token": "s3cret"})
+	h := cfg.Wrap(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/push", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("no credentials: status %d, want 401", rec.Code)
+	}
+	if got := rec.Header().Get("WWW-Authenticate"); got == "" {
+		t.Error("missing WWW-Authenticate header")
+	}
+
+	req.Header.Set("Authorization", "Bearer s3cret")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("valid token: status %d, want 204", rec.Code)
+	}
+}
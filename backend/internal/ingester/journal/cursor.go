@@ -0,0 +1,104 @@
+package journal
+
+import (
+	"cmp"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// cursor identifies one journal entry, in the same text format journalctl
+// prints with --show-cursor:
+//
+//	s=<seqnum id>;i=<seqnum>;b=<boot id>;m=<monotonic>;t=<realtime>;x=<xor hash>
+//
+// IDs are 32 lowercase hex digits; numbers are hex.
+type cursor struct {
+	seqnumID  [16]byte
+	seqnum    uint64
+	bootID    [16]byte
+	monotonic uint64
+	realtime  uint64 // microseconds since the epoch
+	xorHash   uint64
+}
+
+// String formats the cursor.
+func (c cursor) String() string {
+	return fmt.Sprintf("s=%s;i=%x;b=%s;m=%x;t=%x;x=%x",
+		hex.EncodeToString(c.seqnumID[:]), c.seqnum,
+		hex.EncodeToString(c.bootID[:]), c.monotonic,
+		c.realtime, c.xorHash)
+}
+
+// parseCursor parses a cursor string. All six fields are required.
+func parseCursor(s string) (cursor, error) {
+	var c cursor
+	seen := 0
+	for part := range strings.SplitSeq(s, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok {
+			return cursor{}, fmt.Errorf("invalid cursor field %q", part)
+		}
+		var err error
+		switch key {
+		case "s":
+			err = parseID(val, &c.seqnumID)
+			seen |= 1 << 0
+		case "i":
+			c.seqnum, err = strconv.ParseUint(val, 16, 64)
+			seen |= 1 << 1
+		case "b":
+			err = parseID(val, &c.bootID)
+			seen |= 1 << 2
+		case "m":
+			c.monotonic, err = strconv.ParseUint(val, 16, 64)
+			seen |= 1 << 3
+		case "t":
+			c.realtime, err = strconv.ParseUint(val, 16, 64)
+			seen |= 1 << 4
+		case "x":
+			c.xorHash, err = strconv.ParseUint(val, 16, 64)
+			seen |= 1 << 5
+		default:
+			return cursor{}, fmt.Errorf("unknown cursor field %q", key)
+		}
+		if err != nil {
+			return cursor{}, fmt.Errorf("invalid cursor field %q: %w", key, err)
+		}
+	}
+	if seen != 1<<6-1 {
+		return cursor{}, errors.New("incomplete cursor")
+	}
+	return c, nil
+}
+
+func parseID(s string, id *[16]byte) error {
+	if len(s) != 32 {
+		return errors.New("id must be 32 hex digits")
+	}
+	_, err := hex.Decode(id[:], []byte(s))
+	return err
+}
+
+// compareCursors orders entries the way sd_journal interleaves files:
+// by sequence number when both come from the same sequence (the same
+// journald instance), else by monotonic time within the same boot, else
+// by wall-clock time. The xor hash breaks remaining ties.
+func compareCursors(a, b cursor) int {
+	if a.seqnumID == b.seqnumID {
+		if c := cmp.Compare(a.seqnum, b.seqnum); c != 0 {
+			return c
+		}
+	}
+	if a.bootID == b.bootID {
+		if c := cmp.Compare(a.monotonic, b.monotonic); c != 0 {
+			return c
+		}
+	}
+	if c := cmp.Compare(a.realtime, b.realtime); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.xorHash, b.xorHash)
+}
@@ -0,0 +1,87 @@
+package journal
+
+import "testing"
+
+func TestCursorRoundTrip(t *testing.T) {
+	c := cursor{
+		seqnumID:  [16]byte{0xde, 0xad},
+		seqnum:    0x1f2e,
+		bootID:    [16]byte{15: 0x01},
+		monotonic: 123456789,
+		realtime:  1767225600000000,
+		xorHash:   0xfeedface,
+	}
+	s := c.String()
+	const want = "s=dead0000000000000000000000000000;i=1f2e;b=00000000000000000000000000000001;m=75bcd15;t=6474846204000;x=feedface"
+	if s != want {
+		t.Errorf("String() = %q, want %q", s, want)
+	}
+	got, err := parseCursor(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != c {
+		t.Errorf("parseCursor(%q) = %+v, want %+v", s, got, c)
+	}
+}
+
+func TestParseCursorErrors(t *testing.T) {
+	valid := cursor{}.String()
+	for _, s := range []string{
+		"",
+		"s=00",
+		valid + ";z=1",
+		valid[:len(valid)-len(";x=0")],
+		"s=zz000000000000000000000000000000;i=0;b=00000000000000000000000000000000;m=0;t=0;x=0",
+		"s=00000000000000000000000000000000;i=g;b=00000000000000000000000000000000;m=0;t=0;x=0",
+	} {
+		if _, err := parseCursor(s); err == nil {
+			t.Errorf("parseCursor(%q): expected error", s)
+		}
+	}
+}
+
+func TestCompareCursors(t *testing.T) {
+	seq, boot := [16]byte{1}, [16]byte{2}
+	tests := []struct {
+		name string
+		a, b cursor
+		want int
+	}{
+		{
+			name: "same sequence orders by seqnum despite clocks",
+			a:    cursor{seqnumID: seq, seqnum: 1, bootID: boot, monotonic: 9, realtime: 9},
+			b:    cursor{seqnumID: seq, seqnum: 2, bootID: boot, monotonic: 1, realtime: 1},
+			want: -1,
+		},
+		{
+			name: "same boot orders by monotonic despite wall clock",
+			a:    cursor{seqnumID: [16]byte{3}, bootID: boot, monotonic: 5, realtime: 9},
+			b:    cursor{seqnumID: [16]byte{4}, bootID: boot, monotonic: 6, realtime: 1},
+			want: -1,
+		},
+		{
+			name: "different boots order by realtime",
+			a:    cursor{bootID: [16]byte{5}, monotonic: 1, realtime: 20},
+			b:    cursor{bootID: [16]byte{6}, monotonic: 9, realtime: 10},
+			want: 1,
+		},
+		{
+			name: "xor hash breaks ties",
+			a:    cursor{bootID: [16]byte{5}, realtime: 10, xorHash: 1},
+			b:    cursor{bootID: [16]byte{6}, realtime: 10, xorHash: 2},
+			want: -1,
+		},
+		{
+			name: "equal",
+			a:    cursor{seqnumID: seq, seqnum: 7},
+			b:    cursor{seqnumID: seq, seqnum: 7},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		if got := compareCursors(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: compareCursors = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package journal
+
+import (
+	"testing"
+	"time"
+
+	"gastrolog/internal/ingester/identitytest"
+)
+
+// TestEventIDIdentity pins gastrolog-44b9r for the journal ingester.
+// We drive buildMessage directly — the seam where IngesterID and
+// IngestTS land on the IngestMessage.
+func TestEventIDIdentity(t *testing.T) {
+	t.Parallel()
+	const ingesterID = "test-journal-ingester"
+	fields := map[string][]byte{"MESSAGE": []byte("identity probe")}
+	msg, ok := buildMessage(fields, cursor{realtime: 1}, ingesterID, time.Now())
+	if !ok {
+		t.Fatal("buildMessage skipped the entry")
+	}
+	identitytest.AssertHasIdentity(t, msg, ingesterID)
+}
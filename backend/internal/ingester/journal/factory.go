@@ -0,0 +1,100 @@
+package journal
+
+import (
+	"fmt"
+	"gastrolog/internal/glid"
+	"log/slog"
+	"path/filepath"
+	"time"
+
+	"gastrolog/internal/logging"
+	"gastrolog/internal/orchestrator"
+)
+
+// DefaultDir is where journald keeps persistent journals.
+const DefaultDir = "/var/log/journal"
+
+// ParamDefaults returns the default parameter values for a journal ingester.
+func ParamDefaults() map[string]string {
+	return map[string]string{
+		"dir":           DefaultDir,
+		"poll_interval": "1s",
+		"read_from":     "end",
+	}
+}
+
+// NewFactory returns an IngesterFactory for systemd journal ingesters.
+func NewFactory() orchestrator.IngesterFactory {
+	return func(id glid.GLID, params map[string]string, logger *slog.Logger) (orchestrator.Ingester, error) {
+		cfg, err := parseConfig(id.String(), params, logger)
+		if err != nil {
+			return nil, err
+		}
+		return newIngester(cfg), nil
+	}
+}
+
+// config holds parsed configuration for a journal ingester.
+type config struct {
+	ID           string
+	Dir          string
+	PollInterval time.Duration
+	FromStart    bool // without a cursor, read existing entries too
+	StateFile    string
+	Logger       *slog.Logger
+}
+
+func parseConfig(id string, params map[string]string, logger *slog.Logger) (config, error) {
+	dir := params["dir"]
+	if dir == "" {
+		dir = DefaultDir
+	}
+
+	pollInterval := time.Second
+	if v := params["poll_interval"]; v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return config{}, fmt.Errorf("journal ingester %q: invalid poll_interval %q: %w", id, v, err)
+		}
+		if d <= 0 {
+			return config{}, fmt.Errorf("journal ingester %q: poll_interval must be positive", id)
+		}
+		pollInterval = d
+	}
+
+	var fromStart bool
+	switch v := params["read_from"]; v {
+	case "", "end":
+	case "beginning":
+		fromStart = true
+	default:
+		return config{}, fmt.Errorf("journal ingester %q: invalid read_from %q: must be end or beginning", id, v)
+	}
+
+	var stateFile string
+	if stateDir := params["_state_dir"]; stateDir != "" {
+		stateFile = filepath.Join(stateDir, "state", "journal", id+".json")
+	}
+
+	return config{
+		ID:           id,
+		Dir:          dir,
+		PollInterval: pollInterval,
+		FromStart:    fromStart,
+		StateFile:    stateFile,
+		Logger:       logging.Default(logger).With("component", "ingester", "type", "journal", "instance", id),
+	}, nil
+}
+
+// newIngester creates a journal ingester from parsed config.
+func newIngester(cfg config) *ingester {
+	return &ingester{
+		id:           cfg.ID,
+		dir:          cfg.Dir,
+		pollInterval: cfg.PollInterval,
+		fromStart:    cfg.FromStart,
+		stateFile:    cfg.StateFile,
+		logger:       cfg.Logger,
+		failed:       make(map[string]bool),
+	}
+}
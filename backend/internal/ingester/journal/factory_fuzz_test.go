@@ -0,0 +1,79 @@
+package journal
+
+import (
+	"bytes"
+	"gastrolog/internal/glid"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func FuzzNewFactory(f *testing.F) {
+	logger := slog.New(slog.NewTextHandler(&bytes.Buffer{}, nil))
+	factory := NewFactory()
+	id := glid.New()
+
+	// Seed: valid params.
+	f.Add([]byte("dir\x00/var/log/journal\x00poll_interval\x00500ms\x00read_from\x00beginning"))
+	f.Add([]byte("read_from\x00end"))
+	// Seed: invalid values.
+	f.Add([]byte("poll_interval\x00-1s"))
+	f.Add([]byte("read_from\x00yesterday"))
+	f.Add([]byte(""))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		params := splitParams(data)
+		ing, err := factory(id, params, logger)
+		if err != nil {
+			return
+		}
+		if ing == nil {
+			t.Fatal("nil ingester without error")
+		}
+	})
+}
+
+// FuzzJournalFile reads arbitrary bytes as a journal file; corrupt files
+// must produce errors, not panics or endless loops.
+func FuzzJournalFile(f *testing.F) {
+	for _, opts := range [][]journalOption{nil, {withCompact(), withCompression(objCompressedLZ4)}} {
+		seedPath := filepath.Join(f.TempDir(), "seed.journal")
+		j := newTestJournal(f, seedPath, opts...)
+		messages(f, j, time.Unix(0, 0), "one", "two", "three", "four")
+		seed, err := os.ReadFile(seedPath)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(seed)
+	}
+
+	// Inputs run one at a time per worker process, so one path suffices.
+	path := filepath.Join(f.TempDir(), "fuzz.journal")
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		jf, err := openJournalFile(path)
+		if err != nil {
+			return
+		}
+		defer func() { _ = jf.close() }()
+		_, _ = jf.end()
+		refs, _, _ := jf.next(position{}, 100)
+		for _, ref := range refs {
+			_, _, _ = jf.readFields(ref.offset)
+		}
+	})
+}
+
+// splitParams splits fuzz bytes on null into alternating key/value pairs.
+func splitParams(data []byte) map[string]string {
+	parts := bytes.Split(data, []byte{0})
+	m := make(map[string]string, len(parts)/2)
+	for i := 0; i+1 < len(parts); i += 2 {
+		m[string(parts[i])] = string(parts[i+1])
+	}
+	return m
+}
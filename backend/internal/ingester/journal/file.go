@@ -0,0 +1,431 @@
+package journal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Journal file format, as documented in systemd's JOURNAL_FILE_FORMAT.md.
+// All integers are little-endian and all objects are 8-byte aligned. Only
+// the parts needed to read entries in order are parsed: the header, the
+// entry array chain, entry objects and the data objects they reference.
+// Hash tables are ignored.
+
+const (
+	signature = "LPKSHHRH"
+
+	// Header field offsets.
+	hdrIncompatibleFlags = 12
+	hdrState             = 16
+	hdrFileID            = 24
+	hdrSeqnumID          = 72
+	hdrHeaderSize        = 88
+	hdrNEntries          = 152
+	hdrTailEntrySeqnum   = 160
+	hdrEntryArrayOffset  = 176
+	hdrMinSize           = 208 // header size since systemd 187
+
+	// Incompatible header flags.
+	incompatCompressedXZ   = 1 << 0
+	incompatCompressedLZ4  = 1 << 1
+	incompatKeyedHash      = 1 << 2
+	incompatCompressedZSTD = 1 << 3
+	incompatCompact        = 1 << 4
+	incompatSupported      = incompatCompressedXZ | incompatCompressedLZ4 |
+		incompatKeyedHash | incompatCompressedZSTD | incompatCompact
+
+	stateArchived = 2
+
+	// Object types.
+	objectData       = 1
+	objectEntry      = 3
+	objectEntryArray = 6
+
+	// Object compression flags.
+	objCompressedXZ   = 1 << 0
+	objCompressedLZ4  = 1 << 1
+	objCompressedZSTD = 1 << 2
+
+	objectHeaderSize = 16
+
+	// Entry object layout.
+	entrySeqnum    = 16
+	entryRealtime  = 24
+	entryMonotonic = 32
+	entryBootID    = 40
+	entryXorHash   = 56
+	entryItems     = 64
+
+	// Entry array object layout.
+	entryArrayNext  = 16
+	entryArrayItems = 24
+
+	// Data object payload offset, regular and compact.
+	dataPayload        = 64
+	dataPayloadCompact = 72
+
+	// maxObjectSize bounds a single object read, so a corrupt size field
+	// can't trigger a huge allocation. journald itself caps fields well
+	// below this.
+	maxObjectSize = 64 << 20
+)
+
+// errUnsupported marks files this reader cannot parse at all.
+var errUnsupported = errors.New("unsupported journal file")
+
+// zstdDec decodes zstd-compressed data objects (the default compression
+// since systemd 246). DecodeAll is safe for concurrent use.
+var zstdDec, _ = zstd.NewReader(nil,
+	zstd.WithDecoderConcurrency(1),
+	zstd.WithDecoderMaxMemory(maxObjectSize),
+)
+
+// position is a read position in a file's entry array chain.
+type position struct {
+	array    uint64 // offset of the current entry array object; 0 = chain head
+	index    int    // next item within that array
+	consumed uint64 // entries passed so far
+}
+
+// entryRef is an entry located in a file, with its ordering key and the
+// read position just past it.
+type entryRef struct {
+	file   *journalFile
+	offset uint64
+	key    cursor
+	next   position
+}
+
+// journalFile is an open journal file being followed.
+type journalFile struct {
+	path     string
+	f        *os.File
+	info     os.FileInfo
+	fileID   [16]byte
+	seqnumID [16]byte
+	compact  bool
+	pos      position
+
+	// done is set once an archived file has been read to the end; it
+	// will never grow again.
+	done bool
+}
+
+// header holds the header fields read on each poll.
+type header struct {
+	state            uint8
+	nEntries         uint64
+	tailEntrySeqnum  uint64
+	entryArrayOffset uint64
+}
+
+// openJournalFile opens a journal file and validates its header.
+func openJournalFile(path string) (*journalFile, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	buf := make([]byte, hdrMinSize)
+	if _, err := f.ReadAt(buf, 0); err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("%w: short header: %w", errUnsupported, err)
+	}
+	if string(buf[:8]) != signature {
+		_ = f.Close()
+		return nil, fmt.Errorf("%w: bad signature", errUnsupported)
+	}
+	incompat := binary.LittleEndian.Uint32(buf[hdrIncompatibleFlags:])
+	if incompat&^incompatSupported != 0 {
+		_ = f.Close()
+		return nil, fmt.Errorf("%w: incompatible flags %#x", errUnsupported, incompat)
+	}
+	if binary.LittleEndian.Uint64(buf[hdrHeaderSize:]) < hdrMinSize {
+		_ = f.Close()
+		return nil, fmt.Errorf("%w: header too small", errUnsupported)
+	}
+
+	jf := &journalFile{
+		path:    path,
+		f:       f,
+		info:    info,
+		compact: incompat&incompatCompact != 0,
+	}
+	copy(jf.fileID[:], buf[hdrFileID:])
+	copy(jf.seqnumID[:], buf[hdrSeqnumID:])
+	return jf, nil
+}
+
+func (jf *journalFile) close() error {
+	return jf.f.Close()
+}
+
+// readHeader re-reads the header fields that change as journald appends.
+func (jf *journalFile) readHeader() (header, error) {
+	buf := make([]byte, hdrMinSize)
+	if _, err := jf.f.ReadAt(buf, 0); err != nil {
+		return header{}, err
+	}
+	return header{
+		state:            buf[hdrState],
+		nEntries:         binary.LittleEndian.Uint64(buf[hdrNEntries:]),
+		tailEntrySeqnum:  binary.LittleEndian.Uint64(buf[hdrTailEntrySeqnum:]),
+		entryArrayOffset: binary.LittleEndian.Uint64(buf[hdrEntryArrayOffset:]),
+	}, nil
+}
+
+// readObject reads the object at offset, checking its type.
+func (jf *journalFile) readObject(offset uint64, typ uint8) ([]byte, error) {
+	if offset == 0 || offset%8 != 0 {
+		return nil, fmt.Errorf("invalid object offset %d", offset)
+	}
+	var hdr [objectHeaderSize]byte
+	if _, err := jf.f.ReadAt(hdr[:], int64(offset)); err != nil {
+		return nil, fmt.Errorf("object at %d: %w", offset, err)
+	}
+	if hdr[0] != typ {
+		return nil, fmt.Errorf("object at %d: type %d, want %d", offset, hdr[0], typ)
+	}
+	size := binary.LittleEndian.Uint64(hdr[8:])
+	if size < objectHeaderSize || size > maxObjectSize {
+		return nil, fmt.Errorf("object at %d: invalid size %d", offset, size)
+	}
+	obj := make([]byte, size)
+	if _, err := jf.f.ReadAt(obj, int64(offset)); err != nil {
+		return nil, fmt.Errorf("object at %d: %w", offset, err)
+	}
+	return obj, nil
+}
+
+// itemSize is the width of entry array and entry items: offsets are 32-bit
+// in compact files.
+func (jf *journalFile) itemSize(entryItem bool) int {
+	switch {
+	case jf.compact:
+		return 4
+	case entryItem:
+		return 16 // object offset + hash
+	default:
+		return 8
+	}
+}
+
+func (jf *journalFile) item(buf []byte) uint64 {
+	if jf.compact {
+		return uint64(binary.LittleEndian.Uint32(buf))
+	}
+	return binary.LittleEndian.Uint64(buf)
+}
+
+// next returns up to limit entries following pos. The file's own
+// position is not changed; callers advance it to the next field of the
+// last entry they consume. full reports whether limit was reached, i.e.
+// more entries may be available right away.
+func (jf *journalFile) next(pos position, limit int) (refs []entryRef, full bool, err error) {
+	h, err := jf.readHeader()
+	if err != nil {
+		return nil, false, err
+	}
+	if pos.consumed >= h.nEntries {
+		return nil, false, nil
+	}
+	if pos.array == 0 {
+		if h.entryArrayOffset == 0 {
+			return nil, false, nil
+		}
+		pos.array = h.entryArrayOffset
+	}
+
+	for len(refs) < limit && pos.consumed < h.nEntries {
+		arr, err := jf.readObject(pos.array, objectEntryArray)
+		if err != nil {
+			return refs, false, err
+		}
+		if len(arr) < entryArrayItems {
+			return refs, false, fmt.Errorf("entry array at %d too small", pos.array)
+		}
+		size := jf.itemSize(false)
+		capacity := (len(arr) - entryArrayItems) / size
+
+		for pos.index < capacity && len(refs) < limit && pos.consumed < h.nEntries {
+			off := jf.item(arr[entryArrayItems+pos.index*size:])
+			if off == 0 {
+				return refs, false, nil // not written yet
+			}
+			key, err := jf.entryKey(off)
+			if err != nil {
+				return refs, false, err
+			}
+			pos.index++
+			pos.consumed++
+			refs = append(refs, entryRef{file: jf, offset: off, key: key, next: pos})
+		}
+
+		if pos.index < capacity {
+			break
+		}
+		nextArray := binary.LittleEndian.Uint64(arr[entryArrayNext:])
+		if nextArray == 0 {
+			break // journald hasn't linked the next array yet
+		}
+		if nextArray <= pos.array {
+			return refs, false, fmt.Errorf("entry array at %d links backwards", pos.array)
+		}
+		pos = position{array: nextArray, consumed: pos.consumed}
+		// Entries past the end of one array continue in the next; keep
+		// the last returned ref pointing at the new array so a caller
+		// resuming from it doesn't re-read the full one.
+		if n := len(refs); n > 0 {
+			refs[n-1].next = pos
+		}
+	}
+	return refs, len(refs) >= limit, nil
+}
+
+// end returns the position past the last entry currently in the file.
+// Only the entry arrays are read, not the entries.
+func (jf *journalFile) end() (position, error) {
+	h, err := jf.readHeader()
+	if err != nil || h.entryArrayOffset == 0 {
+		return position{}, err
+	}
+	pos := position{array: h.entryArrayOffset}
+	size := jf.itemSize(false)
+	for {
+		arr, err := jf.readObject(pos.array, objectEntryArray)
+		if err != nil {
+			return position{}, err
+		}
+		if len(arr) < entryArrayItems {
+			return position{}, fmt.Errorf("entry array at %d too small", pos.array)
+		}
+		capacity := (len(arr) - entryArrayItems) / size
+		nextArray := binary.LittleEndian.Uint64(arr[entryArrayNext:])
+		if nextArray != 0 {
+			// Arrays are appended, so the chain only moves forward; a
+			// corrupt file could otherwise loop forever.
+			if nextArray <= pos.array {
+				return position{}, fmt.Errorf("entry array at %d links backwards", pos.array)
+			}
+			pos = position{array: nextArray, consumed: pos.consumed + uint64(capacity)}
+			continue
+		}
+		for pos.index < capacity && pos.consumed < h.nEntries &&
+			jf.item(arr[entryArrayItems+pos.index*size:]) != 0 {
+			pos.index++
+			pos.consumed++
+		}
+		return pos, nil
+	}
+}
+
+// entryKey reads the ordering key of the entry at offset.
+func (jf *journalFile) entryKey(offset uint64) (cursor, error) {
+	var buf [entryItems]byte
+	if _, err := jf.f.ReadAt(buf[:], int64(offset)); err != nil {
+		return cursor{}, fmt.Errorf("entry at %d: %w", offset, err)
+	}
+	if buf[0] != objectEntry {
+		return cursor{}, fmt.Errorf("entry at %d: type %d", offset, buf[0])
+	}
+	c := cursor{
+		seqnumID:  jf.seqnumID,
+		seqnum:    binary.LittleEndian.Uint64(buf[entrySeqnum:]),
+		realtime:  binary.LittleEndian.Uint64(buf[entryRealtime:]),
+		monotonic: binary.LittleEndian.Uint64(buf[entryMonotonic:]),
+		xorHash:   binary.LittleEndian.Uint64(buf[entryXorHash:]),
+	}
+	copy(c.bootID[:], buf[entryBootID:])
+	return c, nil
+}
+
+// readFields reads all FIELD=value pairs of the entry at offset. A field
+// that cannot be decoded (e.g. XZ-compressed) is skipped and reported in
+// skipped; the others are still returned.
+func (jf *journalFile) readFields(offset uint64) (fields map[string][]byte, skipped int, err error) {
+	obj, err := jf.readObject(offset, objectEntry)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(obj) < entryItems {
+		return nil, 0, fmt.Errorf("entry at %d too small", offset)
+	}
+	size := jf.itemSize(true)
+	n := (len(obj) - entryItems) / size
+	fields = make(map[string][]byte, n)
+	for i := range n {
+		dataOff := jf.item(obj[entryItems+i*size:])
+		name, value, err := jf.readData(dataOff)
+		if err != nil {
+			skipped++
+			continue
+		}
+		fields[name] = value
+	}
+	return fields, skipped, nil
+}
+
+// readData reads one data object and splits its payload at '='.
+func (jf *journalFile) readData(offset uint64) (string, []byte, error) {
+	obj, err := jf.readObject(offset, objectData)
+	if err != nil {
+		return "", nil, err
+	}
+	start := dataPayload
+	if jf.compact {
+		start = dataPayloadCompact
+	}
+	if len(obj) < start {
+		return "", nil, fmt.Errorf("data at %d too small", offset)
+	}
+	payload := obj[start:]
+
+	switch flags := obj[1]; {
+	case flags&objCompressedZSTD != 0:
+		payload, err = zstdDec.DecodeAll(payload, nil)
+	case flags&objCompressedLZ4 != 0:
+		payload, err = decodeLZ4(payload, maxObjectSize)
+	case flags&objCompressedXZ != 0:
+		err = errors.New("xz compression is not supported")
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("data at %d: %w", offset, err)
+	}
+
+	name, value, ok := bytes.Cut(payload, []byte("="))
+	if !ok {
+		return "", nil, fmt.Errorf("data at %d: no '=' in field", offset)
+	}
+	return string(name), value, nil
+}
+
+// discover lists journal files in dir and its immediate subdirectories
+// (journald stores them under /var/log/journal/<machine-id>/). Files
+// ending in "~" were not closed cleanly and are skipped, as journald does.
+func discover(dir string) ([]string, error) {
+	var paths []string
+	for _, pattern := range []string{"*.journal", "*/*.journal"} {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, matches...)
+	}
+	if len(paths) == 0 {
+		if _, err := os.Stat(dir); err != nil {
+			return nil, err
+		}
+	}
+	return paths, nil
+}
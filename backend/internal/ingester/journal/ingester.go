@@ -0,0 +1,391 @@
+// Package journal provides an ingester that reads systemd journal files
+// directly, without cgo, libsystemd or a running journald.
+package journal
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"gastrolog/internal/chanwatch"
+	"gastrolog/internal/ingester/syslogparse"
+	"gastrolog/internal/orchestrator"
+)
+
+// batchSize is the number of entries read from each file per round.
+const batchSize = 1000
+
+// ingester follows the journal files in a directory, emitting entries in
+// the order journalctl would show them.
+type ingester struct {
+	id           string
+	dir          string
+	pollInterval time.Duration
+	fromStart    bool
+	stateFile    string
+	logger       *slog.Logger
+
+	files  []*journalFile
+	failed map[string]bool // paths that couldn't be opened, to log once
+
+	mu     sync.Mutex
+	cursor cursor
+	seeded bool // cursor holds a real entry
+
+	restoredState *state // checkpoint loaded before Run()
+
+	// pressureGate pauses reading between batches while the ingest
+	// pipeline is backed up. Journal files just keep growing meanwhile;
+	// nothing is lost. See gastrolog-4fguu.
+	pressureGate *chanwatch.PressureGate
+}
+
+// SetPressureGate wires the orchestrator's pressure gate into the ingester.
+// Implements orchestrator.PressureAware.
+func (ing *ingester) SetPressureGate(gate *chanwatch.PressureGate) {
+	ing.pressureGate = gate
+}
+
+// SaveCheckpoint returns the cursor of the last emitted entry as a JSON blob.
+// Implements orchestrator.Checkpointable.
+func (ing *ingester) SaveCheckpoint() ([]byte, error) {
+	st, ok := ing.state()
+	if !ok {
+		return nil, nil
+	}
+	return json.Marshal(st)
+}
+
+// LoadCheckpoint restores the cursor from a JSON blob. Called before Run().
+// Implements orchestrator.Checkpointable.
+func (ing *ingester) LoadCheckpoint(data []byte) error {
+	var st state
+	if err := json.Unmarshal(data, &st); err != nil {
+		return err
+	}
+	if _, err := parseCursor(st.Cursor); err != nil {
+		return err
+	}
+	ing.restoredState = &st
+	return nil
+}
+
+func (ing *ingester) state() (state, bool) {
+	ing.mu.Lock()
+	defer ing.mu.Unlock()
+	if !ing.seeded {
+		return state{}, false
+	}
+	return state{Cursor: ing.cursor.String()}, true
+}
+
+// Run implements orchestrator.Ingester.
+func (ing *ingester) Run(ctx context.Context, out chan<- orchestrator.IngestMessage) error {
+	// Load state: prefer Raft-replicated checkpoint, fall back to local file.
+	var st state
+	if ing.restoredState != nil {
+		st = *ing.restoredState
+		ing.restoredState = nil
+	} else {
+		var err error
+		st, err = loadState(ing.stateFile)
+		if err != nil {
+			ing.logger.Warn("failed to load state, starting fresh", "error", err)
+		}
+	}
+	if st.Cursor != "" {
+		c, err := parseCursor(st.Cursor)
+		if err != nil {
+			ing.logger.Warn("invalid saved cursor, starting fresh", "cursor", st.Cursor, "error", err)
+		} else {
+			ing.mu.Lock()
+			ing.cursor, ing.seeded = c, true
+			ing.mu.Unlock()
+		}
+	}
+
+	defer func() {
+		for _, jf := range ing.files {
+			_ = jf.close()
+		}
+		ing.files = nil
+		if st, ok := ing.state(); ok {
+			if err := saveState(ing.stateFile, st); err != nil {
+				ing.logger.Warn("failed to save state on shutdown", "error", err)
+			}
+		}
+	}()
+
+	// Without a cursor, files present at startup are read from the end
+	// (or the beginning, if configured), like tail without a bookmark.
+	ing.mu.Lock()
+	seeded := ing.seeded
+	ing.mu.Unlock()
+	ing.scan(!seeded && !ing.fromStart)
+	ing.logger.Info("journal ingester starting", "dir", ing.dir, "files", len(ing.files))
+
+	ticker := time.NewTicker(ing.pollInterval)
+	defer ticker.Stop()
+	for {
+		if !ing.readAll(ctx, out) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			ing.scan(false)
+		}
+	}
+}
+
+// scan reconciles the tracked files with the directory: new files are
+// opened (positioned at their end if atEnd, else at their beginning),
+// renamed files keep their position, and deleted files are closed.
+func (ing *ingester) scan(atEnd bool) {
+	paths, err := discover(ing.dir)
+	if err != nil {
+		ing.logger.Warn("failed to list journal files", "dir", ing.dir, "error", err)
+		return
+	}
+
+	seen := make(map[*journalFile]bool, len(ing.files))
+	present := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		present[path] = true
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		if jf := ing.tracked(info); jf != nil {
+			// journald archives a file by renaming it; keep reading it.
+			jf.path = path
+			seen[jf] = true
+			continue
+		}
+		if ing.failed[path] {
+			continue
+		}
+
+		jf, err := openJournalFile(path)
+		if err != nil {
+			ing.logger.Warn("cannot read journal file", "path", path, "error", err)
+			ing.failed[path] = true
+			continue
+		}
+		if atEnd || ing.archivedBeforeCursor(jf) {
+			if jf.pos, err = jf.end(); err != nil {
+				ing.logger.Warn("cannot find end of journal file", "path", path, "error", err)
+			}
+		}
+		ing.files = append(ing.files, jf)
+		seen[jf] = true
+		ing.logger.Debug("following journal file", "path", path, "entries", jf.pos.consumed)
+	}
+
+	// Drop files that were removed (e.g. vacuumed).
+	kept := ing.files[:0]
+	for _, jf := range ing.files {
+		if seen[jf] {
+			kept = append(kept, jf)
+			continue
+		}
+		ing.logger.Debug("journal file removed", "path", jf.path)
+		_ = jf.close()
+	}
+	clear(ing.files[len(kept):])
+	ing.files = kept
+
+	for path := range ing.failed {
+		if !present[path] {
+			delete(ing.failed, path)
+		}
+	}
+}
+
+// archivedBeforeCursor reports whether jf is an archived file whose entries
+// all precede the cursor, so it can be skipped without reading them.
+// Archived files of the same journald instance share its sequence number
+// space, which makes the check exact.
+func (ing *ingester) archivedBeforeCursor(jf *journalFile) bool {
+	ing.mu.Lock()
+	c, seeded := ing.cursor, ing.seeded
+	ing.mu.Unlock()
+	if !seeded || jf.seqnumID != c.seqnumID {
+		return false
+	}
+	h, err := jf.readHeader()
+	return err == nil && h.state == stateArchived && h.tailEntrySeqnum <= c.seqnum
+}
+
+func (ing *ingester) tracked(info os.FileInfo) *journalFile {
+	for _, jf := range ing.files {
+		if os.SameFile(jf.info, info) {
+			return jf
+		}
+	}
+	return nil
+}
+
+// readAll emits all new entries across files, interleaved in order.
+// It returns false if ctx was cancelled.
+func (ing *ingester) readAll(ctx context.Context, out chan<- orchestrator.IngestMessage) bool {
+	for {
+		if ing.pressureGate != nil {
+			if err := ing.pressureGate.Wait(ctx); err != nil {
+				return false
+			}
+		}
+
+		more, err := ing.readBatch(ctx, out)
+		if err != nil {
+			return false
+		}
+		if !more {
+			return true
+		}
+	}
+}
+
+// readBatch reads up to batchSize entries from each file and emits them
+// merged in cursor order. It reports whether any file had more entries
+// than were read.
+//
+// A file whose batch was cut off may hold entries that sort before
+// another file's later entries, so the merge stops as soon as such a file
+// runs out; the rest is picked up in the next batch.
+func (ing *ingester) readBatch(ctx context.Context, out chan<- orchestrator.IngestMessage) (bool, error) {
+	type pending struct {
+		refs []entryRef
+		full bool
+	}
+	batches := make([]pending, 0, len(ing.files))
+	more := false
+	for _, jf := range ing.files {
+		if jf.done {
+			continue
+		}
+		refs, full, err := jf.next(jf.pos, batchSize)
+		if err != nil {
+			// Usually journald is mid-write; retry on the next poll.
+			ing.logger.Debug("journal read error", "path", jf.path, "error", err)
+		}
+		if len(refs) == 0 && err == nil {
+			if h, err := jf.readHeader(); err == nil && h.state == stateArchived {
+				jf.done = true
+			}
+		}
+		if len(refs) > 0 {
+			batches = append(batches, pending{refs: refs, full: full})
+		}
+		more = more || full
+	}
+
+	for {
+		// Pick the earliest head entry.
+		best := -1
+		for i, b := range batches {
+			if len(b.refs) == 0 {
+				if b.full {
+					return true, nil
+				}
+				continue
+			}
+			if best < 0 || compareCursors(b.refs[0].key, batches[best].refs[0].key) < 0 {
+				best = i
+			}
+		}
+		if best < 0 {
+			return more, nil
+		}
+		ref := batches[best].refs[0]
+		batches[best].refs = batches[best].refs[1:]
+
+		if err := ing.emit(ctx, ref, out); err != nil {
+			return false, err
+		}
+	}
+}
+
+// emit sends one entry unless it is at or before the cursor, then
+// advances the file position and the cursor past it.
+func (ing *ingester) emit(ctx context.Context, ref entryRef, out chan<- orchestrator.IngestMessage) error {
+	ing.mu.Lock()
+	skip := ing.seeded && compareCursors(ref.key, ing.cursor) <= 0
+	ing.mu.Unlock()
+
+	if !skip {
+		fields, skipped, err := ref.file.readFields(ref.offset)
+		if err != nil {
+			ing.logger.Warn("skipping unreadable journal entry", "path", ref.file.path, "cursor", ref.key.String(), "error", err)
+		} else {
+			if skipped > 0 {
+				ing.logger.Debug("skipped undecodable journal fields", "path", ref.file.path, "count", skipped)
+			}
+			if msg, ok := buildMessage(fields, ref.key, ing.id, time.Now()); ok {
+				select {
+				case out <- msg:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+	}
+
+	ref.file.pos = ref.next
+	if !skip {
+		ing.mu.Lock()
+		ing.cursor, ing.seeded = ref.key, true
+		ing.mu.Unlock()
+	}
+	return nil
+}
+
+// buildMessage converts journal fields to an IngestMessage. Entries
+// without a MESSAGE field are skipped.
+func buildMessage(fields map[string][]byte, key cursor, ingesterID string, now time.Time) (orchestrator.IngestMessage, bool) {
+	raw, ok := fields["MESSAGE"]
+	if !ok {
+		return orchestrator.IngestMessage{}, false
+	}
+
+	attrs := map[string]string{"ingester_type": "journal"}
+	set := func(attr, field string) {
+		if v := fields[field]; len(v) > 0 {
+			attrs[attr] = string(v)
+		}
+	}
+	set("hostname", "_HOSTNAME")
+	set("unit", "_SYSTEMD_UNIT")
+	set("pid", "_PID")
+	set("transport", "_TRANSPORT")
+	set("app_name", "_COMM")
+	set("app_name", "SYSLOG_IDENTIFIER")
+	if p, err := strconv.Atoi(string(fields["PRIORITY"])); err == nil {
+		attrs["severity"] = strconv.Itoa(p)
+		attrs["severity_name"] = syslogparse.SeverityName(p)
+	}
+	if f, err := strconv.Atoi(string(fields["SYSLOG_FACILITY"])); err == nil {
+		attrs["facility"] = strconv.Itoa(f)
+		attrs["facility_name"] = syslogparse.FacilityName(f)
+	}
+
+	// The sender's own timestamp, when it supplied one, is more accurate
+	// than the time journald received the entry.
+	sourceTS := time.UnixMicro(int64(key.realtime)) //nolint:gosec // journal timestamps fit in int64
+	if v, err := strconv.ParseInt(string(fields["_SOURCE_REALTIME_TIMESTAMP"]), 10, 64); err == nil && v > 0 {
+		sourceTS = time.UnixMicro(v)
+	}
+
+	return orchestrator.IngestMessage{
+		Attrs:      attrs,
+		Raw:        raw,
+		SourceTS:   sourceTS,
+		IngestTS:   now,
+		IngesterID: ingesterID,
+	}, true
+}
@@ -0,0 +1,299 @@
+package journal
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"gastrolog/internal/orchestrator"
+)
+
+var t0 = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func testIngester(t *testing.T, dir string, fromStart bool) *ingester {
+	t.Helper()
+	cfg, err := parseConfig("test", map[string]string{"dir": dir, "poll_interval": "10ms"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.FromStart = fromStart
+	return newIngester(cfg)
+}
+
+// run starts ing and returns a stop function that cancels it and waits
+// for Run to return.
+func run(t *testing.T, ing *ingester, out chan orchestrator.IngestMessage) (stop func()) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error, 1)
+	go func() { done <- ing.Run(ctx, out) }()
+	return func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("Run: %v", err)
+		}
+	}
+}
+
+// collect receives n messages and returns their raw lines.
+func collect(t *testing.T, out <-chan orchestrator.IngestMessage, n int) []string {
+	t.Helper()
+	var raws []string
+	for range n {
+		select {
+		case m := <-out:
+			raws = append(raws, string(m.Raw))
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out after %d of %d messages: %v", len(raws), n, raws)
+		}
+	}
+	return raws
+}
+
+func expectNone(t *testing.T, out <-chan orchestrator.IngestMessage) {
+	t.Helper()
+	select {
+	case m := <-out:
+		t.Fatalf("unexpected message %q", m.Raw)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestJournalReadsEntries(t *testing.T) {
+	for name, opts := range map[string][]journalOption{
+		"regular":     nil,
+		"compact":     {withCompact()},
+		"zstd":        {withCompression(objCompressedZSTD)},
+		"lz4 compact": {withCompact(), withCompression(objCompressedLZ4)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			j := newTestJournal(t, filepath.Join(dir, "system.journal"), opts...)
+			j.add(t0, "MESSAGE=Started nginx.service", "_HOSTNAME=web01", "_SYSTEMD_UNIT=nginx.service",
+				"_PID=812", "PRIORITY=3", "SYSLOG_FACILITY=3", "SYSLOG_IDENTIFIER=systemd",
+				"_COMM=systemd", "_TRANSPORT=journal",
+				"_SOURCE_REALTIME_TIMESTAMP="+strconv.FormatInt(t0.Add(-time.Millisecond).UnixMicro(), 10))
+			messages(t, j, t0.Add(time.Second), "b", "c", "d", "e") // crosses entry arrays
+
+			out := make(chan orchestrator.IngestMessage, 10)
+			defer run(t, testIngester(t, dir, true), out)()
+
+			first := <-out
+			if string(first.Raw) != "Started nginx.service" {
+				t.Errorf("raw = %q", first.Raw)
+			}
+			want := map[string]string{
+				"ingester_type": "journal",
+				"hostname":      "web01",
+				"unit":          "nginx.service",
+				"pid":           "812",
+				"severity":      "3",
+				"severity_name": "err",
+				"facility":      "3",
+				"facility_name": "daemon",
+				"app_name":      "systemd",
+				"transport":     "journal",
+			}
+			for k, v := range want {
+				if first.Attrs[k] != v {
+					t.Errorf("attr %s = %q, want %q", k, first.Attrs[k], v)
+				}
+			}
+			if !first.SourceTS.Equal(t0.Add(-time.Millisecond)) {
+				t.Errorf("SourceTS = %v, want the source timestamp", first.SourceTS)
+			}
+			if got := collect(t, out, 4); !slices.Equal(got, []string{"b", "c", "d", "e"}) {
+				t.Errorf("got %v", got)
+			}
+		})
+	}
+}
+
+func TestJournalStartsAtEnd(t *testing.T) {
+	dir := t.TempDir()
+	j := newTestJournal(t, filepath.Join(dir, "system.journal"))
+	messages(t, j, t0, "old1", "old2")
+
+	out := make(chan orchestrator.IngestMessage, 10)
+	ing := testIngester(t, dir, false)
+	defer run(t, ing, out)()
+	expectNone(t, out)
+
+	messages(t, j, t0.Add(time.Minute), "new1", "new2", "new3", "new4")
+	if got := collect(t, out, 4); !slices.Equal(got, []string{"new1", "new2", "new3", "new4"}) {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestJournalCheckpointResume(t *testing.T) {
+	dir := t.TempDir()
+	j := newTestJournal(t, filepath.Join(dir, "system.journal"))
+	messages(t, j, t0, "m1", "m2", "m3")
+
+	out := make(chan orchestrator.IngestMessage, 10)
+	ing := testIngester(t, dir, true)
+	stop := run(t, ing, out)
+	collect(t, out, 3)
+	stop()
+
+	blob, err := ing.SaveCheckpoint()
+	if err != nil || blob == nil {
+		t.Fatalf("SaveCheckpoint: %s, %v", blob, err)
+	}
+	var st state
+	if err := json.Unmarshal(blob, &st); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(st.Cursor, ";i=3;") {
+		t.Errorf("cursor %q does not point at the third entry", st.Cursor)
+	}
+
+	// Entries written while no instance runs are picked up by the next,
+	// which starts from the cursor even though read_from is "end".
+	messages(t, j, t0.Add(time.Minute), "m4", "m5")
+
+	next := testIngester(t, dir, false)
+	if err := next.LoadCheckpoint(blob); err != nil {
+		t.Fatal(err)
+	}
+	defer run(t, next, out)()
+	if got := collect(t, out, 2); !slices.Equal(got, []string{"m4", "m5"}) {
+		t.Errorf("got %v", got)
+	}
+	expectNone(t, out)
+}
+
+func TestJournalStateFile(t *testing.T) {
+	dir := t.TempDir()
+	stateDir := t.TempDir()
+	j := newTestJournal(t, filepath.Join(dir, "system.journal"))
+	messages(t, j, t0, "m1", "m2")
+
+	params := map[string]string{"dir": dir, "poll_interval": "10ms", "read_from": "beginning", "_state_dir": stateDir}
+	cfg, err := parseConfig("persisted", params, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := make(chan orchestrator.IngestMessage, 10)
+	stop := run(t, newIngester(cfg), out)
+	collect(t, out, 2)
+	stop()
+
+	// Without a Raft checkpoint, the next instance resumes from the local
+	// state file written on shutdown.
+	messages(t, j, t0.Add(time.Minute), "m3")
+	defer run(t, newIngester(cfg), out)()
+	if got := collect(t, out, 1); got[0] != "m3" {
+		t.Errorf("got %v", got)
+	}
+	expectNone(t, out)
+}
+
+func TestJournalInterleavesFiles(t *testing.T) {
+	dir := t.TempDir()
+	sys := newTestJournal(t, filepath.Join(dir, "system.journal"), withSeqnum(1, 1))
+	user := newTestJournal(t, filepath.Join(dir, "user-1000.journal"), withSeqnum(2, 1))
+
+	sys.add(t0, message("s1")...)
+	user.add(t0.Add(1*time.Second), message("u1")...)
+	sys.add(t0.Add(2*time.Second), message("s2")...)
+	user.add(t0.Add(3*time.Second), message("u2")...)
+	user.add(t0.Add(4*time.Second), message("u3")...)
+	sys.add(t0.Add(5*time.Second), message("s3")...)
+
+	out := make(chan orchestrator.IngestMessage, 10)
+	defer run(t, testIngester(t, dir, true), out)()
+	want := []string{"s1", "u1", "s2", "u2", "u3", "s3"}
+	if got := collect(t, out, len(want)); !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestJournalRotation(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "0123456789abcdef0123456789abcdef") // machine ID subdirectory
+	if err := os.Mkdir(dir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	active := filepath.Join(dir, "system.journal")
+	j := newTestJournal(t, active, withSeqnum(1, 1))
+	messages(t, j, t0, "a1", "a2")
+
+	out := make(chan orchestrator.IngestMessage, 10)
+	ing := testIngester(t, filepath.Dir(dir), true)
+	defer run(t, ing, out)()
+	collect(t, out, 2)
+
+	// journald archives the active file by renaming it, then continues
+	// the same sequence in a new system.journal.
+	j.add(t0.Add(time.Minute), message("a3")...)
+	j.archive()
+	if err := os.Rename(active, filepath.Join(dir, "system@aa-0000000000000001-0000000000000001.journal")); err != nil {
+		t.Fatal(err)
+	}
+	next := newTestJournal(t, active, withSeqnum(1, 4))
+	messages(t, next, t0.Add(2*time.Minute), "b1", "b2")
+
+	want := []string{"a3", "b1", "b2"}
+	if got := collect(t, out, len(want)); !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	expectNone(t, out)
+}
+
+func TestJournalSkipsBrokenFiles(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "garbage.journal"), []byte("not a journal"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// Files journald didn't close cleanly are renamed with a "~" suffix.
+	dirty := newTestJournal(t, filepath.Join(dir, "system@0.journal~"))
+	messages(t, dirty, t0, "dirty")
+	j := newTestJournal(t, filepath.Join(dir, "system.journal"))
+	messages(t, j, t0, "ok")
+
+	out := make(chan orchestrator.IngestMessage, 10)
+	defer run(t, testIngester(t, dir, true), out)()
+	if got := collect(t, out, 1); got[0] != "ok" {
+		t.Errorf("got %v", got)
+	}
+	expectNone(t, out)
+}
+
+func TestJournalFactoryParams(t *testing.T) {
+	factory := NewFactory()
+	id := [16]byte{1}
+
+	for _, params := range []map[string]string{
+		{"poll_interval": "0s"},
+		{"poll_interval": "often"},
+		{"read_from": "middle"},
+	} {
+		if _, err := factory(id, params, nil); err == nil {
+			t.Errorf("params %v: expected error", params)
+		}
+	}
+
+	ing, err := factory(id, map[string]string{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	j := ing.(*ingester)
+	if j.dir != DefaultDir || j.pollInterval != time.Second || j.fromStart {
+		t.Errorf("defaults: dir %q, poll %v, fromStart %v", j.dir, j.pollInterval, j.fromStart)
+	}
+}
+
+func TestLoadCheckpointRejectsBadCursor(t *testing.T) {
+	ing := testIngester(t, t.TempDir(), false)
+	for _, blob := range []string{`not json`, `{"cursor":"s=1"}`} {
+		if err := ing.LoadCheckpoint([]byte(blob)); err == nil {
+			t.Errorf("%s: expected error", blob)
+		}
+	}
+}
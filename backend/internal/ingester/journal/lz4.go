@@ -0,0 +1,94 @@
+package journal
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// decodeLZ4 decompresses an LZ4-compressed DATA payload as systemd writes
+// it: a little-endian uint64 uncompressed size followed by one raw LZ4
+// block (no frame header).
+func decodeLZ4(src []byte, maxSize int) ([]byte, error) {
+	if len(src) < 8 {
+		return nil, errors.New("lz4: payload too short")
+	}
+	size := binary.LittleEndian.Uint64(src)
+	if size > uint64(maxSize) {
+		return nil, errors.New("lz4: uncompressed size exceeds limit")
+	}
+	dst := make([]byte, 0, int(size))
+	src = src[8:]
+
+	for i := 0; i < len(src); {
+		token := src[i]
+		i++
+
+		// Literals.
+		litLen, n, err := lz4Length(src[i:], int(token>>4))
+		if err != nil {
+			return nil, err
+		}
+		i += n
+		if litLen > len(src)-i || len(dst)+litLen > int(size) {
+			return nil, errors.New("lz4: literal run out of bounds")
+		}
+		dst = append(dst, src[i:i+litLen]...)
+		i += litLen
+		if i == len(src) {
+			break // the last sequence has literals only
+		}
+
+		// Match.
+		if len(src)-i < 2 {
+			return nil, errors.New("lz4: truncated match offset")
+		}
+		offset := int(binary.LittleEndian.Uint16(src[i:]))
+		i += 2
+		if offset == 0 || offset > len(dst) {
+			return nil, errors.New("lz4: invalid match offset")
+		}
+		matchLen, n, err := lz4Length(src[i:], int(token&0x0f))
+		if err != nil {
+			return nil, err
+		}
+		i += n
+		matchLen += 4
+		if len(dst)+matchLen > int(size) {
+			return nil, errors.New("lz4: match out of bounds")
+		}
+		// Matches may overlap the bytes they produce; copy byte by byte.
+		start := len(dst) - offset
+		for k := range matchLen {
+			dst = append(dst, dst[start+k])
+		}
+	}
+
+	if uint64(len(dst)) != size {
+		return nil, errors.New("lz4: size mismatch")
+	}
+	return dst, nil
+}
+
+// lz4Length decodes an LZ4 length: the 4-bit value from the token, extended
+// by additional bytes while it is 15 and each byte is 255. Returns the
+// length and the number of extension bytes consumed.
+func lz4Length(src []byte, base int) (int, int, error) {
+	if base != 15 {
+		return base, 0, nil
+	}
+	n := 0
+	for {
+		if n >= len(src) {
+			return 0, 0, errors.New("lz4: truncated length")
+		}
+		b := src[n]
+		n++
+		base += int(b)
+		if base > 1<<30 {
+			return 0, 0, errors.New("lz4: length overflow")
+		}
+		if b != 255 {
+			return base, n, nil
+		}
+	}
+}
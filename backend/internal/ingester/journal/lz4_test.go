@@ -0,0 +1,77 @@
+package journal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestDecodeLZ4(t *testing.T) {
+	long := bytes.Repeat([]byte("x"), 300)
+	tests := []struct {
+		name string
+		src  []byte
+		want []byte
+	}{
+		{
+			name: "literals only",
+			src:  lz4Literals([]byte("hello")),
+			want: []byte("hello"),
+		},
+		{
+			name: "long literal run",
+			src:  lz4Literals(long),
+			want: long,
+		},
+		{
+			// "abc" followed by a 9-byte match at offset 3, overlapping
+			// the bytes it produces, then the empty final literal run.
+			name: "overlapping match",
+			src:  lz4Block(12, 0x35, 'a', 'b', 'c', 0x03, 0x00, 0x00),
+			want: []byte("abcabcabcabc"),
+		},
+		{
+			// Match length 4+15+5 = 24 using an extension byte.
+			name: "extended match length",
+			src:  lz4Block(25, 0x1f, 'z', 0x01, 0x00, 0x05, 0x00),
+			want: bytes.Repeat([]byte("z"), 25),
+		},
+	}
+	for _, tt := range tests {
+		got, err := decodeLZ4(tt.src, 1<<20)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDecodeLZ4Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []byte
+	}{
+		{"short", []byte{1, 2, 3}},
+		{"over limit", lz4Block(1 << 21)},
+		{"size mismatch", lz4Block(10, 0x30, 'a', 'b', 'c')},
+		{"literals past end", lz4Block(5, 0x50, 'a')},
+		{"zero offset", lz4Block(8, 0x10, 'a', 0x00, 0x00)},
+		{"offset before start", lz4Block(8, 0x10, 'a', 0x05, 0x00)},
+		{"truncated offset", lz4Block(8, 0x10, 'a', 0x01)},
+		{"match past size", lz4Block(3, 0x10, 'a', 0x01, 0x00)},
+		{"truncated length", lz4Block(20, 0xf0)},
+	}
+	for _, tt := range tests {
+		if _, err := decodeLZ4(tt.src, 1<<20); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+}
+
+// lz4Block prefixes a raw LZ4 block with its uncompressed size.
+func lz4Block(size uint64, block ...byte) []byte {
+	return append(binary.LittleEndian.AppendUint64(nil, size), block...)
+}
@@ -0,0 +1,57 @@
+package journal
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// state persists the cursor of the last ingested entry across restarts.
+type state struct {
+	Cursor string `json:"cursor"`
+}
+
+// loadState reads the cursor state from the given path.
+// Returns empty state if the file doesn't exist.
+func loadState(path string) (state, error) {
+	if path == "" {
+		return state{}, nil
+	}
+
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state{}, nil
+		}
+		return state{}, err
+	}
+
+	var s state
+	if err := json.Unmarshal(data, &s); err != nil {
+		// Corrupt state file; start fresh rather than failing.
+		return state{}, nil //nolint:nilerr // corrupt bookmark file is treated as empty state
+	}
+	return s, nil
+}
+
+// saveState atomically writes the cursor state to the given path.
+func saveState(path string, s state) error {
+	if path == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
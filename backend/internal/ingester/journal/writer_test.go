@@ -0,0 +1,233 @@
+package journal
+
+import (
+	"encoding/binary"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// testJournal writes journal files the way journald does, closely enough
+// for the reader: append-only objects, entry arrays linked into a chain,
+// and a header updated after each entry. Apart from array slots and links,
+// earlier bytes never change, so a reader can follow the file while it
+// grows.
+type testJournal struct {
+	t        testing.TB
+	f        *os.File
+	buf      []byte
+	compact  bool
+	compress uint8 // object flag applied to data payloads, or 0
+	arrayCap int
+
+	seqnumID [16]byte
+	bootID   [16]byte
+	seqnum   uint64
+
+	lastArray uint64
+	inArray   int
+}
+
+type journalOption func(*testJournal)
+
+func withCompact() journalOption { return func(j *testJournal) { j.compact = true } }
+
+func withCompression(flag uint8) journalOption {
+	return func(j *testJournal) { j.compress = flag }
+}
+
+// withSeqnum sets the sequence number space: files sharing a seqnum ID
+// come from the same journald instance.
+func withSeqnum(id byte, first uint64) journalOption {
+	return func(j *testJournal) {
+		j.seqnumID = [16]byte{id}
+		j.seqnum = first - 1
+	}
+}
+
+func newTestJournal(t testing.TB, path string, opts ...journalOption) *testJournal {
+	t.Helper()
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = f.Close() })
+
+	j := &testJournal{
+		t:        t,
+		f:        f,
+		arrayCap: 3, // small, so tests cross array boundaries
+		seqnumID: [16]byte{0xaa},
+		bootID:   [16]byte{0xbb},
+	}
+	for _, o := range opts {
+		o(j)
+	}
+
+	const headerSize = 272
+	j.buf = make([]byte, headerSize)
+	copy(j.buf, signature)
+	var incompat uint32
+	if j.compact {
+		incompat |= incompatCompact
+	}
+	switch j.compress {
+	case objCompressedZSTD:
+		incompat |= incompatCompressedZSTD
+	case objCompressedLZ4:
+		incompat |= incompatCompressedLZ4
+	}
+	binary.LittleEndian.PutUint32(j.buf[hdrIncompatibleFlags:], incompat)
+	j.buf[hdrState] = 1 // online
+	copy(j.buf[hdrFileID:hdrFileID+16], path)
+	copy(j.buf[hdrSeqnumID:], j.seqnumID[:])
+	binary.LittleEndian.PutUint64(j.buf[hdrHeaderSize:], headerSize)
+	j.flush()
+	return j
+}
+
+// appendObject appends an 8-byte aligned object and returns its offset.
+func (j *testJournal) appendObject(typ, flags uint8, body []byte) uint64 {
+	off := uint64(len(j.buf))
+	obj := make([]byte, objectHeaderSize+len(body))
+	obj[0], obj[1] = typ, flags
+	binary.LittleEndian.PutUint64(obj[8:], uint64(len(obj)))
+	copy(obj[objectHeaderSize:], body)
+	j.buf = append(j.buf, obj...)
+	for len(j.buf)%8 != 0 {
+		j.buf = append(j.buf, 0)
+	}
+	return off
+}
+
+func (j *testJournal) putItem(at int, v uint64) {
+	if j.compact {
+		binary.LittleEndian.PutUint32(j.buf[at:], uint32(v))
+	} else {
+		binary.LittleEndian.PutUint64(j.buf[at:], v)
+	}
+}
+
+func (j *testJournal) itemSize() int {
+	if j.compact {
+		return 4
+	}
+	return 8
+}
+
+// add appends one entry with the given FIELD=value pairs.
+func (j *testJournal) add(realtime time.Time, fields ...string) {
+	j.t.Helper()
+	j.seqnum++
+
+	var items []uint64
+	for _, field := range fields {
+		payload := []byte(field)
+		var flags uint8
+		switch j.compress {
+		case objCompressedZSTD:
+			enc, _ := zstd.NewWriter(nil)
+			payload = enc.EncodeAll(payload, nil)
+			flags = objCompressedZSTD
+		case objCompressedLZ4:
+			payload = lz4Literals(payload)
+			flags = objCompressedLZ4
+		}
+		hdrLen := dataPayload - objectHeaderSize
+		if j.compact {
+			hdrLen = dataPayloadCompact - objectHeaderSize
+		}
+		body := make([]byte, hdrLen, hdrLen+len(payload))
+		items = append(items, j.appendObject(objectData, flags, append(body, payload...)))
+	}
+
+	entrySize := 16
+	if j.compact {
+		entrySize = 4
+	}
+	body := make([]byte, entryItems-objectHeaderSize+len(items)*entrySize)
+	binary.LittleEndian.PutUint64(body[entrySeqnum-objectHeaderSize:], j.seqnum)
+	binary.LittleEndian.PutUint64(body[entryRealtime-objectHeaderSize:], uint64(realtime.UnixMicro()))
+	// One boot, with the monotonic clock tracking wall time.
+	binary.LittleEndian.PutUint64(body[entryMonotonic-objectHeaderSize:], uint64(realtime.UnixMicro()))
+	copy(body[entryBootID-objectHeaderSize:], j.bootID[:])
+	binary.LittleEndian.PutUint64(body[entryXorHash-objectHeaderSize:], j.seqnum*7919)
+	for i, off := range items {
+		at := entryItems - objectHeaderSize + i*entrySize
+		if j.compact {
+			binary.LittleEndian.PutUint32(body[at:], uint32(off))
+		} else {
+			binary.LittleEndian.PutUint64(body[at:], off)
+		}
+	}
+	entry := j.appendObject(objectEntry, 0, body)
+
+	// Link the entry into the array chain, starting a new array when the
+	// current one is full.
+	if j.lastArray == 0 || j.inArray == j.arrayCap {
+		arr := j.appendObject(objectEntryArray, 0, make([]byte, entryArrayItems-objectHeaderSize+j.arrayCap*j.itemSize()))
+		if j.lastArray == 0 {
+			binary.LittleEndian.PutUint64(j.buf[hdrEntryArrayOffset:], arr)
+		} else {
+			binary.LittleEndian.PutUint64(j.buf[j.lastArray+entryArrayNext:], arr)
+		}
+		j.lastArray, j.inArray = arr, 0
+	}
+	j.putItem(int(j.lastArray)+entryArrayItems+j.inArray*j.itemSize(), entry)
+	j.inArray++
+
+	n := binary.LittleEndian.Uint64(j.buf[hdrNEntries:])
+	binary.LittleEndian.PutUint64(j.buf[hdrNEntries:], n+1)
+	binary.LittleEndian.PutUint64(j.buf[hdrTailEntrySeqnum:], j.seqnum)
+	j.flush()
+}
+
+// archive marks the file archived, as journald does before renaming it.
+func (j *testJournal) archive() {
+	j.buf[hdrState] = stateArchived
+	j.flush()
+}
+
+// flush writes the body before the header, so a concurrent reader never
+// sees a header that points past what's written.
+func (j *testJournal) flush() {
+	j.t.Helper()
+	if len(j.buf) > hdrMinSize {
+		if _, err := j.f.WriteAt(j.buf[hdrMinSize:], hdrMinSize); err != nil {
+			j.t.Fatal(err)
+		}
+	}
+	if _, err := j.f.WriteAt(j.buf[:hdrMinSize], 0); err != nil {
+		j.t.Fatal(err)
+	}
+}
+
+// lz4Literals encodes data as a systemd LZ4 payload holding a single
+// literal-only sequence.
+func lz4Literals(data []byte) []byte {
+	out := binary.LittleEndian.AppendUint64(nil, uint64(len(data)))
+	n := len(data)
+	if n < 15 {
+		out = append(out, byte(n<<4))
+	} else {
+		out = append(out, 0xf0)
+		for n -= 15; n >= 255; n -= 255 {
+			out = append(out, 255)
+		}
+		out = append(out, byte(n))
+	}
+	return append(out, data...)
+}
+
+func message(s string) []string {
+	return []string{"MESSAGE=" + s, "_HOSTNAME=host1", "PRIORITY=6", "_SYSTEMD_UNIT=test.service"}
+}
+
+func messages(t testing.TB, j *testJournal, start time.Time, texts ...string) {
+	t.Helper()
+	for i, s := range texts {
+		j.add(start.Add(time.Duration(i)*time.Second), message(s)...)
+	}
+}
@@ -0,0 +1,424 @@
+package kafka
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// avroMaxDepth bounds nesting while decoding, so recursive schemas can't
+// be driven into unbounded recursion by crafted data.
+const avroMaxDepth = 64
+
+var errAvroTruncated = errors.New("truncated data")
+
+// avroSchema is a parsed Avro schema. Only what's needed to decode the
+// binary encoding is kept: with a single writer schema there is nothing to
+// resolve, so defaults, aliases and logical types don't matter. Logical
+// types decode as their underlying type.
+type avroSchema struct {
+	kind     string        // primitive name, or record, enum, array, map, union, fixed
+	fields   []avroField   // record
+	symbols  []string      // enum
+	items    *avroSchema   // array items, map values
+	branches []*avroSchema // union
+	size     int           // fixed
+}
+
+type avroField struct {
+	name   string
+	schema *avroSchema
+}
+
+// avroDecoder decodes Avro binary datums written with one schema to JSON.
+type avroDecoder struct {
+	schema *avroSchema
+}
+
+// newAvroDecoder parses an Avro schema in its JSON form (an .avsc file).
+func newAvroDecoder(data []byte) (*avroDecoder, error) {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, fmt.Errorf("invalid Avro schema: %w", err)
+	}
+	p := avroParser{names: make(map[string]*avroSchema)}
+	s, err := p.parse(v, "")
+	if err != nil {
+		return nil, fmt.Errorf("invalid Avro schema: %w", err)
+	}
+	return &avroDecoder{schema: s}, nil
+}
+
+func (d *avroDecoder) decode(value []byte) ([]byte, error) {
+	r := avroReader{buf: value}
+	var out bytes.Buffer
+	if err := r.value(&out, d.schema, 0); err != nil {
+		return nil, fmt.Errorf("invalid Avro data: %w", err)
+	}
+	if r.pos != len(value) {
+		return nil, fmt.Errorf("invalid Avro data: %d bytes after the datum", len(value)-r.pos)
+	}
+	return out.Bytes(), nil
+}
+
+// avroParser parses schemas, tracking named types so later references
+// (including recursive ones) resolve.
+type avroParser struct {
+	names map[string]*avroSchema
+}
+
+func (p *avroParser) parse(v any, namespace string) (*avroSchema, error) {
+	switch x := v.(type) {
+	case string:
+		return p.named(x, namespace)
+	case []any:
+		if len(x) == 0 {
+			return nil, errors.New("empty union")
+		}
+		u := &avroSchema{kind: "union"}
+		for _, b := range x {
+			s, err := p.parse(b, namespace)
+			if err != nil {
+				return nil, err
+			}
+			u.branches = append(u.branches, s)
+		}
+		return u, nil
+	case map[string]any:
+		return p.complex(x, namespace)
+	}
+	return nil, fmt.Errorf("unexpected schema %v", v)
+}
+
+// named resolves a primitive type name or a reference to a named type.
+func (p *avroParser) named(name, namespace string) (*avroSchema, error) {
+	switch name {
+	case "null", "boolean", "int", "long", "float", "double", "bytes", "string":
+		return &avroSchema{kind: name}, nil
+	}
+	if s, ok := p.names[avroFullName(name, namespace)]; ok {
+		return s, nil
+	}
+	if s, ok := p.names[name]; ok {
+		return s, nil
+	}
+	return nil, fmt.Errorf("unknown type %q", name)
+}
+
+func (p *avroParser) complex(m map[string]any, namespace string) (*avroSchema, error) {
+	switch typ := m["type"].(type) {
+	case string:
+		switch typ {
+		case "record", "error", "enum", "fixed":
+			return p.definition(typ, m, namespace)
+		case "array":
+			items, err := p.parse(m["items"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			return &avroSchema{kind: "array", items: items}, nil
+		case "map":
+			values, err := p.parse(m["values"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			return &avroSchema{kind: "map", items: values}, nil
+		}
+		// A primitive or reference with attributes, such as a logical type.
+		return p.named(typ, namespace)
+	case map[string]any, []any:
+		return p.parse(typ, namespace)
+	}
+	return nil, errors.New("schema object without a type")
+}
+
+// definition parses a named type: a record, enum or fixed.
+func (p *avroParser) definition(kind string, m map[string]any, namespace string) (*avroSchema, error) {
+	name, _ := m["name"].(string)
+	if name == "" {
+		return nil, fmt.Errorf("%s without a name", kind)
+	}
+	if ns, ok := m["namespace"].(string); ok {
+		namespace = ns
+	}
+	full := avroFullName(name, namespace)
+	if _, dup := p.names[full]; dup {
+		return nil, fmt.Errorf("type %q defined twice", full)
+	}
+	// Names inside a definition are relative to its own namespace.
+	namespace = ""
+	if i := strings.LastIndexByte(full, '.'); i >= 0 {
+		namespace = full[:i]
+	}
+
+	s := &avroSchema{kind: kind}
+	p.names[full] = s // before the fields, which may refer back to s
+	switch kind {
+	case "record", "error":
+		s.kind = "record"
+		fields, ok := m["fields"].([]any)
+		if !ok {
+			return nil, fmt.Errorf("record %q without fields", full)
+		}
+		for _, f := range fields {
+			fm, _ := f.(map[string]any)
+			fname, _ := fm["name"].(string)
+			if fname == "" {
+				return nil, fmt.Errorf("record %q has a field without a name", full)
+			}
+			fs, err := p.parse(fm["type"], namespace)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %w", fname, err)
+			}
+			s.fields = append(s.fields, avroField{name: fname, schema: fs})
+		}
+	case "enum":
+		symbols, _ := m["symbols"].([]any)
+		for _, sym := range symbols {
+			str, ok := sym.(string)
+			if !ok {
+				return nil, fmt.Errorf("enum %q has a non-string symbol", full)
+			}
+			s.symbols = append(s.symbols, str)
+		}
+	case "fixed":
+		size, ok := m["size"].(float64)
+		if !ok || size < 0 || size != math.Trunc(size) || size > math.MaxInt32 {
+			return nil, fmt.Errorf("fixed %q has an invalid size", full)
+		}
+		s.size = int(size)
+	}
+	return s, nil
+}
+
+// avroFullName qualifies name with namespace unless it is already a full
+// name.
+func avroFullName(name, namespace string) string {
+	if namespace == "" || strings.Contains(name, ".") {
+		return name
+	}
+	return namespace + "." + name
+}
+
+// avroReader decodes the Avro binary encoding.
+type avroReader struct {
+	buf []byte
+	pos int
+}
+
+// value decodes one value of schema s and writes it to out as JSON.
+// Unions are written as the chosen branch's value, bytes and fixed as
+// base64 strings, and non-finite floats as "NaN" or "Infinity" strings.
+func (r *avroReader) value(out *bytes.Buffer, s *avroSchema, depth int) error {
+	if depth > avroMaxDepth {
+		return errors.New("nested too deeply")
+	}
+	switch s.kind {
+	case "null":
+		out.WriteString("null")
+	case "boolean":
+		b, err := r.fixed(1)
+		if err != nil {
+			return err
+		}
+		out.WriteString(strconv.FormatBool(b[0] != 0))
+	case "int", "long":
+		v, err := r.long()
+		if err != nil {
+			return err
+		}
+		if s.kind == "int" && (v < math.MinInt32 || v > math.MaxInt32) {
+			return errors.New("int out of range")
+		}
+		out.WriteString(strconv.FormatInt(v, 10))
+	case "float":
+		b, err := r.fixed(4)
+		if err != nil {
+			return err
+		}
+		writeFloat(out, float64(math.Float32frombits(binary.LittleEndian.Uint32(b))), 32)
+	case "double":
+		b, err := r.fixed(8)
+		if err != nil {
+			return err
+		}
+		writeFloat(out, math.Float64frombits(binary.LittleEndian.Uint64(b)), 64)
+	case "bytes", "fixed":
+		var b []byte
+		var err error
+		if s.kind == "fixed" {
+			b, err = r.fixed(s.size)
+		} else {
+			b, err = r.bytes()
+		}
+		if err != nil {
+			return err
+		}
+		writeJSON(out, b)
+	case "string":
+		b, err := r.bytes()
+		if err != nil {
+			return err
+		}
+		writeJSON(out, string(b))
+	case "enum":
+		i, err := r.long()
+		if err != nil {
+			return err
+		}
+		if i < 0 || i >= int64(len(s.symbols)) {
+			return fmt.Errorf("enum index %d out of range", i)
+		}
+		writeJSON(out, s.symbols[i])
+	case "union":
+		i, err := r.long()
+		if err != nil {
+			return err
+		}
+		if i < 0 || i >= int64(len(s.branches)) {
+			return fmt.Errorf("union index %d out of range", i)
+		}
+		return r.value(out, s.branches[i], depth+1)
+	case "record":
+		out.WriteByte('{')
+		for i, f := range s.fields {
+			if i > 0 {
+				out.WriteByte(',')
+			}
+			writeJSON(out, f.name)
+			out.WriteByte(':')
+			if err := r.value(out, f.schema, depth+1); err != nil {
+				return err
+			}
+		}
+		out.WriteByte('}')
+	case "array":
+		out.WriteByte('[')
+		err := r.blocks(out, func() error {
+			return r.value(out, s.items, depth+1)
+		})
+		if err != nil {
+			return err
+		}
+		out.WriteByte(']')
+	case "map":
+		out.WriteByte('{')
+		err := r.blocks(out, func() error {
+			key, err := r.bytes()
+			if err != nil {
+				return err
+			}
+			writeJSON(out, string(key))
+			out.WriteByte(':')
+			return r.value(out, s.items, depth+1)
+		})
+		if err != nil {
+			return err
+		}
+		out.WriteByte('}')
+	default:
+		return fmt.Errorf("unsupported type %q", s.kind)
+	}
+	return nil
+}
+
+// blocks decodes the items of an array or map, written as blocks each
+// preceded by its item count. A negative count is followed by the block's
+// size in bytes, which isn't needed here.
+func (r *avroReader) blocks(out *bytes.Buffer, item func() error) error {
+	first := true
+	for {
+		n, err := r.long()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+		if n < 0 {
+			if n = -n; n < 0 {
+				return errors.New("invalid block count")
+			}
+			if _, err := r.long(); err != nil {
+				return err
+			}
+		}
+		// Every item but a null takes at least a byte; a larger count is
+		// corrupt data that would otherwise spin here.
+		if n > int64(len(r.buf)-r.pos) {
+			return errAvroTruncated
+		}
+		for range n {
+			if !first {
+				out.WriteByte(',')
+			}
+			first = false
+			if err := item(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// long reads a zigzag varint, which Avro uses for int and long alike.
+func (r *avroReader) long() (int64, error) {
+	v, n := binary.Varint(r.buf[r.pos:])
+	if n == 0 {
+		return 0, errAvroTruncated
+	}
+	if n < 0 {
+		return 0, errors.New("varint overflows 64 bits")
+	}
+	r.pos += n
+	return v, nil
+}
+
+// bytes reads a length-prefixed byte string.
+func (r *avroReader) bytes() ([]byte, error) {
+	n, err := r.long()
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 {
+		return nil, errors.New("negative length")
+	}
+	if n > int64(len(r.buf)-r.pos) {
+		return nil, errAvroTruncated
+	}
+	return r.fixed(int(n))
+}
+
+func (r *avroReader) fixed(n int) ([]byte, error) {
+	if n > len(r.buf)-r.pos {
+		return nil, errAvroTruncated
+	}
+	b := r.buf[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+// writeFloat writes f as a JSON number, or as a string for values JSON
+// can't represent, as protojson does.
+func writeFloat(out *bytes.Buffer, f float64, bits int) {
+	switch {
+	case math.IsNaN(f):
+		out.WriteString(`"NaN"`)
+	case math.IsInf(f, 1):
+		out.WriteString(`"Infinity"`)
+	case math.IsInf(f, -1):
+		out.WriteString(`"-Infinity"`)
+	default:
+		out.Write(strconv.AppendFloat(out.AvailableBuffer(), f, 'g', -1, bits))
+	}
+}
+
+// writeJSON writes v, a string or byte slice, JSON-encoded.
+func writeJSON(out *bytes.Buffer, v any) {
+	b, _ := json.Marshal(v) // strings and byte slices always marshal
+	out.Write(b)
+}
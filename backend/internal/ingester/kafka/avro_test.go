@@ -0,0 +1,213 @@
+package kafka
+
+import (
+	"encoding/binary"
+	"math"
+	"strings"
+	"testing"
+)
+
+// avroLong appends v in Avro's zigzag varint encoding.
+func avroLong(b []byte, v int64) []byte {
+	return binary.AppendVarint(b, v)
+}
+
+func avroString(b []byte, s string) []byte {
+	return append(avroLong(b, int64(len(s))), s...)
+}
+
+const eventSchema = `{
+	"type": "record", "name": "Event", "namespace": "com.example",
+	"fields": [
+		{"name": "message", "type": "string"},
+		{"name": "level", "type": {"type": "enum", "name": "Level", "symbols": ["DEBUG", "INFO", "ERROR"]}},
+		{"name": "count", "type": "int"},
+		{"name": "ts", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+		{"name": "ratio", "type": "double"},
+		{"name": "score", "type": "float"},
+		{"name": "ok", "type": "boolean"},
+		{"name": "user", "type": ["null", "string"]},
+		{"name": "tags", "type": {"type": "array", "items": "string"}},
+		{"name": "labels", "type": {"type": "map", "values": "long"}},
+		{"name": "id", "type": {"type": "fixed", "name": "ID", "size": 2}},
+		{"name": "payload", "type": "bytes"},
+		{"name": "source", "type": {"type": "record", "name": "Source", "fields": [
+			{"name": "host", "type": "string"},
+			{"name": "level", "type": "Level"}
+		]}}
+	]
+}`
+
+func encodeEvent() []byte {
+	var b []byte
+	b = avroString(b, "disk full")
+	b = avroLong(b, 2) // ERROR
+	b = avroLong(b, -3)
+	b = avroLong(b, 1700000000000)
+	b = binary.LittleEndian.AppendUint64(b, math.Float64bits(0.5))
+	b = binary.LittleEndian.AppendUint32(b, math.Float32bits(1.5))
+	b = append(b, 1)
+	b = avroLong(b, 1) // union branch: string
+	b = avroString(b, "alice")
+	// Array in two blocks; the second has a negative count and byte size.
+	b = avroLong(b, 1)
+	b = avroString(b, "a")
+	b = avroLong(b, -1)
+	b = avroLong(b, 2)
+	b = avroString(b, "b")
+	b = avroLong(b, 0)
+	b = avroLong(b, 1)
+	b = avroString(b, "k")
+	b = avroLong(b, 7)
+	b = avroLong(b, 0)
+	b = append(b, 0xca, 0xfe)
+	b = avroString(b, "\x00\x01")
+	b = avroString(b, "web-1")
+	b = avroLong(b, 1) // INFO
+	return b
+}
+
+func TestAvroDecode(t *testing.T) {
+	t.Parallel()
+	d, err := newAvroDecoder([]byte(eventSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := d.decode(encodeEvent())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"message":"disk full","level":"ERROR","count":-3,"ts":1700000000000,"ratio":0.5,"score":1.5,"ok":true,` +
+		`"user":"alice","tags":["a","b"],"labels":{"k":7},"id":"yv4=","payload":"AAE=",` +
+		`"source":{"host":"web-1","level":"INFO"}}`
+	if string(got) != want {
+		t.Errorf("decoded:\n got %s\nwant %s", got, want)
+	}
+}
+
+func TestAvroDecodeRecursive(t *testing.T) {
+	t.Parallel()
+	d, err := newAvroDecoder([]byte(`{"type": "record", "name": "Node", "fields": [
+		{"name": "value", "type": "long"},
+		{"name": "next", "type": ["null", "Node"]}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b []byte
+	b = avroLong(b, 1)
+	b = avroLong(b, 1)
+	b = avroLong(b, 2)
+	b = avroLong(b, 0)
+	got, err := d.decode(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"value":1,"next":{"value":2,"next":null}}`; string(got) != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	// A cycle deeper than avroMaxDepth is rejected rather than followed.
+	var deep []byte
+	for range avroMaxDepth {
+		deep = avroLong(avroLong(deep, 0), 1)
+	}
+	deep = avroLong(avroLong(deep, 0), 0)
+	if _, err := d.decode(deep); err == nil || !strings.Contains(err.Error(), "nested too deeply") {
+		t.Errorf("expected nesting error, got %v", err)
+	}
+}
+
+func TestAvroDecodeNonFiniteFloats(t *testing.T) {
+	t.Parallel()
+	d, err := newAvroDecoder([]byte(`{"type": "array", "items": "double"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := avroLong(nil, 3)
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(f))
+	}
+	b = avroLong(b, 0)
+	got, err := d.decode(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `["NaN","Infinity","-Infinity"]`; string(got) != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestAvroDecodeInvalidData(t *testing.T) {
+	t.Parallel()
+	d, err := newAvroDecoder([]byte(eventSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	full := encodeEvent()
+	for n := range len(full) {
+		if _, err := d.decode(full[:n]); err == nil {
+			t.Fatalf("truncated to %d bytes: expected error", n)
+		}
+	}
+	if _, err := d.decode(append(full, 0)); err == nil {
+		t.Error("trailing byte: expected error")
+	}
+
+	enum, _ := newAvroDecoder([]byte(`{"type": "enum", "name": "E", "symbols": ["A"]}`))
+	if _, err := enum.decode(avroLong(nil, 1)); err == nil {
+		t.Error("enum index out of range: expected error")
+	}
+	union, _ := newAvroDecoder([]byte(`["null", "int"]`))
+	if _, err := union.decode(avroLong(nil, 2)); err == nil {
+		t.Error("union index out of range: expected error")
+	}
+	if _, err := union.decode(avroLong(avroLong(nil, 1), math.MaxInt32+1)); err == nil {
+		t.Error("int out of range: expected error")
+	}
+	array, _ := newAvroDecoder([]byte(`{"type": "array", "items": "null"}`))
+	if _, err := array.decode(avroLong(nil, math.MaxInt64)); err == nil {
+		t.Error("huge block count: expected error")
+	}
+}
+
+func TestAvroSchemaNames(t *testing.T) {
+	t.Parallel()
+	// Short names resolve in the enclosing namespace, full names anywhere.
+	d, err := newAvroDecoder([]byte(`{"type": "record", "name": "a.Outer", "fields": [
+		{"name": "x", "type": {"type": "fixed", "name": "Hash", "size": 1}},
+		{"name": "y", "type": "Hash"},
+		{"name": "z", "type": "a.Hash"}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := d.decode([]byte{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"x":"AQ==","y":"Ag==","z":"Aw=="}`; string(got) != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestAvroSchemaInvalid(t *testing.T) {
+	t.Parallel()
+	for _, schema := range []string{
+		`not json`,
+		`"Unknown"`,
+		`[]`,
+		`{"type": "record", "fields": []}`,
+		`{"type": "record", "name": "R"}`,
+		`{"type": "record", "name": "R", "fields": [{"type": "int"}]}`,
+		`{"type": "record", "name": "R", "fields": [{"name": "a", "type": "R2"}]}`,
+		`{"type": "fixed", "name": "F", "size": -1}`,
+		`{"type": "enum", "name": "E", "symbols": [1]}`,
+		`[{"type": "fixed", "name": "F", "size": 1}, {"type": "fixed", "name": "F", "size": 2}]`,
+		`{"name": "x"}`,
+	} {
+		if _, err := newAvroDecoder([]byte(schema)); err == nil {
+			t.Errorf("schema %s: expected error", schema)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Payload formats.
+const (
+	formatRaw      = "raw"
+	formatJSON     = "json"
+	formatAvro     = "avro"
+	formatProtobuf = "protobuf"
+)
+
+// schemaRetryInterval is how often a missing schema file is looked for.
+// Managed files are copied to each node in the background, so the file may
+// appear shortly after the ingester starts.
+const schemaRetryInterval = 5 * time.Second
+
+// decoder converts a binary record value to JSON.
+type decoder interface {
+	decode(value []byte) ([]byte, error)
+}
+
+// payloadParser turns record values into log lines. Avro and Protobuf
+// values are decoded to JSON first; JSON can then have fields promoted to
+// attributes and one field used as the log line.
+type payloadParser struct {
+	format       string
+	decoder      decoder
+	framed       bool // values carry the Confluent Schema Registry header
+	promote      []string
+	messageField string
+}
+
+// loadParser builds the payload parser for the configured format, reading
+// the schema file if there is one. It returns nil for raw payloads, and
+// nil, nil if ctx is cancelled while waiting for the schema file.
+func (ing *Ingester) loadParser(ctx context.Context) (*payloadParser, error) {
+	cfg := ing.cfg
+	if cfg.Format == "" || cfg.Format == formatRaw {
+		return nil, nil
+	}
+	p := &payloadParser{
+		format:       cfg.Format,
+		framed:       cfg.ConfluentFraming,
+		promote:      cfg.PromoteFields,
+		messageField: cfg.MessageField,
+	}
+	if cfg.Format == formatJSON {
+		return p, nil
+	}
+
+	var data []byte
+	for {
+		var err error
+		data, err = os.ReadFile(cfg.SchemaPath)
+		if err == nil {
+			break
+		}
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("read schema file: %w", err)
+		}
+		ing.logger.Warn("kafka: schema file not available yet", "path", cfg.SchemaPath)
+		select {
+		case <-ctx.Done():
+			return nil, nil
+		case <-time.After(schemaRetryInterval):
+		}
+	}
+
+	var err error
+	switch cfg.Format {
+	case formatAvro:
+		p.decoder, err = newAvroDecoder(data)
+	case formatProtobuf:
+		p.decoder, err = newProtobufDecoder(data, cfg.MessageType)
+	default:
+		err = fmt.Errorf("unsupported format %q", cfg.Format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// parse returns the log line for a record value and adds promoted fields
+// to attrs.
+func (p *payloadParser) parse(value []byte, attrs map[string]string) ([]byte, error) {
+	doc := value
+	if p.framed {
+		var err error
+		if doc, err = stripFraming(doc, p.format); err != nil {
+			return nil, err
+		}
+	}
+	if p.decoder != nil {
+		var err error
+		if doc, err = p.decoder.decode(doc); err != nil {
+			return nil, err
+		}
+	}
+	if len(p.promote) == 0 && p.messageField == "" {
+		return doc, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(doc))
+	dec.UseNumber()
+	var obj map[string]any
+	if err := dec.Decode(&obj); err != nil || obj == nil {
+		return nil, errors.New("payload is not a JSON object")
+	}
+	for _, path := range p.promote {
+		if s, ok := fieldString(lookup(obj, path)); ok {
+			attrs[path] = s
+		}
+	}
+	if p.messageField != "" {
+		if s, ok := lookup(obj, p.messageField).(string); ok && s != "" {
+			return []byte(s), nil
+		}
+	}
+	return doc, nil
+}
+
+// lookup returns the value at a dotted path in obj, or nil. A key that
+// itself contains dots is matched before descending into nested objects.
+func lookup(obj map[string]any, path string) any {
+	if v, ok := obj[path]; ok {
+		return v
+	}
+	for i := range len(path) {
+		if path[i] != '.' {
+			continue
+		}
+		if m, ok := obj[path[:i]].(map[string]any); ok {
+			if v := lookup(m, path[i+1:]); v != nil {
+				return v
+			}
+		}
+	}
+	return nil
+}
+
+// fieldString stringifies a JSON value for use as an attribute. Objects
+// and arrays are JSON-encoded; null yields no attribute.
+func fieldString(v any) (string, bool) {
+	switch x := v.(type) {
+	case nil:
+		return "", false
+	case string:
+		return x, true
+	case json.Number:
+		return x.String(), true
+	case bool:
+		return strconv.FormatBool(x), true
+	default:
+		b, err := json.Marshal(x)
+		return string(b), err == nil
+	}
+}
+
+// stripFraming removes the Confluent Schema Registry header: a zero magic
+// byte and a 4-byte schema ID, followed for Protobuf by the indexes of the
+// message type within its schema. The configured schema file is used
+// whatever the schema ID says.
+func stripFraming(value []byte, format string) ([]byte, error) {
+	if len(value) < 5 || value[0] != 0 {
+		return nil, errors.New("missing schema registry header")
+	}
+	value = value[5:]
+	if format != formatProtobuf {
+		return value, nil
+	}
+	n, k := binary.Varint(value)
+	if k <= 0 || n < 0 {
+		return nil, errors.New("invalid schema registry message indexes")
+	}
+	value = value[k:]
+	for range n {
+		if _, k = binary.Varint(value); k <= 0 {
+			return nil, errors.New("invalid schema registry message indexes")
+		}
+		value = value[k:]
+	}
+	return value, nil
+}
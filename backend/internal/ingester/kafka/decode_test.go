@@ -0,0 +1,160 @@
+package kafka
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func TestParseJSONPromotesFields(t *testing.T) {
+	t.Parallel()
+	p := &payloadParser{
+		format:       formatJSON,
+		promote:      []string{"level", "service.name", "http.status", "ctx", "missing", "nothing"},
+		messageField: "msg",
+	}
+	value := []byte(`{"msg":"request done","level":"info","service.name":"api",` +
+		`"http":{"status":200},"ctx":{"a":[1,2]},"nothing":null}`)
+	attrs := map[string]string{}
+	raw, err := p.parse(value, attrs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(raw) != "request done" {
+		t.Errorf("raw = %q, want message field", raw)
+	}
+	want := map[string]string{
+		"level":        "info",
+		"service.name": "api",
+		"http.status":  "200",
+		"ctx":          `{"a":[1,2]}`,
+	}
+	if len(attrs) != len(want) {
+		t.Errorf("attrs = %v, want %v", attrs, want)
+	}
+	for k, v := range want {
+		if attrs[k] != v {
+			t.Errorf("attrs[%q] = %q, want %q", k, attrs[k], v)
+		}
+	}
+}
+
+func TestParseJSONKeepsPayloadWithoutMessageField(t *testing.T) {
+	t.Parallel()
+	p := &payloadParser{format: formatJSON, promote: []string{"level"}, messageField: "msg"}
+	value := []byte(`{"level":"warn","msg":42}`)
+	raw, err := p.parse(value, map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(raw) != string(value) {
+		t.Errorf("raw = %q, want whole payload when the message field isn't a string", raw)
+	}
+}
+
+func TestParseJSONRejectsNonObject(t *testing.T) {
+	t.Parallel()
+	p := &payloadParser{format: formatJSON, promote: []string{"level"}}
+	for _, v := range []string{`plain text`, `[1,2]`, `null`, `"s"`} {
+		if _, err := p.parse([]byte(v), map[string]string{}); err == nil {
+			t.Errorf("%s: expected error", v)
+		}
+	}
+}
+
+func TestBuildMessageDecodeError(t *testing.T) {
+	t.Parallel()
+	p := &payloadParser{format: formatJSON, promote: []string{"level"}}
+	rec := &kgo.Record{Topic: "logs", Value: []byte("not json")}
+	msg := buildMessage(rec, "id", p, time.Now())
+	if string(msg.Raw) != "not json" {
+		t.Errorf("raw = %q, want the value unchanged", msg.Raw)
+	}
+	if msg.Attrs["decode_error"] == "" {
+		t.Error("expected decode_error attribute")
+	}
+}
+
+func TestBuildMessageAttributes(t *testing.T) {
+	t.Parallel()
+	rec := &kgo.Record{
+		Topic:     "logs",
+		Partition: 3,
+		Offset:    42,
+		Key:       []byte("user-7"),
+		Value:     []byte("hello"),
+		Headers: []kgo.RecordHeader{
+			{Key: "trace", Value: []byte("abc")},
+			{Key: "kafka_topic", Value: []byte("spoofed")},
+		},
+	}
+	msg := buildMessage(rec, "id", nil, time.Now())
+	want := map[string]string{
+		"ingester_type":   "kafka",
+		"kafka_topic":     "logs",
+		"kafka_partition": "3",
+		"kafka_offset":    "42",
+		"kafka_key":       "user-7",
+		"trace":           "abc",
+	}
+	for k, v := range want {
+		if msg.Attrs[k] != v {
+			t.Errorf("attrs[%q] = %q, want %q", k, msg.Attrs[k], v)
+		}
+	}
+
+	rec.Key = []byte{0xff, 0x00}
+	if got := buildMessage(rec, "id", nil, time.Now()).Attrs["kafka_key"]; got != "/wA=" {
+		t.Errorf("binary key = %q, want base64", got)
+	}
+	rec.Key = nil
+	if _, ok := buildMessage(rec, "id", nil, time.Now()).Attrs["kafka_key"]; ok {
+		t.Error("nil key should not produce kafka_key")
+	}
+}
+
+func TestStripFraming(t *testing.T) {
+	t.Parallel()
+	header := []byte{0, 0, 0, 0, 1}
+	got, err := stripFraming(append(header, 'x'), formatAvro)
+	if err != nil || string(got) != "x" {
+		t.Errorf("avro: got %q, %v", got, err)
+	}
+	// Protobuf message indexes [1, 2]: count 2, then 1 and 2, zigzag.
+	got, err = stripFraming(append(header, 4, 2, 4, 'x'), formatProtobuf)
+	if err != nil || string(got) != "x" {
+		t.Errorf("protobuf: got %q, %v", got, err)
+	}
+	for _, v := range [][]byte{nil, {1, 0, 0, 0, 1, 'x'}, {0, 0, 0}} {
+		if _, err := stripFraming(v, formatAvro); err == nil {
+			t.Errorf("%v: expected error", v)
+		}
+	}
+	if _, err := stripFraming(append(header, 4, 2), formatProtobuf); err == nil {
+		t.Error("truncated message indexes: expected error")
+	}
+}
+
+func TestLoadParserWaitsForSchemaFile(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "schema.avsc")
+	ing := New(Config{Format: formatAvro, SchemaPath: path})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	p, err := ing.loadParser(ctx)
+	if p != nil || err != nil {
+		t.Fatalf("cancelled while waiting: got %v, %v", p, err)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"type": "record", "name": "R"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ing.loadParser(t.Context()); err == nil {
+		t.Error("invalid schema: expected error")
+	}
+}
@@ -6,15 +6,19 @@ import (
 	"fmt"
 	"gastrolog/internal/glid"
 	"log/slog"
+	"regexp"
+	"strconv"
 	"strings"
 
+	"gastrolog/internal/home"
 	"gastrolog/internal/orchestrator"
 )
 
 // ParamDefaults returns the default parameter values for a Kafka ingester.
 func ParamDefaults() map[string]string {
 	return map[string]string{
-		"group": "gastrolog",
+		"group":  "gastrolog",
+		"format": formatRaw,
 	}
 }
 
@@ -26,10 +30,21 @@ func NewFactory() orchestrator.IngesterFactory {
 			return nil, errors.New("kafka ingester: brokers param is required")
 		}
 
-		topic := params["topic"]
-		if topic == "" {
+		topics := splitList(params["topic"])
+		if len(topics) == 0 {
 			return nil, errors.New("kafka ingester: topic param is required")
 		}
+		topicRegex, err := parseBool(params, "topic_regex")
+		if err != nil {
+			return nil, err
+		}
+		if topicRegex {
+			for _, t := range topics {
+				if _, err := regexp.Compile(t); err != nil {
+					return nil, fmt.Errorf("kafka ingester: invalid topic regex %q: %w", t, err)
+				}
+			}
+		}
 
 		group := cmp.Or(params["group"], "gastrolog")
 		tls := params["tls"] == "true"
@@ -53,14 +68,79 @@ func NewFactory() orchestrator.IngesterFactory {
 			brokerList[i] = strings.TrimSpace(brokerList[i])
 		}
 
-		return New(Config{
-			ID:      id.String(),
-			Brokers: brokerList,
-			Topic:   topic,
-			Group:   group,
-			TLS:     tls,
-			SASL:    sasl,
-			Logger:  logger,
-		}), nil
+		cfg := Config{
+			ID:            id.String(),
+			Brokers:       brokerList,
+			Topics:        topics,
+			TopicRegex:    topicRegex,
+			Group:         group,
+			TLS:           tls,
+			SASL:          sasl,
+			Logger:        logger,
+			Format:        cmp.Or(strings.ToLower(params["format"]), formatRaw),
+			PromoteFields: splitList(params["promote_fields"]),
+			MessageField:  strings.TrimSpace(params["message_field"]),
+			MessageType:   strings.TrimSpace(params["message_type"]),
+		}
+		if err := parsePayloadParams(&cfg, params); err != nil {
+			return nil, err
+		}
+		return New(cfg), nil
+	}
+}
+
+// parsePayloadParams validates the payload format and resolves the schema
+// file, a managed file ID, to its path on this node.
+func parsePayloadParams(cfg *Config, params map[string]string) error {
+	switch cfg.Format {
+	case formatRaw:
+		if len(cfg.PromoteFields) > 0 || cfg.MessageField != "" {
+			return errors.New("kafka ingester: promote_fields and message_field need a json, avro or protobuf format")
+		}
+		return nil
+	case formatJSON:
+		return nil
+	case formatAvro, formatProtobuf:
+	default:
+		return fmt.Errorf("kafka ingester: unsupported format %q (supported: raw, json, avro, protobuf)", params["format"])
+	}
+
+	fileID, err := glid.Parse(params["schema_file"])
+	if err != nil {
+		return fmt.Errorf("kafka ingester: invalid schema_file: %w", err)
+	}
+	if fileID.IsZero() {
+		return fmt.Errorf("kafka ingester: schema_file param is required for %s", cfg.Format)
+	}
+	cfg.SchemaPath = home.New(params["_state_dir"]).ManagedFilePath(fileID.String())
+
+	if cfg.Format == formatProtobuf && cfg.MessageType == "" {
+		return errors.New("kafka ingester: message_type param is required for protobuf")
+	}
+	cfg.ConfluentFraming, err = parseBool(params, "confluent_framing")
+	return err
+}
+
+// splitList splits a comma-separated param, dropping empty entries.
+func splitList(s string) []string {
+	var out []string
+	for v := range strings.SplitSeq(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// parseBool parses an optional boolean param.
+func parseBool(params map[string]string, key string) (bool, error) {
+	v := params[key]
+	if v == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("kafka ingester: invalid %s %q: must be true or false", key, v)
 	}
+	return b, nil
 }
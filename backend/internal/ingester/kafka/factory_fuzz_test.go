@@ -2,6 +2,7 @@ package kafka
 
 import (
 	"bytes"
+	"encoding/json"
 	"gastrolog/internal/glid"
 	"log/slog"
 	"testing"
@@ -23,6 +24,12 @@ func FuzzNewFactory(f *testing.F) {
 	f.Add([]byte(""))
 	// Seed: bad SASL mechanism.
 	f.Add([]byte("brokers\x00h:1\x00topic\x00t\x00sasl_mechanism\x00badmech"))
+	// Seed: topic lists, regexes and payload formats.
+	f.Add([]byte("brokers\x00h:1\x00topic\x00a,b\x00topic_regex\x00true"))
+	f.Add([]byte("brokers\x00h:1\x00topic\x00a(\x00topic_regex\x00true"))
+	f.Add([]byte("brokers\x00h:1\x00topic\x00t\x00format\x00json\x00promote_fields\x00level,a.b\x00message_field\x00msg"))
+	f.Add([]byte("brokers\x00h:1\x00topic\x00t\x00format\x00avro\x00schema_file\x0006gk8dn0alqdp17d2b1haovoak\x00confluent_framing\x00true"))
+	f.Add([]byte("brokers\x00h:1\x00topic\x00t\x00format\x00protobuf\x00schema_file\x0006gk8dn0alqdp17d2b1haovoak"))
 
 	f.Fuzz(func(t *testing.T, data []byte) {
 		params := splitParams(data)
@@ -36,6 +43,26 @@ func FuzzNewFactory(f *testing.F) {
 	})
 }
 
+func FuzzAvroDecode(f *testing.F) {
+	d, err := newAvroDecoder([]byte(eventSchema))
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(encodeEvent())
+	f.Add([]byte{})
+	f.Add([]byte{0x01, 0xff})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		out, err := d.decode(data)
+		if err != nil {
+			return
+		}
+		if !json.Valid(out) {
+			t.Fatalf("invalid JSON for %x: %s", data, out)
+		}
+	})
+}
+
 func splitParams(data []byte) map[string]string {
 	parts := bytes.Split(data, []byte{0})
 	m := make(map[string]string, len(parts)/2)
@@ -4,10 +4,13 @@ package kafka
 import (
 	"context"
 	"crypto/tls"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strconv"
 	"time"
+	"unicode/utf8"
 
 	"github.com/twmb/franz-go/pkg/kgo"
 	"github.com/twmb/franz-go/pkg/sasl"
@@ -22,8 +25,18 @@ import (
 const (
 	backoffMin = 100 * time.Millisecond
 	backoffMax = 5 * time.Second
+
+	// ackDrainTimeout is how long shutdown waits for writes already handed
+	// to the pipeline, which still stores them, so their offsets can be
+	// committed.
+	ackDrainTimeout = 5 * time.Second
 )
 
+// errWriteFailed reports that the pipeline failed to store a record. The
+// consumer rejoins its group so the record is consumed again from the last
+// committed offset.
+var errWriteFailed = errors.New("write failed")
+
 // SASLConfig holds SASL authentication parameters.
 type SASLConfig struct {
 	Mechanism string // "plain", "scram-sha-256", "scram-sha-512"
@@ -33,16 +46,28 @@ type SASLConfig struct {
 
 // Config holds Kafka ingester configuration.
 type Config struct {
-	ID      string
-	Brokers []string
-	Topic   string
-	Group   string
-	TLS     bool
-	SASL    *SASLConfig
-	Logger  *slog.Logger
+	ID         string
+	Brokers    []string
+	Topics     []string
+	TopicRegex bool // Topics are regular expressions
+	Group      string
+	TLS        bool
+	SASL       *SASLConfig
+	Logger     *slog.Logger
+
+	// Payload decoding. Format is "raw" (or empty), "json", "avro" or
+	// "protobuf". Avro and Protobuf need a schema file: an .avsc file, or a
+	// descriptor set with MessageType naming the message.
+	Format           string
+	SchemaPath       string
+	MessageType      string
+	ConfluentFraming bool
+	PromoteFields    []string
+	MessageField     string
 }
 
-// Ingester consumes messages from a Kafka topic.
+// Ingester consumes messages from Kafka topics. Offsets are committed only
+// for records the pipeline has acknowledged as stored.
 type Ingester struct {
 	cfg    Config
 	logger *slog.Logger
@@ -68,12 +93,45 @@ func New(cfg Config) *Ingester {
 	}
 }
 
-// Run connects to Kafka and polls messages until ctx is cancelled.
+// Run connects to Kafka and polls messages until ctx is cancelled. If a
+// write fails, it reconnects and resumes from the last committed offsets.
 func (ing *Ingester) Run(ctx context.Context, out chan<- orchestrator.IngestMessage) error {
+	parser, err := ing.loadParser(ctx)
+	if err != nil || ctx.Err() != nil {
+		return err
+	}
+
+	for {
+		err := ing.consume(ctx, parser, out)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if !errors.Is(err, errWriteFailed) {
+			return err
+		}
+		ing.logger.Warn("kafka: rejoining group to consume failed records again", "error", err)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoffMax):
+		}
+	}
+}
+
+// consume runs one consumer group session. Each poll's records are sent to
+// the pipeline and their offsets marked once stored; rebalances wait until
+// then, and only marked offsets are committed.
+func (ing *Ingester) consume(ctx context.Context, parser *payloadParser, out chan<- orchestrator.IngestMessage) error {
 	opts := []kgo.Opt{
 		kgo.SeedBrokers(ing.cfg.Brokers...),
-		kgo.ConsumeTopics(ing.cfg.Topic),
+		kgo.ConsumeTopics(ing.cfg.Topics...),
 		kgo.ConsumerGroup(ing.cfg.Group),
+		kgo.AutoCommitMarks(),
+		kgo.BlockRebalanceOnPoll(),
+	}
+
+	if ing.cfg.TopicRegex {
+		opts = append(opts, kgo.ConsumeRegex())
 	}
 
 	if ing.cfg.TLS {
@@ -98,7 +156,7 @@ func (ing *Ingester) Run(ctx context.Context, out chan<- orchestrator.IngestMess
 
 	ing.logger.Info("kafka ingester starting",
 		"brokers", ing.cfg.Brokers,
-		"topic", ing.cfg.Topic,
+		"topics", ing.cfg.Topics,
 		"group", ing.cfg.Group,
 	)
 
@@ -112,26 +170,90 @@ func (ing *Ingester) Run(ctx context.Context, out chan<- orchestrator.IngestMess
 
 		fetches := client.PollFetches(ctx)
 		if ctx.Err() != nil {
+			client.AllowRebalance()
 			ing.shutdown(client)
 			return nil
 		}
 
 		if ing.handleFetchErrors(fetches, &backoff, ctx) {
+			client.AllowRebalance()
 			continue
 		}
 		backoff = backoffMin // reset on successful fetch
 
-		now := time.Now()
+		stored, err := ing.deliver(ctx, fetches.Records(), parser, out)
+		client.MarkCommitRecords(stored...)
+		client.AllowRebalance()
+		if err != nil {
+			ing.shutdown(client)
+			return err
+		}
+	}
+}
+
+// topicPartition identifies a partition.
+type topicPartition struct {
+	topic     string
+	partition int32
+}
 
-		fetches.EachRecord(func(rec *kgo.Record) {
-			msg := buildMessage(rec, ing.cfg.ID, now)
-			select {
-			case out <- msg:
-			case <-ctx.Done():
-				return
+// deliver sends records to the pipeline and waits until each write is
+// acknowledged. It returns the records whose offsets may be committed: per
+// partition, those before the first one that wasn't stored. The error is
+// ctx's if it was cancelled, or wraps errWriteFailed.
+func (ing *Ingester) deliver(ctx context.Context, records []*kgo.Record, parser *payloadParser, out chan<- orchestrator.IngestMessage) ([]*kgo.Record, error) {
+	now := time.Now()
+	acks := make([]chan error, 0, len(records))
+	var err error
+	for _, rec := range records {
+		ack := make(chan error, 1)
+		msg := buildMessage(rec, ing.cfg.ID, parser, now)
+		msg.Ack = ack
+		select {
+		case out <- msg:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+		if err != nil {
+			break
+		}
+		acks = append(acks, ack)
+	}
+
+	// Writes already queued are still stored after ctx is cancelled, so
+	// their acks are worth waiting for a little longer.
+	waitCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancel()
+	stop := context.AfterFunc(ctx, func() { time.AfterFunc(ackDrainTimeout, cancel) })
+	defer stop()
+
+	stored := make([]*kgo.Record, 0, len(acks))
+	failed := make(map[topicPartition]bool)
+	for i, ack := range acks {
+		rec := records[i]
+		tp := topicPartition{rec.Topic, rec.Partition}
+		if failed[tp] {
+			continue
+		}
+		var werr error
+		select {
+		case werr = <-ack:
+			if werr != nil && err == nil {
+				err = fmt.Errorf("%w: %s[%d] offset %d: %w", errWriteFailed, rec.Topic, rec.Partition, rec.Offset, werr)
 			}
-		})
+		case <-waitCtx.Done():
+			werr = ctx.Err()
+		}
+		if werr != nil {
+			failed[tp] = true
+			continue
+		}
+		stored = append(stored, rec)
+	}
+	if err == nil {
+		err = ctx.Err()
 	}
+	return stored, err
 }
 
 // shouldExit handles the pressure-gate wait and returns true if the loop
@@ -146,11 +268,11 @@ func (ing *Ingester) shouldExit(ctx context.Context) bool {
 	return ctx.Err() != nil
 }
 
-// shutdown logs the stop, then tries to commit uncommitted offsets on a
-// background context so in-flight offsets aren't lost at shutdown.
+// shutdown logs the stop, then commits the offsets of stored records on a
+// background context so they aren't consumed again after a restart.
 func (ing *Ingester) shutdown(client *kgo.Client) {
 	ing.logger.Info("kafka ingester stopping")
-	if err := client.CommitUncommittedOffsets(context.Background()); err != nil {
+	if err := client.CommitMarkedOffsets(context.Background()); err != nil {
 		ing.logger.Warn("kafka: failed to commit offsets on shutdown", "error", err)
 	}
 }
@@ -180,24 +302,50 @@ func (ing *Ingester) handleFetchErrors(fetches kgo.Fetches, backoff *time.Durati
 }
 
 // buildMessage converts a kgo.Record into an orchestrator.IngestMessage.
-func buildMessage(rec *kgo.Record, ingesterID string, now time.Time) orchestrator.IngestMessage {
-	attrs := make(map[string]string, len(rec.Headers)+5)
+// Headers become attributes, as do fields the parser promotes; the record's
+// own kafka_* attributes take precedence. A value the parser can't decode
+// is kept as is, with the reason in the decode_error attribute.
+func buildMessage(rec *kgo.Record, ingesterID string, parser *payloadParser, now time.Time) orchestrator.IngestMessage {
+	attrs := make(map[string]string, len(rec.Headers)+6)
+	for _, h := range rec.Headers {
+		attrs[h.Key] = string(h.Value)
+	}
+
+	raw := rec.Value
+	if parser != nil {
+		line, err := parser.parse(rec.Value, attrs)
+		if err != nil {
+			attrs["decode_error"] = err.Error()
+		} else {
+			raw = line
+		}
+	}
+
 	attrs["ingester_type"] = "kafka"
 	attrs["kafka_topic"] = rec.Topic
 	attrs["kafka_partition"] = strconv.Itoa(int(rec.Partition))
 	attrs["kafka_offset"] = strconv.FormatInt(rec.Offset, 10)
-	for _, h := range rec.Headers {
-		attrs[h.Key] = string(h.Value)
+	if rec.Key != nil {
+		attrs["kafka_key"] = keyString(rec.Key)
 	}
 	return orchestrator.IngestMessage{
 		Attrs:      attrs,
-		Raw:        rec.Value,
+		Raw:        raw,
 		SourceTS:   rec.Timestamp,
 		IngestTS:   now,
 		IngesterID: ingesterID,
 	}
 }
 
+// keyString renders a record key as an attribute value: as is if it is
+// text, base64-encoded otherwise.
+func keyString(key []byte) string {
+	if utf8.Valid(key) {
+		return string(key)
+	}
+	return base64.StdEncoding.EncodeToString(key)
+}
+
 // buildSASLMechanism constructs the appropriate SASL mechanism.
 func buildSASLMechanism(cfg *SASLConfig) (sasl.Mechanism, error) {
 	switch cfg.Mechanism {
@@ -1,8 +1,16 @@
 package kafka
 
 import (
+	"context"
+	"errors"
 	"gastrolog/internal/glid"
+	"slices"
 	"testing"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"gastrolog/internal/orchestrator"
 )
 
 // --- Factory Tests ---
@@ -370,7 +378,7 @@ func TestNewIngester(t *testing.T) {
 	ing := New(Config{
 		ID:      id,
 		Brokers: []string{"b1:9092", "b2:9092"},
-		Topic:   "test-topic",
+		Topics:  []string{"test-topic"},
 		Group:   "test-group",
 		TLS:     true,
 		SASL: &SASLConfig{
@@ -386,8 +394,8 @@ func TestNewIngester(t *testing.T) {
 	if ing.cfg.ID != id {
 		t.Errorf("ID: expected %q, got %q", id, ing.cfg.ID)
 	}
-	if ing.cfg.Topic != "test-topic" {
-		t.Errorf("topic: expected test-topic, got %q", ing.cfg.Topic)
+	if len(ing.cfg.Topics) != 1 || ing.cfg.Topics[0] != "test-topic" {
+		t.Errorf("topics: expected [test-topic], got %q", ing.cfg.Topics)
 	}
 	if ing.cfg.Group != "test-group" {
 		t.Errorf("group: expected test-group, got %q", ing.cfg.Group)
@@ -432,8 +440,8 @@ func TestFactoryAllParams(t *testing.T) {
 	if ki.cfg.Brokers[1] != "broker2:9092" {
 		t.Errorf("broker 1: expected broker2:9092, got %q", ki.cfg.Brokers[1])
 	}
-	if ki.cfg.Topic != "application-logs" {
-		t.Errorf("topic: expected application-logs, got %q", ki.cfg.Topic)
+	if len(ki.cfg.Topics) != 1 || ki.cfg.Topics[0] != "application-logs" {
+		t.Errorf("topics: expected [application-logs], got %q", ki.cfg.Topics)
 	}
 	if ki.cfg.Group != "log-consumers" {
 		t.Errorf("group: expected log-consumers, got %q", ki.cfg.Group)
@@ -518,3 +526,206 @@ func TestFactoryIDPropagated(t *testing.T) {
 		t.Errorf("ID: expected %q, got %q", id.String(), ki.cfg.ID)
 	}
 }
+
+func TestFactoryTopicList(t *testing.T) {
+	t.Parallel()
+	ing, err := NewFactory()(glid.New(), map[string]string{
+		"brokers": "localhost:9092",
+		"topic":   "app-logs, audit,,",
+	}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ki := ing.(*Ingester)
+	if len(ki.cfg.Topics) != 2 || ki.cfg.Topics[0] != "app-logs" || ki.cfg.Topics[1] != "audit" {
+		t.Errorf("topics: expected [app-logs audit], got %q", ki.cfg.Topics)
+	}
+	if ki.cfg.TopicRegex {
+		t.Error("TopicRegex should be false by default")
+	}
+}
+
+func TestFactoryTopicRegex(t *testing.T) {
+	t.Parallel()
+	factory := NewFactory()
+	ing, err := factory(glid.New(), map[string]string{
+		"brokers":     "localhost:9092",
+		"topic":       `logs\..*`,
+		"topic_regex": "true",
+	}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ing.(*Ingester).cfg.TopicRegex {
+		t.Error("TopicRegex should be true")
+	}
+
+	for _, params := range []map[string]string{
+		{"topic": "logs.(", "topic_regex": "true"},
+		{"topic": "logs", "topic_regex": "maybe"},
+	} {
+		params["brokers"] = "localhost:9092"
+		if _, err := factory(glid.New(), params, nil); err == nil {
+			t.Errorf("params %v: expected error", params)
+		}
+	}
+}
+
+func TestFactoryFormats(t *testing.T) {
+	t.Parallel()
+	factory := NewFactory()
+	fileID := glid.New().String()
+	base := func(kv ...string) map[string]string {
+		m := map[string]string{"brokers": "localhost:9092", "topic": "logs", "_state_dir": "/var/lib/gastrolog"}
+		for i := 0; i+1 < len(kv); i += 2 {
+			m[kv[i]] = kv[i+1]
+		}
+		return m
+	}
+
+	valid := []map[string]string{
+		base(),
+		base("format", "raw"),
+		base("format", "JSON", "promote_fields", "level, service.name", "message_field", "msg"),
+		base("format", "avro", "schema_file", fileID, "confluent_framing", "true"),
+		base("format", "protobuf", "schema_file", fileID, "message_type", "acme.LogEvent"),
+	}
+	for _, params := range valid {
+		if _, err := factory(glid.New(), params, nil); err != nil {
+			t.Errorf("params %v: unexpected error: %v", params, err)
+		}
+	}
+
+	invalid := []map[string]string{
+		base("format", "xml"),
+		base("promote_fields", "level"),
+		base("message_field", "msg"),
+		base("format", "avro"),
+		base("format", "avro", "schema_file", "not-a-glid"),
+		base("format", "protobuf", "schema_file", fileID),
+		base("format", "avro", "schema_file", fileID, "confluent_framing", "yes please"),
+	}
+	for _, params := range invalid {
+		if _, err := factory(glid.New(), params, nil); err == nil {
+			t.Errorf("params %v: expected error", params)
+		}
+	}
+
+	ing, err := factory(glid.New(), base("format", "protobuf", "schema_file", fileID, "message_type", "acme.LogEvent"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := ing.(*Ingester).cfg
+	if want := "/var/lib/gastrolog/managed-files/" + fileID + "/data"; cfg.SchemaPath != want {
+		t.Errorf("schema path: expected %q, got %q", want, cfg.SchemaPath)
+	}
+	if cfg.Format != formatProtobuf || cfg.MessageType != "acme.LogEvent" {
+		t.Errorf("format %q, message type %q", cfg.Format, cfg.MessageType)
+	}
+}
+
+// --- Delivery Tests ---
+
+// ackPipeline stands in for the orchestrator: it acknowledges every
+// message it receives with the error fail returns for it.
+func ackPipeline(t *testing.T, out <-chan orchestrator.IngestMessage, fail func(orchestrator.IngestMessage) error) {
+	t.Helper()
+	go func() {
+		for msg := range out {
+			msg.Ack <- fail(msg)
+		}
+	}()
+}
+
+func testRecords(partitions, perPartition int) []*kgo.Record {
+	var recs []*kgo.Record
+	for off := range perPartition {
+		for p := range partitions {
+			recs = append(recs, &kgo.Record{Topic: "logs", Partition: int32(p), Offset: int64(off), Value: []byte("x")})
+		}
+	}
+	return recs
+}
+
+func TestDeliverCommitsAcknowledgedRecords(t *testing.T) {
+	t.Parallel()
+	out := make(chan orchestrator.IngestMessage)
+	defer close(out)
+	ackPipeline(t, out, func(orchestrator.IngestMessage) error { return nil })
+
+	recs := testRecords(2, 3)
+	stored, err := New(Config{}).deliver(t.Context(), recs, nil, out)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stored) != len(recs) {
+		t.Errorf("stored %d of %d records", len(stored), len(recs))
+	}
+}
+
+func TestDeliverStopsPartitionAtFailedWrite(t *testing.T) {
+	t.Parallel()
+	out := make(chan orchestrator.IngestMessage)
+	defer close(out)
+	ackPipeline(t, out, func(msg orchestrator.IngestMessage) error {
+		if msg.Attrs["kafka_partition"] == "0" && msg.Attrs["kafka_offset"] == "1" {
+			return errors.New("disk full")
+		}
+		return nil
+	})
+
+	stored, err := New(Config{}).deliver(t.Context(), testRecords(2, 3), nil, out)
+	if !errors.Is(err, errWriteFailed) {
+		t.Fatalf("expected errWriteFailed, got %v", err)
+	}
+	var p0, p1 []int64
+	for _, rec := range stored {
+		if rec.Partition == 0 {
+			p0 = append(p0, rec.Offset)
+		} else {
+			p1 = append(p1, rec.Offset)
+		}
+	}
+	// Offset 2 of partition 0 was stored, but committing it would skip 1.
+	if !slices.Equal(p0, []int64{0}) {
+		t.Errorf("partition 0: committed %v, want [0]", p0)
+	}
+	if !slices.Equal(p1, []int64{0, 1, 2}) {
+		t.Errorf("partition 1: committed %v, want [0 1 2]", p1)
+	}
+}
+
+func TestDeliverWaitsForQueuedWritesOnShutdown(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(t.Context())
+	out := make(chan orchestrator.IngestMessage, 10)
+	recs := testRecords(1, 3)
+
+	done := make(chan struct{})
+	var stored []*kgo.Record
+	var err error
+	go func() {
+		defer close(done)
+		stored, err = New(Config{}).deliver(ctx, recs, nil, out)
+	}()
+
+	// All three are queued; cancel before any is acknowledged, as when the
+	// ingester stops while the pipeline is still writing.
+	var msgs []orchestrator.IngestMessage
+	for range recs {
+		msgs = append(msgs, <-out)
+	}
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+	for _, msg := range msgs {
+		msg.Ack <- nil
+	}
+	<-done
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if len(stored) != len(recs) {
+		t.Errorf("stored %d of %d records acknowledged after cancel", len(stored), len(recs))
+	}
+}
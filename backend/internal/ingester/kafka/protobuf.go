@@ -0,0 +1,63 @@
+package kafka
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// protobufDecoder decodes Protobuf messages of one type to JSON, using a
+// descriptor set rather than generated code.
+type protobufDecoder struct {
+	desc  protoreflect.MessageDescriptor
+	types *dynamicpb.Types
+}
+
+// newProtobufDecoder loads a FileDescriptorSet, as written by
+// `protoc --include_imports --descriptor_set_out` or `buf build -o`, and
+// looks up the message type by its full name.
+func newProtobufDecoder(data []byte, messageType string) (*protobufDecoder, error) {
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("schema file is not a Protobuf descriptor set: %w", err)
+	}
+	files, err := protodesc.NewFiles(&set)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Protobuf descriptor set: %w", err)
+	}
+	d, err := files.FindDescriptorByName(protoreflect.FullName(messageType))
+	if err != nil {
+		return nil, fmt.Errorf("message type %q not found in descriptor set", messageType)
+	}
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a message type", messageType)
+	}
+	return &protobufDecoder{desc: md, types: dynamicpb.NewTypes(files)}, nil
+}
+
+func (d *protobufDecoder) decode(value []byte) ([]byte, error) {
+	msg := dynamicpb.NewMessage(d.desc)
+	if err := (proto.UnmarshalOptions{Resolver: d.types}).Unmarshal(value, msg); err != nil {
+		return nil, fmt.Errorf("invalid Protobuf message: %w", err)
+	}
+	b, err := protojson.MarshalOptions{UseProtoNames: true, Resolver: d.types}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// protojson deliberately varies its whitespace; compact it so equal
+	// messages give equal log lines.
+	var out bytes.Buffer
+	if err := json.Compact(&out, b); err != nil {
+		return nil, errors.New("protojson produced invalid JSON")
+	}
+	return out.Bytes(), nil
+}
@@ -0,0 +1,180 @@
+package kafka
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// logEventSet is a descriptor set for:
+//
+//	package test;
+//	message LogEvent {
+//	  string message = 1;
+//	  int64 code = 2;
+//	  map<string, string> labels = 3;
+//	  Source source = 4;
+//	}
+//	message Source { string host = 1; }
+func logEventSet() *descriptorpb.FileDescriptorSet {
+	field := func(name string, num int32, typ descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(num),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     typ.Enum(),
+		}
+		if typeName != "" {
+			f.TypeName = proto.String(typeName)
+		}
+		return f
+	}
+	labels := field("labels", 3, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".test.LogEvent.LabelsEntry")
+	labels.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	return &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{{
+		Name:    proto.String("test.proto"),
+		Package: proto.String("test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("LogEvent"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("message", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+					field("code", 2, descriptorpb.FieldDescriptorProto_TYPE_INT64, ""),
+					labels,
+					field("source", 4, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".test.Source"),
+				},
+				NestedType: []*descriptorpb.DescriptorProto{{
+					Name: proto.String("LabelsEntry"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						field("key", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+						field("value", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+					},
+					Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+				}},
+			},
+			{
+				Name:  proto.String("Source"),
+				Field: []*descriptorpb.FieldDescriptorProto{field("host", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, "")},
+			},
+		},
+	}}}
+}
+
+// encodeLogEvent marshals a test.LogEvent built through reflection.
+func encodeLogEvent(t *testing.T, set *descriptorpb.FileDescriptorSet) []byte {
+	t.Helper()
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := files.FindDescriptorByName("test.LogEvent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	md := d.(protoreflect.MessageDescriptor)
+	msg := dynamicpb.NewMessage(md)
+	msg.Set(md.Fields().ByName("message"), protoreflect.ValueOfString("disk full"))
+	msg.Set(md.Fields().ByName("code"), protoreflect.ValueOfInt64(507))
+	labels := msg.Mutable(md.Fields().ByName("labels")).Map()
+	labels.Set(protoreflect.ValueOfString("env").MapKey(), protoreflect.ValueOfString("prod"))
+	source := msg.Mutable(md.Fields().ByName("source")).Message()
+	source.Set(source.Descriptor().Fields().ByName("host"), protoreflect.ValueOfString("web-1"))
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestProtobufDecode(t *testing.T) {
+	t.Parallel()
+	set := logEventSet()
+	data, err := proto.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := newProtobufDecoder(data, "test.LogEvent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := d.decode(encodeLogEvent(t, set))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// int64 is a string in proto3 JSON.
+	want := `{"message":"disk full","code":"507","labels":{"env":"prod"},"source":{"host":"web-1"}}`
+	if string(got) != want {
+		t.Errorf("decoded:\n got %s\nwant %s", got, want)
+	}
+
+	if _, err := d.decode([]byte{0xff}); err == nil {
+		t.Error("invalid message: expected error")
+	}
+}
+
+func TestProtobufDecoderErrors(t *testing.T) {
+	t.Parallel()
+	data, err := proto.Marshal(logEventSet())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newProtobufDecoder(data, "test.Missing"); err == nil {
+		t.Error("unknown message type: expected error")
+	}
+	if _, err := newProtobufDecoder(data, "test"); err == nil {
+		t.Error("package name as message type: expected error")
+	}
+	if _, err := newProtobufDecoder([]byte("syntax = \"proto3\";"), "test.LogEvent"); err == nil {
+		t.Error(".proto source instead of a descriptor set: expected error")
+	}
+}
+
+// TestLoadParserProtobuf covers the schema file path from Config through
+// to a working parser, with Confluent framing and field promotion.
+func TestLoadParserProtobuf(t *testing.T) {
+	t.Parallel()
+	set := logEventSet()
+	data, err := proto.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "schema.binpb")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	ing := New(Config{
+		Format:           formatProtobuf,
+		SchemaPath:       path,
+		MessageType:      "test.LogEvent",
+		ConfluentFraming: true,
+		PromoteFields:    []string{"source.host", "labels.env"},
+		MessageField:     "message",
+	})
+	parser, err := ing.loadParser(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Magic byte, schema ID 42, message indexes [0] as the single 0 byte.
+	value := append([]byte{0, 0, 0, 0, 42, 0}, encodeLogEvent(t, set)...)
+	attrs := map[string]string{}
+	raw, err := parser.parse(value, attrs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(raw) != "disk full" {
+		t.Errorf("raw = %q, want message field", raw)
+	}
+	if attrs["source.host"] != "web-1" || attrs["labels.env"] != "prod" {
+		t.Errorf("promoted attrs = %v", attrs)
+	}
+}
@@ -0,0 +1,103 @@
+// Package listentls builds server-side TLS configs for listener ingesters
+// (syslog, RELP, HTTP, OTLP, Fluent Forward) from their params:
+//
+//	tls             "true" enables TLS
+//	tls_cert        server certificate name in the cert manager
+//	tls_ca          CA file path; when set, clients must present a
+//	                certificate signed by it (mutual TLS)
+//	tls_allowed_cn  optional wildcard pattern for the client certificate CN
+package listentls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gastrolog/internal/cert"
+)
+
+// BuildConfig builds a *tls.Config from ingester parameters.
+// Returns nil if TLS is not configured (tls param is empty or "false").
+//
+// The server certificate is resolved from the cert manager by name
+// (tls_cert param). For mutual TLS, tls_ca specifies the CA file path
+// and tls_allowed_cn optionally restricts client certificate CNs.
+func BuildConfig(params map[string]string, certMgr *cert.Manager) (*tls.Config, error) {
+	if params["tls"] != "true" {
+		return nil, nil
+	}
+
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	// Resolve server certificate from the cert manager by name.
+	// Uses GetCertificate callback so cert rotations are picked up automatically.
+	certName := params["tls_cert"]
+	if certName != "" {
+		if certMgr == nil {
+			return nil, errors.New("TLS: cert manager not available")
+		}
+		// Verify the cert exists at config time.
+		if certMgr.Certificate(certName) == nil {
+			return nil, fmt.Errorf("TLS: certificate %q not found in cert manager", certName)
+		}
+		cfg.GetCertificate = func(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+			c := certMgr.Certificate(certName)
+			if c == nil {
+				return nil, fmt.Errorf("TLS: certificate %q no longer available", certName)
+			}
+			return c, nil
+		}
+	}
+
+	// Load CA for client certificate verification (mutual TLS).
+	caFile := params["tls_ca"]
+	if caFile != "" {
+		caPEM, err := os.ReadFile(caFile) //nolint:gosec // G304: CA file path from user config
+		if err != nil {
+			return nil, fmt.Errorf("read TLS CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("TLS CA file contains no valid certificates")
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+
+		// Optional CN-based ACL.
+		if pattern := params["tls_allowed_cn"]; pattern != "" {
+			if _, err := filepath.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("invalid tls_allowed_cn %q: %w", pattern, err)
+			}
+			cfg.VerifyPeerCertificate = buildCNVerifier(pattern)
+		}
+	}
+
+	return cfg, nil
+}
+
+// buildCNVerifier returns a VerifyPeerCertificate function that checks
+// the client certificate's Common Name against a wildcard pattern.
+func buildCNVerifier(pattern string) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return errors.New("no client certificate provided")
+		}
+		cert, err := x509.ParseCertificate(rawCerts[0])
+		if err != nil {
+			return fmt.Errorf("parse client certificate: %w", err)
+		}
+		matched, err := filepath.Match(pattern, cert.Subject.CommonName)
+		if err != nil {
+			return fmt.Errorf("invalid CN pattern %q: %w", pattern, err)
+		}
+		if !matched {
+			return fmt.Errorf("client CN %q does not match allowed pattern %q", cert.Subject.CommonName, pattern)
+		}
+		return nil
+	}
+}
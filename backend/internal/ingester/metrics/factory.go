@@ -19,6 +19,7 @@ const (
 type StatsSource interface {
 	IngestQueueDepth() int
 	IngestQueueCapacity() int
+	IngestDiskQueueStats() (entries, bytes, maxBytes int64)
 	VaultSnapshots() []orchestrator.VaultSnapshot
 }
 
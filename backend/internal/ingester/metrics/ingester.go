@@ -83,6 +83,7 @@ func (m *ingester) collectSystem() orchestrator.IngestMessage {
 	goroutines := runtime.NumGoroutine()
 	queueDepth := m.src.IngestQueueDepth()
 	queueCap := m.src.IngestQueueCapacity()
+	diskEntries, diskBytes, diskMax := m.src.IngestDiskQueueStats()
 
 	now := time.Now()
 
@@ -102,6 +103,10 @@ func (m *ingester) collectSystem() orchestrator.IngestMessage {
 		queueDepth,
 		queueCap,
 	)
+	if diskMax > 0 {
+		raw += fmt.Sprintf(" ingest_disk_queue_entries=%d ingest_disk_queue_bytes=%d ingest_disk_queue_max_bytes=%d",
+			diskEntries, diskBytes, diskMax)
+	}
 
 	return orchestrator.IngestMessage{
 		Attrs: map[string]string{
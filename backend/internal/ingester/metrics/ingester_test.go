@@ -13,11 +13,15 @@ import (
 type fakeStats struct {
 	depth     int
 	capacity  int
+	disk      [3]int64 // entries, bytes, max bytes
 	snapshots []orchestrator.VaultSnapshot
 }
 
 func (f *fakeStats) IngestQueueDepth() int    { return f.depth }
 func (f *fakeStats) IngestQueueCapacity() int { return f.capacity }
+func (f *fakeStats) IngestDiskQueueStats() (int64, int64, int64) {
+	return f.disk[0], f.disk[1], f.disk[2]
+}
 func (f *fakeStats) VaultSnapshots() []orchestrator.VaultSnapshot {
 	return f.snapshots
 }
@@ -0,0 +1,204 @@
+// Package multiline assembles consecutive lines into multiline events, such
+// as stack traces, for line-oriented ingesters.
+//
+// Rules are read from the multiline_* ingester params so every ingester that
+// uses this package accepts the same settings:
+//
+//	multiline_start          regex matching the first line of an event
+//	multiline_continue       regex matching a continuation line
+//	multiline_indent         "true": lines starting with a space or tab continue
+//	multiline_max_lines      max lines per event (default 500)
+//	multiline_max_bytes      max bytes per event (default 1 MiB)
+//	multiline_flush_timeout  emit a pending event after this long without a
+//	                         new line (default 1s)
+//
+// A line matching multiline_start always begins a new event. Otherwise it
+// continues the pending event if it matches multiline_continue or the indent
+// rule. With only multiline_start set, every non-matching line continues.
+package multiline
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultMaxLines     = 500
+	DefaultMaxBytes     = 1 << 20
+	DefaultFlushTimeout = time.Second
+)
+
+// Config holds multiline assembly rules. A nil *Config disables assembly.
+type Config struct {
+	Start        *regexp.Regexp // nil = no start rule
+	Continue     *regexp.Regexp // nil = no continuation rule
+	Indent       bool
+	MaxLines     int
+	MaxBytes     int
+	FlushTimeout time.Duration
+}
+
+// ParamDefaults returns the default values of the multiline_* params.
+func ParamDefaults() map[string]string {
+	return map[string]string{
+		"multiline_max_lines":     strconv.Itoa(DefaultMaxLines),
+		"multiline_max_bytes":     strconv.Itoa(DefaultMaxBytes),
+		"multiline_flush_timeout": DefaultFlushTimeout.String(),
+	}
+}
+
+// ParseParams reads multiline rules from ingester params. It returns nil when
+// no rule (start, continue or indent) is configured.
+func ParseParams(params map[string]string) (*Config, error) {
+	cfg := &Config{
+		MaxLines:     DefaultMaxLines,
+		MaxBytes:     DefaultMaxBytes,
+		FlushTimeout: DefaultFlushTimeout,
+	}
+
+	var err error
+	if v := params["multiline_start"]; v != "" {
+		if cfg.Start, err = regexp.Compile(v); err != nil {
+			return nil, fmt.Errorf("invalid multiline_start: %w", err)
+		}
+	}
+	if v := params["multiline_continue"]; v != "" {
+		if cfg.Continue, err = regexp.Compile(v); err != nil {
+			return nil, fmt.Errorf("invalid multiline_continue: %w", err)
+		}
+	}
+	if v := params["multiline_indent"]; v != "" {
+		if cfg.Indent, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("invalid multiline_indent %q: must be true or false", v)
+		}
+	}
+	if cfg.MaxLines, err = positiveInt(params, "multiline_max_lines", DefaultMaxLines); err != nil {
+		return nil, err
+	}
+	if cfg.MaxBytes, err = positiveInt(params, "multiline_max_bytes", DefaultMaxBytes); err != nil {
+		return nil, err
+	}
+	if v := params["multiline_flush_timeout"]; v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid multiline_flush_timeout %q: must be a positive duration", v)
+		}
+		cfg.FlushTimeout = d
+	}
+
+	if cfg.Start == nil && cfg.Continue == nil && !cfg.Indent {
+		for _, k := range []string{"multiline_max_lines", "multiline_max_bytes", "multiline_flush_timeout"} {
+			if params[k] != "" {
+				return nil, errors.New(k + " requires multiline_start, multiline_continue or multiline_indent")
+			}
+		}
+		return nil, nil
+	}
+	return cfg, nil
+}
+
+func positiveInt(params map[string]string, key string, def int) (int, error) {
+	v := params[key]
+	if v == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid %s %q: must be a positive integer", key, v)
+	}
+	return n, nil
+}
+
+// continues reports whether line continues a pending event.
+func (c *Config) continues(line []byte) bool {
+	switch {
+	case c.Start != nil && c.Start.Match(line):
+		return false
+	case c.Continue != nil && c.Continue.Match(line):
+		return true
+	case c.Indent && len(line) > 0 && (line[0] == ' ' || line[0] == '\t'):
+		return true
+	default:
+		return c.Start != nil && c.Continue == nil && !c.Indent
+	}
+}
+
+// Event is an assembled event. First and Last carry the metadata passed
+// with its first and last line.
+type Event[T any] struct {
+	Raw   []byte // lines joined by "\n"
+	Lines int
+	First T
+	Last  T
+}
+
+// Assembler joins lines into events according to a Config. T is per-line
+// metadata supplied by the caller, such as a file offset or a timestamp.
+// With a nil Config every line is its own event. Not safe for concurrent use.
+type Assembler[T any] struct {
+	cfg *Config
+
+	buf     []byte
+	lines   int
+	first   T
+	last    T
+	lastAdd time.Time
+}
+
+// NewAssembler creates an assembler for cfg, which may be nil.
+func NewAssembler[T any](cfg *Config) *Assembler[T] {
+	return &Assembler[T]{cfg: cfg}
+}
+
+// Add feeds one line, without its line terminator, received at now. It
+// returns the event completed by this line, if any: the pending event when
+// line starts a new one or would push it past its limits. Unless assembly is
+// disabled, line itself stays pending until a later Add or Flush. The line is
+// copied.
+func (a *Assembler[T]) Add(line []byte, meta T, now time.Time) (Event[T], bool) {
+	if a.cfg == nil {
+		return Event[T]{Raw: append([]byte(nil), line...), Lines: 1, First: meta, Last: meta}, true
+	}
+
+	if a.lines > 0 && a.cfg.continues(line) &&
+		a.lines < a.cfg.MaxLines && len(a.buf)+1+len(line) <= a.cfg.MaxBytes {
+		a.buf = append(a.buf, '\n')
+		a.buf = append(a.buf, line...)
+		a.lines++
+		a.last = meta
+		a.lastAdd = now
+		return Event[T]{}, false
+	}
+
+	ev, ok := a.Flush()
+	a.buf = append(a.buf, line...)
+	a.lines = 1
+	a.first, a.last = meta, meta
+	a.lastAdd = now
+	return ev, ok
+}
+
+// Flush returns and clears the pending event, if any.
+func (a *Assembler[T]) Flush() (Event[T], bool) {
+	if a.lines == 0 {
+		return Event[T]{}, false
+	}
+	ev := Event[T]{Raw: a.buf, Lines: a.lines, First: a.first, Last: a.last}
+	var zero T
+	a.buf, a.lines, a.first, a.last = nil, 0, zero, zero
+	return ev, true
+}
+
+// Pending returns the first-line metadata of the pending event, if any.
+func (a *Assembler[T]) Pending() (T, bool) {
+	return a.first, a.lines > 0
+}
+
+// Expired reports whether the pending event has waited for more lines for at
+// least the flush timeout.
+func (a *Assembler[T]) Expired(now time.Time) bool {
+	return a.lines > 0 && now.Sub(a.lastAdd) >= a.cfg.FlushTimeout
+}
@@ -0,0 +1,150 @@
+package multiline
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+var t0 = time.Date(2025, 6, 15, 10, 0, 0, 0, time.UTC)
+
+// assemble feeds lines through an assembler built from params and returns
+// the raw events, flushing whatever is pending at the end.
+func assemble(t *testing.T, params map[string]string, lines ...string) []string {
+	t.Helper()
+	cfg, err := ParseParams(params)
+	if err != nil {
+		t.Fatalf("ParseParams: %v", err)
+	}
+	a := NewAssembler[int](cfg)
+	var out []string
+	for i, l := range lines {
+		if ev, ok := a.Add([]byte(l), i, t0); ok {
+			out = append(out, string(ev.Raw))
+		}
+	}
+	if ev, ok := a.Flush(); ok {
+		out = append(out, string(ev.Raw))
+	}
+	return out
+}
+
+func TestStartPattern(t *testing.T) {
+	got := assemble(t, map[string]string{"multiline_start": `^\d{4}-\d{2}-\d{2}`},
+		"2025-06-15 ERROR boom",
+		"java.lang.IllegalStateException: bad",
+		"\tat com.example.Foo.bar(Foo.java:10)",
+		"2025-06-15 INFO ok",
+	)
+	want := []string{
+		"2025-06-15 ERROR boom\njava.lang.IllegalStateException: bad\n\tat com.example.Foo.bar(Foo.java:10)",
+		"2025-06-15 INFO ok",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestContinuePatternAndIndent(t *testing.T) {
+	got := assemble(t, map[string]string{"multiline_continue": `^Caused by:`, "multiline_indent": "true"},
+		"Exception in thread main",
+		"    at Main.run(Main.java:5)",
+		"Caused by: java.io.IOException",
+		"    at Main.io(Main.java:9)",
+		"next event",
+	)
+	if len(got) != 2 || !strings.HasPrefix(got[1], "next event") || strings.Count(got[0], "\n") != 3 {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestStartWithIndentOnlyJoinsIndented(t *testing.T) {
+	// With a start rule and the indent rule, an unindented non-start line
+	// begins a new event.
+	got := assemble(t, map[string]string{"multiline_start": `^Traceback`, "multiline_indent": "true"},
+		"Traceback (most recent call last):",
+		`  File "app.py", line 3`,
+		"plain line",
+	)
+	want := []string{"Traceback (most recent call last):\n  File \"app.py\", line 3", "plain line"}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	got := assemble(t, map[string]string{"multiline_indent": "true", "multiline_max_lines": "2"},
+		"a", " 1", " 2", " 3")
+	if want := []string{"a\n 1", " 2\n 3"}; !slices.Equal(got, want) {
+		t.Errorf("max lines: got %q, want %q", got, want)
+	}
+
+	got = assemble(t, map[string]string{"multiline_indent": "true", "multiline_max_bytes": "6"},
+		"abc", " de", " f")
+	if want := []string{"abc", " de\n f"}; !slices.Equal(got, want) {
+		t.Errorf("max bytes: got %q, want %q", got, want)
+	}
+}
+
+func TestEventMetadata(t *testing.T) {
+	cfg, _ := ParseParams(map[string]string{"multiline_indent": "true"})
+	a := NewAssembler[int](cfg)
+	a.Add([]byte("a"), 10, t0)
+	a.Add([]byte(" b"), 20, t0)
+	if first, ok := a.Pending(); !ok || first != 10 {
+		t.Errorf("Pending = %d, %v; want 10, true", first, ok)
+	}
+	ev, ok := a.Add([]byte("c"), 30, t0)
+	if !ok || ev.First != 10 || ev.Last != 20 || ev.Lines != 2 {
+		t.Errorf("event = %+v, %v", ev, ok)
+	}
+}
+
+func TestExpired(t *testing.T) {
+	cfg, _ := ParseParams(map[string]string{"multiline_indent": "true", "multiline_flush_timeout": "2s"})
+	a := NewAssembler[int](cfg)
+	if a.Expired(t0) {
+		t.Error("empty assembler should not expire")
+	}
+	a.Add([]byte("a"), 0, t0)
+	if a.Expired(t0.Add(time.Second)) {
+		t.Error("expired before timeout")
+	}
+	if !a.Expired(t0.Add(2 * time.Second)) {
+		t.Error("not expired after timeout")
+	}
+}
+
+func TestDisabledPassesLinesThrough(t *testing.T) {
+	cfg, err := ParseParams(map[string]string{})
+	if err != nil || cfg != nil {
+		t.Fatalf("ParseParams(empty) = %v, %v; want nil, nil", cfg, err)
+	}
+	a := NewAssembler[int](nil)
+	line := []byte("one")
+	ev, ok := a.Add(line, 1, t0)
+	line[0] = 'X'
+	if !ok || string(ev.Raw) != "one" {
+		t.Errorf("event = %q, %v; want a copy of the line", ev.Raw, ok)
+	}
+	if _, ok := a.Pending(); ok {
+		t.Error("nothing should be pending")
+	}
+}
+
+func TestParseParamsErrors(t *testing.T) {
+	for _, params := range []map[string]string{
+		{"multiline_start": "("},
+		{"multiline_continue": "[a-"},
+		{"multiline_indent": "maybe"},
+		{"multiline_indent": "true", "multiline_max_lines": "0"},
+		{"multiline_indent": "true", "multiline_max_bytes": "lots"},
+		{"multiline_indent": "true", "multiline_flush_timeout": "-1s"},
+		{"multiline_max_lines": "10"},
+	} {
+		if _, err := ParseParams(params); err == nil {
+			t.Errorf("ParseParams(%v): expected error", params)
+		}
+	}
+}
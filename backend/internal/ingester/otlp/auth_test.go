@@ -0,0 +1,113 @@
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+
+	"gastrolog/internal/ingester/httpauth"
+	"gastrolog/internal/ingester/tlstest"
+)
+
+func TestOTLPHTTPAuth(t *testing.T) {
+	t.Parallel()
+	auth, err := httpauth.ParseParams(map[string]string{"auth": "bearer", "auth_token": "s3cret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpAddr, _, out := startOTLP(t, Config{ID: "test-otlp", Auth: auth}, 10, nil)
+
+	data, _ := proto.Marshal(makeExportRequest(nil, nil, makeStringLogRecord("authed", time.Now())))
+	post := func(token string) int {
+		req, _ := http.NewRequest(http.MethodPost, "http://"+httpAddr+"/v1/logs", bytes.NewReader(data))
+		req.Header.Set("Content-Type", "application/x-protobuf")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("POST failed: %v", err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	if code := post(""); code != http.StatusUnauthorized {
+		t.Errorf("no token: expected 401, got %d", code)
+	}
+	if code := post("wrong"); code != http.StatusUnauthorized {
+		t.Errorf("wrong token: expected 401, got %d", code)
+	}
+	if code := post("s3cret"); code != http.StatusOK {
+		t.Fatalf("valid token: expected 200, got %d", code)
+	}
+	if msg := recv(t, out); string(msg.Raw) != "authed" {
+		t.Errorf("raw: expected %q, got %q", "authed", msg.Raw)
+	}
+}
+
+func TestOTLPGRPCAuth(t *testing.T) {
+	t.Parallel()
+	auth, err := httpauth.ParseParams(map[string]string{"auth": "bearer", "auth_token": "s3cret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, grpcAddr, out := startOTLP(t, Config{ID: "test-otlp", Auth: auth}, 10, nil)
+
+	conn, err := grpc.NewClient(grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("grpc dial: %v", err)
+	}
+	defer conn.Close()
+	client := collogspb.NewLogsServiceClient(conn)
+	req := makeExportRequest(nil, nil, makeStringLogRecord("grpc authed", time.Now()))
+
+	_, err = client.Export(context.Background(), req)
+	if st, _ := status.FromError(err); st.Code() != codes.Unauthenticated {
+		t.Errorf("no token: expected UNAUTHENTICATED, got %v", err)
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer s3cret")
+	if _, err := client.Export(ctx, req); err != nil {
+		t.Fatalf("Export with token failed: %v", err)
+	}
+	if msg := recv(t, out); string(msg.Raw) != "grpc authed" {
+		t.Errorf("raw: expected %q, got %q", "grpc authed", msg.Raw)
+	}
+}
+
+func TestOTLPGRPCTLS(t *testing.T) {
+	t.Parallel()
+	ca := tlstest.NewCA(t)
+	_, _, srvCert := ca.Server(t)
+	tlsCfg := &tls.Config{Certificates: []tls.Certificate{srvCert}, MinVersion: tls.VersionTLS12}
+	_, grpcAddr, out := startOTLP(t, Config{ID: "test-otlp", TLSConfig: tlsCfg}, 10, ca.Pool)
+
+	creds := credentials.NewTLS(&tls.Config{RootCAs: ca.Pool, MinVersion: tls.VersionTLS12})
+	conn, err := grpc.NewClient(grpcAddr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		t.Fatalf("grpc dial: %v", err)
+	}
+	defer conn.Close()
+
+	client := collogspb.NewLogsServiceClient(conn)
+	if _, err := client.Export(context.Background(), makeExportRequest(nil, nil, makeStringLogRecord("over tls", time.Now()))); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	if msg := recv(t, out); string(msg.Raw) != "over tls" {
+		t.Errorf("raw: expected %q, got %q", "over tls", msg.Raw)
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"gastrolog/internal/glid"
 	"log/slog"
 
+	"gastrolog/internal/cert"
+	"gastrolog/internal/ingester/httpauth"
+	"gastrolog/internal/ingester/listentls"
 	"gastrolog/internal/orchestrator"
 )
 
@@ -18,7 +21,8 @@ func ParamDefaults() map[string]string {
 }
 
 // NewFactory returns an IngesterFactory for OTLP ingesters.
-func NewFactory() orchestrator.IngesterFactory {
+// The cert manager is used to resolve TLS certificate names.
+func NewFactory(certMgr *cert.Manager) orchestrator.IngesterFactory {
 	return func(id glid.GLID, params map[string]string, logger *slog.Logger) (orchestrator.Ingester, error) {
 		httpAddr := cmp.Or(params["http_addr"], ":4318")
 		grpcAddr := cmp.Or(params["grpc_addr"], ":4317")
@@ -39,11 +43,22 @@ func NewFactory() orchestrator.IngesterFactory {
 			}
 		}
 
+		tlsCfg, err := listentls.BuildConfig(params, certMgr)
+		if err != nil {
+			return nil, fmt.Errorf("otlp ingester: %w", err)
+		}
+		auth, err := httpauth.ParseParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("otlp ingester: %w", err)
+		}
+
 		return New(Config{
-			ID:       id.String(),
-			HTTPAddr: httpAddr,
-			GRPCAddr: grpcAddr,
-			Logger:   logger,
+			ID:        id.String(),
+			HTTPAddr:  httpAddr,
+			GRPCAddr:  grpcAddr,
+			TLSConfig: tlsCfg,
+			Auth:      auth,
+			Logger:    logger,
 		}), nil
 	}
 }
@@ -1,9 +1,11 @@
 // Package otlp provides an OTLP ingester that accepts OpenTelemetry log records
-// via HTTP (POST /v1/logs) and gRPC (LogsService/Export).
+// and trace spans via HTTP (POST /v1/logs, POST /v1/traces) and gRPC
+// (LogsService/Export, TraceService/Export).
 package otlp
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -17,27 +19,33 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
 	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
 	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
 	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
 
 	"gastrolog/internal/chanwatch"
 	"gastrolog/internal/ingester/bodyutil"
+	"gastrolog/internal/ingester/httpauth"
 	"gastrolog/internal/logging"
 	"gastrolog/internal/orchestrator"
 )
 
-// Ingester accepts OpenTelemetry log records via HTTP and gRPC.
+// Ingester accepts OpenTelemetry log records and spans via HTTP and gRPC.
 type Ingester struct {
-	id       string
-	httpAddr string
-	grpcAddr string
-	out      chan<- orchestrator.IngestMessage
-	logger   *slog.Logger
+	id        string
+	httpAddr  string
+	grpcAddr  string
+	tlsConfig *tls.Config
+	auth      *httpauth.Config
+	out       chan<- orchestrator.IngestMessage
+	logger    *slog.Logger
 
 	// pressureGate is consulted non-blockingly by processExportRequest to
 	// decide whether to reject incoming exports with 429 / ResourceExhausted.
@@ -57,16 +65,26 @@ type Config struct {
 	ID       string
 	HTTPAddr string // e.g. ":4318"
 	GRPCAddr string // e.g. ":4317"
-	Logger   *slog.Logger
+
+	// TLSConfig, if non-nil, secures both the HTTP and gRPC listeners.
+	TLSConfig *tls.Config
+
+	// Auth, if non-nil, requires bearer or basic credentials on exports
+	// (the Authorization header, or "authorization" gRPC metadata).
+	Auth *httpauth.Config
+
+	Logger *slog.Logger
 }
 
 // New creates a new OTLP ingester.
 func New(cfg Config) *Ingester {
 	return &Ingester{
-		id:       cfg.ID,
-		httpAddr: cfg.HTTPAddr,
-		grpcAddr: cfg.GRPCAddr,
-		logger:   logging.Default(cfg.Logger).With("component", "ingester", "type", "otlp"),
+		id:        cfg.ID,
+		httpAddr:  cfg.HTTPAddr,
+		grpcAddr:  cfg.GRPCAddr,
+		tlsConfig: cfg.TLSConfig,
+		auth:      cfg.Auth,
+		logger:    logging.Default(cfg.Logger).With("component", "ingester", "type", "otlp"),
 	}
 }
 
@@ -83,18 +101,23 @@ func (ing *Ingester) Run(ctx context.Context, out chan<- orchestrator.IngestMess
 	}
 
 	mux := http.NewServeMux()
-	mux.HandleFunc("POST /v1/logs", ing.handleHTTP)
+	mux.Handle("POST /v1/logs", ing.auth.Wrap(http.HandlerFunc(ing.handleHTTPLogs)))
+	mux.Handle("POST /v1/traces", ing.auth.Wrap(http.HandlerFunc(ing.handleHTTPTraces)))
 	mux.HandleFunc("GET /ready", func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	})
 	httpSrv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
 
+	httpServeLn := httpLn
+	if ing.tlsConfig != nil {
+		httpServeLn = tls.NewListener(httpLn, ing.tlsConfig)
+	}
 	go func() {
-		if err := httpSrv.Serve(httpLn); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		if err := httpSrv.Serve(httpServeLn); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			errCh <- fmt.Errorf("otlp http: %w", err)
 		}
 	}()
-	ing.logger.Info("otlp http listening", "addr", httpLn.Addr().String())
+	ing.logger.Info("otlp http listening", "addr", httpLn.Addr().String(), "tls", ing.tlsConfig != nil)
 
 	// Start gRPC server.
 	grpcLn, err := net.Listen("tcp", ing.grpcAddr)
@@ -103,15 +126,23 @@ func (ing *Ingester) Run(ctx context.Context, out chan<- orchestrator.IngestMess
 		return fmt.Errorf("otlp grpc listen: %w", err)
 	}
 
-	grpcSrv := grpc.NewServer()
+	var grpcOpts []grpc.ServerOption
+	if ing.tlsConfig != nil {
+		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(ing.tlsConfig)))
+	}
+	if ing.auth != nil {
+		grpcOpts = append(grpcOpts, grpc.UnaryInterceptor(grpcAuthInterceptor(ing.auth)))
+	}
+	grpcSrv := grpc.NewServer(grpcOpts...)
 	collogspb.RegisterLogsServiceServer(grpcSrv, &logsServiceServer{ing: ing})
+	coltracepb.RegisterTraceServiceServer(grpcSrv, &traceServiceServer{ing: ing})
 
 	go func() {
 		if err := grpcSrv.Serve(grpcLn); err != nil {
 			errCh <- fmt.Errorf("otlp grpc: %w", err)
 		}
 	}()
-	ing.logger.Info("otlp grpc listening", "addr", grpcLn.Addr().String())
+	ing.logger.Info("otlp grpc listening", "addr", grpcLn.Addr().String(), "tls", ing.tlsConfig != nil)
 
 	// Wait for shutdown or error.
 	select {
@@ -129,33 +160,63 @@ func (ing *Ingester) Run(ctx context.Context, out chan<- orchestrator.IngestMess
 	}
 }
 
-// handleHTTP handles POST /v1/logs requests.
+// grpcAuthInterceptor rejects calls whose "authorization" metadata lacks
+// the expected credentials, the gRPC counterpart of auth.Wrap.
+func grpcAuthInterceptor(auth *httpauth.Config) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var header string
+		md, _ := metadata.FromIncomingContext(ctx)
+		if v := md.Get("authorization"); len(v) > 0 {
+			header = v[0]
+		}
+		if !auth.Authorized(header) {
+			return nil, status.Error(codes.Unauthenticated, "missing or invalid credentials")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// handleHTTPLogs handles POST /v1/logs requests.
+func (ing *Ingester) handleHTTPLogs(w http.ResponseWriter, req *http.Request) {
+	exportReq := &collogspb.ExportLogsServiceRequest{}
+	if !decodeHTTPExport(w, req, exportReq) {
+		return
+	}
+	err := ing.processExportRequest(req.Context(), exportReq)
+	writeHTTPExportResult(w, err, &collogspb.ExportLogsServiceResponse{})
+}
+
+// decodeHTTPExport reads an OTLP/HTTP request body into msg, writing a 400
+// response and returning false on failure.
 // Accepts protobuf (application/x-protobuf) and JSON (application/json).
-func (ing *Ingester) handleHTTP(w http.ResponseWriter, req *http.Request) {
+func decodeHTTPExport(w http.ResponseWriter, req *http.Request, msg proto.Message) bool {
 	data, err := bodyutil.ReadBody(req.Body, req.Header.Get("Content-Encoding"), 10<<20)
 	if err != nil {
 		http.Error(w, "failed to read body: "+err.Error(), http.StatusBadRequest)
-		return
+		return false
 	}
 
-	exportReq := &collogspb.ExportLogsServiceRequest{}
-
 	ct := req.Header.Get("Content-Type")
 	switch ct {
 	case "application/x-protobuf", "application/protobuf":
-		if err := proto.Unmarshal(data, exportReq); err != nil {
+		if err := proto.Unmarshal(data, msg); err != nil {
 			http.Error(w, "invalid protobuf", http.StatusBadRequest)
-			return
+			return false
 		}
 	default:
 		// Default to JSON (the OTLP/HTTP spec recommends JSON as default).
-		if err := protojson.Unmarshal(data, exportReq); err != nil {
+		if err := protojson.Unmarshal(data, msg); err != nil {
 			http.Error(w, "invalid JSON", http.StatusBadRequest)
-			return
+			return false
 		}
 	}
+	return true
+}
 
-	if err := ing.processExportRequest(req.Context(), exportReq); err != nil {
+// writeHTTPExportResult writes the outcome of processing an OTLP/HTTP export:
+// the empty export response on success, 429 on backpressure, 500 otherwise.
+func writeHTTPExportResult(w http.ResponseWriter, err error, resp proto.Message) {
+	if err != nil {
 		if errors.Is(err, errBackpressure) {
 			w.Header().Set("Retry-After", "1")
 			http.Error(w, "queue full, retry later", http.StatusTooManyRequests)
@@ -165,8 +226,6 @@ func (ing *Ingester) handleHTTP(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	// Respond with empty ExportLogsServiceResponse.
-	resp := &collogspb.ExportLogsServiceResponse{}
 	respData, _ := proto.Marshal(resp)
 	w.Header().Set("Content-Type", "application/x-protobuf")
 	w.WriteHeader(http.StatusOK)
@@ -176,8 +235,8 @@ func (ing *Ingester) handleHTTP(w http.ResponseWriter, req *http.Request) {
 // errBackpressure signals the queue is near capacity.
 var errBackpressure = errors.New("backpressure: ingest queue near capacity")
 
-// processExportRequest converts OTLP log records to IngestMessages and sends them.
-func (ing *Ingester) processExportRequest(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error {
+// checkPressure returns errBackpressure when exports should be rejected.
+func (ing *Ingester) checkPressure() error {
 	// Non-blocking backpressure check. With a pressure gate (normal
 	// orchestrator-attached operation), use the hysteresis gate which
 	// prevents flapping at the threshold. Without a gate (standalone or
@@ -190,6 +249,24 @@ func (ing *Ingester) processExportRequest(ctx context.Context, req *collogspb.Ex
 	} else if c := cap(ing.out); c > 0 && len(ing.out) >= c*9/10 {
 		return errBackpressure
 	}
+	return nil
+}
+
+// send delivers msg to the orchestrator, giving up when ctx is cancelled.
+func (ing *Ingester) send(ctx context.Context, msg orchestrator.IngestMessage) error {
+	select {
+	case ing.out <- msg:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// processExportRequest converts OTLP log records to IngestMessages and sends them.
+func (ing *Ingester) processExportRequest(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error {
+	if err := ing.checkPressure(); err != nil {
+		return err
+	}
 
 	now := time.Now()
 
@@ -201,10 +278,8 @@ func (ing *Ingester) processExportRequest(ctx context.Context, req *collogspb.Ex
 
 			for _, lr := range sl.GetLogRecords() {
 				msg := ing.logRecordToMessage(lr, resourceAttrs, scopeAttrs, now)
-				select {
-				case ing.out <- msg:
-				case <-ctx.Done():
-					return ctx.Err()
+				if err := ing.send(ctx, msg); err != nil {
+					return err
 				}
 			}
 		}
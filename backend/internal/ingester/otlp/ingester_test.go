@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/hex"
 	"gastrolog/internal/glid"
 	"io"
@@ -70,6 +72,14 @@ func makeStringLogRecord(body string, ts time.Time) *logspb.LogRecord {
 // Returns (httpAddr, grpcAddr, out channel). Ingester runs until test ends.
 func listenAndStartOTLP(t *testing.T, chanSize int) (string, string, chan orchestrator.IngestMessage) {
 	t.Helper()
+	return startOTLP(t, Config{ID: "test-otlp"}, chanSize, nil)
+}
+
+// startOTLP is listenAndStartOTLP with a custom config. The addresses in cfg
+// are replaced by random ports; with TLS, rootCAs verifies the server during
+// the readiness check.
+func startOTLP(t *testing.T, cfg Config, chanSize int, rootCAs *x509.CertPool) (string, string, chan orchestrator.IngestMessage) {
+	t.Helper()
 
 	// Find two free ports.
 	httpLn, err := net.Listen("tcp", "127.0.0.1:0")
@@ -87,11 +97,8 @@ func listenAndStartOTLP(t *testing.T, chanSize int) (string, string, chan orches
 	grpcLn.Close()
 
 	out := make(chan orchestrator.IngestMessage, chanSize)
-	ing := New(Config{
-		ID:       "test-otlp",
-		HTTPAddr: httpAddr,
-		GRPCAddr: grpcAddr,
-	})
+	cfg.HTTPAddr, cfg.GRPCAddr = httpAddr, grpcAddr
+	ing := New(cfg)
 
 	ctx := t.Context()
 	go ing.Run(ctx, out)
@@ -99,8 +106,13 @@ func listenAndStartOTLP(t *testing.T, chanSize int) (string, string, chan orches
 	// Wait for HTTP listener to be ready.
 	deadline := time.Now().Add(2 * time.Second)
 	client := &http.Client{Timeout: 50 * time.Millisecond}
+	scheme := "http://"
+	if cfg.TLSConfig != nil {
+		scheme = "https://"
+		client.Transport = &http.Transport{TLSClientConfig: &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}}
+	}
 	for {
-		resp, err := client.Get("http://" + httpAddr + "/ready")
+		resp, err := client.Get(scheme + httpAddr + "/ready")
 		if err == nil {
 			resp.Body.Close()
 			break
@@ -627,7 +639,7 @@ func TestOTLPGRPCBackpressure(t *testing.T) {
 
 func TestOTLPFactory(t *testing.T) {
 	t.Parallel()
-	factory := NewFactory()
+	factory := NewFactory(nil)
 
 	// Default addrs.
 	ing, err := factory(glid.New(), nil, nil)
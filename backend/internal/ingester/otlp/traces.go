@@ -0,0 +1,139 @@
+package otlp
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"maps"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+
+	"gastrolog/internal/orchestrator"
+)
+
+// Spans are stored as ordinary records so they can be queried alongside logs:
+// the span name is the raw line, the start time is the source timestamp, and
+// the span's identity and timing are attributes. A log record carrying the
+// same trace_id joins naturally on that attribute.
+
+// handleHTTPTraces handles POST /v1/traces requests.
+func (ing *Ingester) handleHTTPTraces(w http.ResponseWriter, req *http.Request) {
+	exportReq := &coltracepb.ExportTraceServiceRequest{}
+	if !decodeHTTPExport(w, req, exportReq) {
+		return
+	}
+	err := ing.processTraceExportRequest(req.Context(), exportReq)
+	writeHTTPExportResult(w, err, &coltracepb.ExportTraceServiceResponse{})
+}
+
+// processTraceExportRequest converts OTLP spans to IngestMessages and sends them.
+func (ing *Ingester) processTraceExportRequest(ctx context.Context, req *coltracepb.ExportTraceServiceRequest) error {
+	if err := ing.checkPressure(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for _, rs := range req.GetResourceSpans() {
+		resourceAttrs := flattenKVList(rs.GetResource().GetAttributes())
+
+		for _, ss := range rs.GetScopeSpans() {
+			scopeAttrs := flattenKVList(ss.GetScope().GetAttributes())
+
+			for _, span := range ss.GetSpans() {
+				msg := ing.spanToMessage(span, resourceAttrs, scopeAttrs, now)
+				if err := ing.send(ctx, msg); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (ing *Ingester) spanToMessage(span *tracepb.Span, resourceAttrs, scopeAttrs map[string]string, now time.Time) orchestrator.IngestMessage {
+	attrs := make(map[string]string, len(resourceAttrs)+len(scopeAttrs)+12)
+
+	maps.Copy(attrs, resourceAttrs)
+	maps.Copy(attrs, scopeAttrs)
+	maps.Copy(attrs, flattenKVList(span.GetAttributes()))
+
+	if len(span.GetTraceId()) > 0 {
+		attrs["trace_id"] = hex.EncodeToString(span.GetTraceId())
+	}
+	if len(span.GetSpanId()) > 0 {
+		attrs["span_id"] = hex.EncodeToString(span.GetSpanId())
+	}
+	if len(span.GetParentSpanId()) > 0 {
+		attrs["parent_span_id"] = hex.EncodeToString(span.GetParentSpanId())
+	}
+	if span.GetTraceState() != "" {
+		attrs["trace_state"] = span.GetTraceState()
+	}
+	if span.GetName() != "" {
+		attrs["span_name"] = span.GetName()
+	}
+	if k := span.GetKind(); k != tracepb.Span_SPAN_KIND_UNSPECIFIED {
+		attrs["span_kind"] = enumLabel(k.String(), "SPAN_KIND_")
+	}
+	if c := span.GetStatus().GetCode(); c != tracepb.Status_STATUS_CODE_UNSET {
+		attrs["status_code"] = enumLabel(c.String(), "STATUS_CODE_")
+	}
+	if span.GetStatus().GetMessage() != "" {
+		attrs["status_message"] = span.GetStatus().GetMessage()
+	}
+
+	attrs["ingester_type"] = "otlp"
+
+	// SourceTS is the span start. The end is kept as an attribute and, when
+	// both are present, folded into duration_ns.
+	var sourceTS time.Time
+	start, end := span.GetStartTimeUnixNano(), span.GetEndTimeUnixNano()
+	if start != 0 {
+		sourceTS = time.Unix(0, int64(start)) //nolint:gosec // G115: OTLP nanosecond timestamps are well within int64 range
+	}
+	if end != 0 {
+		attrs["end_time_unix_nano"] = time.Unix(0, int64(end)).Format(time.RFC3339Nano) //nolint:gosec // G115
+	}
+	if start != 0 && end >= start {
+		attrs["duration_ns"] = strconv.FormatUint(end-start, 10)
+	}
+
+	return orchestrator.IngestMessage{
+		Attrs:      attrs,
+		Raw:        []byte(span.GetName()),
+		SourceTS:   sourceTS,
+		IngestTS:   now,
+		IngesterID: ing.id,
+	}
+}
+
+// enumLabel turns a protobuf enum name such as SPAN_KIND_SERVER into its
+// short lowercase label ("server").
+func enumLabel(name, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(name, prefix))
+}
+
+// traceServiceServer implements the gRPC TraceService.
+type traceServiceServer struct {
+	coltracepb.UnimplementedTraceServiceServer
+	ing *Ingester
+}
+
+func (s *traceServiceServer) Export(ctx context.Context, req *coltracepb.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error) {
+	if err := s.ing.processTraceExportRequest(ctx, req); err != nil {
+		if errors.Is(err, errBackpressure) {
+			return nil, status.Error(codes.ResourceExhausted, "ingest queue near capacity")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &coltracepb.ExportTraceServiceResponse{}, nil
+}
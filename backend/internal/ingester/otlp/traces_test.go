@@ -0,0 +1,187 @@
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+var (
+	testTraceID = []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+	testSpanID  = []byte{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff, 0x11, 0x22}
+	testParent  = []byte{0x10, 0x20, 0x30, 0x40, 0x50, 0x60, 0x70, 0x80}
+)
+
+// makeTraceRequest wraps spans in an ExportTraceServiceRequest with the given
+// resource attributes.
+func makeTraceRequest(resourceAttrs map[string]string, spans ...*tracepb.Span) *coltracepb.ExportTraceServiceRequest {
+	var resKVs []*commonpb.KeyValue
+	for k, v := range resourceAttrs {
+		resKVs = append(resKVs, &commonpb.KeyValue{
+			Key:   k,
+			Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v}},
+		})
+	}
+	return &coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: []*tracepb.ResourceSpans{{
+			Resource:   &resourcepb.Resource{Attributes: resKVs},
+			ScopeSpans: []*tracepb.ScopeSpans{{Spans: spans}},
+		}},
+	}
+}
+
+func makeSpan(name string, start time.Time, d time.Duration) *tracepb.Span {
+	return &tracepb.Span{
+		TraceId:           testTraceID,
+		SpanId:            testSpanID,
+		ParentSpanId:      testParent,
+		Name:              name,
+		Kind:              tracepb.Span_SPAN_KIND_SERVER,
+		StartTimeUnixNano: uint64(start.UnixNano()),
+		EndTimeUnixNano:   uint64(start.Add(d).UnixNano()),
+		Attributes: []*commonpb.KeyValue{{
+			Key:   "http.route",
+			Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: "/users/:id"}},
+		}},
+		Status: &tracepb.Status{Code: tracepb.Status_STATUS_CODE_ERROR, Message: "upstream timeout"},
+	}
+}
+
+func TestOTLPHTTPTracesJSON(t *testing.T) {
+	t.Parallel()
+	httpAddr, _, out := listenAndStartOTLP(t, 10)
+
+	start := time.Now().Truncate(time.Microsecond)
+	req := makeTraceRequest(map[string]string{"service.name": "api"}, makeSpan("GET /users/:id", start, 1500*time.Microsecond))
+	data, err := protojson.Marshal(req)
+	if err != nil {
+		t.Fatalf("marshal json: %v", err)
+	}
+	resp, err := http.Post("http://"+httpAddr+"/v1/traces", "application/json", bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("POST failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	msg := recv(t, out)
+	if string(msg.Raw) != "GET /users/:id" {
+		t.Errorf("raw: expected span name, got %q", msg.Raw)
+	}
+	if !msg.SourceTS.Equal(start) {
+		t.Errorf("SourceTS: expected %v, got %v", start, msg.SourceTS)
+	}
+	want := map[string]string{
+		"trace_id":       hex.EncodeToString(testTraceID),
+		"span_id":        hex.EncodeToString(testSpanID),
+		"parent_span_id": hex.EncodeToString(testParent),
+		"duration_ns":    "1500000",
+		"span_name":      "GET /users/:id",
+		"span_kind":      "server",
+		"status_code":    "error",
+		"status_message": "upstream timeout",
+		"http.route":     "/users/:id",
+		"service.name":   "api",
+		"ingester_type":  "otlp",
+	}
+	for k, v := range want {
+		if msg.Attrs[k] != v {
+			t.Errorf("%s: expected %q, got %q", k, v, msg.Attrs[k])
+		}
+	}
+}
+
+func TestOTLPHTTPTracesProtobuf(t *testing.T) {
+	t.Parallel()
+	httpAddr, _, out := listenAndStartOTLP(t, 10)
+
+	req := makeTraceRequest(nil, makeSpan("db.query", time.Now(), time.Millisecond))
+	data, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatalf("marshal proto: %v", err)
+	}
+	resp, err := http.Post("http://"+httpAddr+"/v1/traces", "application/x-protobuf", bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("POST failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	msg := recv(t, out)
+	if msg.Attrs["duration_ns"] != "1000000" {
+		t.Errorf("duration_ns: expected 1000000, got %q", msg.Attrs["duration_ns"])
+	}
+}
+
+func TestOTLPHTTPTracesInvalidJSON(t *testing.T) {
+	t.Parallel()
+	httpAddr, _, _ := listenAndStartOTLP(t, 10)
+
+	resp, err := http.Post("http://"+httpAddr+"/v1/traces", "application/json", bytes.NewReader([]byte("{not valid")))
+	if err != nil {
+		t.Fatalf("POST failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", resp.StatusCode)
+	}
+}
+
+func TestOTLPGRPCTraces(t *testing.T) {
+	t.Parallel()
+	_, grpcAddr, out := listenAndStartOTLP(t, 10)
+
+	conn, err := grpc.NewClient(grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("grpc dial: %v", err)
+	}
+	defer conn.Close()
+
+	client := coltracepb.NewTraceServiceClient(conn)
+	root := makeSpan("checkout", time.Now(), 20*time.Millisecond)
+	root.ParentSpanId = nil
+	if _, err := client.Export(context.Background(), makeTraceRequest(nil, root)); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	msg := recv(t, out)
+	if msg.Attrs["trace_id"] != hex.EncodeToString(testTraceID) {
+		t.Errorf("trace_id: got %q", msg.Attrs["trace_id"])
+	}
+	if _, ok := msg.Attrs["parent_span_id"]; ok {
+		t.Errorf("root span should have no parent_span_id, got %q", msg.Attrs["parent_span_id"])
+	}
+}
+
+func TestOTLPSpanWithoutTimes(t *testing.T) {
+	t.Parallel()
+	ing := New(Config{ID: "test-otlp"})
+
+	span := &tracepb.Span{Name: "orphan"}
+	msg := ing.spanToMessage(span, nil, nil, time.Now())
+	if !msg.SourceTS.IsZero() {
+		t.Errorf("SourceTS: expected zero, got %v", msg.SourceTS)
+	}
+	for _, k := range []string{"duration_ns", "span_kind", "status_code", "trace_id"} {
+		if _, ok := msg.Attrs[k]; ok {
+			t.Errorf("%s should be absent, got %q", k, msg.Attrs[k])
+		}
+	}
+}
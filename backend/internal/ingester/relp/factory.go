@@ -1,10 +1,12 @@
 package relp
 
 import (
+	"fmt"
 	"gastrolog/internal/glid"
 	"log/slog"
 
 	"gastrolog/internal/cert"
+	"gastrolog/internal/ingester/listentls"
 	"gastrolog/internal/orchestrator"
 )
 
@@ -24,9 +26,9 @@ func NewFactory(certMgr *cert.Manager) orchestrator.IngesterFactory {
 			addr = ":2514" // RELP convention port
 		}
 
-		tlsCfg, err := BuildTLSConfig(params, certMgr)
+		tlsCfg, err := listentls.BuildConfig(params, certMgr)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("relp ingester: %w", err)
 		}
 
 		return New(Config{
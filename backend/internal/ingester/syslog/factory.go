@@ -2,19 +2,24 @@ package syslog
 
 import (
 	"errors"
+	"fmt"
 	"gastrolog/internal/glid"
 	"log/slog"
 
+	"gastrolog/internal/cert"
+	"gastrolog/internal/ingester/listentls"
+	"gastrolog/internal/ingester/multiline"
 	"gastrolog/internal/orchestrator"
 )
 
 // ParamDefaults returns the default parameter values for a syslog ingester.
 func ParamDefaults() map[string]string {
-	return map[string]string{}
+	return multiline.ParamDefaults()
 }
 
 // NewFactory returns a IngesterFactory for syslog ingesters.
-func NewFactory() orchestrator.IngesterFactory {
+// The cert manager is used to resolve TLS certificate names.
+func NewFactory(certMgr *cert.Manager) orchestrator.IngesterFactory {
 	return func(id glid.GLID, params map[string]string, logger *slog.Logger) (orchestrator.Ingester, error) {
 		udpAddr := params["udp_addr"]
 		tcpAddr := params["tcp_addr"]
@@ -23,11 +28,26 @@ func NewFactory() orchestrator.IngesterFactory {
 			return nil, errors.New("syslog ingester: at least one of udp_addr or tcp_addr is required")
 		}
 
+		tlsCfg, err := listentls.BuildConfig(params, certMgr)
+		if err != nil {
+			return nil, fmt.Errorf("syslog ingester: %w", err)
+		}
+		if tlsCfg != nil && tcpAddr == "" {
+			return nil, errors.New("syslog ingester: TLS requires tcp_addr")
+		}
+
+		ml, err := multiline.ParseParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("syslog ingester: %w", err)
+		}
+
 		return New(Config{
-			ID:      id.String(),
-			UDPAddr: udpAddr,
-			TCPAddr: tcpAddr,
-			Logger:  logger,
+			ID:        id.String(),
+			UDPAddr:   udpAddr,
+			TCPAddr:   tcpAddr,
+			TLSConfig: tlsCfg,
+			Multiline: ml,
+			Logger:    logger,
 		}), nil
 	}
 }
@@ -4,6 +4,7 @@ package syslog
 import (
 	"bufio"
 	"context"
+	"crypto/tls"
 	"errors"
 	"io"
 	"log/slog"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	"gastrolog/internal/chanwatch"
+	"gastrolog/internal/ingester/multiline"
 	"gastrolog/internal/ingester/syslogparse"
 	"gastrolog/internal/logging"
 	"gastrolog/internal/orchestrator"
@@ -23,11 +25,13 @@ import (
 // Supports both RFC 3164 (BSD) and RFC 5424 (IETF) formats with auto-detection.
 // Messages are parsed and relevant fields extracted into attributes.
 type Ingester struct {
-	id      string
-	udpAddr string
-	tcpAddr string
-	out     chan<- orchestrator.IngestMessage
-	logger  *slog.Logger
+	id        string
+	udpAddr   string
+	tcpAddr   string
+	tlsConfig *tls.Config
+	multiline *multiline.Config
+	out       chan<- orchestrator.IngestMessage
+	logger    *slog.Logger
 
 	mu          sync.Mutex
 	udpConn     *net.UDPConn
@@ -59,6 +63,14 @@ type Config struct {
 	// Empty string disables TCP.
 	TCPAddr string
 
+	// TLSConfig, if non-nil, wraps accepted TCP connections with TLS
+	// (RFC 5425). UDP is unaffected.
+	TLSConfig *tls.Config
+
+	// Multiline joins consecutive TCP frames from one connection into one
+	// message. Nil sends every frame as its own message.
+	Multiline *multiline.Config
+
 	// Logger for structured logging.
 	Logger *slog.Logger
 }
@@ -66,10 +78,12 @@ type Config struct {
 // New creates a new syslog ingester.
 func New(cfg Config) *Ingester {
 	return &Ingester{
-		id:      cfg.ID,
-		udpAddr: cfg.UDPAddr,
-		tcpAddr: cfg.TCPAddr,
-		logger:  logging.Default(cfg.Logger).With("component", "ingester", "type", "syslog"),
+		id:        cfg.ID,
+		udpAddr:   cfg.UDPAddr,
+		tcpAddr:   cfg.TCPAddr,
+		tlsConfig: cfg.TLSConfig,
+		multiline: cfg.Multiline,
+		logger:    logging.Default(cfg.Logger).With("component", "ingester", "type", "syslog"),
 	}
 }
 
@@ -211,7 +225,11 @@ func (r *Ingester) runTCP(ctx context.Context) error {
 	r.tcpListener = listener
 	r.mu.Unlock()
 
-	r.logger.Info("syslog TCP listener starting", "addr", listener.Addr().String())
+	proto := "TCP"
+	if r.tlsConfig != nil {
+		proto = "TLS"
+	}
+	r.logger.Info("syslog TCP listener starting", "addr", listener.Addr().String(), "proto", proto)
 
 	var wg sync.WaitGroup
 	for {
@@ -241,6 +259,15 @@ func (r *Ingester) runTCP(ctx context.Context) error {
 
 		wg.Go(func() {
 			defer func() { _ = conn.Close() }()
+			if r.tlsConfig != nil {
+				tlsConn := tls.Server(conn, r.tlsConfig)
+				if err := tlsConn.HandshakeContext(ctx); err != nil {
+					r.logger.Debug("syslog TLS handshake failed", "error", err, "remote", conn.RemoteAddr().String())
+					return
+				}
+				r.handleTCPConn(ctx, tlsConn)
+				return
+			}
 			r.handleTCPConn(ctx, conn)
 		})
 	}
@@ -248,12 +275,28 @@ func (r *Ingester) runTCP(ctx context.Context) error {
 
 // handleTCPConn handles a single TCP connection.
 // TCP syslog uses either newline-delimited or octet-counted framing.
+// With multiline rules, frames are assembled into messages per connection.
 func (r *Ingester) handleTCPConn(ctx context.Context, conn net.Conn) {
 	remoteIP := ""
 	if tcpAddr, ok := conn.RemoteAddr().(*net.TCPAddr); ok {
 		remoteIP = tcpAddr.IP.String()
 	}
 
+	asm := multiline.NewAssembler[struct{}](r.multiline)
+	send := func(ev multiline.Event[struct{}], ok bool) bool {
+		if !ok {
+			return true
+		}
+		select {
+		case r.out <- r.buildMessage(ev.Raw, remoteIP):
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	// A connection that closes mid-event still delivers what it sent.
+	defer func() { send(asm.Flush()) }()
+
 	reader := bufio.NewReader(conn)
 	for {
 		select {
@@ -262,6 +305,21 @@ func (r *Ingester) handleTCPConn(ctx context.Context, conn net.Conn) {
 		default:
 		}
 
+		// While an event is pending, wait for its next frame only up to the
+		// flush timeout. Peek consumes nothing, so a timeout here loses no data.
+		if _, pending := asm.Pending(); pending {
+			_ = conn.SetReadDeadline(time.Now().Add(r.multiline.FlushTimeout))
+			if _, err := reader.Peek(1); err != nil {
+				if !isTimeout(err) {
+					return
+				}
+				if !send(asm.Flush()) {
+					return
+				}
+				continue
+			}
+		}
+
 		// Backpressure: pause reads while the pipeline is backed up. TCP's
 		// sliding window does the rest — senders block on a closed window.
 		if r.pressureGate != nil {
@@ -284,10 +342,7 @@ func (r *Ingester) handleTCPConn(ctx context.Context, conn net.Conn) {
 			continue
 		}
 
-		msg := r.buildMessage(line, remoteIP)
-		select {
-		case r.out <- msg:
-		case <-ctx.Done():
+		if !send(asm.Add(line, struct{}{}, time.Now())) {
 			return
 		}
 	}
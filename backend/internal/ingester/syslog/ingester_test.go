@@ -1,12 +1,16 @@
 package syslog
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
 	"runtime"
 	"testing"
 	"time"
 
+	"gastrolog/internal/glid"
+	"gastrolog/internal/ingester/multiline"
+	"gastrolog/internal/ingester/tlstest"
 	"gastrolog/internal/orchestrator"
 )
 
@@ -197,6 +201,92 @@ func TestSyslogTCPOctetCounted(t *testing.T) {
 	}
 }
 
+func TestSyslogTCPMultiline(t *testing.T) {
+	ml, err := multiline.ParseParams(map[string]string{
+		"multiline_start":         `^<\d+>`,
+		"multiline_flush_timeout": "100ms",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := make(chan orchestrator.IngestMessage, 10)
+	recv := New(Config{TCPAddr: "127.0.0.1:0", Multiline: ml})
+
+	go recv.Run(t.Context(), out)
+	waitAddr(t, recv.TCPAddr)
+
+	conn, err := net.Dial("tcp", recv.TCPAddr().String())
+	if err != nil {
+		t.Fatalf("dial failed: %v", err)
+	}
+	defer conn.Close()
+
+	// A stack trace whose continuation lines carry no syslog header,
+	// followed by an ordinary message that is only flushed by the timeout.
+	trace := "<11>Jan 15 10:22:15 host1 app: Traceback (most recent call last):\n" +
+		"  File \"app.py\", line 3, in <module>\n" +
+		"ZeroDivisionError: division by zero"
+	next := "<14>Jan 15 10:22:16 host1 app: recovered"
+	conn.Write([]byte(trace + "\n" + next + "\n"))
+
+	for _, want := range []string{trace, next} {
+		select {
+		case m := <-out:
+			if string(m.Raw) != want {
+				t.Errorf("expected raw %q, got %q", want, m.Raw)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for %q", want)
+		}
+	}
+}
+
+func TestSyslogTCPTLS(t *testing.T) {
+	ca := tlstest.NewCA(t)
+	factory := NewFactory(ca.Manager(t, "syslog"))
+	ing, err := factory(glid.New(), map[string]string{
+		"tcp_addr": "127.0.0.1:0",
+		"tls":      "true",
+		"tls_cert": "syslog",
+	}, nil)
+	if err != nil {
+		t.Fatalf("factory: %v", err)
+	}
+	recv := ing.(*Ingester)
+	out := make(chan orchestrator.IngestMessage, 10)
+
+	go recv.Run(t.Context(), out)
+	waitAddr(t, recv.TCPAddr)
+
+	conn, err := tls.Dial("tcp", recv.TCPAddr().String(), &tls.Config{RootCAs: ca.Pool, MinVersion: tls.VersionTLS12})
+	if err != nil {
+		t.Fatalf("TLS dial failed: %v", err)
+	}
+	defer conn.Close()
+
+	// RFC 5425 uses octet-counted framing.
+	msg := "<34>1 2025-01-15T10:22:15Z host1 app - - - over TLS"
+	fmt.Fprintf(conn, "%d %s", len(msg), msg)
+
+	select {
+	case m := <-out:
+		if string(m.Raw) != msg {
+			t.Errorf("expected raw %q, got %q", msg, m.Raw)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for message")
+	}
+}
+
+func TestSyslogFactoryTLSRequiresTCP(t *testing.T) {
+	ca := tlstest.NewCA(t)
+	factory := NewFactory(ca.Manager(t, "syslog"))
+	_, err := factory(glid.New(), map[string]string{"udp_addr": ":0", "tls": "true", "tls_cert": "syslog"}, nil)
+	if err == nil {
+		t.Fatal("expected error for TLS without tcp_addr")
+	}
+}
+
 func TestSyslogMultipleUDPMessages(t *testing.T) {
 	out := make(chan orchestrator.IngestMessage, 100)
 	recv := New(Config{UDPAddr: "127.0.0.1:0"})
@@ -368,7 +458,7 @@ func TestSyslogBothUDPAndTCP(t *testing.T) {
 }
 
 func TestSyslogFactoryMissingAddr(t *testing.T) {
-	factory := NewFactory()
+	factory := NewFactory(nil)
 	id := [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
 
 	// No udp_addr or tcp_addr → must error.
@@ -0,0 +1,187 @@
+package tail
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+
+	"gastrolog/internal/ingester/multiline"
+	"gastrolog/internal/orchestrator"
+)
+
+// isArchive reports whether path is a compressed log, which is read whole
+// in backfill mode and never followed.
+func isArchive(path string) bool {
+	switch filepath.Ext(path) {
+	case ".gz", ".zst":
+		return true
+	}
+	return false
+}
+
+// decompress wraps r in the decoder for the archive at path.
+func decompress(path string, r io.Reader) (io.ReadCloser, error) {
+	if filepath.Ext(path) == ".zst" {
+		d, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return d.IOReadCloser(), nil
+	}
+	return gzip.NewReader(r)
+}
+
+// readArchives reads the compressed archives among paths that haven't been
+// read to the end yet. Bookmark updates are applied only after every archive
+// has been looked up: rotation shifts names, so one archive's new name may
+// be another's old one. It returns false if ctx was cancelled.
+func (ing *ingester) readArchives(ctx context.Context, paths []string, bm bookmarks, out chan<- orchestrator.IngestMessage) bool {
+	updates := make(map[string]fileBookmark)
+	defer maps.Copy(bm.Files, updates)
+
+	for _, path := range paths {
+		if isArchive(path) && !ing.readArchive(ctx, path, bm, updates, out) {
+			return false
+		}
+	}
+	return true
+}
+
+// readArchive emits the lines of one archive, resuming after the
+// decompressed bytes its bookmark says were read. An archive still being
+// written by logrotate ends early; the rest is read on a later poll.
+func (ing *ingester) readArchive(ctx context.Context, path string, bm bookmarks, updates map[string]fileBookmark, out chan<- orchestrator.IngestMessage) bool {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		ing.logger.Warn("failed to open archive", "path", path, "error", err)
+		return true
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return true
+	}
+	fb, found := bm.findArchive(path, f)
+	if found && fb.Complete {
+		updates[path] = fb // keep the bookmark under the archive's current name
+		return true
+	}
+	fp, err := takeFingerprint(f, info.Size())
+	if err != nil {
+		return true
+	}
+	fb.Inode, _ = getInode(info)
+	fb.FingerprintSize, fb.Fingerprint = fp.Size, fp.Sum
+
+	r, err := decompress(path, f)
+	if err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return true // header not written yet
+		}
+		ing.logger.Warn("cannot read archive", "path", path, "error", err)
+		fb.Complete = true
+		updates[path] = fb
+		return true
+	}
+	defer func() { _ = r.Close() }()
+
+	if fb.Offset > 0 {
+		if _, err := io.CopyN(io.Discard, r, fb.Offset); err != nil {
+			return true
+		}
+	}
+	if !found {
+		ing.logger.Info("reading archive", "path", path)
+	}
+
+	now := time.Now()
+	asm := multiline.NewAssembler[int64](ing.multiline)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024) // 1MB max line
+
+	// Offsets count decompressed bytes, like readLines counts file bytes.
+	consumed := fb.Offset
+	scanner.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		advance, token, err := bufio.ScanLines(data, atEOF)
+		consumed += int64(advance)
+		return advance, token, err
+	})
+	checkpoint := func() int64 {
+		if start, ok := asm.Pending(); ok {
+			return start
+		}
+		return consumed
+	}
+
+	next := fb.Offset
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		lineStart := next
+		next = consumed
+		if len(line) > 0 && line[len(line)-1] == '\r' {
+			line = line[:len(line)-1]
+		}
+		if len(line) == 0 {
+			continue
+		}
+		if ev, ok := asm.Add(line, lineStart, now); ok {
+			if !ing.send(ctx, path, ev.Raw, now, out) {
+				fb.Offset = ev.First
+				updates[path] = fb
+				return false
+			}
+		}
+	}
+
+	switch err := scanner.Err(); {
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		// Still being compressed; keep the pending event for next time.
+		fb.Offset = checkpoint()
+	case err != nil:
+		ing.logger.Warn("stopped reading corrupt archive", "path", path, "error", err)
+		fb.Complete = true
+	default:
+		fb.Complete = true
+	}
+	if fb.Complete {
+		if ev, ok := asm.Flush(); ok && !ing.send(ctx, path, ev.Raw, now, out) {
+			fb.Complete = false
+			fb.Offset = ev.First
+		}
+	}
+	updates[path] = fb
+	return ctx.Err() == nil
+}
+
+// send emits one record read from an archive, waiting out backpressure.
+// Archives can be large, so unlike live files they yield to cancellation.
+func (ing *ingester) send(ctx context.Context, path string, raw []byte, now time.Time, out chan<- orchestrator.IngestMessage) bool {
+	if ing.pressureGate != nil {
+		if err := ing.pressureGate.Wait(ctx); err != nil {
+			return false
+		}
+	}
+	select {
+	case out <- orchestrator.IngestMessage{
+		Attrs: map[string]string{
+			"ingester_type": "tail",
+			"file":          path,
+		},
+		Raw:        raw,
+		IngestTS:   now,
+		IngesterID: ing.id,
+	}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
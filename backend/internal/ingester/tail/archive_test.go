@@ -0,0 +1,182 @@
+package tail
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"gastrolog/internal/glid"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+
+	"gastrolog/internal/orchestrator"
+)
+
+func gzipLines(t *testing.T, path string, lines string) {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write([]byte(lines))
+	zw.Close()
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func zstdLines(t *testing.T, path string, lines string) {
+	t.Helper()
+	enc, _ := zstd.NewWriter(nil)
+	if err := os.WriteFile(path, enc.EncodeAll([]byte(lines), nil), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBackfillReadsArchivesOnce(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "app.log")
+	appendFile(t, logFile, "p1\n")
+	zstdLines(t, logFile+".1.zst", "z1\nz2\n")
+	gzipLines(t, logFile+".2.gz", "g1\r\ng2\n")
+
+	params := map[string]string{
+		"paths":         `["` + logFile + `*"]`,
+		"poll_interval": "0s",
+		"backfill":      "true",
+		"_state_dir":    dir,
+	}
+	id := glid.New()
+	run := func() []string {
+		t.Helper()
+		ing, err := NewFactory()(id, params, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		out := make(chan orchestrator.IngestMessage, 100)
+		errCh := make(chan error, 1)
+		go func() { errCh <- ing.Run(ctx, out) }()
+		msgs := collectMessages(t, out, 300*time.Millisecond)
+		cancel()
+		if err := <-errCh; err != nil {
+			t.Fatal(err)
+		}
+		var raws []string
+		for _, m := range msgs {
+			raws = append(raws, string(m.Raw))
+		}
+		slices.Sort(raws)
+		return raws
+	}
+
+	if got := run(); !slices.Equal(got, []string{"g1", "g2", "p1", "z1", "z2"}) {
+		t.Fatalf("first run: %v", got)
+	}
+	if got := run(); len(got) != 0 {
+		t.Fatalf("second run re-read: %v", got)
+	}
+
+	// Rotation renames every archive and compresses the newest file.
+	must(t, os.Rename(logFile+".2.gz", logFile+".3.gz"))
+	must(t, os.Rename(logFile+".1.zst", logFile+".2.zst"))
+	gzipLines(t, logFile+".1.gz", "n1\n")
+	if got := run(); !slices.Equal(got, []string{"n1"}) {
+		t.Errorf("after rotation: %v", got)
+	}
+}
+
+func must(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArchivesIgnoredWithoutBackfill(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "app.log")
+	appendFile(t, logFile, "old\n")
+
+	ing := testIngester(t, logFile+"*", false)
+	bm := bookmarks{Files: map[string]fileBookmark{}}
+	out := make(chan orchestrator.IngestMessage, 100)
+	ing.mu.Lock()
+	ing.openFile(logFile, bm, false)
+	ing.mu.Unlock()
+
+	gzipLines(t, logFile+".1.gz", "archived\n")
+	if got := readOnce(t, ing, bm, out); len(got) != 0 {
+		t.Errorf("got %q", got)
+	}
+	if _, ok := ing.files[logFile+".1.gz"]; ok {
+		t.Error("archive is being followed as a live file")
+	}
+}
+
+func TestArchiveStillBeingWritten(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	archive := filepath.Join(dir, "app.log.1.gz")
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write([]byte("l1\n"))
+	zw.Flush()
+	partial := buf.Len()
+	zw.Write([]byte("l2\nl3\n"))
+	zw.Close()
+	must(t, os.WriteFile(archive, buf.Bytes()[:partial], 0o644))
+
+	ing := testIngester(t, archive, true)
+	bm := bookmarks{Files: map[string]fileBookmark{}}
+	out := make(chan orchestrator.IngestMessage, 100)
+	paths := []string{archive}
+
+	ing.readArchives(t.Context(), paths, bm, out)
+	if got := received(out); !slices.Equal(got, []string{"l1"}) {
+		t.Fatalf("partial archive: %v", got)
+	}
+	if bm.Files[archive].Complete {
+		t.Fatal("partial archive marked complete")
+	}
+
+	must(t, os.WriteFile(archive, buf.Bytes(), 0o644))
+	ing.readArchives(t.Context(), paths, bm, out)
+	if got := received(out); !slices.Equal(got, []string{"l2", "l3"}) {
+		t.Errorf("completed archive: %v", got)
+	}
+	if !bm.Files[archive].Complete {
+		t.Error("archive not marked complete")
+	}
+}
+
+func TestBackfillReadsExistingFileFromStart(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "app.log")
+	appendFile(t, logFile, "existing\n")
+
+	ing := testIngester(t, logFile, true)
+	ing.mu.Lock()
+	ing.openFile(logFile, bookmarks{Files: map[string]fileBookmark{}}, ing.backfill)
+	ing.mu.Unlock()
+	if tf := ing.files[logFile]; tf == nil || tf.offset != 0 {
+		t.Errorf("expected offset 0, got %+v", tf)
+	}
+}
+
+func TestFactoryInvalidBackfill(t *testing.T) {
+	t.Parallel()
+	_, err := NewFactory()(glid.New(), map[string]string{
+		"paths":    `["/var/log/*.log"]`,
+		"backfill": "sometimes",
+	}, nil)
+	if err == nil {
+		t.Fatal("expected error for invalid backfill")
+	}
+}
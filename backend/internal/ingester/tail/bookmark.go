@@ -11,9 +11,68 @@ type bookmarks struct {
 	Files map[string]fileBookmark `json:"files"`
 }
 
+// fileBookmark records how far a file has been read. A file is identified
+// by its inode plus a fingerprint of its first bytes, so a bookmark follows
+// a file that was renamed and ignores a new file that reused the inode.
+// Bookmarks written before fingerprints existed match on the inode alone.
 type fileBookmark struct {
 	Inode  uint64 `json:"inode"`
 	Offset int64  `json:"offset"`
+
+	FingerprintSize int    `json:"fingerprint_size,omitempty"`
+	Fingerprint     uint64 `json:"fingerprint,omitempty"`
+
+	// Complete marks a compressed archive that has been read to the end.
+	// For archives, Offset counts decompressed bytes.
+	Complete bool `json:"complete,omitempty"`
+}
+
+func (fb fileBookmark) fingerprint() fingerprint {
+	return fingerprint{Size: fb.FingerprintSize, Sum: fb.Fingerprint}
+}
+
+// matches reports whether fb describes the open file f with the given inode.
+func (fb fileBookmark) matches(inode uint64, f *os.File) bool {
+	return fb.Inode == inode && fb.fingerprint().matches(f)
+}
+
+// find returns the bookmark for the file f at path. The path's own entry
+// is preferred; otherwise any entry with the same inode and fingerprint
+// matches, which is a file renamed while the ingester wasn't watching.
+func (b bookmarks) find(path string, inode uint64, f *os.File) (fileBookmark, bool) {
+	if fb, ok := b.Files[path]; ok && fb.matches(inode, f) {
+		return fb, true
+	}
+	for p, fb := range b.Files {
+		if p != path && fb.FingerprintSize > 0 && fb.matches(inode, f) {
+			return fb, true
+		}
+	}
+	return fileBookmark{}, false
+}
+
+// findArchive returns the bookmark for the compressed archive f at path.
+// Archives are matched on content alone: rotation renames them, and
+// compressing a rotated file creates a new inode.
+func (b bookmarks) findArchive(path string, f *os.File) (fileBookmark, bool) {
+	if fb, ok := b.Files[path]; ok && fb.FingerprintSize > 0 && fb.fingerprint().matches(f) {
+		return fb, true
+	}
+	for p, fb := range b.Files {
+		if p != path && fb.FingerprintSize > 0 && fb.fingerprint().matches(f) {
+			return fb, true
+		}
+	}
+	return fileBookmark{}, false
+}
+
+// prune drops bookmarks of files that no longer exist.
+func (b bookmarks) prune() {
+	for path := range b.Files {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			delete(b.Files, path)
+		}
+	}
 }
 
 // loadBookmarks reads bookmark state from the given path.
@@ -5,18 +5,24 @@ import (
 	"fmt"
 	"gastrolog/internal/glid"
 	"log/slog"
+	"maps"
 	"path/filepath"
+	"strconv"
 	"time"
 
+	"gastrolog/internal/ingester/multiline"
 	"gastrolog/internal/logging"
 	"gastrolog/internal/orchestrator"
 )
 
 // ParamDefaults returns the default parameter values for a tail ingester.
 func ParamDefaults() map[string]string {
-	return map[string]string{
+	defaults := map[string]string{
 		"poll_interval": "30s",
+		"backfill":      "false",
 	}
+	maps.Copy(defaults, multiline.ParamDefaults())
+	return defaults
 }
 
 // NewFactory returns an IngesterFactory for file tail ingesters.
@@ -35,7 +41,9 @@ type config struct {
 	ID           string
 	Patterns     []string
 	PollInterval time.Duration
+	Backfill     bool // read existing content and compressed archives
 	StateFile    string
+	Multiline    *multiline.Config // nil = one record per line
 	Logger       *slog.Logger
 }
 
@@ -65,6 +73,20 @@ func parseConfig(id string, params map[string]string, logger *slog.Logger) (conf
 		pollInterval = d
 	}
 
+	var backfill bool
+	if v := params["backfill"]; v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return config{}, fmt.Errorf("tail ingester %q: invalid backfill %q: must be true or false", id, v)
+		}
+		backfill = b
+	}
+
+	ml, err := multiline.ParseParams(params)
+	if err != nil {
+		return config{}, fmt.Errorf("tail ingester %q: %w", id, err)
+	}
+
 	var stateFile string
 	if stateDir := params["_state_dir"]; stateDir != "" {
 		stateFile = filepath.Join(stateDir, "state", "tail", id+".json")
@@ -74,7 +96,9 @@ func parseConfig(id string, params map[string]string, logger *slog.Logger) (conf
 		ID:           id,
 		Patterns:     patterns,
 		PollInterval: pollInterval,
+		Backfill:     backfill,
 		StateFile:    stateFile,
+		Multiline:    ml,
 		Logger:       logging.Default(logger).With("component", "ingester", "type", "tail", "instance", id),
 	}, nil
 }
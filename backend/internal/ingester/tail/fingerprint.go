@@ -0,0 +1,49 @@
+package tail
+
+import (
+	"hash/crc64"
+	"io"
+	"os"
+)
+
+// fingerprintSize is how many leading bytes of a file identify its content.
+// Together with the inode this tells a renamed file from a new one that
+// reused the inode, and finds the copy logrotate's copytruncate leaves.
+const fingerprintSize = 1024
+
+var crcTable = crc64.MakeTable(crc64.ECMA)
+
+// fingerprint is a checksum of the first Size bytes of a file. Files shorter
+// than fingerprintSize get a shorter fingerprint, extended as they grow.
+type fingerprint struct {
+	Size int
+	Sum  uint64
+}
+
+// takeFingerprint checksums the first min(size, fingerprintSize) bytes of f.
+func takeFingerprint(f *os.File, size int64) (fingerprint, error) {
+	n := int(min(size, fingerprintSize))
+	buf := make([]byte, n)
+	if _, err := f.ReadAt(buf, 0); err != nil && err != io.EOF {
+		return fingerprint{}, err
+	}
+	return fingerprint{Size: n, Sum: crc64.Checksum(buf, crcTable)}, nil
+}
+
+// matches reports whether f still begins with the fingerprinted bytes.
+// An empty fingerprint matches any file.
+func (fp fingerprint) matches(f *os.File) bool {
+	if fp.Size == 0 {
+		return true
+	}
+	buf := make([]byte, fp.Size)
+	if _, err := f.ReadAt(buf, 0); err != nil {
+		return false // shorter than it was
+	}
+	return crc64.Checksum(buf, crcTable) == fp.Sum
+}
+
+// complete reports whether the fingerprint covers the full fingerprintSize.
+func (fp fingerprint) complete() bool {
+	return fp.Size == fingerprintSize
+}
@@ -5,8 +5,10 @@ import (
 	"context"
 	"io"
 	"log/slog"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"sync"
 	"syscall"
 	"time"
@@ -14,6 +16,7 @@ import (
 	"github.com/fsnotify/fsnotify"
 
 	"gastrolog/internal/chanwatch"
+	"gastrolog/internal/ingester/multiline"
 	"gastrolog/internal/orchestrator"
 )
 
@@ -24,6 +27,36 @@ type tailedFile struct {
 	offset  int64
 	lineBuf []byte // partial line from last read
 	file    *os.File
+	fp      fingerprint
+
+	// asm assembles lines into events; its line metadata is the line's
+	// start offset, so a pending event knows where it begins.
+	asm *multiline.Assembler[int64]
+
+	// rotatedAt is set once the path no longer refers to this file; see
+	// rotatedIdle. lastRead is when new lines were last read.
+	rotatedAt time.Time
+	lastRead  time.Time
+}
+
+// checkpoint returns the offset to bookmark. While an event is still being
+// assembled it is the event's start, so a restart re-reads the pending lines
+// instead of losing them.
+func (tf *tailedFile) checkpoint() int64 {
+	if start, ok := tf.asm.Pending(); ok {
+		return start
+	}
+	return tf.offset
+}
+
+// bookmark returns the bookmark to save for tf.
+func (tf *tailedFile) bookmark() fileBookmark {
+	return fileBookmark{
+		Inode:           tf.inode,
+		Offset:          tf.checkpoint(),
+		FingerprintSize: tf.fp.Size,
+		Fingerprint:     tf.fp.Sum,
+	}
 }
 
 // newIngester creates a tail ingester from parsed config.
@@ -32,7 +65,9 @@ func newIngester(cfg config) *ingester {
 		id:           cfg.ID,
 		patterns:     cfg.Patterns,
 		pollInterval: cfg.PollInterval,
+		backfill:     cfg.Backfill,
 		stateFile:    cfg.StateFile,
+		multiline:    cfg.Multiline,
 		logger:       cfg.Logger,
 		files:        make(map[string]*tailedFile),
 	}
@@ -42,11 +77,14 @@ type ingester struct {
 	id           string
 	patterns     []string
 	pollInterval time.Duration
+	backfill     bool
 	stateFile    string
+	multiline    *multiline.Config
 	logger       *slog.Logger
 
-	mu    sync.Mutex
-	files map[string]*tailedFile
+	mu      sync.Mutex
+	files   map[string]*tailedFile
+	rotated []*tailedFile // rotated away, still read until quiet
 
 	// pressureGate throttles the Run event loop when the ingest pipeline is
 	// backed up. Tail's natural rate limiter is filesystem writes — pausing
@@ -76,10 +114,15 @@ func (ing *ingester) Run(ctx context.Context, out chan<- orchestrator.IngestMess
 		return err
 	}
 
-	// Open and seek to bookmarked offsets (or EOF if no bookmark).
+	// Open and seek to bookmarked offsets. Without a bookmark, start at EOF,
+	// or at the beginning when backfilling.
+	ing.mu.Lock()
 	for _, path := range paths {
-		ing.openFile(path, bm)
+		if !isArchive(path) {
+			ing.openFile(path, bm, ing.backfill)
+		}
 	}
+	ing.mu.Unlock()
 
 	// Set up fsnotify watcher.
 	watcher, err := fsnotify.NewWatcher()
@@ -97,10 +140,12 @@ func (ing *ingester) Run(ctx context.Context, out chan<- orchestrator.IngestMess
 
 	// Initial read of all files.
 	ing.mu.Lock()
-	for _, tf := range ing.files {
-		ing.readNewLines(tf, out)
-	}
+	ing.readAll(out)
 	ing.mu.Unlock()
+	if ing.backfill && !ing.readArchives(ctx, paths, bm, out) {
+		ing.saveAndCleanup(bm)
+		return nil
+	}
 
 	// Set up poll ticker.
 	var ticker *time.Ticker
@@ -111,6 +156,15 @@ func (ing *ingester) Run(ctx context.Context, out chan<- orchestrator.IngestMess
 		defer ticker.Stop()
 	}
 
+	// Pending multiline events are flushed once they've been idle for the
+	// flush timeout; check at half that to bound the extra delay.
+	var flushCh <-chan time.Time
+	if ing.multiline != nil {
+		flushTicker := time.NewTicker(max(ing.multiline.FlushTimeout/2, time.Millisecond))
+		flushCh = flushTicker.C
+		defer flushTicker.Stop()
+	}
+
 	for {
 		// Backpressure: pause before processing the next fs event or tick.
 		// Queued fsnotify events will coalesce on the next iteration — we
@@ -140,16 +194,21 @@ func (ing *ingester) Run(ctx context.Context, out chan<- orchestrator.IngestMess
 			ing.logger.Warn("fsnotify error", "error", err)
 
 		case <-tickCh:
-			ing.poll(bm, out, watcher)
+			ing.poll(ctx, bm, out)
+
+		case now := <-flushCh:
+			ing.flushExpired(now, out)
 		}
 	}
 }
 
-// openFile opens a file and seeks to the bookmarked offset, or EOF if no bookmark.
-func (ing *ingester) openFile(path string, bm bookmarks) {
-	ing.mu.Lock()
-	defer ing.mu.Unlock()
-
+// openFile starts following the file at path. A file already followed under
+// another name was renamed and keeps its position. Otherwise the file resumes
+// from its bookmark, or starts at the beginning if fromStart, else at EOF to
+// avoid flooding. A bookmark for path that describes a different file means
+// the path was rotated while the ingester wasn't running: the new file is
+// read in full and the old one recovered. Caller must hold ing.mu.
+func (ing *ingester) openFile(path string, bm bookmarks, fromStart bool) {
 	if _, exists := ing.files[path]; exists {
 		return
 	}
@@ -168,18 +227,36 @@ func (ing *ingester) openFile(path string, bm bookmarks) {
 	}
 
 	inode, _ := getInode(info)
+	if tf := ing.tracking(inode); tf != nil {
+		_ = f.Close()
+		ing.adopt(tf, path)
+		return
+	}
+
+	fp, err := takeFingerprint(f, info.Size())
+	if err != nil {
+		_ = f.Close()
+		ing.logger.Warn("failed to read file", "path", path, "error", err)
+		return
+	}
 
 	tf := &tailedFile{
 		path:  path,
 		inode: inode,
 		file:  f,
+		fp:    fp,
+		asm:   multiline.NewAssembler[int64](ing.multiline),
 	}
 
-	// Check for bookmark.
-	if fb, ok := bm.Files[path]; ok && fb.Inode == inode && fb.Offset <= info.Size() {
+	prev, hadPrev := bm.Files[path]
+	if fb, ok := bm.find(path, inode, f); ok && fb.Offset <= info.Size() {
 		tf.offset = fb.Offset
+	} else if hadPrev {
+		tf.offset = 0
+		ing.recoverRotated(path, prev)
+	} else if fromStart {
+		tf.offset = 0
 	} else {
-		// No valid bookmark — seek to end to avoid flooding.
 		tf.offset = info.Size()
 	}
 
@@ -193,50 +270,91 @@ func (ing *ingester) openFile(path string, bm bookmarks) {
 	ing.logger.Debug("tailing file", "path", path, "offset", tf.offset)
 }
 
-// readNewLines reads complete lines from a tailed file and emits them.
+// tracking returns the followed or rotated file with the given inode.
+// Caller must hold ing.mu.
+func (ing *ingester) tracking(inode uint64) *tailedFile {
+	if inode == 0 {
+		return nil
+	}
+	for _, tf := range ing.files {
+		if tf.inode == inode {
+			return tf
+		}
+	}
+	for _, tf := range ing.rotated {
+		if tf.inode == inode {
+			return tf
+		}
+	}
+	return nil
+}
+
+// adopt moves tf, found renamed to path, to be followed under path.
 // Caller must hold ing.mu.
+func (ing *ingester) adopt(tf *tailedFile, path string) {
+	if tf.rotatedAt.IsZero() {
+		delete(ing.files, tf.path)
+	} else {
+		ing.rotated = slices.DeleteFunc(ing.rotated, func(r *tailedFile) bool { return r == tf })
+		tf.rotatedAt = time.Time{}
+	}
+	ing.logger.Debug("following renamed file", "from", tf.path, "to", path, "offset", tf.offset)
+	tf.path = path
+	ing.files[path] = tf
+}
+
+// readAll reads all followed and rotated files. Caller must hold ing.mu.
+func (ing *ingester) readAll(out chan<- orchestrator.IngestMessage) {
+	// Reading may rotate files, changing ing.files.
+	for _, tf := range slices.Collect(maps.Values(ing.files)) {
+		ing.readNewLines(tf, out)
+	}
+	for _, tf := range ing.rotated {
+		ing.drain(tf, out)
+	}
+}
+
+// readNewLines reads new lines from a followed file, then checks whether
+// its path still refers to it. Caller must hold ing.mu.
 func (ing *ingester) readNewLines(tf *tailedFile, out chan<- orchestrator.IngestMessage) {
-	info, err := os.Stat(tf.path)
+	ing.drain(tf, out)
+	ing.checkRotation(tf, out)
+}
+
+// drain reads complete lines from tf's open file up to its end. A file that
+// shrank, or whose first bytes changed, was truncated in place; lines written
+// before the truncation are recovered from logrotate's copy if it can be
+// found. Caller must hold ing.mu.
+func (ing *ingester) drain(tf *tailedFile, out chan<- orchestrator.IngestMessage) {
+	info, err := tf.file.Stat()
 	if err != nil {
 		ing.logger.Warn("failed to stat file during read", "path", tf.path, "error", err)
 		return
 	}
 
-	// Check for inode change (file was rotated/replaced).
-	if newInode, ok := getInode(info); ok && tf.inode != 0 && newInode != tf.inode {
-		ing.logger.Info("inode change detected, reopening", "path", tf.path)
-		_ = tf.file.Close()
-		f, err := os.Open(tf.path)
-		if err != nil {
-			ing.logger.Warn("failed to reopen after rotation", "path", tf.path, "error", err)
-			return
-		}
-		newInfo, err := f.Stat()
-		if err != nil {
-			_ = f.Close()
-			return
-		}
-		tf.file = f
-		tf.inode, _ = getInode(newInfo)
-		tf.offset = 0
-		tf.lineBuf = nil
-	}
-
-	// Check for truncation (file size < our offset).
-	if info.Size() < tf.offset {
+	if info.Size() < tf.offset || !tf.fp.matches(tf.file) {
 		ing.logger.Info("truncation detected, resetting", "path", tf.path)
+		ing.recoverTruncated(tf, out)
+		ing.flushPending(tf, time.Now(), out)
 		tf.offset = 0
 		tf.lineBuf = nil
-		if _, err := tf.file.Seek(0, io.SeekStart); err != nil {
-			return
-		}
+		tf.fp = fingerprint{}
 	}
 
-	// Nothing new to read.
-	if info.Size() == tf.offset {
-		return
+	if info.Size() > tf.offset {
+		ing.readLines(tf, info, out)
+		tf.lastRead = time.Now()
 	}
+	if !tf.fp.complete() {
+		if fp, err := takeFingerprint(tf.file, info.Size()); err == nil {
+			tf.fp = fp
+		}
+	}
+}
 
+// readLines reads and emits complete lines from tf.file between tf.offset
+// and the end given by info. Caller must hold ing.mu.
+func (ing *ingester) readLines(tf *tailedFile, info os.FileInfo, out chan<- orchestrator.IngestMessage) {
 	// Seek to our current offset.
 	if _, err := tf.file.Seek(tf.offset, io.SeekStart); err != nil {
 		return
@@ -246,8 +364,18 @@ func (ing *ingester) readNewLines(tf *tailedFile, out chan<- orchestrator.Ingest
 	scanner := bufio.NewScanner(tf.file)
 	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024) // 1MB max line
 
+	// Count consumed bytes so each line's start offset is known.
+	var consumed, next int64
+	scanner.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		advance, token, err := bufio.ScanLines(data, atEOF)
+		consumed += int64(advance)
+		return advance, token, err
+	})
+
 	for scanner.Scan() {
 		line := scanner.Bytes()
+		lineStart := tf.offset + next
+		next = consumed
 
 		// If we had a partial line buffered, prepend it.
 		if len(tf.lineBuf) > 0 {
@@ -265,24 +393,53 @@ func (ing *ingester) readNewLines(tf *tailedFile, out chan<- orchestrator.Ingest
 			continue
 		}
 
-		// Make a copy since scanner reuses the buffer.
-		raw := make([]byte, len(line))
-		copy(raw, line)
-
-		out <- orchestrator.IngestMessage{
-			Attrs: map[string]string{
-				"ingester_type": "tail",
-				"file":          tf.path,
-			},
-			Raw:        raw,
-			IngestTS:   now,
-			IngesterID: ing.id,
+		// The assembler copies the line, since scanner reuses the buffer.
+		if ev, ok := tf.asm.Add(line, lineStart, now); ok {
+			ing.emit(tf, ev.Raw, now, out)
 		}
 	}
 
 	ing.updateOffset(tf, info, scanner.Err())
 }
 
+// emit sends one record read from tf.
+func (ing *ingester) emit(tf *tailedFile, raw []byte, now time.Time, out chan<- orchestrator.IngestMessage) {
+	out <- orchestrator.IngestMessage{
+		Attrs: map[string]string{
+			"ingester_type": "tail",
+			"file":          tf.path,
+		},
+		Raw:        raw,
+		IngestTS:   now,
+		IngesterID: ing.id,
+	}
+}
+
+// flushPending emits tf's pending multiline event, if any.
+// Caller must hold ing.mu.
+func (ing *ingester) flushPending(tf *tailedFile, now time.Time, out chan<- orchestrator.IngestMessage) {
+	if ev, ok := tf.asm.Flush(); ok {
+		ing.emit(tf, ev.Raw, now, out)
+	}
+}
+
+// flushExpired emits pending multiline events that have waited for more
+// lines longer than the flush timeout.
+func (ing *ingester) flushExpired(now time.Time, out chan<- orchestrator.IngestMessage) {
+	ing.mu.Lock()
+	defer ing.mu.Unlock()
+	for _, tf := range ing.files {
+		if tf.asm.Expired(now) {
+			ing.flushPending(tf, now, out)
+		}
+	}
+	for _, tf := range ing.rotated {
+		if tf.asm.Expired(now) {
+			ing.flushPending(tf, now, out)
+		}
+	}
+}
+
 func (ing *ingester) updateOffset(tf *tailedFile, info os.FileInfo, scanErr error) {
 	newOffset, err := tf.file.Seek(0, io.SeekCurrent)
 	if err != nil || scanErr != nil {
@@ -312,60 +469,61 @@ func (ing *ingester) handleFSEvent(event fsnotify.Event, bm bookmarks, out chan<
 	case event.Has(fsnotify.Write):
 		if tf, ok := ing.files[event.Name]; ok {
 			ing.readNewLines(tf, out)
+		} else {
+			ing.drainRotated(event.Name, out)
 		}
 
 	case event.Has(fsnotify.Create):
-		// Check if new file matches our patterns.
-		if matchesAnyPattern(event.Name, ing.patterns) {
-			// Unlock to call openFile (which takes the lock).
-			ing.mu.Unlock()
-			ing.openFile(event.Name, bm)
-			ing.mu.Lock()
+		// Compressed archives are left to the next poll, since they are
+		// still being written when created.
+		if !isArchive(event.Name) && matchesAnyPattern(event.Name, ing.patterns) {
+			// Newly created files are read from the start.
+			ing.openFile(event.Name, bm, true)
 			if tf, ok := ing.files[event.Name]; ok {
-				// For newly created files, start from offset 0.
-				tf.offset = 0
-				if _, err := tf.file.Seek(0, io.SeekStart); err == nil {
-					ing.readNewLines(tf, out)
-				}
+				ing.readNewLines(tf, out)
 			}
 		}
 
 	case event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename):
+		// Read what was written before the rotation; the file stays open
+		// for lines written after it.
 		if tf, ok := ing.files[event.Name]; ok {
-			_ = tf.file.Close()
-			delete(ing.files, event.Name)
-			ing.logger.Debug("file removed/renamed", "path", event.Name)
+			ing.readNewLines(tf, out)
 		}
 	}
+	ing.expireRotated(time.Now(), out)
 }
 
 // poll re-evaluates globs, reads all files, and saves bookmarks.
-func (ing *ingester) poll(bm bookmarks, out chan<- orchestrator.IngestMessage, _ *fsnotify.Watcher) {
-	// Discover files and open any new ones.
+func (ing *ingester) poll(ctx context.Context, bm bookmarks, out chan<- orchestrator.IngestMessage) {
+	// Discover files and open any new ones, which are read from the start.
 	paths, err := discoverFiles(ing.patterns)
 	if err != nil {
 		ing.logger.Warn("poll discovery failed", "error", err)
-	} else {
-		for _, path := range paths {
-			ing.openFile(path, bm)
-		}
 	}
 
-	// Read all files.
 	ing.mu.Lock()
-	for _, tf := range ing.files {
-		ing.readNewLines(tf, out)
+	for _, path := range paths {
+		if !isArchive(path) {
+			ing.openFile(path, bm, true)
+		}
 	}
 
+	// Read all files.
+	ing.readAll(out)
+	ing.expireRotated(time.Now(), out)
+
 	// Update bookmarks.
 	for path, tf := range ing.files {
-		bm.Files[path] = fileBookmark{
-			Inode:  tf.inode,
-			Offset: tf.offset,
-		}
+		bm.Files[path] = tf.bookmark()
 	}
 	ing.mu.Unlock()
 
+	if ing.backfill {
+		ing.readArchives(ctx, paths, bm, out)
+	}
+	bm.prune()
+
 	if err := saveBookmarks(ing.stateFile, bm); err != nil {
 		ing.logger.Warn("failed to save bookmarks", "error", err)
 	}
@@ -377,12 +535,13 @@ func (ing *ingester) saveAndCleanup(bm bookmarks) {
 	defer ing.mu.Unlock()
 
 	for path, tf := range ing.files {
-		bm.Files[path] = fileBookmark{
-			Inode:  tf.inode,
-			Offset: tf.offset,
-		}
+		bm.Files[path] = tf.bookmark()
+		_ = tf.file.Close()
+	}
+	for _, tf := range ing.rotated {
 		_ = tf.file.Close()
 	}
+	ing.rotated = nil
 
 	if err := saveBookmarks(ing.stateFile, bm); err != nil {
 		ing.logger.Warn("failed to save bookmarks on shutdown", "error", err)
@@ -522,3 +522,121 @@ func TestPollDetectsNewFile(t *testing.T) {
 	cancel()
 	<-errCh
 }
+
+func TestMultilineStackTrace(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "app.log")
+	if err := os.WriteFile(logFile, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ing, err := NewFactory()(glid.New(), map[string]string{
+		"paths":                   `["` + filepath.Join(dir, "*.log") + `"]`,
+		"poll_interval":           "0s",
+		"multiline_start":         `^\d{4}-\d{2}-\d{2} `,
+		"multiline_flush_timeout": "100ms",
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	out := make(chan orchestrator.IngestMessage, 100)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- ing.Run(ctx, out)
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	f, _ := os.OpenFile(logFile, os.O_APPEND|os.O_WRONLY, 0o644)
+	f.WriteString("2025-06-15 ERROR request failed\n")
+	f.WriteString("java.lang.NullPointerException\n")
+	f.WriteString("\tat com.example.Handler.run(Handler.java:42)\n")
+	f.WriteString("2025-06-15 INFO recovered\n")
+	f.Close()
+
+	// The second event has no successor and is emitted by the flush timeout.
+	msgs := collectMessages(t, out, time.Second)
+	if len(msgs) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(msgs))
+	}
+	want := "2025-06-15 ERROR request failed\njava.lang.NullPointerException\n\tat com.example.Handler.run(Handler.java:42)"
+	if string(msgs[0].Raw) != want {
+		t.Errorf("msg[0] = %q, want %q", msgs[0].Raw, want)
+	}
+	if string(msgs[1].Raw) != "2025-06-15 INFO recovered" {
+		t.Errorf("msg[1] = %q", msgs[1].Raw)
+	}
+
+	cancel()
+	<-errCh
+}
+
+func TestMultilineBookmarkPendingAtShutdown(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "app.log")
+	if err := os.WriteFile(logFile, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	params := map[string]string{
+		"paths":                   `["` + filepath.Join(dir, "*.log") + `"]`,
+		"poll_interval":           "0s",
+		"_state_dir":              dir,
+		"multiline_indent":        "true",
+		"multiline_flush_timeout": "1h",
+	}
+
+	id := glid.New()
+	run := func() (chan orchestrator.IngestMessage, context.CancelFunc, chan error) {
+		ing, err := NewFactory()(id, params, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		out := make(chan orchestrator.IngestMessage, 100)
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- ing.Run(ctx, out)
+		}()
+		time.Sleep(100 * time.Millisecond)
+		return out, cancel, errCh
+	}
+
+	out, cancel, errCh := run()
+	first := "first event\n  detail\n"
+	f, _ := os.OpenFile(logFile, os.O_APPEND|os.O_WRONLY, 0o644)
+	f.WriteString(first)
+	f.WriteString("second event\n  more detail\n")
+	f.Close()
+
+	msgs := collectMessages(t, out, 500*time.Millisecond)
+	if len(msgs) != 1 || string(msgs[0].Raw) != "first event\n  detail" {
+		t.Fatalf("expected only the first event, got %d messages", len(msgs))
+	}
+	cancel()
+	<-errCh
+
+	// The bookmark points at the start of the still-pending second event.
+	bm, err := loadBookmarks(filepath.Join(dir, "state", "tail", id.String()+".json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := bm.Files[logFile].Offset; got != int64(len(first)) {
+		t.Errorf("bookmark offset = %d, want %d", got, len(first))
+	}
+
+	// After a restart the pending event is read again and completed.
+	out, cancel, errCh = run()
+	f, _ = os.OpenFile(logFile, os.O_APPEND|os.O_WRONLY, 0o644)
+	f.WriteString("third event\n")
+	f.Close()
+
+	msgs = collectMessages(t, out, 500*time.Millisecond)
+	if len(msgs) != 1 || string(msgs[0].Raw) != "second event\n  more detail" {
+		t.Fatalf("expected the second event after restart, got %d messages", len(msgs))
+	}
+	cancel()
+	<-errCh
+}
@@ -0,0 +1,161 @@
+package tail
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gastrolog/internal/ingester/multiline"
+	"gastrolog/internal/orchestrator"
+)
+
+// rotatedIdle is how long a file renamed or removed by rotation stays open
+// after its last new line. Writers keep appending to the old file until they
+// reopen their log, which usually happens right after rotation.
+const rotatedIdle = 30 * time.Second
+
+// checkRotation handles tf's path no longer referring to tf's file, as with
+// rename-create rotation. tf, already read to its end, moves to ing.rotated
+// where it is still read until it goes quiet; a new file at the path is read
+// from its beginning. Caller must hold ing.mu.
+func (ing *ingester) checkRotation(tf *tailedFile, out chan<- orchestrator.IngestMessage) {
+	info, err := os.Stat(tf.path)
+	switch {
+	case err == nil:
+		inode, ok := getInode(info)
+		if !ok || tf.inode == 0 || inode == tf.inode {
+			return
+		}
+	case !os.IsNotExist(err):
+		return
+	}
+
+	ing.logger.Info("rotation detected", "path", tf.path)
+	delete(ing.files, tf.path)
+	tf.rotatedAt = time.Now()
+	ing.rotated = append(ing.rotated, tf)
+
+	if err == nil {
+		ing.openFile(tf.path, bookmarks{}, true)
+		if nf, ok := ing.files[tf.path]; ok {
+			ing.drain(nf, out)
+		}
+	}
+}
+
+// drainRotated reads the rotated file now at path, if any.
+// Caller must hold ing.mu.
+func (ing *ingester) drainRotated(path string, out chan<- orchestrator.IngestMessage) {
+	if len(ing.rotated) == 0 {
+		return
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return
+	}
+	inode, _ := getInode(info)
+	for _, tf := range ing.rotated {
+		if tf.inode == inode {
+			ing.drain(tf, out)
+		}
+	}
+}
+
+// expireRotated closes rotated files that have had no new lines for
+// rotatedIdle. Caller must hold ing.mu.
+func (ing *ingester) expireRotated(now time.Time, out chan<- orchestrator.IngestMessage) {
+	kept := ing.rotated[:0]
+	for _, tf := range ing.rotated {
+		last := tf.rotatedAt
+		if tf.lastRead.After(last) {
+			last = tf.lastRead
+		}
+		if now.Sub(last) < rotatedIdle {
+			kept = append(kept, tf)
+			continue
+		}
+		ing.flushPending(tf, now, out)
+		_ = tf.file.Close()
+		ing.logger.Debug("finished rotated file", "path", tf.path)
+	}
+	clear(ing.rotated[len(kept):])
+	ing.rotated = kept
+}
+
+// recoverTruncated handles copytruncate rotation. logrotate copies the file,
+// then truncates it, so lines written since tf was last read survive only in
+// the copy. If a copy starting with the same bytes is found next to the
+// file, it is read from tf's offset. Caller must hold ing.mu.
+func (ing *ingester) recoverTruncated(tf *tailedFile, out chan<- orchestrator.IngestMessage) {
+	if tf.fp.Size == 0 || tf.offset == 0 {
+		return
+	}
+	f, info := findSibling(tf.path, func(f *os.File, info os.FileInfo) bool {
+		inode, _ := getInode(info)
+		return inode != tf.inode && info.Size() >= tf.offset && tf.fp.matches(f)
+	})
+	if f == nil {
+		return
+	}
+	defer func() { _ = f.Close() }()
+
+	ing.logger.Info("reading end of truncated file from its copy", "path", tf.path, "copy", f.Name())
+	cp := *tf
+	cp.file = f
+	ing.readLines(&cp, info, out)
+}
+
+// recoverRotated looks next to path for the file fb describes, rotated away
+// while the ingester wasn't running, and queues it in ing.rotated to be read
+// from the bookmarked offset. Both renamed files and copytruncate copies are
+// found. Caller must hold ing.mu.
+func (ing *ingester) recoverRotated(path string, fb fileBookmark) {
+	f, info := findSibling(path, func(f *os.File, info os.FileInfo) bool {
+		inode, _ := getInode(info)
+		return info.Size() >= fb.Offset && ing.tracking(inode) == nil &&
+			(inode == fb.Inode || fb.FingerprintSize > 0) && fb.fingerprint().matches(f)
+	})
+	if f == nil {
+		return
+	}
+
+	ing.logger.Info("reading file rotated while stopped", "path", path, "rotated", f.Name(), "offset", fb.Offset)
+	inode, _ := getInode(info)
+	fp, _ := takeFingerprint(f, info.Size())
+	ing.rotated = append(ing.rotated, &tailedFile{
+		path:      path,
+		inode:     inode,
+		offset:    fb.Offset,
+		file:      f,
+		fp:        fp,
+		asm:       multiline.NewAssembler[int64](ing.multiline),
+		rotatedAt: time.Now(),
+	})
+}
+
+// findSibling returns the first regular file next to path whose name extends
+// path's, as rotated names do (app.log.1, app.log-20260301), and that match
+// accepts. The file is returned open. Compressed archives are skipped.
+func findSibling(path string, match func(*os.File, os.FileInfo) bool) (*os.File, os.FileInfo) {
+	dir, base := filepath.Split(path)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil
+	}
+	for _, e := range entries {
+		name := e.Name()
+		if name == base || !strings.HasPrefix(name, base) || !e.Type().IsRegular() || isArchive(name) {
+			continue
+		}
+		f, err := os.Open(filepath.Join(dir, name))
+		if err != nil {
+			continue
+		}
+		if info, err := f.Stat(); err == nil && match(f, info) {
+			return f, info
+		}
+		_ = f.Close()
+	}
+	return nil, nil
+}
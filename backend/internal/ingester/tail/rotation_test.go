@@ -0,0 +1,268 @@
+package tail
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"testing"
+
+	"gastrolog/internal/orchestrator"
+)
+
+func testIngester(t *testing.T, pattern string, backfill bool) *ingester {
+	t.Helper()
+	cfg, err := parseConfig("test", map[string]string{
+		"paths":         `["` + pattern + `"]`,
+		"poll_interval": "0s",
+		"backfill":      strconv.FormatBool(backfill),
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newIngester(cfg)
+}
+
+// received drains the messages emitted so far.
+func received(out chan orchestrator.IngestMessage) []string {
+	var raws []string
+	for {
+		select {
+		case msg := <-out:
+			raws = append(raws, string(msg.Raw))
+		default:
+			return raws
+		}
+	}
+}
+
+func appendFile(t *testing.T, path, s string) {
+	t.Helper()
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(s); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// readOnce runs one discovery and read pass, as a poll does.
+func readOnce(t *testing.T, ing *ingester, bm bookmarks, out chan orchestrator.IngestMessage) []string {
+	t.Helper()
+	ing.poll(t.Context(), bm, out)
+	return received(out)
+}
+
+func TestRenameCreateRotationKeepsTail(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "app.log")
+	appendFile(t, logFile, "a1\n")
+
+	ing := testIngester(t, logFile, false)
+	bm := bookmarks{Files: map[string]fileBookmark{}}
+	out := make(chan orchestrator.IngestMessage, 100)
+	ing.mu.Lock()
+	ing.openFile(logFile, bm, true)
+	ing.mu.Unlock()
+	if got := readOnce(t, ing, bm, out); !slices.Equal(got, []string{"a1"}) {
+		t.Fatalf("before rotation: %v", got)
+	}
+
+	// The writer keeps its descriptor across the rename.
+	writer, err := os.OpenFile(logFile, os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer writer.Close()
+	writer.WriteString("a2\n")
+	if err := os.Rename(logFile, logFile+".1"); err != nil {
+		t.Fatal(err)
+	}
+	writer.WriteString("a3\n")
+	appendFile(t, logFile, "b1\n")
+
+	if got := readOnce(t, ing, bm, out); !slices.Equal(got, []string{"a2", "a3", "b1"}) {
+		t.Errorf("after rotation: %v", got)
+	}
+
+	// Lines the writer appends before reopening its log are still read,
+	// attributed to the path they were written to.
+	writer.WriteString("a4\n")
+	appendFile(t, logFile, "b2\n")
+	ing.poll(t.Context(), bm, out)
+	var got []string
+	for range len(out) {
+		m := <-out
+		got = append(got, string(m.Raw))
+		if m.Attrs["file"] != logFile {
+			t.Errorf("%q: file = %q, want %q", m.Raw, m.Attrs["file"], logFile)
+		}
+	}
+	slices.Sort(got)
+	if !slices.Equal(got, []string{"a4", "b2"}) {
+		t.Errorf("after writer appended to rotated file: %v", got)
+	}
+}
+
+func TestRenamedFileMatchingPatternIsNotReread(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "app.log")
+	appendFile(t, logFile, "a1\n")
+
+	ing := testIngester(t, logFile+"*", false)
+	bm := bookmarks{Files: map[string]fileBookmark{}}
+	out := make(chan orchestrator.IngestMessage, 100)
+	ing.mu.Lock()
+	ing.openFile(logFile, bm, true)
+	ing.mu.Unlock()
+	readOnce(t, ing, bm, out)
+
+	appendFile(t, logFile, "a2\n")
+	if err := os.Rename(logFile, logFile+".1"); err != nil {
+		t.Fatal(err)
+	}
+	appendFile(t, logFile, "b1\n")
+
+	got := readOnce(t, ing, bm, out)
+	got = append(got, readOnce(t, ing, bm, out)...)
+	slices.Sort(got)
+	if !slices.Equal(got, []string{"a2", "b1"}) {
+		t.Errorf("got %v, want a2 and b1 once each", got)
+	}
+	if tf := ing.files[logFile+".1"]; tf == nil || tf.offset != int64(len("a1\na2\n")) {
+		t.Errorf("renamed file not followed at its offset: %+v", tf)
+	}
+}
+
+func TestCopyTruncateRecoversTail(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "app.log")
+	appendFile(t, logFile, "a1\n")
+
+	ing := testIngester(t, logFile, false)
+	bm := bookmarks{Files: map[string]fileBookmark{}}
+	out := make(chan orchestrator.IngestMessage, 100)
+	ing.mu.Lock()
+	ing.openFile(logFile, bm, true)
+	ing.mu.Unlock()
+	readOnce(t, ing, bm, out)
+
+	// logrotate's copytruncate: lines written since the last read are only
+	// in the copy once the original is truncated.
+	appendFile(t, logFile, "a2\na3\n")
+	data, err := os.ReadFile(logFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(logFile+"-20260301", data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Truncate(logFile, 0); err != nil {
+		t.Fatal(err)
+	}
+	appendFile(t, logFile, "b1\n")
+
+	if got := readOnce(t, ing, bm, out); !slices.Equal(got, []string{"a2", "a3", "b1"}) {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestRewriteDetectedByFingerprint(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "app.log")
+	appendFile(t, logFile, "short\n")
+
+	ing := testIngester(t, logFile, false)
+	bm := bookmarks{Files: map[string]fileBookmark{}}
+	out := make(chan orchestrator.IngestMessage, 100)
+	ing.mu.Lock()
+	ing.openFile(logFile, bm, true)
+	ing.mu.Unlock()
+	readOnce(t, ing, bm, out)
+
+	// Truncated and rewritten past the old offset before the next read.
+	if err := os.WriteFile(logFile, []byte("a much longer replacement\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if got := readOnce(t, ing, bm, out); !slices.Equal(got, []string{"a much longer replacement"}) {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestRotatedWhileStopped(t *testing.T) {
+	t.Parallel()
+	for name, pattern := range map[string]string{
+		"rotated name not matched": "app.log",
+		"rotated name matched":     "app.log*",
+	} {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			logFile := filepath.Join(dir, "app.log")
+			appendFile(t, logFile, "a1\n")
+
+			ing := testIngester(t, filepath.Join(dir, pattern), false)
+			bm := bookmarks{Files: map[string]fileBookmark{}}
+			out := make(chan orchestrator.IngestMessage, 100)
+			ing.mu.Lock()
+			ing.openFile(logFile, bm, true)
+			ing.mu.Unlock()
+			readOnce(t, ing, bm, out)
+			ing.saveAndCleanup(bm)
+
+			// While stopped: more lines, then rename-create rotation.
+			appendFile(t, logFile, "a2\n")
+			if err := os.Rename(logFile, logFile+".1"); err != nil {
+				t.Fatal(err)
+			}
+			appendFile(t, logFile, "b1\n")
+
+			next := testIngester(t, filepath.Join(dir, pattern), false)
+			paths, err := discoverFiles(next.patterns)
+			if err != nil {
+				t.Fatal(err)
+			}
+			next.mu.Lock()
+			for _, p := range paths {
+				next.openFile(p, bm, false)
+			}
+			next.readAll(out)
+			next.mu.Unlock()
+
+			got := received(out)
+			slices.Sort(got)
+			if !slices.Equal(got, []string{"a2", "b1"}) {
+				t.Errorf("got %v, want a2 and b1 once each", got)
+			}
+		})
+	}
+}
+
+func TestBookmarkIgnoresReusedInode(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "app.log")
+	appendFile(t, logFile, "new content\n")
+	info, err := os.Stat(logFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inode, _ := getInode(info)
+
+	// Same inode, but the bookmarked file started with other bytes.
+	bm := bookmarks{Files: map[string]fileBookmark{
+		logFile: {Inode: inode, Offset: 4, FingerprintSize: 12, Fingerprint: 1},
+	}}
+	ing := testIngester(t, logFile, false)
+	ing.mu.Lock()
+	ing.openFile(logFile, bm, false)
+	ing.mu.Unlock()
+	if tf := ing.files[logFile]; tf == nil || tf.offset != 0 {
+		t.Errorf("expected a fresh read from offset 0, got %+v", tf)
+	}
+}
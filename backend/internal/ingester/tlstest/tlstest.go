@@ -0,0 +1,139 @@
+// Package tlstest issues throwaway certificates for the TLS tests of
+// listener ingesters: a CA, server certificates for the cert manager, and
+// client certificates for mutual TLS.
+package tlstest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gastrolog/internal/cert"
+)
+
+// CA is a self-signed certificate authority valid for one hour.
+type CA struct {
+	cert   *x509.Certificate
+	key    *ecdsa.PrivateKey
+	serial int64
+
+	// PEM is the CA certificate, PEM-encoded.
+	PEM []byte
+	// Pool contains the CA certificate, for clients verifying servers.
+	Pool *x509.CertPool
+}
+
+// NewCA creates a CA.
+func NewCA(t testing.TB) *CA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate CA key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create CA cert: %v", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return &CA{
+		cert:   cert,
+		key:    key,
+		serial: 1,
+		PEM:    pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		Pool:   pool,
+	}
+}
+
+// WriteFile writes the CA certificate to a temp file and returns its path,
+// for use as the tls_ca param.
+func (ca *CA) WriteFile(t testing.TB) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(path, ca.PEM, 0o600); err != nil {
+		t.Fatalf("write CA file: %v", err)
+	}
+	return path
+}
+
+// Server issues a certificate for localhost and 127.0.0.1, returned as PEM
+// (for cert.Manager.AddFromPEM) and parsed.
+func (ca *CA) Server(t testing.TB) (certPEM, keyPEM string, cert tls.Certificate) {
+	t.Helper()
+	return ca.issue(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "localhost"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames:    []string{"localhost"},
+		IPAddresses: []net.IP{net.IPv4(127, 0, 0, 1)},
+	})
+}
+
+// Manager returns a cert manager holding a server certificate under name,
+// for use with the tls_cert param.
+func (ca *CA) Manager(t testing.TB, name string) *cert.Manager {
+	t.Helper()
+	certPEM, keyPEM, _ := ca.Server(t)
+	m := cert.New(cert.Config{})
+	if err := m.AddFromPEM(name, certPEM, keyPEM); err != nil {
+		t.Fatalf("add cert: %v", err)
+	}
+	return m
+}
+
+// Client issues a client certificate with the given Common Name.
+func (ca *CA) Client(t testing.TB, cn string) tls.Certificate {
+	t.Helper()
+	_, _, cert := ca.issue(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: cn},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return cert
+}
+
+func (ca *CA) issue(t testing.TB, tmpl *x509.Certificate) (certPEM, keyPEM string, cert tls.Certificate) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	ca.serial++
+	tmpl.SerialNumber = big.NewInt(ca.serial)
+	tmpl.NotBefore = time.Now().Add(-time.Minute)
+	tmpl.NotAfter = time.Now().Add(time.Hour)
+	tmpl.KeyUsage = x509.KeyUsageDigitalSignature
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatalf("create cert: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+	certPEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	cert, err = tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+	if err != nil {
+		t.Fatalf("load keypair: %v", err)
+	}
+	return certPEM, keyPEM, cert
+}
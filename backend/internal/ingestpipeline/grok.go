@@ -0,0 +1,132 @@
+package ingestpipeline
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// grokPatterns is the built-in grok library: a subset of the Logstash
+// core patterns, rewritten for RE2 (no backreferences or lookaround).
+var grokPatterns = map[string]string{
+	"USERNAME":          `[a-zA-Z0-9._-]+`,
+	"USER":              `%{USERNAME}`,
+	"EMAILADDRESS":      `[a-zA-Z0-9_.+-]+@[a-zA-Z0-9-]+(?:\.[a-zA-Z0-9-]+)+`,
+	"INT":               `[+-]?[0-9]+`,
+	"BASE10NUM":         `[+-]?(?:[0-9]+(?:\.[0-9]+)?|\.[0-9]+)`,
+	"NUMBER":            `%{BASE10NUM}`,
+	"BASE16NUM":         `(?:0[xX])?[0-9A-Fa-f]+`,
+	"POSINT":            `\b[1-9][0-9]*\b`,
+	"NONNEGINT":         `\b[0-9]+\b`,
+	"WORD":              `\b\w+\b`,
+	"NOTSPACE":          `\S+`,
+	"SPACE":             `\s*`,
+	"DATA":              `.*?`,
+	"GREEDYDATA":        `.*`,
+	"QUOTEDSTRING":      `"(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*'`,
+	"UUID":              `[A-Fa-f0-9]{8}-(?:[A-Fa-f0-9]{4}-){3}[A-Fa-f0-9]{12}`,
+	"MAC":               `(?:[A-Fa-f0-9]{2}[:-]){5}[A-Fa-f0-9]{2}`,
+	"IPV4":              `(?:(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.){3}(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)`,
+	"IPV6":              `(?:[0-9A-Fa-f]{1,4}:){7}[0-9A-Fa-f]{1,4}|(?:[0-9A-Fa-f]{1,4}:){1,7}:|(?:[0-9A-Fa-f]{1,4}:){1,6}:[0-9A-Fa-f]{1,4}|::(?:[0-9A-Fa-f]{1,4}:){0,5}[0-9A-Fa-f]{1,4}|::`,
+	"IP":                `%{IPV6}|%{IPV4}`,
+	"HOSTNAME":          `\b[0-9A-Za-z][0-9A-Za-z-]{0,62}(?:\.[0-9A-Za-z][0-9A-Za-z-]{0,62})*\.?\b`,
+	"IPORHOST":          `%{IP}|%{HOSTNAME}`,
+	"HOSTPORT":          `%{IPORHOST}:%{POSINT}`,
+	"PATH":              `(?:/[^\s/]*)+`,
+	"URIPATH":           `(?:/[A-Za-z0-9$.+!*'(){},~:;=@#%&_\-]*)+`,
+	"URIPARAM":          `\?[A-Za-z0-9$.+!*'|(){},~@#%&/=:;_?\-\[\]<>]*`,
+	"URIPATHPARAM":      `%{URIPATH}(?:%{URIPARAM})?`,
+	"MONTH":             `\b(?:[Jj]an(?:uary)?|[Ff]eb(?:ruary)?|[Mm]ar(?:ch)?|[Aa]pr(?:il)?|[Mm]ay|[Jj]une?|[Jj]uly?|[Aa]ug(?:ust)?|[Ss]ep(?:tember)?|[Oo]ct(?:ober)?|[Nn]ov(?:ember)?|[Dd]ec(?:ember)?)\b`,
+	"MONTHNUM":          `0?[1-9]|1[0-2]`,
+	"MONTHDAY":          `0[1-9]|[12][0-9]|3[01]|[1-9]`,
+	"DAY":               `(?:Mon(?:day)?|Tue(?:sday)?|Wed(?:nesday)?|Thu(?:rsday)?|Fri(?:day)?|Sat(?:urday)?|Sun(?:day)?)`,
+	"YEAR":              `(?:\d\d){1,2}`,
+	"HOUR":              `2[0123]|[01]?[0-9]`,
+	"MINUTE":            `[0-5][0-9]`,
+	"SECOND":            `(?:[0-5]?[0-9]|60)(?:[:.,][0-9]+)?`,
+	"TIME":              `%{HOUR}:%{MINUTE}(?::%{SECOND})?`,
+	"ISO8601_TIMEZONE":  `Z|[+-]%{HOUR}(?::?%{MINUTE})?`,
+	"TIMESTAMP_ISO8601": `%{YEAR}-%{MONTHNUM}-%{MONTHDAY}[T ]%{HOUR}:?%{MINUTE}(?::?%{SECOND})?(?:%{ISO8601_TIMEZONE})?`,
+	"SYSLOGTIMESTAMP":   `%{MONTH} +%{MONTHDAY} %{TIME}`,
+	"HTTPDATE":          `%{MONTHDAY}/%{MONTH}/%{YEAR}:%{TIME} %{INT}`,
+	"LOGLEVEL":          `[Aa]lert|ALERT|[Tt]race|TRACE|[Dd]ebug|DEBUG|[Nn]otice|NOTICE|[Ii]nfo|INFO|[Ww]arn(?:ing)?|WARN(?:ING)?|[Ee]rr(?:or)?|ERR(?:OR)?|[Cc]rit(?:ical)?|CRIT(?:ICAL)?|[Ff]atal|FATAL|[Ss]evere|SEVERE|[Ee]merg(?:ency)?|EMERG(?:ENCY)?`,
+}
+
+// grokRef matches %{PATTERN}, %{PATTERN:field} and %{PATTERN:field:type}.
+// The type suffix is accepted for Logstash compatibility and ignored:
+// attribute values are always strings.
+var grokRef = regexp.MustCompile(`%\{(\w+)(?::([^:}]+))?(?::\w+)?\}`)
+
+// grokGroupPrefix names the capture groups generated for %{PATTERN:field}
+// references.
+const grokGroupPrefix = "_grok"
+
+// maxGrokDepth bounds pattern expansion so a self-referencing library
+// entry fails instead of recursing forever.
+const maxGrokDepth = 16
+
+// compileGrok expands a grok pattern into a regexp. The returned names are
+// indexed by submatch, like Regexp.SubexpNames, but hold attribute names
+// as written in the pattern — which may contain characters (dots, dashes)
+// that RE2 does not allow in group names.
+func compileGrok(pattern string) (*regexp.Regexp, []string, error) {
+	var fields []string
+	expanded, err := expandGrok(pattern, 0, &fields)
+	if err != nil {
+		return nil, nil, err
+	}
+	re, err := regexp.Compile(expanded)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid pattern: %w", err)
+	}
+
+	// Map the generated group names back to field names. Groups the user
+	// named with plain (?P<name>...) syntax keep their name; unnamed
+	// groups inside library patterns keep an empty one.
+	names := re.SubexpNames()
+	out := make([]string, len(names))
+	for i, n := range names {
+		out[i] = n
+		if rest, ok := strings.CutPrefix(n, grokGroupPrefix); ok {
+			if idx, err := strconv.Atoi(rest); err == nil && idx < len(fields) {
+				out[i] = fields[idx]
+			}
+		}
+	}
+	return re, out, nil
+}
+
+// expandGrok replaces every %{...} reference with its library pattern.
+// Named references become (?P<_grokN>...) capture groups and record their
+// field name in fields[N]; unnamed ones become non-capturing groups.
+func expandGrok(pattern string, depth int, fields *[]string) (string, error) {
+	if depth > maxGrokDepth {
+		return "", fmt.Errorf("grok pattern nests deeper than %d levels", maxGrokDepth)
+	}
+	var b strings.Builder
+	last := 0
+	for _, m := range grokRef.FindAllStringSubmatchIndex(pattern, -1) {
+		b.WriteString(pattern[last:m[0]])
+		last = m[1]
+
+		name := pattern[m[2]:m[3]]
+		def, ok := grokPatterns[name]
+		if !ok {
+			return "", fmt.Errorf("unknown grok pattern %q", name)
+		}
+		// Library patterns never name their own fields.
+		inner, err := expandGrok(def, depth+1, new([]string))
+		if err != nil {
+			return "", err
+		}
+		if m[4] >= 0 && depth == 0 {
+			fmt.Fprintf(&b, "(?P<%s%d>%s)", grokGroupPrefix, len(*fields), inner)
+			*fields = append(*fields, pattern[m[4]:m[5]])
+		} else {
+			b.WriteString("(?:" + inner + ")")
+		}
+	}
+	b.WriteString(pattern[last:])
+	return b.String(), nil
+}
@@ -0,0 +1,75 @@
+package ingestpipeline
+
+import (
+	"testing"
+
+	"gastrolog/internal/chunk"
+	"gastrolog/internal/system"
+)
+
+func TestGrokLibraryCompiles(t *testing.T) {
+	for name := range grokPatterns {
+		if _, _, err := compileGrok("%{" + name + ":v}"); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
+
+func TestGrokExtract(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		raw     string
+		want    map[string]string
+	}{
+		{
+			name:    "access log",
+			pattern: `%{IPORHOST:client.ip} - %{USER:user} \[%{HTTPDATE:ts}\] "%{WORD:method} %{URIPATHPARAM:path}" %{INT:status:int}`,
+			raw:     `192.168.1.10 - bob [10/Oct/2026:13:55:36 +0000] "GET /index.html?x=1" 200`,
+			want: map[string]string{
+				"client.ip": "192.168.1.10",
+				"user":      "bob",
+				"ts":        "10/Oct/2026:13:55:36 +0000",
+				"method":    "GET",
+				"path":      "/index.html?x=1",
+				"status":    "200",
+			},
+		},
+		{
+			name:    "iso timestamp and level",
+			pattern: `^%{TIMESTAMP_ISO8601:time} %{LOGLEVEL:level} %{GREEDYDATA:msg}`,
+			raw:     "2026-10-16T08:00:01.123Z WARN disk almost full",
+			want: map[string]string{
+				"time":  "2026-10-16T08:00:01.123Z",
+				"level": "WARN",
+				"msg":   "disk almost full",
+			},
+		},
+		{
+			name:    "mixed with plain named group",
+			pattern: `id=%{UUID:id} (?P<rest>.*)`,
+			raw:     "id=0192f1a4-7c3e-7b6a-9d8e-123456789abc done",
+			want: map[string]string{
+				"id":   "0192f1a4-7c3e-7b6a-9d8e-123456789abc",
+				"rest": "done",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := mustCompile(t, system.RouteStage{
+				Type:   system.RouteStageGrok,
+				Params: map[string]string{"pattern": tt.pattern},
+			})
+			out, _ := p.Apply(chunk.Record{Raw: []byte(tt.raw)})
+			for k, v := range tt.want {
+				if out.Attrs[k] != v {
+					t.Errorf("%s = %q, want %q", k, out.Attrs[k], v)
+				}
+			}
+			if len(out.Attrs) != len(tt.want) {
+				t.Errorf("extra attrs: %v", out.Attrs)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Package ingestpipeline compiles and runs the per-route ingest pipelines
+// configured on system.RouteConfig.Stages.
+//
+// A pipeline is an ordered list of stages — field extraction (regex, grok),
+// attribute edits (set, rename, delete), PII redaction (hash, mask) and
+// drop-by-expression. The orchestrator runs a route's pipeline once per
+// matched record, after the route filter and before the record is written
+// to the route's destinations.
+package ingestpipeline
+
+import (
+	"fmt"
+	"maps"
+
+	"gastrolog/internal/chunk"
+	"gastrolog/internal/system"
+)
+
+// Pipeline is a compiled route pipeline. It is immutable after Compile and
+// safe for concurrent use.
+type Pipeline struct {
+	stages []stage
+}
+
+// stage is one compiled pipeline step. apply mutates rec in place and
+// returns false to drop the record. Implementations may replace rec.Raw
+// but must never write into it: the input slice is shared with other
+// routes and the ingester.
+type stage interface {
+	apply(rec *chunk.Record) bool
+}
+
+// Compile validates and compiles route stages. Returns nil for an empty
+// stage list, which Apply treats as a pass-through.
+func Compile(stages []system.RouteStage) (*Pipeline, error) {
+	if len(stages) == 0 {
+		return nil, nil
+	}
+	p := &Pipeline{stages: make([]stage, 0, len(stages))}
+	for i, sc := range stages {
+		s, err := compileStage(sc)
+		if err != nil {
+			return nil, fmt.Errorf("stage %d (%s): %w", i+1, sc.Type, err)
+		}
+		p.stages = append(p.stages, s)
+	}
+	return p, nil
+}
+
+// Apply runs the pipeline against rec and returns the transformed record.
+// keep is false when a stage dropped the record. The input record is not
+// modified: attributes are copied before the first stage runs.
+func (p *Pipeline) Apply(rec chunk.Record) (out chunk.Record, keep bool) {
+	if p == nil || len(p.stages) == 0 {
+		return rec, true
+	}
+	attrs := make(chunk.Attributes, len(rec.Attrs)+4)
+	maps.Copy(attrs, rec.Attrs)
+	rec.Attrs = attrs
+	for _, s := range p.stages {
+		if !s.apply(&rec) {
+			return rec, false
+		}
+	}
+	return rec, true
+}
@@ -0,0 +1,197 @@
+package ingestpipeline
+
+import (
+	"strings"
+	"testing"
+
+	"gastrolog/internal/chunk"
+	"gastrolog/internal/system"
+)
+
+func stageCfg(t system.RouteStageType, kv ...string) system.RouteStage {
+	p := make(map[string]string, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		p[kv[i]] = kv[i+1]
+	}
+	return system.RouteStage{Type: t, Params: p}
+}
+
+func mustCompile(t *testing.T, stages ...system.RouteStage) *Pipeline {
+	t.Helper()
+	p, err := Compile(stages)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+	return p
+}
+
+func TestCompileEmptyIsPassThrough(t *testing.T) {
+	p := mustCompile(t)
+	if p != nil {
+		t.Fatalf("expected nil pipeline for no stages, got %+v", p)
+	}
+	rec := chunk.Record{Raw: []byte("hello"), Attrs: chunk.Attributes{"a": "1"}}
+	out, keep := p.Apply(rec)
+	if !keep || string(out.Raw) != "hello" || out.Attrs["a"] != "1" {
+		t.Fatalf("nil pipeline changed record: %+v keep=%v", out, keep)
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		stage system.RouteStage
+		want  string
+	}{
+		{"unknown type", stageCfg("bogus"), "unknown stage type"},
+		{"regex no pattern", stageCfg(system.RouteStageRegex), "pattern is required"},
+		{"regex invalid", stageCfg(system.RouteStageRegex, "pattern", "(?P<x>"), "invalid pattern"},
+		{"regex unnamed", stageCfg(system.RouteStageRegex, "pattern", `(\d+)`), "no named capture groups"},
+		{"grok unknown", stageCfg(system.RouteStageGrok, "pattern", "%{NOPE:x}"), "unknown grok pattern"},
+		{"grok unnamed", stageCfg(system.RouteStageGrok, "pattern", "%{INT}"), "no named fields"},
+		{"set no key", stageCfg(system.RouteStageSet, "value", "x"), "key is required"},
+		{"rename missing to", stageCfg(system.RouteStageRename, "from", "a"), "from and to are required"},
+		{"delete no keys", stageCfg(system.RouteStageDelete, "keys", " , "), "keys is required"},
+		{"hash no keys", stageCfg(system.RouteStageHash), "keys is required"},
+		{"mask no pattern", stageCfg(system.RouteStageMask), "pattern is required"},
+		{"drop no expression", stageCfg(system.RouteStageDrop), "expression is required"},
+		{"drop invalid", stageCfg(system.RouteStageDrop, "expression", "a=1 AND ("), "invalid expression"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile([]system.RouteStage{stageCfg(system.RouteStageSet, "key", "k"), tt.stage})
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("error %q does not contain %q", err, tt.want)
+			}
+			if !strings.HasPrefix(err.Error(), "stage 2 ") {
+				t.Fatalf("error %q should name the failing stage", err)
+			}
+		})
+	}
+}
+
+func TestApplyDoesNotMutateInput(t *testing.T) {
+	p := mustCompile(t,
+		stageCfg(system.RouteStageSet, "key", "env", "value", "prod"),
+		stageCfg(system.RouteStageDelete, "keys", "secret"),
+		stageCfg(system.RouteStageMask, "pattern", `\d{4}`),
+	)
+	raw := []byte("card 1234")
+	attrs := chunk.Attributes{"secret": "s"}
+	out, keep := p.Apply(chunk.Record{Raw: raw, Attrs: attrs})
+	if !keep {
+		t.Fatal("record dropped")
+	}
+	if string(raw) != "card 1234" {
+		t.Errorf("input raw mutated: %q", raw)
+	}
+	if attrs["secret"] != "s" || len(attrs) != 1 {
+		t.Errorf("input attrs mutated: %v", attrs)
+	}
+	if string(out.Raw) != "card ****" {
+		t.Errorf("raw = %q, want %q", out.Raw, "card ****")
+	}
+	if out.Attrs["env"] != "prod" {
+		t.Errorf("env = %q, want prod", out.Attrs["env"])
+	}
+	if _, ok := out.Attrs["secret"]; ok {
+		t.Error("secret not deleted")
+	}
+}
+
+func TestRegexExtract(t *testing.T) {
+	p := mustCompile(t,
+		stageCfg(system.RouteStageRegex, "pattern", `user=(?P<user>\w+) status=(?P<status>\d+)`),
+		stageCfg(system.RouteStageRegex, "pattern", `^(?P<domain>[^.]+)\.`, "field", "host"),
+	)
+	out, _ := p.Apply(chunk.Record{
+		Raw:   []byte("login user=alice status=401"),
+		Attrs: chunk.Attributes{"host": "web1.example.com"},
+	})
+	if out.Attrs["user"] != "alice" || out.Attrs["status"] != "401" {
+		t.Errorf("raw extraction: %v", out.Attrs)
+	}
+	if out.Attrs["domain"] != "web1" {
+		t.Errorf("field extraction: domain = %q", out.Attrs["domain"])
+	}
+
+	// No match leaves the record unchanged.
+	out, keep := p.Apply(chunk.Record{Raw: []byte("nothing here")})
+	if !keep || len(out.Attrs) != 0 {
+		t.Errorf("unexpected attrs on no match: %v", out.Attrs)
+	}
+}
+
+func TestRenameAndHash(t *testing.T) {
+	p := mustCompile(t,
+		stageCfg(system.RouteStageRename, "from", "src", "to", "client_ip"),
+		stageCfg(system.RouteStageHash, "keys", "client_ip, email", "salt", "pepper"),
+	)
+	a, _ := p.Apply(chunk.Record{Attrs: chunk.Attributes{"src": "10.0.0.1", "email": "a@b.c"}})
+	b, _ := p.Apply(chunk.Record{Attrs: chunk.Attributes{"src": "10.0.0.1", "email": "x@y.z"}})
+
+	if _, ok := a.Attrs["src"]; ok {
+		t.Error("src not renamed")
+	}
+	if got := a.Attrs["client_ip"]; len(got) != 64 || got == "10.0.0.1" {
+		t.Errorf("client_ip not hashed: %q", got)
+	}
+	if a.Attrs["client_ip"] != b.Attrs["client_ip"] {
+		t.Error("equal inputs should hash equally")
+	}
+	if a.Attrs["email"] == b.Attrs["email"] {
+		t.Error("different inputs should hash differently")
+	}
+
+	unsalted := mustCompile(t, stageCfg(system.RouteStageHash, "keys", "email"))
+	c, _ := unsalted.Apply(chunk.Record{Attrs: chunk.Attributes{"email": "a@b.c"}})
+	if c.Attrs["email"] == a.Attrs["email"] {
+		t.Error("salt should change the hash")
+	}
+}
+
+func TestMaskAttributeWithGroups(t *testing.T) {
+	p := mustCompile(t, stageCfg(system.RouteStageMask,
+		"field", "card",
+		"pattern", `\d{12}(\d{4})`,
+		"replacement", "************$1",
+	))
+	out, _ := p.Apply(chunk.Record{Raw: []byte("raw 4111111111111111"), Attrs: chunk.Attributes{"card": "4111111111111111"}})
+	if got := out.Attrs["card"]; got != "************1111" {
+		t.Errorf("card = %q", got)
+	}
+	if string(out.Raw) != "raw 4111111111111111" {
+		t.Errorf("raw should be untouched when field is set: %q", out.Raw)
+	}
+}
+
+func TestDrop(t *testing.T) {
+	p := mustCompile(t,
+		stageCfg(system.RouteStageRegex, "pattern", `path=(?P<path>\S+)`),
+		stageCfg(system.RouteStageDrop, "expression", `path="/healthz" OR debug`),
+		stageCfg(system.RouteStageSet, "key", "kept", "value", "yes"),
+	)
+	tests := []struct {
+		raw  string
+		keep bool
+	}{
+		{"GET path=/healthz 200", false},
+		{"debug cache warmed", false},
+		{"GET path=/api 200", true},
+	}
+	for _, tt := range tests {
+		out, keep := p.Apply(chunk.Record{Raw: []byte(tt.raw)})
+		if keep != tt.keep {
+			t.Errorf("%q: keep = %v, want %v", tt.raw, keep, tt.keep)
+		}
+		if keep && out.Attrs["kept"] != "yes" {
+			t.Errorf("%q: later stages did not run", tt.raw)
+		}
+		if !keep && out.Attrs["kept"] != "" {
+			t.Errorf("%q: stages after drop should not run", tt.raw)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package ingestpipeline
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gastrolog/internal/chunk"
+	"gastrolog/internal/query"
+	"gastrolog/internal/querylang"
+	"gastrolog/internal/system"
+)
+
+// defaultMask replaces matched text when a mask stage has no replacement.
+const defaultMask = "****"
+
+func compileStage(sc system.RouteStage) (stage, error) {
+	p := sc.Params
+	switch sc.Type {
+	case system.RouteStageRegex:
+		return compileRegexStage(p["pattern"], p["field"])
+	case system.RouteStageGrok:
+		return compileGrokStage(p["pattern"], p["field"])
+	case system.RouteStageSet:
+		if p["key"] == "" {
+			return nil, errors.New("key is required")
+		}
+		return &setStage{key: p["key"], value: p["value"]}, nil
+	case system.RouteStageRename:
+		if p["from"] == "" || p["to"] == "" {
+			return nil, errors.New("from and to are required")
+		}
+		return &renameStage{from: p["from"], to: p["to"]}, nil
+	case system.RouteStageDelete:
+		keys := splitKeys(p["keys"])
+		if len(keys) == 0 {
+			return nil, errors.New("keys is required")
+		}
+		return &deleteStage{keys: keys}, nil
+	case system.RouteStageHash:
+		keys := splitKeys(p["keys"])
+		if len(keys) == 0 {
+			return nil, errors.New("keys is required")
+		}
+		return &hashStage{keys: keys, salt: []byte(p["salt"])}, nil
+	case system.RouteStageMask:
+		return compileMaskStage(p)
+	case system.RouteStageDrop:
+		return compileDropStage(p["expression"])
+	default:
+		return nil, fmt.Errorf("unknown stage type %q", sc.Type)
+	}
+}
+
+// splitKeys parses a comma-separated attribute list, ignoring blanks.
+func splitKeys(s string) []string {
+	var keys []string
+	for k := range strings.SplitSeq(s, ",") {
+		if k = strings.TrimSpace(k); k != "" {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// fieldValue reads the stage input: the named attribute, or the raw
+// message when field is empty.
+func fieldValue(rec *chunk.Record, field string) (string, bool) {
+	if field == "" {
+		return string(rec.Raw), true
+	}
+	v, ok := rec.Attrs[field]
+	return v, ok
+}
+
+// ---------------------------------------------------------------------------
+// regex / grok
+// ---------------------------------------------------------------------------
+
+// extractStage copies capture groups into attributes. names[i] is the
+// attribute for submatch i; empty names are skipped.
+type extractStage struct {
+	re    *regexp.Regexp
+	names []string
+	field string
+}
+
+func compileRegexStage(pattern, field string) (stage, error) {
+	if pattern == "" {
+		return nil, errors.New("pattern is required")
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern: %w", err)
+	}
+	names := re.SubexpNames()
+	if !hasName(names) {
+		return nil, errors.New("pattern has no named capture groups")
+	}
+	return &extractStage{re: re, names: names, field: field}, nil
+}
+
+func compileGrokStage(pattern, field string) (stage, error) {
+	if pattern == "" {
+		return nil, errors.New("pattern is required")
+	}
+	re, names, err := compileGrok(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if !hasName(names) {
+		return nil, errors.New("pattern has no named fields (use %{PATTERN:name})")
+	}
+	return &extractStage{re: re, names: names, field: field}, nil
+}
+
+func hasName(names []string) bool {
+	for _, n := range names {
+		if n != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *extractStage) apply(rec *chunk.Record) bool {
+	v, ok := fieldValue(rec, s.field)
+	if !ok {
+		return true
+	}
+	m := s.re.FindStringSubmatchIndex(v)
+	if m == nil {
+		return true
+	}
+	for i, name := range s.names {
+		if name == "" || m[2*i] < 0 {
+			continue
+		}
+		rec.Attrs[name] = v[m[2*i]:m[2*i+1]]
+	}
+	return true
+}
+
+// ---------------------------------------------------------------------------
+// set / rename / delete
+// ---------------------------------------------------------------------------
+
+type setStage struct {
+	key, value string
+}
+
+func (s *setStage) apply(rec *chunk.Record) bool {
+	rec.Attrs[s.key] = s.value
+	return true
+}
+
+type renameStage struct {
+	from, to string
+}
+
+func (s *renameStage) apply(rec *chunk.Record) bool {
+	if v, ok := rec.Attrs[s.from]; ok {
+		delete(rec.Attrs, s.from)
+		rec.Attrs[s.to] = v
+	}
+	return true
+}
+
+type deleteStage struct {
+	keys []string
+}
+
+func (s *deleteStage) apply(rec *chunk.Record) bool {
+	for _, k := range s.keys {
+		delete(rec.Attrs, k)
+	}
+	return true
+}
+
+// ---------------------------------------------------------------------------
+// hash / mask
+// ---------------------------------------------------------------------------
+
+// hashStage replaces attribute values with a hex HMAC-SHA256 keyed by salt.
+// Equal inputs hash equally, so hashed fields stay usable for grouping and
+// joins without exposing the original value.
+type hashStage struct {
+	keys []string
+	salt []byte
+}
+
+func (s *hashStage) apply(rec *chunk.Record) bool {
+	for _, k := range s.keys {
+		v, ok := rec.Attrs[k]
+		if !ok {
+			continue
+		}
+		mac := hmac.New(sha256.New, s.salt)
+		mac.Write([]byte(v))
+		rec.Attrs[k] = hex.EncodeToString(mac.Sum(nil))
+	}
+	return true
+}
+
+// maskStage rewrites regex matches in an attribute or the raw message.
+// The replacement may reference capture groups ($1, ${name}).
+type maskStage struct {
+	re          *regexp.Regexp
+	replacement string
+	field       string
+}
+
+func compileMaskStage(p map[string]string) (stage, error) {
+	if p["pattern"] == "" {
+		return nil, errors.New("pattern is required")
+	}
+	re, err := regexp.Compile(p["pattern"])
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern: %w", err)
+	}
+	repl, ok := p["replacement"]
+	if !ok {
+		repl = defaultMask
+	}
+	return &maskStage{re: re, replacement: repl, field: p["field"]}, nil
+}
+
+func (s *maskStage) apply(rec *chunk.Record) bool {
+	if s.field == "" {
+		// ReplaceAll always returns a fresh slice, so the shared input
+		// Raw is left untouched.
+		rec.Raw = s.re.ReplaceAll(rec.Raw, []byte(s.replacement))
+		return true
+	}
+	if v, ok := rec.Attrs[s.field]; ok {
+		rec.Attrs[s.field] = s.re.ReplaceAllString(v, s.replacement)
+	}
+	return true
+}
+
+// ---------------------------------------------------------------------------
+// drop
+// ---------------------------------------------------------------------------
+
+// dropStage discards records matching a query expression. Unlike route
+// filters the expression may use tokens, regexes and globs on the raw
+// message, not just attribute predicates.
+type dropStage struct {
+	match func(chunk.Record) bool
+}
+
+func compileDropStage(expression string) (stage, error) {
+	if strings.TrimSpace(expression) == "" {
+		return nil, errors.New("expression is required")
+	}
+	expr, err := querylang.Parse(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression: %w", err)
+	}
+	return &dropStage{match: query.CompileFilter(expr)}, nil
+}
+
+func (s *dropStage) apply(rec *chunk.Record) bool {
+	return !s.match(*rec)
+}
@@ -0,0 +1,682 @@
+// Package ingestqueue provides a disk-backed FIFO for ingested messages.
+// The orchestrator spools every message through it before digesting, so
+// records survive a process crash and a vault stall no longer pushes
+// backpressure onto ingesters until the queue's byte bound is reached.
+//
+// The queue is a sequence of segment files named after the sequence number
+// of their first entry. Each entry is framed as payload length (4) +
+// CRC32C (4) + payload. The sequence number of the last released entry is
+// persisted in a small cursor file; on Open, entries after the cursor are
+// handed out again. Torn or corrupt tails left by a crash are truncated.
+//
+// The queue has a single writer (Append), a single reader (Next/TryNext)
+// and a releaser (Release) that may run on a third goroutine. Entries are
+// released in the order they were read.
+package ingestqueue
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SyncPolicy controls when appended entries are fsynced.
+type SyncPolicy string
+
+const (
+	// SyncAlways fsyncs every Append before it returns. Nothing that was
+	// accepted is lost on power failure, at the cost of one fsync per batch.
+	SyncAlways SyncPolicy = "always"
+
+	// SyncInterval fsyncs in the background every Config.SyncInterval.
+	// A power failure loses at most that much; a process crash loses
+	// nothing, since writes are already in the page cache.
+	SyncInterval SyncPolicy = "interval"
+
+	// SyncNone leaves flushing to the operating system.
+	SyncNone SyncPolicy = "none"
+)
+
+// ParseSyncPolicy validates a sync policy name.
+func ParseSyncPolicy(s string) (SyncPolicy, error) {
+	switch p := SyncPolicy(s); p {
+	case SyncAlways, SyncInterval, SyncNone:
+		return p, nil
+	default:
+		return "", fmt.Errorf("invalid sync policy %q: must be always, interval or none", s)
+	}
+}
+
+const (
+	// frameHeaderSize is payload length (4) + CRC32C (4).
+	frameHeaderSize = 8
+
+	// maxEntrySize bounds a single payload. Lengths above it can only come
+	// from a corrupt frame, so replay stops there.
+	maxEntrySize = 256 << 20
+
+	// maxSegmentSize is the target size of a segment before rotation.
+	// Queues smaller than 8 segments of this size use MaxBytes/8 so that
+	// released space is returned to the filesystem in reasonable steps.
+	maxSegmentSize = 64 << 20
+	minSegmentSize = 64 << 10
+
+	segmentPrefix = "ingest-"
+	segmentSuffix = ".log"
+	cursorName    = "cursor"
+
+	// cursorInterval is how often the release cursor is persisted. Entries
+	// released within the last interval are handed out again after a crash.
+	cursorInterval = time.Second
+
+	// DefaultSyncInterval is used when Config.SyncInterval is zero.
+	DefaultSyncInterval = time.Second
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// ErrClosed is returned by queue operations after Close.
+var ErrClosed = errors.New("ingest queue closed")
+
+// Config describes a queue.
+type Config struct {
+	// Dir holds the segment and cursor files. Created if missing.
+	Dir string
+
+	// MaxBytes bounds the size of unreleased entries, framing included.
+	// Append waits for space once the bound is reached. An entry is always
+	// admitted into an empty queue, so one oversized entry can't wedge it.
+	MaxBytes int64
+
+	// Sync selects the fsync policy. Defaults to SyncInterval.
+	Sync SyncPolicy
+
+	// SyncInterval is the background fsync period for SyncInterval.
+	SyncInterval time.Duration
+}
+
+// Entry is a queued payload.
+type Entry struct {
+	// Seq is assigned by Append and identifies the entry for Release.
+	Seq uint64
+
+	// Data is the persisted payload.
+	Data []byte
+
+	// Tag is an in-memory value carried from Append to Next, such as an
+	// acknowledgement channel. It is not persisted: entries replayed
+	// after a restart have a nil Tag.
+	Tag any
+}
+
+// Stats is a point-in-time view of the queue.
+type Stats struct {
+	Entries  int64 // unreleased entries
+	Bytes    int64 // unreleased bytes, framing included
+	MaxBytes int64
+}
+
+// segment is one file of the queue. Entries have consecutive sequence
+// numbers starting at first.
+type segment struct {
+	first uint64
+	count uint64
+	size  int64
+	path  string
+
+	// releasedSize is the size of the entries already released when the
+	// segment was recovered.
+	releasedSize int64
+}
+
+func (s *segment) end() uint64 { return s.first + s.count }
+
+// inflight is an entry handed out by Next and not yet released.
+type inflight struct {
+	seq  uint64
+	size int64
+}
+
+// Queue is a disk-backed FIFO. See the package documentation.
+type Queue struct {
+	cfg         Config
+	segmentSize int64
+
+	mu       sync.Mutex
+	closed   bool
+	segments []*segment // oldest first; the last one is written to
+	active   *os.File   // write handle on the last segment
+	dirty    bool       // written since the last fsync
+	nextSeq  uint64     // sequence number of the next appended entry
+	released uint64     // last released sequence number
+	saved    uint64     // released value in the cursor file
+	pending  Stats      // unreleased entries and bytes
+	reading  []inflight // read, not yet released
+	tags     map[uint64]any
+	readSeq  uint64   // sequence number of the next entry to read
+	readSeg  *segment // segment holding readSeq
+	readPos  int64    // offset of readSeq in readSeg
+	reopen   bool     // the read handle must be reopened at readPos
+
+	// readMu serializes readers and guards the read handle.
+	readMu   sync.Mutex
+	readFile *os.File
+	reader   *bufio.Reader
+
+	appended chan struct{} // signalled on Append and Close
+	freed    chan struct{} // signalled on Release and Close
+	stop     chan struct{}
+	flushWg  sync.WaitGroup
+}
+
+// Open opens the queue in cfg.Dir, recovering unreleased entries from a
+// previous run. They are returned by Next before anything appended later.
+func Open(cfg Config) (*Queue, error) {
+	if cfg.MaxBytes <= 0 {
+		return nil, errors.New("ingest queue: max bytes must be positive")
+	}
+	if cfg.Sync == "" {
+		cfg.Sync = SyncInterval
+	}
+	if _, err := ParseSyncPolicy(string(cfg.Sync)); err != nil {
+		return nil, fmt.Errorf("ingest queue: %w", err)
+	}
+	if cfg.SyncInterval <= 0 {
+		cfg.SyncInterval = DefaultSyncInterval
+	}
+	if err := os.MkdirAll(cfg.Dir, 0o750); err != nil {
+		return nil, fmt.Errorf("create ingest queue directory: %w", err)
+	}
+
+	q := &Queue{
+		cfg:         cfg,
+		segmentSize: min(maxSegmentSize, max(cfg.MaxBytes/8, minSegmentSize)),
+		tags:        make(map[uint64]any),
+		appended:    make(chan struct{}, 1),
+		freed:       make(chan struct{}, 1),
+		stop:        make(chan struct{}),
+	}
+	q.pending.MaxBytes = cfg.MaxBytes
+
+	if err := q.recover(); err != nil {
+		return nil, err
+	}
+	if err := q.startSegment(); err != nil {
+		return nil, err
+	}
+
+	q.flushWg.Go(q.flushLoop)
+	return q, nil
+}
+
+// recover scans existing segments, truncates damaged tails, drops fully
+// released segments and positions the reader after the cursor.
+func (q *Queue) recover() error {
+	q.released = q.readCursor()
+	q.saved = q.released
+
+	paths, err := filepath.Glob(filepath.Join(q.cfg.Dir, segmentPrefix+"*"+segmentSuffix))
+	if err != nil {
+		return err
+	}
+	var segs []*segment
+	for _, p := range paths {
+		name := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(p), segmentPrefix), segmentSuffix)
+		first, err := strconv.ParseUint(name, 10, 64)
+		if err != nil {
+			continue
+		}
+		segs = append(segs, &segment{first: first, path: p})
+	}
+	slices.SortFunc(segs, func(a, b *segment) int { return cmp.Compare(a.first, b.first) })
+
+	q.nextSeq = q.released + 1
+	for _, s := range segs {
+		if err := q.scanSegment(s); err != nil {
+			return err
+		}
+		q.nextSeq = max(q.nextSeq, s.end())
+		if s.count == 0 || s.end() <= q.released+1 {
+			if err := os.Remove(s.path); err != nil {
+				return fmt.Errorf("remove released ingest queue segment: %w", err)
+			}
+			continue
+		}
+		q.segments = append(q.segments, s)
+	}
+	q.readSeq = q.nextSeq
+	if len(q.segments) > 0 {
+		// The first kept segment is the only one that can hold released
+		// entries; start reading after them.
+		first := q.segments[0]
+		q.readSeg = first
+		q.readSeq = max(first.first, q.released+1)
+		q.readPos = first.releasedSize
+		q.reopen = true
+	}
+	return nil
+}
+
+// scanSegment counts the valid entries of s, accumulates those after the
+// cursor into the pending stats, and truncates anything after the last
+// valid frame.
+func (q *Queue) scanSegment(s *segment) error {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return fmt.Errorf("open ingest queue segment: %w", err)
+	}
+	r := bufio.NewReader(f)
+	var off int64
+	for {
+		n, err := readFrame(r, nil)
+		if err != nil {
+			break
+		}
+		seq := s.first + s.count
+		s.count++
+		if seq <= q.released {
+			s.releasedSize = off + n
+		} else {
+			q.pending.Entries++
+			q.pending.Bytes += n
+		}
+		off += n
+	}
+	_ = f.Close()
+	s.size = off
+
+	fi, err := os.Stat(s.path)
+	if err != nil {
+		return fmt.Errorf("stat ingest queue segment: %w", err)
+	}
+	if fi.Size() > off {
+		if err := os.Truncate(s.path, off); err != nil {
+			return fmt.Errorf("truncate ingest queue segment: %w", err)
+		}
+	}
+	return nil
+}
+
+// readFrame reads one frame from r. With buf nil the payload is discarded.
+// Returns the frame size including the header.
+func readFrame(r *bufio.Reader, buf *[]byte) (int64, error) {
+	var hdr [frameHeaderSize]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return 0, err
+	}
+	n := binary.LittleEndian.Uint32(hdr[0:4])
+	sum := binary.LittleEndian.Uint32(hdr[4:8])
+	if n > maxEntrySize {
+		return 0, errors.New("frame too large")
+	}
+	payload := make([]byte, n)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, err
+	}
+	if crc32.Checksum(payload, crcTable) != sum {
+		return 0, errors.New("frame checksum mismatch")
+	}
+	if buf != nil {
+		*buf = payload
+	}
+	return frameHeaderSize + int64(n), nil
+}
+
+// startSegment begins a new segment at nextSeq. Caller holds q.mu or has
+// exclusive access.
+func (q *Queue) startSegment() error {
+	if q.active != nil {
+		if err := q.active.Sync(); err != nil {
+			return fmt.Errorf("sync ingest queue segment: %w", err)
+		}
+		if err := q.active.Close(); err != nil {
+			return fmt.Errorf("close ingest queue segment: %w", err)
+		}
+	}
+	s := &segment{
+		first: q.nextSeq,
+		path:  filepath.Join(q.cfg.Dir, fmt.Sprintf("%s%020d%s", segmentPrefix, q.nextSeq, segmentSuffix)),
+	}
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("create ingest queue segment: %w", err)
+	}
+	q.active = f
+	q.dirty = false
+	q.segments = append(q.segments, s)
+	return nil
+}
+
+// Append persists entries in order and sets their Seq. It waits while the
+// queue is full, returning ctx.Err() if ctx ends first. Entries persisted
+// before an error stay queued; the others keep a zero Seq.
+func (q *Queue) Append(ctx context.Context, entries ...Entry) error {
+	var buf []byte
+	for len(entries) > 0 {
+		q.mu.Lock()
+		if q.closed {
+			q.mu.Unlock()
+			return ErrClosed
+		}
+		// Take as many entries as fit.
+		buf = buf[:0]
+		n := 0
+		room := q.cfg.MaxBytes - q.pending.Bytes
+		for _, e := range entries {
+			size := int64(frameHeaderSize + len(e.Data))
+			if size > room && (n > 0 || q.pending.Entries > 0) {
+				break
+			}
+			buf = binary.LittleEndian.AppendUint32(buf, uint32(len(e.Data))) //nolint:gosec // bounded by maxEntrySize below
+			buf = binary.LittleEndian.AppendUint32(buf, crc32.Checksum(e.Data, crcTable))
+			buf = append(buf, e.Data...)
+			room -= size
+			n++
+		}
+		if n == 0 {
+			q.mu.Unlock()
+			select {
+			case <-q.freed:
+				continue
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if err := q.write(entries[:n], buf); err != nil {
+			q.mu.Unlock()
+			return err
+		}
+		q.mu.Unlock()
+		entries = entries[n:]
+		signal(q.appended)
+	}
+	return nil
+}
+
+// write appends one encoded batch and publishes its entries. Caller holds q.mu.
+func (q *Queue) write(entries []Entry, buf []byte) error {
+	for _, e := range entries {
+		if len(e.Data) > maxEntrySize {
+			return fmt.Errorf("ingest queue entry of %d bytes exceeds %d", len(e.Data), maxEntrySize)
+		}
+	}
+	seg := q.segments[len(q.segments)-1]
+	if seg.size > 0 && seg.size+int64(len(buf)) > q.segmentSize {
+		if err := q.startSegment(); err != nil {
+			return err
+		}
+		seg = q.segments[len(q.segments)-1]
+	}
+	if _, err := q.active.Write(buf); err != nil {
+		// Drop the partial write so later batches start on a frame boundary.
+		// The reader may have buffered the discarded bytes.
+		_ = q.active.Truncate(seg.size)
+		_, _ = q.active.Seek(seg.size, io.SeekStart)
+		q.reopen = true
+		return fmt.Errorf("write ingest queue: %w", err)
+	}
+	if q.cfg.Sync == SyncAlways {
+		if err := q.active.Sync(); err != nil {
+			return fmt.Errorf("sync ingest queue: %w", err)
+		}
+	} else {
+		q.dirty = true
+	}
+	for i := range entries {
+		entries[i].Seq = q.nextSeq
+		if entries[i].Tag != nil {
+			q.tags[q.nextSeq] = entries[i].Tag
+		}
+		q.nextSeq++
+	}
+	seg.count += uint64(len(entries))
+	seg.size += int64(len(buf))
+	q.pending.Entries += int64(len(entries))
+	q.pending.Bytes += int64(len(buf))
+	return nil
+}
+
+// Next returns the oldest unread entry, waiting until one is appended.
+// It returns ctx.Err() if ctx ends first and ErrClosed after Close.
+func (q *Queue) Next(ctx context.Context) (Entry, error) {
+	for {
+		e, ok, err := q.TryNext()
+		if err != nil || ok {
+			return e, err
+		}
+		select {
+		case <-q.appended:
+		case <-ctx.Done():
+			return Entry{}, ctx.Err()
+		}
+	}
+}
+
+// TryNext returns the oldest unread entry without waiting. ok is false
+// when every appended entry has been read.
+func (q *Queue) TryNext() (e Entry, ok bool, err error) {
+	q.readMu.Lock()
+	defer q.readMu.Unlock()
+
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return Entry{}, false, ErrClosed
+	}
+	if q.readSeq >= q.nextSeq {
+		q.mu.Unlock()
+		return Entry{}, false, nil
+	}
+	// Move on to the segment holding readSeq. Sequence numbers can skip
+	// ahead across segments when a damaged tail was truncated on recovery.
+	if q.readSeg == nil || q.readSeq >= q.readSeg.end() {
+		i := slices.IndexFunc(q.segments, func(s *segment) bool { return s.end() > q.readSeq })
+		if i < 0 {
+			q.mu.Unlock()
+			return Entry{}, false, nil
+		}
+		q.readSeg = q.segments[i]
+		q.readSeq = max(q.readSeq, q.readSeg.first)
+		q.readPos = 0
+		q.reopen = true
+	}
+	seq, seg, pos, reopen := q.readSeq, q.readSeg, q.readPos, q.reopen
+	q.reopen = false
+	q.mu.Unlock()
+
+	// The frame at pos is complete: it was written before nextSeq moved
+	// past seq, so the file can be read without holding q.mu.
+	if reopen {
+		if err := q.openReader(seg.path, pos); err != nil {
+			return Entry{}, false, err
+		}
+	}
+	var data []byte
+	size, err := readFrame(q.reader, &data)
+	if err != nil {
+		return Entry{}, false, fmt.Errorf("read ingest queue entry %d: %w", seq, err)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.readSeq = seq + 1
+	q.readPos = pos + size
+	q.reading = append(q.reading, inflight{seq: seq, size: size})
+	e = Entry{Seq: seq, Data: data, Tag: q.tags[seq]}
+	delete(q.tags, seq)
+	return e, true, nil
+}
+
+// openReader points the read handle at offset pos of path. Caller holds q.readMu.
+func (q *Queue) openReader(path string, pos int64) error {
+	if q.readFile != nil {
+		_ = q.readFile.Close()
+		q.readFile = nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open ingest queue segment: %w", err)
+	}
+	if _, err := f.Seek(pos, io.SeekStart); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("seek ingest queue segment: %w", err)
+	}
+	q.readFile = f
+	q.reader = bufio.NewReaderSize(f, 64<<10)
+	return nil
+}
+
+// Release marks every read entry up to and including seq as processed,
+// freeing its space. Fully released segments are deleted.
+func (q *Queue) Release(seq uint64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return
+	}
+	n := 0
+	for _, r := range q.reading {
+		if r.seq > seq {
+			break
+		}
+		q.pending.Entries--
+		q.pending.Bytes -= r.size
+		q.released = r.seq
+		n++
+	}
+	if n == 0 {
+		return
+	}
+	q.reading = slices.Delete(q.reading, 0, n)
+
+	// Delete segments whose entries are all released, keeping the one
+	// being written.
+	for len(q.segments) > 1 && q.segments[0].end() <= q.released+1 {
+		_ = os.Remove(q.segments[0].path)
+		q.segments = q.segments[1:]
+	}
+	signal(q.freed)
+}
+
+// Stats returns the current depth of the queue.
+func (q *Queue) Stats() Stats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.pending
+}
+
+// Close flushes the queue and persists the release cursor. Unreleased
+// entries are returned by Next after the queue is opened again.
+func (q *Queue) Close() error {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return nil
+	}
+	q.closed = true
+	close(q.stop)
+	signal(q.appended)
+	signal(q.freed)
+	q.mu.Unlock()
+
+	q.flushWg.Wait()
+
+	q.readMu.Lock()
+	if q.readFile != nil {
+		_ = q.readFile.Close()
+		q.readFile = nil
+	}
+	q.readMu.Unlock()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	err := q.active.Sync()
+	if cerr := q.active.Close(); err == nil {
+		err = cerr
+	}
+	if cerr := q.writeCursor(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// flushLoop fsyncs under SyncInterval and persists the release cursor.
+func (q *Queue) flushLoop() {
+	interval := cursorInterval
+	if q.cfg.Sync == SyncInterval {
+		interval = min(interval, q.cfg.SyncInterval)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	lastCursor := time.Now()
+	for {
+		select {
+		case <-q.stop:
+			return
+		case now := <-ticker.C:
+			q.mu.Lock()
+			if q.cfg.Sync == SyncInterval && q.dirty {
+				if err := q.active.Sync(); err == nil {
+					q.dirty = false
+				}
+			}
+			if now.Sub(lastCursor) >= cursorInterval {
+				_ = q.writeCursor()
+				lastCursor = now
+			}
+			q.mu.Unlock()
+		}
+	}
+}
+
+// writeCursor persists the release cursor if it moved. Caller holds q.mu.
+func (q *Queue) writeCursor() error {
+	if q.released == q.saved {
+		return nil
+	}
+	var b [12]byte
+	binary.LittleEndian.PutUint64(b[0:8], q.released)
+	binary.LittleEndian.PutUint32(b[8:12], crc32.Checksum(b[0:8], crcTable))
+	path := filepath.Join(q.cfg.Dir, cursorName)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, b[:], 0o600); err != nil {
+		return fmt.Errorf("write ingest queue cursor: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("write ingest queue cursor: %w", err)
+	}
+	q.saved = q.released
+	return nil
+}
+
+// readCursor returns the persisted release cursor, or 0 if there is none
+// or it is damaged (everything on disk is then handed out again).
+func (q *Queue) readCursor() uint64 {
+	b, err := os.ReadFile(filepath.Join(q.cfg.Dir, cursorName))
+	if err != nil || len(b) != 12 {
+		return 0
+	}
+	if crc32.Checksum(b[0:8], crcTable) != binary.LittleEndian.Uint32(b[8:12]) {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(b[0:8])
+}
+
+func signal(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
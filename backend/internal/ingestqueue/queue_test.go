@@ -0,0 +1,280 @@
+package ingestqueue
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func openQueue(t *testing.T, dir string, maxBytes int64) *Queue {
+	t.Helper()
+	q, err := Open(Config{Dir: dir, MaxBytes: maxBytes, Sync: SyncAlways})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return q
+}
+
+func appendStrings(t *testing.T, q *Queue, values ...string) {
+	t.Helper()
+	entries := make([]Entry, len(values))
+	for i, v := range values {
+		entries[i] = Entry{Data: []byte(v), Tag: v}
+	}
+	if err := q.Append(t.Context(), entries...); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func next(t *testing.T, q *Queue) Entry {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+	e, err := q.Next(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func TestAppendNextRelease(t *testing.T) {
+	t.Parallel()
+	q := openQueue(t, t.TempDir(), 1<<20)
+	defer func() { _ = q.Close() }()
+
+	appendStrings(t, q, "a", "b", "c")
+	if st := q.Stats(); st.Entries != 3 || st.Bytes != 3*(frameHeaderSize+1) {
+		t.Errorf("stats = %+v", st)
+	}
+	for i, want := range []string{"a", "b", "c"} {
+		e := next(t, q)
+		if string(e.Data) != want || e.Tag != want || e.Seq != uint64(i+1) {
+			t.Errorf("entry %d = %+v, want %q", i, e, want)
+		}
+	}
+	if _, ok, err := q.TryNext(); ok || err != nil {
+		t.Errorf("TryNext on drained queue: ok=%v err=%v", ok, err)
+	}
+
+	q.Release(2)
+	if st := q.Stats(); st.Entries != 1 {
+		t.Errorf("after release, stats = %+v", st)
+	}
+	q.Release(3)
+	if st := q.Stats(); st.Entries != 0 || st.Bytes != 0 {
+		t.Errorf("after full release, stats = %+v", st)
+	}
+}
+
+func TestReopenReplaysUnreleased(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	q := openQueue(t, dir, 1<<20)
+	appendStrings(t, q, "1", "2", "3", "4", "5")
+	next(t, q)
+	next(t, q)
+	next(t, q)
+	q.Release(2)
+	if err := q.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	q = openQueue(t, dir, 1<<20)
+	defer func() { _ = q.Close() }()
+	if st := q.Stats(); st.Entries != 3 {
+		t.Errorf("recovered stats = %+v, want 3 entries", st)
+	}
+	appendStrings(t, q, "6")
+	for _, want := range []string{"3", "4", "5", "6"} {
+		e := next(t, q)
+		if string(e.Data) != want {
+			t.Fatalf("got %q, want %q", e.Data, want)
+		}
+		if want != "6" && e.Tag != nil {
+			t.Errorf("replayed entry %q has tag %v", want, e.Tag)
+		}
+	}
+}
+
+func TestRecoverTruncatesTornTail(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	q := openQueue(t, dir, 1<<20)
+	appendStrings(t, q, "first", "second")
+	if err := q.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	paths, _ := filepath.Glob(filepath.Join(dir, segmentPrefix+"*"))
+	var seg string
+	for _, p := range paths {
+		if fi, _ := os.Stat(p); fi.Size() > 0 {
+			seg = p
+		}
+	}
+	data, err := os.ReadFile(seg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Corrupt the last payload byte and add half a frame header.
+	data[len(data)-1] ^= 0xff
+	data = append(data, 9, 0, 0)
+	if err := os.WriteFile(seg, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	q = openQueue(t, dir, 1<<20)
+	defer func() { _ = q.Close() }()
+	appendStrings(t, q, "third")
+	for _, want := range []string{"first", "third"} {
+		if e := next(t, q); string(e.Data) != want {
+			t.Fatalf("got %q, want %q", e.Data, want)
+		}
+	}
+	if fi, _ := os.Stat(seg); fi.Size() != frameHeaderSize+int64(len("first")) {
+		t.Errorf("segment size %d, want the damaged tail truncated", fi.Size())
+	}
+}
+
+func TestAppendWaitsForSpace(t *testing.T) {
+	t.Parallel()
+	q := openQueue(t, t.TempDir(), 2*(frameHeaderSize+4))
+	defer func() { _ = q.Close() }()
+	appendStrings(t, q, "aaaa", "bbbb")
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+	if err := q.Append(ctx, Entry{Data: []byte("cccc")}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("append to full queue: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- q.Append(t.Context(), Entry{Data: []byte("dddd")}) }()
+	next(t, q)
+	q.Release(1)
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("append did not resume after release")
+	}
+	if e := next(t, q); string(e.Data) != "bbbb" {
+		t.Errorf("got %q", e.Data)
+	}
+	if e := next(t, q); string(e.Data) != "dddd" {
+		t.Errorf("got %q", e.Data)
+	}
+}
+
+func TestOversizedEntryAdmittedWhenEmpty(t *testing.T) {
+	t.Parallel()
+	q := openQueue(t, t.TempDir(), 16)
+	defer func() { _ = q.Close() }()
+	big := bytes.Repeat([]byte("x"), 100)
+	if err := q.Append(t.Context(), Entry{Data: big}); err != nil {
+		t.Fatal(err)
+	}
+	if e := next(t, q); !bytes.Equal(e.Data, big) {
+		t.Error("oversized entry corrupted")
+	}
+}
+
+func TestSegmentsRotateAndAreDeleted(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	q := openQueue(t, dir, 1<<20) // 128 KiB segments
+	defer func() { _ = q.Close() }()
+
+	payload := bytes.Repeat([]byte("y"), 10<<10)
+	const n = 30
+	for range n {
+		if err := q.Append(t.Context(), Entry{Data: payload}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	countSegments := func() int {
+		paths, _ := filepath.Glob(filepath.Join(dir, segmentPrefix+"*"))
+		return len(paths)
+	}
+	if got := countSegments(); got < 3 {
+		t.Fatalf("%d segments, want rotation", got)
+	}
+	var last uint64
+	for range n {
+		e := next(t, q)
+		if !bytes.Equal(e.Data, payload) {
+			t.Fatalf("entry %d corrupted", e.Seq)
+		}
+		last = e.Seq
+	}
+	q.Release(last)
+	if got := countSegments(); got != 1 {
+		t.Errorf("%d segments after releasing everything, want 1", got)
+	}
+}
+
+func TestNextStopsOnClose(t *testing.T) {
+	t.Parallel()
+	q := openQueue(t, t.TempDir(), 1<<20)
+	done := make(chan error, 1)
+	go func() {
+		_, err := q.Next(context.Background())
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	if err := q.Close(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-done:
+		if !errors.Is(err, ErrClosed) {
+			t.Errorf("Next after Close: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Next did not return after Close")
+	}
+	if err := q.Append(t.Context(), Entry{Data: []byte("x")}); !errors.Is(err, ErrClosed) {
+		t.Errorf("Append after Close: %v", err)
+	}
+}
+
+func TestSyncPolicies(t *testing.T) {
+	t.Parallel()
+	for _, p := range []SyncPolicy{SyncAlways, SyncInterval, SyncNone} {
+		t.Run(string(p), func(t *testing.T) {
+			t.Parallel()
+			dir := t.TempDir()
+			q, err := Open(Config{Dir: dir, MaxBytes: 1 << 20, Sync: p, SyncInterval: time.Millisecond})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := range 10 {
+				appendStrings(t, q, fmt.Sprint(i))
+			}
+			if err := q.Close(); err != nil {
+				t.Fatal(err)
+			}
+			q, err = Open(Config{Dir: dir, MaxBytes: 1 << 20, Sync: p})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer func() { _ = q.Close() }()
+			if st := q.Stats(); st.Entries != 10 {
+				t.Errorf("recovered %d entries, want 10", st.Entries)
+			}
+		})
+	}
+	if _, err := ParseSyncPolicy("sometimes"); err == nil {
+		t.Error("invalid policy: expected error")
+	}
+	if _, err := Open(Config{Dir: t.TempDir(), MaxBytes: 0}); err == nil {
+		t.Error("zero max bytes: expected error")
+	}
+}
@@ -48,17 +48,29 @@ func (o *Orchestrator) ingest(rec chunk.Record) (*pendingAcks, error) {
 	// Fire-and-forget remote replication happens OUTSIDE the orchestrator
 	// lock so a slow or paused follower cannot starve writers (retention,
 	// reconfig). See gastrolog-5oofa.
-	for _, remotes := range deferredRemotes {
-		o.fireAndForgetRemote(remotes, rec)
+	for _, d := range deferredRemotes {
+		o.fireAndForgetRemote(d.targets, d.rec)
 	}
 	return pa, err
 }
 
+// deferredRemote is a fire-and-forget follower fan-out collected under
+// o.mu and dispatched after it is released. rec is the record as written
+// to the local vault, i.e. after the matching route's pipeline ran.
+type deferredRemote struct {
+	targets []remoteForwardTarget
+	rec     chunk.Record
+}
+
 // ingestLocked is the mu-protected portion of ingest. It returns the
 // pendingAcks for ack-gated sync work and a list of remote-target sets
 // (one per local vault append) that the caller must dispatch via
 // fireAndForgetRemote AFTER releasing the lock.
-func (o *Orchestrator) ingestLocked(rec chunk.Record) (*pendingAcks, [][]remoteForwardTarget, error) {
+//
+// Each matched route's ingest pipeline runs once per record, before any
+// of the route's destinations are written. A route whose pipeline drops
+// the record skips all of its destinations; other routes are unaffected.
+func (o *Orchestrator) ingestLocked(rec chunk.Record) (*pendingAcks, []deferredRemote, error) {
 	o.mu.RLock()
 	defer o.mu.RUnlock()
 
@@ -80,21 +92,30 @@ func (o *Orchestrator) ingestLocked(rec chunk.Record) (*pendingAcks, [][]remoteF
 		return nil, nil, nil
 	}
 
+	piped := o.applyRoutePipelines(rec, matches)
+
 	// Write records first, then update stats only on success.
 	routed := false
 	var pa *pendingAcks
-	var deferredRemotes [][]remoteForwardTarget
+	var deferredRemotes []deferredRemote
 	for _, t := range matches {
+		out, override := rec, (*chunk.Record)(nil)
+		if pr := piped[t.RouteID]; pr != nil {
+			if !pr.keep {
+				continue
+			}
+			out, override = pr.rec, &pr.rec
+		}
 		if t.NodeID != "" {
 			var err error
-			pa, err = o.handleRemoteVaultMatch(pa, t, rec)
+			pa, err = o.handleRemoteVaultMatch(pa, t, out, override)
 			if err != nil {
 				return pa, deferredRemotes, err
 			}
 			routed = true
 			continue
 		}
-		task, remotes, err := o.appendLocal(t.VaultID, rec)
+		task, remotes, err := o.appendLocal(t.VaultID, out)
 		if err != nil {
 			if errors.Is(err, ErrVaultDisabled) {
 				continue // Skip disabled vaults during ingestion.
@@ -102,10 +123,11 @@ func (o *Orchestrator) ingestLocked(rec chunk.Record) (*pendingAcks, [][]remoteF
 			return pa, deferredRemotes, err
 		}
 		if task != nil {
+			task.rec = override
 			pa = pa.addReplication(*task)
 		}
 		if len(remotes) > 0 {
-			deferredRemotes = append(deferredRemotes, remotes)
+			deferredRemotes = append(deferredRemotes, deferredRemote{targets: remotes, rec: out})
 		}
 		vs := o.getOrCreateVaultRouteStats(t.VaultID)
 		vs.Matched.Add(1)
@@ -121,17 +143,52 @@ func (o *Orchestrator) ingestLocked(rec chunk.Record) (*pendingAcks, [][]remoteF
 	return pa, deferredRemotes, nil
 }
 
+// pipelineResult is one route's ingest pipeline output for a record.
+type pipelineResult struct {
+	rec  chunk.Record
+	keep bool
+}
+
+// applyRoutePipelines runs the ingest pipeline of every matched route that
+// has one, once per route even when the route matched several vaults.
+// Routes without a pipeline have no entry. Caller holds o.mu.RLock.
+func (o *Orchestrator) applyRoutePipelines(rec chunk.Record, matches []MatchResult) map[glid.GLID]*pipelineResult {
+	if len(o.routePipelines) == 0 {
+		return nil
+	}
+	var out map[glid.GLID]*pipelineResult
+	for _, t := range matches {
+		pl := o.routePipelines[t.RouteID]
+		if pl == nil {
+			continue
+		}
+		if _, done := out[t.RouteID]; done {
+			continue
+		}
+		r, keep := pl.Apply(rec)
+		if out == nil {
+			out = make(map[glid.GLID]*pipelineResult)
+		}
+		out[t.RouteID] = &pipelineResult{rec: r, keep: keep}
+		if !keep {
+			o.getOrCreatePerRouteStats(t.RouteID).Dropped.Add(1)
+		}
+	}
+	return out
+}
+
 // handleRemoteVaultMatch updates route stats and queues cross-node forwarding
 // for a filter match whose vault lives on another node. Caller holds o.mu.RLock.
-func (o *Orchestrator) handleRemoteVaultMatch(pa *pendingAcks, t MatchResult, rec chunk.Record) (*pendingAcks, error) {
+// override is the route pipeline's output, or nil when the route has none.
+func (o *Orchestrator) handleRemoteVaultMatch(pa *pendingAcks, t MatchResult, rec chunk.Record, override *chunk.Record) (*pendingAcks, error) {
 	if rec.WaitForReplica {
-		pa = pa.addForward(forwardTask{nodeID: t.NodeID, vaultID: t.VaultID})
+		pa = pa.addForward(forwardTask{nodeID: t.NodeID, vaultID: t.VaultID, rec: override})
 	} else {
 		if o.forwarder == nil {
 			o.routeStats.Dropped.Add(1)
 			return pa, errors.New("remote vault route requires record forwarder")
 		}
-		pa = pa.addSyncForward(forwardTask{nodeID: t.NodeID, vaultID: t.VaultID})
+		pa = pa.addSyncForward(forwardTask{nodeID: t.NodeID, vaultID: t.VaultID, rec: override})
 	}
 	vs := o.getOrCreateVaultRouteStats(t.VaultID)
 	vs.Matched.Add(1)
@@ -192,6 +249,16 @@ func (p *pendingAcks) isEmpty() bool {
 type forwardTask struct {
 	nodeID  string
 	vaultID glid.GLID
+	rec     *chunk.Record // route pipeline output; nil = the ingested record
+}
+
+// record returns the record to forward: the route pipeline's output when
+// there is one, otherwise the ingested record.
+func (f forwardTask) record(ingested chunk.Record) chunk.Record {
+	if f.rec != nil {
+		return *f.rec
+	}
+	return ingested
 }
 
 // getOrCreateVaultRouteStats returns the per-vault route stats, creating if needed.
@@ -240,7 +307,7 @@ func (o *Orchestrator) flushRecordRouteForwards(ctx context.Context, pa *pending
 		return nil
 	}
 	for _, f := range pa.syncForwards {
-		if err := o.forwarder.ForwardSync(ctx, f.nodeID, f.vaultID, []chunk.Record{rec}); err != nil {
+		if err := o.forwarder.ForwardSync(ctx, f.nodeID, f.vaultID, []chunk.Record{f.record(rec)}); err != nil {
 			return fmt.Errorf("forward to %s: %w", f.nodeID, err)
 		}
 	}
@@ -0,0 +1,250 @@
+package orchestrator
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"gastrolog/internal/alert"
+	"gastrolog/internal/ingestqueue"
+)
+
+// spoolBatchSize caps how many messages the spool loop writes per Append.
+// Batching amortizes the write syscall and, under SyncAlways, the fsync.
+const spoolBatchSize = 256
+
+// spoolLoop moves messages from ingestCh into the disk queue. It exits once
+// ingestCh is closed and drained. While the queue is full it stops reading,
+// so ingestCh fills and the pressure gate throttles ingesters as it would
+// without a queue.
+func (o *Orchestrator) spoolLoop(q *ingestqueue.Queue) {
+	batch := make([]ingestqueue.Entry, 0, spoolBatchSize)
+	for msg := range o.ingestCh {
+		batch = append(batch[:0], queueEntry(msg))
+	fill:
+		for len(batch) < spoolBatchSize {
+			select {
+			case msg, ok := <-o.ingestCh:
+				if !ok {
+					break fill
+				}
+				batch = append(batch, queueEntry(msg))
+			default:
+				break fill
+			}
+		}
+		o.spool(q, batch)
+	}
+}
+
+// spool appends one batch, failing the acks of any messages that could not
+// be written. A write error is surfaced as an alert rather than retried:
+// retrying a failing disk would stall every ingester on the node.
+func (o *Orchestrator) spool(q *ingestqueue.Queue, batch []ingestqueue.Entry) {
+	err := q.Append(context.Background(), batch...)
+	if err == nil {
+		if o.spoolFailed {
+			o.spoolFailed = false
+			if o.alerts != nil {
+				o.alerts.Clear("ingest-queue")
+			}
+		}
+		return
+	}
+	// Entries written before the error have sequence numbers; the rest
+	// were dropped.
+	dropped := 0
+	for _, e := range batch {
+		if e.Seq != 0 {
+			continue
+		}
+		dropped++
+		if ack, ok := e.Tag.(chan<- error); ok {
+			ack <- fmt.Errorf("ingest queue: %w", err)
+		}
+	}
+	o.logger.Error("ingest queue write failed, messages dropped", "dropped", dropped, "error", err)
+	if !o.spoolFailed && o.alerts != nil {
+		o.alerts.Set("ingest-queue", alert.Error, "orchestrator",
+			fmt.Sprintf("Ingest queue write failed, messages are being dropped: %v", err))
+	}
+	o.spoolFailed = true
+}
+
+// digestQueueLoop is the digestLoop used when the disk queue is enabled.
+// Entries recovered from a previous run come first. On cancellation it
+// waits for the spool loop to finish and drains the queue, so a clean
+// shutdown leaves nothing behind; after a crash, whatever was queued is
+// replayed on the next start.
+func (o *Orchestrator) digestQueueLoop(ctx context.Context, q *ingestqueue.Queue, spoolDone <-chan struct{}) {
+	for {
+		e, err := q.Next(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				o.logger.Error("ingest queue read failed", "error", err)
+				return
+			}
+			break
+		}
+		o.digestQueued(q, e)
+	}
+
+	<-spoolDone
+	for {
+		e, ok, err := q.TryNext()
+		if err != nil {
+			o.logger.Error("ingest queue read failed", "error", err)
+			return
+		}
+		if !ok {
+			return
+		}
+		o.digestQueued(q, e)
+	}
+}
+
+// digestQueued decodes a queue entry and hands it to digestAndForward.
+// Undecodable entries are released and skipped.
+func (o *Orchestrator) digestQueued(q *ingestqueue.Queue, e ingestqueue.Entry) {
+	msg, err := decodeIngestMessage(e.Data)
+	if err != nil {
+		o.logger.Error("dropping corrupt ingest queue entry", "seq", e.Seq, "error", err)
+		q.Release(e.Seq)
+		return
+	}
+	if ack, ok := e.Tag.(chan<- error); ok {
+		msg.Ack = ack
+	}
+	o.digestAndForward(msg, e.Seq)
+}
+
+// IngestDiskQueueStats reports the depth of the disk-backed ingest queue.
+// All values are zero when the queue is disabled or the orchestrator is
+// not running.
+func (o *Orchestrator) IngestDiskQueueStats() (entries, bytes, maxBytes int64) {
+	q := o.ingestQueue.Load()
+	if q == nil {
+		return 0, 0, 0
+	}
+	st := q.Stats()
+	return st.Entries, st.Bytes, st.MaxBytes
+}
+
+// queueEntry wraps a message for the disk queue. The ack channel can't be
+// persisted, so it travels in memory as the entry's tag.
+func queueEntry(msg IngestMessage) ingestqueue.Entry {
+	e := ingestqueue.Entry{Data: encodeIngestMessage(msg)}
+	if msg.Ack != nil {
+		e.Tag = msg.Ack
+	}
+	return e
+}
+
+// encodeIngestMessage serializes everything in msg except Ack. Layout:
+// SourceTS, IngestTS (length-prefixed time.Time binary), IngesterID, Raw,
+// then the attribute count and key/value pairs, all lengths as uvarints.
+func encodeIngestMessage(msg IngestMessage) []byte {
+	size := 64 + len(msg.IngesterID) + len(msg.Raw)
+	for k, v := range msg.Attrs {
+		size += 4 + len(k) + len(v)
+	}
+	b := make([]byte, 0, size)
+	b = appendTime(b, msg.SourceTS)
+	b = appendTime(b, msg.IngestTS)
+	b = appendBytes(b, []byte(msg.IngesterID))
+	b = appendBytes(b, msg.Raw)
+	b = binary.AppendUvarint(b, uint64(len(msg.Attrs)))
+	for k, v := range msg.Attrs {
+		b = appendBytes(b, []byte(k))
+		b = appendBytes(b, []byte(v))
+	}
+	return b
+}
+
+func appendTime(b []byte, t time.Time) []byte {
+	tb, err := t.MarshalBinary()
+	if err != nil {
+		// Only zone offsets that aren't whole minutes fail; keep the instant.
+		tb, _ = t.UTC().MarshalBinary()
+	}
+	return appendBytes(b, tb)
+}
+
+func appendBytes(b, v []byte) []byte {
+	return append(binary.AppendUvarint(b, uint64(len(v))), v...)
+}
+
+var errCorruptEntry = errors.New("corrupt ingest queue entry")
+
+// decodeIngestMessage reverses encodeIngestMessage.
+func decodeIngestMessage(b []byte) (IngestMessage, error) {
+	d := entryDecoder{b: b}
+	var msg IngestMessage
+	msg.SourceTS = d.time()
+	msg.IngestTS = d.time()
+	msg.IngesterID = string(d.bytes())
+	if raw := d.bytes(); len(raw) > 0 {
+		msg.Raw = raw
+	}
+	n := d.uvarint()
+	if d.err == nil && n > uint64(len(d.b)) {
+		d.err = errCorruptEntry // each attribute takes at least two bytes
+	}
+	if n > 0 && d.err == nil {
+		msg.Attrs = make(map[string]string, n)
+		for range n {
+			k := string(d.bytes())
+			msg.Attrs[k] = string(d.bytes())
+		}
+	}
+	if d.err == nil && len(d.b) > 0 {
+		d.err = errCorruptEntry
+	}
+	return msg, d.err
+}
+
+// entryDecoder reads the fields of an encoded IngestMessage, recording the
+// first error and returning zero values after it.
+type entryDecoder struct {
+	b   []byte
+	err error
+}
+
+func (d *entryDecoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.b)
+	if n <= 0 {
+		d.err = errCorruptEntry
+		return 0
+	}
+	d.b = d.b[n:]
+	return v
+}
+
+func (d *entryDecoder) bytes() []byte {
+	n := d.uvarint()
+	if d.err != nil {
+		return nil
+	}
+	if n > uint64(len(d.b)) {
+		d.err = errCorruptEntry
+		return nil
+	}
+	v := d.b[:n:n]
+	d.b = d.b[n:]
+	return v
+}
+
+func (d *entryDecoder) time() time.Time {
+	var t time.Time
+	if b := d.bytes(); d.err == nil {
+		if err := t.UnmarshalBinary(b); err != nil {
+			d.err = fmt.Errorf("%w: %w", errCorruptEntry, err)
+		}
+	}
+	return t
+}
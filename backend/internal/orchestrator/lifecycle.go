@@ -10,6 +10,7 @@ import (
 	"gastrolog/internal/alert"
 	"gastrolog/internal/chanwatch"
 	"gastrolog/internal/chunk"
+	"gastrolog/internal/ingestqueue"
 )
 
 // digestedRecord is the intermediate type passed from digestLoop to writeLoop.
@@ -17,7 +18,8 @@ type digestedRecord struct {
 	rec        chunk.Record
 	ack        chan<- error
 	ingesterID string
-	rawLen     int // original message raw length for stats
+	rawLen     int    // original message raw length for stats
+	queueSeq   uint64 // disk queue entry to release once written; 0 without a queue
 }
 
 // Start launches all ingesters and the digest/write pipeline.
@@ -33,6 +35,22 @@ func (o *Orchestrator) Start(ctx context.Context) error {
 		return ErrAlreadyRunning
 	}
 
+	// Open the disk-backed ingest queue first so that a bad queue directory
+	// fails Start before anything is running. Entries left by a crash are
+	// digested ahead of new messages.
+	var queue *ingestqueue.Queue
+	if o.ingestQueueCfg.Dir != "" {
+		q, err := ingestqueue.Open(o.ingestQueueCfg)
+		if err != nil {
+			return fmt.Errorf("open ingest queue: %w", err)
+		}
+		if st := q.Stats(); st.Entries > 0 {
+			o.logger.Info("replaying ingest queue", "entries", st.Entries, "bytes", st.Bytes)
+		}
+		queue = q
+		o.ingestQueue.Store(q)
+	}
+
 	// Create cancellable context for all ingesters and digest loop.
 	ctx, cancel := context.WithCancel(ctx)
 	o.cancel = cancel
@@ -62,6 +80,10 @@ func (o *Orchestrator) Start(ctx context.Context) error {
 	digestedProbe := func() (int, int) {
 		return len(o.digestedCh), cap(o.digestedCh)
 	}
+	queueProbe := func() (int, int) {
+		st := queue.Stats()
+		return int(st.Bytes), int(st.MaxBytes)
+	}
 
 	// Pressure gate: ingesters consult this to throttle when the pipeline
 	// is backed up. Hysteresis thresholds: elevated at 80%, critical at 95%,
@@ -71,6 +93,9 @@ func (o *Orchestrator) Start(ctx context.Context) error {
 	gate := chanwatch.NewPressureGate(chanwatch.DefaultThresholds())
 	gate.AddProbe("ingestCh", ingestProbe)
 	gate.AddProbe("digestedCh", digestedProbe)
+	if queue != nil {
+		gate.AddProbe("ingestQueue", queueProbe)
+	}
 	if ac, ok := o.alerts.(*alert.Collector); ok {
 		gate.AddOnChange(func(tr chanwatch.PressureTransition) {
 			if tr.To == chanwatch.PressureNormal {
@@ -114,8 +139,19 @@ func (o *Orchestrator) Start(ctx context.Context) error {
 		o.ingesterWg.Go(func() { o.runIngester(id, r, recvCtx, o.ingestCh) })
 	}
 
-	// Launch digest + write pipeline.
-	o.digestWg.Go(func() { o.digestLoop(ctx) })
+	// Launch digest + write pipeline. With a disk queue, a spool loop sits
+	// between ingestCh and the digest loop so that a stalled write loop
+	// backs up onto disk instead of onto the ingesters.
+	if queue != nil {
+		spoolDone := make(chan struct{})
+		o.spoolWg.Go(func() {
+			defer close(spoolDone)
+			o.spoolLoop(queue)
+		})
+		o.digestWg.Go(func() { o.digestQueueLoop(ctx, queue, spoolDone) })
+	} else {
+		o.digestWg.Go(func() { o.digestLoop(ctx) })
+	}
 	o.writeWg.Go(func() { o.writeLoop() })
 
 	// Channel pressure watchdog — kept for the slog-based alerts at 90%
@@ -126,6 +162,9 @@ func (o *Orchestrator) Start(ctx context.Context) error {
 	}
 	cw.Watch("ingestCh", ingestProbe, 0.9)
 	cw.Watch("digestedCh", digestedProbe, 0.9)
+	if queue != nil {
+		cw.Watch("ingestQueue", queueProbe, 0.9)
+	}
 	o.auxWg.Go(func() { cw.Run(ctx) })
 
 	// Start the pressure gate after everything else is wired.
@@ -178,8 +217,10 @@ func (o *Orchestrator) runRateAlertEvaluator(ctx context.Context, interval time.
 //     fireAndForgetRemote / sealRemoteFollowers so the drain pipeline
 //     doesn't spam peers that are going down alongside us.
 //  1. Cancel ingester contexts → ingesterWg.Wait() → close ingestCh
+//     → spoolWg.Wait() (disk queue only: spools remaining messages)
 //  2. digestWg.Wait() (drains remaining messages) → close digestedCh
 //  3. writeWg.Wait() (drains remaining records) → close done
+//     → close the disk queue
 func (o *Orchestrator) Stop() error {
 	o.mu.Lock()
 	if !o.running {
@@ -213,6 +254,7 @@ func (o *Orchestrator) Stop() error {
 	// Stage 1: Wait for ingesters to exit, then close the ingest channel.
 	o.ingesterWg.Wait()
 	close(ingestCh)
+	o.spoolWg.Wait()
 
 	// Stage 2: Wait for digest loop to drain, then close the intermediate channel.
 	o.digestWg.Wait()
@@ -220,6 +262,11 @@ func (o *Orchestrator) Stop() error {
 
 	// Stage 3: Wait for write loop to drain remaining records.
 	o.writeWg.Wait()
+	if q := o.ingestQueue.Swap(nil); q != nil {
+		if err := q.Close(); err != nil {
+			o.logger.Error("close ingest queue", "error", err)
+		}
+	}
 
 	// Stage 4: Wait for in-flight ack-gated replication goroutines.
 	o.ackWg.Wait()
@@ -351,20 +398,21 @@ func (o *Orchestrator) digestLoop(ctx context.Context) {
 			// Context cancelled — drain remaining messages.
 			// ingestCh will be closed after ingesters exit.
 			for msg := range o.ingestCh {
-				o.digestAndForward(msg)
+				o.digestAndForward(msg, 0)
 			}
 			return
 		case msg, ok := <-o.ingestCh:
 			if !ok {
 				return
 			}
-			o.digestAndForward(msg)
+			o.digestAndForward(msg, 0)
 		}
 	}
 }
 
 // digestAndForward digests a single message and sends the result to digestedCh.
-func (o *Orchestrator) digestAndForward(msg IngestMessage) {
+// queueSeq is the message's disk queue entry, or 0 without a queue.
+func (o *Orchestrator) digestAndForward(msg IngestMessage, queueSeq uint64) {
 	// Apply digester pipeline (enriches attrs based on message content).
 	// NodeID lives on EventID as a first-class field (gastrolog-1k3l9);
 	// the orchestrator no longer stamps it as an attribute.
@@ -405,6 +453,7 @@ func (o *Orchestrator) digestAndForward(msg IngestMessage) {
 		ack:        msg.Ack,
 		ingesterID: msg.IngesterID,
 		rawLen:     len(msg.Raw),
+		queueSeq:   queueSeq,
 	}
 }
 
@@ -429,6 +478,14 @@ func (o *Orchestrator) writeLoop() {
 		// Track per-ingester stats.
 		o.trackWriteStats(dr, err)
 
+		// The record has been handed to its vaults (or failed for good);
+		// its disk queue entry is no longer needed for recovery.
+		if dr.queueSeq != 0 {
+			if q := o.ingestQueue.Load(); q != nil {
+				q.Release(dr.queueSeq)
+			}
+		}
+
 		// Send ack if requested.
 		if dr.ack != nil {
 			if err != nil || pa.isEmpty() {
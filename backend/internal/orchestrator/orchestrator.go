@@ -17,6 +17,8 @@ import (
 	"gastrolog/internal/chanwatch"
 	"gastrolog/internal/chunk"
 	"gastrolog/internal/cluster"
+	"gastrolog/internal/ingestpipeline"
+	"gastrolog/internal/ingestqueue"
 	"gastrolog/internal/lifecycle"
 	"gastrolog/internal/logging"
 	"gastrolog/internal/notify"
@@ -51,6 +53,7 @@ type VaultRouteStats struct {
 type PerRouteStats struct {
 	Matched   atomic.Int64 // records matched by this route
 	Forwarded atomic.Int64 // records forwarded to remote node by this route
+	Dropped   atomic.Int64 // records discarded by this route's pipeline
 }
 
 // ingesterInfo holds metadata about an ingester for logging purposes.
@@ -214,6 +217,10 @@ type Orchestrator struct {
 	// Vault filters.
 	filterSet *FilterSet
 
+	// Route ingest pipelines, keyed by route ID. Routes without stages
+	// have no entry. Rebuilt with filterSet under o.mu.
+	routePipelines map[glid.GLID]*ingestpipeline.Pipeline
+
 	// Route stats (atomic, no lock needed for reads/writes).
 	routeStats      RouteStats
 	vaultRouteStats sync.Map // glid.GLID → *VaultRouteStats
@@ -255,6 +262,12 @@ type Orchestrator struct {
 	ackWg        sync.WaitGroup // tracks in-flight ack-gated replication goroutines
 	auxWg        sync.WaitGroup // tracks auxiliary goroutines (watchdog, etc.)
 
+	// Disk-backed ingest queue (optional). The queue is open while running.
+	ingestQueueCfg ingestqueue.Config
+	ingestQueue    atomic.Pointer[ingestqueue.Queue]
+	spoolWg        sync.WaitGroup // tracks the spool goroutine
+	spoolFailed    bool           // last spool write failed; owned by the spool goroutine
+
 	// Per-tier import mutex for serializing SetNextChunkID + ImportRecords.
 	importMu sync.Map // tierID → *sync.Mutex
 
@@ -424,6 +437,11 @@ type Config struct {
 	// Defaults to 1000 if not set.
 	IngestChannelSize int
 
+	// IngestQueue enables the disk-backed ingest queue when Dir is set.
+	// Messages are persisted before digesting and replayed on the next
+	// Start if the process dies before they reach a vault.
+	IngestQueue ingestqueue.Config
+
 	// MaxConcurrentJobs limits how many scheduler jobs (index builds,
 	// cron rotation, retention) can run in parallel. Defaults to 4.
 	MaxConcurrentJobs int
@@ -502,6 +520,7 @@ func New(cfg Config) (*Orchestrator, error) {
 		scheduler:            sched,
 		cronRotation:         newCronRotationManager(sched, logger),
 		ingestSize:           cfg.IngestChannelSize,
+		ingestQueueCfg:       cfg.IngestQueue,
 		sysLoader:            cfg.SystemLoader,
 		localNodeID:          cfg.LocalNodeID,
 		localNodeIDGLID:      parseNodeGLID(cfg.LocalNodeID),
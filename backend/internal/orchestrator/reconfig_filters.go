@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"gastrolog/internal/glid"
 
+	"gastrolog/internal/ingestpipeline"
 	"gastrolog/internal/system"
 )
 
@@ -78,6 +79,7 @@ func (o *Orchestrator) ReloadFilters(ctx context.Context) error {
 // For each enabled route, resolves the filter expression and compiles a
 // CompiledFilter for each destination vault. If multiple routes target
 // the same vault, the last route's filter wins (AddOrUpdate replaces).
+// Route ingest pipelines are compiled alongside and swapped in together.
 func (o *Orchestrator) reloadFiltersFromRoutes(sys *system.System) error {
 	if sys == nil {
 		return nil
@@ -85,6 +87,7 @@ func (o *Orchestrator) reloadFiltersFromRoutes(sys *system.System) error {
 	cfg := &sys.Config
 
 	var fs *FilterSet
+	pipelines := make(map[glid.GLID]*ingestpipeline.Pipeline)
 	for _, route := range cfg.Routes {
 		if !route.Enabled {
 			continue
@@ -93,6 +96,14 @@ func (o *Orchestrator) reloadFiltersFromRoutes(sys *system.System) error {
 			continue // eject-only routes excluded from live FilterSet
 		}
 
+		pl, err := ingestpipeline.Compile(route.Stages)
+		if err != nil {
+			return fmt.Errorf("invalid pipeline for route %s: %w", route.ID, err)
+		}
+		if pl != nil {
+			pipelines[route.ID] = pl
+		}
+
 		var filterExpr string
 		if route.FilterID != nil {
 			filterExpr = resolveFilterExpr(cfg, *route.FilterID)
@@ -141,6 +152,7 @@ func (o *Orchestrator) reloadFiltersFromRoutes(sys *system.System) error {
 		newCount = len(fs.filters)
 	}
 	o.filterSet = fs
+	o.routePipelines = pipelines
 	if fs == nil && oldCount > 0 {
 		o.logger.Warn("no route filters compiled, ingested records will be dropped")
 	} else if newCount != oldCount {
@@ -80,7 +80,7 @@ func (o *Orchestrator) ackAfterReplication(ack chan<- error, pa *pendingAcks, re
 		for _, t := range pa.replication {
 			for _, tgt := range t.targets {
 				g.Go(func() error {
-					if err := o.chunkReplicator.AppendRecords(ctx, tgt.NodeID, t.vaultID, t.tierID, t.chunkID, []chunk.Record{rec}); err != nil {
+					if err := o.chunkReplicator.AppendRecords(ctx, tgt.NodeID, t.vaultID, t.tierID, t.chunkID, []chunk.Record{t.record(rec)}); err != nil {
 						return fmt.Errorf("ack-gated replication to %s: %w", tgt.NodeID, err)
 					}
 					return nil
@@ -92,7 +92,7 @@ func (o *Orchestrator) ackAfterReplication(ack chan<- error, pa *pendingAcks, re
 	if o.forwarder != nil {
 		for _, f := range pa.forwards {
 			g.Go(func() error {
-				if err := o.forwarder.ForwardSync(ctx, f.nodeID, f.vaultID, []chunk.Record{rec}); err != nil {
+				if err := o.forwarder.ForwardSync(ctx, f.nodeID, f.vaultID, []chunk.Record{f.record(rec)}); err != nil {
 					return fmt.Errorf("ack-gated forward to %s: %w", f.nodeID, err)
 				}
 				return nil
@@ -0,0 +1,180 @@
+package orchestrator
+
+import (
+	"context"
+	"gastrolog/internal/glid"
+	"strings"
+	"testing"
+	"time"
+
+	"gastrolog/internal/chunk"
+	"gastrolog/internal/ingestpipeline"
+	"gastrolog/internal/system"
+)
+
+func routePipelineStages() []system.RouteStage {
+	return []system.RouteStage{
+		{Type: system.RouteStageRegex, Params: map[string]string{"pattern": `user=(?P<user>\w+)`}},
+		{Type: system.RouteStageHash, Params: map[string]string{"keys": "user"}},
+		{Type: system.RouteStageMask, Params: map[string]string{"pattern": `user=\w+`, "replacement": "user=***"}},
+		{Type: system.RouteStageDrop, Params: map[string]string{"expression": "healthcheck"}},
+	}
+}
+
+// TestRoutePipelineAppliedPerRoute verifies that a route's pipeline
+// transforms records written to its destinations only, and that records
+// dropped by the pipeline skip the route without affecting other routes.
+func TestRoutePipelineAppliedPerRoute(t *testing.T) {
+	t.Parallel()
+
+	pipedVault, plainVault := glid.New(), glid.New()
+	pipedRoute, plainRoute := glid.New(), glid.New()
+	filterID := glid.New()
+
+	o := newTestOrch(t, Config{LocalNodeID: "node-A"})
+	pipedCM := newMemoryTierInstance(t, glid.New()).Chunks
+	plainCM := newMemoryTierInstance(t, glid.New()).Chunks
+	for id, cm := range map[glid.GLID]chunk.ChunkManager{pipedVault: pipedCM, plainVault: plainCM} {
+		v := NewVaultFromComponents(id, cm, nil, nil)
+		v.Enabled = true
+		o.vaults[id] = v
+	}
+
+	sys := &system.System{Config: system.Config{
+		Filters: []system.FilterConfig{{ID: filterID, Name: "all", Expression: "*"}},
+		Routes: []system.RouteConfig{
+			{ID: pipedRoute, Enabled: true, FilterID: &filterID, Destinations: []glid.GLID{pipedVault}, Stages: routePipelineStages()},
+			{ID: plainRoute, Enabled: true, FilterID: &filterID, Destinations: []glid.GLID{plainVault}},
+		},
+		Vaults: []system.VaultConfig{{ID: pipedVault}, {ID: plainVault}},
+	}}
+	if err := o.reloadFiltersFromRoutes(sys); err != nil {
+		t.Fatalf("reloadFiltersFromRoutes: %v", err)
+	}
+	if len(o.routePipelines) != 1 || o.routePipelines[pipedRoute] == nil {
+		t.Fatalf("expected one compiled pipeline for the piped route, got %v", o.routePipelines)
+	}
+
+	in := makeRecord("login user=alice ok")
+	if err := o.Ingest(in); err != nil {
+		t.Fatalf("Ingest: %v", err)
+	}
+	if err := o.Ingest(makeRecord("healthcheck ok")); err != nil {
+		t.Fatalf("Ingest: %v", err)
+	}
+
+	piped := readAllRecords(t, pipedCM)
+	if len(piped) != 1 {
+		t.Fatalf("piped vault: expected 1 record (healthcheck dropped), got %d", len(piped))
+	}
+	if got := string(piped[0].Raw); got != "login user=*** ok" {
+		t.Errorf("piped raw = %q", got)
+	}
+	if u := piped[0].Attrs["user"]; len(u) != 64 {
+		t.Errorf("piped user attr should be hashed, got %q", u)
+	}
+
+	plain := readAllRecords(t, plainCM)
+	if len(plain) != 2 {
+		t.Fatalf("plain vault: expected 2 records, got %d", len(plain))
+	}
+	for _, r := range plain {
+		if _, ok := r.Attrs["user"]; ok || strings.Contains(string(r.Raw), "***") {
+			t.Errorf("plain vault record was transformed: %q %v", r.Raw, r.Attrs)
+		}
+	}
+	if string(in.Raw) != "login user=alice ok" || len(in.Attrs) != 1 {
+		t.Errorf("ingested record mutated: %q %v", in.Raw, in.Attrs)
+	}
+
+	stats := o.PerRouteStatsList()
+	if got := stats[pipedRoute].Dropped.Load(); got != 1 {
+		t.Errorf("piped route dropped = %d, want 1", got)
+	}
+	if got := stats[pipedRoute].Matched.Load(); got != 1 {
+		t.Errorf("piped route matched = %d, want 1", got)
+	}
+	if got := stats[plainRoute].Matched.Load(); got != 2 {
+		t.Errorf("plain route matched = %d, want 2", got)
+	}
+}
+
+// TestRoutePipelineInvalidStagesRejected verifies that a route with a bad
+// stage fails the reload instead of silently ingesting untransformed data.
+func TestRoutePipelineInvalidStagesRejected(t *testing.T) {
+	t.Parallel()
+
+	vaultID := glid.New()
+	o := newTestOrch(t, Config{LocalNodeID: "node-A"})
+	v := NewVaultFromComponents(vaultID, newMemoryTierInstance(t, glid.New()).Chunks, nil, nil)
+	v.Enabled = true
+	o.vaults[vaultID] = v
+
+	sys := &system.System{Config: system.Config{
+		Routes: []system.RouteConfig{{
+			ID:           glid.New(),
+			Enabled:      true,
+			Destinations: []glid.GLID{vaultID},
+			Stages:       []system.RouteStage{{Type: system.RouteStageRegex, Params: map[string]string{"pattern": "("}}},
+		}},
+	}}
+	err := o.reloadFiltersFromRoutes(sys)
+	if err == nil || !strings.Contains(err.Error(), "invalid pipeline") {
+		t.Fatalf("expected invalid pipeline error, got %v", err)
+	}
+}
+
+// TestRoutePipelineOutputForwarded verifies that cross-node forwards carry
+// the pipeline's output, on both the ack-gated and the non-ack path.
+func TestRoutePipelineOutputForwarded(t *testing.T) {
+	t.Parallel()
+
+	for _, ackGated := range []bool{false, true} {
+		remoteVaultID := glid.New()
+		routeID := glid.New()
+
+		fwd := &mockForwarder{}
+		o := newTestOrch(t, Config{LocalNodeID: "node-A"})
+		o.SetRecordForwarder(fwd)
+
+		remoteFilter, _ := CompileFilter(remoteVaultID, "*")
+		remoteFilter.NodeID = "node-B"
+		remoteFilter.RouteID = routeID
+		o.SetFilterSet(NewFilterSet([]*CompiledFilter{remoteFilter}))
+		pl, err := ingestpipeline.Compile(routePipelineStages())
+		if err != nil {
+			t.Fatal(err)
+		}
+		o.routePipelines = map[glid.GLID]*ingestpipeline.Pipeline{routeID: pl}
+
+		rec := makeRecord("login user=bob ok")
+		rec.WaitForReplica = ackGated
+
+		pa, err := o.ingest(rec)
+		if err != nil {
+			t.Fatalf("ingest: %v", err)
+		}
+		if ackGated {
+			ack := make(chan error, 1)
+			o.ackAfterReplication(ack, pa, rec)
+			select {
+			case err := <-ack:
+				if err != nil {
+					t.Fatalf("ack: %v", err)
+				}
+			case <-time.After(2 * time.Second):
+				t.Fatal("ack did not fire")
+			}
+		} else if err := o.flushRecordRouteForwards(context.Background(), pa, rec); err != nil {
+			t.Fatalf("flush: %v", err)
+		}
+
+		calls := fwd.getCalls()
+		if len(calls) != 1 || len(calls[0].Records) != 1 {
+			t.Fatalf("ackGated=%v: expected 1 forwarded record, got %+v", ackGated, calls)
+		}
+		if got := string(calls[0].Records[0].Raw); got != "login user=*** ok" {
+			t.Errorf("ackGated=%v: forwarded raw = %q, want pipeline output", ackGated, got)
+		}
+	}
+}
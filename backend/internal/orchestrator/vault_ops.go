@@ -859,6 +859,16 @@ type replicationTask struct {
 	tierID  glid.GLID
 	chunkID chunk.ChunkID
 	targets []system.ReplicationTarget
+	rec     *chunk.Record // route pipeline output; nil = the ingested record
+}
+
+// record returns the record to replicate: the route pipeline's output when
+// there is one, otherwise the ingested record.
+func (t replicationTask) record(ingested chunk.Record) chunk.Record {
+	if t.rec != nil {
+		return *t.rec
+	}
+	return ingested
 }
 
 // appendRecord is the unified append-with-seal-detection path.
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"gastrolog/internal/glid"
 	"maps"
+	"math"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
@@ -144,12 +146,8 @@ func NewAggregator(stats *querylang.StatsOp) (*Aggregator, error) {
 
 	// Validate aggregate functions.
 	for _, agg := range stats.Aggs {
-		switch strings.ToLower(agg.Func) {
-		case "count", "sum", "avg", "min", "max",
-			"dcount", "median", "first", "last", "values":
-			// OK
-		default:
-			return nil, fmt.Errorf("unknown aggregate function: %s", agg.Func)
+		if _, err := newAccumulator(agg); err != nil {
+			return nil, err
 		}
 	}
 
@@ -163,65 +161,102 @@ func (a *Aggregator) Add(rec chunk.Record) error {
 	}
 
 	row := RecordToRow(rec)
+	groupValues, ok := a.groupValues(rec, row)
+	if !ok {
+		return nil // skip records with missing timestamp
+	}
+
+	gs, err := a.group(groupValues)
+	if gs == nil || err != nil {
+		return err
+	}
+
+	feedAccumulators(gs.accs, a.inputs(rec, row))
+	return nil
+}
 
-	// Compute group values.
+// groupValues computes the group-by values for a record. Returns false if
+// the record has no timestamp for a bin() group.
+func (a *Aggregator) groupValues(rec chunk.Record, row querylang.Row) ([]string, bool) {
 	groupValues := make([]string, len(a.groups))
 	for i, g := range a.groups {
 		if g.Bin != nil {
 			ts, ok := a.getTimestamp(rec)
 			if !ok {
-				return nil // skip records with missing timestamp
+				return nil, false
 			}
 			binTS := ts.Truncate(a.binWidth)
 			groupValues[i] = binTS.UTC().Format(time.RFC3339)
 		} else {
-			v, ok := row[g.Field.Name]
-			if !ok {
-				groupValues[i] = "" // missing field → empty group value
-			} else {
-				groupValues[i] = v
-			}
+			// Missing field → empty group value.
+			groupValues[i] = row[g.Field.Name]
 		}
 	}
+	return groupValues, true
+}
 
-	key := makeGroupKey(groupValues)
-	gs, exists := a.state[key]
-	if !exists {
-		if len(a.state) >= MaxGroupCardinality {
-			a.truncated = true
-			return nil
+// aggInput holds one record's evaluated aggregate arguments.
+type aggInput struct {
+	vals []querylang.Value // one per aggregate expression
+	ts   time.Time         // for time-aware aggregates (rate)
+}
+
+// inputs evaluates each aggregate's argument against a record.
+func (a *Aggregator) inputs(rec chunk.Record, row querylang.Row) aggInput {
+	ts, ok := a.getTimestamp(rec)
+	if !ok {
+		ts = rec.WriteTS
+	}
+	in := aggInput{vals: make([]querylang.Value, len(a.aggs)), ts: ts}
+	for i, agg := range a.aggs {
+		if agg.Arg == nil {
+			// Bare count — always non-missing.
+			in.vals[i] = querylang.NumValue(1)
+			continue
 		}
-		accs, err := a.makeAccumulators()
+		v, err := a.eval.Eval(agg.Arg, row)
 		if err != nil {
-			return err
-		}
-		gs = &groupState{
-			groupValues: groupValues,
-			accs:        accs,
+			// Expression evaluation errors → missing (skip silently).
+			v = querylang.MissingValue()
 		}
-		a.state[key] = gs
-		a.keyOrder = append(a.keyOrder, key)
+		in.vals[i] = v
 	}
+	return in
+}
 
-	// Evaluate and accumulate each aggregate.
-	for i, agg := range a.aggs {
-		var val querylang.Value
-		if agg.Arg == nil {
-			// Bare count — always non-missing.
-			val = querylang.NumValue(1)
+// feedAccumulators adds one record's inputs to a set of accumulators.
+func feedAccumulators(accs []accumulator, in aggInput) {
+	for i, acc := range accs {
+		if t, ok := acc.(timedAccumulator); ok {
+			t.AddAt(in.vals[i], in.ts)
 		} else {
-			v, err := a.eval.Eval(agg.Arg, row)
-			if err != nil {
-				// Expression evaluation errors → missing (skip silently).
-				val = querylang.MissingValue()
-			} else {
-				val = v
-			}
+			acc.Add(in.vals[i])
 		}
-		gs.accs[i].Add(val)
 	}
+}
 
-	return nil
+// group returns the state for groupValues, creating it on first sight.
+// Returns nil (and marks the result truncated) once the cardinality cap is hit.
+func (a *Aggregator) group(groupValues []string) (*groupState, error) {
+	key := makeGroupKey(groupValues)
+	if gs, exists := a.state[key]; exists {
+		return gs, nil
+	}
+	if len(a.state) >= MaxGroupCardinality {
+		a.truncated = true
+		return nil, nil
+	}
+	accs, err := a.makeAccumulators()
+	if err != nil {
+		return nil, err
+	}
+	gs := &groupState{
+		groupValues: groupValues,
+		accs:        accs,
+	}
+	a.state[key] = gs
+	a.keyOrder = append(a.keyOrder, key)
+	return gs, nil
 }
 
 // Result produces the final TableResult.
@@ -233,7 +268,24 @@ func (a *Aggregator) Result(start, end time.Time) *TableResult {
 		a.gapFill(start, end)
 	}
 
-	// Build column names.
+	a.setWindow(start, end)
+	columns := a.columns()
+
+	// Build rows.
+	rows := a.buildRows(columns)
+
+	// Sort rows by group values.
+	a.sortRows(rows)
+
+	return &TableResult{
+		Columns:   columns,
+		Rows:      rows,
+		Truncated: a.truncated,
+	}
+}
+
+// columns returns the result column names: groups first, then aggregates.
+func (a *Aggregator) columns() []string {
 	columns := make([]string, 0, len(a.groups)+len(a.aggs))
 	for _, g := range a.groups {
 		if g.Bin != nil {
@@ -245,17 +297,26 @@ func (a *Aggregator) Result(start, end time.Time) *TableResult {
 	for _, agg := range a.aggs {
 		columns = append(columns, agg.DefaultAlias())
 	}
+	return columns
+}
 
-	// Build rows.
-	rows := a.buildRows(columns)
-
-	// Sort rows by group values.
-	a.sortRows(rows)
-
-	return &TableResult{
-		Columns:   columns,
-		Rows:      rows,
-		Truncated: a.truncated,
+// setWindow tells time-aware accumulators how long each group's window is:
+// the bin width when grouping by bin(), otherwise the query time range.
+// Zero leaves them to fall back to the span of the records they saw.
+func (a *Aggregator) setWindow(start, end time.Time) {
+	var window time.Duration
+	switch {
+	case a.binIdx >= 0:
+		window = a.binWidth
+	case !start.IsZero() && !end.IsZero():
+		window = end.Sub(start)
+	}
+	for _, gs := range a.state {
+		for _, acc := range gs.accs {
+			if w, ok := acc.(windowedAccumulator); ok {
+				w.setWindow(window)
+			}
+		}
 	}
 }
 
@@ -267,7 +328,7 @@ func makeGroupKey(values []string) string {
 func (a *Aggregator) makeAccumulators() ([]accumulator, error) {
 	accs := make([]accumulator, len(a.aggs))
 	for i, agg := range a.aggs {
-		acc, err := newAccumulator(agg.Func)
+		acc, err := newAccumulator(agg)
 		if err != nil {
 			return nil, err
 		}
@@ -495,6 +556,17 @@ type accumulator interface {
 	Result() querylang.Value
 }
 
+// timedAccumulator is an accumulator that also wants each record's timestamp.
+type timedAccumulator interface {
+	AddAt(v querylang.Value, ts time.Time)
+}
+
+// windowedAccumulator is an accumulator whose result depends on the length
+// of the group's time window.
+type windowedAccumulator interface {
+	setWindow(d time.Duration)
+}
+
 // countAcc counts non-missing values. For bare count (no argument),
 // the caller passes a non-missing value for every record.
 type countAcc struct{ n int64 }
@@ -608,27 +680,161 @@ func (a *dcountAcc) Result() querylang.Value {
 	return querylang.NumValue(float64(len(a.seen)))
 }
 
-// medianAcc collects numeric values and returns the median.
-type medianAcc struct {
-	vals []float64
+// quantileAcc estimates the q-quantile of numeric values with a mergeable
+// sketch. Backs median, pNN and percentile.
+type quantileAcc struct {
+	q      float64
+	sketch quantileSketch
 }
 
-func (a *medianAcc) Add(v querylang.Value) {
+func (a *quantileAcc) Add(v querylang.Value) {
 	if n, ok := v.ToNum(); ok {
-		a.vals = append(a.vals, n)
+		a.sketch.Add(n)
 	}
 }
 
-func (a *medianAcc) Result() querylang.Value {
-	if len(a.vals) == 0 {
+func (a *quantileAcc) Result() querylang.Value {
+	x, ok := a.sketch.Quantile(a.q)
+	if !ok {
 		return querylang.MissingValue()
 	}
-	slices.Sort(a.vals)
-	n := len(a.vals)
-	if n%2 == 1 {
-		return querylang.NumValue(a.vals[n/2])
+	return querylang.NumValue(x)
+}
+
+// momentsAcc tracks count, mean and the sum of squared deviations with
+// Welford's algorithm, yielding the sample variance or standard deviation.
+type momentsAcc struct {
+	stddev bool
+	n      float64
+	mean   float64
+	m2     float64
+}
+
+func (a *momentsAcc) Add(v querylang.Value) {
+	if x, ok := v.ToNum(); ok {
+		a.n++
+		d := x - a.mean
+		a.mean += d / a.n
+		a.m2 += d * (x - a.mean)
+	}
+}
+
+func (a *momentsAcc) Result() querylang.Value {
+	if a.n == 0 {
+		return querylang.MissingValue()
+	}
+	variance := 0.0
+	if a.n > 1 {
+		variance = a.m2 / (a.n - 1)
 	}
-	return querylang.NumValue((a.vals[n/2-1] + a.vals[n/2]) / 2)
+	if a.stddev {
+		return querylang.NumValue(math.Sqrt(variance))
+	}
+	return querylang.NumValue(variance)
+}
+
+// rangeAcc returns max - min of numeric values.
+type rangeAcc struct {
+	min, max float64
+	any      bool
+}
+
+func (a *rangeAcc) Add(v querylang.Value) {
+	n, ok := v.ToNum()
+	if !ok {
+		return
+	}
+	if !a.any {
+		a.min, a.max, a.any = n, n, true
+		return
+	}
+	a.min = min(a.min, n)
+	a.max = max(a.max, n)
+}
+
+func (a *rangeAcc) Result() querylang.Value {
+	if !a.any {
+		return querylang.MissingValue()
+	}
+	return querylang.NumValue(a.max - a.min)
+}
+
+// modeAcc returns the most frequent non-missing value. Ties go to the value
+// seen first.
+type modeAcc struct {
+	counts map[string]int
+	order  []string
+}
+
+func (a *modeAcc) Add(v querylang.Value) {
+	if v.Missing {
+		return
+	}
+	if a.counts == nil {
+		a.counts = make(map[string]int)
+	}
+	if a.counts[v.Str] == 0 {
+		a.order = append(a.order, v.Str)
+	}
+	a.counts[v.Str]++
+}
+
+func (a *modeAcc) Result() querylang.Value {
+	best, bestN := "", 0
+	for _, s := range a.order {
+		if a.counts[s] > bestN {
+			best, bestN = s, a.counts[s]
+		}
+	}
+	if bestN == 0 {
+		return querylang.MissingValue()
+	}
+	return querylang.StrValue(best)
+}
+
+// rateAcc counts non-missing values per second of the group's window (see
+// Aggregator.setWindow). Without a window it uses the span between the
+// group's first and last record.
+type rateAcc struct {
+	n           int64
+	first, last time.Time
+	window      time.Duration
+}
+
+func (a *rateAcc) Add(v querylang.Value) {
+	a.AddAt(v, time.Time{})
+}
+
+func (a *rateAcc) AddAt(v querylang.Value, ts time.Time) {
+	if v.Missing {
+		return
+	}
+	a.n++
+	if ts.IsZero() {
+		return
+	}
+	if a.first.IsZero() || ts.Before(a.first) {
+		a.first = ts
+	}
+	if ts.After(a.last) {
+		a.last = ts
+	}
+}
+
+func (a *rateAcc) setWindow(d time.Duration) { a.window = d }
+
+func (a *rateAcc) Result() querylang.Value {
+	window := a.window
+	if window <= 0 {
+		window = a.last.Sub(a.first)
+	}
+	if a.n == 0 {
+		return querylang.NumValue(0)
+	}
+	if window <= 0 {
+		return querylang.MissingValue()
+	}
+	return querylang.NumValue(float64(a.n) / window.Seconds())
 }
 
 // firstAcc tracks the first non-missing value seen.
@@ -697,8 +903,18 @@ func (a *valuesAcc) Result() querylang.Value {
 	return querylang.StrValue(strings.Join(a.order, ", "))
 }
 
-func newAccumulator(funcName string) (accumulator, error) {
-	switch strings.ToLower(funcName) {
+func newAccumulator(agg querylang.AggExpr) (accumulator, error) {
+	name := strings.ToLower(agg.Func)
+	if q, ok, err := percentileOf(agg); ok || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		return &quantileAcc{q: q}, nil
+	}
+	if agg.Param != "" {
+		return nil, fmt.Errorf("%s takes a single argument", agg.Func)
+	}
+	switch name {
 	case "count":
 		return &countAcc{}, nil
 	case "sum":
@@ -711,15 +927,55 @@ func newAccumulator(funcName string) (accumulator, error) {
 		return &maxAcc{}, nil
 	case "dcount":
 		return &dcountAcc{}, nil
-	case "median":
-		return &medianAcc{}, nil
 	case "first":
 		return &firstAcc{}, nil
 	case "last":
 		return &lastAcc{}, nil
 	case "values":
 		return &valuesAcc{}, nil
+	case "stddev":
+		return &momentsAcc{stddev: true}, nil
+	case "variance":
+		return &momentsAcc{}, nil
+	case "range":
+		return &rangeAcc{}, nil
+	case "mode":
+		return &modeAcc{}, nil
+	case "rate":
+		return &rateAcc{}, nil
 	default:
-		return nil, fmt.Errorf("unknown aggregate function: %s", funcName)
+		return nil, fmt.Errorf("unknown aggregate function: %s", agg.Func)
+	}
+}
+
+// percentileFuncRe matches the pNN shorthand, p1 through p99.
+var percentileFuncRe = regexp.MustCompile(`^p([1-9][0-9]?)$`)
+
+// percentileOf returns the quantile (0..1) computed by a median, pNN or
+// percentile(field, N) aggregate. ok is false for other functions.
+func percentileOf(agg querylang.AggExpr) (q float64, ok bool, err error) {
+	name := strings.ToLower(agg.Func)
+	switch {
+	case name == "median":
+		q = 50
+	case name == "percentile":
+		if agg.Param == "" {
+			return 0, true, errors.New("percentile needs a field and a percentage, e.g. percentile(duration, 95)")
+		}
+		q, err = strconv.ParseFloat(agg.Param, 64)
+		if err != nil || q <= 0 || q > 100 {
+			return 0, true, fmt.Errorf("percentile: %q is not a percentage between 0 and 100", agg.Param)
+		}
+	case percentileFuncRe.MatchString(name):
+		q, _ = strconv.ParseFloat(name[1:], 64)
+	default:
+		return 0, false, nil
+	}
+	if name != "percentile" && agg.Param != "" {
+		return 0, true, fmt.Errorf("%s takes a single argument", agg.Func)
+	}
+	if agg.Arg == nil {
+		return 0, true, fmt.Errorf("%s needs a field argument", agg.Func)
 	}
+	return q / 100, true, nil
 }
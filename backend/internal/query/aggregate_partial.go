@@ -0,0 +1,296 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Partial aggregation lets cluster nodes run stats over their own records and
+// ship the accumulator state, not the raw records or final values, to the
+// coordinator, which merges the states and produces the result. Only
+// accumulators implementing mergeableAccumulator take part; a stats operator
+// using any other function must gather raw records instead.
+
+// mergeableAccumulator is an accumulator whose state can be serialized on one
+// node and folded into the same accumulator on another.
+type mergeableAccumulator interface {
+	accumulator
+	State() string
+	MergeState(state string) error
+}
+
+// PartialResult returns the aggregation state as a table: the same columns
+// as Result, with each aggregate cell holding its accumulator's encoded
+// state. Gap-filling and sorting are left to the node that finishes the
+// merge.
+func (a *Aggregator) PartialResult() (*TableResult, error) {
+	columns := a.columns()
+	rows := make([][]string, 0, len(a.keyOrder))
+	for _, key := range a.keyOrder {
+		gs := a.state[key]
+		row := make([]string, 0, len(columns))
+		row = append(row, gs.groupValues...)
+		for i, acc := range gs.accs {
+			m, ok := acc.(mergeableAccumulator)
+			if !ok {
+				return nil, fmt.Errorf("%s cannot be computed from partial results", a.aggs[i].Func)
+			}
+			row = append(row, m.State())
+		}
+		rows = append(rows, row)
+	}
+	return &TableResult{
+		Columns:   columns,
+		Rows:      rows,
+		Truncated: a.truncated,
+	}, nil
+}
+
+// MergePartial folds a table produced by PartialResult into the aggregator.
+func (a *Aggregator) MergePartial(t *TableResult) error {
+	if len(t.Columns) != len(a.groups)+len(a.aggs) {
+		return fmt.Errorf("partial stats has %d columns, want %d", len(t.Columns), len(a.groups)+len(a.aggs))
+	}
+	if t.Truncated {
+		a.truncated = true
+	}
+	for _, row := range t.Rows {
+		if len(row) != len(t.Columns) {
+			return errors.New("partial stats row has the wrong number of cells")
+		}
+		groupValues := append([]string(nil), row[:len(a.groups)]...)
+		gs, err := a.group(groupValues)
+		if err != nil {
+			return err
+		}
+		if gs == nil {
+			continue // cardinality cap
+		}
+		for i, state := range row[len(a.groups):] {
+			m, ok := gs.accs[i].(mergeableAccumulator)
+			if !ok {
+				return fmt.Errorf("%s cannot be computed from partial results", a.aggs[i].Func)
+			}
+			if err := m.MergeState(state); err != nil {
+				return fmt.Errorf("merge %s: %w", a.aggs[i].DefaultAlias(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// Accumulator states are short comma-separated lists of numbers. An empty
+// state means the accumulator saw no values.
+
+func formatState(vals ...float64) string {
+	parts := make([]string, len(vals))
+	for i, v := range vals {
+		parts[i] = strconv.FormatFloat(v, 'g', -1, 64)
+	}
+	return strings.Join(parts, ",")
+}
+
+func parseState(state string, n int) ([]float64, error) {
+	parts := strings.Split(state, ",")
+	if len(parts) != n {
+		return nil, fmt.Errorf("malformed state %q", state)
+	}
+	vals := make([]float64, n)
+	for i, p := range parts {
+		v, err := strconv.ParseFloat(p, 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed state %q", state)
+		}
+		vals[i] = v
+	}
+	return vals, nil
+}
+
+func (a *countAcc) State() string { return strconv.FormatInt(a.n, 10) }
+
+func (a *countAcc) MergeState(state string) error {
+	n, err := strconv.ParseInt(state, 10, 64)
+	if err != nil {
+		return fmt.Errorf("malformed state %q", state)
+	}
+	a.n += n
+	return nil
+}
+
+func (a *sumAcc) State() string {
+	if !a.any {
+		return ""
+	}
+	return formatState(a.sum)
+}
+
+func (a *sumAcc) MergeState(state string) error {
+	if state == "" {
+		return nil
+	}
+	v, err := parseState(state, 1)
+	if err != nil {
+		return err
+	}
+	a.sum += v[0]
+	a.any = true
+	return nil
+}
+
+func (a *minAcc) State() string {
+	if !a.any {
+		return ""
+	}
+	return formatState(a.min)
+}
+
+func (a *minAcc) MergeState(state string) error {
+	if state == "" {
+		return nil
+	}
+	v, err := parseState(state, 1)
+	if err != nil {
+		return err
+	}
+	if !a.any || v[0] < a.min {
+		a.min = v[0]
+		a.any = true
+	}
+	return nil
+}
+
+func (a *maxAcc) State() string {
+	if !a.any {
+		return ""
+	}
+	return formatState(a.max)
+}
+
+func (a *maxAcc) MergeState(state string) error {
+	if state == "" {
+		return nil
+	}
+	v, err := parseState(state, 1)
+	if err != nil {
+		return err
+	}
+	if !a.any || v[0] > a.max {
+		a.max = v[0]
+		a.any = true
+	}
+	return nil
+}
+
+func (a *quantileAcc) State() string {
+	if a.sketch.Count() == 0 {
+		return ""
+	}
+	return a.sketch.Encode()
+}
+
+func (a *quantileAcc) MergeState(state string) error {
+	if state == "" {
+		return nil
+	}
+	s, err := decodeSketch(state)
+	if err != nil {
+		return err
+	}
+	a.sketch.Merge(s)
+	return nil
+}
+
+func (a *momentsAcc) State() string {
+	if a.n == 0 {
+		return ""
+	}
+	return formatState(a.n, a.mean, a.m2)
+}
+
+// MergeState combines moments with Chan et al.'s parallel update.
+func (a *momentsAcc) MergeState(state string) error {
+	if state == "" {
+		return nil
+	}
+	v, err := parseState(state, 3)
+	if err != nil {
+		return err
+	}
+	n, mean, m2 := v[0], v[1], v[2]
+	if n <= 0 {
+		return nil
+	}
+	total := a.n + n
+	d := mean - a.mean
+	a.m2 += m2 + d*d*a.n*n/total
+	a.mean += d * n / total
+	a.n = total
+	return nil
+}
+
+func (a *rangeAcc) State() string {
+	if !a.any {
+		return ""
+	}
+	return formatState(a.min, a.max)
+}
+
+func (a *rangeAcc) MergeState(state string) error {
+	if state == "" {
+		return nil
+	}
+	v, err := parseState(state, 2)
+	if err != nil {
+		return err
+	}
+	if !a.any {
+		a.min, a.max, a.any = v[0], v[1], true
+		return nil
+	}
+	a.min = min(a.min, v[0])
+	a.max = max(a.max, v[1])
+	return nil
+}
+
+// rateAcc state is the count plus the first and last timestamps (Unix nanos,
+// 0 if unknown). The window is set by the merging node.
+func (a *rateAcc) State() string {
+	return strconv.FormatInt(a.n, 10) + "," + unixNanos(a.first) + "," + unixNanos(a.last)
+}
+
+func (a *rateAcc) MergeState(state string) error {
+	parts := strings.Split(state, ",")
+	if len(parts) != 3 {
+		return fmt.Errorf("malformed state %q", state)
+	}
+	var vals [3]int64
+	for i, p := range parts {
+		v, err := strconv.ParseInt(p, 10, 64)
+		if err != nil {
+			return fmt.Errorf("malformed state %q", state)
+		}
+		vals[i] = v
+	}
+	a.n += vals[0]
+	if vals[1] != 0 {
+		if first := time.Unix(0, vals[1]); a.first.IsZero() || first.Before(a.first) {
+			a.first = first
+		}
+	}
+	if vals[2] != 0 {
+		if last := time.Unix(0, vals[2]); last.After(a.last) {
+			a.last = last
+		}
+	}
+	return nil
+}
+
+func unixNanos(t time.Time) string {
+	if t.IsZero() {
+		return "0"
+	}
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
@@ -2,6 +2,8 @@ package query
 
 import (
 	"math"
+	"slices"
+	"strconv"
 	"testing"
 	"time"
 
@@ -901,6 +903,127 @@ func TestAggregatorMedianEven(t *testing.T) {
 	}
 }
 
+func TestAggregatorPercentiles(t *testing.T) {
+	stats := &querylang.StatsOp{
+		Aggs: []querylang.AggExpr{
+			{Func: "p50", Arg: &querylang.FieldRef{Name: "val"}},
+			{Func: "p90", Arg: &querylang.FieldRef{Name: "val"}},
+			{Func: "percentile", Arg: &querylang.FieldRef{Name: "val"}, Param: "25"},
+		},
+	}
+
+	agg, err := NewAggregator(stats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 11; i++ {
+		agg.Add(makeRec(baseTime, chunk.Attributes{"val": strconv.Itoa(i * 10)}, ""))
+	}
+
+	result := agg.Result(time.Time{}, time.Time{})
+	if want := []string{"p50_val", "p90_val", "p25_val"}; !slices.Equal(result.Columns, want) {
+		t.Errorf("columns = %v, want %v", result.Columns, want)
+	}
+	if want := []string{"60", "100", "35"}; !slices.Equal(result.Rows[0], want) {
+		t.Errorf("row = %v, want %v", result.Rows[0], want)
+	}
+}
+
+func TestAggregatorSpreadFunctions(t *testing.T) {
+	stats := &querylang.StatsOp{
+		Aggs: []querylang.AggExpr{
+			{Func: "stddev", Arg: &querylang.FieldRef{Name: "val"}},
+			{Func: "variance", Arg: &querylang.FieldRef{Name: "val"}},
+			{Func: "range", Arg: &querylang.FieldRef{Name: "val"}},
+			{Func: "mode", Arg: &querylang.FieldRef{Name: "val"}},
+		},
+	}
+
+	agg, err := NewAggregator(stats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []string{"2", "4", "4", "4", "5", "5", "7", "9"} {
+		agg.Add(makeRec(baseTime, chunk.Attributes{"val": v}, ""))
+	}
+	agg.Add(makeRec(baseTime, nil, "")) // missing
+
+	row := agg.Result(time.Time{}, time.Time{}).Rows[0]
+	// Sample variance of the classic 2,4,4,4,5,5,7,9 set is 32/7.
+	if v, _ := strconv.ParseFloat(row[1], 64); math.Abs(v-32.0/7) > 1e-9 {
+		t.Errorf("variance = %s, want %v", row[1], 32.0/7)
+	}
+	if v, _ := strconv.ParseFloat(row[0], 64); math.Abs(v-math.Sqrt(32.0/7)) > 1e-9 {
+		t.Errorf("stddev = %s, want %v", row[0], math.Sqrt(32.0/7))
+	}
+	if row[2] != "7" {
+		t.Errorf("range = %q, want 7", row[2])
+	}
+	if row[3] != "4" {
+		t.Errorf("mode = %q, want 4", row[3])
+	}
+}
+
+func TestAggregatorRate(t *testing.T) {
+	t.Run("query range", func(t *testing.T) {
+		agg, err := NewAggregator(&querylang.StatsOp{Aggs: []querylang.AggExpr{
+			{Func: "rate", Arg: &querylang.FieldRef{Name: "status"}},
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range 30 {
+			agg.Add(makeRec(baseTime.Add(time.Duration(i)*time.Second), chunk.Attributes{"status": "200"}, ""))
+		}
+		agg.Add(makeRec(baseTime, nil, "")) // missing field is not counted
+
+		result := agg.Result(baseTime, baseTime.Add(time.Minute))
+		if result.Rows[0][0] != "0.5" {
+			t.Errorf("rate = %q, want 0.5", result.Rows[0][0])
+		}
+	})
+
+	t.Run("per bin", func(t *testing.T) {
+		agg, err := NewAggregator(&querylang.StatsOp{
+			Aggs:   []querylang.AggExpr{{Func: "rate", Arg: &querylang.FieldRef{Name: "status"}}},
+			Groups: []querylang.GroupExpr{{Bin: &querylang.BinExpr{Duration: "1m"}}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for range 120 {
+			agg.Add(makeRec(baseTime, chunk.Attributes{"status": "200"}, "")) // 2/s in the first minute
+		}
+		agg.Add(makeRec(baseTime.Add(90*time.Second), chunk.Attributes{"status": "200"}, ""))
+
+		result := agg.Result(time.Time{}, time.Time{})
+		if len(result.Rows) != 2 {
+			t.Fatalf("rows = %v, want 2 bins", result.Rows)
+		}
+		if result.Rows[0][1] != "2" {
+			t.Errorf("first bin rate = %q, want 2", result.Rows[0][1])
+		}
+	})
+}
+
+func TestNewAggregatorRejectsBadPercentiles(t *testing.T) {
+	field := &querylang.FieldRef{Name: "val"}
+	for _, agg := range []querylang.AggExpr{
+		{Func: "percentile", Arg: field},
+		{Func: "percentile", Arg: field, Param: "0"},
+		{Func: "percentile", Arg: field, Param: "101"},
+		{Func: "p100", Arg: field},
+		{Func: "p0", Arg: field},
+		{Func: "p95", Arg: field, Param: "5"},
+		{Func: "sum", Arg: field, Param: "5"},
+		{Func: "p95"},
+	} {
+		if _, err := NewAggregator(&querylang.StatsOp{Aggs: []querylang.AggExpr{agg}}); err == nil {
+			t.Errorf("NewAggregator(%s) expected error", agg.String())
+		}
+	}
+}
+
 func TestAggregatorFirstLast(t *testing.T) {
 	stats := &querylang.StatsOp{
 		Aggs: []querylang.AggExpr{
@@ -962,7 +1085,7 @@ func TestAggregatorNewAccumulatorsEmpty(t *testing.T) {
 	})
 
 	t.Run("median empty", func(t *testing.T) {
-		acc := &medianAcc{}
+		acc := &quantileAcc{q: 0.5}
 		if !acc.Result().Missing {
 			t.Error("expected missing for empty median")
 		}
@@ -0,0 +1,213 @@
+package query
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"gastrolog/internal/chunk"
+	"gastrolog/internal/querylang"
+)
+
+// eventstats and streamstats reuse the stats accumulators but, instead of
+// collapsing records into a table, write each record's aggregates back into
+// its Attrs under the aggregate's alias.
+
+// applyRecordEventstats aggregates all records per group, then adds each
+// record's group aggregates to the record.
+func applyRecordEventstats(records []chunk.Record, op *querylang.EventstatsOp) error {
+	a, err := NewAggregator(&querylang.StatsOp{Aggs: op.Aggs, Groups: op.Groups})
+	if err != nil {
+		return err
+	}
+
+	groups := make([]*groupState, len(records))
+	for i, rec := range records {
+		row := RecordToRow(rec)
+		gv, ok := a.groupValues(rec, row)
+		if !ok {
+			continue
+		}
+		gs, err := a.group(gv)
+		if err != nil {
+			return err
+		}
+		if gs == nil {
+			continue // over the cardinality cap
+		}
+		feedAccumulators(gs.accs, a.inputs(rec, row))
+		groups[i] = gs
+	}
+
+	// Rate falls back to the span of each group's records.
+	a.setWindow(time.Time{}, time.Time{})
+	for i, gs := range groups {
+		if gs != nil {
+			setAggFields(&records[i], a.aggs, gs.accs)
+		}
+	}
+	return nil
+}
+
+// applyRecordStreamstats adds running aggregates to each record, in order.
+func applyRecordStreamstats(records []chunk.Record, op *querylang.StreamstatsOp) error {
+	ss, err := newStreamStats(op)
+	if err != nil {
+		return err
+	}
+	for i := range records {
+		ss.add(&records[i])
+	}
+	return nil
+}
+
+// streamStats holds the running state of a streamstats operator.
+type streamStats struct {
+	agg        *Aggregator
+	window     int           // 0 = unbounded
+	timeWindow time.Duration // 0 = unbounded
+	windows    map[string]*statsWindow
+}
+
+// statsWindow holds the inputs of the records currently inside one group's
+// window. Accumulators can't retract values, so bounded windows recompute
+// their aggregates from these on every record.
+type statsWindow struct {
+	inputs []aggInput
+}
+
+func newStreamStats(op *querylang.StreamstatsOp) (*streamStats, error) {
+	a, err := NewAggregator(&querylang.StatsOp{Aggs: op.Aggs, Groups: op.Groups})
+	if err != nil {
+		return nil, err
+	}
+	ss := &streamStats{
+		agg:     a,
+		window:  op.Window,
+		windows: make(map[string]*statsWindow),
+	}
+	if op.TimeWindow != "" {
+		d, err := time.ParseDuration(op.TimeWindow)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid streamstats time_window %q", op.TimeWindow)
+		}
+		ss.timeWindow = d
+	}
+	return ss, nil
+}
+
+// add folds rec into its group's running aggregates and attaches the
+// current values to rec.
+func (s *streamStats) add(rec *chunk.Record) {
+	row := RecordToRow(*rec)
+	gv, ok := s.agg.groupValues(*rec, row)
+	if !ok {
+		return
+	}
+	in := s.agg.inputs(*rec, row)
+
+	if s.window == 0 && s.timeWindow == 0 {
+		gs, _ := s.agg.group(gv)
+		if gs == nil {
+			return // over the cardinality cap
+		}
+		feedAccumulators(gs.accs, in)
+		setAggFields(rec, s.agg.aggs, gs.accs)
+		return
+	}
+
+	key := makeGroupKey(gv)
+	w := s.windows[key]
+	if w == nil {
+		if len(s.windows) >= MaxGroupCardinality {
+			return
+		}
+		w = &statsWindow{}
+		s.windows[key] = w
+	}
+	w.inputs = append(w.inputs, in)
+	if s.window > 0 && len(w.inputs) > s.window {
+		w.inputs = slices.Delete(w.inputs, 0, len(w.inputs)-s.window)
+	}
+	if s.timeWindow > 0 {
+		// Records may arrive newest first, so compare absolute distance.
+		n := 0
+		for n < len(w.inputs) && absDuration(in.ts.Sub(w.inputs[n].ts)) > s.timeWindow {
+			n++
+		}
+		w.inputs = slices.Delete(w.inputs, 0, n)
+	}
+
+	accs, err := s.agg.makeAccumulators()
+	if err != nil {
+		return // validated by NewAggregator
+	}
+	for _, old := range w.inputs {
+		feedAccumulators(accs, old)
+	}
+	if s.timeWindow > 0 {
+		for _, acc := range accs {
+			if wa, ok := acc.(windowedAccumulator); ok {
+				wa.setWindow(s.timeWindow)
+			}
+		}
+	}
+	setAggFields(rec, s.agg.aggs, accs)
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// setAggFields writes each aggregate's current value into rec.Attrs.
+// Missing results leave the field unset.
+func setAggFields(rec *chunk.Record, aggs []querylang.AggExpr, accs []accumulator) {
+	for i, agg := range aggs {
+		v := accs[i].Result()
+		if v.Missing {
+			continue
+		}
+		if rec.Attrs == nil {
+			rec.Attrs = make(chunk.Attributes)
+		}
+		rec.Attrs[agg.DefaultAlias()] = v.Str
+	}
+}
+
+// applyTableAggFields runs eventstats or streamstats over table rows, adding
+// a column per aggregate. Rows are treated as records whose fields are the
+// table's columns.
+func applyTableAggFields(table *TableResult, aggs []querylang.AggExpr, apply func([]chunk.Record) error) error {
+	records := make([]chunk.Record, len(table.Rows))
+	for i, row := range table.Rows {
+		attrs := make(chunk.Attributes, len(table.Columns))
+		for j, col := range table.Columns {
+			if j < len(row) {
+				attrs[col] = row[j]
+			}
+		}
+		records[i] = chunk.Record{Attrs: attrs}
+	}
+	if err := apply(records); err != nil {
+		return err
+	}
+
+	for _, agg := range aggs {
+		alias := agg.DefaultAlias()
+		idx := slices.Index(table.Columns, alias)
+		if idx < 0 {
+			table.Columns = append(table.Columns, alias)
+			idx = len(table.Columns) - 1
+			for i := range table.Rows {
+				table.Rows[i] = append(table.Rows[i], "")
+			}
+		}
+		for i := range table.Rows {
+			table.Rows[i][idx] = records[i].Attrs[alias]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"gastrolog/internal/chunk"
+	"gastrolog/internal/querylang"
+)
+
+func mustParsePipes(t *testing.T, s string) []querylang.PipeOp {
+	t.Helper()
+	p, err := querylang.ParsePipeline(s)
+	if err != nil {
+		t.Fatalf("ParsePipeline(%q): %v", s, err)
+	}
+	return p.Pipes
+}
+
+func attrColumn(records []chunk.Record, name string) []string {
+	out := make([]string, len(records))
+	for i, rec := range records {
+		out[i] = rec.Attrs[name]
+	}
+	return out
+}
+
+func TestApplyRecordEventstats(t *testing.T) {
+	records := []chunk.Record{
+		makeRec(baseTime, chunk.Attributes{"service": "api", "latency": "100"}, "a"),
+		makeRec(baseTime, chunk.Attributes{"service": "db", "latency": "10"}, "b"),
+		makeRec(baseTime, chunk.Attributes{"service": "api", "latency": "300"}, "c"),
+		makeRec(baseTime, chunk.Attributes{"service": "db", "latency": "30"}, "d"),
+	}
+	op := mustParsePipes(t, "| eventstats avg(latency) as svc_avg, count by service")[0].(*querylang.EventstatsOp)
+
+	if err := applyRecordEventstats(records, op); err != nil {
+		t.Fatalf("applyRecordEventstats: %v", err)
+	}
+	if got, want := attrColumn(records, "svc_avg"), []string{"200", "20", "200", "20"}; !slices.Equal(got, want) {
+		t.Errorf("svc_avg = %v, want %v", got, want)
+	}
+	if got, want := attrColumn(records, "count"), []string{"2", "2", "2", "2"}; !slices.Equal(got, want) {
+		t.Errorf("count = %v, want %v", got, want)
+	}
+	// Original fields are kept.
+	if records[0].Attrs["latency"] != "100" {
+		t.Errorf("latency = %q, want 100", records[0].Attrs["latency"])
+	}
+}
+
+func TestApplyRecordStreamstats(t *testing.T) {
+	records := []chunk.Record{
+		makeRec(baseTime, chunk.Attributes{"session": "x", "bytes": "10"}, "1"),
+		makeRec(baseTime, chunk.Attributes{"session": "y", "bytes": "5"}, "2"),
+		makeRec(baseTime, chunk.Attributes{"session": "x", "bytes": "20"}, "3"),
+		makeRec(baseTime, chunk.Attributes{"session": "x", "bytes": "30"}, "4"),
+	}
+	op := mustParsePipes(t, "| streamstats count, sum(bytes) by session")[0].(*querylang.StreamstatsOp)
+
+	if err := applyRecordStreamstats(records, op); err != nil {
+		t.Fatalf("applyRecordStreamstats: %v", err)
+	}
+	if got, want := attrColumn(records, "count"), []string{"1", "1", "2", "3"}; !slices.Equal(got, want) {
+		t.Errorf("count = %v, want %v", got, want)
+	}
+	if got, want := attrColumn(records, "sum_bytes"), []string{"10", "5", "30", "60"}; !slices.Equal(got, want) {
+		t.Errorf("sum_bytes = %v, want %v", got, want)
+	}
+}
+
+func TestApplyRecordStreamstatsWindow(t *testing.T) {
+	var records []chunk.Record
+	for _, v := range []string{"1", "2", "3", "4", "5"} {
+		records = append(records, makeRec(baseTime, chunk.Attributes{"v": v}, v))
+	}
+	op := mustParsePipes(t, "| streamstats window=2 sum(v) as moving")[0].(*querylang.StreamstatsOp)
+
+	if err := applyRecordStreamstats(records, op); err != nil {
+		t.Fatalf("applyRecordStreamstats: %v", err)
+	}
+	if got, want := attrColumn(records, "moving"), []string{"1", "3", "5", "7", "9"}; !slices.Equal(got, want) {
+		t.Errorf("moving = %v, want %v", got, want)
+	}
+}
+
+func TestApplyRecordStreamstatsTimeWindow(t *testing.T) {
+	offsets := []time.Duration{0, 1 * time.Minute, 3 * time.Minute, 4 * time.Minute, 10 * time.Minute}
+	var records []chunk.Record
+	for _, off := range offsets {
+		records = append(records, makeRec(baseTime.Add(off), chunk.Attributes{"level": "error"}, "boom"))
+	}
+	op := mustParsePipes(t, "| streamstats time_window=2m count as recent")[0].(*querylang.StreamstatsOp)
+
+	if err := applyRecordStreamstats(records, op); err != nil {
+		t.Fatalf("applyRecordStreamstats: %v", err)
+	}
+	// 0m:1, 1m:2 (0,1), 3m:2 (1,3), 4m:2 (3,4), 10m:1.
+	if got, want := attrColumn(records, "recent"), []string{"1", "2", "2", "2", "1"}; !slices.Equal(got, want) {
+		t.Errorf("recent = %v, want %v", got, want)
+	}
+}
+
+func TestApplyTableEventstats(t *testing.T) {
+	table := &TableResult{
+		Columns: []string{"host", "count"},
+		Rows:    [][]string{{"a", "30"}, {"b", "10"}, {"c", "60"}},
+	}
+	ops := mustParsePipes(t, "| stats count by host | eventstats sum(count) as total | streamstats sum(count) as running")[1:]
+
+	table, err := applyTableOps(context.Background(), table, ops, nil)
+	if err != nil {
+		t.Fatalf("applyTableOps: %v", err)
+	}
+	if !slices.Equal(table.Columns, []string{"host", "count", "total", "running"}) {
+		t.Fatalf("columns = %v", table.Columns)
+	}
+	want := [][]string{{"a", "30", "100", "30"}, {"b", "10", "100", "40"}, {"c", "60", "100", "100"}}
+	for i, row := range want {
+		if !slices.Equal(table.Rows[i], row) {
+			t.Errorf("row %d = %v, want %v", i, table.Rows[i], row)
+		}
+	}
+}
+
+func TestEventstatsThenWhere(t *testing.T) {
+	records := []chunk.Record{
+		makeRec(baseTime, chunk.Attributes{"service": "api", "latency": "100"}, "a"),
+		makeRec(baseTime, chunk.Attributes{"service": "api", "latency": "110"}, "b"),
+		makeRec(baseTime, chunk.Attributes{"service": "api", "latency": "900"}, "c"),
+		makeRec(baseTime, chunk.Attributes{"service": "api", "latency": "90"}, "d"),
+	}
+	ops := mustParsePipes(t, "| eventstats avg(latency) as svc_avg by service | eval ratio = latency / svc_avg | where ratio > 2")
+
+	result, err := applyRecordOps(context.Background(), recordIter(records), ops, nil)
+	if err != nil {
+		t.Fatalf("applyRecordOps: %v", err)
+	}
+	if len(result) != 1 || string(result[0].Raw) != "c" {
+		t.Fatalf("expected only record c, got %d records", len(result))
+	}
+}
+
+func TestStreamstatsBeforeTail(t *testing.T) {
+	records := makeTestRecords(10, nil)
+	ops := mustParsePipes(t, "| streamstats count as n | tail 2")
+
+	result, err := applyRecordOps(context.Background(), recordIter(records), ops, nil)
+	if err != nil {
+		t.Fatalf("applyRecordOps: %v", err)
+	}
+	if got, want := attrColumn(result, "n"), []string{"9", "10"}; !slices.Equal(got, want) {
+		t.Errorf("n = %v, want %v", got, want)
+	}
+}
@@ -25,7 +25,10 @@ type ContextResult struct {
 }
 
 // ReadRecord reads a single record by vault, chunk, and position.
-func (e *Engine) ReadRecord(_ context.Context, vaultID glid.GLID, chunkID chunk.ChunkID, pos uint64) (chunk.Record, error) {
+func (e *Engine) ReadRecord(ctx context.Context, vaultID glid.GLID, chunkID chunk.ChunkID, pos uint64) (chunk.Record, error) {
+	if !VaultAccessFromContext(ctx).Allows(vaultID) {
+		return chunk.Record{}, fmt.Errorf("vault %q: %w", vaultID, ErrVaultAccessDenied)
+	}
 	cm, _ := e.getVaultManagers(vaultID)
 	if cm == nil {
 		return chunk.Record{}, fmt.Errorf("vault %q: %w", vaultID, chunk.ErrVaultNotFound)
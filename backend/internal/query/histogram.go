@@ -34,7 +34,7 @@ import (
 func (e *Engine) runTimechart(ctx context.Context, q Query, tc *querylang.TimechartOp, preOps []querylang.PipeOp) (*TableResult, error) {
 	numBuckets := clampBuckets(tc.N)
 
-	selectedVaults := e.timechartVaults(q)
+	selectedVaults := e.timechartVaults(ctx, q)
 
 	// If no time range, derive from chunk metadata.
 	if q.Start.IsZero() || q.End.IsZero() {
@@ -229,15 +229,17 @@ func (e *Engine) vaultChunkMetas(vaultID glid.GLID) []chunk.ChunkMeta {
 	return out
 }
 
-// timechartVaults returns the vaults to query for a timechart, applying any vault filter.
-func (e *Engine) timechartVaults(q Query) []glid.GLID {
+// timechartVaults returns the vaults to query for a timechart, applying any
+// vault filter and the caller's vault access.
+func (e *Engine) timechartVaults(ctx context.Context, q Query) []glid.GLID {
+	access := VaultAccessFromContext(ctx)
 	allVaults := e.listVaults()
 	if q.BoolExpr != nil {
 		if vaults, _ := ExtractVaultFilter(q.BoolExpr, allVaults); vaults != nil {
-			return vaults
+			return access.Restrict(vaults)
 		}
 	}
-	return allVaults
+	return access.Restrict(allVaults)
 }
 
 // deriveTimeRange fills in missing Start/End from chunk metadata across the selected vaults.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"gastrolog/internal/glid"
+	"slices"
 	"strconv"
 	"testing"
 	"time"
@@ -326,6 +327,21 @@ func TestPipelineNeedsGlobalRecords(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "eventstats before stats",
+			ops: []querylang.PipeOp{
+				&querylang.EventstatsOp{Aggs: []querylang.AggExpr{{Func: "count"}}},
+				&querylang.StatsOp{Aggs: []querylang.AggExpr{{Func: "sum", Arg: &querylang.FieldRef{Name: "count"}}}},
+			},
+			want: true,
+		},
+		{
+			name: "bare streamstats",
+			ops: []querylang.PipeOp{
+				&querylang.StreamstatsOp{Aggs: []querylang.AggExpr{{Func: "count"}}},
+			},
+			want: true,
+		},
 		{
 			name: "slice before stats",
 			ops: []querylang.PipeOp{
@@ -383,12 +399,21 @@ func TestPipelineNeedsGlobalRecords(t *testing.T) {
 			want: true,
 		},
 		{
-			name: "stats with median is non-distributive",
+			name: "stats with median merges partial sketches",
 			ops: []querylang.PipeOp{
 				&querylang.StatsOp{Aggs: []querylang.AggExpr{
 					{Func: "median", Arg: &querylang.FieldRef{Name: "latency"}},
 				}},
 			},
+			want: false,
+		},
+		{
+			name: "stats with mode is non-distributive",
+			ops: []querylang.PipeOp{
+				&querylang.StatsOp{Aggs: []querylang.AggExpr{
+					{Func: "mode", Arg: &querylang.FieldRef{Name: "status"}},
+				}},
+			},
 			want: true,
 		},
 		{
@@ -496,3 +521,221 @@ func TestRunPipelineOnRecords(t *testing.T) {
 		t.Errorf("stats count = %d, want 10 (head should cap merged records)", count)
 	}
 }
+
+func TestPipelineMergesPartialStats(t *testing.T) {
+	field := &querylang.FieldRef{Name: "latency"}
+	tests := []struct {
+		name string
+		ops  []querylang.PipeOp
+		want bool
+	}{
+		{"count only", []querylang.PipeOp{
+			&querylang.StatsOp{Aggs: []querylang.AggExpr{{Func: "count"}}},
+		}, false},
+		{"p95", []querylang.PipeOp{
+			&querylang.StatsOp{Aggs: []querylang.AggExpr{{Func: "count"}, {Func: "p95", Arg: field}}},
+		}, true},
+		{"stddev", []querylang.PipeOp{
+			&querylang.StatsOp{Aggs: []querylang.AggExpr{{Func: "stddev", Arg: field}}},
+		}, true},
+		{"p95 with avg gathers records", []querylang.PipeOp{
+			&querylang.StatsOp{Aggs: []querylang.AggExpr{{Func: "avg", Arg: field}, {Func: "p95", Arg: field}}},
+		}, false},
+		{"top", []querylang.PipeOp{
+			&querylang.TopOp{N: 10, Fields: []string{"host"}},
+		}, true},
+		{"p95 after head gathers records", []querylang.PipeOp{
+			&querylang.HeadOp{N: 10},
+			&querylang.StatsOp{Aggs: []querylang.AggExpr{{Func: "p95", Arg: field}}},
+		}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := query.PipelineMergesPartialStats(&querylang.Pipeline{Pipes: tc.ops})
+			if got != tc.want {
+				t.Errorf("PipelineMergesPartialStats = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRunPipelinePartial(t *testing.T) {
+	// Two single-vault engines stand in for two cluster nodes; a third holds
+	// every record and provides the expected single-node answer.
+	t0 := time.Date(2025, 6, 15, 10, 0, 0, 0, time.UTC)
+	newEngine := func(recs []chunk.Record) *query.Engine {
+		s := memtest.MustNewVault(t, chunkmem.Config{
+			RotationPolicy: chunk.NewRecordCountPolicy(10000),
+		})
+		for _, rec := range recs {
+			s.CM.Append(rec)
+		}
+		s.CM.Seal()
+		return query.NewWithRegistry(&testRegistry{
+			vaults: map[glid.GLID]struct {
+				cm chunk.ChunkManager
+				im index.IndexManager
+			}{
+				glid.New(): {s.CM, s.IM},
+			},
+		}, nil)
+	}
+
+	var all, a, b []chunk.Record
+	for i := range 1000 {
+		ts := t0.Add(time.Duration(i) * time.Second)
+		rec := chunk.Record{
+			WriteTS:  ts,
+			IngestTS: ts,
+			Attrs:    chunk.Attributes{"latency": strconv.Itoa((i * 7919) % 500)},
+			Raw:      fmt.Appendf(nil, "req-%d", i),
+		}
+		all = append(all, rec)
+		if i%2 == 0 {
+			a = append(a, rec)
+		} else {
+			b = append(b, rec)
+		}
+	}
+
+	pipeline, err := querylang.ParsePipeline("| stats count, p50(latency), p99(latency), range(latency) | eval total=count")
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := query.Query{Start: t0, End: t0.Add(time.Hour)}
+	ctx := context.Background()
+
+	want, err := newEngine(all).RunPipeline(ctx, q, pipeline)
+	if err != nil {
+		t.Fatalf("RunPipeline: %v", err)
+	}
+
+	engA, engB := newEngine(a), newEngine(b)
+	partA, err := engA.RunPipelinePartial(ctx, q, pipeline)
+	if err != nil {
+		t.Fatalf("RunPipelinePartial: %v", err)
+	}
+	partB, err := engB.RunPipelinePartial(ctx, q, pipeline)
+	if err != nil {
+		t.Fatalf("RunPipelinePartial: %v", err)
+	}
+	got, err := engA.FinishPartialPipeline(ctx, q, pipeline, []*query.TableResult{partA, partB})
+	if err != nil {
+		t.Fatalf("FinishPartialPipeline: %v", err)
+	}
+
+	if fmt.Sprint(got.Table.Columns) != fmt.Sprint(want.Table.Columns) {
+		t.Errorf("columns = %v, want %v", got.Table.Columns, want.Table.Columns)
+	}
+	if fmt.Sprint(got.Table.Rows) != fmt.Sprint(want.Table.Rows) {
+		t.Errorf("rows = %v, want %v", got.Table.Rows, want.Table.Rows)
+	}
+}
+
+// TestRunPipelinePartialTop verifies that top merged from per-node counts
+// ranks on the combined counts, not on each node's own top N.
+func TestRunPipelinePartialTop(t *testing.T) {
+	t0 := time.Date(2025, 6, 15, 10, 0, 0, 0, time.UTC)
+	newEngine := func(hosts ...string) *query.Engine {
+		s := memtest.MustNewVault(t, chunkmem.Config{
+			RotationPolicy: chunk.NewRecordCountPolicy(1000),
+		})
+		for i, h := range hosts {
+			ts := t0.Add(time.Duration(i) * time.Second)
+			s.CM.Append(chunk.Record{
+				WriteTS:  ts,
+				IngestTS: ts,
+				Attrs:    chunk.Attributes{"host": h},
+				Raw:      []byte("req"),
+			})
+		}
+		s.CM.Seal()
+		return query.NewWithRegistry(&testRegistry{
+			vaults: map[glid.GLID]struct {
+				cm chunk.ChunkManager
+				im index.IndexManager
+			}{
+				glid.New(): {s.CM, s.IM},
+			},
+		}, nil)
+	}
+
+	// "c" is second on neither node alone but second overall.
+	engA := newEngine("a", "a", "a", "b", "b", "c")
+	engB := newEngine("a", "d", "d", "c", "c")
+
+	pipeline, err := querylang.ParsePipeline("| top 2 host")
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := query.Query{Start: t0, End: t0.Add(time.Minute)}
+	ctx := context.Background()
+
+	partA, err := engA.RunPipelinePartial(ctx, q, pipeline)
+	if err != nil {
+		t.Fatalf("RunPipelinePartial: %v", err)
+	}
+	partB, err := engB.RunPipelinePartial(ctx, q, pipeline)
+	if err != nil {
+		t.Fatalf("RunPipelinePartial: %v", err)
+	}
+	got, err := engA.FinishPartialPipeline(ctx, q, pipeline, []*query.TableResult{partA, partB})
+	if err != nil {
+		t.Fatalf("FinishPartialPipeline: %v", err)
+	}
+
+	want := [][]string{{"a", "4", "36.36"}, {"c", "3", "27.27"}}
+	if fmt.Sprint(got.Table.Rows) != fmt.Sprint(want) {
+		t.Errorf("rows = %v, want %v", got.Table.Rows, want)
+	}
+}
+
+// TestRunPipelineParseStats verifies that fields extracted by parse can be
+// grouped on by stats.
+func TestRunPipelineParseStats(t *testing.T) {
+	vaultID := glid.New()
+	s := memtest.MustNewVault(t, chunkmem.Config{
+		RotationPolicy: chunk.NewRecordCountPolicy(1000),
+	})
+	t0 := time.Date(2025, 6, 15, 10, 0, 0, 0, time.UTC)
+	for i, user := range []string{"alice", "bob", "alice", "carol", "alice", "bob"} {
+		ts := t0.Add(time.Duration(i) * time.Second)
+		s.CM.Append(chunk.Record{
+			WriteTS:  ts,
+			IngestTS: ts,
+			Raw:      fmt.Appendf(nil, "login user=%s ip=10.0.0.%d", user, i),
+		})
+	}
+	s.CM.Seal()
+
+	reg := &testRegistry{
+		vaults: map[glid.GLID]struct {
+			cm chunk.ChunkManager
+			im index.IndexManager
+		}{
+			vaultID: {s.CM, s.IM},
+		},
+	}
+	eng := query.NewWithRegistry(reg, nil)
+
+	pipeline, err := querylang.ParsePipeline(`| parse "user=* ip=*" as user, ip | stats count by user | sort -count`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := eng.RunPipeline(context.Background(), query.Query{Start: t0, End: t0.Add(time.Minute)}, pipeline)
+	if err != nil {
+		t.Fatalf("RunPipeline: %v", err)
+	}
+	if result.Table == nil {
+		t.Fatal("expected table result")
+	}
+	want := [][]string{{"alice", "3"}, {"bob", "2"}, {"carol", "1"}}
+	if len(result.Table.Rows) != len(want) {
+		t.Fatalf("rows = %v, want %v", result.Table.Rows, want)
+	}
+	for i, row := range want {
+		if !slices.Equal(result.Table.Rows[i], row) {
+			t.Errorf("row %d = %v, want %v", i, result.Table.Rows[i], row)
+		}
+	}
+}
@@ -34,6 +34,7 @@ type pipelinePhases struct {
 	preOps      []querylang.PipeOp
 	postOps     []querylang.PipeOp
 	statsOp     *querylang.StatsOp
+	topOp       *querylang.TopOp // set with statsOp when it was derived from top/rare
 	timechartOp *querylang.TimechartOp
 	hasRaw      bool
 	vizOp       querylang.PipeOp // explicit visualization operator (barchart, donut, map)
@@ -53,6 +54,15 @@ func classifyPipes(pipeline *querylang.Pipeline) (*pipelinePhases, error) {
 				return nil, errors.New("pipeline cannot contain both timechart and stats")
 			}
 			p.statsOp = op
+		case *querylang.TopOp:
+			if p.statsOp != nil {
+				return nil, errors.New("pipeline can contain at most one stats, top or rare operator")
+			}
+			if p.timechartOp != nil {
+				return nil, errors.New("pipeline cannot contain both timechart and top or rare")
+			}
+			p.statsOp = topStats(op)
+			p.topOp = op
 		case *querylang.TimechartOp:
 			if p.timechartOp != nil {
 				return nil, errors.New("pipeline can contain at most one timechart operator")
@@ -79,6 +89,16 @@ func classifyPipes(pipeline *querylang.Pipeline) (*pipelinePhases, error) {
 	return p, nil
 }
 
+// statsResult produces the stats table, finished into top/rare output when
+// the stats operator was derived from one.
+func (p *pipelinePhases) statsResult(agg *Aggregator, q Query) *TableResult {
+	table := agg.Result(q.Start, q.End)
+	if p.topOp != nil {
+		table = finishTop(table, p.topOp)
+	}
+	return table
+}
+
 // runTimechartPipeline handles the timechart fast path.
 func (e *Engine) runTimechartPipeline(ctx context.Context, q Query, ph *pipelinePhases) (*PipelineResult, error) {
 	table, err := e.runTimechart(ctx, q, ph.timechartOp, ph.preOps)
@@ -103,8 +123,7 @@ func (e *Engine) runAggregation(ctx context.Context, records []chunk.Record, ph
 			return nil, err
 		}
 	}
-	table := agg.Result(q.Start, q.End)
-	table, err = applyTableOps(ctx, table, ph.postOps, e.lookupResolver)
+	table, err := applyTableOps(ctx, ph.statsResult(agg, q), ph.postOps, e.lookupResolver)
 	if err != nil {
 		return nil, err
 	}
@@ -187,7 +206,8 @@ func headOnlyLimit(ops []querylang.PipeOp) int {
 			headN = o.N
 		case *querylang.SortOp, *querylang.TailOp, *querylang.SliceOp:
 			return 0 // sort, tail, and slice require all records
-		case *querylang.WhereOp, *querylang.EvalOp, *querylang.RenameOp, *querylang.FieldsOp, *querylang.LookupOp, *querylang.DedupOp:
+		case *querylang.WhereOp, *querylang.EvalOp, *querylang.RenameOp, *querylang.FieldsOp, *querylang.LookupOp, *querylang.DedupOp,
+			*querylang.ParseOp, *querylang.RexOp:
 			// these are fine
 		default:
 			return 0
@@ -199,11 +219,12 @@ func headOnlyLimit(ops []querylang.PipeOp) int {
 // PipelineNeedsGlobalRecords reports whether a pipeline query must gather raw
 // records from all cluster nodes before running the pipeline on the coordinator.
 // This is true when:
-//   - The pipeline contains a non-distributive ordering operator (tail, sort,
-//     slice) that requires all records to produce a correct result, OR
+//   - The pipeline contains a non-distributive operator (tail, sort, slice,
+//     eventstats, streamstats, transaction) that requires all records to
+//     produce a correct result, OR
 //   - A cap operator (head, tail, slice) appears before an aggregation, OR
 //   - The pipeline contains a non-distributive aggregation function (avg,
-//     dcount, median, first, last, values) that cannot be correctly merged
+//     dcount, mode, first, last, values) that cannot be correctly merged
 //     from per-node results.
 func PipelineNeedsGlobalRecords(pipeline *querylang.Pipeline) bool {
 	ph, err := classifyPipes(pipeline)
@@ -225,12 +246,14 @@ func PipelineNeedsGlobalRecords(pipeline *querylang.Pipeline) bool {
 }
 
 // needsAllRecords returns true if the pipeline contains operators that require
-// the full record set to produce correct results (tail, sort, slice).
-// Head is excluded because it can short-circuit after N records.
+// the full record set to produce correct results (tail, sort, slice,
+// eventstats, streamstats, transaction). Head is excluded because it can
+// short-circuit after N records.
 func needsAllRecords(ops []querylang.PipeOp) bool {
 	for _, op := range ops {
 		switch op.(type) {
-		case *querylang.TailOp, *querylang.SortOp, *querylang.SliceOp:
+		case *querylang.TailOp, *querylang.SortOp, *querylang.SliceOp,
+			*querylang.EventstatsOp, *querylang.StreamstatsOp, *querylang.TransactionOp:
 			return true
 		}
 	}
@@ -240,20 +263,111 @@ func needsAllRecords(ops []querylang.PipeOp) bool {
 // hasNonDistributiveAgg returns true if the StatsOp contains aggregate functions
 // that cannot be correctly merged from independent per-node results.
 // Distributive: count, sum, min, max (can be merged by summing/min/max).
-// Non-distributive: avg, dcount, median, first, last, values.
+// Mergeable from partial state: median, percentiles, stddev, variance,
+// range, rate (see PipelineMergesPartialStats).
+// Non-distributive: avg, dcount, mode, first, last, values.
 func hasNonDistributiveAgg(op *querylang.StatsOp) bool {
 	if op == nil {
 		return false
 	}
 	for _, agg := range op.Aggs {
 		switch strings.ToLower(agg.Func) {
-		case "avg", "dcount", "median", "first", "last", "values":
+		case "avg", "dcount", "mode", "first", "last", "values":
 			return true
 		}
 	}
 	return false
 }
 
+// PipelineMergesPartialStats reports whether a distributed stats pipeline
+// must be merged from partial accumulator state (RunPipelinePartial on each
+// node, FinishPartialPipeline on the coordinator) rather than from final
+// per-node tables. This is the case when the stats operator uses an
+// aggregate whose final value cannot be combined, such as a percentile,
+// but whose state can, and for top and rare, whose per-node output is
+// already ranked and capped.
+func PipelineMergesPartialStats(pipeline *querylang.Pipeline) bool {
+	if PipelineNeedsGlobalRecords(pipeline) {
+		return false
+	}
+	ph, err := classifyPipes(pipeline)
+	if err != nil || ph.statsOp == nil {
+		return false
+	}
+	// Per-node top/rare tables are already capped; merge the raw counts.
+	if ph.topOp != nil {
+		return true
+	}
+	for _, agg := range ph.statsOp.Aggs {
+		if _, ok, _ := percentileOf(agg); ok {
+			return true
+		}
+		switch strings.ToLower(agg.Func) {
+		case "stddev", "variance", "range", "rate":
+			return true
+		}
+	}
+	return false
+}
+
+// RunPipelinePartial runs the pre-stats operators and the stats operator of
+// a pipeline and returns the aggregation state as produced by
+// Aggregator.PartialResult. Post-stats operators are left to
+// FinishPartialPipeline on the coordinator.
+func (e *Engine) RunPipelinePartial(ctx context.Context, q Query, pipeline *querylang.Pipeline) (*TableResult, error) {
+	ph, err := classifyPipes(pipeline)
+	if err != nil {
+		return nil, err
+	}
+	if ph.statsOp == nil {
+		return nil, errors.New("partial results require a stats operator")
+	}
+	agg, err := NewAggregator(ph.statsOp)
+	if err != nil {
+		return nil, err
+	}
+
+	q.Limit = 0
+	iter, _ := e.Search(ctx, q, nil)
+	records, err := applyRecordOps(ctx, iter, ph.preOps, e.lookupResolver)
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range records {
+		if err := agg.Add(rec); err != nil {
+			return nil, err
+		}
+	}
+	return agg.PartialResult()
+}
+
+// FinishPartialPipeline merges partial stats tables from RunPipelinePartial
+// and applies the post-stats operators, producing the same result RunPipeline
+// would over the union of the nodes' records.
+func (e *Engine) FinishPartialPipeline(ctx context.Context, q Query, pipeline *querylang.Pipeline, partials []*TableResult) (*PipelineResult, error) {
+	ph, err := classifyPipes(pipeline)
+	if err != nil {
+		return nil, err
+	}
+	if ph.statsOp == nil {
+		return nil, errors.New("partial results require a stats operator")
+	}
+	agg, err := NewAggregator(ph.statsOp)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range partials {
+		if err := agg.MergePartial(t); err != nil {
+			return nil, err
+		}
+	}
+	table, err := applyTableOps(ctx, ph.statsResult(agg, q), ph.postOps, e.lookupResolver)
+	if err != nil {
+		return nil, err
+	}
+	return &PipelineResult{Table: table}, nil
+}
+
 // RunPipelineOnRecords executes a pipeline query where extra records (typically
 // from remote cluster nodes) are merged with the local search results before
 // pipeline operators run. This enables correct head/tail/slice + stats on a
@@ -325,6 +439,14 @@ func (e *Engine) RunPipelineOnRecords(ctx context.Context, q Query, pipeline *qu
 			applyRecordFields(records, o)
 		case *querylang.LookupOp:
 			applyRecordLookup(ctx, records, o, e.lookupResolver)
+		case *querylang.ParseOp, *querylang.RexOp:
+			err = applyRecordExtract(records, o)
+		case *querylang.EventstatsOp:
+			err = applyRecordEventstats(records, o)
+		case *querylang.StreamstatsOp:
+			err = applyRecordStreamstats(records, o)
+		case *querylang.TransactionOp:
+			records, err = applyRecordTransaction(records, o)
 		}
 		if err != nil {
 			return nil, err
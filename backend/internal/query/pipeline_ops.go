@@ -64,6 +64,14 @@ func applyRecordOps(ctx context.Context, it iter.Seq2[chunk.Record, error], ops
 			applyRecordFields(records, o)
 		case *querylang.LookupOp:
 			applyRecordLookup(ctx, records, o, resolve)
+		case *querylang.ParseOp, *querylang.RexOp:
+			err = applyRecordExtract(records, o)
+		case *querylang.EventstatsOp:
+			err = applyRecordEventstats(records, o)
+		case *querylang.StreamstatsOp:
+			err = applyRecordStreamstats(records, o)
+		case *querylang.TransactionOp:
+			records, err = applyRecordTransaction(records, o)
 		default:
 			return nil, fmt.Errorf("unsupported pre-stats operator: %T", op)
 		}
@@ -81,8 +89,8 @@ func findStreamableCap(ops []querylang.PipeOp) (int, bool) {
 	capIdx := -1
 	for i, op := range ops {
 		switch op.(type) {
-		case *querylang.SortOp:
-			return 0, false // sort before any cap — cannot stream
+		case *querylang.SortOp, *querylang.EventstatsOp, *querylang.TransactionOp:
+			return 0, false // sort, eventstats and transaction need every record — cannot stream
 		case *querylang.TailOp, *querylang.SliceOp:
 			capIdx = i
 		}
@@ -98,7 +106,10 @@ func applyStreamingCap(ctx context.Context, it iter.Seq2[chunk.Record, error], o
 	capOp := ops[capIdx]
 	postOps := ops[capIdx+1:]
 
-	sf := newStreamFilter(ctx, preOps, resolve)
+	sf, err := newStreamFilter(ctx, preOps, resolve)
+	if err != nil {
+		return nil, err
+	}
 
 	// Initialize collector based on cap type.
 	var ring []chunk.Record
@@ -163,14 +174,16 @@ func applyStreamingCap(ctx context.Context, it iter.Seq2[chunk.Record, error], o
 
 // streamFilter applies pre-cap pipeline operators inline per-record.
 type streamFilter struct {
-	ctx       context.Context
-	ops       []querylang.PipeOp
-	filters   map[int]func(chunk.Record) bool // compiled where filters by op index
-	dedups    map[int]*dedupTracker           // dedup state by op index
-	eval      *querylang.Evaluator
-	headLimit int // 0 = no limit
-	survivors int
-	resolve   lookup.Resolver
+	ctx        context.Context
+	ops        []querylang.PipeOp
+	filters    map[int]func(chunk.Record) bool // compiled where filters by op index
+	dedups     map[int]*dedupTracker           // dedup state by op index
+	extractors map[int]*querylang.Extractor    // compiled parse/rex patterns by op index
+	streams    map[int]*streamStats            // streamstats state by op index
+	eval       *querylang.Evaluator
+	headLimit  int // 0 = no limit
+	survivors  int
+	resolve    lookup.Resolver
 }
 
 type dedupTracker struct {
@@ -178,17 +191,31 @@ type dedupTracker struct {
 	window time.Duration
 }
 
-func newStreamFilter(ctx context.Context, ops []querylang.PipeOp, resolve lookup.Resolver) *streamFilter {
+func newStreamFilter(ctx context.Context, ops []querylang.PipeOp, resolve lookup.Resolver) (*streamFilter, error) {
 	sf := &streamFilter{
-		ctx:     ctx,
-		ops:     ops,
-		filters: make(map[int]func(chunk.Record) bool),
-		dedups:  make(map[int]*dedupTracker),
-		eval:    querylang.NewEvaluator(),
-		resolve: resolve,
+		ctx:        ctx,
+		ops:        ops,
+		filters:    make(map[int]func(chunk.Record) bool),
+		dedups:     make(map[int]*dedupTracker),
+		extractors: make(map[int]*querylang.Extractor),
+		streams:    make(map[int]*streamStats),
+		eval:       querylang.NewEvaluator(),
+		resolve:    resolve,
 	}
 	for i, op := range ops {
 		switch o := op.(type) {
+		case *querylang.ParseOp, *querylang.RexOp:
+			x, err := extractorFor(o)
+			if err != nil {
+				return nil, err
+			}
+			sf.extractors[i] = x
+		case *querylang.StreamstatsOp:
+			ss, err := newStreamStats(o)
+			if err != nil {
+				return nil, err
+			}
+			sf.streams[i] = ss
 		case *querylang.WhereOp:
 			sf.filters[i] = CompileFilter(o.Expr)
 		case *querylang.DedupOp:
@@ -197,7 +224,7 @@ func newStreamFilter(ctx context.Context, ops []querylang.PipeOp, resolve lookup
 			sf.headLimit = o.N
 		}
 	}
-	return sf
+	return sf, nil
 }
 
 // apply runs all pre-cap operators on a single record. Returns (keep, error).
@@ -225,6 +252,10 @@ func (sf *streamFilter) apply(rec *chunk.Record) (bool, error) {
 			applyInlineFields(rec, o)
 		case *querylang.LookupOp:
 			applyRecordLookup(sf.ctx, []chunk.Record{*rec}, o, sf.resolve)
+		case *querylang.ParseOp, *querylang.RexOp:
+			extractInto(rec, sf.extractors[i])
+		case *querylang.StreamstatsOp:
+			sf.streams[i].add(rec)
 		case *querylang.HeadOp:
 			// handled via survivors counter
 		}
@@ -330,6 +361,14 @@ func applyBatchOps(ctx context.Context, records []chunk.Record, ops []querylang.
 			applyRecordFields(records, o)
 		case *querylang.LookupOp:
 			applyRecordLookup(ctx, records, o, resolve)
+		case *querylang.ParseOp, *querylang.RexOp:
+			err = applyRecordExtract(records, o)
+		case *querylang.EventstatsOp:
+			err = applyRecordEventstats(records, o)
+		case *querylang.StreamstatsOp:
+			err = applyRecordStreamstats(records, o)
+		case *querylang.TransactionOp:
+			records, err = applyRecordTransaction(records, o)
 		default:
 			return nil, fmt.Errorf("unsupported post-cap operator: %T", op)
 		}
@@ -537,6 +576,16 @@ func applyTableOps(ctx context.Context, table *TableResult, ops []querylang.Pipe
 			applyTableFields(table, o)
 		case *querylang.LookupOp:
 			table = applyTableLookup(ctx, table, o, resolve)
+		case *querylang.ParseOp, *querylang.RexOp:
+			err = applyTableExtract(table, o)
+		case *querylang.EventstatsOp:
+			err = applyTableAggFields(table, o.Aggs, func(records []chunk.Record) error {
+				return applyRecordEventstats(records, o)
+			})
+		case *querylang.StreamstatsOp:
+			err = applyTableAggFields(table, o.Aggs, func(records []chunk.Record) error {
+				return applyRecordStreamstats(records, o)
+			})
 		default:
 			return nil, fmt.Errorf("unsupported post-stats operator: %T", op)
 		}
@@ -689,6 +738,88 @@ func applyTableFields(table *TableResult, op *querylang.FieldsOp) {
 	}
 }
 
+// --- parse / rex operators ---
+
+// extractorFor compiles the pattern of a parse or rex operator.
+func extractorFor(op querylang.PipeOp) (*querylang.Extractor, error) {
+	switch o := op.(type) {
+	case *querylang.ParseOp:
+		return o.Extractor()
+	case *querylang.RexOp:
+		return o.Extractor()
+	default:
+		return nil, fmt.Errorf("not a field extraction operator: %T", op)
+	}
+}
+
+// applyRecordExtract adds the fields captured by a parse or rex operator to
+// each record's Attrs. Records that don't match are left unchanged.
+func applyRecordExtract(records []chunk.Record, op querylang.PipeOp) error {
+	x, err := extractorFor(op)
+	if err != nil {
+		return err
+	}
+	for i := range records {
+		extractInto(&records[i], x)
+	}
+	return nil
+}
+
+// extractInto matches a single record against x.
+func extractInto(rec *chunk.Record, x *querylang.Extractor) {
+	src := string(rec.Raw)
+	if x.Field != "" && x.Field != "raw" {
+		v, ok := rec.Attrs[x.Field]
+		if !ok {
+			return
+		}
+		src = v
+	}
+	x.Extract(src, func(name, value string) {
+		if rec.Attrs == nil {
+			rec.Attrs = make(chunk.Attributes)
+		}
+		rec.Attrs[name] = value
+	})
+}
+
+// applyTableExtract runs a parse or rex operator over a table column,
+// adding a column per extracted field. The source column defaults to "raw"
+// (present after | raw); if it is missing the table is unchanged.
+func applyTableExtract(table *TableResult, op querylang.PipeOp) error {
+	x, err := extractorFor(op)
+	if err != nil {
+		return err
+	}
+	source := x.Field
+	if source == "" {
+		source = "raw"
+	}
+	srcIdx := slices.Index(table.Columns, source)
+	if srcIdx < 0 {
+		return nil
+	}
+
+	colIdx := make(map[string]int)
+	for _, name := range x.Names() {
+		idx := slices.Index(table.Columns, name)
+		if idx < 0 {
+			table.Columns = append(table.Columns, name)
+			idx = len(table.Columns) - 1
+			for i := range table.Rows {
+				table.Rows[i] = append(table.Rows[i], "")
+			}
+		}
+		colIdx[name] = idx
+	}
+	for _, row := range table.Rows {
+		x.Extract(row[srcIdx], func(name, value string) {
+			row[colIdx[name]] = value
+		})
+	}
+	return nil
+}
+
 // --- lookup operators ---
 
 // applyRecordLookup enriches records by looking up field values in a table.
@@ -947,7 +1078,7 @@ func CanStreamPipeline(pipeline *querylang.Pipeline) bool {
 		switch op.(type) {
 		case *querylang.WhereOp, *querylang.EvalOp, *querylang.RenameOp,
 			*querylang.FieldsOp, *querylang.HeadOp, *querylang.LookupOp,
-			*querylang.RawOp:
+			*querylang.ParseOp, *querylang.RexOp, *querylang.RawOp:
 			// streamable
 		default:
 			return false
@@ -976,6 +1107,7 @@ type transformStep struct {
 	lookupT lookup.LookupTable
 	lookupF []string // lookup field names
 	lookupN string   // lookup table name (for parameterized prefix)
+	extract *querylang.Extractor
 }
 
 type stepKind int
@@ -986,6 +1118,7 @@ const (
 	stepRename
 	stepFields
 	stepLookup
+	stepExtract
 )
 
 // NewRecordTransform compiles a sequence of pipeline operators into a
@@ -1016,6 +1149,11 @@ func NewRecordTransform(ops []querylang.PipeOp, resolve lookup.Resolver) *Record
 				lookupF: o.Fields,
 				lookupN: o.Table,
 			})
+		case *querylang.ParseOp, *querylang.RexOp:
+			// Patterns were validated by the parser.
+			if x, err := extractorFor(o); err == nil {
+				rt.steps = append(rt.steps, transformStep{kind: stepExtract, extract: x})
+			}
 		case *querylang.DedupOp:
 			// Dedup is not streamable — handled by batch path only.
 		case *querylang.HeadOp:
@@ -1052,6 +1190,8 @@ func (rt *RecordTransform) Apply(ctx context.Context, rec chunk.Record) (chunk.R
 			s.applyFields(&rec)
 		case stepLookup:
 			s.applyLookup(ctx, &rec)
+		case stepExtract:
+			extractInto(&rec, s.extract)
 		}
 	}
 
@@ -781,3 +781,115 @@ func TestSortBeforeTailFallsBackToMaterialization(t *testing.T) {
 		t.Errorf("expected 5 records, got %d", len(result))
 	}
 }
+
+func TestApplyRecordParse(t *testing.T) {
+	records := []chunk.Record{
+		makeRec(baseTime, chunk.Attributes{"host": "a"}, "login user=alice ip=10.0.0.1"),
+		makeRec(baseTime, nil, "login user=bob ip=10.0.0.2 port=22"),
+		makeRec(baseTime, chunk.Attributes{"host": "c"}, "heartbeat"),
+	}
+	op := &querylang.ParseOp{Pattern: "user=* ip=*", Names: []string{"user", "ip"}}
+
+	if err := applyRecordExtract(records, op); err != nil {
+		t.Fatalf("applyRecordExtract: %v", err)
+	}
+	if records[0].Attrs["user"] != "alice" || records[0].Attrs["ip"] != "10.0.0.1" {
+		t.Errorf("record 0 attrs = %v", records[0].Attrs)
+	}
+	if records[1].Attrs["user"] != "bob" || records[1].Attrs["ip"] != "10.0.0.2" {
+		t.Errorf("record 1 attrs = %v", records[1].Attrs)
+	}
+	// Non-matching records pass through unchanged.
+	if len(records[2].Attrs) != 1 {
+		t.Errorf("record 2 attrs = %v, want only host", records[2].Attrs)
+	}
+}
+
+func TestApplyRecordRexFromField(t *testing.T) {
+	records := []chunk.Record{
+		makeRec(baseTime, chunk.Attributes{"msg": "GET /api/users 200"}, "raw POST /ignored 500"),
+		makeRec(baseTime, nil, "GET /no-msg 404"),
+	}
+	op := &querylang.RexOp{Field: "msg", Pattern: `(?P<method>[A-Z]+) (?P<path>\S+) (?P<status>\d+)`}
+
+	if err := applyRecordExtract(records, op); err != nil {
+		t.Fatalf("applyRecordExtract: %v", err)
+	}
+	if records[0].Attrs["method"] != "GET" || records[0].Attrs["path"] != "/api/users" || records[0].Attrs["status"] != "200" {
+		t.Errorf("record 0 attrs = %v", records[0].Attrs)
+	}
+	// Records without the source field are left alone.
+	if records[1].Attrs["method"] != "" {
+		t.Errorf("record 1 should not be extracted, got %v", records[1].Attrs)
+	}
+}
+
+func TestApplyTableRex(t *testing.T) {
+	table := &TableResult{
+		Columns: []string{"path", "count"},
+		Rows: [][]string{
+			{"/api/users", "10"},
+			{"/static/app.js", "4"},
+			{"", "1"},
+		},
+	}
+	op := &querylang.RexOp{Field: "path", Pattern: `^/(?P<section>[^/]+)`}
+
+	if err := applyTableExtract(table, op); err != nil {
+		t.Fatalf("applyTableExtract: %v", err)
+	}
+	if len(table.Columns) != 3 || table.Columns[2] != "section" {
+		t.Fatalf("columns = %v, want [path count section]", table.Columns)
+	}
+	want := []string{"api", "static", ""}
+	for i, row := range table.Rows {
+		if row[2] != want[i] {
+			t.Errorf("row %d section = %q, want %q", i, row[2], want[i])
+		}
+	}
+}
+
+func TestParseThenWhereSort(t *testing.T) {
+	records := []chunk.Record{
+		makeRec(baseTime, nil, "req took=250 path=/a"),
+		makeRec(baseTime, nil, "req took=40 path=/b"),
+		makeRec(baseTime, nil, "healthcheck"),
+		makeRec(baseTime, nil, "req took=900 path=/c"),
+	}
+	pipeline, err := querylang.ParsePipeline(`| parse "took=* path=*" as took, path | where took=* | sort -took`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := applyRecordOps(context.Background(), recordIter(records), pipeline.Pipes, nil)
+	if err != nil {
+		t.Fatalf("applyRecordOps: %v", err)
+	}
+	if len(result) != 3 {
+		t.Fatalf("expected 3 records, got %d", len(result))
+	}
+	for i, want := range []string{"/c", "/a", "/b"} {
+		if got := result[i].Attrs["path"]; got != want {
+			t.Errorf("record %d path = %q, want %q", i, got, want)
+		}
+	}
+}
+
+func TestRecordTransformExtract(t *testing.T) {
+	pipeline, err := querylang.ParsePipeline(`| rex /status=(?P<status>\d+)/ | where status=500`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt := NewRecordTransform(pipeline.Pipes, nil)
+
+	if _, ok := rt.Apply(context.Background(), makeRec(baseTime, nil, "GET / status=200")); ok {
+		t.Error("status=200 record should be filtered out")
+	}
+	rec, ok := rt.Apply(context.Background(), makeRec(baseTime, nil, "GET / status=500"))
+	if !ok {
+		t.Fatal("status=500 record should pass")
+	}
+	if rec.Attrs["status"] != "500" {
+		t.Errorf("status = %q, want 500", rec.Attrs["status"])
+	}
+}
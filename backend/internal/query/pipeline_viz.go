@@ -40,7 +40,7 @@ func ValidateVizOp(op querylang.PipeOp, table *TableResult) string {
 
 // validateBarchart checks: ≥2 columns, ≥2 rows, last column parseable as float.
 func validateBarchart(table *TableResult) bool {
-	if len(table.Columns) < 2 || len(table.Rows) < 2 {
+	if chartColumnCount(table) < 2 || len(table.Rows) < 2 {
 		return false
 	}
 	return lastColumnNumeric(table)
@@ -48,7 +48,7 @@ func validateBarchart(table *TableResult) bool {
 
 // validateDonut checks: exactly 2 columns, ≥2 rows, last column numeric.
 func validateDonut(table *TableResult) bool {
-	if len(table.Columns) != 2 || len(table.Rows) < 2 {
+	if chartColumnCount(table) != 2 || len(table.Rows) < 2 {
 		return false
 	}
 	return lastColumnNumeric(table)
@@ -68,6 +68,13 @@ func validateHeatmap(table *TableResult) bool {
 const maxAutoDonutRows = 12
 
 func AutoDetectVizType(table *TableResult) string {
+	// Auto top/rare: a donut for a single key with few rows, otherwise bars.
+	if isTopTable(table) && len(table.Rows) >= 2 && lastColumnNumeric(table) {
+		if chartColumnCount(table) == 2 && len(table.Rows) <= maxAutoDonutRows {
+			return "donut"
+		}
+		return "barchart"
+	}
 	// Auto-donut: exactly 2 columns, 2–12 rows, last column numeric.
 	if len(table.Columns) == 2 && len(table.Rows) >= 2 && len(table.Rows) <= maxAutoDonutRows {
 		if lastColumnNumeric(table) {
@@ -86,6 +93,22 @@ func AutoDetectVizType(table *TableResult) string {
 	return ""
 }
 
+// isTopTable reports whether the table has the shape top and rare produce:
+// key columns followed by count and percent.
+func isTopTable(table *TableResult) bool {
+	n := len(table.Columns)
+	return n >= 3 && table.Columns[n-2] == "count" && table.Columns[n-1] == "percent"
+}
+
+// chartColumnCount returns the number of columns bar and donut charts plot.
+// They drop the percent column of top/rare tables and chart the count.
+func chartColumnCount(table *TableResult) int {
+	if isTopTable(table) {
+		return len(table.Columns) - 1
+	}
+	return len(table.Columns)
+}
+
 // distinctCount returns the number of distinct values in the given column index.
 func distinctCount(table *TableResult, col int) int {
 	seen := make(map[string]struct{}, len(table.Rows))
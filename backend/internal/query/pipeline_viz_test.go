@@ -1,6 +1,7 @@
 package query
 
 import (
+	"strconv"
 	"testing"
 
 	"gastrolog/internal/querylang"
@@ -70,6 +71,17 @@ func TestValidateBarchart(t *testing.T) {
 	}
 }
 
+func TestValidateDonutTopTable(t *testing.T) {
+	t.Parallel()
+	table := &TableResult{
+		Columns: []string{"host", "count", "percent"},
+		Rows:    [][]string{{"a", "3", "75.00"}, {"b", "1", "25.00"}},
+	}
+	if !validateDonut(table) {
+		t.Error("top table with one key should validate as donut")
+	}
+}
+
 func TestValidateDonut(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -495,6 +507,36 @@ func TestAutoDetectVizType(t *testing.T) {
 			},
 			wantType: "donut",
 		},
+		{
+			name: "auto donut: top with one field",
+			table: &TableResult{
+				Columns: []string{"host", "count", "percent"},
+				Rows:    [][]string{{"a", "3", "75.00"}, {"b", "1", "25.00"}},
+			},
+			wantType: "donut",
+		},
+		{
+			name: "auto barchart: top with two fields",
+			table: &TableResult{
+				Columns: []string{"host", "status", "count", "percent"},
+				Rows:    [][]string{{"a", "200", "3", "60.00"}, {"a", "500", "1", "20.00"}, {"b", "200", "1", "20.00"}},
+			},
+			wantType: "barchart",
+		},
+		{
+			name: "auto barchart: top with many rows",
+			table: &TableResult{
+				Columns: []string{"path", "count", "percent"},
+				Rows: func() [][]string {
+					rows := make([][]string, 20)
+					for i := range rows {
+						rows[i] = []string{strconv.Itoa(i), "1", "5.00"}
+					}
+					return rows
+				}(),
+			},
+			wantType: "barchart",
+		},
 		{
 			name: "no auto donut: 3 cols",
 			table: &TableResult{
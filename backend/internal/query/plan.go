@@ -70,6 +70,7 @@ func (e *Engine) Explain(ctx context.Context, q Query) (*QueryPlan, error) {
 	if selectedVaults == nil {
 		selectedVaults = allVaults
 	}
+	selectedVaults = VaultAccessFromContext(ctx).Restrict(selectedVaults)
 
 	// Extract chunk predicates.
 	chunkIDs, remainingExpr := ExtractChunkFilter(remainingExpr)
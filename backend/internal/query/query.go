@@ -310,6 +310,10 @@ var ErrInvalidResumeToken = errors.New("invalid resume token: chunk no longer ex
 // ErrMultiVaultNotSupported is returned when an operation doesn't support multi-vault mode.
 var ErrMultiVaultNotSupported = errors.New("operation not supported in multi-vault mode")
 
+// ErrVaultAccessDenied is returned when the context's VaultAccess does not
+// permit reading the requested vault.
+var ErrVaultAccessDenied = errors.New("vault access denied")
+
 // recordWithRef combines a record with its reference for internal iteration.
 // VaultID is included for multi-vault queries.
 type recordWithRef struct {
@@ -553,6 +553,7 @@ func (e *Engine) Search(ctx context.Context, q Query, resume *ResumeToken) (iter
 	if selectedVaults == nil {
 		selectedVaults = allVaults // no vault filter means all vaults
 	}
+	selectedVaults = VaultAccessFromContext(ctx).Restrict(selectedVaults)
 
 	// Update query to use remaining expression (without vault/chunk predicates).
 	q.BoolExpr = remainingExpr
@@ -654,6 +655,7 @@ func (e *Engine) SearchThenFollow(ctx context.Context, q Query, resume *ResumeTo
 	if selectedVaults == nil {
 		selectedVaults = allVaults
 	}
+	selectedVaults = VaultAccessFromContext(ctx).Restrict(selectedVaults)
 
 	// Extract chunk predicates.
 	chunkIDs, remainingExpr := ExtractChunkFilter(remainingExpr)
@@ -866,6 +868,7 @@ func (e *Engine) Follow(ctx context.Context, q Query) iter.Seq2[chunk.Record, er
 			engine:        e,
 			q:             q,
 			vaultFilter:   vaultFilter,
+			access:        VaultAccessFromContext(ctx),
 			lastPositions: make(map[mergeKey]uint64),
 			knownVaults:   make(map[glid.GLID]bool),
 		}
@@ -882,6 +885,7 @@ type followState struct {
 	engine        *Engine
 	q             Query
 	vaultFilter   []glid.GLID
+	access        *VaultAccess // nil when unrestricted
 	lastPositions map[mergeKey]uint64
 	knownVaults   map[glid.GLID]bool
 }
@@ -936,6 +940,7 @@ func (fs *followState) resolveVaults() []glid.GLID {
 	if vaults == nil {
 		vaults = fs.engine.listVaults()
 	}
+	vaults = fs.access.Restrict(vaults)
 	for _, id := range vaults {
 		if !fs.knownVaults[id] {
 			fs.initVaultPositions(id)
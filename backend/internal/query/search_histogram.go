@@ -26,7 +26,7 @@ const histogramGroupField = "level"
 // reflects the filtered result set.
 func (e *Engine) ComputeHistogram(ctx context.Context, q Query, numBuckets int) []HistogramBucket {
 	numBuckets = clampBuckets(numBuckets)
-	selectedVaults := e.timechartVaults(q)
+	selectedVaults := e.timechartVaults(ctx, q)
 
 	if q.Start.IsZero() || q.End.IsZero() {
 		e.deriveTimeRange(&q, selectedVaults)
@@ -68,6 +68,7 @@ func (e *Engine) ComputeHistogram(ctx context.Context, q Query, numBuckets int)
 // Used by the forward search handler to compute a per-node histogram.
 func (e *Engine) ComputeHistogramForVaults(ctx context.Context, q Query, numBuckets int, vaultIDs []glid.GLID) []HistogramBucket {
 	numBuckets = clampBuckets(numBuckets)
+	vaultIDs = VaultAccessFromContext(ctx).Restrict(vaultIDs)
 
 	if q.Start.IsZero() || q.End.IsZero() {
 		e.deriveTimeRange(&q, vaultIDs)
@@ -0,0 +1,351 @@
+package query
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"math"
+	"slices"
+)
+
+// Quantile sketch tuning.
+const (
+	// sketchExactLimit is how many values are kept verbatim before switching
+	// to buckets. Small groups get exact, interpolated quantiles.
+	sketchExactLimit = 256
+
+	// sketchRelativeAccuracy bounds the relative error of a bucketed quantile.
+	sketchRelativeAccuracy = 0.01
+
+	// sketchMaxBuckets caps the buckets per sign. Beyond it the buckets
+	// closest to zero are collapsed, trading accuracy on tiny values for
+	// bounded memory.
+	sketchMaxBuckets = 2048
+
+	// sketchMinIndexable is the smallest magnitude given its own bucket;
+	// anything closer to zero is counted as zero.
+	sketchMinIndexable = 1e-9
+)
+
+var (
+	sketchGamma    = (1 + sketchRelativeAccuracy) / (1 - sketchRelativeAccuracy)
+	sketchLogGamma = math.Log(sketchGamma)
+)
+
+// quantileSketch estimates quantiles of a stream of numbers in bounded
+// memory. It is a DDSketch (Masson, Rim & Lee, VLDB 2019): values fall into
+// logarithmically sized buckets, so every quantile is within
+// sketchRelativeAccuracy of the true value. Two sketches merge without loss,
+// which lets cluster nodes ship partial percentiles instead of raw values.
+//
+// The first sketchExactLimit values are buffered as-is and answered exactly.
+type quantileSketch struct {
+	exact    []float64 // raw values while count <= sketchExactLimit
+	bucketed bool
+
+	count    uint64
+	zero     uint64
+	pos, neg map[int32]uint64 // bucket index → count; neg holds magnitudes
+	min, max float64
+}
+
+// Add records v. NaN and infinities are ignored.
+func (s *quantileSketch) Add(v float64) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return
+	}
+	if !s.bucketed {
+		s.exact = append(s.exact, v)
+		if len(s.exact) > sketchExactLimit {
+			s.toBuckets()
+		}
+		return
+	}
+	s.insert(v, 1)
+}
+
+// Count returns the number of values recorded.
+func (s *quantileSketch) Count() uint64 {
+	if !s.bucketed {
+		return uint64(len(s.exact))
+	}
+	return s.count
+}
+
+// Quantile returns the q-quantile (0 ≤ q ≤ 1), or false if the sketch is empty.
+func (s *quantileSketch) Quantile(q float64) (float64, bool) {
+	if s.Count() == 0 {
+		return 0, false
+	}
+	q = min(max(q, 0), 1)
+	if !s.bucketed {
+		slices.Sort(s.exact)
+		rank := q * float64(len(s.exact)-1)
+		lo := int(math.Floor(rank))
+		hi := int(math.Ceil(rank))
+		frac := rank - float64(lo)
+		return s.exact[lo] + (s.exact[hi]-s.exact[lo])*frac, true
+	}
+
+	switch q {
+	case 0:
+		return s.min, true
+	case 1:
+		return s.max, true
+	}
+	rank := q * float64(s.count-1)
+	var seen float64
+	// Most negative first: negative buckets by descending magnitude.
+	for _, idx := range sortedKeys(s.neg, true) {
+		seen += float64(s.neg[idx])
+		if seen > rank {
+			return s.clamp(-bucketValue(idx)), true
+		}
+	}
+	seen += float64(s.zero)
+	if seen > rank {
+		return s.clamp(0), true
+	}
+	for _, idx := range sortedKeys(s.pos, false) {
+		seen += float64(s.pos[idx])
+		if seen > rank {
+			return s.clamp(bucketValue(idx)), true
+		}
+	}
+	return s.max, true
+}
+
+// Merge folds o into s.
+func (s *quantileSketch) Merge(o *quantileSketch) {
+	if o.Count() == 0 {
+		return
+	}
+	if !o.bucketed {
+		for _, v := range o.exact {
+			s.Add(v)
+		}
+		return
+	}
+	if !s.bucketed {
+		s.toBuckets()
+	}
+	s.count += o.count
+	s.zero += o.zero
+	for idx, c := range o.pos {
+		s.pos[idx] += c
+	}
+	for idx, c := range o.neg {
+		s.neg[idx] += c
+	}
+	s.min = min(s.min, o.min)
+	s.max = max(s.max, o.max)
+	s.collapse()
+}
+
+// toBuckets moves the exact buffer into buckets.
+func (s *quantileSketch) toBuckets() {
+	s.bucketed = true
+	s.pos = make(map[int32]uint64)
+	s.neg = make(map[int32]uint64)
+	s.min, s.max = math.Inf(1), math.Inf(-1)
+	for _, v := range s.exact {
+		s.insert(v, 1)
+	}
+	s.exact = nil
+}
+
+func (s *quantileSketch) insert(v float64, n uint64) {
+	s.count += n
+	s.min = min(s.min, v)
+	s.max = max(s.max, v)
+	switch {
+	case v >= sketchMinIndexable:
+		s.pos[bucketIndex(v)] += n
+	case v <= -sketchMinIndexable:
+		s.neg[bucketIndex(-v)] += n
+	default:
+		s.zero += n
+	}
+	s.collapse()
+}
+
+// collapse folds the buckets nearest zero together once a sign exceeds
+// sketchMaxBuckets.
+func (s *quantileSketch) collapse() {
+	for _, m := range []map[int32]uint64{s.pos, s.neg} {
+		if len(m) <= sketchMaxBuckets {
+			continue
+		}
+		keys := sortedKeys(m, false)
+		excess := keys[:len(keys)-sketchMaxBuckets]
+		into := keys[len(excess)]
+		for _, idx := range excess {
+			m[into] += m[idx]
+			delete(m, idx)
+		}
+	}
+}
+
+func (s *quantileSketch) clamp(v float64) float64 {
+	return min(max(v, s.min), s.max)
+}
+
+// bucketIndex returns i such that γ^(i-1) < v ≤ γ^i.
+func bucketIndex(v float64) int32 {
+	return int32(math.Ceil(math.Log(v) / sketchLogGamma))
+}
+
+// bucketValue is the estimate for bucket i, within the relative accuracy
+// of every value in it.
+func bucketValue(i int32) float64 {
+	return 2 * math.Pow(sketchGamma, float64(i)) / (sketchGamma + 1)
+}
+
+func sortedKeys(m map[int32]uint64, desc bool) []int32 {
+	keys := make([]int32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	if desc {
+		slices.Reverse(keys)
+	}
+	return keys
+}
+
+// Sketch wire format (base64, no padding):
+//
+//	'e' uvarint(n) n×float64          exact values
+//	'b' uvarint(count) uvarint(zero) float64(min) float64(max)
+//	    uvarint(len pos) {varint(idx) uvarint(count)}…
+//	    uvarint(len neg) {varint(idx) uvarint(count)}…
+const (
+	sketchTagExact   = 'e'
+	sketchTagBuckets = 'b'
+)
+
+var errBadSketch = errors.New("malformed quantile sketch")
+
+// Encode serializes the sketch for shipping between nodes.
+func (s *quantileSketch) Encode() string {
+	var buf []byte
+	if !s.bucketed {
+		buf = append(buf, sketchTagExact)
+		buf = binary.AppendUvarint(buf, uint64(len(s.exact)))
+		for _, v := range s.exact {
+			buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(v))
+		}
+		return base64.RawStdEncoding.EncodeToString(buf)
+	}
+	buf = append(buf, sketchTagBuckets)
+	buf = binary.AppendUvarint(buf, s.count)
+	buf = binary.AppendUvarint(buf, s.zero)
+	buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(s.min))
+	buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(s.max))
+	for _, m := range []map[int32]uint64{s.pos, s.neg} {
+		buf = binary.AppendUvarint(buf, uint64(len(m)))
+		for _, idx := range sortedKeys(m, false) {
+			buf = binary.AppendVarint(buf, int64(idx))
+			buf = binary.AppendUvarint(buf, m[idx])
+		}
+	}
+	return base64.RawStdEncoding.EncodeToString(buf)
+}
+
+// decodeSketch parses a sketch produced by Encode.
+func decodeSketch(enc string) (*quantileSketch, error) {
+	buf, err := base64.RawStdEncoding.DecodeString(enc)
+	if err != nil || len(buf) == 0 {
+		return nil, errBadSketch
+	}
+	r := sketchReader{buf: buf[1:]}
+	s := &quantileSketch{}
+	switch buf[0] {
+	case sketchTagExact:
+		n := r.uvarint()
+		if n > sketchExactLimit {
+			return nil, errBadSketch
+		}
+		s.exact = make([]float64, 0, n)
+		for range n {
+			s.exact = append(s.exact, r.float())
+		}
+	case sketchTagBuckets:
+		s.bucketed = true
+		s.count = r.uvarint()
+		s.zero = r.uvarint()
+		s.min = r.float()
+		s.max = r.float()
+		s.pos = r.buckets()
+		s.neg = r.buckets()
+	default:
+		return nil, errBadSketch
+	}
+	if r.err != nil || len(r.buf) != 0 {
+		return nil, errBadSketch
+	}
+	return s, nil
+}
+
+// sketchReader decodes the sketch wire format, remembering the first error.
+type sketchReader struct {
+	buf []byte
+	err error
+}
+
+func (r *sketchReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.buf)
+	if n <= 0 {
+		r.err = errBadSketch
+		return 0
+	}
+	r.buf = r.buf[n:]
+	return v
+}
+
+func (r *sketchReader) varint() int64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(r.buf)
+	if n <= 0 {
+		r.err = errBadSketch
+		return 0
+	}
+	r.buf = r.buf[n:]
+	return v
+}
+
+func (r *sketchReader) float() float64 {
+	if r.err != nil {
+		return 0
+	}
+	if len(r.buf) < 8 {
+		r.err = errBadSketch
+		return 0
+	}
+	v := math.Float64frombits(binary.LittleEndian.Uint64(r.buf))
+	r.buf = r.buf[8:]
+	return v
+}
+
+func (r *sketchReader) buckets() map[int32]uint64 {
+	n := r.uvarint()
+	if n > uint64(len(r.buf)) { // each bucket takes at least two bytes
+		r.err = errBadSketch
+		return nil
+	}
+	m := make(map[int32]uint64, n)
+	for range n {
+		idx := r.varint()
+		if idx < math.MinInt32 || idx > math.MaxInt32 {
+			r.err = errBadSketch
+			return nil
+		}
+		m[int32(idx)] += r.uvarint()
+	}
+	return m
+}
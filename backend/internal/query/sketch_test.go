@@ -0,0 +1,139 @@
+package query
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func exactQuantile(sorted []float64, q float64) float64 {
+	return sorted[int(q*float64(len(sorted)-1))]
+}
+
+func TestQuantileSketchExact(t *testing.T) {
+	var s quantileSketch
+	for _, v := range []float64{40, 10, 30, 20} {
+		s.Add(v)
+	}
+	if got, _ := s.Quantile(0.5); got != 25 {
+		t.Errorf("median = %v, want 25", got)
+	}
+	if got, _ := s.Quantile(0); got != 10 {
+		t.Errorf("q0 = %v, want 10", got)
+	}
+	if got, _ := s.Quantile(1); got != 40 {
+		t.Errorf("q1 = %v, want 40", got)
+	}
+
+	var empty quantileSketch
+	if _, ok := empty.Quantile(0.5); ok {
+		t.Error("expected no quantile from an empty sketch")
+	}
+}
+
+func TestQuantileSketchRelativeAccuracy(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	var s quantileSketch
+	vals := make([]float64, 50000)
+	for i := range vals {
+		vals[i] = math.Exp(r.NormFloat64()*2) * 100 // log-normal latencies
+		s.Add(vals[i])
+	}
+	slices.Sort(vals)
+
+	for _, q := range []float64{0.01, 0.5, 0.9, 0.95, 0.99, 0.999} {
+		got, _ := s.Quantile(q)
+		want := exactQuantile(vals, q)
+		if rel := math.Abs(got-want) / want; rel > sketchRelativeAccuracy+1e-9 {
+			t.Errorf("q%v = %v, want %v (relative error %.4f)", q, got, want, rel)
+		}
+	}
+}
+
+func TestQuantileSketchNegativeAndZero(t *testing.T) {
+	var s quantileSketch
+	for i := -500; i <= 500; i++ {
+		s.Add(float64(i))
+	}
+	if got, _ := s.Quantile(0.5); got != 0 {
+		t.Errorf("median = %v, want 0", got)
+	}
+	if got, _ := s.Quantile(0); got != -500 {
+		t.Errorf("q0 = %v, want -500", got)
+	}
+	if got, _ := s.Quantile(0.25); math.Abs(got+250)/250 > sketchRelativeAccuracy {
+		t.Errorf("q25 = %v, want ≈ -250", got)
+	}
+}
+
+func TestQuantileSketchMerge(t *testing.T) {
+	var a, b, all quantileSketch
+	for i := 1; i <= 3000; i++ {
+		v := float64(i)
+		all.Add(v)
+		if i%3 == 0 {
+			a.Add(v)
+		} else {
+			b.Add(v)
+		}
+	}
+	// Small sketches stay exact through the merge.
+	var small quantileSketch
+	small.Add(5000)
+
+	a.Merge(&b)
+	a.Merge(&small)
+	all.Add(5000)
+
+	for _, q := range []float64{0.1, 0.5, 0.99} {
+		got, _ := a.Quantile(q)
+		want, _ := all.Quantile(q)
+		if got != want {
+			t.Errorf("merged q%v = %v, single sketch = %v", q, got, want)
+		}
+	}
+	if a.Count() != 3001 {
+		t.Errorf("count = %d, want 3001", a.Count())
+	}
+}
+
+func TestQuantileSketchEncodeRoundTrip(t *testing.T) {
+	for _, n := range []int{1, 100, 10000} {
+		var s quantileSketch
+		for i := range n {
+			s.Add(float64(i) - 10)
+		}
+		got, err := decodeSketch(s.Encode())
+		if err != nil {
+			t.Fatalf("n=%d: decode: %v", n, err)
+		}
+		for _, q := range []float64{0, 0.5, 0.95, 1} {
+			want, _ := s.Quantile(q)
+			if v, _ := got.Quantile(q); v != want {
+				t.Errorf("n=%d q%v = %v after round trip, want %v", n, q, v, want)
+			}
+		}
+	}
+
+	for _, bad := range []string{"", "!!", "eA", "YgAA"} {
+		if _, err := decodeSketch(bad); err == nil {
+			t.Errorf("decodeSketch(%q) expected error", bad)
+		}
+	}
+}
+
+func TestQuantileSketchBucketCap(t *testing.T) {
+	var s quantileSketch
+	for i := range 200000 {
+		s.Add(math.Pow(1.001, float64(i%20000)) * 1e-6)
+	}
+	if len(s.pos) > sketchMaxBuckets {
+		t.Errorf("buckets = %d, want ≤ %d", len(s.pos), sketchMaxBuckets)
+	}
+	// High quantiles are unaffected by collapsing the low buckets.
+	want := math.Pow(1.001, 19800) * 1e-6
+	if got, _ := s.Quantile(0.99); math.Abs(got-want)/want > sketchRelativeAccuracy {
+		t.Errorf("q99 = %v, want ≈ %v", got, want)
+	}
+}
@@ -0,0 +1,119 @@
+package query
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+
+	"gastrolog/internal/querylang"
+)
+
+// top and rare are computed as "stats count by <by fields>, <key fields>"
+// so they share the aggregation, cardinality cap and cluster merging of
+// stats. finishTop then ranks, caps and annotates the counted table.
+
+// topOtherLabel is the key shown on the showother=true row.
+const topOtherLabel = "other"
+
+// topStats returns the stats operator a top or rare operator counts with.
+func topStats(op *querylang.TopOp) *querylang.StatsOp {
+	names := slices.Concat(op.By, op.Fields)
+	groups := make([]querylang.GroupExpr, len(names))
+	for i, name := range names {
+		groups[i] = querylang.GroupExpr{Field: &querylang.FieldRef{Name: name}}
+	}
+	return &querylang.StatsOp{
+		Aggs:   []querylang.AggExpr{{Func: "count"}},
+		Groups: groups,
+	}
+}
+
+// topGroup collects the counted keys of one by-group.
+type topGroup struct {
+	by      []string
+	entries []topEntry
+	total   int64
+}
+
+type topEntry struct {
+	key   []string
+	count int64
+}
+
+// finishTop converts the table produced by topStats into top or rare output:
+// per by-group, the N most (or least) common keys with their count and their
+// percentage of the group's total. Records missing any key field are not
+// counted.
+func finishTop(table *TableResult, op *querylang.TopOp) *TableResult {
+	nb, nk := len(op.By), len(op.Fields)
+
+	var order []string
+	groups := make(map[string]*topGroup)
+	for _, row := range table.Rows {
+		key := row[nb : nb+nk]
+		if slices.Contains(key, "") {
+			continue
+		}
+		count, err := strconv.ParseInt(row[nb+nk], 10, 64)
+		if err != nil {
+			continue
+		}
+		gk := makeGroupKey(row[:nb])
+		g := groups[gk]
+		if g == nil {
+			g = &topGroup{by: row[:nb]}
+			groups[gk] = g
+			order = append(order, gk)
+		}
+		g.entries = append(g.entries, topEntry{key: key, count: count})
+		g.total += count
+	}
+
+	columns := make([]string, 0, nb+nk+2)
+	columns = append(columns, op.By...)
+	columns = append(columns, op.Fields...)
+	columns = append(columns, "count", "percent")
+
+	var rows [][]string
+	for _, gk := range order {
+		g := groups[gk]
+		slices.SortFunc(g.entries, func(a, b topEntry) int {
+			c := cmp.Compare(b.count, a.count)
+			if op.Rare {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+			return slices.Compare(a.key, b.key)
+		})
+
+		shown := g.entries[:min(op.N, len(g.entries))]
+		for _, e := range shown {
+			rows = append(rows, topRow(g, e.key, e.count))
+		}
+		if op.ShowOther && len(shown) < len(g.entries) {
+			var other int64
+			for _, e := range g.entries[len(shown):] {
+				other += e.count
+			}
+			key := make([]string, nk)
+			key[0] = topOtherLabel
+			rows = append(rows, topRow(g, key, other))
+		}
+	}
+
+	return &TableResult{
+		Columns:   columns,
+		Rows:      rows,
+		Truncated: table.Truncated,
+	}
+}
+
+func topRow(g *topGroup, key []string, count int64) []string {
+	row := make([]string, 0, len(g.by)+len(key)+2)
+	row = append(row, g.by...)
+	row = append(row, key...)
+	pct := 100 * float64(count) / float64(g.total)
+	return append(row, strconv.FormatInt(count, 10), strconv.FormatFloat(pct, 'f', 2, 64))
+}
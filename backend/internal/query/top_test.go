@@ -0,0 +1,109 @@
+package query
+
+import (
+	"slices"
+	"testing"
+
+	"gastrolog/internal/chunk"
+	"gastrolog/internal/querylang"
+)
+
+func runTop(t *testing.T, expr string, records []chunk.Record) *TableResult {
+	t.Helper()
+	op := mustParsePipes(t, expr)[0].(*querylang.TopOp)
+	agg, err := NewAggregator(topStats(op))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rec := range records {
+		if err := agg.Add(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return finishTop(agg.Result(baseTime, baseTime), op)
+}
+
+func hostRecords(hosts ...string) []chunk.Record {
+	records := make([]chunk.Record, len(hosts))
+	for i, h := range hosts {
+		attrs := chunk.Attributes{"svc": "api"}
+		if h != "" {
+			attrs["host"] = h
+		}
+		if i%2 == 1 {
+			attrs["svc"] = "db"
+		}
+		records[i] = makeRec(baseTime, attrs, "x")
+	}
+	return records
+}
+
+func assertTable(t *testing.T, got *TableResult, columns []string, rows [][]string) {
+	t.Helper()
+	if !slices.Equal(got.Columns, columns) {
+		t.Fatalf("columns = %v, want %v", got.Columns, columns)
+	}
+	if len(got.Rows) != len(rows) {
+		t.Fatalf("rows = %v, want %v", got.Rows, rows)
+	}
+	for i, row := range rows {
+		if !slices.Equal(got.Rows[i], row) {
+			t.Errorf("row %d = %v, want %v", i, got.Rows[i], row)
+		}
+	}
+}
+
+func TestTop(t *testing.T) {
+	records := hostRecords("a", "b", "a", "c", "a", "b", "", "d")
+	got := runTop(t, "| top 2 host", records)
+	assertTable(t, got, []string{"host", "count", "percent"}, [][]string{
+		{"a", "3", "42.86"},
+		{"b", "2", "28.57"},
+	})
+}
+
+func TestTopShowOther(t *testing.T) {
+	records := hostRecords("a", "b", "a", "c", "a", "b", "d")
+	got := runTop(t, "| top 2 host showother=true", records)
+	assertTable(t, got, []string{"host", "count", "percent"}, [][]string{
+		{"a", "3", "42.86"},
+		{"b", "2", "28.57"},
+		{"other", "2", "28.57"},
+	})
+}
+
+func TestRare(t *testing.T) {
+	records := hostRecords("a", "b", "a", "c", "a", "b")
+	got := runTop(t, "| rare 2 host", records)
+	assertTable(t, got, []string{"host", "count", "percent"}, [][]string{
+		{"c", "1", "16.67"},
+		{"b", "2", "33.33"},
+	})
+}
+
+func TestTopBy(t *testing.T) {
+	// Even indexes are svc=api, odd are svc=db.
+	records := hostRecords("a", "x", "a", "x", "b", "y")
+	got := runTop(t, "| top 1 host by svc showother=true", records)
+	assertTable(t, got, []string{"svc", "host", "count", "percent"}, [][]string{
+		{"api", "a", "2", "66.67"},
+		{"api", "other", "1", "33.33"},
+		{"db", "x", "2", "66.67"},
+		{"db", "other", "1", "33.33"},
+	})
+}
+
+func TestTopMultiField(t *testing.T) {
+	records := []chunk.Record{
+		makeRec(baseTime, chunk.Attributes{"host": "a", "status": "200"}, "1"),
+		makeRec(baseTime, chunk.Attributes{"host": "a", "status": "500"}, "2"),
+		makeRec(baseTime, chunk.Attributes{"host": "a", "status": "200"}, "3"),
+		makeRec(baseTime, chunk.Attributes{"host": "b", "status": "200"}, "4"),
+	}
+	got := runTop(t, "| top host, status", records)
+	assertTable(t, got, []string{"host", "status", "count", "percent"}, [][]string{
+		{"a", "200", "2", "50.00"},
+		{"a", "500", "1", "25.00"},
+		{"b", "200", "1", "25.00"},
+	})
+}
@@ -0,0 +1,240 @@
+package query
+
+import (
+	"bytes"
+	"cmp"
+	"container/list"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"gastrolog/internal/chunk"
+	"gastrolog/internal/querylang"
+)
+
+const (
+	// defaultTransactionMaxOpen bounds the number of transactions held open
+	// at once. When exceeded, the least recently active one is closed early
+	// and marked evicted, so high-cardinality keys can't exhaust memory.
+	defaultTransactionMaxOpen = 10_000
+
+	// defaultTransactionMaxEvents bounds the events in one transaction.
+	defaultTransactionMaxEvents = 1_000
+)
+
+// applyRecordTransaction groups records into transactions and returns one
+// record per transaction, ordered by start time in the direction the input
+// was in.
+func applyRecordTransaction(records []chunk.Record, op *querylang.TransactionOp) ([]chunk.Record, error) {
+	t, err := newTransactionizer(op)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := len(records) > 1 && records[0].WriteTS.After(records[len(records)-1].WriteTS)
+	slices.SortStableFunc(records, func(a, b chunk.Record) int {
+		return a.WriteTS.Compare(b.WriteTS)
+	})
+	for _, rec := range records {
+		t.add(rec)
+	}
+
+	out := t.finish()
+	slices.SortStableFunc(out, func(a, b chunk.Record) int {
+		if desc {
+			return b.WriteTS.Compare(a.WriteTS)
+		}
+		return a.WriteTS.Compare(b.WriteTS)
+	})
+	return out, nil
+}
+
+// transactionizer assigns records, fed in time order, to open transactions
+// keyed on the transaction fields. Open transactions are kept in an LRU list
+// so idle and excess ones can be closed from the back.
+type transactionizer struct {
+	fields     []string
+	maxSpan    time.Duration
+	maxPause   time.Duration
+	startsWith func(chunk.Record) bool // nil = any record may start a transaction
+	endsWith   func(chunk.Record) bool // nil = transactions end only on limits
+	maxOpen    int
+	maxEvents  int
+
+	open map[string]*list.Element // key → element holding *openTxn
+	lru  *list.List               // front = most recently active
+	out  []chunk.Record
+}
+
+// openTxn accumulates one transaction's events.
+type openTxn struct {
+	key   string
+	first chunk.Record
+	last  time.Time
+	attrs chunk.Attributes // merged event attributes; first value wins
+	raws  [][]byte
+	count int
+}
+
+func newTransactionizer(op *querylang.TransactionOp) (*transactionizer, error) {
+	t := &transactionizer{
+		fields:    op.Fields,
+		maxOpen:   cmp.Or(op.MaxOpen, defaultTransactionMaxOpen),
+		maxEvents: cmp.Or(op.MaxEvents, defaultTransactionMaxEvents),
+		open:      make(map[string]*list.Element),
+		lru:       list.New(),
+	}
+	var err error
+	if t.maxSpan, err = parseOptionalDuration("maxspan", op.MaxSpan); err != nil {
+		return nil, err
+	}
+	if t.maxPause, err = parseOptionalDuration("maxpause", op.MaxPause); err != nil {
+		return nil, err
+	}
+	if t.startsWith, err = compileOptionalFilter("startswith", op.StartsWith); err != nil {
+		return nil, err
+	}
+	if t.endsWith, err = compileOptionalFilter("endswith", op.EndsWith); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func parseOptionalDuration(name, s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid transaction %s %q", name, s)
+	}
+	return d, nil
+}
+
+func compileOptionalFilter(name, s string) (func(chunk.Record) bool, error) {
+	if s == "" {
+		return nil, nil
+	}
+	expr, err := querylang.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction %s: %w", name, err)
+	}
+	return CompileFilter(expr), nil
+}
+
+// add assigns rec to its transaction, opening or closing transactions as
+// the options require.
+func (t *transactionizer) add(rec chunk.Record) {
+	key, ok := t.keyOf(rec)
+	if !ok {
+		return
+	}
+	ts := rec.WriteTS
+	starts := t.startsWith != nil && t.startsWith(rec)
+
+	el := t.open[key]
+	if el != nil {
+		tx := el.Value.(*openTxn)
+		if starts || t.expired(tx, ts) {
+			t.close(el, false)
+			el = nil
+		}
+	}
+	if el == nil {
+		if t.startsWith != nil && !starts {
+			return // not inside any transaction
+		}
+		el = t.lru.PushFront(&openTxn{key: key, first: rec, attrs: make(chunk.Attributes)})
+		t.open[key] = el
+	} else {
+		t.lru.MoveToFront(el)
+	}
+
+	tx := el.Value.(*openTxn)
+	tx.last = ts
+	tx.raws = append(tx.raws, rec.Raw)
+	tx.count++
+	for k, v := range rec.Attrs {
+		if _, exists := tx.attrs[k]; !exists {
+			tx.attrs[k] = v
+		}
+	}
+	if (t.endsWith != nil && t.endsWith(rec)) || tx.count >= t.maxEvents {
+		t.close(el, false)
+	}
+	t.evict(ts)
+}
+
+// keyOf returns the transaction key of rec. Records missing any of the
+// transaction fields don't belong to a transaction.
+func (t *transactionizer) keyOf(rec chunk.Record) (string, bool) {
+	if len(t.fields) == 0 {
+		return "", true
+	}
+	row := RecordToRow(rec)
+	values := make([]string, len(t.fields))
+	for i, f := range t.fields {
+		v := row[f]
+		if v == "" {
+			return "", false
+		}
+		values[i] = v
+	}
+	return makeGroupKey(values), true
+}
+
+// expired reports whether an event at ts falls outside tx's span or pause limit.
+func (t *transactionizer) expired(tx *openTxn, ts time.Time) bool {
+	return (t.maxPause > 0 && ts.Sub(tx.last) > t.maxPause) ||
+		(t.maxSpan > 0 && ts.Sub(tx.first.WriteTS) > t.maxSpan)
+}
+
+// evict closes transactions from the least recently active end: those idle
+// past maxpause, which can no longer grow, and any beyond maxOpen.
+func (t *transactionizer) evict(now time.Time) {
+	for t.lru.Len() > 0 {
+		el := t.lru.Back()
+		tx := el.Value.(*openTxn)
+		switch {
+		case t.lru.Len() > t.maxOpen:
+			t.close(el, true)
+		case t.maxPause > 0 && now.Sub(tx.last) > t.maxPause:
+			t.close(el, false)
+		default:
+			return
+		}
+	}
+}
+
+func (t *transactionizer) close(el *list.Element, evicted bool) {
+	tx := t.lru.Remove(el).(*openTxn)
+	delete(t.open, tx.key)
+	t.out = append(t.out, tx.record(t.fields, evicted))
+}
+
+// finish closes every open transaction and returns all transactions.
+func (t *transactionizer) finish() []chunk.Record {
+	for t.lru.Len() > 0 {
+		t.close(t.lru.Back(), false)
+	}
+	return t.out
+}
+
+// record builds the output record of a transaction: the first event's
+// timestamps and identity, the merged attributes, and the joined raw text.
+func (tx *openTxn) record(fields []string, evicted bool) chunk.Record {
+	rec := tx.first
+	rec.Attrs = tx.attrs
+	row := RecordToRow(tx.first)
+	for _, f := range fields {
+		rec.Attrs[f] = row[f]
+	}
+	rec.Attrs["duration"] = strconv.FormatFloat(tx.last.Sub(tx.first.WriteTS).Seconds(), 'f', -1, 64)
+	rec.Attrs["eventcount"] = strconv.Itoa(tx.count)
+	if evicted {
+		rec.Attrs["evicted"] = "true"
+	}
+	rec.Raw = bytes.Join(tx.raws, []byte("\n"))
+	return rec
+}
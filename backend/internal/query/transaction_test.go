@@ -0,0 +1,161 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"gastrolog/internal/chunk"
+	"gastrolog/internal/querylang"
+)
+
+func runTransaction(t *testing.T, records []chunk.Record, pipe string) []chunk.Record {
+	t.Helper()
+	op := mustParsePipes(t, pipe)[0].(*querylang.TransactionOp)
+	out, err := applyRecordTransaction(records, op)
+	if err != nil {
+		t.Fatalf("applyRecordTransaction: %v", err)
+	}
+	return out
+}
+
+func rawColumn(records []chunk.Record) []string {
+	out := make([]string, len(records))
+	for i, rec := range records {
+		out[i] = string(rec.Raw)
+	}
+	return out
+}
+
+func sessionRec(off time.Duration, session, raw string) chunk.Record {
+	return makeRec(baseTime.Add(off), chunk.Attributes{"session": session}, raw)
+}
+
+func TestApplyRecordTransaction(t *testing.T) {
+	records := []chunk.Record{
+		sessionRec(0, "a", "a1"),
+		sessionRec(1*time.Second, "b", "b1"),
+		sessionRec(3*time.Second, "a", "a2"),
+		makeRec(baseTime.Add(4*time.Second), nil, "no session"),
+		sessionRec(5*time.Second, "a", "a3"),
+	}
+	out := runTransaction(t, records, "| transaction session")
+
+	if got, want := rawColumn(out), []string{"a1\na2\na3", "b1"}; !slices.Equal(got, want) {
+		t.Fatalf("raw = %q, want %q", got, want)
+	}
+	if got, want := attrColumn(out, "eventcount"), []string{"3", "1"}; !slices.Equal(got, want) {
+		t.Errorf("eventcount = %v, want %v", got, want)
+	}
+	if got, want := attrColumn(out, "duration"), []string{"5", "0"}; !slices.Equal(got, want) {
+		t.Errorf("duration = %v, want %v", got, want)
+	}
+	if !out[0].WriteTS.Equal(baseTime) {
+		t.Errorf("WriteTS = %v, want first event's", out[0].WriteTS)
+	}
+}
+
+func TestApplyRecordTransactionNewestFirst(t *testing.T) {
+	records := []chunk.Record{
+		sessionRec(5*time.Second, "b", "b2"),
+		sessionRec(4*time.Second, "a", "a2"),
+		sessionRec(2*time.Second, "b", "b1"),
+		sessionRec(0, "a", "a1"),
+	}
+	out := runTransaction(t, records, "| transaction session")
+
+	// Events are joined oldest first; transactions keep the input's order.
+	if got, want := rawColumn(out), []string{"b1\nb2", "a1\na2"}; !slices.Equal(got, want) {
+		t.Errorf("raw = %q, want %q", got, want)
+	}
+}
+
+func TestApplyRecordTransactionMaxPauseAndSpan(t *testing.T) {
+	offsets := []time.Duration{0, 10 * time.Second, 20 * time.Second, 90 * time.Second, 100 * time.Second}
+	var records []chunk.Record
+	for i, off := range offsets {
+		records = append(records, sessionRec(off, "a", string(rune('1'+i))))
+	}
+
+	out := runTransaction(t, slices.Clone(records), "| transaction session maxpause=30s")
+	if got, want := rawColumn(out), []string{"1\n2\n3", "4\n5"}; !slices.Equal(got, want) {
+		t.Errorf("maxpause raw = %q, want %q", got, want)
+	}
+
+	out = runTransaction(t, slices.Clone(records), "| transaction session maxspan=15s")
+	if got, want := rawColumn(out), []string{"1\n2", "3", "4\n5"}; !slices.Equal(got, want) {
+		t.Errorf("maxspan raw = %q, want %q", got, want)
+	}
+}
+
+func TestApplyRecordTransactionStartsEndsWith(t *testing.T) {
+	msg := func(off time.Duration, m string) chunk.Record {
+		return makeRec(baseTime.Add(off), chunk.Attributes{"req": "r1", "msg": m}, m)
+	}
+	records := []chunk.Record{
+		msg(0, "noise"),
+		msg(1*time.Second, "start"),
+		msg(2*time.Second, "work"),
+		msg(3*time.Second, "done"),
+		msg(4*time.Second, "stray"),
+		msg(5*time.Second, "start"),
+		msg(6*time.Second, "start"),
+		msg(7*time.Second, "work"),
+	}
+	out := runTransaction(t, records, `| transaction req startswith="msg=start" endswith="msg=done"`)
+
+	// Records outside a start..done bracket are dropped; a second start
+	// closes the open transaction.
+	want := []string{"start\nwork\ndone", "start", "start\nwork"}
+	if got := rawColumn(out); !slices.Equal(got, want) {
+		t.Errorf("raw = %q, want %q", got, want)
+	}
+}
+
+func TestApplyRecordTransactionMaxEvents(t *testing.T) {
+	var records []chunk.Record
+	for i := range 5 {
+		records = append(records, sessionRec(time.Duration(i)*time.Second, "a", string(rune('1'+i))))
+	}
+	out := runTransaction(t, records, "| transaction session maxevents=2")
+	if got, want := attrColumn(out, "eventcount"), []string{"2", "2", "1"}; !slices.Equal(got, want) {
+		t.Errorf("eventcount = %v, want %v", got, want)
+	}
+}
+
+func TestApplyRecordTransactionEvictsAtMaxOpen(t *testing.T) {
+	records := []chunk.Record{
+		sessionRec(0, "a", "a1"),
+		sessionRec(1*time.Second, "b", "b1"),
+		sessionRec(2*time.Second, "a", "a2"),
+		sessionRec(3*time.Second, "c", "c1"), // evicts b, the least recently active
+		sessionRec(4*time.Second, "b", "b2"), // starts a new b, evicts a
+	}
+	out := runTransaction(t, records, "| transaction session maxopen=2")
+
+	if got, want := rawColumn(out), []string{"a1\na2", "b1", "c1", "b2"}; !slices.Equal(got, want) {
+		t.Fatalf("raw = %q, want %q", got, want)
+	}
+	if got, want := attrColumn(out, "evicted"), []string{"true", "true", "", ""}; !slices.Equal(got, want) {
+		t.Errorf("evicted = %v, want %v", got, want)
+	}
+}
+
+func TestTransactionThenWhere(t *testing.T) {
+	records := []chunk.Record{
+		sessionRec(0, "a", "a1"),
+		sessionRec(10*time.Second, "a", "a2"),
+		sessionRec(0, "b", "b1"),
+		sessionRec(30*time.Second, "b", "b2"),
+	}
+	ops := mustParsePipes(t, "| transaction session | where duration > 20")
+
+	result, err := applyRecordOps(context.Background(), recordIter(records), ops, nil)
+	if err != nil {
+		t.Fatalf("applyRecordOps: %v", err)
+	}
+	if len(result) != 1 || string(result[0].Raw) != "b1\nb2" {
+		t.Fatalf("expected only session b, got %q", rawColumn(result))
+	}
+}
@@ -0,0 +1,159 @@
+package query_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gastrolog/internal/glid"
+	"testing"
+	"time"
+
+	"gastrolog/internal/chunk"
+	chunkmem "gastrolog/internal/chunk/memory"
+	"gastrolog/internal/index"
+	"gastrolog/internal/memtest"
+	"gastrolog/internal/query"
+	"gastrolog/internal/querylang"
+)
+
+// newAccessTestEngine builds a two-vault engine with 3 records in each
+// vault and returns the engine plus the two vault IDs.
+func newAccessTestEngine(t *testing.T) (*query.Engine, glid.GLID, glid.GLID) {
+	t.Helper()
+	reg := &testRegistry{
+		vaults: make(map[glid.GLID]struct {
+			cm chunk.ChunkManager
+			im index.IndexManager
+		}),
+	}
+	t0 := time.Date(2025, 6, 15, 10, 0, 0, 0, time.UTC)
+	ids := [2]glid.GLID{glid.New(), glid.New()}
+	for n, vaultID := range ids {
+		s := memtest.MustNewVault(t, chunkmem.Config{
+			RotationPolicy: chunk.NewRecordCountPolicy(1000),
+		})
+		for i := range 3 {
+			ts := t0.Add(time.Duration(i) * time.Second)
+			s.CM.Append(chunk.Record{IngestTS: ts, WriteTS: ts, Raw: fmt.Appendf(nil, "vault%d record %d", n, i)})
+		}
+		s.CM.Seal()
+		reg.vaults[vaultID] = struct {
+			cm chunk.ChunkManager
+			im index.IndexManager
+		}{s.CM, s.IM}
+	}
+	return query.NewWithRegistry(reg, nil), ids[0], ids[1]
+}
+
+func searchVaults(t *testing.T, ctx context.Context, eng *query.Engine, expr string) map[glid.GLID]int {
+	t.Helper()
+	var q query.Query
+	if expr != "" {
+		bexpr, err := querylang.Parse(expr)
+		if err != nil {
+			t.Fatalf("parse %q: %v", expr, err)
+		}
+		q.BoolExpr = bexpr
+	}
+	it, _ := eng.Search(ctx, q, nil)
+	got := make(map[glid.GLID]int)
+	for rec, err := range it {
+		if err != nil {
+			t.Fatalf("search %q: %v", expr, err)
+		}
+		got[rec.VaultID]++
+	}
+	return got
+}
+
+func TestVaultAccessRestrictsSearch(t *testing.T) {
+	eng, allowed, denied := newAccessTestEngine(t)
+	ctx := query.WithVaultAccess(context.Background(), query.NewVaultAccess([]glid.GLID{allowed}))
+
+	if got := searchVaults(t, context.Background(), eng, ""); got[allowed] != 3 || got[denied] != 3 {
+		t.Fatalf("unrestricted search: expected 3+3, got %v", got)
+	}
+	if got := searchVaults(t, ctx, eng, ""); got[allowed] != 3 || got[denied] != 0 {
+		t.Errorf("restricted search: expected only the allowed vault, got %v", got)
+	}
+	// Naming the denied vault explicitly must not widen access.
+	if got := searchVaults(t, ctx, eng, "vault_id="+denied.String()); len(got) != 0 {
+		t.Errorf("vault_id=<denied>: expected no records, got %v", got)
+	}
+	expr := fmt.Sprintf("vault_id=%s OR vault_id=%s", allowed, denied)
+	if got := searchVaults(t, ctx, eng, expr); got[allowed] != 3 || got[denied] != 0 {
+		t.Errorf("vault_id=<allowed> OR vault_id=<denied>: got %v", got)
+	}
+
+	// An empty grant set reads nothing.
+	none := query.WithVaultAccess(context.Background(), query.NewVaultAccess(nil))
+	if got := searchVaults(t, none, eng, ""); len(got) != 0 {
+		t.Errorf("empty access: expected no records, got %v", got)
+	}
+}
+
+func TestVaultAccessRestrictsHistogramAndExplain(t *testing.T) {
+	eng, allowed, _ := newAccessTestEngine(t)
+	ctx := query.WithVaultAccess(context.Background(), query.NewVaultAccess([]glid.GLID{allowed}))
+
+	var total int64
+	for _, b := range eng.ComputeHistogram(ctx, query.Query{}, 10) {
+		total += b.Count
+	}
+	if total != 3 {
+		t.Errorf("histogram: expected 3 records, got %d", total)
+	}
+
+	plan, err := eng.Explain(ctx, query.Query{})
+	if err != nil {
+		t.Fatalf("Explain: %v", err)
+	}
+	for _, cp := range plan.ChunkPlans {
+		if cp.VaultID != allowed {
+			t.Errorf("explain planned chunk in denied vault %s", cp.VaultID)
+		}
+	}
+}
+
+func TestVaultAccessReadRecord(t *testing.T) {
+	eng, allowed, denied := newAccessTestEngine(t)
+	ctx := query.WithVaultAccess(context.Background(), query.NewVaultAccess([]glid.GLID{allowed}))
+
+	it, _ := eng.Search(context.Background(), query.Query{BoolExpr: &querylang.PredicateExpr{
+		Kind: querylang.PredKV, Key: "vault_id", Value: denied.String(),
+	}}, nil)
+	var ref chunk.RecordRef
+	for rec, err := range it {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ref = rec.Ref
+		break
+	}
+
+	if _, err := eng.ReadRecord(context.Background(), denied, ref.ChunkID, ref.Pos); err != nil {
+		t.Fatalf("unrestricted ReadRecord: %v", err)
+	}
+	if _, err := eng.ReadRecord(ctx, denied, ref.ChunkID, ref.Pos); !errors.Is(err, query.ErrVaultAccessDenied) {
+		t.Errorf("restricted ReadRecord: expected ErrVaultAccessDenied, got %v", err)
+	}
+}
+
+func TestVaultAccessNilIsUnrestricted(t *testing.T) {
+	var a *query.VaultAccess
+	id := glid.New()
+	if !a.Allows(id) {
+		t.Error("nil access should allow every vault")
+	}
+	in := []glid.GLID{id}
+	if got := a.Restrict(in); len(got) != 1 {
+		t.Errorf("nil Restrict: got %v", got)
+	}
+	ctx := query.WithVaultAccess(context.Background(), nil)
+	if query.VaultAccessFromContext(ctx) != nil {
+		t.Error("WithVaultAccess(nil) should leave the context unrestricted")
+	}
+	if got := query.NewVaultAccess(nil).Restrict(in); got == nil || len(got) != 0 {
+		t.Errorf("empty access Restrict: expected empty non-nil slice, got %#v", got)
+	}
+}
@@ -1,6 +1,7 @@
 package query
 
 import (
+	"context"
 	"gastrolog/internal/glid"
 	"strings"
 
@@ -10,6 +11,78 @@ import (
 // vaultKey is the reserved key for vault filtering.
 const vaultKey = "vault_id"
 
+// VaultAccess is the set of vaults a caller may read. It is carried on the
+// query context and applied after vault_id= predicates are resolved, so a
+// restricted caller never reads a vault outside the set no matter how the
+// query is written. A nil *VaultAccess means unrestricted.
+type VaultAccess struct {
+	allowed map[glid.GLID]struct{}
+}
+
+// NewVaultAccess returns an access set permitting exactly ids. An empty ids
+// permits nothing.
+func NewVaultAccess(ids []glid.GLID) *VaultAccess {
+	a := &VaultAccess{allowed: make(map[glid.GLID]struct{}, len(ids))}
+	for _, id := range ids {
+		a.allowed[id] = struct{}{}
+	}
+	return a
+}
+
+// Allows reports whether the vault may be read. A nil receiver allows all.
+func (a *VaultAccess) Allows(id glid.GLID) bool {
+	if a == nil {
+		return true
+	}
+	_, ok := a.allowed[id]
+	return ok
+}
+
+// Restrict returns the subset of vaults the caller may read. A nil receiver
+// returns vaults unchanged; otherwise the result is never nil.
+func (a *VaultAccess) Restrict(vaults []glid.GLID) []glid.GLID {
+	if a == nil {
+		return vaults
+	}
+	out := make([]glid.GLID, 0, len(vaults))
+	for _, id := range vaults {
+		if a.Allows(id) {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// IDs returns the permitted vaults in no particular order.
+func (a *VaultAccess) IDs() []glid.GLID {
+	if a == nil {
+		return nil
+	}
+	out := make([]glid.GLID, 0, len(a.allowed))
+	for id := range a.allowed {
+		out = append(out, id)
+	}
+	return out
+}
+
+type vaultAccessKey struct{}
+
+// WithVaultAccess returns a context that restricts every engine call made
+// with it to the vaults in a. A nil a leaves the context unrestricted.
+func WithVaultAccess(ctx context.Context, a *VaultAccess) context.Context {
+	if a == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, vaultAccessKey{}, a)
+}
+
+// VaultAccessFromContext returns the access set attached by WithVaultAccess,
+// or nil when the context is unrestricted.
+func VaultAccessFromContext(ctx context.Context) *VaultAccess {
+	a, _ := ctx.Value(vaultAccessKey{}).(*VaultAccess)
+	return a
+}
+
 // ExtractVaultFilter extracts vault predicates from a BoolExpr and returns:
 // - vaults: the set of vault IDs to query (nil means all vaults)
 // - remainingExpr: the expression with vault predicates removed (nil if nothing remains)
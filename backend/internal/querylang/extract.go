@@ -0,0 +1,94 @@
+package querylang
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Extractor pulls fields out of text for the parse and rex operators.
+type Extractor struct {
+	Field string // source field; empty = raw message
+	re    *regexp.Regexp
+	names []string // output field per capture group (index 0 unused); "" = not captured
+}
+
+// Names returns the fields the extractor produces, in pattern order.
+func (x *Extractor) Names() []string {
+	var out []string
+	for _, n := range x.names[1:] {
+		if n != "" {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+// Extract matches text against the pattern and calls set for each captured
+// field. Returns false, without calling set, if the text does not match.
+func (x *Extractor) Extract(text string, set func(name, value string)) bool {
+	m := x.re.FindStringSubmatchIndex(text)
+	if m == nil {
+		return false
+	}
+	for i, name := range x.names {
+		if name == "" || m[2*i] < 0 {
+			continue
+		}
+		set(name, text[m[2*i]:m[2*i+1]])
+	}
+	return true
+}
+
+// Extractor compiles the parse pattern. Literal text must match exactly;
+// a * followed by more literal text captures up to the first occurrence of
+// that text, and a trailing * captures up to the next whitespace.
+func (p *ParseOp) Extractor() (*Extractor, error) {
+	parts := strings.Split(p.Pattern, "*")
+	wildcards := len(parts) - 1
+	if wildcards == 0 {
+		return nil, errors.New("parse pattern has no * to capture")
+	}
+	if wildcards != len(p.Names) {
+		return nil, fmt.Errorf("parse pattern has %d wildcards but %d field names", wildcards, len(p.Names))
+	}
+
+	var sb strings.Builder
+	sb.WriteString("(?s)")
+	for i, lit := range parts {
+		sb.WriteString(regexp.QuoteMeta(lit))
+		switch {
+		case i == wildcards:
+			// no capture after the last literal
+		case i == wildcards-1 && parts[wildcards] == "":
+			sb.WriteString(`(\S*)`)
+		default:
+			sb.WriteString(`(.*?)`)
+		}
+	}
+	re, err := regexp.Compile(sb.String())
+	if err != nil {
+		return nil, fmt.Errorf("parse pattern: %w", err)
+	}
+	return &Extractor{
+		Field: p.Field,
+		re:    re,
+		names: append([]string{""}, p.Names...),
+	}, nil
+}
+
+// Extractor compiles the rex regular expression. Only named groups become
+// fields; unnamed groups are matched but not captured.
+func (r *RexOp) Extractor() (*Extractor, error) {
+	re, err := regexp.Compile(r.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("rex pattern: %w", err)
+	}
+	names := re.SubexpNames()
+	if !slices.ContainsFunc(names, func(n string) bool { return n != "" }) {
+		return nil, errors.New("rex pattern has no named capture groups, e.g. (?P<user>\\w+)")
+	}
+	return &Extractor{Field: r.Field, re: re, names: names}, nil
+}
@@ -0,0 +1,109 @@
+package querylang
+
+import (
+	"maps"
+	"testing"
+)
+
+func extractAll(t *testing.T, x *Extractor, text string) (map[string]string, bool) {
+	t.Helper()
+	got := make(map[string]string)
+	ok := x.Extract(text, func(name, value string) { got[name] = value })
+	return got, ok
+}
+
+func TestParseExtractor(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		pattern string
+		names   []string
+		text    string
+		want    map[string]string // nil = no match
+	}{
+		{
+			name:    "trailing wildcard stops at whitespace",
+			pattern: "user=* ip=*",
+			names:   []string{"user", "ip"},
+			text:    "login ok user=alice ip=10.0.0.1 port=22",
+			want:    map[string]string{"user": "alice", "ip": "10.0.0.1"},
+		},
+		{
+			name:    "inner wildcard spans to next literal",
+			pattern: "took *ms",
+			names:   []string{"took"},
+			text:    "request took 1 234ms total",
+			want:    map[string]string{"took": "1 234"},
+		},
+		{
+			name:    "regex metacharacters are literal",
+			pattern: "[*] (*)",
+			names:   []string{"level", "pid"},
+			text:    "[warn] (4711)",
+			want:    map[string]string{"level": "warn", "pid": "4711"},
+		},
+		{
+			name:    "no match",
+			pattern: "user=* ip=*",
+			names:   []string{"user", "ip"},
+			text:    "nothing to see here",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			x, err := (&ParseOp{Pattern: tt.pattern, Names: tt.names}).Extractor()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := extractAll(t, x, tt.text)
+			if ok != (tt.want != nil) {
+				t.Fatalf("matched = %v, want %v", ok, tt.want != nil)
+			}
+			if ok && !maps.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRexExtractor(t *testing.T) {
+	t.Parallel()
+	x, err := (&RexOp{Pattern: `(GET|POST) (?P<path>\S+) (?P<status>\d{3})(?P<extra> .*)?$`}).Extractor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := x.Names(); len(got) != 3 || got[0] != "path" || got[1] != "status" || got[2] != "extra" {
+		t.Errorf("Names() = %v, want [path status extra]", got)
+	}
+
+	got, ok := extractAll(t, x, "GET /index.html 200")
+	if !ok {
+		t.Fatal("expected match")
+	}
+	// Unnamed and unmatched optional groups are not set.
+	want := map[string]string{"path": "/index.html", "status": "200"}
+	if !maps.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if _, ok := extractAll(t, x, "DELETE /x 204"); ok {
+		t.Error("expected no match for DELETE")
+	}
+}
+
+func TestExtractorErrors(t *testing.T) {
+	t.Parallel()
+	if _, err := (&ParseOp{Pattern: "user=", Names: []string{"user"}}).Extractor(); err == nil {
+		t.Error("expected error for parse pattern without wildcard")
+	}
+	if _, err := (&ParseOp{Pattern: "a=* b=*", Names: []string{"a"}}).Extractor(); err == nil {
+		t.Error("expected error for wildcard/name count mismatch")
+	}
+	if _, err := (&RexOp{Pattern: `(\w+)`}).Extractor(); err == nil {
+		t.Error("expected error for rex without named groups")
+	}
+	if _, err := (&RexOp{Pattern: `(?P<x>[`}).Extractor(); err == nil {
+		t.Error("expected error for invalid regex")
+	}
+}
@@ -43,9 +43,10 @@ type rawToken struct {
 // pipeKeywordSet contains all recognized pipe operator keywords.
 var pipeKeywordSet = map[string]bool{
 	"stats": true, "where": true, "eval": true, "sort": true,
+	"eventstats": true, "streamstats": true, "top": true, "rare": true, "transaction": true,
 	"head": true, "tail": true, "slice": true, "rename": true,
 	"fields": true, "timechart": true, "dedup": true, "raw": true,
-	"lookup": true, "linechart": true, "barchart": true, "donut": true, "heatmap": true, "scatter": true, "map": true, "export": true,
+	"lookup": true, "parse": true, "rex": true, "linechart": true, "barchart": true, "donut": true, "heatmap": true, "scatter": true, "map": true, "export": true,
 }
 
 // aggFuncSet contains aggregation function names.
@@ -299,12 +300,12 @@ func classifyPipeSegment(tokens []rawToken) []Span {
 		// Detect field = expr patterns and function calls.
 		classifyEvalBody(tokens, spans, restNonWS)
 
-	case "stats", "timechart", "linechart", "barchart", "donut", "heatmap", "scatter", "map":
+	case "stats", "eventstats", "streamstats", "timechart", "linechart", "barchart", "donut", "heatmap", "scatter", "map":
 		// Detect function calls, "by"/"as" keywords, field references.
 		classifyStatsBody(tokens, spans, restNonWS)
 
 	default:
-		// For sort, head, tail, slice, rename, fields, raw, lookup:
+		// For sort, head, tail, slice, rename, fields, raw, lookup, parse, rex, top, rare, transaction:
 		// detect "by"/"as" keywords and leave rest as tokens.
 		classifyGenericPipeBody(tokens, spans, restNonWS)
 	}
@@ -336,7 +337,7 @@ func classifyEvalBody(tokens []rawToken, spans []Span, nonWS []int) {
 	}
 }
 
-// classifyStatsBody handles stats/timechart/barchart/donut/map segments.
+// classifyStatsBody handles stats/eventstats/streamstats/timechart/barchart/donut/map segments.
 func classifyStatsBody(tokens []rawToken, spans []Span, nonWS []int) {
 	for j := range nonWS {
 		idx := nonWS[j]
@@ -39,25 +39,141 @@ type StatsOp struct {
 func (StatsOp) pipeOp() {}
 
 func (s *StatsOp) String() string {
-	var parts []string
-	parts = append(parts, "stats")
+	return "stats " + aggClause(s.Aggs, s.Groups)
+}
 
-	aggStrs := make([]string, len(s.Aggs))
-	for i, a := range s.Aggs {
+// aggClause formats "agg_list (by group_list)?" for the stats family.
+func aggClause(aggs []AggExpr, groups []GroupExpr) string {
+	aggStrs := make([]string, len(aggs))
+	for i, a := range aggs {
 		aggStrs[i] = a.String()
 	}
-	parts = append(parts, strings.Join(aggStrs, ", "))
+	s := strings.Join(aggStrs, ", ")
 
-	if len(s.Groups) > 0 {
-		groupStrs := make([]string, len(s.Groups))
-		for i, g := range s.Groups {
+	if len(groups) > 0 {
+		groupStrs := make([]string, len(groups))
+		for i, g := range groups {
 			groupStrs[i] = g.String()
 		}
-		parts = append(parts, "by")
-		parts = append(parts, strings.Join(groupStrs, ", "))
+		s += " by " + strings.Join(groupStrs, ", ")
 	}
+	return s
+}
+
+// EventstatsOp represents: eventstats agg_list (by group_list)?
+// Unlike stats it keeps every record, adding its group's aggregates as fields.
+type EventstatsOp struct {
+	Aggs   []AggExpr
+	Groups []GroupExpr
+}
+
+func (EventstatsOp) pipeOp() {}
 
-	return strings.Join(parts, " ")
+func (e *EventstatsOp) String() string {
+	return "eventstats " + aggClause(e.Aggs, e.Groups)
+}
+
+// StreamstatsOp represents:
+// streamstats (window=N)? (time_window=DUR)? agg_list (by group_list)?
+// It adds running aggregates to each record in the order records arrive,
+// optionally limited to the last N records or a trailing time window per group.
+type StreamstatsOp struct {
+	Aggs       []AggExpr
+	Groups     []GroupExpr
+	Window     int    // 0 = unbounded
+	TimeWindow string // raw duration, e.g. "5m". Empty = unbounded.
+}
+
+func (StreamstatsOp) pipeOp() {}
+
+func (s *StreamstatsOp) String() string {
+	var sb strings.Builder
+	sb.WriteString("streamstats ")
+	if s.Window > 0 {
+		fmt.Fprintf(&sb, "window=%d ", s.Window)
+	}
+	if s.TimeWindow != "" {
+		sb.WriteString("time_window=" + s.TimeWindow + " ")
+	}
+	sb.WriteString(aggClause(s.Aggs, s.Groups))
+	return sb.String()
+}
+
+// DefaultTopN is the number of rows per group top and rare return when no
+// count is given.
+const DefaultTopN = 10
+
+// TopOp represents: (top|rare) N? field_list (by field_list)? (showother=BOOL)?
+// It counts records per distinct combination of Fields, keeping the N most
+// (top) or least (rare) common per group, with count and percent columns.
+type TopOp struct {
+	Rare      bool
+	N         int
+	Fields    []string
+	By        []string
+	ShowOther bool // add an "other" row totalling the values past N
+}
+
+func (TopOp) pipeOp() {}
+
+func (t *TopOp) String() string {
+	var sb strings.Builder
+	if t.Rare {
+		sb.WriteString("rare ")
+	} else {
+		sb.WriteString("top ")
+	}
+	fmt.Fprintf(&sb, "%d %s", t.N, strings.Join(t.Fields, ", "))
+	if len(t.By) > 0 {
+		sb.WriteString(" by " + strings.Join(t.By, ", "))
+	}
+	if t.ShowOther {
+		sb.WriteString(" showother=true")
+	}
+	return sb.String()
+}
+
+// TransactionOp represents: transaction field_list? options*
+// It groups records sharing the same values of Fields into transactions,
+// emitting one record per transaction with its duration, event count and
+// the events' raw text joined by newlines.
+type TransactionOp struct {
+	Fields     []string
+	MaxSpan    string // raw duration; max time from first to last event. Empty = unbounded.
+	MaxPause   string // raw duration; max gap between events. Empty = unbounded.
+	StartsWith string // filter expression that starts a new transaction
+	EndsWith   string // filter expression that ends a transaction
+	MaxOpen    int    // max open transactions before the least recently active is evicted; 0 = default
+	MaxEvents  int    // max events per transaction; 0 = default
+}
+
+func (TransactionOp) pipeOp() {}
+
+func (t *TransactionOp) String() string {
+	var sb strings.Builder
+	sb.WriteString("transaction")
+	if len(t.Fields) > 0 {
+		sb.WriteString(" " + strings.Join(t.Fields, ", "))
+	}
+	if t.MaxSpan != "" {
+		sb.WriteString(" maxspan=" + t.MaxSpan)
+	}
+	if t.MaxPause != "" {
+		sb.WriteString(" maxpause=" + t.MaxPause)
+	}
+	if t.StartsWith != "" {
+		sb.WriteString(` startswith="` + escapeQuoted(t.StartsWith) + `"`)
+	}
+	if t.EndsWith != "" {
+		sb.WriteString(` endswith="` + escapeQuoted(t.EndsWith) + `"`)
+	}
+	if t.MaxOpen > 0 {
+		fmt.Fprintf(&sb, " maxopen=%d", t.MaxOpen)
+	}
+	if t.MaxEvents > 0 {
+		fmt.Fprintf(&sb, " maxevents=%d", t.MaxEvents)
+	}
+	return sb.String()
 }
 
 // WhereOp represents: where filter_expr
@@ -75,6 +191,7 @@ func (w *WhereOp) String() string {
 type AggExpr struct {
 	Func  string     // aggregate function name: "count", "avg", "sum", "min", "max"
 	Arg   PipeExpr   // argument expression; nil for bare "count"
+	Param string     // numeric second argument, e.g. 95 in percentile(x, 95); empty if none
 	Alias string     // optional alias from "as"; empty if not specified
 }
 
@@ -83,6 +200,8 @@ func (a *AggExpr) String() string {
 	var s string
 	if a.Arg == nil {
 		s = a.Func
+	} else if a.Param != "" {
+		s = fmt.Sprintf("%s(%s, %s)", a.Func, a.Arg.String(), a.Param)
 	} else {
 		s = fmt.Sprintf("%s(%s)", a.Func, a.Arg.String())
 	}
@@ -101,12 +220,16 @@ func (a *AggExpr) DefaultAlias() string {
 	if a.Arg == nil {
 		return a.Func // "count"
 	}
+	name := a.Func
+	if a.Param != "" && strings.EqualFold(a.Func, "percentile") {
+		name = "p" + a.Param // percentile(x, 95) reads like p95(x)
+	}
 	// For func(field), use "func_field" if arg is a simple field reference.
 	if ref, ok := a.Arg.(*FieldRef); ok {
-		return a.Func + "_" + ref.Name
+		return name + "_" + ref.Name
 	}
 	// Complex expressions: just use the function name.
-	return a.Func
+	return name
 }
 
 // GroupExpr represents a group-by expression: a field name or bin(duration[, field]).
@@ -313,6 +436,51 @@ func (l *LookupOp) String() string {
 	return fmt.Sprintf("lookup %s %s", l.Table, strings.Join(l.Fields, " "))
 }
 
+// ParseOp represents: parse [field=<field>] "<pattern>" as <name>, <name>, ...
+// Extracts fields from text with a wildcard pattern: each * in the pattern
+// captures into the corresponding name. The source is the raw message unless
+// field= is given. Records that don't match pass through unchanged.
+type ParseOp struct {
+	Field   string   // source field; empty = raw message
+	Pattern string   // literal text with * wildcards
+	Names   []string // one output field per *
+}
+
+func (ParseOp) pipeOp() {}
+
+func (p *ParseOp) String() string {
+	var sb strings.Builder
+	sb.WriteString("parse ")
+	if p.Field != "" {
+		sb.WriteString("field=" + p.Field + " ")
+	}
+	sb.WriteString(`"` + escapeQuoted(p.Pattern) + `" as `)
+	sb.WriteString(strings.Join(p.Names, ", "))
+	return sb.String()
+}
+
+// RexOp represents: rex [field=<field>] /<regex>/
+// Extracts fields from text with a regular expression; each named capture
+// group ((?P<name>...) or (?<name>...)) becomes a field. The source is the raw
+// message unless field= is given. Records that don't match pass through
+// unchanged.
+type RexOp struct {
+	Field   string // source field; empty = raw message
+	Pattern string // Go RE2 syntax
+}
+
+func (RexOp) pipeOp() {}
+
+func (r *RexOp) String() string {
+	var sb strings.Builder
+	sb.WriteString("rex ")
+	if r.Field != "" {
+		sb.WriteString("field=" + r.Field + " ")
+	}
+	sb.WriteString("/" + strings.ReplaceAll(r.Pattern, "/", `\/`) + "/")
+	return sb.String()
+}
+
 // LinechartOp represents: linechart
 // Forces the pipeline result to render as a line chart.
 // Validates: first column parseable as time, ≥1 numeric column, ≥2 rows.
@@ -165,6 +165,26 @@ func applyOperator(op PipeOp, fields fieldSet) fieldSet {
 		}
 		return result
 
+	case *ParseOp:
+		// Parse adds one field per wildcard.
+		result := copyFieldSet(fields)
+		for _, name := range o.Names {
+			result[name] = true
+		}
+		return result
+
+	case *RexOp:
+		// Rex adds one field per named capture group.
+		x, err := o.Extractor()
+		if err != nil {
+			return fields
+		}
+		result := copyFieldSet(fields)
+		for _, name := range x.Names() {
+			result[name] = true
+		}
+		return result
+
 	case *RenameOp:
 		result := copyFieldSet(fields)
 		for _, r := range o.Renames {
@@ -208,6 +228,32 @@ func applyOperator(op PipeOp, fields fieldSet) fieldSet {
 		}
 		return result
 
+	case *TopOp:
+		// Top and rare replace the schema: groups, key fields, count, percent.
+		result := make(fieldSet)
+		for _, name := range o.By {
+			result[name] = true
+		}
+		for _, name := range o.Fields {
+			result[name] = true
+		}
+		result["count"] = true
+		result["percent"] = true
+		return result
+
+	case *TransactionOp:
+		// Transactions keep their events' fields and add summary fields.
+		result := copyFieldSet(fields)
+		result["duration"] = true
+		result["eventcount"] = true
+		return result
+
+	case *EventstatsOp:
+		return withAggFields(fields, o.Aggs)
+
+	case *StreamstatsOp:
+		return withAggFields(fields, o.Aggs)
+
 	case *TimechartOp:
 		// Timechart replaces schema: _time + count (or series values).
 		result := make(fieldSet)
@@ -229,6 +275,16 @@ func applyOperator(op PipeOp, fields fieldSet) fieldSet {
 	}
 }
 
+// withAggFields returns fields plus one field per aggregate alias, for the
+// operators that attach aggregates to each record.
+func withAggFields(fields fieldSet, aggs []AggExpr) fieldSet {
+	result := copyFieldSet(fields)
+	for _, a := range aggs {
+		result[a.DefaultAlias()] = true
+	}
+	return result
+}
+
 func copyFieldSet(fs fieldSet) fieldSet {
 	result := make(fieldSet, len(fs))
 	for f := range fs {
@@ -241,11 +297,11 @@ func copyFieldSet(fs fieldSet) fieldSet {
 // the cursor is currently inside.
 func completionsForOperator(op PipeOp) []string {
 	switch op.(type) {
-	case *StatsOp:
+	case *StatsOp, *EventstatsOp, *StreamstatsOp:
 		return []string{"by", "as"}
-	case *RenameOp:
+	case *RenameOp, *ParseOp:
 		return []string{"as"}
-	case *TimechartOp:
+	case *TimechartOp, *TopOp:
 		return []string{"by"}
 	default:
 		return nil
@@ -274,11 +330,11 @@ func contextCompletionsForSegment(stripped string, pipePositions []int, segmentI
 	}
 
 	switch strings.ToLower(keyword[0]) {
-	case "stats":
+	case "stats", "eventstats", "streamstats":
 		return []string{"by", "as"}
-	case "rename":
+	case "rename", "parse":
 		return []string{"as"}
-	case "timechart":
+	case "timechart", "top", "rare":
 		return []string{"by"}
 	default:
 		return nil
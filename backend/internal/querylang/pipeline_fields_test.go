@@ -222,3 +222,61 @@ func TestFieldsAtCursor_TimechartOutput(t *testing.T) {
 		t.Errorf("expected 'count' from timechart, got %v", fields)
 	}
 }
+
+func TestFieldsAtCursor_ParseAndRexAddFields(t *testing.T) {
+	t.Parallel()
+	base := []string{"level", "message"}
+	expr := `error | parse "user=* ip=*" as user, ip | rex /(?P<method>GET|POST)/ | `
+	fields, _ := FieldsAtCursor(expr, len(expr), base)
+	for _, want := range []string{"level", "user", "ip", "method"} {
+		if !slices.Contains(fields, want) {
+			t.Errorf("expected %q after parse/rex, got %v", want, fields)
+		}
+	}
+}
+
+func TestFieldsAtCursor_ParseCompletesAs(t *testing.T) {
+	t.Parallel()
+	expr := `error | parse "user=*" `
+	_, completions := FieldsAtCursor(expr, len(expr), []string{"level"})
+	if !slices.Contains(completions, "as") {
+		t.Errorf("expected 'as' completion after parse pattern, got %v", completions)
+	}
+}
+
+func TestFieldsAtCursor_EventstatsKeepsFields(t *testing.T) {
+	t.Parallel()
+	base := []string{"level", "latency"}
+	expr := "error | eventstats avg(latency) as svc_avg by service | streamstats count | "
+	fields, _ := FieldsAtCursor(expr, len(expr), base)
+	for _, want := range []string{"level", "latency", "svc_avg", "count"} {
+		if !slices.Contains(fields, want) {
+			t.Errorf("expected %q after eventstats/streamstats, got %v", want, fields)
+		}
+	}
+}
+
+func TestFieldsAtCursor_TopReplacesSchema(t *testing.T) {
+	t.Parallel()
+	expr := "error | top 5 host by service | "
+	fields, _ := FieldsAtCursor(expr, len(expr), []string{"level", "host"})
+	if slices.Contains(fields, "level") {
+		t.Errorf("'level' should not survive top, got %v", fields)
+	}
+	for _, want := range []string{"service", "host", "count", "percent"} {
+		if !slices.Contains(fields, want) {
+			t.Errorf("expected %q after top, got %v", want, fields)
+		}
+	}
+}
+
+func TestFieldsAtCursor_TransactionAddsSummaryFields(t *testing.T) {
+	t.Parallel()
+	expr := "error | transaction session maxpause=5m | "
+	fields, _ := FieldsAtCursor(expr, len(expr), []string{"level", "session"})
+	for _, want := range []string{"level", "session", "duration", "eventcount"} {
+		if !slices.Contains(fields, want) {
+			t.Errorf("expected %q after transaction, got %v", want, fields)
+		}
+	}
+}
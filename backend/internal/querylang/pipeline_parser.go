@@ -2,6 +2,8 @@ package querylang
 
 import (
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 )
 
@@ -13,9 +15,19 @@ import (
 //	pipe_op       = stats_op | where_op | eval_op | sort_op | head_op
 //	              | tail_op | slice_op | rename_op | fields_op
 //	              | timechart_op | dedup_op | raw_op | lookup_op
-//	              | barchart_op | donut_op | map_op
+//	              | barchart_op | donut_op | map_op | parse_op | rex_op
+//	              | eventstats_op | streamstats_op | top_op | transaction_op
 //	dedup_op      = "dedup" [ duration ]
 //	stats_op      = "stats" agg_list ( "by" group_list )?
+//	eventstats_op = "eventstats" agg_list ( "by" group_list )?
+//	streamstats_op = "streamstats" ( "window" "=" NUMBER | "time_window" "=" DURATION )*
+//	                 agg_list ( "by" group_list )?
+//	top_op        = ( "top" | "rare" ) top_opt* NUMBER? field_list ( "by" field_list )? top_opt*
+//	top_opt       = "showother" "=" ( "true" | "false" )
+//	transaction_op = "transaction" field_list? txn_opt*
+//	txn_opt       = ( "maxspan" | "maxpause" ) "=" DURATION
+//	              | ( "startswith" | "endswith" ) "=" STRING
+//	              | ( "maxopen" | "maxevents" ) "=" NUMBER
 //	agg_list      = agg_expr ( "," agg_expr )*
 //	agg_expr      = "count" ( "as" IDENT )?
 //	              | IDENT "(" expr ")" ( "as" IDENT )?
@@ -103,6 +115,16 @@ func (p *parser) parsePipeOp() (PipeOp, error) {
 	switch strings.ToLower(p.cur.Lit) {
 	case "stats":
 		return p.parseStatsOp()
+	case "eventstats":
+		return p.parseEventstatsOp()
+	case "streamstats":
+		return p.parseStreamstatsOp()
+	case "top":
+		return p.parseTopOp(false)
+	case "rare":
+		return p.parseTopOp(true)
+	case "transaction":
+		return p.parseTransactionOp()
 	case "where":
 		return p.parseWhereOp()
 	case "eval":
@@ -127,6 +149,10 @@ func (p *parser) parsePipeOp() (PipeOp, error) {
 		return p.parseRawOp()
 	case "lookup":
 		return p.parseLookupOp()
+	case "parse":
+		return p.parseParseOp()
+	case "rex":
+		return p.parseRexOp()
 	case "linechart":
 		return p.parseLinechartOp()
 	case "barchart":
@@ -151,38 +177,277 @@ func (p *parser) parseStatsOp() (*StatsOp, error) {
 	if err := p.advance(); err != nil { // consume "stats"
 		return nil, err
 	}
+	aggs, groups, err := p.parseAggClause("stats")
+	if err != nil {
+		return nil, err
+	}
+	return &StatsOp{Aggs: aggs, Groups: groups}, nil
+}
 
-	aggs, err := p.parseAggList()
+// parseEventstatsOp parses: "eventstats" agg_list ( "by" group_list )?
+func (p *parser) parseEventstatsOp() (*EventstatsOp, error) {
+	if err := p.advance(); err != nil { // consume "eventstats"
+		return nil, err
+	}
+	aggs, groups, err := p.parseAggClause("eventstats")
+	if err != nil {
+		return nil, err
+	}
+	return &EventstatsOp{Aggs: aggs, Groups: groups}, nil
+}
+
+// parseStreamstatsOp parses:
+// "streamstats" ( "window" "=" NUMBER | "time_window" "=" DURATION )* agg_list ( "by" group_list )?
+func (p *parser) parseStreamstatsOp() (*StreamstatsOp, error) {
+	if err := p.advance(); err != nil { // consume "streamstats"
+		return nil, err
+	}
+	op := &StreamstatsOp{}
+
+	// Options come before the aggregations. Neither name is an aggregation
+	// function, so no lookahead is needed to tell them apart.
+	for p.cur.Kind == TokWord && !p.cur.Quoted {
+		name := strings.ToLower(p.cur.Lit)
+		if name != "window" && name != "time_window" {
+			break
+		}
+		if err := p.advance(); err != nil { // consume option name
+			return nil, err
+		}
+		if p.cur.Kind != TokEq {
+			return nil, newParseError(p.cur.Pos, ErrUnexpectedToken, "expected '=' after '%s'", name)
+		}
+		if err := p.advance(); err != nil { // consume "="
+			return nil, err
+		}
+		switch name {
+		case "window":
+			n, err := strconv.Atoi(p.cur.Lit)
+			if p.cur.Kind != TokWord || err != nil || n <= 0 {
+				return nil, newParseError(p.cur.Pos, ErrUnexpectedToken, "window must be a positive number of records, got %s", p.cur.Lit)
+			}
+			op.Window = n
+		case "time_window":
+			if p.cur.Kind != TokWord || !isDurationLiteral(p.cur.Lit) {
+				return nil, newParseError(p.cur.Pos, ErrUnexpectedToken, "time_window must be a duration such as 5m, got %s", p.cur.Lit)
+			}
+			op.TimeWindow = p.cur.Lit
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+
+	aggs, groups, err := p.parseAggClause("streamstats")
 	if err != nil {
 		return nil, err
 	}
+	op.Aggs = aggs
+	op.Groups = groups
+	return op, nil
+}
+
+// parseAggClause parses: agg_list ( "by" group_list )?
+// keyword names the operator in error messages.
+func (p *parser) parseAggClause(keyword string) ([]AggExpr, []GroupExpr, error) {
+	aggs, err := p.parseAggList()
+	if err != nil {
+		return nil, nil, err
+	}
 
 	if len(aggs) == 0 {
-		return nil, newParseError(p.cur.Pos, ErrUnexpectedToken, "stats requires at least one aggregation")
+		return nil, nil, newParseError(p.cur.Pos, ErrUnexpectedToken, "%s requires at least one aggregation", keyword)
 	}
 
 	// Check for duplicate default aliases.
 	if err := checkDuplicateAliases(aggs); err != nil {
+		return nil, nil, err
+	}
+
+	// Check for "by" clause.
+	if p.cur.Kind != TokWord || strings.ToLower(p.cur.Lit) != "by" {
+		return aggs, nil, nil
+	}
+	if err := p.advance(); err != nil { // consume "by"
+		return nil, nil, err
+	}
+	groups, err := p.parseGroupList()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(groups) == 0 {
+		return nil, nil, newParseError(p.cur.Pos, ErrUnexpectedToken, "'by' requires at least one group expression")
+	}
+	return aggs, groups, nil
+}
+
+// parseTopOp parses top and rare:
+// ( "top" | "rare" ) top_opt* NUMBER? field_list ( "by" field_list )? top_opt*
+func (p *parser) parseTopOp(rare bool) (*TopOp, error) {
+	keyword := strings.ToLower(p.cur.Lit)
+	if err := p.advance(); err != nil { // consume "top" / "rare"
 		return nil, err
 	}
+	op := &TopOp{Rare: rare, N: DefaultTopN}
 
-	op := &StatsOp{Aggs: aggs}
+	if err := p.parseTopOptions(op); err != nil {
+		return nil, err
+	}
+	if p.cur.Kind == TokWord && !p.cur.Quoted && isNumericLiteral(p.cur.Lit) {
+		n, err := strconv.Atoi(p.cur.Lit)
+		if err != nil || n <= 0 {
+			return nil, newParseError(p.cur.Pos, ErrUnexpectedToken, "%s count must be a positive integer, got %s", keyword, p.cur.Lit)
+		}
+		op.N = n
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+
+	fields, err := p.parseFieldNameList(keyword, "by", "showother")
+	if err != nil {
+		return nil, err
+	}
+	op.Fields = fields
 
-	// Check for "by" clause.
 	if p.cur.Kind == TokWord && strings.ToLower(p.cur.Lit) == "by" {
 		if err := p.advance(); err != nil { // consume "by"
 			return nil, err
 		}
-		groups, err := p.parseGroupList()
+		by, err := p.parseFieldNameList("by", "showother")
 		if err != nil {
 			return nil, err
 		}
-		if len(groups) == 0 {
-			return nil, newParseError(p.cur.Pos, ErrUnexpectedToken, "'by' requires at least one group expression")
+		op.By = by
+	}
+
+	if err := p.parseTopOptions(op); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// parseFieldNameList parses field names separated by commas or whitespace,
+// stopping at any of the unquoted keywords in stop (e.g. "by" or an option).
+func (p *parser) parseFieldNameList(after string, stop ...string) ([]string, error) {
+	var names []string
+	for {
+		if p.cur.Kind == TokComma && len(names) > 0 {
+			if err := p.advance(); err != nil { // consume ","
+				return nil, err
+			}
+		}
+		if p.cur.Kind != TokWord {
+			break
 		}
-		op.Groups = groups
+		if !p.cur.Quoted && slices.Contains(stop, strings.ToLower(p.cur.Lit)) {
+			break
+		}
+		names = append(names, p.cur.Lit)
+		if err := p.advance(); err != nil { // consume field name
+			return nil, err
+		}
+	}
+	if len(names) == 0 {
+		return nil, newParseError(p.cur.Pos, ErrUnexpectedToken, "expected field name after '%s', got %s", after, p.cur.Kind)
+	}
+	return names, nil
+}
+
+// parseTopOptions parses any number of "showother=BOOL" options.
+func (p *parser) parseTopOptions(op *TopOp) error {
+	for p.cur.Kind == TokWord && !p.cur.Quoted && strings.ToLower(p.cur.Lit) == "showother" {
+		if err := p.advance(); err != nil { // consume "showother"
+			return err
+		}
+		if p.cur.Kind != TokEq {
+			return newParseError(p.cur.Pos, ErrUnexpectedToken, "expected '=' after 'showother'")
+		}
+		if err := p.advance(); err != nil { // consume "="
+			return err
+		}
+		v, err := strconv.ParseBool(p.cur.Lit)
+		if p.cur.Kind != TokWord || err != nil {
+			return newParseError(p.cur.Pos, ErrUnexpectedToken, "showother must be true or false, got %s", p.cur.Lit)
+		}
+		op.ShowOther = v
+		if err := p.advance(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transactionOptions are the option names accepted by transaction.
+var transactionOptions = []string{"maxspan", "maxpause", "startswith", "endswith", "maxopen", "maxevents"}
+
+// parseTransactionOp parses: "transaction" field_list? txn_opt*
+func (p *parser) parseTransactionOp() (*TransactionOp, error) {
+	if err := p.advance(); err != nil { // consume "transaction"
+		return nil, err
 	}
+	op := &TransactionOp{}
 
+	if p.cur.Kind == TokWord && (p.cur.Quoted || !slices.Contains(transactionOptions, strings.ToLower(p.cur.Lit))) {
+		fields, err := p.parseFieldNameList("transaction", transactionOptions...)
+		if err != nil {
+			return nil, err
+		}
+		op.Fields = fields
+	}
+
+	for p.cur.Kind == TokWord && !p.cur.Quoted {
+		name := strings.ToLower(p.cur.Lit)
+		if !slices.Contains(transactionOptions, name) {
+			break
+		}
+		if err := p.advance(); err != nil { // consume option name
+			return nil, err
+		}
+		if p.cur.Kind != TokEq {
+			return nil, newParseError(p.cur.Pos, ErrUnexpectedToken, "expected '=' after '%s'", name)
+		}
+		if err := p.advance(); err != nil { // consume "="
+			return nil, err
+		}
+		if p.cur.Kind != TokWord {
+			return nil, newParseError(p.cur.Pos, ErrUnexpectedToken, "expected value after '%s='", name)
+		}
+		val, pos := p.cur.Lit, p.cur.Pos
+		switch name {
+		case "maxspan", "maxpause":
+			if !isDurationLiteral(val) {
+				return nil, newParseError(pos, ErrUnexpectedToken, "%s must be a duration such as 30s, got %s", name, val)
+			}
+			if name == "maxspan" {
+				op.MaxSpan = val
+			} else {
+				op.MaxPause = val
+			}
+		case "startswith", "endswith":
+			if _, err := Parse(val); err != nil {
+				return nil, newParseError(pos, ErrUnexpectedToken, "invalid %s filter %q: %v", name, val, err)
+			}
+			if name == "startswith" {
+				op.StartsWith = val
+			} else {
+				op.EndsWith = val
+			}
+		case "maxopen", "maxevents":
+			n, err := strconv.Atoi(val)
+			if err != nil || n <= 0 {
+				return nil, newParseError(pos, ErrUnexpectedToken, "%s must be a positive integer, got %s", name, val)
+			}
+			if name == "maxopen" {
+				op.MaxOpen = n
+			} else {
+				op.MaxEvents = n
+			}
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
 	return op, nil
 }
 
@@ -210,7 +475,7 @@ func (p *parser) parseAggList() ([]AggExpr, error) {
 	return aggs, nil
 }
 
-// parseAggExpr parses: "count" ("as" IDENT)? | IDENT "(" expr ")" ("as" IDENT)?
+// parseAggExpr parses: "count" ("as" IDENT)? | IDENT "(" expr ("," NUMBER)? ")" ("as" IDENT)?
 func (p *parser) parseAggExpr() (*AggExpr, error) {
 	if p.cur.Kind != TokWord {
 		return nil, newParseError(p.cur.Pos, ErrUnexpectedToken, "expected aggregation function, got %s", p.cur.Kind)
@@ -250,6 +515,20 @@ func (p *parser) parseAggExpr() (*AggExpr, error) {
 			return nil, err
 		}
 		agg.Arg = arg
+
+		// Optional numeric parameter: percentile(field, 95).
+		if p.cur.Kind == TokComma {
+			if err := p.advance(); err != nil { // consume ","
+				return nil, err
+			}
+			if p.cur.Kind != TokWord || p.cur.Quoted || !isNumericLiteral(p.cur.Lit) {
+				return nil, newParseError(p.cur.Pos, ErrUnexpectedToken, "expected number as second argument to '%s'", funcName)
+			}
+			agg.Param = p.cur.Lit
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	if p.cur.Kind != TokRParen {
@@ -1065,6 +1344,105 @@ func (p *parser) parseLookupOp() (*LookupOp, error) {
 	return &LookupOp{Table: table, Fields: fields}, nil
 }
 
+// parseParseOp parses: "parse" [ "field" "=" FIELD ] STRING "as" NAME ("," NAME)*
+func (p *parser) parseParseOp() (*ParseOp, error) {
+	start := p.cur.Pos
+	if err := p.advance(); err != nil { // consume "parse"
+		return nil, err
+	}
+	field, err := p.parseExtractField()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.cur.Kind != TokWord || !p.cur.Quoted {
+		return nil, newParseError(p.cur.Pos, ErrUnexpectedToken, "expected quoted pattern after 'parse', e.g. parse \"user=* ip=*\" as user, ip")
+	}
+	op := &ParseOp{Field: field, Pattern: p.cur.Lit}
+	if err := p.advance(); err != nil { // consume pattern
+		return nil, err
+	}
+
+	if p.cur.Kind != TokWord || strings.ToLower(p.cur.Lit) != "as" {
+		return nil, newParseError(p.cur.Pos, ErrUnexpectedToken, "expected 'as' after parse pattern")
+	}
+	if err := p.advance(); err != nil { // consume "as"
+		return nil, err
+	}
+	for {
+		if p.cur.Kind != TokWord {
+			return nil, newParseError(p.cur.Pos, ErrUnexpectedToken, "expected field name in parse, got %s", p.cur.Kind)
+		}
+		op.Names = append(op.Names, p.cur.Lit)
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if p.cur.Kind != TokComma {
+			break
+		}
+		if err := p.advance(); err != nil { // consume ","
+			return nil, err
+		}
+	}
+
+	if _, err := op.Extractor(); err != nil {
+		return nil, newParseError(start, ErrUnexpectedToken, "%v", err)
+	}
+	return op, nil
+}
+
+// parseRexOp parses: "rex" [ "field" "=" FIELD ] ( REGEX | STRING )
+// Like where, the pattern is lexed in filter mode so /regex/ literals work.
+func (p *parser) parseRexOp() (*RexOp, error) {
+	start := p.cur.Pos
+	p.lex.SetPipeMode(false)
+	if err := p.advance(); err != nil { // consume "rex"
+		return nil, err
+	}
+	field, err := p.parseExtractField()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.cur.Kind != TokRegex && (p.cur.Kind != TokWord || !p.cur.Quoted) {
+		return nil, newParseError(p.cur.Pos, ErrUnexpectedToken, "expected /regex/ after 'rex', e.g. rex /user=(?P<user>\\w+)/")
+	}
+	op := &RexOp{Field: field, Pattern: p.cur.Lit}
+	p.lex.SetPipeMode(true)
+	if err := p.advance(); err != nil { // consume pattern
+		return nil, err
+	}
+
+	if _, err := op.Extractor(); err != nil {
+		return nil, newParseError(start, ErrInvalidRegex, "%v", err)
+	}
+	return op, nil
+}
+
+// parseExtractField parses the optional "field=NAME" prefix of parse and rex.
+func (p *parser) parseExtractField() (string, error) {
+	if p.cur.Kind != TokWord || p.cur.Quoted || strings.ToLower(p.cur.Lit) != "field" {
+		return "", nil
+	}
+	if err := p.advance(); err != nil { // consume "field"
+		return "", err
+	}
+	if p.cur.Kind != TokEq {
+		return "", newParseError(p.cur.Pos, ErrUnexpectedToken, "expected '=' after 'field'")
+	}
+	if err := p.advance(); err != nil { // consume "="
+		return "", err
+	}
+	if p.cur.Kind != TokWord {
+		return "", newParseError(p.cur.Pos, ErrUnexpectedToken, "expected field name after 'field='")
+	}
+	field := p.cur.Lit
+	if err := p.advance(); err != nil {
+		return "", err
+	}
+	return field, nil
+}
+
 // parseScatterOp parses: "scatter" X_FIELD Y_FIELD
 func (p *parser) parseScatterOp() (*ScatterOp, error) {
 	if err := p.advance(); err != nil { // consume "scatter"
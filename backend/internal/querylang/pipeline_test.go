@@ -2,6 +2,7 @@ package querylang
 
 import (
 	"errors"
+	"slices"
 	"testing"
 )
 
@@ -243,6 +244,29 @@ func TestParsePipelineStatsMultipleAggs(t *testing.T) {
 	}
 }
 
+func TestParsePipelineStatsPercentile(t *testing.T) {
+	p, err := ParsePipeline("error | stats p95(latency), percentile(latency, 99.9) by service")
+	if err != nil {
+		t.Fatalf("ParsePipeline error: %v", err)
+	}
+	stats := p.Pipes[0].(*StatsOp)
+	if len(stats.Aggs) != 2 {
+		t.Fatalf("expected 2 aggs, got %d", len(stats.Aggs))
+	}
+	if got := stats.Aggs[0].DefaultAlias(); got != "p95_latency" {
+		t.Errorf("agg 0 alias = %q, want p95_latency", got)
+	}
+	if stats.Aggs[1].Func != "percentile" || stats.Aggs[1].Param != "99.9" {
+		t.Errorf("agg 1 = %s/%q, want percentile/99.9", stats.Aggs[1].Func, stats.Aggs[1].Param)
+	}
+	if got := stats.Aggs[1].DefaultAlias(); got != "p99.9_latency" {
+		t.Errorf("agg 1 alias = %q, want p99.9_latency", got)
+	}
+	if got := stats.Aggs[1].String(); got != "percentile(latency, 99.9)" {
+		t.Errorf("agg 1 String() = %q", got)
+	}
+}
+
 func TestParsePipelineStatsBin(t *testing.T) {
 	p, err := ParsePipeline("error | stats count by bin(5m)")
 	if err != nil {
@@ -633,6 +657,8 @@ func TestParsePipelineErrors(t *testing.T) {
 		{"where pipe", "error | where |", ErrUnexpectedToken},
 		{"by without group", "error | stats count by", ErrUnexpectedToken},
 		{"duplicate alias", "error | stats count, count", ErrUnexpectedToken},
+		{"percentile non-numeric param", "error | stats percentile(latency, high)", ErrUnexpectedToken},
+		{"percentile missing param", "error | stats percentile(latency,)", ErrUnexpectedToken},
 	}
 
 	for _, tt := range tests {
@@ -1014,6 +1040,58 @@ func TestParsePipelineNewOpsString(t *testing.T) {
 			"error | slice 12 54",
 			"token(error) | slice 12 54",
 		},
+		{
+			"error | top host",
+			"token(error) | top 10 host",
+		},
+		{
+			"error | top 5 host status by service showother=true",
+			"token(error) | top 5 host, status by service showother=true",
+		},
+		{
+			"error | rare showother=false 3 user_agent",
+			"token(error) | rare 3 user_agent",
+		},
+		{
+			"error | eventstats avg(latency) as svc_avg by service",
+			"token(error) | eventstats avg(latency) as svc_avg by service",
+		},
+		{
+			"error | streamstats count",
+			"token(error) | streamstats count",
+		},
+		{
+			"error | streamstats time_window=5m window=10 count, max(bytes) by host",
+			"token(error) | streamstats window=10 time_window=5m count, max(bytes) by host",
+		},
+		{
+			"error | transaction session_id maxpause=30m",
+			"token(error) | transaction session_id maxpause=30m",
+		},
+		{
+			`error | transaction host request_id maxevents=50 endswith="msg=done" startswith="msg=start" maxspan=1m maxopen=100`,
+			`token(error) | transaction host, request_id maxspan=1m startswith="msg=start" endswith="msg=done" maxopen=100 maxevents=50`,
+		},
+		{
+			"error | transaction maxpause=5s",
+			"token(error) | transaction maxpause=5s",
+		},
+		{
+			`error | parse "user=* ip=*" as user, ip`,
+			`token(error) | parse "user=* ip=*" as user, ip`,
+		},
+		{
+			`error | parse field=msg "took *ms" as took`,
+			`token(error) | parse field=msg "took *ms" as took`,
+		},
+		{
+			`error | rex /(?P<method>\w+) (?P<path>\S+)/`,
+			`token(error) | rex /(?P<method>\w+) (?P<path>\S+)/`,
+		},
+		{
+			`error | rex field=url "^/(?P<section>[a-z]+)"`,
+			`token(error) | rex field=url /^\/(?P<section>[a-z]+)/`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1079,6 +1157,42 @@ func TestParsePipelineNewOpsErrors(t *testing.T) {
 		{"timechart negative large", "error | timechart -50"},
 		{"timechart not number", "error | timechart abc"},
 		{"timechart by missing field", "error | timechart 50 by"},
+		// top / rare
+		{"top no field", "error | top"},
+		{"top count only", "error | top 5"},
+		{"top zero", "error | top 0 host"},
+		{"top by no field", "error | top host by"},
+		{"rare showother no value", "error | rare host showother="},
+		{"rare showother not bool", "error | rare host showother=maybe"},
+		// eventstats / streamstats
+		{"eventstats no aggs", "error | eventstats"},
+		{"eventstats by missing group", "error | eventstats count by"},
+		{"streamstats no aggs", "error | streamstats"},
+		{"streamstats options only", "error | streamstats window=5"},
+		{"streamstats window no equals", "error | streamstats window 5 count"},
+		// transaction
+		{"transaction option no equals", "error | transaction host maxpause 5m"},
+		{"transaction option no value", "error | transaction host maxspan="},
+		{"transaction bad duration", "error | transaction host maxspan=soon"},
+		{"transaction bad filter", `error | transaction host startswith="(a"`},
+		{"transaction zero maxopen", "error | transaction host maxopen=0"},
+		{"transaction trailing junk", "error | transaction host maxevents=5 ("},
+		{"streamstats window zero", "error | streamstats window=0 count"},
+		{"streamstats window not number", "error | streamstats window=abc count"},
+		{"streamstats bad time_window", "error | streamstats time_window=soon count"},
+		// parse
+		{"parse no pattern", "error | parse"},
+		{"parse unquoted pattern", "error | parse user=* as user"},
+		{"parse no as", `error | parse "user=*" user`},
+		{"parse no names", `error | parse "user=*" as`},
+		{"parse no wildcard", `error | parse "user=" as user`},
+		{"parse too few names", `error | parse "user=* ip=*" as user`},
+		{"parse too many names", `error | parse "user=*" as user, ip`},
+		{"parse bad field", `error | parse field= "user=*" as user`},
+		// rex
+		{"rex no pattern", "error | rex"},
+		{"rex no named group", "error | rex /(\\w+)/"},
+		{"rex invalid regex", "error | rex /(?P<x>[/"},
 		// lookup
 		{"lookup no args", "error | lookup"},
 		{"lookup one arg", "error | lookup rdns"},
@@ -1969,3 +2083,114 @@ func TestHasExportOpNilPipeline(t *testing.T) {
 		t.Fatal("expected HasExportOp to return false for nil pipeline")
 	}
 }
+
+func TestParsePipelineParse(t *testing.T) {
+	p, err := ParsePipeline(`error | parse "user=* ip=*" as user, ip`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Pipes) != 1 {
+		t.Fatalf("expected 1 pipe, got %d", len(p.Pipes))
+	}
+	op, ok := p.Pipes[0].(*ParseOp)
+	if !ok {
+		t.Fatalf("expected *ParseOp, got %T", p.Pipes[0])
+	}
+	if op.Field != "" || op.Pattern != "user=* ip=*" {
+		t.Errorf("got field=%q pattern=%q", op.Field, op.Pattern)
+	}
+	if !slices.Equal(op.Names, []string{"user", "ip"}) {
+		t.Errorf("names = %v, want [user ip]", op.Names)
+	}
+}
+
+func TestParsePipelineRex(t *testing.T) {
+	p, err := ParsePipeline(`error | rex field=msg /(?P<method>[A-Z]+) \/(?P<path>\S*)/ | stats count by method`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Pipes) != 2 {
+		t.Fatalf("expected 2 pipes, got %d", len(p.Pipes))
+	}
+	op, ok := p.Pipes[0].(*RexOp)
+	if !ok {
+		t.Fatalf("expected *RexOp, got %T", p.Pipes[0])
+	}
+	if op.Field != "msg" {
+		t.Errorf("field = %q, want msg", op.Field)
+	}
+	if want := `(?P<method>[A-Z]+) /(?P<path>\S*)`; op.Pattern != want {
+		t.Errorf("pattern = %q, want %q", op.Pattern, want)
+	}
+	if _, ok := p.Pipes[1].(*StatsOp); !ok {
+		t.Errorf("expected *StatsOp after rex, got %T", p.Pipes[1])
+	}
+}
+
+func TestParsePipelineStreamstats(t *testing.T) {
+	p, err := ParsePipeline("error | streamstats window=3 time_window=10s avg(latency) as moving by host")
+	if err != nil {
+		t.Fatal(err)
+	}
+	op, ok := p.Pipes[0].(*StreamstatsOp)
+	if !ok {
+		t.Fatalf("expected *StreamstatsOp, got %T", p.Pipes[0])
+	}
+	if op.Window != 3 || op.TimeWindow != "10s" {
+		t.Errorf("window=%d time_window=%q, want 3 and 10s", op.Window, op.TimeWindow)
+	}
+	if len(op.Aggs) != 1 || op.Aggs[0].DefaultAlias() != "moving" {
+		t.Errorf("aggs = %v", op.Aggs)
+	}
+	if len(op.Groups) != 1 || op.Groups[0].Field.Name != "host" {
+		t.Errorf("groups = %v", op.Groups)
+	}
+}
+
+func TestParsePipelineTop(t *testing.T) {
+	p, err := ParsePipeline("error | top 3 host, path by service showother=true | head 5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	op, ok := p.Pipes[0].(*TopOp)
+	if !ok {
+		t.Fatalf("expected *TopOp, got %T", p.Pipes[0])
+	}
+	if op.Rare || op.N != 3 || !op.ShowOther {
+		t.Errorf("rare=%v n=%d showother=%v", op.Rare, op.N, op.ShowOther)
+	}
+	if !slices.Equal(op.Fields, []string{"host", "path"}) || !slices.Equal(op.By, []string{"service"}) {
+		t.Errorf("fields=%v by=%v", op.Fields, op.By)
+	}
+	if _, ok := p.Pipes[1].(*HeadOp); !ok {
+		t.Errorf("expected *HeadOp after top, got %T", p.Pipes[1])
+	}
+
+	p, err = ParsePipeline("error | rare user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op := p.Pipes[0].(*TopOp); !op.Rare || op.N != DefaultTopN {
+		t.Errorf("rare=%v n=%d, want rare with default N", op.Rare, op.N)
+	}
+}
+
+func TestParsePipelineTransaction(t *testing.T) {
+	p, err := ParsePipeline(`error | transaction "user id", session startswith="action=login" maxpause=15m | stats avg(duration)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	op, ok := p.Pipes[0].(*TransactionOp)
+	if !ok {
+		t.Fatalf("expected *TransactionOp, got %T", p.Pipes[0])
+	}
+	if !slices.Equal(op.Fields, []string{"user id", "session"}) {
+		t.Errorf("fields = %v", op.Fields)
+	}
+	if op.StartsWith != "action=login" || op.MaxPause != "15m" || op.MaxSpan != "" || op.EndsWith != "" {
+		t.Errorf("options = %+v", op)
+	}
+	if _, ok := p.Pipes[1].(*StatsOp); !ok {
+		t.Errorf("expected *StatsOp after transaction, got %T", p.Pipes[1])
+	}
+}
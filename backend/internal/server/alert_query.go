@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"gastrolog/internal/alertrule"
+	"gastrolog/internal/query"
+	"gastrolog/internal/querylang"
+)
+
+// RunAlertQuery runs an alert rule's query across the cluster and returns
+// the result the rule's condition is evaluated against.
+//
+// Aggregating queries (stats, timechart) return their merged table. Any
+// other query is counted by appending "| stats count", so matching records
+// are aggregated per node instead of being shipped to the coordinator.
+func (s *QueryServer) RunAlertQuery(ctx context.Context, expression string) (alertrule.Result, error) {
+	if s.queryTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.queryTimeout)
+		defer cancel()
+	}
+
+	q, pipeline, err := parseExpression(expression)
+	if err != nil {
+		return alertrule.Result{}, err
+	}
+	if pipeline != nil {
+		if _, hasExport := querylang.HasExportOp(pipeline); hasExport {
+			return alertrule.Result{}, errors.New("alert queries cannot use | export")
+		}
+	}
+
+	countOnly := !pipelineAggregates(pipeline)
+	if countOnly {
+		// Strip comments first so a trailing "# ..." can't swallow the
+		// appended operator.
+		q, pipeline, err = parseExpression(querylang.StripComments(expression) + " | stats count")
+		if err != nil {
+			return alertrule.Result{}, err
+		}
+	}
+
+	q = s.resolveUnboundedQuery(ctx, q)
+
+	eng := s.orch.LeaderVaultQueryEngine()
+	if s.lookupResolver != nil {
+		eng.SetLookupResolver(s.lookupResolver)
+	}
+
+	var table *query.TableResult
+	if query.PipelineNeedsGlobalRecords(pipeline) {
+		result, err := eng.RunPipelineOnRecords(ctx, q, pipeline, s.drainRemoteRecords(ctx, q))
+		if err != nil {
+			return alertrule.Result{}, err
+		}
+		table = result.Table
+	} else if query.PipelineMergesPartialStats(pipeline) {
+		table, err = s.runPartialStats(ctx, eng, q, pipeline)
+		if err != nil {
+			return alertrule.Result{}, err
+		}
+	} else {
+		result, err := eng.RunPipeline(ctx, q, pipeline)
+		if err != nil {
+			return alertrule.Result{}, err
+		}
+		table = result.Table
+		if table != nil {
+			if remote := s.collectRemotePipeline(ctx, q, pipeline, false); len(remote) > 0 {
+				table = mergeTableResults(table, remote)
+			}
+		}
+	}
+	if table == nil {
+		return alertrule.Result{}, errors.New("query did not produce a result table")
+	}
+
+	if countOnly {
+		var n int64
+		if len(table.Rows) > 0 && len(table.Rows[0]) > 0 {
+			n, err = strconv.ParseInt(table.Rows[0][0], 10, 64)
+			if err != nil {
+				return alertrule.Result{}, fmt.Errorf("parse count: %w", err)
+			}
+		}
+		return alertrule.Result{Count: n}, nil
+	}
+	return alertrule.Result{
+		Count:   int64(len(table.Rows)),
+		Columns: table.Columns,
+		Rows:    table.Rows,
+	}, nil
+}
+
+// pipelineAggregates reports whether the pipeline produces a table through
+// stats, top, rare or timechart.
+func pipelineAggregates(pipeline *querylang.Pipeline) bool {
+	if pipeline == nil {
+		return false
+	}
+	for _, op := range pipeline.Pipes {
+		switch op.(type) {
+		case *querylang.StatsOp, *querylang.TopOp, *querylang.TimechartOp:
+			return true
+		}
+	}
+	return false
+}
+
+// AlertQueryFunc returns a function suitable for alertrule.Manager.SetSearch.
+// The query server is created when the handler is built, so evaluations
+// before that report an error instead of running.
+func (s *Server) AlertQueryFunc() alertrule.SearchFunc {
+	return func(ctx context.Context, expression string) (alertrule.Result, error) {
+		if s.queryServer == nil {
+			return alertrule.Result{}, errors.New("query server not started")
+		}
+		return s.queryServer.RunAlertQuery(ctx, expression)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"log/slog"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 	"unicode/utf8"
 
@@ -28,6 +29,11 @@ type AuthServer struct {
 	tokens   *auth.TokenService
 	logger   *slog.Logger
 	noAuth   bool
+
+	// OIDC client for the current SSO settings, rebuilt when they change.
+	oidcMu  sync.Mutex
+	oidcCfg system.OIDCConfig
+	oidc    *auth.OIDCClient
 }
 
 var _ gastrologv1connect.AuthServiceHandler = (*AuthServer)(nil)
@@ -232,7 +238,8 @@ func (s *AuthServer) Login(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("get user: %w", err))
 	}
-	if user == nil {
+	if user == nil || user.ExternalID != "" {
+		// SSO users have no password.
 		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid credentials"))
 	}
 
@@ -366,6 +373,9 @@ func (s *AuthServer) ChangePassword(
 	if user == nil {
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
 	}
+	if user.ExternalID != "" {
+		return nil, errSSOPassword()
+	}
 
 	ok, err := auth.VerifyPassword(oldPassword, user.PasswordHash)
 	if err != nil {
@@ -414,11 +424,36 @@ func (s *AuthServer) GetAuthStatus(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("count users: %w", err))
 	}
 
+	ss, err := s.cfgStore.LoadServerSettings(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("load settings: %w", err))
+	}
+
 	return connect.NewResponse(&apiv1.GetAuthStatusResponse{
-		NeedsSetup: count == 0,
+		NeedsSetup:  count == 0,
+		OidcEnabled: ss.Auth.OIDC.Enabled && !s.noAuth,
 	}), nil
 }
 
+// checkRole verifies that role is a built-in role or the name of an
+// existing custom role.
+func (s *AuthServer) checkRole(ctx context.Context, role string) *connect.Error {
+	if system.IsBuiltinRole(role) {
+		return nil
+	}
+	roles, err := s.cfgStore.ListRoles(ctx)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("list roles: %w", err))
+	}
+	for _, r := range roles {
+		if r.Name == role {
+			return nil
+		}
+	}
+	return connect.NewError(connect.CodeInvalidArgument,
+		fmt.Errorf("role must be \"admin\", \"user\", or a defined role; %q is not defined", role))
+}
+
 // CreateUser creates a new user account. Admin only.
 func (s *AuthServer) CreateUser(
 	ctx context.Context,
@@ -437,9 +472,8 @@ func (s *AuthServer) CreateUser(
 		return nil, errInvalidArg(err)
 	}
 
-	if role != "admin" && role != "user" {
-		return nil, connect.NewError(connect.CodeInvalidArgument,
-			errors.New("role must be \"admin\" or \"user\""))
+	if connErr := s.checkRole(ctx, role); connErr != nil {
+		return nil, connErr
 	}
 
 	existing, err := s.cfgStore.GetUserByUsername(ctx, username)
@@ -501,9 +535,8 @@ func (s *AuthServer) UpdateUserRole(
 	userID := glid.FromBytes(req.Msg.Id)
 	role := req.Msg.Role
 
-	if role != "admin" && role != "user" {
-		return nil, connect.NewError(connect.CodeInvalidArgument,
-			errors.New("role must be \"admin\" or \"user\""))
+	if connErr := s.checkRole(ctx, role); connErr != nil {
+		return nil, connErr
 	}
 
 	user, err := s.cfgStore.GetUser(ctx, userID)
@@ -556,6 +589,9 @@ func (s *AuthServer) ResetPassword(
 	if user == nil {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("user %q not found", userID))
 	}
+	if user.ExternalID != "" {
+		return nil, errSSOPassword()
+	}
 
 	hash, err := auth.HashPassword(newPassword)
 	if err != nil {
@@ -691,5 +727,6 @@ func userToProto(u system.User) *apiv1.UserInfo {
 		Role:      u.Role,
 		CreatedAt: u.CreatedAt.Unix(),
 		UpdatedAt: u.UpdatedAt.Unix(),
+		Sso:       u.ExternalID != "",
 	}
 }
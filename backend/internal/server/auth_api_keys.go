@@ -0,0 +1,174 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gastrolog/internal/glid"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+
+	apiv1 "gastrolog/api/gen/gastrolog/v1"
+	"gastrolog/internal/auth"
+	"gastrolog/internal/system"
+)
+
+// apiKeyTouchInterval bounds how often a key's last-used time is written.
+// Every touch is a Raft command, so busy keys are recorded at most once per
+// interval rather than on every request.
+const apiKeyTouchInterval = time.Minute
+
+// apiKeyValidator adapts system.Store to auth.APIKeyValidator.
+type apiKeyValidator struct {
+	cfgStore system.Store
+}
+
+func (v *apiKeyValidator) ValidateAPIKey(ctx context.Context, keyHash string) (*auth.APIKeyPrincipal, error) {
+	key, err := v.cfgStore.GetAPIKeyByHash(ctx, keyHash)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	if key == nil || key.Expired(now) {
+		return nil, nil
+	}
+	if now.Sub(key.LastUsedAt) >= apiKeyTouchInterval {
+		// Best-effort: a failed touch must not fail the request.
+		_ = v.cfgStore.TouchAPIKey(ctx, key.ID, now)
+	}
+	return &auth.APIKeyPrincipal{
+		ID:     key.ID.String(),
+		Name:   key.Name,
+		Scopes: slices.Clone(key.Scopes),
+	}, nil
+}
+
+// CreateAPIKey creates a new API key. The key is returned once; only its
+// hash is stored. Admin only.
+func (s *AuthServer) CreateAPIKey(
+	ctx context.Context,
+	req *connect.Request[apiv1.CreateAPIKeyRequest],
+) (*connect.Response[apiv1.CreateAPIKeyResponse], error) {
+	if req.Msg.Name == "" {
+		return nil, errRequired("name")
+	}
+
+	now := time.Now().UTC()
+	var expiresAt time.Time
+	if req.Msg.ExpiresIn != "" {
+		d, err := time.ParseDuration(req.Msg.ExpiresIn)
+		if err != nil {
+			return nil, errInvalidArg(fmt.Errorf("invalid expires_in: %w", err))
+		}
+		if d <= 0 {
+			return nil, errInvalidArg(errors.New("expires_in must be positive"))
+		}
+		expiresAt = now.Add(d)
+	}
+
+	existing, err := s.cfgStore.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list api keys: %w", err))
+	}
+	for _, k := range existing {
+		if k.Name == req.Msg.Name {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("api key %q already exists", req.Msg.Name))
+		}
+	}
+
+	secret, hash, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	key := system.APIKey{
+		ID:        glid.New(),
+		Name:      req.Msg.Name,
+		KeyHash:   hash,
+		Scopes:    req.Msg.Scopes,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}
+	if claims := auth.ClaimsFromContext(ctx); claims != nil {
+		key.CreatedBy = claims.Username()
+	}
+	if err := key.Validate(); err != nil {
+		return nil, errInvalidArg(err)
+	}
+
+	if err := s.cfgStore.CreateAPIKey(ctx, key); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("create api key: %w", err))
+	}
+
+	return connect.NewResponse(&apiv1.CreateAPIKeyResponse{
+		ApiKey: apiKeyToProto(key),
+		Key:    secret,
+	}), nil
+}
+
+// ListAPIKeys returns all API keys without their secrets. Admin only.
+func (s *AuthServer) ListAPIKeys(
+	ctx context.Context,
+	req *connect.Request[apiv1.ListAPIKeysRequest],
+) (*connect.Response[apiv1.ListAPIKeysResponse], error) {
+	keys, err := s.cfgStore.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list api keys: %w", err))
+	}
+
+	infos := make([]*apiv1.APIKeyInfo, len(keys))
+	for i, k := range keys {
+		infos[i] = apiKeyToProto(k)
+	}
+
+	return connect.NewResponse(&apiv1.ListAPIKeysResponse{
+		ApiKeys: infos,
+	}), nil
+}
+
+// DeleteAPIKey revokes an API key. Admin only.
+func (s *AuthServer) DeleteAPIKey(
+	ctx context.Context,
+	req *connect.Request[apiv1.DeleteAPIKeyRequest],
+) (*connect.Response[apiv1.DeleteAPIKeyResponse], error) {
+	if len(req.Msg.Id) == 0 {
+		return nil, errRequired("id")
+	}
+	id, connErr := parseProtoID(req.Msg.Id)
+	if connErr != nil {
+		return nil, connErr
+	}
+
+	keys, err := s.cfgStore.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list api keys: %w", err))
+	}
+	if !slices.ContainsFunc(keys, func(k system.APIKey) bool { return k.ID == id }) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("api key %q not found", id))
+	}
+
+	if err := s.cfgStore.DeleteAPIKey(ctx, id); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("delete api key: %w", err))
+	}
+
+	return connect.NewResponse(&apiv1.DeleteAPIKeyResponse{}), nil
+}
+
+func apiKeyToProto(k system.APIKey) *apiv1.APIKeyInfo {
+	info := &apiv1.APIKeyInfo{
+		Id:        k.ID.ToProto(),
+		Name:      k.Name,
+		Scopes:    k.Scopes,
+		CreatedBy: k.CreatedBy,
+		CreatedAt: k.CreatedAt.Unix(),
+	}
+	if !k.ExpiresAt.IsZero() {
+		info.ExpiresAt = k.ExpiresAt.Unix()
+	}
+	if !k.LastUsedAt.IsZero() {
+		info.LastUsedAt = k.LastUsedAt.Unix()
+	}
+	return info
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gastrolog/internal/auth"
+	"gastrolog/internal/glid"
+	"gastrolog/internal/system"
+	sysmem "gastrolog/internal/system/memory"
+)
+
+func TestAPIKeyValidator(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	store := sysmem.NewStore()
+	now := time.Now().UTC()
+
+	live := system.APIKey{ID: glid.New(), Name: "live", KeyHash: auth.HashAPIKey("live"), Scopes: []string{"query"}, CreatedAt: now}
+	expired := system.APIKey{ID: glid.New(), Name: "expired", KeyHash: auth.HashAPIKey("expired"), Scopes: []string{"query"}, ExpiresAt: now.Add(-time.Minute), CreatedAt: now}
+	for _, k := range []system.APIKey{live, expired} {
+		if err := store.CreateAPIKey(ctx, k); err != nil {
+			t.Fatal(err)
+		}
+	}
+	v := &apiKeyValidator{cfgStore: store}
+
+	p, err := v.ValidateAPIKey(ctx, auth.HashAPIKey("live"))
+	if err != nil {
+		t.Fatalf("ValidateAPIKey: %v", err)
+	}
+	if p == nil || p.Name != "live" || p.ID != live.ID.String() {
+		t.Fatalf("principal = %+v, want live key", p)
+	}
+	got, err := store.GetAPIKeyByHash(ctx, live.KeyHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.LastUsedAt.IsZero() {
+		t.Error("LastUsedAt not recorded")
+	}
+
+	for _, key := range []string{"expired", "unknown"} {
+		p, err := v.ValidateAPIKey(ctx, auth.HashAPIKey(key))
+		if err != nil {
+			t.Fatalf("ValidateAPIKey(%s): %v", key, err)
+		}
+		if p != nil {
+			t.Errorf("%s key should be rejected, got %+v", key, p)
+		}
+	}
+}
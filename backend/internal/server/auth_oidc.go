@@ -0,0 +1,253 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gastrolog/internal/glid"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+
+	apiv1 "gastrolog/api/gen/gastrolog/v1"
+	"gastrolog/internal/auth"
+	"gastrolog/internal/system"
+)
+
+// BeginOIDCLogin returns the identity provider's authorization URL and sets
+// the state cookie that binds the login to this browser.
+func (s *AuthServer) BeginOIDCLogin(
+	ctx context.Context,
+	req *connect.Request[apiv1.BeginOIDCLoginRequest],
+) (*connect.Response[apiv1.BeginOIDCLoginResponse], error) {
+	client, cfg, connErr := s.oidcClient(ctx)
+	if connErr != nil {
+		return nil, connErr
+	}
+
+	state, nonce, cookie, err := s.tokens.IssueOIDCState()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	authURL, err := client.AuthCodeURL(ctx, state, nonce)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("identity provider: %w", err))
+	}
+
+	resp := connect.NewResponse(&apiv1.BeginOIDCLoginResponse{AuthorizationUrl: authURL})
+	resp.Header().Add("Set-Cookie", oidcStateCookie(cfg, cookie, int(auth.OIDCStateTTL.Seconds())).String())
+	return resp, nil
+}
+
+// CompleteOIDCLogin redeems the authorization code, provisions or updates
+// the user, and issues the same token pair as Login.
+func (s *AuthServer) CompleteOIDCLogin(
+	ctx context.Context,
+	req *connect.Request[apiv1.CompleteOIDCLoginRequest],
+) (*connect.Response[apiv1.CompleteOIDCLoginResponse], error) {
+	if req.Msg.Code == "" {
+		return nil, errRequired("code")
+	}
+	if req.Msg.State == "" {
+		return nil, errRequired("state")
+	}
+
+	client, cfg, connErr := s.oidcClient(ctx)
+	if connErr != nil {
+		return nil, connErr
+	}
+
+	var stateCookie string
+	if cookies, err := http.ParseCookie(req.Header().Get("Cookie")); err == nil {
+		for _, c := range cookies {
+			if c.Name == auth.OIDCStateCookie {
+				stateCookie = c.Value
+			}
+		}
+	}
+	if stateCookie == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("login state cookie missing; start the sign-in again"))
+	}
+	nonce, err := s.tokens.VerifyOIDCState(stateCookie, req.Msg.State)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	claims, err := client.Exchange(ctx, req.Msg.Code, nonce)
+	if err != nil {
+		s.logger.Warn("oidc login failed", "err", err)
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("single sign-on failed: %w", err))
+	}
+
+	user, connErr := s.provisionOIDCUser(ctx, cfg, claims)
+	if connErr != nil {
+		return nil, connErr
+	}
+
+	token, expiresAt, err := s.tokens.Issue(user.ID.String(), user.Username, user.Role)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("issue token: %w", err))
+	}
+	refreshToken, err := s.issueRefreshToken(ctx, user.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("issue refresh token: %w", err))
+	}
+
+	resp := connect.NewResponse(&apiv1.CompleteOIDCLoginResponse{
+		Token: &apiv1.Token{
+			Token:     token,
+			ExpiresAt: expiresAt.Unix(),
+		},
+		RefreshToken: refreshToken,
+	})
+	resp.Header().Add("Set-Cookie", oidcStateCookie(cfg, "", -1).String())
+	return resp, nil
+}
+
+// oidcClient returns a client for the current SSO settings. The client is
+// cached while the settings are unchanged so its discovery document and
+// signing keys are fetched once.
+func (s *AuthServer) oidcClient(ctx context.Context) (*auth.OIDCClient, system.OIDCConfig, *connect.Error) {
+	if s.noAuth {
+		return nil, system.OIDCConfig{}, connect.NewError(connect.CodeFailedPrecondition, errors.New("authentication is disabled"))
+	}
+	ss, err := s.cfgStore.LoadServerSettings(ctx)
+	if err != nil {
+		return nil, system.OIDCConfig{}, connect.NewError(connect.CodeInternal, fmt.Errorf("load settings: %w", err))
+	}
+	cfg := ss.Auth.OIDC
+	if !cfg.Enabled {
+		return nil, cfg, connect.NewError(connect.CodeFailedPrecondition, errors.New("single sign-on is not configured"))
+	}
+
+	s.oidcMu.Lock()
+	defer s.oidcMu.Unlock()
+	if s.oidc == nil || !reflect.DeepEqual(s.oidcCfg, cfg) {
+		s.oidc = auth.NewOIDCClient(auth.OIDCProviderConfig{
+			IssuerURL:    cfg.IssuerURL,
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       cfg.Scopes,
+		}, nil)
+		s.oidcCfg = cfg
+	}
+	return s.oidc, cfg, nil
+}
+
+// provisionOIDCUser finds the user for the ID token's issuer and subject,
+// creating it on first login. The role is re-derived from the groups claim
+// on every login; other sessions pick up a changed role on their next
+// token refresh.
+func (s *AuthServer) provisionOIDCUser(ctx context.Context, cfg system.OIDCConfig, claims jwt.MapClaims) (*system.User, *connect.Error) {
+	iss, _ := claims["iss"].(string)
+	sub, _ := claims["sub"].(string)
+	if sub == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("id token has no subject"))
+	}
+	externalID := iss + "|" + sub
+
+	usernameClaim := cfg.UsernameClaimOrDefault()
+	username, _ := claims[usernameClaim].(string)
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("id token has no %q claim", usernameClaim))
+	}
+
+	groups := claimStrings(claims[cfg.GroupsClaimOrDefault()])
+	role := cfg.RoleFor(groups)
+	if role == "" {
+		return nil, connect.NewError(connect.CodePermissionDenied,
+			fmt.Errorf("user %q is not in any group mapped to a role", username))
+	}
+	if connErr := s.checkRole(ctx, role); connErr != nil {
+		if connErr.Code() != connect.CodeInvalidArgument {
+			return nil, connErr
+		}
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("role %q mapped from SSO groups is not defined", role))
+	}
+
+	users, err := s.cfgStore.ListUsers(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list users: %w", err))
+	}
+	for _, u := range users {
+		if u.ExternalID != externalID {
+			continue
+		}
+		if u.Role != role {
+			if err := s.cfgStore.UpdateUserRole(ctx, u.ID, role); err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("update role: %w", err))
+			}
+			u.Role = role
+		}
+		return &u, nil
+	}
+
+	// First login. Never take over a local account with the same name.
+	existing, err := s.cfgStore.GetUserByUsername(ctx, username)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("check user: %w", err))
+	}
+	if existing != nil {
+		return nil, connect.NewError(connect.CodeAlreadyExists,
+			fmt.Errorf("username %q is already taken by another account", username))
+	}
+
+	now := time.Now().UTC()
+	user := system.User{
+		ID:         glid.New(),
+		Username:   username,
+		Role:       role,
+		ExternalID: externalID,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := s.cfgStore.CreateUser(ctx, user); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("create user: %w", err))
+	}
+	s.logger.Info("provisioned sso user", "username", username, "role", role)
+	return &user, nil
+}
+
+// claimStrings reads a claim that may be a single string or a list.
+func claimStrings(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		out := make([]string, 0, len(v))
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+// oidcStateCookie builds the login state cookie. maxAge < 0 deletes it.
+func oidcStateCookie(cfg system.OIDCConfig, value string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     auth.OIDCStateCookie,
+		Value:    value,
+		Path:     "/",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   strings.HasPrefix(cfg.RedirectURL, "https://"),
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+// errSSOPassword is returned when password operations target an SSO user.
+func errSSOPassword() *connect.Error {
+	return connect.NewError(connect.CodeFailedPrecondition,
+		errors.New("password is managed by the identity provider for single sign-on users"))
+}
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	apiv1 "gastrolog/api/gen/gastrolog/v1"
+	"gastrolog/internal/auth"
+	"gastrolog/internal/auth/oidctest"
+	"gastrolog/internal/glid"
+	"gastrolog/internal/system"
+	sysmem "gastrolog/internal/system/memory"
+)
+
+func newOIDCTestServer(t *testing.T) (*AuthServer, *oidctest.Provider, system.Store) {
+	t.Helper()
+	idp := oidctest.New(t, "gastrolog", "s3cret")
+	store := sysmem.NewStore()
+	ss := system.ServerSettings{}
+	ss.Auth.OIDC = system.OIDCConfig{
+		Enabled:      true,
+		IssuerURL:    idp.URL,
+		ClientID:     "gastrolog",
+		ClientSecret: "s3cret",
+		RedirectURL:  "http://localhost:4564/auth/callback",
+		Scopes:       []string{"profile", "groups"},
+		RoleMappings: []system.OIDCRoleMapping{{Group: "ops", Role: "admin"}, {Group: "dev", Role: "user"}},
+	}
+	if err := store.SaveServerSettings(context.Background(), ss); err != nil {
+		t.Fatal(err)
+	}
+	tokens := auth.NewTokenService([]byte("test-secret-key-32-bytes-long!!"), time.Hour)
+	return NewAuthServer(store, tokens, nil, false), idp, store
+}
+
+// ssoLogin runs Begin → IdP → Complete as the browser would.
+func ssoLogin(t *testing.T, s *AuthServer, idp *oidctest.Provider, claims map[string]any) (*apiv1.CompleteOIDCLoginResponse, error) {
+	t.Helper()
+	ctx := context.Background()
+	begin, err := s.BeginOIDCLogin(ctx, connect.NewRequest(&apiv1.BeginOIDCLoginRequest{}))
+	if err != nil {
+		t.Fatalf("BeginOIDCLogin: %v", err)
+	}
+	setCookie, err := http.ParseSetCookie(begin.Header().Get("Set-Cookie"))
+	if err != nil {
+		t.Fatalf("state cookie: %v", err)
+	}
+	code, state, err := idp.Authorize(begin.Msg.AuthorizationUrl, claims)
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+
+	req := connect.NewRequest(&apiv1.CompleteOIDCLoginRequest{Code: code, State: state})
+	req.Header().Set("Cookie", setCookie.Name+"="+setCookie.Value)
+	resp, err := s.CompleteOIDCLogin(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+func TestCompleteOIDCLogin_ProvisionsAndMapsRole(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	s, idp, store := newOIDCTestServer(t)
+
+	resp, err := ssoLogin(t, s, idp, map[string]any{
+		"sub": "u-1", "preferred_username": "alice", "groups": []string{"dev"},
+	})
+	if err != nil {
+		t.Fatalf("CompleteOIDCLogin: %v", err)
+	}
+	claims, err := s.tokens.Verify(resp.Token.Token)
+	if err != nil {
+		t.Fatalf("verify token: %v", err)
+	}
+	if claims.Username() != "alice" || claims.Role != "user" {
+		t.Errorf("claims = %s/%s, want alice/user", claims.Username(), claims.Role)
+	}
+	if resp.RefreshToken == "" {
+		t.Error("missing refresh token")
+	}
+
+	user, err := store.GetUserByUsername(ctx, "alice")
+	if err != nil || user == nil {
+		t.Fatalf("user not provisioned: %v", err)
+	}
+	if user.ExternalID != idp.URL+"|u-1" || user.PasswordHash != "" {
+		t.Errorf("unexpected user %+v", user)
+	}
+
+	// Second login with a different group updates the role in place.
+	resp, err = ssoLogin(t, s, idp, map[string]any{
+		"sub": "u-1", "preferred_username": "alice", "groups": []string{"ops"},
+	})
+	if err != nil {
+		t.Fatalf("second login: %v", err)
+	}
+	if claims, _ := s.tokens.Verify(resp.Token.Token); claims == nil || claims.Role != "admin" {
+		t.Errorf("role after regroup = %v, want admin", claims)
+	}
+	users, _ := store.ListUsers(ctx)
+	if len(users) != 1 {
+		t.Errorf("got %d users, want 1", len(users))
+	}
+
+	// SSO users cannot use the password login.
+	_, err = s.Login(ctx, connect.NewRequest(&apiv1.LoginRequest{Username: "alice", Password: ""}))
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("password login code = %v, want unauthenticated", connect.CodeOf(err))
+	}
+}
+
+func TestCompleteOIDCLogin_Rejections(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	s, idp, store := newOIDCTestServer(t)
+
+	_, err := ssoLogin(t, s, idp, map[string]any{
+		"sub": "u-2", "preferred_username": "bob", "groups": []string{"sales"},
+	})
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("unmapped group: code = %v, want permission_denied", connect.CodeOf(err))
+	}
+
+	if err := store.CreateUser(ctx, system.User{ID: glid.New(), Username: "carol", Role: "user"}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = ssoLogin(t, s, idp, map[string]any{
+		"sub": "u-3", "preferred_username": "carol", "groups": []string{"ops"},
+	})
+	if connect.CodeOf(err) != connect.CodeAlreadyExists {
+		t.Errorf("username clash: code = %v, want already_exists", connect.CodeOf(err))
+	}
+
+	// A state cookie from another login does not match this state.
+	begin, err := s.BeginOIDCLogin(ctx, connect.NewRequest(&apiv1.BeginOIDCLoginRequest{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	setCookie, _ := http.ParseSetCookie(begin.Header().Get("Set-Cookie"))
+	req := connect.NewRequest(&apiv1.CompleteOIDCLoginRequest{Code: "x", State: "forged"})
+	req.Header().Set("Cookie", setCookie.Name+"="+setCookie.Value)
+	if _, err := s.CompleteOIDCLogin(ctx, req); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("forged state: code = %v, want unauthenticated", connect.CodeOf(err))
+	}
+}
@@ -53,6 +53,16 @@ func (s *QueryServer) ExportToVault(
 		return nil, err
 	}
 
+	// Export needs an export grant on the target and limits sources to
+	// the vaults granted for export.
+	access, err := s.vaultAccess(ctx, system.VaultActionExport)
+	if err != nil {
+		return nil, err
+	}
+	if !access.Allows(targetVaultID) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("vault %s: access denied", targetName))
+	}
+
 	// Parse expression and extract pipeline.
 	q, pipeline, parseErr := parseExpression(req.Msg.Expression)
 	if parseErr != nil {
@@ -93,7 +103,7 @@ func (s *QueryServer) ExportToVault(
 	// Submit the export as a background job on this node.
 	jobName := "export to " + targetName
 	jobID := s.orch.Scheduler().Submit(jobName, func(jobCtx context.Context, job *orchestrator.JobProgress) {
-		s.runExportJob(jobCtx, job, q, pipeline, targetVaultID)
+		s.runExportJob(query.WithVaultAccess(jobCtx, access), job, q, pipeline, targetVaultID)
 	})
 
 	return connect.NewResponse(&apiv1.ExportToVaultResponse{JobId: []byte(jobID)}), nil
@@ -432,6 +432,7 @@ func (s *LifecycleServer) buildRouteStats() *apiv1.GetRouteStatsResponse {
 			RouteId:          routeID.ToProto(),
 			RecordsMatched:   ps.Matched.Load(),
 			RecordsForwarded: ps.Forwarded.Load(),
+			RecordsDropped:   ps.Dropped.Load(),
 		}
 	}
 
@@ -80,6 +80,10 @@ func (s *QueryServer) Search(
 		ctx, cancel = context.WithTimeout(ctx, s.queryTimeout)
 		defer cancel()
 	}
+	ctx, err := s.withVaultAccess(ctx, system.VaultActionSearch)
+	if err != nil {
+		return err
+	}
 
 	eng := s.orch.LeaderVaultQueryEngine()
 	if s.lookupResolver != nil {
@@ -600,9 +604,10 @@ func (s *QueryServer) resolveUnboundedQuery(ctx context.Context, q query.Query)
 // to know which vaults' chunks contribute to the unbounded-query bound
 // derivation.
 func (s *QueryServer) selectedOrAllVaults(ctx context.Context, q query.Query) []glid.GLID {
+	access := query.VaultAccessFromContext(ctx)
 	selected, _ := query.ExtractVaultFilter(q.Normalize().BoolExpr, nil)
 	if len(selected) > 0 || s.cfgStore == nil {
-		return selected
+		return access.Restrict(selected)
 	}
 	vaults, err := s.cfgStore.ListVaults(ctx)
 	if err != nil {
@@ -610,7 +615,9 @@ func (s *QueryServer) selectedOrAllVaults(ctx context.Context, q query.Query) []
 	}
 	out := make([]glid.GLID, 0, len(vaults))
 	for _, v := range vaults {
-		out = append(out, v.ID)
+		if access.Allows(v.ID) {
+			out = append(out, v.ID)
+		}
 	}
 	return out
 }
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	"gastrolog/internal/auth"
+	"gastrolog/internal/query"
+	"gastrolog/internal/system"
+)
+
+// vaultAccess resolves which vaults the caller may use for action.
+//
+// A nil result means unrestricted: no claims (internal callers such as
+// alert rules), the admin role, or the built-in user role reading via
+// search or follow. Custom roles get exactly the vaults their grants name
+// for action. An unknown role gets an empty set rather than an error, so a
+// role deleted under a live token fails closed.
+func (s *QueryServer) vaultAccess(ctx context.Context, action system.VaultAction) (*query.VaultAccess, error) {
+	claims := auth.ClaimsFromContext(ctx)
+	if claims == nil || claims.Role == system.RoleAdmin {
+		return nil, nil
+	}
+	if claims.Role == system.RoleUser {
+		if action == system.VaultActionExport {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				errors.New("export requires the admin role or an export grant"))
+		}
+		return nil, nil
+	}
+	if s.cfgStore == nil {
+		return query.NewVaultAccess(nil), nil
+	}
+	roles, err := s.cfgStore.ListRoles(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list roles: %w", err))
+	}
+	for _, r := range roles {
+		if r.Name == claims.Role {
+			return query.NewVaultAccess(r.VaultsFor(action)), nil
+		}
+	}
+	return query.NewVaultAccess(nil), nil
+}
+
+// withVaultAccess attaches the caller's vault access for action to ctx.
+// Every engine call and cross-node forward made with the returned context
+// is limited to the permitted vaults.
+func (s *QueryServer) withVaultAccess(ctx context.Context, action system.VaultAction) (context.Context, error) {
+	access, err := s.vaultAccess(ctx, action)
+	if err != nil {
+		return ctx, err
+	}
+	return query.WithVaultAccess(ctx, access), nil
+}
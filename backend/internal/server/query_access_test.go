@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"gastrolog/internal/auth"
+	"gastrolog/internal/glid"
+	"gastrolog/internal/system"
+	sysmem "gastrolog/internal/system/memory"
+)
+
+func TestQueryServerVaultAccess(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := sysmem.NewStore()
+	web, audit := glid.New(), glid.New()
+	if err := store.PutRole(ctx, system.RoleConfig{ID: glid.New(), Name: "web-oncall", Grants: []system.VaultGrant{
+		{VaultID: web, Actions: []system.VaultAction{system.VaultActionSearch, system.VaultActionFollow}},
+		{VaultID: audit, Actions: []system.VaultAction{system.VaultActionExport}},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	s := &QueryServer{cfgStore: store}
+	as := func(role string) context.Context {
+		return auth.WithClaims(ctx, &auth.Claims{Role: role})
+	}
+
+	for _, tc := range []struct {
+		name    string
+		ctx     context.Context
+		action  system.VaultAction
+		wantNil bool
+		allowed []glid.GLID
+		denied  []glid.GLID
+	}{
+		{name: "no claims", ctx: ctx, action: system.VaultActionExport, wantNil: true},
+		{name: "admin", ctx: as(system.RoleAdmin), action: system.VaultActionExport, wantNil: true},
+		{name: "user search", ctx: as(system.RoleUser), action: system.VaultActionSearch, wantNil: true},
+		{name: "custom search", ctx: as("web-oncall"), action: system.VaultActionSearch, allowed: []glid.GLID{web}, denied: []glid.GLID{audit}},
+		{name: "custom export", ctx: as("web-oncall"), action: system.VaultActionExport, allowed: []glid.GLID{audit}, denied: []glid.GLID{web}},
+		{name: "unknown role", ctx: as("deleted"), action: system.VaultActionSearch, denied: []glid.GLID{web, audit}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			access, err := s.vaultAccess(tc.ctx, tc.action)
+			if err != nil {
+				t.Fatalf("vaultAccess: %v", err)
+			}
+			if (access == nil) != tc.wantNil {
+				t.Fatalf("access = %v, want nil = %v", access, tc.wantNil)
+			}
+			for _, id := range tc.allowed {
+				if !access.Allows(id) {
+					t.Errorf("vault %s should be allowed", id)
+				}
+			}
+			for _, id := range tc.denied {
+				if access.Allows(id) {
+					t.Errorf("vault %s should be denied", id)
+				}
+			}
+		})
+	}
+
+	_, err := s.vaultAccess(as(system.RoleUser), system.VaultActionExport)
+	var connErr *connect.Error
+	if !errors.As(err, &connErr) || connErr.Code() != connect.CodePermissionDenied {
+		t.Errorf("user export: expected PermissionDenied, got %v", err)
+	}
+}
@@ -37,6 +37,13 @@ func (s *QueryServer) GetContext(
 	}
 
 	vaultID := glid.FromBytes(ref.VaultId)
+	ctx, err := s.withVaultAccess(ctx, system.VaultActionSearch)
+	if err != nil {
+		return nil, err
+	}
+	if !query.VaultAccessFromContext(ctx).Allows(vaultID) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("vault %s: access denied", vaultID))
+	}
 
 	if len(ref.ChunkId) != glid.Size {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid chunk_id: expected %d bytes, got %d", glid.Size, len(ref.ChunkId)))
@@ -9,6 +9,7 @@ import (
 
 	apiv1 "gastrolog/api/gen/gastrolog/v1"
 	"gastrolog/internal/query"
+	"gastrolog/internal/system"
 )
 
 // Explain returns the query execution plan without executing.
@@ -17,6 +18,10 @@ func (s *QueryServer) Explain(
 	ctx context.Context,
 	req *connect.Request[apiv1.ExplainRequest],
 ) (*connect.Response[apiv1.ExplainResponse], error) {
+	ctx, err := s.withVaultAccess(ctx, system.VaultActionSearch)
+	if err != nil {
+		return nil, err
+	}
 	eng := s.orch.LeaderVaultQueryEngine()
 
 	q, pipeline, err := protoToQuery(req.Msg.Query)
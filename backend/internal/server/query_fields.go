@@ -8,6 +8,7 @@ import (
 
 	apiv1 "gastrolog/api/gen/gastrolog/v1"
 	"gastrolog/internal/safeutf8"
+	"gastrolog/internal/system"
 	"gastrolog/internal/tokenizer"
 )
 
@@ -24,6 +25,10 @@ func (s *QueryServer) GetFields(
 	ctx context.Context,
 	req *connect.Request[apiv1.GetFieldsRequest],
 ) (*connect.Response[apiv1.GetFieldsResponse], error) {
+	ctx, err := s.withVaultAccess(ctx, system.VaultActionSearch)
+	if err != nil {
+		return nil, err
+	}
 	q, _, err := parseExpression(req.Msg.Expression)
 	if err != nil {
 		return nil, errInvalidArg(err)
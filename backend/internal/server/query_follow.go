@@ -12,6 +12,7 @@ import (
 	"gastrolog/internal/chunk"
 	"gastrolog/internal/query"
 	"gastrolog/internal/querylang"
+	"gastrolog/internal/system"
 )
 
 // Follow executes a query and streams matching records, continuously polling for new arrivals.
@@ -26,6 +27,10 @@ func (s *QueryServer) Follow(
 		ctx, cancel = context.WithTimeout(ctx, s.maxFollowDuration)
 		defer cancel()
 	}
+	ctx, err := s.withVaultAccess(ctx, system.VaultActionFollow)
+	if err != nil {
+		return err
+	}
 
 	eng := s.orch.LeaderVaultQueryEngine()
 
@@ -42,6 +47,18 @@ func (s *QueryServer) Follow(
 			case *querylang.StatsOp:
 				return connect.NewError(connect.CodeInvalidArgument,
 					errors.New("stats operator is not supported in follow mode"))
+			case *querylang.TopOp:
+				return connect.NewError(connect.CodeInvalidArgument,
+					errors.New("top and rare operators are not supported in follow mode"))
+			case *querylang.EventstatsOp:
+				return connect.NewError(connect.CodeInvalidArgument,
+					errors.New("eventstats operator is not supported in follow mode"))
+			case *querylang.StreamstatsOp:
+				return connect.NewError(connect.CodeInvalidArgument,
+					errors.New("streamstats operator is not supported in follow mode"))
+			case *querylang.TransactionOp:
+				return connect.NewError(connect.CodeInvalidArgument,
+					errors.New("transaction operator is not supported in follow mode"))
 			case *querylang.SortOp:
 				return connect.NewError(connect.CodeInvalidArgument,
 					errors.New("sort operator is not supported in follow mode"))
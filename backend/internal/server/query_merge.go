@@ -1,13 +1,33 @@
 package server
 
 import (
+	"context"
 	"math"
 	"strconv"
 	"strings"
 
 	"gastrolog/internal/query"
+	"gastrolog/internal/querylang"
 )
 
+// runPartialStats runs a stats pipeline whose aggregates cannot be merged
+// from final values (percentiles, stddev, ...). Every node, this one
+// included, returns its accumulator state; the coordinator merges the states
+// and applies the post-stats operators once. Quantile sketches are shipped
+// instead of raw values.
+func (s *QueryServer) runPartialStats(ctx context.Context, eng *query.Engine, q query.Query, pipeline *querylang.Pipeline) (*query.TableResult, error) {
+	local, err := eng.RunPipelinePartial(ctx, q, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	partials := append([]*query.TableResult{local}, s.collectRemotePipeline(ctx, q, pipeline, true)...)
+	result, err := eng.FinishPartialPipeline(ctx, q, pipeline, partials)
+	if err != nil {
+		return nil, err
+	}
+	return result.Table, nil
+}
+
 // mergeTableResults combines a local table result with one or more remote
 // results. The merge strategy depends on the column names:
 //
@@ -15,6 +35,9 @@ import (
 //     last (count) and sum counts per bucket.
 //   - Stats: aggregate columns detected by name pattern (count, sum(*), etc.)
 //   - Fallback: concatenate rows.
+//
+// Stats using percentiles, stddev, variance, range or rate never get here;
+// they go through runPartialStats.
 func mergeTableResults(local *query.TableResult, remotes []*query.TableResult) *query.TableResult {
 	if len(remotes) == 0 {
 		return local
@@ -84,7 +107,7 @@ func detectAggColumns(cols []string) []aggColumn {
 // isNonDistributiveAgg returns true if the column name is a non-distributive
 // aggregate function that cannot be correctly merged by summing per-node results.
 func isNonDistributiveAgg(lower string) bool {
-	for _, prefix := range []string{"avg(", "dcount(", "median(", "first(", "last(", "values("} {
+	for _, prefix := range []string{"avg(", "dcount(", "median(", "mode(", "first(", "last(", "values("} {
 		if strings.HasPrefix(lower, prefix) {
 			return true
 		}
@@ -28,6 +28,17 @@ func (s *QueryServer) searchPipeline(
 	if query.PipelineNeedsGlobalRecords(pipeline) {
 		return s.searchPipelineGlobal(ctx, eng, q, pipeline, stream)
 	}
+	// Percentiles and friends: merge per-node accumulator state.
+	if query.PipelineMergesPartialStats(pipeline) {
+		table, err := s.runPartialStats(ctx, eng, q, pipeline)
+		if err != nil {
+			return errInternal(err)
+		}
+		return stream.Send(&apiv1.SearchResponse{
+			TableResult: tableResultToProto(table, pipeline),
+			Histogram:   HistogramToProto(eng.ComputeHistogram(ctx, q, 50)),
+		})
+	}
 
 	if s.maxResultCount > 0 && (q.Limit == 0 || int64(q.Limit) > s.maxResultCount) {
 		q.Limit = int(s.maxResultCount)
@@ -41,7 +52,7 @@ func (s *QueryServer) searchPipeline(
 
 	if result.Table != nil {
 		// Fan out to remote nodes and merge table results.
-		remoteResults := s.collectRemotePipeline(ctx, q, pipeline)
+		remoteResults := s.collectRemotePipeline(ctx, q, pipeline, false)
 		if len(remoteResults) > 0 {
 			result.Table = mergeTableResults(result.Table, remoteResults)
 		}
@@ -140,9 +151,20 @@ func buildPipelineStages(pipeline *querylang.Pipeline) []*apiv1.QueryPipelineSta
 
 // pipeOpName returns the operator name for a PipeOp.
 func pipeOpName(op querylang.PipeOp) string {
-	switch op.(type) {
+	switch o := op.(type) {
 	case *querylang.StatsOp:
 		return "stats"
+	case *querylang.EventstatsOp:
+		return "eventstats"
+	case *querylang.StreamstatsOp:
+		return "streamstats"
+	case *querylang.TopOp:
+		if o.Rare {
+			return "rare"
+		}
+		return "top"
+	case *querylang.TransactionOp:
+		return "transaction"
 	case *querylang.WhereOp:
 		return "where"
 	case *querylang.EvalOp:
@@ -165,6 +187,10 @@ func pipeOpName(op querylang.PipeOp) string {
 		return "raw"
 	case *querylang.LookupOp:
 		return "lookup"
+	case *querylang.ParseOp:
+		return "parse"
+	case *querylang.RexOp:
+		return "rex"
 	case *querylang.BarchartOp:
 		return "barchart"
 	case *querylang.DonutOp:
@@ -185,7 +211,8 @@ func pipeOpName(op querylang.PipeOp) string {
 func isMaterializing(op querylang.PipeOp) bool {
 	switch op.(type) {
 	case *querylang.StatsOp, *querylang.TimechartOp, *querylang.SortOp,
-		*querylang.TailOp, *querylang.SliceOp, *querylang.RawOp:
+		*querylang.TailOp, *querylang.SliceOp, *querylang.RawOp, *querylang.EventstatsOp,
+		*querylang.TopOp, *querylang.TransactionOp:
 		return true
 	default:
 		return false
@@ -195,9 +222,10 @@ func isMaterializing(op querylang.PipeOp) bool {
 // pipeOpExecution returns a short execution mode label for a pipeline operator.
 func pipeOpExecution(op querylang.PipeOp) string {
 	switch op.(type) {
-	case *querylang.StatsOp, *querylang.TimechartOp:
+	case *querylang.StatsOp, *querylang.TimechartOp, *querylang.TopOp:
 		return "materializing" // runs on each node, merged on coordinator
-	case *querylang.SortOp, *querylang.TailOp, *querylang.SliceOp:
+	case *querylang.SortOp, *querylang.TailOp, *querylang.SliceOp,
+		*querylang.EventstatsOp, *querylang.StreamstatsOp, *querylang.TransactionOp:
 		return "coordinator-only" // buffers all records on the coordinating node
 	case *querylang.HeadOp:
 		return "short-circuit" // stops iteration early
@@ -219,6 +247,64 @@ func pipeOpNote(op querylang.PipeOp) string {
 		}
 		n += ". All records must be scanned before results are produced. In a cluster, each node aggregates locally and results are merged."
 		return n
+	case *querylang.TopOp:
+		order := "most"
+		if o.Rare {
+			order = "least"
+		}
+		n := fmt.Sprintf("Counts records by %s and keeps the %d %s common values", strings.Join(o.Fields, ", "), o.N, order)
+		if len(o.By) > 0 {
+			n += " per " + strings.Join(o.By, ", ")
+		}
+		if o.ShowOther {
+			n += ", plus an \"other\" row for the rest"
+		}
+		n += ", with count and percent columns. In a cluster, each node counts locally and the counts are merged before ranking."
+		return n
+	case *querylang.EventstatsOp:
+		n := fmt.Sprintf("Adds aggregates (%s)", aggList(o.Aggs))
+		if len(o.Groups) > 0 {
+			n += " per " + groupList(o.Groups)
+		}
+		n += " to every record. All records must be buffered before any are returned."
+		return n
+	case *querylang.StreamstatsOp:
+		n := fmt.Sprintf("Adds running aggregates (%s)", aggList(o.Aggs))
+		if len(o.Groups) > 0 {
+			n += " per " + groupList(o.Groups)
+		}
+		switch {
+		case o.Window > 0 && o.TimeWindow != "":
+			n += fmt.Sprintf(" over the last %d records within %s", o.Window, o.TimeWindow)
+		case o.Window > 0:
+			n += fmt.Sprintf(" over the last %d records", o.Window)
+		case o.TimeWindow != "":
+			n += " over a trailing " + o.TimeWindow + " window"
+		}
+		return n + " to each record in result order. Runs on the coordinating node."
+	case *querylang.TransactionOp:
+		n := "Groups records into transactions"
+		if len(o.Fields) > 0 {
+			n += " by " + strings.Join(o.Fields, ", ")
+		}
+		var conds []string
+		if o.StartsWith != "" {
+			conds = append(conds, "starting at "+o.StartsWith)
+		}
+		if o.EndsWith != "" {
+			conds = append(conds, "ending at "+o.EndsWith)
+		}
+		if o.MaxSpan != "" {
+			conds = append(conds, "spanning at most "+o.MaxSpan)
+		}
+		if o.MaxPause != "" {
+			conds = append(conds, "with gaps of at most "+o.MaxPause)
+		}
+		if len(conds) > 0 {
+			n += ", " + strings.Join(conds, ", ")
+		}
+		n += ". Each transaction becomes one record with duration, eventcount and the joined raw text. All records are buffered on the coordinating node; when too many transactions are open, the least recently active are closed early and marked evicted=true."
+		return n
 	case *querylang.TimechartOp:
 		n := fmt.Sprintf("Buckets records into %d time intervals", o.N)
 		if o.By != "" {
@@ -268,6 +354,14 @@ func pipeOpNote(op querylang.PipeOp) string {
 		return "Removes duplicate records keyed on EventID within a 1s window."
 	case *querylang.LookupOp:
 		return fmt.Sprintf("Enriches each record by looking up %s in the %s table.", strings.Join(o.Fields, ", "), o.Table)
+	case *querylang.ParseOp:
+		return fmt.Sprintf("Extracts fields %s from %s with a pattern. Applied per-record.", strings.Join(o.Names, ", "), extractSource(o.Field))
+	case *querylang.RexOp:
+		var names []string
+		if x, err := o.Extractor(); err == nil {
+			names = x.Names()
+		}
+		return fmt.Sprintf("Extracts fields %s from %s with a regular expression. Applied per-record.", strings.Join(names, ", "), extractSource(o.Field))
 	case *querylang.RawOp:
 		return "Forces table output format. No data transformation."
 	case *querylang.BarchartOp:
@@ -286,6 +380,13 @@ func pipeOpNote(op querylang.PipeOp) string {
 	}
 }
 
+func extractSource(field string) string {
+	if field == "" {
+		return "the raw message"
+	}
+	return field
+}
+
 func aggList(aggs []querylang.AggExpr) string {
 	names := make([]string, len(aggs))
 	for i, a := range aggs {
@@ -121,11 +121,15 @@ func (s *QueryServer) remoteVaultsByNodeFiltered(ctx context.Context, selectedVa
 		selected[id] = true
 	}
 
+	access := query.VaultAccessFromContext(ctx)
 	byNode := make(map[string][]glid.GLID)
 	for _, v := range vaults {
 		if len(selected) > 0 && !selected[v.ID] {
 			continue
 		}
+		if !access.Allows(v.ID) {
+			continue // caller's role has no grant on this vault
+		}
 		if localVaultIDs[v.ID] {
 			continue // searched locally, skip remote
 		}
@@ -319,12 +323,13 @@ func runMerge(yield func(chunk.Record, error) bool, states []mergeState, entries
 // collectRemotePipeline fans out a pipeline query to all remote vaults and
 // collects their TableResults. Each remote node runs the full pipeline locally
 // (the executor detects the pipeline and calls RunPipeline). The coordinating
-// node then merges the results.
+// node then merges the results. With partialStats, remote nodes return
+// accumulator state instead (see runPartialStats).
 //
 // The expression is reconstructed from the parsed q and pipeline with absolute
 // start/end timestamps so all nodes use identical time windows (avoids bucket
 // misalignment from re-evaluating relative "last=5m" on each node).
-func (s *QueryServer) collectRemotePipeline(ctx context.Context, q query.Query, pipeline *querylang.Pipeline) []*query.TableResult {
+func (s *QueryServer) collectRemotePipeline(ctx context.Context, q query.Query, pipeline *querylang.Pipeline, partialStats bool) []*query.TableResult {
 	if s.remoteSearcher == nil || s.cfgStore == nil {
 		return nil
 	}
@@ -369,8 +374,9 @@ func (s *QueryServer) collectRemotePipeline(ctx context.Context, q query.Query,
 			peerCtx, cancel := context.WithTimeout(ctx, peerInspectorTimeout)
 			defer cancel()
 			responses[i], fetchErrors[i] = s.remoteSearcher.Search(peerCtx, f.nodeID, &apiv1.ForwardSearchRequest{
-				VaultId: f.vid.ToProto(),
-				Query:   remoteExpr,
+				VaultId:      f.vid.ToProto(),
+				Query:        remoteExpr,
+				PartialStats: partialStats,
 			})
 		})
 	}
@@ -27,7 +27,7 @@ func (s *QueryServer) GetSyntax(
 			"reverse", "start", "end", "last", "limit", "pos",
 			"source_start", "source_end", "ingest_start", "ingest_end",
 		},
-		PipeKeywords:  []string{"stats", "where", "eval", "sort", "head", "tail", "slice", "rename", "fields", "timechart", "dedup", "raw", "lookup", "linechart", "barchart", "donut", "heatmap", "scatter", "map", "export"},
+		PipeKeywords:  []string{"stats", "eventstats", "streamstats", "top", "rare", "transaction", "where", "eval", "sort", "head", "tail", "slice", "rename", "fields", "timechart", "dedup", "raw", "lookup", "parse", "rex", "linechart", "barchart", "donut", "heatmap", "scatter", "map", "export"},
 		PipeFunctions: funcs,
 		LookupTables:  s.lookupNames,
 	}), nil
@@ -19,19 +19,24 @@ func DefaultRoutes() map[string]RPCRoute {
 		// ── AuthService ──────────────────────────────────────────────────
 		// Self-service auth RPCs are RouteLocal — any node can serve them
 		// (writes go through Raft Apply transparently).
-		gastrologv1connect.AuthServiceRegisterProcedure:       {Strategy: RouteLocal},
-		gastrologv1connect.AuthServiceLoginProcedure:          {Strategy: RouteLocal},
-		gastrologv1connect.AuthServiceRefreshTokenProcedure:   {Strategy: RouteLocal},
-		gastrologv1connect.AuthServiceChangePasswordProcedure: {Strategy: RouteLocal},
-		gastrologv1connect.AuthServiceGetAuthStatusProcedure:  {Strategy: RouteLocal},
-		gastrologv1connect.AuthServiceListUsersProcedure:      {Strategy: RouteLocal},
-		gastrologv1connect.AuthServiceLogoutProcedure:         {Strategy: RouteLocal},
+		gastrologv1connect.AuthServiceRegisterProcedure:          {Strategy: RouteLocal},
+		gastrologv1connect.AuthServiceLoginProcedure:             {Strategy: RouteLocal},
+		gastrologv1connect.AuthServiceBeginOIDCLoginProcedure:    {Strategy: RouteLocal},
+		gastrologv1connect.AuthServiceCompleteOIDCLoginProcedure: {Strategy: RouteLocal},
+		gastrologv1connect.AuthServiceRefreshTokenProcedure:      {Strategy: RouteLocal},
+		gastrologv1connect.AuthServiceChangePasswordProcedure:    {Strategy: RouteLocal},
+		gastrologv1connect.AuthServiceGetAuthStatusProcedure:     {Strategy: RouteLocal},
+		gastrologv1connect.AuthServiceListUsersProcedure:         {Strategy: RouteLocal},
+		gastrologv1connect.AuthServiceLogoutProcedure:            {Strategy: RouteLocal},
+		gastrologv1connect.AuthServiceListAPIKeysProcedure:       {Strategy: RouteLocal},
 		// Admin user management RPCs are RouteLeader.
 		gastrologv1connect.AuthServiceCreateUserProcedure:     {Strategy: RouteLeader},
 		gastrologv1connect.AuthServiceUpdateUserRoleProcedure: {Strategy: RouteLeader},
 		gastrologv1connect.AuthServiceResetPasswordProcedure:  {Strategy: RouteLeader},
 		gastrologv1connect.AuthServiceRenameUserProcedure:     {Strategy: RouteLeader},
 		gastrologv1connect.AuthServiceDeleteUserProcedure:     {Strategy: RouteLeader},
+		gastrologv1connect.AuthServiceCreateAPIKeyProcedure:   {Strategy: RouteLeader},
+		gastrologv1connect.AuthServiceDeleteAPIKeyProcedure:   {Strategy: RouteLeader},
 
 		// ── ConfigService ────────────────────────────────────────────────
 		// Reads — every node has a full Raft replica.
@@ -56,6 +61,7 @@ func DefaultRoutes() map[string]RPCRoute {
 		gastrologv1connect.SystemServicePreviewCSVLookupProcedure:     {Strategy: RouteLocal},
 		gastrologv1connect.SystemServicePreviewJSONLookupProcedure:    {Strategy: RouteLocal},
 		gastrologv1connect.SystemServicePreviewYAMLLookupProcedure:    {Strategy: RouteLocal},
+		gastrologv1connect.SystemServicePreviewRoutePipelineProcedure: {Strategy: RouteLocal},
 		gastrologv1connect.SystemServiceWatchIngesterStatusProcedure:  {Strategy: RouteLocal, IsStreaming: true},
 		// Config mutations — go through Raft Apply.
 		gastrologv1connect.SystemServicePutFilterProcedure:            {Strategy: RouteLeader},
@@ -90,6 +96,12 @@ func DefaultRoutes() map[string]RPCRoute {
 		gastrologv1connect.SystemServicePutTierProcedure:              {Strategy: RouteLeader},
 		gastrologv1connect.SystemServiceDeleteTierProcedure:           {Strategy: RouteLeader},
 		gastrologv1connect.SystemServiceDeleteLookupProcedure:         {Strategy: RouteLeader},
+		gastrologv1connect.SystemServicePutAlertRuleProcedure:         {Strategy: RouteLeader},
+		gastrologv1connect.SystemServiceDeleteAlertRuleProcedure:      {Strategy: RouteLeader},
+		// Alert rules are evaluated on the system Raft leader, which holds the state.
+		gastrologv1connect.SystemServiceGetAlertRuleStatusProcedure: {Strategy: RouteLeader},
+		gastrologv1connect.SystemServicePutRoleProcedure:            {Strategy: RouteLeader},
+		gastrologv1connect.SystemServiceDeleteRoleProcedure:         {Strategy: RouteLeader},
 
 		// ── JobService ───────────────────────────────────────────────────
 		gastrologv1connect.JobServiceGetJobProcedure:    {Strategy: RouteLocal},
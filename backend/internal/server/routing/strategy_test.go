@@ -133,8 +133,8 @@ func TestStrategyDistribution(t *testing.T) {
 	}
 
 	want := map[routing.Strategy]int{
-		routing.RouteLocal:    44, // +1: WatchChunks (gastrolog-1jijm), +1: PreviewJSONLookup (gastrolog-4q2b3), +1: PreviewYAMLLookup (gastrolog-l1ywp), +1: WatchIngesterStatus (gastrolog-14ejy), +1: GetIndexes moved here from RouteTargeted (gastrolog-3570f)
-		routing.RouteLeader:   39, // +1: DeleteLookup; PutSettings split into PutService/Lookup/MaxMind/Setup (gastrolog-1uhsr)
+		routing.RouteLocal:    48, // +1: WatchChunks (gastrolog-1jijm), +1: PreviewJSONLookup (gastrolog-4q2b3), +1: PreviewYAMLLookup (gastrolog-l1ywp), +1: WatchIngesterStatus (gastrolog-14ejy), +1: GetIndexes moved here from RouteTargeted (gastrolog-3570f), +1: PreviewRoutePipeline; +1: ListAPIKeys; +2: BeginOIDCLogin, CompleteOIDCLogin
+		routing.RouteLeader:   46, // +1: DeleteLookup; PutSettings split into PutService/Lookup/MaxMind/Setup (gastrolog-1uhsr); +3: PutAlertRule, DeleteAlertRule, GetAlertRuleStatus; +2: PutRole, DeleteRole; +2: CreateAPIKey, DeleteAPIKey
 		routing.RouteTargeted: 10, // +1: RetryUnreadableChunks (gastrolog-25vur); -2: MigrateVault, MergeVaults removed (gastrolog-151ut)
 		routing.RouteFanOut:   7,
 	}
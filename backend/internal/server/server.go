@@ -138,6 +138,10 @@ type Config struct {
 	// PlacementReconcile runs synchronous placement so RPC responses include
 	// tier placements. Nil in single-node or non-cluster mode.
 	PlacementReconcile func(ctx context.Context)
+
+	// AlertRuleStatus reports alert rule evaluation state for
+	// GetAlertRuleStatus. Nil disables the RPC.
+	AlertRuleStatus AlertRuleStatusProvider
 }
 
 // CertManager interface for TLS certificate management.
@@ -183,6 +187,7 @@ type Server struct {
 	queryServer        *QueryServer              // stored for ExportToVault executor wiring
 	routingForwarder   routing.UnaryForwarder    // forwards requests to remote nodes; nil in single-node
 	placementReconcile func(ctx context.Context) // synchronous placement; nil in non-cluster mode
+	alertRuleStatus    AlertRuleStatusProvider   // alert rule evaluation state; nil disables GetAlertRuleStatus
 	mu                 sync.Mutex
 	listener           net.Listener
 	server             *http.Server
@@ -242,6 +247,7 @@ func New(orch *orchestrator.Orchestrator, cfgStore system.Store, factories orche
 		statsSignal:        cfg.StatsSignal,
 		routingForwarder:   cfg.RoutingForwarder,
 		placementReconcile: cfg.PlacementReconcile,
+		alertRuleStatus:    cfg.AlertRuleStatus,
 		shutdown:           make(chan struct{}),
 		rl:                 newRateLimiter(5.0/60.0, 5), // 5 req/min per IP, burst of 5
 	}
@@ -400,6 +406,7 @@ func (s *Server) buildMux(overrideOpts ...connect.HandlerOption) *http.ServeMux
 		handlerOpts = append(handlerOpts, connect.WithInterceptors(interceptors...))
 	case s.tokens != nil:
 		authInterceptor := auth.NewAuthInterceptor(s.tokens, s.cfgStore, &tokenValidator{cfgStore: s.cfgStore})
+		authInterceptor.SetAPIKeyValidator(&apiKeyValidator{cfgStore: s.cfgStore})
 		interceptors := []connect.Interceptor{newRPCErrorLogInterceptor(s.logger), authInterceptor}
 		interceptors = append(interceptors, s.routingInterceptor()...)
 		handlerOpts = append(handlerOpts, connect.WithInterceptors(interceptors...))
@@ -439,6 +446,7 @@ func (s *Server) buildMux(overrideOpts ...connect.HandlerOption) *http.ServeMux
 		CloudTesters:       s.cloudTesters,
 		Tokens:             s.tokens,
 		PlacementReconcile: s.placementReconcile,
+		AlertRuleStatus:    s.alertRuleStatus,
 		OnTLSConfigChange:  s.reconfigureTLS,
 		OnLookupConfigChange: func(cfg system.LookupConfig, mm system.MaxMindConfig) {
 			s.applyLookupConfig(cfg, mm, lookupRegistry)
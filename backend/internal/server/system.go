@@ -63,6 +63,7 @@ type SystemServerConfig struct {
 	CloudTesters         map[string]CloudServiceTester
 	Tokens               *auth.TokenService
 	PlacementReconcile   func(ctx context.Context) // synchronous placement for RPC handlers
+	AlertRuleStatus      AlertRuleStatusProvider
 }
 
 // SystemServer implements the ConfigService.
@@ -83,6 +84,7 @@ type SystemServer struct {
 	cloudTesters         map[string]CloudServiceTester
 	tokens               *auth.TokenService
 	placementReconcile   func(ctx context.Context) // synchronous placement, nil in non-cluster mode
+	alertRuleStatus      AlertRuleStatusProvider   // nil when alert rules are not wired
 }
 
 var _ gastrologv1connect.SystemServiceHandler = (*SystemServer)(nil)
@@ -106,6 +108,7 @@ func NewSystemServer(cfg SystemServerConfig) *SystemServer {
 		cloudTesters:         cfg.CloudTesters,
 		tokens:               cfg.Tokens,
 		placementReconcile:   cfg.PlacementReconcile,
+		alertRuleStatus:      cfg.AlertRuleStatus,
 	}
 }
 
@@ -146,6 +149,8 @@ func (s *SystemServer) buildFullSystem(ctx context.Context) (*apiv1.GetSystemRes
 			s.loadConfigCloudServices(ctx, resp),
 			s.loadSystemTiers(ctx, resp),
 			s.loadConfigNodeStorageConfigs(ctx, resp),
+			s.loadConfigAlertRules(ctx, resp),
+			s.loadConfigRoles(ctx, resp),
 		)
 		if err != nil {
 			return nil, err
@@ -198,11 +203,24 @@ func (s *SystemServer) buildFullSettingsResponse(ctx context.Context, includeSec
 			MaxConsecutiveRepeats: int32(ss.Auth.PasswordPolicy.MaxConsecutiveRepeats), //nolint:gosec // G115
 			ForbidAnimalNoise:     ss.Auth.PasswordPolicy.ForbidAnimalNoise,
 		},
+		Oidc: &apiv1.OIDCSettings{
+			Enabled:                ss.Auth.OIDC.Enabled,
+			IssuerUrl:              ss.Auth.OIDC.IssuerURL,
+			ClientId:               ss.Auth.OIDC.ClientID,
+			ClientSecretConfigured: ss.Auth.OIDC.ClientSecret != "",
+			RedirectUrl:            ss.Auth.OIDC.RedirectURL,
+			Scopes:                 strings.Join(ss.Auth.OIDC.Scopes, " "),
+			UsernameClaim:          ss.Auth.OIDC.UsernameClaim,
+			GroupsClaim:            ss.Auth.OIDC.GroupsClaim,
+			RoleMappings:           system.FormatOIDCRoleMappings(ss.Auth.OIDC.RoleMappings),
+			DefaultRole:            ss.Auth.OIDC.DefaultRole,
+		},
 	}
 
 	if includeSecrets {
 		mm.AccountId = []byte(ss.MaxMind.AccountID)
 		mm.LicenseKey = ss.MaxMind.LicenseKey
+		authSettings.Oidc.ClientSecret = ss.Auth.OIDC.ClientSecret
 	}
 
 	resp := &apiv1.GetSettingsResponse{
@@ -343,6 +361,7 @@ func (s *SystemServer) loadConfigRoutes(ctx context.Context, resp *apiv1.GetSyst
 			Distribution: string(rt.Distribution),
 			Enabled:      rt.Enabled,
 			EjectOnly:    rt.EjectOnly,
+			Stages:       convert.RouteStagesToProto(rt.Stages),
 		}
 		if rt.FilterID != nil {
 			prt.FilterId = rt.FilterID.ToProto()
@@ -548,6 +567,9 @@ func (s *SystemServer) PutServiceSettings(
 	if connErr := validateTokenDurations(ss.Auth); connErr != nil {
 		return nil, connErr
 	}
+	if connErr := s.validateOIDC(ctx, ss.Auth.OIDC); connErr != nil {
+		return nil, connErr
+	}
 	saveCtx := system.WithSaveServerSettingsNotifyKey(ctx, system.NotifyKeyServiceSettings)
 	if err := s.sysStore.SaveServerSettings(saveCtx, ss); err != nil {
 		return nil, errInternal(err)
@@ -883,7 +905,9 @@ func (s *SystemServer) loadServerSettings(ctx context.Context) (system.ServerSet
 
 func mergeServiceSettingsFields(msg *apiv1.PutServiceSettingsRequest, ss *system.ServerSettings) *connect.Error {
 	if msg.Auth != nil {
-		mergeAuth(msg.Auth, &ss.Auth)
+		if err := mergeAuth(msg.Auth, &ss.Auth); err != nil {
+			return err
+		}
 	}
 	if msg.Query != nil {
 		if err := mergeQuery(msg.Query, &ss.Query); err != nil {
@@ -906,7 +930,7 @@ func mergeServiceSettingsFields(msg *apiv1.PutServiceSettingsRequest, ss *system
 	return nil
 }
 
-func mergeAuth(a *apiv1.PutAuthSettings, auth *system.AuthConfig) {
+func mergeAuth(a *apiv1.PutAuthSettings, auth *system.AuthConfig) *connect.Error {
 	if a.TokenDuration != nil {
 		auth.TokenDuration = *a.TokenDuration
 	}
@@ -916,6 +940,82 @@ func mergeAuth(a *apiv1.PutAuthSettings, auth *system.AuthConfig) {
 	if a.PasswordPolicy != nil {
 		mergePasswordPolicy(a.PasswordPolicy, &auth.PasswordPolicy)
 	}
+	if a.Oidc != nil {
+		return mergeOIDC(a.Oidc, &auth.OIDC)
+	}
+	return nil
+}
+
+func mergeOIDC(o *apiv1.PutOIDCSettings, cfg *system.OIDCConfig) *connect.Error {
+	if o.Enabled != nil {
+		cfg.Enabled = *o.Enabled
+	}
+	if o.IssuerUrl != nil {
+		cfg.IssuerURL = strings.TrimSpace(*o.IssuerUrl)
+	}
+	if o.ClientId != nil {
+		cfg.ClientID = *o.ClientId
+	}
+	if o.ClientSecret != nil {
+		cfg.ClientSecret = *o.ClientSecret
+	}
+	if o.RedirectUrl != nil {
+		cfg.RedirectURL = strings.TrimSpace(*o.RedirectUrl)
+	}
+	if o.Scopes != nil {
+		cfg.Scopes = strings.Fields(*o.Scopes)
+	}
+	if o.UsernameClaim != nil {
+		cfg.UsernameClaim = *o.UsernameClaim
+	}
+	if o.GroupsClaim != nil {
+		cfg.GroupsClaim = *o.GroupsClaim
+	}
+	if o.RoleMappings != nil {
+		mappings, err := system.ParseOIDCRoleMappings(*o.RoleMappings)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		cfg.RoleMappings = mappings
+	}
+	if o.DefaultRole != nil {
+		cfg.DefaultRole = *o.DefaultRole
+	}
+	return nil
+}
+
+// validateOIDC checks the SSO settings and that every role they grant exists.
+func (s *SystemServer) validateOIDC(ctx context.Context, cfg system.OIDCConfig) *connect.Error {
+	if err := cfg.Validate(); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if !cfg.Enabled {
+		return nil
+	}
+	roles, err := s.sysStore.ListRoles(ctx)
+	if err != nil {
+		return errInternal(err)
+	}
+	defined := func(name string) bool {
+		if system.IsBuiltinRole(name) {
+			return true
+		}
+		for _, r := range roles {
+			if r.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+	for _, m := range cfg.RoleMappings {
+		if !defined(m.Role) {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("oidc: group %q maps to undefined role %q", m.Group, m.Role))
+		}
+	}
+	if cfg.DefaultRole != "" && !defined(cfg.DefaultRole) {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("oidc: default role %q is not defined", cfg.DefaultRole))
+	}
+	return nil
 }
 
 func mergePasswordPolicy(pp *apiv1.PutPasswordPolicySettings, pol *system.PasswordPolicy) {
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"gastrolog/internal/glid"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv1 "gastrolog/api/gen/gastrolog/v1"
+	"gastrolog/internal/alertrule"
+	"gastrolog/internal/convert"
+	"gastrolog/internal/system"
+	"gastrolog/internal/system/raftfsm"
+)
+
+// AlertRuleStatusProvider reports the evaluation state of alert rules.
+// Implemented by *alertrule.Manager.
+type AlertRuleStatusProvider interface {
+	Statuses() []alertrule.Status
+}
+
+// PutAlertRule creates or updates an alert rule.
+func (s *SystemServer) PutAlertRule(
+	ctx context.Context,
+	req *connect.Request[apiv1.PutAlertRuleRequest],
+) (*connect.Response[apiv1.PutAlertRuleResponse], error) {
+	if req.Msg.Config == nil {
+		return nil, errRequired("config")
+	}
+	if len(req.Msg.Config.Id) == 0 {
+		req.Msg.Config.Id = glid.New().ToProto()
+	}
+	if req.Msg.Config.Name == "" {
+		return nil, errRequired("name")
+	}
+
+	id, connErr := parseProtoID(req.Msg.Config.Id)
+	if connErr != nil {
+		return nil, connErr
+	}
+
+	cfg := convert.AlertRuleConfigFromProto(req.Msg.Config)
+	cfg.ID = id
+	if err := cfg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if _, _, err := parseExpression(cfg.Query); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid query: %w", err))
+	}
+
+	rules, err := s.sysStore.ListAlertRules(ctx)
+	if err != nil {
+		return nil, errInternal(err)
+	}
+	if connErr := checkNameConflict("alert rule", id, cfg.Name, rules, func(r system.AlertRuleConfig) (glid.GLID, string) { return r.ID, r.Name }); connErr != nil {
+		return nil, connErr
+	}
+
+	// Validate vault sinks reference existing vaults.
+	for _, sink := range cfg.Sinks {
+		if sink.Type != system.AlertSinkVault {
+			continue
+		}
+		vid, _ := glid.ParseUUID(sink.Params["vault_id"]) // format checked by Validate
+		vc, err := s.sysStore.GetVault(ctx, vid)
+		if err != nil {
+			return nil, errInternal(err)
+		}
+		if vc == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("sink vault %q not found", vid))
+		}
+	}
+
+	if err := s.sysStore.PutAlertRule(ctx, cfg); err != nil {
+		return nil, errInternal(err)
+	}
+	s.notify(raftfsm.Notification{Kind: raftfsm.NotifyAlertRulePut, ID: id})
+
+	fullCfg, err := s.buildFullSystem(ctx)
+	if err != nil {
+		return nil, errInternal(err)
+	}
+	return connect.NewResponse(&apiv1.PutAlertRuleResponse{System: fullCfg}), nil
+}
+
+// DeleteAlertRule removes an alert rule.
+func (s *SystemServer) DeleteAlertRule(
+	ctx context.Context,
+	req *connect.Request[apiv1.DeleteAlertRuleRequest],
+) (*connect.Response[apiv1.DeleteAlertRuleResponse], error) {
+	if len(req.Msg.Id) == 0 {
+		return nil, errRequired("id")
+	}
+
+	id, connErr := parseProtoID(req.Msg.Id)
+	if connErr != nil {
+		return nil, connErr
+	}
+
+	if err := s.sysStore.DeleteAlertRule(ctx, id); err != nil {
+		return nil, errInternal(err)
+	}
+	s.notify(raftfsm.Notification{Kind: raftfsm.NotifyAlertRuleDeleted, ID: id})
+
+	cfg, err := s.buildFullSystem(ctx)
+	if err != nil {
+		return nil, errInternal(err)
+	}
+	return connect.NewResponse(&apiv1.DeleteAlertRuleResponse{System: cfg}), nil
+}
+
+// GetAlertRuleStatus returns the most recent evaluation state of every rule.
+// Rules are evaluated on the system Raft leader, so the routing layer sends
+// this RPC there.
+func (s *SystemServer) GetAlertRuleStatus(
+	ctx context.Context,
+	req *connect.Request[apiv1.GetAlertRuleStatusRequest],
+) (*connect.Response[apiv1.GetAlertRuleStatusResponse], error) {
+	resp := &apiv1.GetAlertRuleStatusResponse{}
+	if s.alertRuleStatus == nil {
+		return connect.NewResponse(resp), nil
+	}
+	for _, st := range s.alertRuleStatus.Statuses() {
+		ps := &apiv1.AlertRuleStatus{
+			RuleId: st.RuleID.ToProto(),
+			State:  string(st.State),
+			Value:  st.Value,
+			Error:  st.Error,
+		}
+		if !st.LastEvaluated.IsZero() {
+			ps.LastEvaluated = timestamppb.New(st.LastEvaluated)
+		}
+		if !st.FiringSince.IsZero() {
+			ps.FiringSince = timestamppb.New(st.FiringSince)
+		}
+		if !st.LastResolved.IsZero() {
+			ps.LastResolved = timestamppb.New(st.LastResolved)
+		}
+		resp.Statuses = append(resp.Statuses, ps)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *SystemServer) loadConfigAlertRules(ctx context.Context, resp *apiv1.GetSystemResponse) error {
+	rules, err := s.sysStore.ListAlertRules(ctx)
+	if err != nil {
+		return fmt.Errorf("list alert rules: %w", err)
+	}
+	for _, r := range rules {
+		resp.AlertRules = append(resp.AlertRules, convert.AlertRuleConfigToProto(r))
+	}
+	return nil
+}
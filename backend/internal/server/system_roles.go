@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"gastrolog/internal/glid"
+
+	"connectrpc.com/connect"
+
+	apiv1 "gastrolog/api/gen/gastrolog/v1"
+	"gastrolog/internal/convert"
+	"gastrolog/internal/system"
+	"gastrolog/internal/system/raftfsm"
+)
+
+// PutRole creates or updates a custom role.
+func (s *SystemServer) PutRole(
+	ctx context.Context,
+	req *connect.Request[apiv1.PutRoleRequest],
+) (*connect.Response[apiv1.PutRoleResponse], error) {
+	if req.Msg.Config == nil {
+		return nil, errRequired("config")
+	}
+	if len(req.Msg.Config.Id) == 0 {
+		req.Msg.Config.Id = glid.New().ToProto()
+	}
+	if req.Msg.Config.Name == "" {
+		return nil, errRequired("name")
+	}
+
+	id, connErr := parseProtoID(req.Msg.Config.Id)
+	if connErr != nil {
+		return nil, connErr
+	}
+
+	cfg := convert.RoleConfigFromProto(req.Msg.Config)
+	cfg.ID = id
+	if err := cfg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	roles, err := s.sysStore.ListRoles(ctx)
+	if err != nil {
+		return nil, errInternal(err)
+	}
+	if connErr := checkNameConflict("role", id, cfg.Name, roles, func(r system.RoleConfig) (glid.GLID, string) { return r.ID, r.Name }); connErr != nil {
+		return nil, connErr
+	}
+
+	// Users reference roles by name, so renaming an assigned role would
+	// silently strip their access.
+	existing, err := s.sysStore.GetRole(ctx, id)
+	if err != nil {
+		return nil, errInternal(err)
+	}
+	if existing != nil && existing.Name != cfg.Name {
+		if connErr := s.checkRoleUnassigned(ctx, existing.Name, "rename"); connErr != nil {
+			return nil, connErr
+		}
+	}
+
+	for _, g := range cfg.Grants {
+		vc, err := s.sysStore.GetVault(ctx, g.VaultID)
+		if err != nil {
+			return nil, errInternal(err)
+		}
+		if vc == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("grant vault %q not found", g.VaultID))
+		}
+	}
+
+	if err := s.sysStore.PutRole(ctx, cfg); err != nil {
+		return nil, errInternal(err)
+	}
+	s.notify(raftfsm.Notification{Kind: raftfsm.NotifyRolePut, ID: id})
+
+	fullCfg, err := s.buildFullSystem(ctx)
+	if err != nil {
+		return nil, errInternal(err)
+	}
+	return connect.NewResponse(&apiv1.PutRoleResponse{System: fullCfg}), nil
+}
+
+// DeleteRole removes a custom role. Roles still assigned to users cannot
+// be deleted.
+func (s *SystemServer) DeleteRole(
+	ctx context.Context,
+	req *connect.Request[apiv1.DeleteRoleRequest],
+) (*connect.Response[apiv1.DeleteRoleResponse], error) {
+	if len(req.Msg.Id) == 0 {
+		return nil, errRequired("id")
+	}
+
+	id, connErr := parseProtoID(req.Msg.Id)
+	if connErr != nil {
+		return nil, connErr
+	}
+
+	existing, err := s.sysStore.GetRole(ctx, id)
+	if err != nil {
+		return nil, errInternal(err)
+	}
+	if existing != nil {
+		if connErr := s.checkRoleUnassigned(ctx, existing.Name, "delete"); connErr != nil {
+			return nil, connErr
+		}
+	}
+
+	if err := s.sysStore.DeleteRole(ctx, id); err != nil {
+		return nil, errInternal(err)
+	}
+	s.notify(raftfsm.Notification{Kind: raftfsm.NotifyRoleDeleted, ID: id})
+
+	cfg, err := s.buildFullSystem(ctx)
+	if err != nil {
+		return nil, errInternal(err)
+	}
+	return connect.NewResponse(&apiv1.DeleteRoleResponse{System: cfg}), nil
+}
+
+// checkRoleUnassigned returns FailedPrecondition if any user holds role.
+func (s *SystemServer) checkRoleUnassigned(ctx context.Context, role, verb string) *connect.Error {
+	users, err := s.sysStore.ListUsers(ctx)
+	if err != nil {
+		return errInternal(err)
+	}
+	for _, u := range users {
+		if u.Role == role {
+			return connect.NewError(connect.CodeFailedPrecondition,
+				fmt.Errorf("cannot %s role %q: assigned to user %q", verb, role, u.Username))
+		}
+	}
+	return nil
+}
+
+// vaultReferencedByRole reports whether any role grants access to vaultID,
+// returning the first such role's name.
+func (s *SystemServer) vaultReferencedByRole(ctx context.Context, vaultID glid.GLID) (string, bool, error) {
+	roles, err := s.sysStore.ListRoles(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	for _, r := range roles {
+		for _, g := range r.Grants {
+			if g.VaultID == vaultID {
+				return r.Name, true, nil
+			}
+		}
+	}
+	return "", false, nil
+}
+
+func (s *SystemServer) loadConfigRoles(ctx context.Context, resp *apiv1.GetSystemResponse) error {
+	roles, err := s.sysStore.ListRoles(ctx)
+	if err != nil {
+		return fmt.Errorf("list roles: %w", err)
+	}
+	for _, r := range roles {
+		resp.Roles = append(resp.Roles, convert.RoleConfigToProto(r))
+	}
+	return nil
+}
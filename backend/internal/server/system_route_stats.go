@@ -39,6 +39,7 @@ func (s *SystemServer) GetRouteStats(
 			RouteId:          routeID.ToProto(),
 			RecordsMatched:   ps.Matched.Load(),
 			RecordsForwarded: ps.Forwarded.Load(),
+			RecordsDropped:   ps.Dropped.Load(),
 		}
 	}
 
@@ -94,5 +95,6 @@ func mergePerRouteStats(m map[string]*apiv1.PerRouteStats, stats []*apiv1.PerRou
 		}
 		existing.RecordsMatched += rs.RecordsMatched
 		existing.RecordsForwarded += rs.RecordsForwarded
+		existing.RecordsDropped += rs.RecordsDropped
 	}
 }
@@ -9,6 +9,9 @@ import (
 	"connectrpc.com/connect"
 
 	apiv1 "gastrolog/api/gen/gastrolog/v1"
+	"gastrolog/internal/chunk"
+	"gastrolog/internal/convert"
+	"gastrolog/internal/ingestpipeline"
 	"gastrolog/internal/system"
 	"gastrolog/internal/system/raftfsm"
 )
@@ -89,6 +92,13 @@ func (s *SystemServer) PutRoute(
 			fmt.Errorf("distribution must be \"fanout\", \"round-robin\", or \"failover\", got %q", distribution))
 	}
 
+	// Compile the pipeline up front so a bad stage is rejected here rather
+	// than failing the orchestrator's filter reload on every node.
+	stages := convert.RouteStagesFromProto(req.Msg.Config.Stages)
+	if _, err := ingestpipeline.Compile(stages); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	cfg := system.RouteConfig{
 		ID:           id,
 		Name:         req.Msg.Config.Name,
@@ -97,6 +107,7 @@ func (s *SystemServer) PutRoute(
 		Distribution: system.DistributionMode(distribution),
 		Enabled:      req.Msg.Config.Enabled,
 		EjectOnly:    req.Msg.Config.EjectOnly,
+		Stages:       stages,
 	}
 	if err := s.sysStore.PutRoute(ctx, cfg); err != nil {
 		return nil, errInternal(err)
@@ -169,6 +180,41 @@ func (s *SystemServer) DeleteRoute(
 	return connect.NewResponse(&apiv1.DeleteRouteResponse{System: cfg}), nil
 }
 
+// maxPipelinePreviewLines bounds the sample size of PreviewRoutePipeline.
+const maxPipelinePreviewLines = 1000
+
+// PreviewRoutePipeline runs route pipeline stages against sample lines.
+// Each line becomes a record carrying the request attrs; nothing is stored.
+// Invalid stages are reported in the response's error field, like the
+// lookup previews, so the UI can show them inline while the user edits.
+func (s *SystemServer) PreviewRoutePipeline(
+	_ context.Context,
+	req *connect.Request[apiv1.PreviewRoutePipelineRequest],
+) (*connect.Response[apiv1.PreviewRoutePipelineResponse], error) {
+	if len(req.Msg.Lines) > maxPipelinePreviewLines {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("at most %d sample lines per preview", maxPipelinePreviewLines))
+	}
+	pl, err := ingestpipeline.Compile(convert.RouteStagesFromProto(req.Msg.Stages))
+	if err != nil {
+		return connect.NewResponse(&apiv1.PreviewRoutePipelineResponse{Error: err.Error()}), nil
+	}
+
+	resp := &apiv1.PreviewRoutePipelineResponse{}
+	for _, line := range req.Msg.Lines {
+		out, keep := pl.Apply(chunk.Record{
+			Raw:   []byte(line),
+			Attrs: chunk.Attributes(req.Msg.Attrs),
+		})
+		resp.Results = append(resp.Results, &apiv1.RoutePipelineResult{
+			Dropped: !keep,
+			Raw:     string(out.Raw),
+			Attrs:   out.Attrs,
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // vaultReferencedByRoute checks if a vault ID is used as a destination in any route.
 func (s *SystemServer) vaultReferencedByRoute(ctx context.Context, vaultID glid.GLID) (glid.GLID, bool, error) {
 	routes, err := s.sysStore.ListRoutes(ctx)
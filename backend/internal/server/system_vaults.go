@@ -105,6 +105,12 @@ func (s *SystemServer) DeleteVault(
 		return nil, connect.NewError(connect.CodeFailedPrecondition,
 			fmt.Errorf("vault %q is referenced as destination in route %q", req.Msg.Id, routeID))
 	}
+	if roleName, used, err := s.vaultReferencedByRole(ctx, id); err != nil {
+		return nil, errInternal(err)
+	} else if used {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("vault %q is granted by role %q", req.Msg.Id, roleName))
+	}
 
 	if req.Msg.Force {
 		if err := s.forceDeleteVault(id); err != nil {
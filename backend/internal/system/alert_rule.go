@@ -0,0 +1,156 @@
+package system
+
+import (
+	"errors"
+	"fmt"
+	"gastrolog/internal/glid"
+	"time"
+
+	"github.com/go-co-op/gocron/v2"
+)
+
+// ---------------------------------------------------------------------------
+// Alert rules
+// ---------------------------------------------------------------------------
+
+// AlertRuleConfig defines a user-authored alert: a saved search evaluated on
+// a cron schedule, a threshold condition on its result, and the sinks that
+// are notified when the rule starts or stops firing.
+//
+// Rules are cluster-wide. Every node registers the schedule, but only the
+// system Raft leader evaluates, so each evaluation happens exactly once.
+type AlertRuleConfig struct {
+	// ID is the unique identifier (UUIDv7).
+	ID glid.GLID `json:"id"`
+
+	// Name is the human-readable display name (unique).
+	Name string `json:"name"`
+
+	// Query is the search expression, including any pipeline. Time bounds
+	// come from the expression itself (e.g. "last=5m level=error | stats count").
+	Query string `json:"query"`
+
+	// Schedule is a 5-field or 6-field cron expression.
+	Schedule string `json:"schedule"`
+
+	// Condition is the threshold that makes the rule fire.
+	Condition AlertCondition `json:"condition"`
+
+	// Severity is "warning" (default) or "error".
+	Severity string `json:"severity,omitempty"`
+
+	// Sinks are notified on every firing/resolved transition.
+	Sinks []AlertSink `json:"sinks,omitempty"`
+
+	// Enabled controls whether the rule is scheduled.
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// AlertConditionOp is a comparison operator used by AlertCondition.
+type AlertConditionOp string
+
+const (
+	AlertOpGT AlertConditionOp = ">"
+	AlertOpGE AlertConditionOp = ">="
+	AlertOpLT AlertConditionOp = "<"
+	AlertOpLE AlertConditionOp = "<="
+	AlertOpEQ AlertConditionOp = "=="
+	AlertOpNE AlertConditionOp = "!="
+)
+
+// AlertCondition compares a value derived from the query result against a
+// threshold.
+//
+// When Column is empty the value is the row count: the number of records for
+// a plain search, or the number of table rows for an aggregating pipeline.
+// When Column is set the query must produce a table (e.g. "| stats ..."); the
+// rule fires if any row's column value satisfies the comparison.
+type AlertCondition struct {
+	Column    string           `json:"column,omitempty"`
+	Op        AlertConditionOp `json:"op"`
+	Threshold float64          `json:"threshold"`
+}
+
+// Compare reports whether v satisfies the condition.
+func (c AlertCondition) Compare(v float64) bool {
+	switch c.Op {
+	case AlertOpGT:
+		return v > c.Threshold
+	case AlertOpGE:
+		return v >= c.Threshold
+	case AlertOpLT:
+		return v < c.Threshold
+	case AlertOpLE:
+		return v <= c.Threshold
+	case AlertOpEQ:
+		return v == c.Threshold
+	case AlertOpNE:
+		return v != c.Threshold
+	}
+	return false
+}
+
+// AlertSinkType identifies a notification sink implementation.
+type AlertSinkType string
+
+const (
+	// AlertSinkWebhook POSTs a JSON payload. Params: url, header.<Name>.
+	AlertSinkWebhook AlertSinkType = "webhook"
+	// AlertSinkSMTP sends a plain-text email. Params: addr, from, to
+	// (comma-separated), username, password.
+	AlertSinkSMTP AlertSinkType = "smtp"
+	// AlertSinkVault appends an alert record to a vault. Params: vault_id.
+	AlertSinkVault AlertSinkType = "vault"
+)
+
+// AlertSink is a notification target for an alert rule. Params are
+// type-specific, like IngesterConfig.Params; the evaluator's sink factory
+// parses and validates them.
+type AlertSink struct {
+	Type   AlertSinkType     `json:"type"`
+	Params map[string]string `json:"params,omitempty"`
+}
+
+// Validate checks the parts of the rule that can be checked without
+// touching the store: schedule syntax, operator, severity, and sink types.
+func (r AlertRuleConfig) Validate() error {
+	if r.Query == "" {
+		return errors.New("query is required")
+	}
+	if r.Schedule == "" {
+		return errors.New("schedule is required")
+	}
+	cr := gocron.NewDefaultCron(true)
+	if err := cr.IsValid(r.Schedule, time.UTC, time.Now()); err != nil {
+		return fmt.Errorf("invalid cron expression: %w", err)
+	}
+	switch r.Condition.Op {
+	case AlertOpGT, AlertOpGE, AlertOpLT, AlertOpLE, AlertOpEQ, AlertOpNE:
+	default:
+		return fmt.Errorf("invalid condition operator %q", r.Condition.Op)
+	}
+	switch r.Severity {
+	case "", "warning", "error":
+	default:
+		return fmt.Errorf("severity must be \"warning\" or \"error\", got %q", r.Severity)
+	}
+	for i, s := range r.Sinks {
+		switch s.Type {
+		case AlertSinkWebhook:
+			if s.Params["url"] == "" {
+				return fmt.Errorf("sink %d: webhook requires url", i)
+			}
+		case AlertSinkSMTP:
+			if s.Params["addr"] == "" || s.Params["from"] == "" || s.Params["to"] == "" {
+				return fmt.Errorf("sink %d: smtp requires addr, from and to", i)
+			}
+		case AlertSinkVault:
+			if _, err := glid.ParseUUID(s.Params["vault_id"]); err != nil {
+				return fmt.Errorf("sink %d: vault requires a valid vault_id", i)
+			}
+		default:
+			return fmt.Errorf("sink %d: unknown type %q", i, s.Type)
+		}
+	}
+	return nil
+}
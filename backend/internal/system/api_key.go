@@ -0,0 +1,68 @@
+package system
+
+import (
+	"errors"
+	"fmt"
+	"gastrolog/internal/glid"
+	"slices"
+	"time"
+)
+
+// ---------------------------------------------------------------------------
+// API keys
+// ---------------------------------------------------------------------------
+
+// API key scopes. A key may hold several; "admin" implies the others.
+const (
+	// APIKeyScopeQuery allows the QueryService RPCs (search, follow, ...).
+	APIKeyScopeQuery = "query"
+	// APIKeyScopeIngest allows pushing records (VaultService.ImportRecords).
+	APIKeyScopeIngest = "ingest"
+	// APIKeyScopeAdmin allows every RPC an admin user may call.
+	APIKeyScopeAdmin = "admin"
+)
+
+// APIKey is a long-lived credential for scripts, CI jobs, and dashboards.
+// Only the SHA-256 hash of the key is stored; the key itself is shown once
+// when it is created.
+type APIKey struct {
+	ID         glid.GLID `json:"id"`
+	Name       string    `json:"name"`
+	KeyHash    string    `json:"key_hash"`             // SHA-256 of the opaque key
+	Scopes     []string  `json:"scopes"`               // APIKeyScope* values
+	CreatedBy  string    `json:"created_by,omitempty"` // username of the creator
+	ExpiresAt  time.Time `json:"expires_at,omitzero"`  // zero = never expires
+	LastUsedAt time.Time `json:"last_used_at,omitzero"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// Validate checks the key name and scopes.
+func (k APIKey) Validate() error {
+	if k.Name == "" {
+		return errors.New("name is required")
+	}
+	if k.KeyHash == "" {
+		return errors.New("key hash is required")
+	}
+	if len(k.Scopes) == 0 {
+		return errors.New("at least one scope is required")
+	}
+	for _, s := range k.Scopes {
+		switch s {
+		case APIKeyScopeQuery, APIKeyScopeIngest, APIKeyScopeAdmin:
+		default:
+			return fmt.Errorf("unknown scope %q", s)
+		}
+	}
+	return nil
+}
+
+// HasScope reports whether the key grants scope. The admin scope grants all.
+func (k APIKey) HasScope(scope string) bool {
+	return slices.Contains(k.Scopes, scope) || slices.Contains(k.Scopes, APIKeyScopeAdmin)
+}
+
+// Expired reports whether the key has an expiry at or before now.
+func (k APIKey) Expired(now time.Time) bool {
+	return !k.ExpiresAt.IsZero() && !now.Before(k.ExpiresAt)
+}
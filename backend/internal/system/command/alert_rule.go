@@ -0,0 +1,40 @@
+package command
+
+import (
+	gastrologv1 "gastrolog/api/gen/gastrolog/v1"
+	"gastrolog/internal/convert"
+	"gastrolog/internal/glid"
+	"gastrolog/internal/system"
+)
+
+func putAlertRuleCmd(cfg system.AlertRuleConfig) *gastrologv1.PutAlertRuleCommand {
+	return &gastrologv1.PutAlertRuleCommand{
+		AlertRule: convert.AlertRuleConfigToProto(cfg),
+	}
+}
+
+// NewPutAlertRule creates a ConfigCommand for PutAlertRule.
+func NewPutAlertRule(cfg system.AlertRuleConfig) *gastrologv1.SystemCommand {
+	return &gastrologv1.SystemCommand{
+		Command: &gastrologv1.SystemCommand_PutAlertRule{PutAlertRule: putAlertRuleCmd(cfg)},
+	}
+}
+
+// NewDeleteAlertRule creates a ConfigCommand for DeleteAlertRule.
+func NewDeleteAlertRule(id glid.GLID) *gastrologv1.SystemCommand {
+	return &gastrologv1.SystemCommand{
+		Command: &gastrologv1.SystemCommand_DeleteAlertRule{
+			DeleteAlertRule: &gastrologv1.DeleteAlertRuleCommand{Id: id.ToProto()},
+		},
+	}
+}
+
+// ExtractPutAlertRule converts a PutAlertRuleCommand back to an AlertRuleConfig.
+func ExtractPutAlertRule(cmd *gastrologv1.PutAlertRuleCommand) (system.AlertRuleConfig, error) {
+	return convert.AlertRuleConfigFromProto(cmd.GetAlertRule()), nil
+}
+
+// ExtractDeleteAlertRule extracts the UUID from a DeleteAlertRuleCommand.
+func ExtractDeleteAlertRule(cmd *gastrologv1.DeleteAlertRuleCommand) (glid.GLID, error) {
+	return glid.FromBytes(cmd.GetId()), nil
+}
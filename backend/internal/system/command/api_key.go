@@ -0,0 +1,86 @@
+package command
+
+import (
+	gastrologv1 "gastrolog/api/gen/gastrolog/v1"
+	"gastrolog/internal/glid"
+	"gastrolog/internal/system"
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func createAPIKeyCmd(k system.APIKey) *gastrologv1.CreateAPIKeyCommand {
+	cmd := &gastrologv1.CreateAPIKeyCommand{
+		Id:        k.ID.ToProto(),
+		Name:      k.Name,
+		KeyHash:   k.KeyHash,
+		Scopes:    slices.Clone(k.Scopes),
+		CreatedBy: k.CreatedBy,
+		CreatedAt: timestamppb.New(k.CreatedAt),
+	}
+	if !k.ExpiresAt.IsZero() {
+		cmd.ExpiresAt = timestamppb.New(k.ExpiresAt)
+	}
+	if !k.LastUsedAt.IsZero() {
+		cmd.LastUsedAt = timestamppb.New(k.LastUsedAt)
+	}
+	return cmd
+}
+
+// NewCreateAPIKey creates a ConfigCommand for CreateAPIKey.
+func NewCreateAPIKey(k system.APIKey) *gastrologv1.SystemCommand {
+	return &gastrologv1.SystemCommand{
+		Command: &gastrologv1.SystemCommand_CreateApiKey{CreateApiKey: createAPIKeyCmd(k)},
+	}
+}
+
+// NewDeleteAPIKey creates a ConfigCommand for DeleteAPIKey.
+func NewDeleteAPIKey(id glid.GLID) *gastrologv1.SystemCommand {
+	return &gastrologv1.SystemCommand{
+		Command: &gastrologv1.SystemCommand_DeleteApiKey{
+			DeleteApiKey: &gastrologv1.DeleteAPIKeyCommand{Id: id.ToProto()},
+		},
+	}
+}
+
+// NewTouchAPIKey creates a ConfigCommand for TouchAPIKey.
+func NewTouchAPIKey(id glid.GLID, at time.Time) *gastrologv1.SystemCommand {
+	return &gastrologv1.SystemCommand{
+		Command: &gastrologv1.SystemCommand_TouchApiKey{
+			TouchApiKey: &gastrologv1.TouchAPIKeyCommand{
+				Id: id.ToProto(),
+				At: timestamppb.New(at),
+			},
+		},
+	}
+}
+
+// ExtractCreateAPIKey converts a CreateAPIKeyCommand back to an APIKey.
+func ExtractCreateAPIKey(cmd *gastrologv1.CreateAPIKeyCommand) (system.APIKey, error) {
+	k := system.APIKey{
+		ID:        glid.FromBytes(cmd.GetId()),
+		Name:      cmd.GetName(),
+		KeyHash:   cmd.GetKeyHash(),
+		Scopes:    slices.Clone(cmd.GetScopes()),
+		CreatedBy: cmd.GetCreatedBy(),
+		CreatedAt: cmd.GetCreatedAt().AsTime(),
+	}
+	if cmd.GetExpiresAt() != nil {
+		k.ExpiresAt = cmd.GetExpiresAt().AsTime()
+	}
+	if cmd.GetLastUsedAt() != nil {
+		k.LastUsedAt = cmd.GetLastUsedAt().AsTime()
+	}
+	return k, nil
+}
+
+// ExtractDeleteAPIKey extracts the UUID from a DeleteAPIKeyCommand.
+func ExtractDeleteAPIKey(cmd *gastrologv1.DeleteAPIKeyCommand) (glid.GLID, error) {
+	return glid.FromBytes(cmd.GetId()), nil
+}
+
+// ExtractTouchAPIKey returns the key ID and last-used time.
+func ExtractTouchAPIKey(cmd *gastrologv1.TouchAPIKeyCommand) (glid.GLID, time.Time, error) {
+	return glid.FromBytes(cmd.GetId()), cmd.GetAt().AsTime(), nil
+}
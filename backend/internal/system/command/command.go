@@ -303,6 +303,7 @@ func putRouteCmd(cfg system.RouteConfig) *gastrologv1.PutRouteCommand {
 		Distribution:   string(cfg.Distribution),
 		Enabled:        cfg.Enabled,
 		EjectOnly:      cfg.EjectOnly,
+		Stages:         convert.RouteStagesToProto(cfg.Stages),
 	}
 }
 
@@ -332,6 +333,7 @@ func ExtractPutRoute(cmd *gastrologv1.PutRouteCommand) (system.RouteConfig, erro
 		Distribution: system.DistributionMode(cmd.GetDistribution()),
 		Enabled:      cmd.GetEnabled(),
 		EjectOnly:    cmd.GetEjectOnly(),
+		Stages:       convert.RouteStagesFromProto(cmd.GetStages()),
 	}, nil
 }
 
@@ -530,6 +532,7 @@ func createUserCmd(u system.User) *gastrologv1.CreateUserCommand {
 		Username:           u.Username,
 		PasswordHash:       u.PasswordHash,
 		Role:               u.Role,
+		ExternalId:         u.ExternalID,
 		Preferences:        u.Preferences,
 		TokenInvalidatedAt: timestamppb.New(u.TokenInvalidatedAt),
 		CreatedAt:          timestamppb.New(u.CreatedAt),
@@ -620,6 +623,7 @@ func ExtractCreateUser(cmd *gastrologv1.CreateUserCommand) (system.User, error)
 		Username:           cmd.GetUsername(),
 		PasswordHash:       cmd.GetPasswordHash(),
 		Role:               cmd.GetRole(),
+		ExternalID:         cmd.GetExternalId(),
 		Preferences:        cmd.GetPreferences(),
 		TokenInvalidatedAt: cmd.GetTokenInvalidatedAt().AsTime(),
 		CreatedAt:          cmd.GetCreatedAt().AsTime(),
@@ -836,6 +840,15 @@ func BuildSnapshot(sys *system.System, users []system.User, tokens []system.Refr
 	for _, rt := range cfg.Routes {
 		snap.Routes = append(snap.Routes, putRouteCmd(rt))
 	}
+	for _, ar := range cfg.AlertRules {
+		snap.AlertRules = append(snap.AlertRules, putAlertRuleCmd(ar))
+	}
+	for _, r := range cfg.Roles {
+		snap.Roles = append(snap.Roles, putRoleCmd(r))
+	}
+	for _, k := range cfg.APIKeys {
+		snap.ApiKeys = append(snap.ApiKeys, createAPIKeyCmd(k))
+	}
 	for _, lf := range cfg.ManagedFiles {
 		snap.ManagedFiles = append(snap.ManagedFiles, putManagedFileCmd(lf))
 	}
@@ -964,6 +977,27 @@ func RestoreSnapshot(snap *gastrologv1.SystemSnapshot) (*system.System, []system
 		}
 		cfg.Routes = append(cfg.Routes, rc)
 	}
+	for _, ar := range snap.GetAlertRules() {
+		arc, err := ExtractPutAlertRule(ar)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("restore alert rule: %w", err)
+		}
+		cfg.AlertRules = append(cfg.AlertRules, arc)
+	}
+	for _, r := range snap.GetRoles() {
+		rc, err := ExtractPutRole(r)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("restore role: %w", err)
+		}
+		cfg.Roles = append(cfg.Roles, rc)
+	}
+	for _, k := range snap.GetApiKeys() {
+		key, err := ExtractCreateAPIKey(k)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("restore api key: %w", err)
+		}
+		cfg.APIKeys = append(cfg.APIKeys, key)
+	}
 	for _, lf := range snap.GetManagedFiles() {
 		lfc, err := ExtractPutManagedFile(lf)
 		if err != nil {
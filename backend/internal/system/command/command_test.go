@@ -177,6 +177,29 @@ func TestPutIngester(t *testing.T) {
 	}
 }
 
+func TestPutRouteWithStages(t *testing.T) {
+	t.Parallel()
+	want := system.RouteConfig{
+		ID:           glid.New(),
+		Name:         "web",
+		FilterID:     ptr(glid.New()),
+		Destinations: []glid.GLID{glid.New()},
+		Distribution: system.DistributionFanout,
+		Enabled:      true,
+		Stages: []system.RouteStage{
+			{Type: system.RouteStageGrok, Params: map[string]string{"pattern": "%{IP:client}"}},
+			{Type: system.RouteStageHash, Params: map[string]string{"keys": "client", "salt": "s"}},
+			{Type: system.RouteStageDrop, Params: map[string]string{"expression": "healthcheck"}},
+		},
+	}
+	got := roundTripCommand(t, NewPutRoute(want), func(cmd *gastrologv1.SystemCommand) (system.RouteConfig, error) {
+		return ExtractPutRoute(cmd.GetPutRoute())
+	})
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
 func TestPutServerSettings(t *testing.T) {
 	t.Parallel()
 	want := system.ServerSettings{
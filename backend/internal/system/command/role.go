@@ -0,0 +1,40 @@
+package command
+
+import (
+	gastrologv1 "gastrolog/api/gen/gastrolog/v1"
+	"gastrolog/internal/convert"
+	"gastrolog/internal/glid"
+	"gastrolog/internal/system"
+)
+
+func putRoleCmd(cfg system.RoleConfig) *gastrologv1.PutRoleCommand {
+	return &gastrologv1.PutRoleCommand{
+		Role: convert.RoleConfigToProto(cfg),
+	}
+}
+
+// NewPutRole creates a ConfigCommand for PutRole.
+func NewPutRole(cfg system.RoleConfig) *gastrologv1.SystemCommand {
+	return &gastrologv1.SystemCommand{
+		Command: &gastrologv1.SystemCommand_PutRole{PutRole: putRoleCmd(cfg)},
+	}
+}
+
+// NewDeleteRole creates a ConfigCommand for DeleteRole.
+func NewDeleteRole(id glid.GLID) *gastrologv1.SystemCommand {
+	return &gastrologv1.SystemCommand{
+		Command: &gastrologv1.SystemCommand_DeleteRole{
+			DeleteRole: &gastrologv1.DeleteRoleCommand{Id: id.ToProto()},
+		},
+	}
+}
+
+// ExtractPutRole converts a PutRoleCommand back to a RoleConfig.
+func ExtractPutRole(cmd *gastrologv1.PutRoleCommand) (system.RoleConfig, error) {
+	return convert.RoleConfigFromProto(cmd.GetRole()), nil
+}
+
+// ExtractDeleteRole extracts the UUID from a DeleteRoleCommand.
+func ExtractDeleteRole(cmd *gastrologv1.DeleteRoleCommand) (glid.GLID, error) {
+	return glid.FromBytes(cmd.GetId()), nil
+}
@@ -58,6 +58,9 @@ type Config struct {
 	ManagedFiles      []ManagedFileConfig     `json:"managedFiles,omitempty"`
 	CloudServices     []CloudService          `json:"cloudServices,omitempty"`
 	Tiers             []TierConfig            `json:"tiers,omitempty"`
+	AlertRules        []AlertRuleConfig       `json:"alertRules,omitempty"`
+	Roles             []RoleConfig            `json:"roles,omitempty"`
+	APIKeys           []APIKey                `json:"apiKeys,omitempty"`
 
 	// Server-level settings.
 	Auth      AuthConfig      `json:"auth,omitzero"`
@@ -79,6 +82,7 @@ type AuthConfig struct {
 	TokenDuration        string         `json:"token_duration,omitempty"`         // Go duration, e.g. "168h"
 	RefreshTokenDuration string         `json:"refresh_token_duration,omitempty"` // Go duration, e.g. "168h"
 	PasswordPolicy       PasswordPolicy `json:"password_policy,omitzero"`
+	OIDC                 OIDCConfig     `json:"oidc,omitzero"`
 }
 
 // PasswordPolicy holds password complexity rules.
@@ -225,7 +229,8 @@ type User struct {
 	ID                 glid.GLID `json:"id"`
 	Username           string    `json:"username"`
 	PasswordHash       string    `json:"password_hash"`
-	Role               string    `json:"role"`                          // "admin" or "user"
+	Role               string    `json:"role"`                          // "admin", "user", or a RoleConfig name
+	ExternalID         string    `json:"external_id,omitempty"`         // "<issuer>|<subject>" for SSO users; empty for local users
 	Preferences        string    `json:"preferences,omitempty"`         // opaque JSON blob
 	TokenInvalidatedAt time.Time `json:"token_invalidated_at,omitzero"` // tokens issued before this are invalid
 	CreatedAt          time.Time `json:"created_at"`
@@ -49,10 +49,13 @@ type Store struct {
 	vaults               map[glid.GLID]system.VaultConfig
 	ingesters            map[glid.GLID]system.IngesterConfig
 	routes               map[glid.GLID]system.RouteConfig
+	alertRules           map[glid.GLID]system.AlertRuleConfig
+	roles                map[glid.GLID]system.RoleConfig
 	ss                   serverSettings
 	certs                map[glid.GLID]system.CertPEM
 	users                map[glid.GLID]system.User         // keyed by ID (UUID)
 	refreshTokens        map[glid.GLID]system.RefreshToken // keyed by token ID
+	apiKeys              map[glid.GLID]system.APIKey       // keyed by key ID
 	nodes                map[glid.GLID]system.NodeConfig   // keyed by node ID
 	managedFiles         map[glid.GLID]system.ManagedFileConfig
 	cloudServices        map[glid.GLID]system.CloudService
@@ -77,9 +80,12 @@ func NewStore() *Store {
 		vaults:              make(map[glid.GLID]system.VaultConfig),
 		ingesters:           make(map[glid.GLID]system.IngesterConfig),
 		routes:              make(map[glid.GLID]system.RouteConfig),
+		alertRules:          make(map[glid.GLID]system.AlertRuleConfig),
+		roles:               make(map[glid.GLID]system.RoleConfig),
 		certs:               make(map[glid.GLID]system.CertPEM),
 		users:               make(map[glid.GLID]system.User),
 		refreshTokens:       make(map[glid.GLID]system.RefreshToken),
+		apiKeys:             make(map[glid.GLID]system.APIKey),
 		nodes:               make(map[glid.GLID]system.NodeConfig),
 		managedFiles:        make(map[glid.GLID]system.ManagedFileConfig),
 		cloudServices:       make(map[glid.GLID]system.CloudService),
@@ -97,6 +103,7 @@ func (s *Store) isEmpty() bool {
 	return len(s.filters) == 0 && len(s.rotationPolicies) == 0 &&
 		len(s.retentionPolicies) == 0 && len(s.vaults) == 0 &&
 		len(s.ingesters) == 0 && len(s.routes) == 0 &&
+		len(s.alertRules) == 0 && len(s.roles) == 0 && len(s.apiKeys) == 0 &&
 		len(s.managedFiles) == 0 && len(s.cloudServices) == 0 &&
 		len(s.tiers) == 0 && len(s.nodeStorageConfigs) == 0 &&
 		!s.ss.hasServerSettings && s.clusterTLS == nil
@@ -123,6 +130,9 @@ func (s *Store) Load(ctx context.Context) (*system.System, error) {
 	cfg.Vaults = collectAndSort(s.vaults, copyVaultConfig, func(a, b system.VaultConfig) int { return cmpUUID(a.ID, b.ID) })
 	cfg.Ingesters = collectAndSort(s.ingesters, copyIngesterConfig, func(a, b system.IngesterConfig) int { return cmpUUID(a.ID, b.ID) })
 	cfg.Routes = collectAndSort(s.routes, copyRouteConfig, func(a, b system.RouteConfig) int { return cmpUUID(a.ID, b.ID) })
+	cfg.AlertRules = collectAndSort(s.alertRules, copyAlertRuleConfig, func(a, b system.AlertRuleConfig) int { return cmpUUID(a.ID, b.ID) })
+	cfg.Roles = collectAndSort(s.roles, copyRoleConfig, func(a, b system.RoleConfig) int { return cmpUUID(a.ID, b.ID) })
+	cfg.APIKeys = collectAndSort(s.apiKeys, copyAPIKey, func(a, b system.APIKey) int { return cmpUUID(a.ID, b.ID) })
 	cfg.ManagedFiles = collectAndSort(s.managedFiles, func(v system.ManagedFileConfig) system.ManagedFileConfig { return v }, func(a, b system.ManagedFileConfig) int { return cmpUUID(a.ID, b.ID) })
 	cfg.CloudServices = collectAndSort(s.cloudServices, copyCloudService, func(a, b system.CloudService) int { return cmpUUID(a.ID, b.ID) })
 	cfg.Tiers = collectAndSort(s.tiers, copyTierConfig, func(a, b system.TierConfig) int { return cmpUUID(a.ID, b.ID) })
@@ -443,6 +453,90 @@ func (s *Store) DeleteRoute(ctx context.Context, id glid.GLID) error {
 	return nil
 }
 
+// Alert rules
+
+func (s *Store) GetAlertRule(ctx context.Context, id glid.GLID) (*system.AlertRuleConfig, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ar, ok := s.alertRules[id]
+	if !ok {
+		return nil, nil
+	}
+	c := copyAlertRuleConfig(ar)
+	return &c, nil
+}
+
+func (s *Store) ListAlertRules(ctx context.Context) ([]system.AlertRuleConfig, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]system.AlertRuleConfig, 0, len(s.alertRules))
+	for _, ar := range s.alertRules {
+		result = append(result, copyAlertRuleConfig(ar))
+	}
+	slices.SortFunc(result, func(a, b system.AlertRuleConfig) int { return cmpUUID(a.ID, b.ID) })
+	return result, nil
+}
+
+func (s *Store) PutAlertRule(ctx context.Context, cfg system.AlertRuleConfig) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.alertRules[cfg.ID] = copyAlertRuleConfig(cfg)
+	return nil
+}
+
+func (s *Store) DeleteAlertRule(ctx context.Context, id glid.GLID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.alertRules, id)
+	return nil
+}
+
+// Roles
+
+func (s *Store) GetRole(ctx context.Context, id glid.GLID) (*system.RoleConfig, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	r, ok := s.roles[id]
+	if !ok {
+		return nil, nil
+	}
+	c := copyRoleConfig(r)
+	return &c, nil
+}
+
+func (s *Store) ListRoles(ctx context.Context) ([]system.RoleConfig, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]system.RoleConfig, 0, len(s.roles))
+	for _, r := range s.roles {
+		result = append(result, copyRoleConfig(r))
+	}
+	slices.SortFunc(result, func(a, b system.RoleConfig) int { return cmpUUID(a.ID, b.ID) })
+	return result, nil
+}
+
+func (s *Store) PutRole(ctx context.Context, cfg system.RoleConfig) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.roles[cfg.ID] = copyRoleConfig(cfg)
+	return nil
+}
+
+func (s *Store) DeleteRole(ctx context.Context, id glid.GLID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.roles, id)
+	return nil
+}
+
 // Managed files
 
 func (s *Store) GetManagedFile(ctx context.Context, id glid.GLID) (*system.ManagedFileConfig, error) {
@@ -931,6 +1025,65 @@ func (s *Store) DeleteUserRefreshTokens(ctx context.Context, userID glid.GLID) e
 	return nil
 }
 
+// API keys
+
+func (s *Store) CreateAPIKey(ctx context.Context, key system.APIKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.apiKeys[key.ID] = copyAPIKey(key)
+	return nil
+}
+
+func (s *Store) GetAPIKeyByHash(ctx context.Context, keyHash string) (*system.APIKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, k := range s.apiKeys {
+		if k.KeyHash == keyHash {
+			c := copyAPIKey(k)
+			return &c, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *Store) ListAPIKeys(ctx context.Context) ([]system.APIKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	keys := make([]system.APIKey, 0, len(s.apiKeys))
+	for _, k := range s.apiKeys {
+		keys = append(keys, copyAPIKey(k))
+	}
+	slices.SortFunc(keys, func(a, b system.APIKey) int { return cmpUUID(a.ID, b.ID) })
+	return keys, nil
+}
+
+func (s *Store) DeleteAPIKey(ctx context.Context, id glid.GLID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.apiKeys, id)
+	return nil
+}
+
+// TouchAPIKey records a use of the key. Unknown keys are ignored (the key
+// may have been revoked between authentication and the touch), and the
+// timestamp never moves backwards.
+func (s *Store) TouchAPIKey(ctx context.Context, id glid.GLID, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k, ok := s.apiKeys[id]
+	if !ok || !at.After(k.LastUsedAt) {
+		return nil
+	}
+	k.LastUsedAt = at
+	s.apiKeys[id] = k
+	return nil
+}
+
 // Deep copy helpers
 
 func copyFilterConfig(fc system.FilterConfig) system.FilterConfig {
@@ -1029,6 +1182,34 @@ func copyIngesterConfig(ing system.IngesterConfig) system.IngesterConfig {
 	}
 }
 
+func copyAlertRuleConfig(ar system.AlertRuleConfig) system.AlertRuleConfig {
+	c := ar
+	if len(ar.Sinks) > 0 {
+		c.Sinks = make([]system.AlertSink, len(ar.Sinks))
+		for i, sink := range ar.Sinks {
+			c.Sinks[i] = system.AlertSink{Type: sink.Type, Params: maps.Clone(sink.Params)}
+		}
+	}
+	return c
+}
+
+func copyAPIKey(k system.APIKey) system.APIKey {
+	c := k
+	c.Scopes = slices.Clone(k.Scopes)
+	return c
+}
+
+func copyRoleConfig(r system.RoleConfig) system.RoleConfig {
+	c := r
+	if len(r.Grants) > 0 {
+		c.Grants = make([]system.VaultGrant, len(r.Grants))
+		for i, g := range r.Grants {
+			c.Grants[i] = system.VaultGrant{VaultID: g.VaultID, Actions: slices.Clone(g.Actions)}
+		}
+	}
+	return c
+}
+
 func copyRouteConfig(rt system.RouteConfig) system.RouteConfig {
 	c := system.RouteConfig{
 		ID:           rt.ID,
@@ -1044,6 +1225,12 @@ func copyRouteConfig(rt system.RouteConfig) system.RouteConfig {
 		c.Destinations = make([]glid.GLID, len(rt.Destinations))
 		copy(c.Destinations, rt.Destinations)
 	}
+	if len(rt.Stages) > 0 {
+		c.Stages = make([]system.RouteStage, len(rt.Stages))
+		for i, st := range rt.Stages {
+			c.Stages[i] = system.RouteStage{Type: st.Type, Params: maps.Clone(st.Params)}
+		}
+	}
 	return c
 }
 
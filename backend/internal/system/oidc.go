@@ -0,0 +1,124 @@
+package system
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ---------------------------------------------------------------------------
+// OIDC single sign-on
+// ---------------------------------------------------------------------------
+
+// OIDC claim defaults.
+const (
+	DefaultOIDCUsernameClaim = "preferred_username"
+	DefaultOIDCGroupsClaim   = "groups"
+)
+
+// OIDCConfig configures login through an OpenID Connect provider using the
+// authorization-code flow. Users are provisioned on first login; their role
+// is re-derived from the groups claim on every login.
+type OIDCConfig struct {
+	Enabled       bool              `json:"enabled,omitempty"`
+	IssuerURL     string            `json:"issuer_url,omitempty"`
+	ClientID      string            `json:"client_id,omitempty"`
+	ClientSecret  string            `json:"client_secret,omitempty"`  //nolint:gosec // G117: config field, not a hardcoded credential
+	RedirectURL   string            `json:"redirect_url,omitempty"`   // where the IdP sends the browser back, e.g. https://logs.example.com/auth/callback
+	Scopes        []string          `json:"scopes,omitempty"`         // requested in addition to "openid"
+	UsernameClaim string            `json:"username_claim,omitempty"` // default "preferred_username"
+	GroupsClaim   string            `json:"groups_claim,omitempty"`   // default "groups"
+	RoleMappings  []OIDCRoleMapping `json:"role_mappings,omitempty"`  // first matching group wins
+	DefaultRole   string            `json:"default_role,omitempty"`   // role when no group matches; empty = deny login
+}
+
+// OIDCRoleMapping maps an IdP group to a GastroLog role.
+type OIDCRoleMapping struct {
+	Group string `json:"group"`
+	Role  string `json:"role"` // "admin", "user", or a RoleConfig name
+}
+
+// Validate checks the configuration when SSO is enabled.
+// Role names are not checked here; custom roles live in the Raft config.
+func (c OIDCConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.IssuerURL == "" {
+		return errors.New("oidc: issuer URL is required")
+	}
+	if c.ClientID == "" {
+		return errors.New("oidc: client ID is required")
+	}
+	if c.RedirectURL == "" {
+		return errors.New("oidc: redirect URL is required")
+	}
+	for _, m := range c.RoleMappings {
+		if m.Group == "" || m.Role == "" {
+			return fmt.Errorf("oidc: role mapping %q=%q needs both a group and a role", m.Group, m.Role)
+		}
+	}
+	if len(c.RoleMappings) == 0 && c.DefaultRole == "" {
+		return errors.New("oidc: at least one role mapping or a default role is required")
+	}
+	return nil
+}
+
+// UsernameClaimOrDefault returns the claim holding the username.
+func (c OIDCConfig) UsernameClaimOrDefault() string {
+	if c.UsernameClaim != "" {
+		return c.UsernameClaim
+	}
+	return DefaultOIDCUsernameClaim
+}
+
+// GroupsClaimOrDefault returns the claim holding the user's groups.
+func (c OIDCConfig) GroupsClaimOrDefault() string {
+	if c.GroupsClaim != "" {
+		return c.GroupsClaim
+	}
+	return DefaultOIDCGroupsClaim
+}
+
+// RoleFor returns the role for a user in groups: the first mapping whose
+// group matches, else DefaultRole. An empty result means the user may not
+// log in.
+func (c OIDCConfig) RoleFor(groups []string) string {
+	for _, m := range c.RoleMappings {
+		if slices.Contains(groups, m.Group) {
+			return m.Role
+		}
+	}
+	return c.DefaultRole
+}
+
+// FormatOIDCRoleMappings renders mappings as "group=role,group=role".
+func FormatOIDCRoleMappings(mappings []OIDCRoleMapping) string {
+	parts := make([]string, len(mappings))
+	for i, m := range mappings {
+		parts[i] = m.Group + "=" + m.Role
+	}
+	return strings.Join(parts, ",")
+}
+
+// ParseOIDCRoleMappings parses "group=role,group=role". The last "=" splits
+// each pair, so group names may contain "=". Order is preserved.
+func ParseOIDCRoleMappings(s string) ([]OIDCRoleMapping, error) {
+	var out []OIDCRoleMapping
+	for pair := range strings.SplitSeq(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		i := strings.LastIndex(pair, "=")
+		if i <= 0 || i == len(pair)-1 {
+			return nil, fmt.Errorf("invalid role mapping %q: expected group=role", pair)
+		}
+		out = append(out, OIDCRoleMapping{
+			Group: strings.TrimSpace(pair[:i]),
+			Role:  strings.TrimSpace(pair[i+1:]),
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,95 @@
+package system
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestOIDCValidate(t *testing.T) {
+	t.Parallel()
+	base := OIDCConfig{
+		Enabled:      true,
+		IssuerURL:    "https://idp.example.com",
+		ClientID:     "gastrolog",
+		RedirectURL:  "https://logs.example.com/auth/callback",
+		RoleMappings: []OIDCRoleMapping{{Group: "ops", Role: RoleAdmin}},
+	}
+	with := func(f func(*OIDCConfig)) OIDCConfig {
+		c := base
+		f(&c)
+		return c
+	}
+
+	tests := []struct {
+		name    string
+		cfg     OIDCConfig
+		wantErr string
+	}{
+		{"valid", base, ""},
+		{"disabled skips checks", OIDCConfig{}, ""},
+		{"default role only", with(func(c *OIDCConfig) { c.RoleMappings = nil; c.DefaultRole = RoleUser }), ""},
+		{"missing issuer", with(func(c *OIDCConfig) { c.IssuerURL = "" }), "issuer URL"},
+		{"missing client", with(func(c *OIDCConfig) { c.ClientID = "" }), "client ID"},
+		{"missing redirect", with(func(c *OIDCConfig) { c.RedirectURL = "" }), "redirect URL"},
+		{"no roles", with(func(c *OIDCConfig) { c.RoleMappings = nil }), "role mapping or a default role"},
+		{"empty mapping role", with(func(c *OIDCConfig) { c.RoleMappings = []OIDCRoleMapping{{Group: "ops"}} }), "needs both"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.cfg.Validate()
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestOIDCRoleFor(t *testing.T) {
+	t.Parallel()
+	cfg := OIDCConfig{
+		RoleMappings: []OIDCRoleMapping{
+			{Group: "ops", Role: RoleAdmin},
+			{Group: "web", Role: "web-oncall"},
+		},
+	}
+	if got := cfg.RoleFor([]string{"web", "ops"}); got != RoleAdmin {
+		t.Errorf("first mapping should win, got %q", got)
+	}
+	if got := cfg.RoleFor([]string{"web"}); got != "web-oncall" {
+		t.Errorf("web: got %q", got)
+	}
+	if got := cfg.RoleFor([]string{"sales"}); got != "" {
+		t.Errorf("unmapped without default should deny, got %q", got)
+	}
+	cfg.DefaultRole = RoleUser
+	if got := cfg.RoleFor(nil); got != RoleUser {
+		t.Errorf("default role: got %q", got)
+	}
+}
+
+func TestOIDCRoleMappingsRoundTrip(t *testing.T) {
+	t.Parallel()
+	got, err := ParseOIDCRoleMappings(" ops=admin, cn=web,dc=x=web-oncall ,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// "," separates pairs and the last "=" splits each pair.
+	want := []OIDCRoleMapping{{Group: "ops", Role: "admin"}, {Group: "cn", Role: "web"}, {Group: "dc=x", Role: "web-oncall"}}
+	if !slices.Equal(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+	if s := FormatOIDCRoleMappings(want); s != "ops=admin,cn=web,dc=x=web-oncall" {
+		t.Errorf("format: got %q", s)
+	}
+	if _, err := ParseOIDCRoleMappings("ops"); err == nil {
+		t.Error("expected error for pair without role")
+	}
+}
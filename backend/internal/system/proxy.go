@@ -304,6 +304,123 @@ func (p *StoreProxy) DeleteRoute(ctx context.Context, id glid.GLID) error {
 	return p.inner.DeleteRoute(ctx, id)
 }
 
+func (p *StoreProxy) GetAlertRule(ctx context.Context, id glid.GLID) (*AlertRuleConfig, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if err := p.check(); err != nil {
+		return nil, err
+	}
+	return p.inner.GetAlertRule(ctx, id)
+}
+
+func (p *StoreProxy) ListAlertRules(ctx context.Context) ([]AlertRuleConfig, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if err := p.check(); err != nil {
+		return nil, err
+	}
+	return p.inner.ListAlertRules(ctx)
+}
+
+func (p *StoreProxy) PutAlertRule(ctx context.Context, cfg AlertRuleConfig) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if err := p.check(); err != nil {
+		return err
+	}
+	return p.inner.PutAlertRule(ctx, cfg)
+}
+
+func (p *StoreProxy) DeleteAlertRule(ctx context.Context, id glid.GLID) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if err := p.check(); err != nil {
+		return err
+	}
+	return p.inner.DeleteAlertRule(ctx, id)
+}
+
+func (p *StoreProxy) GetRole(ctx context.Context, id glid.GLID) (*RoleConfig, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if err := p.check(); err != nil {
+		return nil, err
+	}
+	return p.inner.GetRole(ctx, id)
+}
+
+func (p *StoreProxy) ListRoles(ctx context.Context) ([]RoleConfig, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if err := p.check(); err != nil {
+		return nil, err
+	}
+	return p.inner.ListRoles(ctx)
+}
+
+func (p *StoreProxy) PutRole(ctx context.Context, cfg RoleConfig) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if err := p.check(); err != nil {
+		return err
+	}
+	return p.inner.PutRole(ctx, cfg)
+}
+
+func (p *StoreProxy) DeleteRole(ctx context.Context, id glid.GLID) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if err := p.check(); err != nil {
+		return err
+	}
+	return p.inner.DeleteRole(ctx, id)
+}
+
+func (p *StoreProxy) CreateAPIKey(ctx context.Context, key APIKey) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if err := p.check(); err != nil {
+		return err
+	}
+	return p.inner.CreateAPIKey(ctx, key)
+}
+
+func (p *StoreProxy) GetAPIKeyByHash(ctx context.Context, keyHash string) (*APIKey, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if err := p.check(); err != nil {
+		return nil, err
+	}
+	return p.inner.GetAPIKeyByHash(ctx, keyHash)
+}
+
+func (p *StoreProxy) ListAPIKeys(ctx context.Context) ([]APIKey, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if err := p.check(); err != nil {
+		return nil, err
+	}
+	return p.inner.ListAPIKeys(ctx)
+}
+
+func (p *StoreProxy) DeleteAPIKey(ctx context.Context, id glid.GLID) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if err := p.check(); err != nil {
+		return err
+	}
+	return p.inner.DeleteAPIKey(ctx, id)
+}
+
+func (p *StoreProxy) TouchAPIKey(ctx context.Context, id glid.GLID, at time.Time) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if err := p.check(); err != nil {
+		return err
+	}
+	return p.inner.TouchAPIKey(ctx, id, at)
+}
+
 func (p *StoreProxy) GetManagedFile(ctx context.Context, id glid.GLID) (*ManagedFileConfig, error) {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
@@ -51,6 +51,10 @@ const (
 	NotifyIngesterCheckpointSet
 	NotifyIngesterAssignmentSet
 	NotifySetupWizardDismissedSet
+	NotifyAlertRulePut
+	NotifyAlertRuleDeleted
+	NotifyRolePut
+	NotifyRoleDeleted
 )
 
 // Notification describes a config mutation that the FSM just applied.
@@ -132,6 +136,10 @@ func (f *FSM) Apply(l *raft.Log) any {
 		*gastrologv1.SystemCommand_PutClusterTls,
 		*gastrologv1.SystemCommand_PutRoute,
 		*gastrologv1.SystemCommand_DeleteRoute,
+		*gastrologv1.SystemCommand_PutAlertRule,
+		*gastrologv1.SystemCommand_DeleteAlertRule,
+		*gastrologv1.SystemCommand_PutRole,
+		*gastrologv1.SystemCommand_DeleteRole,
 		*gastrologv1.SystemCommand_PutManagedFile,
 		*gastrologv1.SystemCommand_DeleteManagedFile,
 		*gastrologv1.SystemCommand_PutCloudService,
@@ -160,6 +168,11 @@ func (f *FSM) Apply(l *raft.Log) any {
 		*gastrologv1.SystemCommand_DeleteUserRefreshTokens:
 		return f.applyRefreshToken(ctx, cmd)
 
+	case *gastrologv1.SystemCommand_CreateApiKey,
+		*gastrologv1.SystemCommand_DeleteApiKey,
+		*gastrologv1.SystemCommand_TouchApiKey:
+		return f.applyAPIKey(ctx, cmd)
+
 	default:
 		return fmt.Errorf("unknown config command type: %T", cmd.Command)
 	}
@@ -252,6 +265,14 @@ func (f *FSM) dispatchConfig(ctx context.Context, cmd *gastrologv1.SystemCommand
 		return f.applyPutRoute(ctx, c.PutRoute)
 	case *gastrologv1.SystemCommand_DeleteRoute:
 		return f.applyDeleteRoute(ctx, c.DeleteRoute)
+	case *gastrologv1.SystemCommand_PutAlertRule:
+		return f.applyPutAlertRule(ctx, c.PutAlertRule)
+	case *gastrologv1.SystemCommand_DeleteAlertRule:
+		return f.applyDeleteAlertRule(ctx, c.DeleteAlertRule)
+	case *gastrologv1.SystemCommand_PutRole:
+		return f.applyPutRole(ctx, c.PutRole)
+	case *gastrologv1.SystemCommand_DeleteRole:
+		return f.applyDeleteRole(ctx, c.DeleteRole)
 	case *gastrologv1.SystemCommand_PutManagedFile:
 		return f.applyPutManagedFile(ctx, c.PutManagedFile)
 	case *gastrologv1.SystemCommand_DeleteManagedFile:
@@ -501,6 +522,50 @@ func (f *FSM) applyDeleteRoute(ctx context.Context, pb *gastrologv1.DeleteRouteC
 	return &Notification{Kind: NotifyRouteDeleted, ID: id}, nil
 }
 
+func (f *FSM) applyPutAlertRule(ctx context.Context, pb *gastrologv1.PutAlertRuleCommand) (*Notification, error) {
+	cfg, err := command.ExtractPutAlertRule(pb)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.store.PutAlertRule(ctx, cfg); err != nil {
+		return nil, err
+	}
+	return &Notification{Kind: NotifyAlertRulePut, ID: cfg.ID}, nil
+}
+
+func (f *FSM) applyDeleteAlertRule(ctx context.Context, pb *gastrologv1.DeleteAlertRuleCommand) (*Notification, error) {
+	id, err := command.ExtractDeleteAlertRule(pb)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.store.DeleteAlertRule(ctx, id); err != nil {
+		return nil, err
+	}
+	return &Notification{Kind: NotifyAlertRuleDeleted, ID: id}, nil
+}
+
+func (f *FSM) applyPutRole(ctx context.Context, pb *gastrologv1.PutRoleCommand) (*Notification, error) {
+	cfg, err := command.ExtractPutRole(pb)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.store.PutRole(ctx, cfg); err != nil {
+		return nil, err
+	}
+	return &Notification{Kind: NotifyRolePut, ID: cfg.ID}, nil
+}
+
+func (f *FSM) applyDeleteRole(ctx context.Context, pb *gastrologv1.DeleteRoleCommand) (*Notification, error) {
+	id, err := command.ExtractDeleteRole(pb)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.store.DeleteRole(ctx, id); err != nil {
+		return nil, err
+	}
+	return &Notification{Kind: NotifyRoleDeleted, ID: id}, nil
+}
+
 func (f *FSM) applyPutManagedFile(ctx context.Context, pb *gastrologv1.PutManagedFileCommand) (*Notification, error) {
 	cfg, err := command.ExtractPutManagedFile(pb)
 	if err != nil {
@@ -733,6 +798,35 @@ func (f *FSM) applyRefreshToken(ctx context.Context, cmd *gastrologv1.SystemComm
 	}
 }
 
+// applyAPIKey dispatches API key commands.
+func (f *FSM) applyAPIKey(ctx context.Context, cmd *gastrologv1.SystemCommand) error {
+	switch c := cmd.Command.(type) {
+	case *gastrologv1.SystemCommand_CreateApiKey:
+		key, err := command.ExtractCreateAPIKey(c.CreateApiKey)
+		if err != nil {
+			return err
+		}
+		return f.store.CreateAPIKey(ctx, key)
+
+	case *gastrologv1.SystemCommand_DeleteApiKey:
+		id, err := command.ExtractDeleteAPIKey(c.DeleteApiKey)
+		if err != nil {
+			return err
+		}
+		return f.store.DeleteAPIKey(ctx, id)
+
+	case *gastrologv1.SystemCommand_TouchApiKey:
+		id, at, err := command.ExtractTouchAPIKey(c.TouchApiKey)
+		if err != nil {
+			return err
+		}
+		return f.store.TouchAPIKey(ctx, id, at)
+
+	default:
+		return fmt.Errorf("unexpected api key command: %T", c)
+	}
+}
+
 // cascadeDeleteRotationPolicy clears rotation policy references from tiers.
 func (f *FSM) cascadeDeleteRotationPolicy(ctx context.Context, policyID glid.GLID) error {
 	tiers, err := f.store.ListTiers(ctx)
@@ -877,6 +971,21 @@ func (f *FSM) Restore(rc io.ReadCloser) error { //nolint:gocognit,gocyclo // sna
 			return fmt.Errorf("restore route %s: %w", route.ID, err)
 		}
 	}
+	for _, ar := range cfg.AlertRules {
+		if err := newStore.PutAlertRule(ctx, ar); err != nil {
+			return fmt.Errorf("restore alert rule %s: %w", ar.ID, err)
+		}
+	}
+	for _, k := range cfg.APIKeys {
+		if err := newStore.CreateAPIKey(ctx, k); err != nil {
+			return fmt.Errorf("restore api key %s: %w", k.ID, err)
+		}
+	}
+	for _, r := range cfg.Roles {
+		if err := newStore.PutRole(ctx, r); err != nil {
+			return fmt.Errorf("restore role %s: %w", r.ID, err)
+		}
+	}
 	if settings := snap.GetSettings(); len(settings) > 0 {
 		if _, ok := settings["server"]; ok {
 			if err := newStore.SaveServerSettings(ctx, system.ServerSettings{
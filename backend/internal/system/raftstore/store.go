@@ -166,6 +166,22 @@ func (s *Store) ListRoutes(ctx context.Context) ([]system.RouteConfig, error) {
 	return s.fsm.Store().ListRoutes(ctx)
 }
 
+func (s *Store) GetAlertRule(ctx context.Context, id glid.GLID) (*system.AlertRuleConfig, error) {
+	return s.fsm.Store().GetAlertRule(ctx, id)
+}
+
+func (s *Store) ListAlertRules(ctx context.Context) ([]system.AlertRuleConfig, error) {
+	return s.fsm.Store().ListAlertRules(ctx)
+}
+
+func (s *Store) GetRole(ctx context.Context, id glid.GLID) (*system.RoleConfig, error) {
+	return s.fsm.Store().GetRole(ctx, id)
+}
+
+func (s *Store) ListRoles(ctx context.Context) ([]system.RoleConfig, error) {
+	return s.fsm.Store().ListRoles(ctx)
+}
+
 func (s *Store) GetManagedFile(ctx context.Context, id glid.GLID) (*system.ManagedFileConfig, error) {
 	return s.fsm.Store().GetManagedFile(ctx, id)
 }
@@ -222,6 +238,14 @@ func (s *Store) ListRefreshTokens(ctx context.Context) ([]system.RefreshToken, e
 	return s.fsm.Store().ListRefreshTokens(ctx)
 }
 
+func (s *Store) GetAPIKeyByHash(ctx context.Context, keyHash string) (*system.APIKey, error) {
+	return s.fsm.Store().GetAPIKeyByHash(ctx, keyHash)
+}
+
+func (s *Store) ListAPIKeys(ctx context.Context) ([]system.APIKey, error) {
+	return s.fsm.Store().ListAPIKeys(ctx)
+}
+
 func (s *Store) GetCloudService(ctx context.Context, id glid.GLID) (*system.CloudService, error) {
 	return s.fsm.Store().GetCloudService(ctx, id)
 }
@@ -298,6 +322,22 @@ func (s *Store) DeleteRoute(ctx context.Context, id glid.GLID) error {
 	return s.apply(ctx, command.NewDeleteRoute(id))
 }
 
+func (s *Store) PutAlertRule(ctx context.Context, cfg system.AlertRuleConfig) error {
+	return s.apply(ctx, command.NewPutAlertRule(cfg))
+}
+
+func (s *Store) DeleteAlertRule(ctx context.Context, id glid.GLID) error {
+	return s.apply(ctx, command.NewDeleteAlertRule(id))
+}
+
+func (s *Store) PutRole(ctx context.Context, cfg system.RoleConfig) error {
+	return s.apply(ctx, command.NewPutRole(cfg))
+}
+
+func (s *Store) DeleteRole(ctx context.Context, id glid.GLID) error {
+	return s.apply(ctx, command.NewDeleteRole(id))
+}
+
 func (s *Store) PutManagedFile(ctx context.Context, cfg system.ManagedFileConfig) error {
 	return s.apply(ctx, command.NewPutManagedFile(cfg))
 }
@@ -374,6 +414,18 @@ func (s *Store) DeleteUserRefreshTokens(ctx context.Context, userID glid.GLID) e
 	return s.apply(ctx, command.NewDeleteUserRefreshTokens(userID))
 }
 
+func (s *Store) CreateAPIKey(ctx context.Context, key system.APIKey) error {
+	return s.apply(ctx, command.NewCreateAPIKey(key))
+}
+
+func (s *Store) DeleteAPIKey(ctx context.Context, id glid.GLID) error {
+	return s.apply(ctx, command.NewDeleteAPIKey(id))
+}
+
+func (s *Store) TouchAPIKey(ctx context.Context, id glid.GLID, at time.Time) error {
+	return s.apply(ctx, command.NewTouchAPIKey(id, at))
+}
+
 func (s *Store) PutCloudService(ctx context.Context, svc system.CloudService) error {
 	return s.apply(ctx, command.NewPutCloudService(svc))
 }
@@ -0,0 +1,102 @@
+package system
+
+import (
+	"errors"
+	"fmt"
+	"gastrolog/internal/glid"
+	"slices"
+)
+
+// ---------------------------------------------------------------------------
+// Roles
+// ---------------------------------------------------------------------------
+
+// Built-in role names. They are not stored as RoleConfig entries and cannot
+// be redefined. "admin" may do everything; "user" may read every vault but
+// not change configuration.
+const (
+	RoleAdmin = "admin"
+	RoleUser  = "user"
+)
+
+// IsBuiltinRole reports whether name is one of the built-in roles.
+func IsBuiltinRole(name string) bool {
+	return name == RoleAdmin || name == RoleUser
+}
+
+// VaultAction is an operation a role may be granted on a vault.
+type VaultAction string
+
+const (
+	// VaultActionSearch allows search, explain, context and field sampling.
+	VaultActionSearch VaultAction = "search"
+	// VaultActionFollow allows live tailing.
+	VaultActionFollow VaultAction = "follow"
+	// VaultActionExport allows ExportToVault from the vault, or into it.
+	VaultActionExport VaultAction = "export"
+)
+
+// VaultGrant gives a role a set of actions on one vault.
+type VaultGrant struct {
+	VaultID glid.GLID     `json:"vaultId"`
+	Actions []VaultAction `json:"actions"`
+}
+
+// RoleConfig is a custom role. Users assigned a custom role can read only
+// the vaults it grants, and cannot call admin procedures.
+//
+// Users reference roles by name (User.Role), so a role that is assigned to
+// any user cannot be renamed or deleted.
+type RoleConfig struct {
+	// ID is the unique identifier (UUIDv7).
+	ID glid.GLID `json:"id"`
+
+	// Name is the role name stored on users (unique, not a built-in name).
+	Name string `json:"name"`
+
+	// Grants lists the vaults the role may read and how.
+	Grants []VaultGrant `json:"grants,omitempty"`
+}
+
+// Validate checks the role name and its grants.
+func (r RoleConfig) Validate() error {
+	if r.Name == "" {
+		return errors.New("name is required")
+	}
+	if IsBuiltinRole(r.Name) {
+		return fmt.Errorf("%q is a built-in role", r.Name)
+	}
+	seen := make(map[glid.GLID]bool, len(r.Grants))
+	for i, g := range r.Grants {
+		if g.VaultID == glid.Nil {
+			return fmt.Errorf("grant %d: vault is required", i)
+		}
+		if seen[g.VaultID] {
+			return fmt.Errorf("grant %d: duplicate vault %s", i, g.VaultID)
+		}
+		seen[g.VaultID] = true
+		if len(g.Actions) == 0 {
+			return fmt.Errorf("grant %d: at least one action is required", i)
+		}
+		for _, a := range g.Actions {
+			switch a {
+			case VaultActionSearch, VaultActionFollow, VaultActionExport:
+			default:
+				return fmt.Errorf("grant %d: unknown action %q", i, a)
+			}
+		}
+	}
+	return nil
+}
+
+// VaultsFor returns the vaults on which the role grants action.
+// The result is non-nil so callers can tell "no vaults" from "unrestricted".
+func (r RoleConfig) VaultsFor(action VaultAction) []glid.GLID {
+	out := make([]glid.GLID, 0, len(r.Grants))
+	for _, g := range r.Grants {
+		if slices.Contains(g.Actions, action) {
+			out = append(out, g.VaultID)
+		}
+	}
+	return out
+}
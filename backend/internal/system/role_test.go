@@ -0,0 +1,67 @@
+package system
+
+import (
+	"gastrolog/internal/glid"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRoleValidate(t *testing.T) {
+	t.Parallel()
+	vault := glid.New()
+	search := []VaultAction{VaultActionSearch}
+
+	tests := []struct {
+		name    string
+		role    RoleConfig
+		wantErr string
+	}{
+		{"valid", RoleConfig{Name: "auditors", Grants: []VaultGrant{{VaultID: vault, Actions: search}}}, ""},
+		{"no grants", RoleConfig{Name: "nobody"}, ""},
+		{"missing name", RoleConfig{}, "name is required"},
+		{"builtin admin", RoleConfig{Name: RoleAdmin}, "built-in"},
+		{"builtin user", RoleConfig{Name: RoleUser}, "built-in"},
+		{"nil vault", RoleConfig{Name: "r", Grants: []VaultGrant{{Actions: search}}}, "vault is required"},
+		{"no actions", RoleConfig{Name: "r", Grants: []VaultGrant{{VaultID: vault}}}, "at least one action"},
+		{"unknown action", RoleConfig{Name: "r", Grants: []VaultGrant{{VaultID: vault, Actions: []VaultAction{"delete"}}}}, "unknown action"},
+		{"duplicate vault", RoleConfig{Name: "r", Grants: []VaultGrant{
+			{VaultID: vault, Actions: search},
+			{VaultID: vault, Actions: []VaultAction{VaultActionFollow}},
+		}}, "duplicate vault"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.role.Validate()
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRoleVaultsFor(t *testing.T) {
+	t.Parallel()
+	a, b := glid.New(), glid.New()
+	role := RoleConfig{Name: "r", Grants: []VaultGrant{
+		{VaultID: a, Actions: []VaultAction{VaultActionSearch, VaultActionFollow}},
+		{VaultID: b, Actions: []VaultAction{VaultActionSearch}},
+	}}
+
+	if got := role.VaultsFor(VaultActionSearch); !slices.Equal(got, []glid.GLID{a, b}) {
+		t.Errorf("search: got %v", got)
+	}
+	if got := role.VaultsFor(VaultActionFollow); !slices.Equal(got, []glid.GLID{a}) {
+		t.Errorf("follow: got %v", got)
+	}
+	if got := role.VaultsFor(VaultActionExport); got == nil || len(got) != 0 {
+		t.Errorf("export: expected empty non-nil slice, got %#v", got)
+	}
+}
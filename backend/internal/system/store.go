@@ -62,6 +62,18 @@ type Store interface {
 	PutRoute(ctx context.Context, cfg RouteConfig) error
 	DeleteRoute(ctx context.Context, id glid.GLID) error
 
+	// Alert rules
+	GetAlertRule(ctx context.Context, id glid.GLID) (*AlertRuleConfig, error)
+	ListAlertRules(ctx context.Context) ([]AlertRuleConfig, error)
+	PutAlertRule(ctx context.Context, cfg AlertRuleConfig) error
+	DeleteAlertRule(ctx context.Context, id glid.GLID) error
+
+	// Roles
+	GetRole(ctx context.Context, id glid.GLID) (*RoleConfig, error)
+	ListRoles(ctx context.Context) ([]RoleConfig, error)
+	PutRole(ctx context.Context, cfg RoleConfig) error
+	DeleteRole(ctx context.Context, id glid.GLID) error
+
 	// Managed files
 	GetManagedFile(ctx context.Context, id glid.GLID) (*ManagedFileConfig, error)
 	ListManagedFiles(ctx context.Context) ([]ManagedFileConfig, error)
@@ -109,6 +121,13 @@ type Store interface {
 	DeleteRefreshToken(ctx context.Context, id glid.GLID) error
 	DeleteUserRefreshTokens(ctx context.Context, userID glid.GLID) error
 
+	// API keys
+	CreateAPIKey(ctx context.Context, key APIKey) error
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (*APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]APIKey, error)
+	DeleteAPIKey(ctx context.Context, id glid.GLID) error
+	TouchAPIKey(ctx context.Context, id glid.GLID, at time.Time) error
+
 	// Cloud services (cluster-wide)
 	GetCloudService(ctx context.Context, id glid.GLID) (*CloudService, error)
 	ListCloudServices(ctx context.Context) ([]CloudService, error)
@@ -38,6 +38,9 @@ func TestStore(t *testing.T, newStore func(t *testing.T) system.Store) {
 	testUsers(t, newStore)
 	testAuth(t, newStore)
 	testCloudServices(t, newStore)
+	testAlertRules(t, newStore)
+	testRoles(t, newStore)
+	testAPIKeys(t, newStore)
 	testTiers(t, newStore)
 	testNodeStorageConfigs(t, newStore)
 }
@@ -1565,6 +1568,295 @@ func testAuth(t *testing.T, newStore func(t *testing.T) system.Store) {
 	})
 }
 
+func testAlertRules(t *testing.T, newStore func(t *testing.T) system.Store) {
+	t.Run("PutGetAlertRule", func(t *testing.T) {
+		s := newStore(t)
+		ctx := context.Background()
+
+		id := newID()
+		rule := system.AlertRuleConfig{
+			ID:        id,
+			Name:      "errors",
+			Query:     "last=5m level=error | stats count",
+			Schedule:  "*/5 * * * *",
+			Condition: system.AlertCondition{Column: "count", Op: system.AlertOpGT, Threshold: 10},
+			Severity:  "error",
+			Sinks: []system.AlertSink{
+				{Type: system.AlertSinkWebhook, Params: map[string]string{"url": "http://hook"}},
+			},
+			Enabled: true,
+		}
+		if err := s.PutAlertRule(ctx, rule); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+
+		got, err := s.GetAlertRule(ctx, id)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if got == nil {
+			t.Fatal("expected alert rule, got nil")
+		}
+		if got.Name != "errors" || got.Query != rule.Query || got.Schedule != rule.Schedule {
+			t.Errorf("unexpected rule: %+v", got)
+		}
+		if got.Condition != rule.Condition {
+			t.Errorf("Condition: expected %+v, got %+v", rule.Condition, got.Condition)
+		}
+		if len(got.Sinks) != 1 || got.Sinks[0].Params["url"] != "http://hook" {
+			t.Errorf("Sinks: expected webhook sink, got %+v", got.Sinks)
+		}
+		if !got.Enabled {
+			t.Error("expected Enabled=true")
+		}
+	})
+
+	t.Run("ListDeleteAlertRules", func(t *testing.T) {
+		s := newStore(t)
+		ctx := context.Background()
+
+		idA := newID()
+		idB := newID()
+		for _, r := range []system.AlertRuleConfig{
+			{ID: idA, Name: "a", Query: "*", Schedule: "* * * * *", Condition: system.AlertCondition{Op: system.AlertOpGT}},
+			{ID: idB, Name: "b", Query: "*", Schedule: "* * * * *", Condition: system.AlertCondition{Op: system.AlertOpLT, Threshold: 1}},
+		} {
+			if err := s.PutAlertRule(ctx, r); err != nil {
+				t.Fatalf("Put %s: %v", r.Name, err)
+			}
+		}
+
+		all, err := s.ListAlertRules(ctx)
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		if len(all) != 2 || all[0].ID != idA || all[1].ID != idB {
+			t.Fatalf("expected [a b] in ID order, got %+v", all)
+		}
+
+		if err := s.DeleteAlertRule(ctx, idA); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+		got, err := s.GetAlertRule(ctx, idA)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if got != nil {
+			t.Fatalf("expected nil after delete, got %+v", got)
+		}
+
+		cfg, err := s.Load(ctx)
+		if err != nil {
+			t.Fatalf("Load: %v", err)
+		}
+		if cfg == nil || len(cfg.Config.AlertRules) != 1 || cfg.Config.AlertRules[0].ID != idB {
+			t.Fatalf("expected Load to return only rule b, got %+v", cfg)
+		}
+	})
+}
+
+func testRoles(t *testing.T, newStore func(t *testing.T) system.Store) {
+	t.Run("PutGetRole", func(t *testing.T) {
+		s := newStore(t)
+		ctx := context.Background()
+
+		id := newID()
+		vaultA, vaultB := newID(), newID()
+		role := system.RoleConfig{
+			ID:   id,
+			Name: "auditors",
+			Grants: []system.VaultGrant{
+				{VaultID: vaultA, Actions: []system.VaultAction{system.VaultActionSearch, system.VaultActionFollow}},
+				{VaultID: vaultB, Actions: []system.VaultAction{system.VaultActionExport}},
+			},
+		}
+		if err := s.PutRole(ctx, role); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+
+		got, err := s.GetRole(ctx, id)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if got == nil {
+			t.Fatal("expected role, got nil")
+		}
+		if got.Name != "auditors" || len(got.Grants) != 2 {
+			t.Fatalf("unexpected role: %+v", got)
+		}
+		if got.Grants[0].VaultID != vaultA || len(got.Grants[0].Actions) != 2 {
+			t.Errorf("grant 0: expected search+follow on %s, got %+v", vaultA, got.Grants[0])
+		}
+		if got.Grants[1].VaultID != vaultB || got.Grants[1].Actions[0] != system.VaultActionExport {
+			t.Errorf("grant 1: expected export on %s, got %+v", vaultB, got.Grants[1])
+		}
+
+		// Mutating the returned copy must not affect the stored role.
+		got.Grants[0].Actions[0] = system.VaultActionExport
+		again, _ := s.GetRole(ctx, id)
+		if again.Grants[0].Actions[0] != system.VaultActionSearch {
+			t.Error("GetRole returned shared grant slices")
+		}
+	})
+
+	t.Run("ListDeleteRoles", func(t *testing.T) {
+		s := newStore(t)
+		ctx := context.Background()
+
+		idA := newID()
+		idB := newID()
+		for _, r := range []system.RoleConfig{{ID: idA, Name: "a"}, {ID: idB, Name: "b"}} {
+			if err := s.PutRole(ctx, r); err != nil {
+				t.Fatalf("Put %s: %v", r.Name, err)
+			}
+		}
+
+		all, err := s.ListRoles(ctx)
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		if len(all) != 2 || all[0].ID != idA || all[1].ID != idB {
+			t.Fatalf("expected [a b] in ID order, got %+v", all)
+		}
+
+		if err := s.DeleteRole(ctx, idA); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+		got, err := s.GetRole(ctx, idA)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if got != nil {
+			t.Fatalf("expected nil after delete, got %+v", got)
+		}
+
+		cfg, err := s.Load(ctx)
+		if err != nil {
+			t.Fatalf("Load: %v", err)
+		}
+		if cfg == nil || len(cfg.Config.Roles) != 1 || cfg.Config.Roles[0].ID != idB {
+			t.Fatalf("expected Load to return only role b, got %+v", cfg)
+		}
+	})
+}
+
+func testAPIKeys(t *testing.T, newStore func(t *testing.T) system.Store) {
+	t.Run("CreateGetAPIKey", func(t *testing.T) {
+		s := newStore(t)
+		ctx := context.Background()
+
+		id := newID()
+		expires := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+		key := system.APIKey{
+			ID:        id,
+			Name:      "ci",
+			KeyHash:   "sha256-key-hash",
+			Scopes:    []string{system.APIKeyScopeQuery, system.APIKeyScopeIngest},
+			CreatedBy: "admin",
+			ExpiresAt: expires,
+			CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+		if err := s.CreateAPIKey(ctx, key); err != nil {
+			t.Fatalf("CreateAPIKey: %v", err)
+		}
+
+		got, err := s.GetAPIKeyByHash(ctx, "sha256-key-hash")
+		if err != nil {
+			t.Fatalf("GetAPIKeyByHash: %v", err)
+		}
+		if got == nil {
+			t.Fatal("expected api key, got nil")
+		}
+		if got.ID != id || got.Name != "ci" || got.CreatedBy != "admin" || len(got.Scopes) != 2 {
+			t.Fatalf("unexpected api key: %+v", got)
+		}
+		if !got.ExpiresAt.Equal(expires) {
+			t.Errorf("ExpiresAt: expected %v, got %v", expires, got.ExpiresAt)
+		}
+		if !got.LastUsedAt.IsZero() {
+			t.Errorf("LastUsedAt: expected zero, got %v", got.LastUsedAt)
+		}
+
+		missing, err := s.GetAPIKeyByHash(ctx, "nonexistent-hash")
+		if err != nil {
+			t.Fatalf("GetAPIKeyByHash missing: %v", err)
+		}
+		if missing != nil {
+			t.Errorf("expected nil for unknown hash, got %+v", missing)
+		}
+	})
+
+	t.Run("TouchAPIKey", func(t *testing.T) {
+		s := newStore(t)
+		ctx := context.Background()
+
+		id := newID()
+		if err := s.CreateAPIKey(ctx, system.APIKey{ID: id, Name: "dash", KeyHash: "touch-hash", Scopes: []string{system.APIKeyScopeQuery}}); err != nil {
+			t.Fatalf("CreateAPIKey: %v", err)
+		}
+
+		t1 := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		if err := s.TouchAPIKey(ctx, id, t1); err != nil {
+			t.Fatalf("TouchAPIKey: %v", err)
+		}
+		// An older timestamp must not move LastUsedAt backwards.
+		if err := s.TouchAPIKey(ctx, id, t1.Add(-time.Hour)); err != nil {
+			t.Fatalf("TouchAPIKey older: %v", err)
+		}
+		got, _ := s.GetAPIKeyByHash(ctx, "touch-hash")
+		if got == nil || !got.LastUsedAt.Equal(t1) {
+			t.Fatalf("LastUsedAt: expected %v, got %+v", t1, got)
+		}
+
+		// Touching a deleted key is a no-op, not an error.
+		if err := s.TouchAPIKey(ctx, newID(), t1); err != nil {
+			t.Errorf("TouchAPIKey unknown: %v", err)
+		}
+	})
+
+	t.Run("ListDeleteAPIKeys", func(t *testing.T) {
+		s := newStore(t)
+		ctx := context.Background()
+
+		idA, idB := newID(), newID()
+		for _, k := range []system.APIKey{
+			{ID: idA, Name: "a", KeyHash: "hash-a", Scopes: []string{system.APIKeyScopeAdmin}},
+			{ID: idB, Name: "b", KeyHash: "hash-b", Scopes: []string{system.APIKeyScopeIngest}},
+		} {
+			if err := s.CreateAPIKey(ctx, k); err != nil {
+				t.Fatalf("CreateAPIKey %s: %v", k.Name, err)
+			}
+		}
+
+		all, err := s.ListAPIKeys(ctx)
+		if err != nil {
+			t.Fatalf("ListAPIKeys: %v", err)
+		}
+		if len(all) != 2 || all[0].ID != idA || all[1].ID != idB {
+			t.Fatalf("expected [a b] in ID order, got %+v", all)
+		}
+
+		if err := s.DeleteAPIKey(ctx, idA); err != nil {
+			t.Fatalf("DeleteAPIKey: %v", err)
+		}
+		got, err := s.GetAPIKeyByHash(ctx, "hash-a")
+		if err != nil {
+			t.Fatalf("GetAPIKeyByHash after delete: %v", err)
+		}
+		if got != nil {
+			t.Fatalf("expected nil after delete, got %+v", got)
+		}
+
+		cfg, err := s.Load(ctx)
+		if err != nil {
+			t.Fatalf("Load: %v", err)
+		}
+		if cfg == nil || len(cfg.Config.APIKeys) != 1 || cfg.Config.APIKeys[0].ID != idB {
+			t.Fatalf("expected Load to return only key b, got %+v", cfg)
+		}
+	})
+}
+
 func testCloudServices(t *testing.T, newStore func(t *testing.T) system.Store) {
 	t.Run("PutGetCloudService", func(t *testing.T) {
 		s := newStore(t)
@@ -230,6 +230,46 @@ type RouteConfig struct {
 	// When true, the route is excluded from live ingestion and can only
 	// be used as an eject target in retention rules.
 	EjectOnly bool `json:"ejectOnly,omitempty"`
+
+	// Stages is the route's ingest pipeline: an ordered list of transforms
+	// applied to every record the route matches, before it is written to
+	// the route's destinations. Empty means records pass through unchanged.
+	Stages []RouteStage `json:"stages,omitempty"`
+}
+
+// RouteStageType identifies an ingest pipeline stage implementation.
+type RouteStageType string
+
+const (
+	// RouteStageRegex extracts named capture groups into attributes.
+	// Params: pattern, field (attribute to read; empty = raw message).
+	RouteStageRegex RouteStageType = "regex"
+	// RouteStageGrok is RouteStageRegex with %{PATTERN:name} syntax.
+	// Params: pattern, field.
+	RouteStageGrok RouteStageType = "grok"
+	// RouteStageSet sets an attribute to a literal value. Params: key, value.
+	RouteStageSet RouteStageType = "set"
+	// RouteStageRename renames an attribute. Params: from, to.
+	RouteStageRename RouteStageType = "rename"
+	// RouteStageDelete removes attributes. Params: keys (comma-separated).
+	RouteStageDelete RouteStageType = "delete"
+	// RouteStageHash replaces attribute values with a hex HMAC-SHA256.
+	// Params: keys (comma-separated), salt.
+	RouteStageHash RouteStageType = "hash"
+	// RouteStageMask replaces regex matches in an attribute or the raw
+	// message. Params: pattern, replacement (default "****"), field.
+	RouteStageMask RouteStageType = "mask"
+	// RouteStageDrop discards records matching a query expression.
+	// Params: expression.
+	RouteStageDrop RouteStageType = "drop"
+)
+
+// RouteStage is one step of a route's ingest pipeline. Params are
+// type-specific, like IngesterConfig.Params; the ingestpipeline package
+// parses and validates them.
+type RouteStage struct {
+	Type   RouteStageType    `json:"type"`
+	Params map[string]string `json:"params,omitempty"`
 }
 
 // IngesterConfig describes a ingester to instantiate.
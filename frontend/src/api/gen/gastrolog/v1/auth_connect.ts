@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { ChangePasswordRequest, ChangePasswordResponse, CreateUserRequest, CreateUserResponse, DeleteUserRequest, DeleteUserResponse, GetAuthStatusRequest, GetAuthStatusResponse, ListUsersRequest, ListUsersResponse, LoginRequest, LoginResponse, LogoutRequest, LogoutResponse, RefreshTokenRequest, RefreshTokenResponse, RegisterRequest, RegisterResponse, RenameUserRequest, RenameUserResponse, ResetPasswordRequest, ResetPasswordResponse, UpdateUserRoleRequest, UpdateUserRoleResponse } from "./auth_pb.js";
+import { BeginOIDCLoginRequest, BeginOIDCLoginResponse, ChangePasswordRequest, ChangePasswordResponse, CompleteOIDCLoginRequest, CompleteOIDCLoginResponse, CreateAPIKeyRequest, CreateAPIKeyResponse, CreateUserRequest, CreateUserResponse, DeleteAPIKeyRequest, DeleteAPIKeyResponse, DeleteUserRequest, DeleteUserResponse, GetAuthStatusRequest, GetAuthStatusResponse, ListAPIKeysRequest, ListAPIKeysResponse, ListUsersRequest, ListUsersResponse, LoginRequest, LoginResponse, LogoutRequest, LogoutResponse, RefreshTokenRequest, RefreshTokenResponse, RegisterRequest, RegisterResponse, RenameUserRequest, RenameUserResponse, ResetPasswordRequest, ResetPasswordResponse, UpdateUserRoleRequest, UpdateUserRoleResponse } from "./auth_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -37,6 +37,31 @@ export const AuthService = {
       O: LoginResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * BeginOIDCLogin starts single sign-on. It returns the identity
+     * provider's authorization URL and sets a short-lived state cookie that
+     * CompleteOIDCLogin checks.
+     *
+     * @generated from rpc gastrolog.v1.AuthService.BeginOIDCLogin
+     */
+    beginOIDCLogin: {
+      name: "BeginOIDCLogin",
+      I: BeginOIDCLoginRequest,
+      O: BeginOIDCLoginResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CompleteOIDCLogin exchanges the authorization code from the identity
+     * provider's redirect for a token, provisioning the user on first login.
+     *
+     * @generated from rpc gastrolog.v1.AuthService.CompleteOIDCLogin
+     */
+    completeOIDCLogin: {
+      name: "CompleteOIDCLogin",
+      I: CompleteOIDCLoginRequest,
+      O: CompleteOIDCLoginResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * RefreshToken exchanges a valid refresh token for a new access + refresh token pair.
      *
@@ -147,6 +172,40 @@ export const AuthService = {
       O: LogoutResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * CreateAPIKey issues a long-lived API key. The key is returned once and
+     * only its hash is stored. Admin only.
+     *
+     * @generated from rpc gastrolog.v1.AuthService.CreateAPIKey
+     */
+    createAPIKey: {
+      name: "CreateAPIKey",
+      I: CreateAPIKeyRequest,
+      O: CreateAPIKeyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListAPIKeys returns all API keys (without the keys themselves). Admin only.
+     *
+     * @generated from rpc gastrolog.v1.AuthService.ListAPIKeys
+     */
+    listAPIKeys: {
+      name: "ListAPIKeys",
+      I: ListAPIKeysRequest,
+      O: ListAPIKeysResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DeleteAPIKey revokes an API key. Admin only.
+     *
+     * @generated from rpc gastrolog.v1.AuthService.DeleteAPIKey
+     */
+    deleteAPIKey: {
+      name: "DeleteAPIKey",
+      I: DeleteAPIKeyRequest,
+      O: DeleteAPIKeyResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
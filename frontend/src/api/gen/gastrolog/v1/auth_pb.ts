@@ -223,6 +223,164 @@ export class LoginResponse extends Message<LoginResponse> {
   }
 }
 
+/**
+ * @generated from message gastrolog.v1.BeginOIDCLoginRequest
+ */
+export class BeginOIDCLoginRequest extends Message<BeginOIDCLoginRequest> {
+  constructor(data?: PartialMessage<BeginOIDCLoginRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.BeginOIDCLoginRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BeginOIDCLoginRequest {
+    return new BeginOIDCLoginRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BeginOIDCLoginRequest {
+    return new BeginOIDCLoginRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BeginOIDCLoginRequest {
+    return new BeginOIDCLoginRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BeginOIDCLoginRequest | PlainMessage<BeginOIDCLoginRequest> | undefined, b: BeginOIDCLoginRequest | PlainMessage<BeginOIDCLoginRequest> | undefined): boolean {
+    return proto3.util.equals(BeginOIDCLoginRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.BeginOIDCLoginResponse
+ */
+export class BeginOIDCLoginResponse extends Message<BeginOIDCLoginResponse> {
+  /**
+   * @generated from field: string authorization_url = 1;
+   */
+  authorizationUrl = "";
+
+  constructor(data?: PartialMessage<BeginOIDCLoginResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.BeginOIDCLoginResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "authorization_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BeginOIDCLoginResponse {
+    return new BeginOIDCLoginResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BeginOIDCLoginResponse {
+    return new BeginOIDCLoginResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BeginOIDCLoginResponse {
+    return new BeginOIDCLoginResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BeginOIDCLoginResponse | PlainMessage<BeginOIDCLoginResponse> | undefined, b: BeginOIDCLoginResponse | PlainMessage<BeginOIDCLoginResponse> | undefined): boolean {
+    return proto3.util.equals(BeginOIDCLoginResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.CompleteOIDCLoginRequest
+ */
+export class CompleteOIDCLoginRequest extends Message<CompleteOIDCLoginRequest> {
+  /**
+   * "code" query parameter of the redirect
+   *
+   * @generated from field: string code = 1;
+   */
+  code = "";
+
+  /**
+   * "state" query parameter of the redirect
+   *
+   * @generated from field: string state = 2;
+   */
+  state = "";
+
+  constructor(data?: PartialMessage<CompleteOIDCLoginRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.CompleteOIDCLoginRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CompleteOIDCLoginRequest {
+    return new CompleteOIDCLoginRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CompleteOIDCLoginRequest {
+    return new CompleteOIDCLoginRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CompleteOIDCLoginRequest {
+    return new CompleteOIDCLoginRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CompleteOIDCLoginRequest | PlainMessage<CompleteOIDCLoginRequest> | undefined, b: CompleteOIDCLoginRequest | PlainMessage<CompleteOIDCLoginRequest> | undefined): boolean {
+    return proto3.util.equals(CompleteOIDCLoginRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.CompleteOIDCLoginResponse
+ */
+export class CompleteOIDCLoginResponse extends Message<CompleteOIDCLoginResponse> {
+  /**
+   * @generated from field: gastrolog.v1.Token token = 1;
+   */
+  token?: Token;
+
+  /**
+   * @generated from field: string refresh_token = 2;
+   */
+  refreshToken = "";
+
+  constructor(data?: PartialMessage<CompleteOIDCLoginResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.CompleteOIDCLoginResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token", kind: "message", T: Token },
+    { no: 2, name: "refresh_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CompleteOIDCLoginResponse {
+    return new CompleteOIDCLoginResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CompleteOIDCLoginResponse {
+    return new CompleteOIDCLoginResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CompleteOIDCLoginResponse {
+    return new CompleteOIDCLoginResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CompleteOIDCLoginResponse | PlainMessage<CompleteOIDCLoginResponse> | undefined, b: CompleteOIDCLoginResponse | PlainMessage<CompleteOIDCLoginResponse> | undefined): boolean {
+    return proto3.util.equals(CompleteOIDCLoginResponse, a, b);
+  }
+}
+
 /**
  * @generated from message gastrolog.v1.RefreshTokenRequest
  */
@@ -428,6 +586,13 @@ export class GetAuthStatusResponse extends Message<GetAuthStatusResponse> {
    */
   authDisabled = false;
 
+  /**
+   * single sign-on is configured
+   *
+   * @generated from field: bool oidc_enabled = 3;
+   */
+  oidcEnabled = false;
+
   constructor(data?: PartialMessage<GetAuthStatusResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -438,6 +603,7 @@ export class GetAuthStatusResponse extends Message<GetAuthStatusResponse> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "needs_setup", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 2, name: "auth_disabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "oidc_enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAuthStatusResponse {
@@ -492,6 +658,13 @@ export class UserInfo extends Message<UserInfo> {
    */
   id = new Uint8Array(0);
 
+  /**
+   * provisioned by single sign-on; password login is disabled
+   *
+   * @generated from field: bool sso = 6;
+   */
+  sso = false;
+
   constructor(data?: PartialMessage<UserInfo>) {
     super();
     proto3.util.initPartial(data, this);
@@ -505,6 +678,7 @@ export class UserInfo extends Message<UserInfo> {
     { no: 3, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 4, name: "updated_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 5, name: "id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 6, name: "sso", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UserInfo {
@@ -539,7 +713,7 @@ export class CreateUserRequest extends Message<CreateUserRequest> {
   password = "";
 
   /**
-   * "admin" or "user"
+   * "admin", "user", or a custom role name
    *
    * @generated from field: string role = 3;
    */
@@ -690,7 +864,7 @@ export class UpdateUserRoleRequest extends Message<UpdateUserRoleRequest> {
   id = new Uint8Array(0);
 
   /**
-   * "admin" or "user"
+   * "admin", "user", or a custom role name
    *
    * @generated from field: string role = 2;
    */
@@ -1055,3 +1229,316 @@ export class LogoutResponse extends Message<LogoutResponse> {
   }
 }
 
+/**
+ * @generated from message gastrolog.v1.APIKeyInfo
+ */
+export class APIKeyInfo extends Message<APIKeyInfo> {
+  /**
+   * @generated from field: bytes id = 1;
+   */
+  id = new Uint8Array(0);
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * "query", "ingest", "admin"
+   *
+   * @generated from field: repeated string scopes = 3;
+   */
+  scopes: string[] = [];
+
+  /**
+   * @generated from field: string created_by = 4;
+   */
+  createdBy = "";
+
+  /**
+   * unix timestamp
+   *
+   * @generated from field: int64 created_at = 5;
+   */
+  createdAt = protoInt64.zero;
+
+  /**
+   * unix timestamp, 0 = never
+   *
+   * @generated from field: int64 expires_at = 6;
+   */
+  expiresAt = protoInt64.zero;
+
+  /**
+   * unix timestamp, 0 = never used
+   *
+   * @generated from field: int64 last_used_at = 7;
+   */
+  lastUsedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<APIKeyInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.APIKeyInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "scopes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "created_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "last_used_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): APIKeyInfo {
+    return new APIKeyInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): APIKeyInfo {
+    return new APIKeyInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): APIKeyInfo {
+    return new APIKeyInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: APIKeyInfo | PlainMessage<APIKeyInfo> | undefined, b: APIKeyInfo | PlainMessage<APIKeyInfo> | undefined): boolean {
+    return proto3.util.equals(APIKeyInfo, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.CreateAPIKeyRequest
+ */
+export class CreateAPIKeyRequest extends Message<CreateAPIKeyRequest> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: repeated string scopes = 2;
+   */
+  scopes: string[] = [];
+
+  /**
+   * Go duration, e.g. "720h"; empty = never expires
+   *
+   * @generated from field: string expires_in = 3;
+   */
+  expiresIn = "";
+
+  constructor(data?: PartialMessage<CreateAPIKeyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.CreateAPIKeyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "scopes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "expires_in", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAPIKeyRequest {
+    return new CreateAPIKeyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAPIKeyRequest {
+    return new CreateAPIKeyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAPIKeyRequest {
+    return new CreateAPIKeyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateAPIKeyRequest | PlainMessage<CreateAPIKeyRequest> | undefined, b: CreateAPIKeyRequest | PlainMessage<CreateAPIKeyRequest> | undefined): boolean {
+    return proto3.util.equals(CreateAPIKeyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.CreateAPIKeyResponse
+ */
+export class CreateAPIKeyResponse extends Message<CreateAPIKeyResponse> {
+  /**
+   * @generated from field: gastrolog.v1.APIKeyInfo api_key = 1;
+   */
+  apiKey?: APIKeyInfo;
+
+  /**
+   * the secret key, shown only once
+   *
+   * @generated from field: string key = 2;
+   */
+  key = "";
+
+  constructor(data?: PartialMessage<CreateAPIKeyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.CreateAPIKeyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "api_key", kind: "message", T: APIKeyInfo },
+    { no: 2, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAPIKeyResponse {
+    return new CreateAPIKeyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAPIKeyResponse {
+    return new CreateAPIKeyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAPIKeyResponse {
+    return new CreateAPIKeyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateAPIKeyResponse | PlainMessage<CreateAPIKeyResponse> | undefined, b: CreateAPIKeyResponse | PlainMessage<CreateAPIKeyResponse> | undefined): boolean {
+    return proto3.util.equals(CreateAPIKeyResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.ListAPIKeysRequest
+ */
+export class ListAPIKeysRequest extends Message<ListAPIKeysRequest> {
+  constructor(data?: PartialMessage<ListAPIKeysRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.ListAPIKeysRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAPIKeysRequest {
+    return new ListAPIKeysRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAPIKeysRequest {
+    return new ListAPIKeysRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAPIKeysRequest {
+    return new ListAPIKeysRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAPIKeysRequest | PlainMessage<ListAPIKeysRequest> | undefined, b: ListAPIKeysRequest | PlainMessage<ListAPIKeysRequest> | undefined): boolean {
+    return proto3.util.equals(ListAPIKeysRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.ListAPIKeysResponse
+ */
+export class ListAPIKeysResponse extends Message<ListAPIKeysResponse> {
+  /**
+   * @generated from field: repeated gastrolog.v1.APIKeyInfo api_keys = 1;
+   */
+  apiKeys: APIKeyInfo[] = [];
+
+  constructor(data?: PartialMessage<ListAPIKeysResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.ListAPIKeysResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "api_keys", kind: "message", T: APIKeyInfo, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAPIKeysResponse {
+    return new ListAPIKeysResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAPIKeysResponse {
+    return new ListAPIKeysResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAPIKeysResponse {
+    return new ListAPIKeysResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAPIKeysResponse | PlainMessage<ListAPIKeysResponse> | undefined, b: ListAPIKeysResponse | PlainMessage<ListAPIKeysResponse> | undefined): boolean {
+    return proto3.util.equals(ListAPIKeysResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.DeleteAPIKeyRequest
+ */
+export class DeleteAPIKeyRequest extends Message<DeleteAPIKeyRequest> {
+  /**
+   * @generated from field: bytes id = 1;
+   */
+  id = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<DeleteAPIKeyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.DeleteAPIKeyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAPIKeyRequest {
+    return new DeleteAPIKeyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAPIKeyRequest {
+    return new DeleteAPIKeyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAPIKeyRequest {
+    return new DeleteAPIKeyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAPIKeyRequest | PlainMessage<DeleteAPIKeyRequest> | undefined, b: DeleteAPIKeyRequest | PlainMessage<DeleteAPIKeyRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteAPIKeyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.DeleteAPIKeyResponse
+ */
+export class DeleteAPIKeyResponse extends Message<DeleteAPIKeyResponse> {
+  constructor(data?: PartialMessage<DeleteAPIKeyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.DeleteAPIKeyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAPIKeyResponse {
+    return new DeleteAPIKeyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAPIKeyResponse {
+    return new DeleteAPIKeyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAPIKeyResponse {
+    return new DeleteAPIKeyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAPIKeyResponse | PlainMessage<DeleteAPIKeyResponse> | undefined, b: DeleteAPIKeyResponse | PlainMessage<DeleteAPIKeyResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteAPIKeyResponse, a, b);
+  }
+}
+
@@ -644,6 +644,23 @@ export class NodeStats extends Message<NodeStats> {
    */
   peerBytes: PeerBytesStat[] = [];
 
+  /**
+   * Disk-backed ingest queue; all zero when the node runs without one.
+   *
+   * @generated from field: uint64 ingest_disk_queue_entries = 38;
+   */
+  ingestDiskQueueEntries = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 ingest_disk_queue_bytes = 39;
+   */
+  ingestDiskQueueBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 ingest_disk_queue_max_bytes = 40;
+   */
+  ingestDiskQueueMaxBytes = protoInt64.zero;
+
   constructor(data?: PartialMessage<NodeStats>) {
     super();
     proto3.util.initPartial(data, this);
@@ -689,6 +706,9 @@ export class NodeStats extends Message<NodeStats> {
     { no: 35, name: "forwarded_received", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 36, name: "alerts", kind: "message", T: SystemAlert, repeated: true },
     { no: 37, name: "peer_bytes", kind: "message", T: PeerBytesStat, repeated: true },
+    { no: 38, name: "ingest_disk_queue_entries", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 39, name: "ingest_disk_queue_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 40, name: "ingest_disk_queue_max_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NodeStats {
@@ -1543,7 +1563,46 @@ export class RequestReplicaCatchupResponse extends Message<RequestReplicaCatchup
 /**
  * ForwardSearchRequest is sent to the node that owns a remote vault,
  * asking it to execute a search locally and return matching records.
+ * VaultAccess carries the vaults the requesting user may read. Forward RPCs
+ * without it are unrestricted (admin, built-in "user", or internal callers).
  *
+ * @generated from message gastrolog.v1.VaultAccess
+ */
+export class VaultAccess extends Message<VaultAccess> {
+  /**
+   * @generated from field: repeated bytes vault_ids = 1;
+   */
+  vaultIds: Uint8Array[] = [];
+
+  constructor(data?: PartialMessage<VaultAccess>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.VaultAccess";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "vault_ids", kind: "scalar", T: 12 /* ScalarType.BYTES */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VaultAccess {
+    return new VaultAccess().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VaultAccess {
+    return new VaultAccess().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VaultAccess {
+    return new VaultAccess().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VaultAccess | PlainMessage<VaultAccess> | undefined, b: VaultAccess | PlainMessage<VaultAccess> | undefined): boolean {
+    return proto3.util.equals(VaultAccess, a, b);
+  }
+}
+
+/**
  * @generated from message gastrolog.v1.ForwardSearchRequest
  */
 export class ForwardSearchRequest extends Message<ForwardSearchRequest> {
@@ -1564,6 +1623,18 @@ export class ForwardSearchRequest extends Message<ForwardSearchRequest> {
    */
   resumeToken = new Uint8Array(0);
 
+  /**
+   * @generated from field: gastrolog.v1.VaultAccess access = 4;
+   */
+  access?: VaultAccess;
+
+  /**
+   * stats pipelines: return mergeable accumulator state, not final values
+   *
+   * @generated from field: bool partial_stats = 5;
+   */
+  partialStats = false;
+
   constructor(data?: PartialMessage<ForwardSearchRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1575,6 +1646,8 @@ export class ForwardSearchRequest extends Message<ForwardSearchRequest> {
     { no: 1, name: "vault_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
     { no: 2, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "resume_token", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 4, name: "access", kind: "message", T: VaultAccess },
+    { no: 5, name: "partial_stats", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForwardSearchRequest {
@@ -1691,6 +1764,11 @@ export class ForwardGetContextRequest extends Message<ForwardGetContextRequest>
    */
   after = 0;
 
+  /**
+   * @generated from field: gastrolog.v1.VaultAccess access = 6;
+   */
+  access?: VaultAccess;
+
   constructor(data?: PartialMessage<ForwardGetContextRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1704,6 +1782,7 @@ export class ForwardGetContextRequest extends Message<ForwardGetContextRequest>
     { no: 3, name: "pos", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
     { no: 4, name: "before", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 5, name: "after", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "access", kind: "message", T: VaultAccess },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForwardGetContextRequest {
@@ -2668,6 +2747,11 @@ export class ForwardExplainRequest extends Message<ForwardExplainRequest> {
    */
   vaultIds: Uint8Array[] = [];
 
+  /**
+   * @generated from field: gastrolog.v1.VaultAccess access = 3;
+   */
+  access?: VaultAccess;
+
   constructor(data?: PartialMessage<ForwardExplainRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2678,6 +2762,7 @@ export class ForwardExplainRequest extends Message<ForwardExplainRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "vault_ids", kind: "scalar", T: 12 /* ScalarType.BYTES */, repeated: true },
+    { no: 3, name: "access", kind: "message", T: VaultAccess },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForwardExplainRequest {
@@ -2760,6 +2845,11 @@ export class ForwardFollowRequest extends Message<ForwardFollowRequest> {
    */
   query = "";
 
+  /**
+   * @generated from field: gastrolog.v1.VaultAccess access = 3;
+   */
+  access?: VaultAccess;
+
   constructor(data?: PartialMessage<ForwardFollowRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2770,6 +2860,7 @@ export class ForwardFollowRequest extends Message<ForwardFollowRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "vault_ids", kind: "scalar", T: 12 /* ScalarType.BYTES */, repeated: true },
     { no: 2, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "access", kind: "message", T: VaultAccess },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForwardFollowRequest {
@@ -5,7 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
-import { TierConfig, TierPlacement, VaultConfig } from "./system_pb.js";
+import { AlertRuleConfig, RoleConfig, RouteStage, TierConfig, TierPlacement, VaultConfig } from "./system_pb.js";
 import { CloudService, NodeStorageConfig } from "./storage_pb.js";
 
 /**
@@ -264,6 +264,48 @@ export class SystemCommand extends Message<SystemCommand> {
      */
     value: SetIngesterCheckpointCommand;
     case: "setIngesterCheckpoint";
+  } | {
+    /**
+     * @generated from field: gastrolog.v1.PutAlertRuleCommand put_alert_rule = 42;
+     */
+    value: PutAlertRuleCommand;
+    case: "putAlertRule";
+  } | {
+    /**
+     * @generated from field: gastrolog.v1.DeleteAlertRuleCommand delete_alert_rule = 43;
+     */
+    value: DeleteAlertRuleCommand;
+    case: "deleteAlertRule";
+  } | {
+    /**
+     * @generated from field: gastrolog.v1.PutRoleCommand put_role = 44;
+     */
+    value: PutRoleCommand;
+    case: "putRole";
+  } | {
+    /**
+     * @generated from field: gastrolog.v1.DeleteRoleCommand delete_role = 45;
+     */
+    value: DeleteRoleCommand;
+    case: "deleteRole";
+  } | {
+    /**
+     * @generated from field: gastrolog.v1.CreateAPIKeyCommand create_api_key = 46;
+     */
+    value: CreateAPIKeyCommand;
+    case: "createApiKey";
+  } | {
+    /**
+     * @generated from field: gastrolog.v1.DeleteAPIKeyCommand delete_api_key = 47;
+     */
+    value: DeleteAPIKeyCommand;
+    case: "deleteApiKey";
+  } | {
+    /**
+     * @generated from field: gastrolog.v1.TouchAPIKeyCommand touch_api_key = 48;
+     */
+    value: TouchAPIKeyCommand;
+    case: "touchApiKey";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<SystemCommand>) {
@@ -315,6 +357,13 @@ export class SystemCommand extends Message<SystemCommand> {
     { no: 39, name: "set_ingester_alive", kind: "message", T: SetIngesterAliveCommand, oneof: "command" },
     { no: 40, name: "set_ingester_assignment", kind: "message", T: SetIngesterAssignmentCommand, oneof: "command" },
     { no: 41, name: "set_ingester_checkpoint", kind: "message", T: SetIngesterCheckpointCommand, oneof: "command" },
+    { no: 42, name: "put_alert_rule", kind: "message", T: PutAlertRuleCommand, oneof: "command" },
+    { no: 43, name: "delete_alert_rule", kind: "message", T: DeleteAlertRuleCommand, oneof: "command" },
+    { no: 44, name: "put_role", kind: "message", T: PutRoleCommand, oneof: "command" },
+    { no: 45, name: "delete_role", kind: "message", T: DeleteRoleCommand, oneof: "command" },
+    { no: 46, name: "create_api_key", kind: "message", T: CreateAPIKeyCommand, oneof: "command" },
+    { no: 47, name: "delete_api_key", kind: "message", T: DeleteAPIKeyCommand, oneof: "command" },
+    { no: 48, name: "touch_api_key", kind: "message", T: TouchAPIKeyCommand, oneof: "command" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SystemCommand {
@@ -1070,6 +1119,13 @@ export class CreateUserCommand extends Message<CreateUserCommand> {
    */
   updatedAt?: Timestamp;
 
+  /**
+   * "<issuer>|<subject>" for SSO users
+   *
+   * @generated from field: string external_id = 9;
+   */
+  externalId = "";
+
   constructor(data?: PartialMessage<CreateUserCommand>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1086,6 +1142,7 @@ export class CreateUserCommand extends Message<CreateUserCommand> {
     { no: 6, name: "token_invalidated_at", kind: "message", T: Timestamp },
     { no: 7, name: "created_at", kind: "message", T: Timestamp },
     { no: 8, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 9, name: "external_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateUserCommand {
@@ -1492,6 +1549,167 @@ export class DeleteUserRefreshTokensCommand extends Message<DeleteUserRefreshTok
   }
 }
 
+/**
+ * @generated from message gastrolog.v1.CreateAPIKeyCommand
+ */
+export class CreateAPIKeyCommand extends Message<CreateAPIKeyCommand> {
+  /**
+   * @generated from field: bytes id = 1;
+   */
+  id = new Uint8Array(0);
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string key_hash = 3;
+   */
+  keyHash = "";
+
+  /**
+   * @generated from field: repeated string scopes = 4;
+   */
+  scopes: string[] = [];
+
+  /**
+   * @generated from field: string created_by = 5;
+   */
+  createdBy = "";
+
+  /**
+   * unset = never
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 6;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_used_at = 7;
+   */
+  lastUsedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<CreateAPIKeyCommand>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.CreateAPIKeyCommand";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "key_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "scopes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "created_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "expires_at", kind: "message", T: Timestamp },
+    { no: 7, name: "last_used_at", kind: "message", T: Timestamp },
+    { no: 8, name: "created_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAPIKeyCommand {
+    return new CreateAPIKeyCommand().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAPIKeyCommand {
+    return new CreateAPIKeyCommand().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAPIKeyCommand {
+    return new CreateAPIKeyCommand().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateAPIKeyCommand | PlainMessage<CreateAPIKeyCommand> | undefined, b: CreateAPIKeyCommand | PlainMessage<CreateAPIKeyCommand> | undefined): boolean {
+    return proto3.util.equals(CreateAPIKeyCommand, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.DeleteAPIKeyCommand
+ */
+export class DeleteAPIKeyCommand extends Message<DeleteAPIKeyCommand> {
+  /**
+   * @generated from field: bytes id = 1;
+   */
+  id = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<DeleteAPIKeyCommand>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.DeleteAPIKeyCommand";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAPIKeyCommand {
+    return new DeleteAPIKeyCommand().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAPIKeyCommand {
+    return new DeleteAPIKeyCommand().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAPIKeyCommand {
+    return new DeleteAPIKeyCommand().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAPIKeyCommand | PlainMessage<DeleteAPIKeyCommand> | undefined, b: DeleteAPIKeyCommand | PlainMessage<DeleteAPIKeyCommand> | undefined): boolean {
+    return proto3.util.equals(DeleteAPIKeyCommand, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.TouchAPIKeyCommand
+ */
+export class TouchAPIKeyCommand extends Message<TouchAPIKeyCommand> {
+  /**
+   * @generated from field: bytes id = 1;
+   */
+  id = new Uint8Array(0);
+
+  /**
+   * @generated from field: google.protobuf.Timestamp at = 2;
+   */
+  at?: Timestamp;
+
+  constructor(data?: PartialMessage<TouchAPIKeyCommand>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.TouchAPIKeyCommand";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TouchAPIKeyCommand {
+    return new TouchAPIKeyCommand().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TouchAPIKeyCommand {
+    return new TouchAPIKeyCommand().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TouchAPIKeyCommand {
+    return new TouchAPIKeyCommand().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TouchAPIKeyCommand | PlainMessage<TouchAPIKeyCommand> | undefined, b: TouchAPIKeyCommand | PlainMessage<TouchAPIKeyCommand> | undefined): boolean {
+    return proto3.util.equals(TouchAPIKeyCommand, a, b);
+  }
+}
+
 /**
  * @generated from message gastrolog.v1.PutNodeConfigCommand
  */
@@ -1672,6 +1890,11 @@ export class PutRouteCommand extends Message<PutRouteCommand> {
    */
   ejectOnly = false;
 
+  /**
+   * @generated from field: repeated gastrolog.v1.RouteStage stages = 8;
+   */
+  stages: RouteStage[] = [];
+
   constructor(data?: PartialMessage<PutRouteCommand>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1687,6 +1910,7 @@ export class PutRouteCommand extends Message<PutRouteCommand> {
     { no: 5, name: "distribution", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 7, name: "eject_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "stages", kind: "message", T: RouteStage, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PutRouteCommand {
@@ -2269,6 +2493,158 @@ export class SetIngesterCheckpointCommand extends Message<SetIngesterCheckpointC
   }
 }
 
+/**
+ * PutAlertRuleCommand carries the full AlertRuleConfig from system.proto.
+ *
+ * @generated from message gastrolog.v1.PutAlertRuleCommand
+ */
+export class PutAlertRuleCommand extends Message<PutAlertRuleCommand> {
+  /**
+   * @generated from field: gastrolog.v1.AlertRuleConfig alert_rule = 1;
+   */
+  alertRule?: AlertRuleConfig;
+
+  constructor(data?: PartialMessage<PutAlertRuleCommand>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.PutAlertRuleCommand";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "alert_rule", kind: "message", T: AlertRuleConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PutAlertRuleCommand {
+    return new PutAlertRuleCommand().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PutAlertRuleCommand {
+    return new PutAlertRuleCommand().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PutAlertRuleCommand {
+    return new PutAlertRuleCommand().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PutAlertRuleCommand | PlainMessage<PutAlertRuleCommand> | undefined, b: PutAlertRuleCommand | PlainMessage<PutAlertRuleCommand> | undefined): boolean {
+    return proto3.util.equals(PutAlertRuleCommand, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.DeleteAlertRuleCommand
+ */
+export class DeleteAlertRuleCommand extends Message<DeleteAlertRuleCommand> {
+  /**
+   * @generated from field: bytes id = 1;
+   */
+  id = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<DeleteAlertRuleCommand>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.DeleteAlertRuleCommand";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAlertRuleCommand {
+    return new DeleteAlertRuleCommand().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAlertRuleCommand {
+    return new DeleteAlertRuleCommand().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAlertRuleCommand {
+    return new DeleteAlertRuleCommand().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAlertRuleCommand | PlainMessage<DeleteAlertRuleCommand> | undefined, b: DeleteAlertRuleCommand | PlainMessage<DeleteAlertRuleCommand> | undefined): boolean {
+    return proto3.util.equals(DeleteAlertRuleCommand, a, b);
+  }
+}
+
+/**
+ * PutRoleCommand carries the full RoleConfig from system.proto.
+ *
+ * @generated from message gastrolog.v1.PutRoleCommand
+ */
+export class PutRoleCommand extends Message<PutRoleCommand> {
+  /**
+   * @generated from field: gastrolog.v1.RoleConfig role = 1;
+   */
+  role?: RoleConfig;
+
+  constructor(data?: PartialMessage<PutRoleCommand>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.PutRoleCommand";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "role", kind: "message", T: RoleConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PutRoleCommand {
+    return new PutRoleCommand().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PutRoleCommand {
+    return new PutRoleCommand().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PutRoleCommand {
+    return new PutRoleCommand().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PutRoleCommand | PlainMessage<PutRoleCommand> | undefined, b: PutRoleCommand | PlainMessage<PutRoleCommand> | undefined): boolean {
+    return proto3.util.equals(PutRoleCommand, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.DeleteRoleCommand
+ */
+export class DeleteRoleCommand extends Message<DeleteRoleCommand> {
+  /**
+   * @generated from field: bytes id = 1;
+   */
+  id = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<DeleteRoleCommand>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.DeleteRoleCommand";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRoleCommand {
+    return new DeleteRoleCommand().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRoleCommand {
+    return new DeleteRoleCommand().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRoleCommand {
+    return new DeleteRoleCommand().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteRoleCommand | PlainMessage<DeleteRoleCommand> | undefined, b: DeleteRoleCommand | PlainMessage<DeleteRoleCommand> | undefined): boolean {
+    return proto3.util.equals(DeleteRoleCommand, a, b);
+  }
+}
+
 /**
  * SystemSnapshot captures the full system state for FSM.Snapshot()/Restore().
  * Each repeated field contains one entry per entity, using the Put/Create
@@ -2382,6 +2758,21 @@ export class SystemSnapshot extends Message<SystemSnapshot> {
    */
   ingesterCheckpoints: SetIngesterCheckpointCommand[] = [];
 
+  /**
+   * @generated from field: repeated gastrolog.v1.PutAlertRuleCommand alert_rules = 22;
+   */
+  alertRules: PutAlertRuleCommand[] = [];
+
+  /**
+   * @generated from field: repeated gastrolog.v1.PutRoleCommand roles = 23;
+   */
+  roles: PutRoleCommand[] = [];
+
+  /**
+   * @generated from field: repeated gastrolog.v1.CreateAPIKeyCommand api_keys = 24;
+   */
+  apiKeys: CreateAPIKeyCommand[] = [];
+
   constructor(data?: PartialMessage<SystemSnapshot>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2411,6 +2802,9 @@ export class SystemSnapshot extends Message<SystemSnapshot> {
     { no: 19, name: "ingester_alive", kind: "message", T: SetIngesterAliveCommand, repeated: true },
     { no: 20, name: "ingester_assignments", kind: "message", T: SetIngesterAssignmentCommand, repeated: true },
     { no: 21, name: "ingester_checkpoints", kind: "message", T: SetIngesterCheckpointCommand, repeated: true },
+    { no: 22, name: "alert_rules", kind: "message", T: PutAlertRuleCommand, repeated: true },
+    { no: 23, name: "roles", kind: "message", T: PutRoleCommand, repeated: true },
+    { no: 24, name: "api_keys", kind: "message", T: CreateAPIKeyCommand, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SystemSnapshot {
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { DeleteCertificateRequest, DeleteCertificateResponse, DeleteCloudServiceRequest, DeleteCloudServiceResponse, DeleteFilterRequest, DeleteFilterResponse, DeleteIngesterRequest, DeleteIngesterResponse, DeleteLookupRequest, DeleteLookupResponse, DeleteManagedFileRequest, DeleteManagedFileResponse, DeleteRetentionPolicyRequest, DeleteRetentionPolicyResponse, DeleteRotationPolicyRequest, DeleteRotationPolicyResponse, DeleteRouteRequest, DeleteRouteResponse, DeleteSavedQueryRequest, DeleteSavedQueryResponse, DeleteTierRequest, DeleteTierResponse, DeleteVaultRequest, DeleteVaultResponse, GenerateNameRequest, GenerateNameResponse, GetCertificateRequest, GetCertificateResponse, GetIngesterDefaultsRequest, GetIngesterDefaultsResponse, GetIngesterStatusRequest, GetIngesterStatusResponse, GetPreferencesRequest, GetPreferencesResponse, GetRouteStatsRequest, GetRouteStatsResponse, GetSavedQueriesRequest, GetSavedQueriesResponse, GetSettingsRequest, GetSettingsResponse, GetSystemRequest, GetSystemResponse, ListCertificatesRequest, ListCertificatesResponse, ListIngestersRequest, ListIngestersResponse, ListManagedFilesRequest, ListManagedFilesResponse, PauseVaultRequest, PauseVaultResponse, PreviewCSVLookupRequest, PreviewCSVLookupResponse, PreviewJSONLookupRequest, PreviewJSONLookupResponse, PreviewYAMLLookupRequest, PreviewYAMLLookupResponse, PutCertificateRequest, PutCertificateResponse, PutCloudServiceRequest, PutCloudServiceResponse, PutFilterRequest, PutFilterResponse, PutIngesterRequest, PutIngesterResponse, PutLookupSettingsRequest, PutLookupSettingsResponse, PutMaxMindSettingsRequest, PutMaxMindSettingsResponse, PutNodeConfigRequest, PutNodeConfigResponse, PutPreferencesRequest, PutPreferencesResponse, PutRetentionPolicyRequest, PutRetentionPolicyResponse, PutRotationPolicyRequest, PutRotationPolicyResponse, PutRouteRequest, PutRouteResponse, PutSavedQueryRequest, PutSavedQueryResponse, PutServiceSettingsRequest, PutServiceSettingsResponse, PutSetupSettingsRequest, PutSetupSettingsResponse, PutTierRequest, PutTierResponse, PutVaultRequest, PutVaultResponse, RegenerateJwtSecretRequest, RegenerateJwtSecretResponse, ResumeVaultRequest, ResumeVaultResponse, SetNodeStorageConfigRequest, SetNodeStorageConfigResponse, TestCloudServiceRequest, TestCloudServiceResponse, TestHTTPLookupRequest, TestHTTPLookupResponse, TestIngesterRequest, TestIngesterResponse, TriggerIngesterRequest, TriggerIngesterResponse, WatchIngesterStatusRequest, WatchIngesterStatusResponse, WatchSystemRequest, WatchSystemResponse } from "./system_pb.js";
+import { DeleteAlertRuleRequest, DeleteAlertRuleResponse, DeleteCertificateRequest, DeleteCertificateResponse, DeleteCloudServiceRequest, DeleteCloudServiceResponse, DeleteFilterRequest, DeleteFilterResponse, DeleteIngesterRequest, DeleteIngesterResponse, DeleteLookupRequest, DeleteLookupResponse, DeleteManagedFileRequest, DeleteManagedFileResponse, DeleteRetentionPolicyRequest, DeleteRetentionPolicyResponse, DeleteRoleRequest, DeleteRoleResponse, DeleteRotationPolicyRequest, DeleteRotationPolicyResponse, DeleteRouteRequest, DeleteRouteResponse, DeleteSavedQueryRequest, DeleteSavedQueryResponse, DeleteTierRequest, DeleteTierResponse, DeleteVaultRequest, DeleteVaultResponse, GenerateNameRequest, GenerateNameResponse, GetAlertRuleStatusRequest, GetAlertRuleStatusResponse, GetCertificateRequest, GetCertificateResponse, GetIngesterDefaultsRequest, GetIngesterDefaultsResponse, GetIngesterStatusRequest, GetIngesterStatusResponse, GetPreferencesRequest, GetPreferencesResponse, GetRouteStatsRequest, GetRouteStatsResponse, GetSavedQueriesRequest, GetSavedQueriesResponse, GetSettingsRequest, GetSettingsResponse, GetSystemRequest, GetSystemResponse, ListCertificatesRequest, ListCertificatesResponse, ListIngestersRequest, ListIngestersResponse, ListManagedFilesRequest, ListManagedFilesResponse, PauseVaultRequest, PauseVaultResponse, PreviewCSVLookupRequest, PreviewCSVLookupResponse, PreviewJSONLookupRequest, PreviewJSONLookupResponse, PreviewRoutePipelineRequest, PreviewRoutePipelineResponse, PreviewYAMLLookupRequest, PreviewYAMLLookupResponse, PutAlertRuleRequest, PutAlertRuleResponse, PutCertificateRequest, PutCertificateResponse, PutCloudServiceRequest, PutCloudServiceResponse, PutFilterRequest, PutFilterResponse, PutIngesterRequest, PutIngesterResponse, PutLookupSettingsRequest, PutLookupSettingsResponse, PutMaxMindSettingsRequest, PutMaxMindSettingsResponse, PutNodeConfigRequest, PutNodeConfigResponse, PutPreferencesRequest, PutPreferencesResponse, PutRetentionPolicyRequest, PutRetentionPolicyResponse, PutRoleRequest, PutRoleResponse, PutRotationPolicyRequest, PutRotationPolicyResponse, PutRouteRequest, PutRouteResponse, PutSavedQueryRequest, PutSavedQueryResponse, PutServiceSettingsRequest, PutServiceSettingsResponse, PutSetupSettingsRequest, PutSetupSettingsResponse, PutTierRequest, PutTierResponse, PutVaultRequest, PutVaultResponse, RegenerateJwtSecretRequest, RegenerateJwtSecretResponse, ResumeVaultRequest, ResumeVaultResponse, SetNodeStorageConfigRequest, SetNodeStorageConfigResponse, TestCloudServiceRequest, TestCloudServiceResponse, TestHTTPLookupRequest, TestHTTPLookupResponse, TestIngesterRequest, TestIngesterResponse, TriggerIngesterRequest, TriggerIngesterResponse, WatchIngesterStatusRequest, WatchIngesterStatusResponse, WatchSystemRequest, WatchSystemResponse } from "./system_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -413,6 +413,18 @@ export const SystemService = {
       O: DeleteRouteResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * PreviewRoutePipeline runs route pipeline stages against sample lines
+     * and returns the resulting records. Nothing is stored.
+     *
+     * @generated from rpc gastrolog.v1.SystemService.PreviewRoutePipeline
+     */
+    previewRoutePipeline: {
+      name: "PreviewRoutePipeline",
+      I: PreviewRoutePipelineRequest,
+      O: PreviewRoutePipelineResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * GenerateName returns a random petname for use as a default entity name.
      *
@@ -598,6 +610,58 @@ export const SystemService = {
       O: DeleteLookupResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Alert rules
+     *
+     * @generated from rpc gastrolog.v1.SystemService.PutAlertRule
+     */
+    putAlertRule: {
+      name: "PutAlertRule",
+      I: PutAlertRuleRequest,
+      O: PutAlertRuleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc gastrolog.v1.SystemService.DeleteAlertRule
+     */
+    deleteAlertRule: {
+      name: "DeleteAlertRule",
+      I: DeleteAlertRuleRequest,
+      O: DeleteAlertRuleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetAlertRuleStatus returns the evaluation state of every alert rule.
+     * Served by the system Raft leader, which is the only node that evaluates.
+     *
+     * @generated from rpc gastrolog.v1.SystemService.GetAlertRuleStatus
+     */
+    getAlertRuleStatus: {
+      name: "GetAlertRuleStatus",
+      I: GetAlertRuleStatusRequest,
+      O: GetAlertRuleStatusResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Roles. Built-in roles ("admin", "user") are implicit and not listed.
+     *
+     * @generated from rpc gastrolog.v1.SystemService.PutRole
+     */
+    putRole: {
+      name: "PutRole",
+      I: PutRoleRequest,
+      O: PutRoleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc gastrolog.v1.SystemService.DeleteRole
+     */
+    deleteRole: {
+      name: "DeleteRole",
+      I: DeleteRoleRequest,
+      O: DeleteRoleResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
 import { CloudService, NodeStorageConfig } from "./storage_pb.js";
 
 /**
@@ -216,6 +216,16 @@ export class GetSystemResponse extends Message<GetSystemResponse> {
    */
   tiers: TierConfig[] = [];
 
+  /**
+   * @generated from field: repeated gastrolog.v1.AlertRuleConfig alert_rules = 13;
+   */
+  alertRules: AlertRuleConfig[] = [];
+
+  /**
+   * @generated from field: repeated gastrolog.v1.RoleConfig roles = 14;
+   */
+  roles: RoleConfig[] = [];
+
   constructor(data?: PartialMessage<GetSystemResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -236,6 +246,8 @@ export class GetSystemResponse extends Message<GetSystemResponse> {
     { no: 10, name: "cloud_services", kind: "message", T: CloudService, repeated: true },
     { no: 11, name: "node_storage_configs", kind: "message", T: NodeStorageConfig, repeated: true },
     { no: 12, name: "tiers", kind: "message", T: TierConfig, repeated: true },
+    { no: 13, name: "alert_rules", kind: "message", T: AlertRuleConfig, repeated: true },
+    { no: 14, name: "roles", kind: "message", T: RoleConfig, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSystemResponse {
@@ -601,6 +613,13 @@ export class RouteConfig extends Message<RouteConfig> {
    */
   ejectOnly = false;
 
+  /**
+   * ingest pipeline, applied in order to every matched record
+   *
+   * @generated from field: repeated gastrolog.v1.RouteStage stages = 8;
+   */
+  stages: RouteStage[] = [];
+
   constructor(data?: PartialMessage<RouteConfig>) {
     super();
     proto3.util.initPartial(data, this);
@@ -616,6 +635,7 @@ export class RouteConfig extends Message<RouteConfig> {
     { no: 5, name: "distribution", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 7, name: "eject_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "stages", kind: "message", T: RouteStage, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RouteConfig {
@@ -635,6 +655,55 @@ export class RouteConfig extends Message<RouteConfig> {
   }
 }
 
+/**
+ * RouteStage is one step of a route's ingest pipeline.
+ *
+ * @generated from message gastrolog.v1.RouteStage
+ */
+export class RouteStage extends Message<RouteStage> {
+  /**
+   * "regex", "grok", "set", "rename", "delete", "hash", "mask", "drop"
+   *
+   * @generated from field: string type = 1;
+   */
+  type = "";
+
+  /**
+   * type-specific parameters
+   *
+   * @generated from field: map<string, string> params = 2;
+   */
+  params: { [key: string]: string } = {};
+
+  constructor(data?: PartialMessage<RouteStage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.RouteStage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "params", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RouteStage {
+    return new RouteStage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RouteStage {
+    return new RouteStage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RouteStage {
+    return new RouteStage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RouteStage | PlainMessage<RouteStage> | undefined, b: RouteStage | PlainMessage<RouteStage> | undefined): boolean {
+    return proto3.util.equals(RouteStage, a, b);
+  }
+}
+
 /**
  * @generated from message gastrolog.v1.IngesterConfig
  */
@@ -1730,305 +1799,1196 @@ export class PutVaultRequest extends Message<PutVaultRequest> {
    */
   config?: VaultConfig;
 
-  constructor(data?: PartialMessage<PutVaultRequest>) {
+  constructor(data?: PartialMessage<PutVaultRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.PutVaultRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "config", kind: "message", T: VaultConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PutVaultRequest {
+    return new PutVaultRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PutVaultRequest {
+    return new PutVaultRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PutVaultRequest {
+    return new PutVaultRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PutVaultRequest | PlainMessage<PutVaultRequest> | undefined, b: PutVaultRequest | PlainMessage<PutVaultRequest> | undefined): boolean {
+    return proto3.util.equals(PutVaultRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.PutVaultResponse
+ */
+export class PutVaultResponse extends Message<PutVaultResponse> {
+  /**
+   * @generated from field: gastrolog.v1.GetSystemResponse system = 1;
+   */
+  system?: GetSystemResponse;
+
+  constructor(data?: PartialMessage<PutVaultResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.PutVaultResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "system", kind: "message", T: GetSystemResponse },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PutVaultResponse {
+    return new PutVaultResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PutVaultResponse {
+    return new PutVaultResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PutVaultResponse {
+    return new PutVaultResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PutVaultResponse | PlainMessage<PutVaultResponse> | undefined, b: PutVaultResponse | PlainMessage<PutVaultResponse> | undefined): boolean {
+    return proto3.util.equals(PutVaultResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.DeleteVaultRequest
+ */
+export class DeleteVaultRequest extends Message<DeleteVaultRequest> {
+  /**
+   * @generated from field: bytes id = 1;
+   */
+  id = new Uint8Array(0);
+
+  /**
+   * @generated from field: bool force = 2;
+   */
+  force = false;
+
+  /**
+   * When true, the vault's data directory is also deleted from disk.
+   * Only meaningful for file vaults. Defaults to false (preserve data).
+   *
+   * @generated from field: bool delete_data = 3;
+   */
+  deleteData = false;
+
+  constructor(data?: PartialMessage<DeleteVaultRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.DeleteVaultRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "force", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "delete_data", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteVaultRequest {
+    return new DeleteVaultRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteVaultRequest {
+    return new DeleteVaultRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteVaultRequest {
+    return new DeleteVaultRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteVaultRequest | PlainMessage<DeleteVaultRequest> | undefined, b: DeleteVaultRequest | PlainMessage<DeleteVaultRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteVaultRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.DeleteVaultResponse
+ */
+export class DeleteVaultResponse extends Message<DeleteVaultResponse> {
+  /**
+   * @generated from field: gastrolog.v1.GetSystemResponse system = 1;
+   */
+  system?: GetSystemResponse;
+
+  constructor(data?: PartialMessage<DeleteVaultResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.DeleteVaultResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "system", kind: "message", T: GetSystemResponse },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteVaultResponse {
+    return new DeleteVaultResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteVaultResponse {
+    return new DeleteVaultResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteVaultResponse {
+    return new DeleteVaultResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteVaultResponse | PlainMessage<DeleteVaultResponse> | undefined, b: DeleteVaultResponse | PlainMessage<DeleteVaultResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteVaultResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.PutRouteRequest
+ */
+export class PutRouteRequest extends Message<PutRouteRequest> {
+  /**
+   * @generated from field: gastrolog.v1.RouteConfig config = 1;
+   */
+  config?: RouteConfig;
+
+  constructor(data?: PartialMessage<PutRouteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.PutRouteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "config", kind: "message", T: RouteConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PutRouteRequest {
+    return new PutRouteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PutRouteRequest {
+    return new PutRouteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PutRouteRequest {
+    return new PutRouteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PutRouteRequest | PlainMessage<PutRouteRequest> | undefined, b: PutRouteRequest | PlainMessage<PutRouteRequest> | undefined): boolean {
+    return proto3.util.equals(PutRouteRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.PutRouteResponse
+ */
+export class PutRouteResponse extends Message<PutRouteResponse> {
+  /**
+   * @generated from field: gastrolog.v1.GetSystemResponse system = 1;
+   */
+  system?: GetSystemResponse;
+
+  constructor(data?: PartialMessage<PutRouteResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.PutRouteResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "system", kind: "message", T: GetSystemResponse },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PutRouteResponse {
+    return new PutRouteResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PutRouteResponse {
+    return new PutRouteResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PutRouteResponse {
+    return new PutRouteResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PutRouteResponse | PlainMessage<PutRouteResponse> | undefined, b: PutRouteResponse | PlainMessage<PutRouteResponse> | undefined): boolean {
+    return proto3.util.equals(PutRouteResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.DeleteRouteRequest
+ */
+export class DeleteRouteRequest extends Message<DeleteRouteRequest> {
+  /**
+   * @generated from field: bytes id = 1;
+   */
+  id = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<DeleteRouteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.DeleteRouteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRouteRequest {
+    return new DeleteRouteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRouteRequest {
+    return new DeleteRouteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRouteRequest {
+    return new DeleteRouteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteRouteRequest | PlainMessage<DeleteRouteRequest> | undefined, b: DeleteRouteRequest | PlainMessage<DeleteRouteRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteRouteRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.DeleteRouteResponse
+ */
+export class DeleteRouteResponse extends Message<DeleteRouteResponse> {
+  /**
+   * @generated from field: gastrolog.v1.GetSystemResponse system = 1;
+   */
+  system?: GetSystemResponse;
+
+  constructor(data?: PartialMessage<DeleteRouteResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.DeleteRouteResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "system", kind: "message", T: GetSystemResponse },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRouteResponse {
+    return new DeleteRouteResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRouteResponse {
+    return new DeleteRouteResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRouteResponse {
+    return new DeleteRouteResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteRouteResponse | PlainMessage<DeleteRouteResponse> | undefined, b: DeleteRouteResponse | PlainMessage<DeleteRouteResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteRouteResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.PreviewRoutePipelineRequest
+ */
+export class PreviewRoutePipelineRequest extends Message<PreviewRoutePipelineRequest> {
+  /**
+   * @generated from field: repeated gastrolog.v1.RouteStage stages = 1;
+   */
+  stages: RouteStage[] = [];
+
+  /**
+   * sample raw messages, one record per line
+   *
+   * @generated from field: repeated string lines = 2;
+   */
+  lines: string[] = [];
+
+  /**
+   * attributes set on every sample before the first stage
+   *
+   * @generated from field: map<string, string> attrs = 3;
+   */
+  attrs: { [key: string]: string } = {};
+
+  constructor(data?: PartialMessage<PreviewRoutePipelineRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.PreviewRoutePipelineRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stages", kind: "message", T: RouteStage, repeated: true },
+    { no: 2, name: "lines", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "attrs", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PreviewRoutePipelineRequest {
+    return new PreviewRoutePipelineRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PreviewRoutePipelineRequest {
+    return new PreviewRoutePipelineRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PreviewRoutePipelineRequest {
+    return new PreviewRoutePipelineRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PreviewRoutePipelineRequest | PlainMessage<PreviewRoutePipelineRequest> | undefined, b: PreviewRoutePipelineRequest | PlainMessage<PreviewRoutePipelineRequest> | undefined): boolean {
+    return proto3.util.equals(PreviewRoutePipelineRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.PreviewRoutePipelineResponse
+ */
+export class PreviewRoutePipelineResponse extends Message<PreviewRoutePipelineResponse> {
+  /**
+   * one per input line, in order
+   *
+   * @generated from field: repeated gastrolog.v1.RoutePipelineResult results = 1;
+   */
+  results: RoutePipelineResult[] = [];
+
+  /**
+   * non-empty when the stages are invalid
+   *
+   * @generated from field: string error = 2;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<PreviewRoutePipelineResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.PreviewRoutePipelineResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "results", kind: "message", T: RoutePipelineResult, repeated: true },
+    { no: 2, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PreviewRoutePipelineResponse {
+    return new PreviewRoutePipelineResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PreviewRoutePipelineResponse {
+    return new PreviewRoutePipelineResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PreviewRoutePipelineResponse {
+    return new PreviewRoutePipelineResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PreviewRoutePipelineResponse | PlainMessage<PreviewRoutePipelineResponse> | undefined, b: PreviewRoutePipelineResponse | PlainMessage<PreviewRoutePipelineResponse> | undefined): boolean {
+    return proto3.util.equals(PreviewRoutePipelineResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.RoutePipelineResult
+ */
+export class RoutePipelineResult extends Message<RoutePipelineResult> {
+  /**
+   * a drop stage discarded the record
+   *
+   * @generated from field: bool dropped = 1;
+   */
+  dropped = false;
+
+  /**
+   * raw message after the pipeline
+   *
+   * @generated from field: string raw = 2;
+   */
+  raw = "";
+
+  /**
+   * attributes after the pipeline
+   *
+   * @generated from field: map<string, string> attrs = 3;
+   */
+  attrs: { [key: string]: string } = {};
+
+  constructor(data?: PartialMessage<RoutePipelineResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.RoutePipelineResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "dropped", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "raw", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "attrs", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoutePipelineResult {
+    return new RoutePipelineResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoutePipelineResult {
+    return new RoutePipelineResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoutePipelineResult {
+    return new RoutePipelineResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RoutePipelineResult | PlainMessage<RoutePipelineResult> | undefined, b: RoutePipelineResult | PlainMessage<RoutePipelineResult> | undefined): boolean {
+    return proto3.util.equals(RoutePipelineResult, a, b);
+  }
+}
+
+/**
+ * AlertCondition compares a value derived from the query result against a
+ * threshold. An empty column means the row count (records, or table rows for
+ * an aggregating pipeline); otherwise the rule fires if any table row's
+ * column value satisfies the comparison.
+ *
+ * @generated from message gastrolog.v1.AlertCondition
+ */
+export class AlertCondition extends Message<AlertCondition> {
+  /**
+   * @generated from field: string column = 1;
+   */
+  column = "";
+
+  /**
+   * ">", ">=", "<", "<=", "==", "!="
+   *
+   * @generated from field: string op = 2;
+   */
+  op = "";
+
+  /**
+   * @generated from field: double threshold = 3;
+   */
+  threshold = 0;
+
+  constructor(data?: PartialMessage<AlertCondition>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.AlertCondition";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "op", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "threshold", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertCondition {
+    return new AlertCondition().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AlertCondition {
+    return new AlertCondition().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AlertCondition {
+    return new AlertCondition().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AlertCondition | PlainMessage<AlertCondition> | undefined, b: AlertCondition | PlainMessage<AlertCondition> | undefined): boolean {
+    return proto3.util.equals(AlertCondition, a, b);
+  }
+}
+
+/**
+ * AlertSink is a notification target. Params are type-specific:
+ *   webhook: url, header.<Name>
+ *   smtp:    addr, from, to (comma-separated), username, password
+ *   vault:   vault_id
+ *
+ * @generated from message gastrolog.v1.AlertSink
+ */
+export class AlertSink extends Message<AlertSink> {
+  /**
+   * "webhook", "smtp", or "vault"
+   *
+   * @generated from field: string type = 1;
+   */
+  type = "";
+
+  /**
+   * @generated from field: map<string, string> params = 2;
+   */
+  params: { [key: string]: string } = {};
+
+  constructor(data?: PartialMessage<AlertSink>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.AlertSink";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "params", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertSink {
+    return new AlertSink().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AlertSink {
+    return new AlertSink().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AlertSink {
+    return new AlertSink().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AlertSink | PlainMessage<AlertSink> | undefined, b: AlertSink | PlainMessage<AlertSink> | undefined): boolean {
+    return proto3.util.equals(AlertSink, a, b);
+  }
+}
+
+/**
+ * AlertRuleConfig is a saved search evaluated on a cron schedule.
+ *
+ * @generated from message gastrolog.v1.AlertRuleConfig
+ */
+export class AlertRuleConfig extends Message<AlertRuleConfig> {
+  /**
+   * @generated from field: bytes id = 1;
+   */
+  id = new Uint8Array(0);
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string query = 3;
+   */
+  query = "";
+
+  /**
+   * 5- or 6-field cron expression
+   *
+   * @generated from field: string schedule = 4;
+   */
+  schedule = "";
+
+  /**
+   * @generated from field: gastrolog.v1.AlertCondition condition = 5;
+   */
+  condition?: AlertCondition;
+
+  /**
+   * "warning" (default) or "error"
+   *
+   * @generated from field: string severity = 6;
+   */
+  severity = "";
+
+  /**
+   * @generated from field: repeated gastrolog.v1.AlertSink sinks = 7;
+   */
+  sinks: AlertSink[] = [];
+
+  /**
+   * @generated from field: bool enabled = 8;
+   */
+  enabled = false;
+
+  constructor(data?: PartialMessage<AlertRuleConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.AlertRuleConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "schedule", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "condition", kind: "message", T: AlertCondition },
+    { no: 6, name: "severity", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "sinks", kind: "message", T: AlertSink, repeated: true },
+    { no: 8, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertRuleConfig {
+    return new AlertRuleConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AlertRuleConfig {
+    return new AlertRuleConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AlertRuleConfig {
+    return new AlertRuleConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AlertRuleConfig | PlainMessage<AlertRuleConfig> | undefined, b: AlertRuleConfig | PlainMessage<AlertRuleConfig> | undefined): boolean {
+    return proto3.util.equals(AlertRuleConfig, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.PutAlertRuleRequest
+ */
+export class PutAlertRuleRequest extends Message<PutAlertRuleRequest> {
+  /**
+   * @generated from field: gastrolog.v1.AlertRuleConfig config = 1;
+   */
+  config?: AlertRuleConfig;
+
+  constructor(data?: PartialMessage<PutAlertRuleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.PutAlertRuleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "config", kind: "message", T: AlertRuleConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PutAlertRuleRequest {
+    return new PutAlertRuleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PutAlertRuleRequest {
+    return new PutAlertRuleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PutAlertRuleRequest {
+    return new PutAlertRuleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PutAlertRuleRequest | PlainMessage<PutAlertRuleRequest> | undefined, b: PutAlertRuleRequest | PlainMessage<PutAlertRuleRequest> | undefined): boolean {
+    return proto3.util.equals(PutAlertRuleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.PutAlertRuleResponse
+ */
+export class PutAlertRuleResponse extends Message<PutAlertRuleResponse> {
+  /**
+   * @generated from field: gastrolog.v1.GetSystemResponse system = 1;
+   */
+  system?: GetSystemResponse;
+
+  constructor(data?: PartialMessage<PutAlertRuleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.PutAlertRuleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "system", kind: "message", T: GetSystemResponse },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PutAlertRuleResponse {
+    return new PutAlertRuleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PutAlertRuleResponse {
+    return new PutAlertRuleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PutAlertRuleResponse {
+    return new PutAlertRuleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PutAlertRuleResponse | PlainMessage<PutAlertRuleResponse> | undefined, b: PutAlertRuleResponse | PlainMessage<PutAlertRuleResponse> | undefined): boolean {
+    return proto3.util.equals(PutAlertRuleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.DeleteAlertRuleRequest
+ */
+export class DeleteAlertRuleRequest extends Message<DeleteAlertRuleRequest> {
+  /**
+   * @generated from field: bytes id = 1;
+   */
+  id = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<DeleteAlertRuleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.DeleteAlertRuleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAlertRuleRequest {
+    return new DeleteAlertRuleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAlertRuleRequest {
+    return new DeleteAlertRuleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAlertRuleRequest {
+    return new DeleteAlertRuleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAlertRuleRequest | PlainMessage<DeleteAlertRuleRequest> | undefined, b: DeleteAlertRuleRequest | PlainMessage<DeleteAlertRuleRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteAlertRuleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.DeleteAlertRuleResponse
+ */
+export class DeleteAlertRuleResponse extends Message<DeleteAlertRuleResponse> {
+  /**
+   * @generated from field: gastrolog.v1.GetSystemResponse system = 1;
+   */
+  system?: GetSystemResponse;
+
+  constructor(data?: PartialMessage<DeleteAlertRuleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.DeleteAlertRuleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "system", kind: "message", T: GetSystemResponse },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAlertRuleResponse {
+    return new DeleteAlertRuleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAlertRuleResponse {
+    return new DeleteAlertRuleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAlertRuleResponse {
+    return new DeleteAlertRuleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAlertRuleResponse | PlainMessage<DeleteAlertRuleResponse> | undefined, b: DeleteAlertRuleResponse | PlainMessage<DeleteAlertRuleResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteAlertRuleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message gastrolog.v1.GetAlertRuleStatusRequest
+ */
+export class GetAlertRuleStatusRequest extends Message<GetAlertRuleStatusRequest> {
+  constructor(data?: PartialMessage<GetAlertRuleStatusRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.GetAlertRuleStatusRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAlertRuleStatusRequest {
+    return new GetAlertRuleStatusRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAlertRuleStatusRequest {
+    return new GetAlertRuleStatusRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAlertRuleStatusRequest {
+    return new GetAlertRuleStatusRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAlertRuleStatusRequest | PlainMessage<GetAlertRuleStatusRequest> | undefined, b: GetAlertRuleStatusRequest | PlainMessage<GetAlertRuleStatusRequest> | undefined): boolean {
+    return proto3.util.equals(GetAlertRuleStatusRequest, a, b);
+  }
+}
+
+/**
+ * AlertRuleStatus is the leader's view of a rule's most recent evaluation.
+ *
+ * @generated from message gastrolog.v1.AlertRuleStatus
+ */
+export class AlertRuleStatus extends Message<AlertRuleStatus> {
+  /**
+   * @generated from field: bytes rule_id = 1;
+   */
+  ruleId = new Uint8Array(0);
+
+  /**
+   * "ok", "firing", or "error"
+   *
+   * @generated from field: string state = 2;
+   */
+  state = "";
+
+  /**
+   * value compared against the threshold
+   *
+   * @generated from field: double value = 3;
+   */
+  value = 0;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_evaluated = 4;
+   */
+  lastEvaluated?: Timestamp;
+
+  /**
+   * unset unless the rule is firing
+   *
+   * @generated from field: google.protobuf.Timestamp firing_since = 5;
+   */
+  firingSince?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_resolved = 6;
+   */
+  lastResolved?: Timestamp;
+
+  /**
+   * last evaluation error, if any
+   *
+   * @generated from field: string error = 7;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<AlertRuleStatus>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "gastrolog.v1.PutVaultRequest";
+  static readonly typeName = "gastrolog.v1.AlertRuleStatus";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "config", kind: "message", T: VaultConfig },
+    { no: 1, name: "rule_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "value", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "last_evaluated", kind: "message", T: Timestamp },
+    { no: 5, name: "firing_since", kind: "message", T: Timestamp },
+    { no: 6, name: "last_resolved", kind: "message", T: Timestamp },
+    { no: 7, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PutVaultRequest {
-    return new PutVaultRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertRuleStatus {
+    return new AlertRuleStatus().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PutVaultRequest {
-    return new PutVaultRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AlertRuleStatus {
+    return new AlertRuleStatus().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PutVaultRequest {
-    return new PutVaultRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AlertRuleStatus {
+    return new AlertRuleStatus().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PutVaultRequest | PlainMessage<PutVaultRequest> | undefined, b: PutVaultRequest | PlainMessage<PutVaultRequest> | undefined): boolean {
-    return proto3.util.equals(PutVaultRequest, a, b);
+  static equals(a: AlertRuleStatus | PlainMessage<AlertRuleStatus> | undefined, b: AlertRuleStatus | PlainMessage<AlertRuleStatus> | undefined): boolean {
+    return proto3.util.equals(AlertRuleStatus, a, b);
   }
 }
 
 /**
- * @generated from message gastrolog.v1.PutVaultResponse
+ * @generated from message gastrolog.v1.GetAlertRuleStatusResponse
  */
-export class PutVaultResponse extends Message<PutVaultResponse> {
+export class GetAlertRuleStatusResponse extends Message<GetAlertRuleStatusResponse> {
   /**
-   * @generated from field: gastrolog.v1.GetSystemResponse system = 1;
+   * @generated from field: repeated gastrolog.v1.AlertRuleStatus statuses = 1;
    */
-  system?: GetSystemResponse;
+  statuses: AlertRuleStatus[] = [];
 
-  constructor(data?: PartialMessage<PutVaultResponse>) {
+  constructor(data?: PartialMessage<GetAlertRuleStatusResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "gastrolog.v1.PutVaultResponse";
+  static readonly typeName = "gastrolog.v1.GetAlertRuleStatusResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "system", kind: "message", T: GetSystemResponse },
+    { no: 1, name: "statuses", kind: "message", T: AlertRuleStatus, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PutVaultResponse {
-    return new PutVaultResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAlertRuleStatusResponse {
+    return new GetAlertRuleStatusResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PutVaultResponse {
-    return new PutVaultResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAlertRuleStatusResponse {
+    return new GetAlertRuleStatusResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PutVaultResponse {
-    return new PutVaultResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAlertRuleStatusResponse {
+    return new GetAlertRuleStatusResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PutVaultResponse | PlainMessage<PutVaultResponse> | undefined, b: PutVaultResponse | PlainMessage<PutVaultResponse> | undefined): boolean {
-    return proto3.util.equals(PutVaultResponse, a, b);
+  static equals(a: GetAlertRuleStatusResponse | PlainMessage<GetAlertRuleStatusResponse> | undefined, b: GetAlertRuleStatusResponse | PlainMessage<GetAlertRuleStatusResponse> | undefined): boolean {
+    return proto3.util.equals(GetAlertRuleStatusResponse, a, b);
   }
 }
 
 /**
- * @generated from message gastrolog.v1.DeleteVaultRequest
+ * VaultGrant gives a role a set of actions on one vault.
+ *
+ * @generated from message gastrolog.v1.VaultGrant
  */
-export class DeleteVaultRequest extends Message<DeleteVaultRequest> {
-  /**
-   * @generated from field: bytes id = 1;
-   */
-  id = new Uint8Array(0);
-
+export class VaultGrant extends Message<VaultGrant> {
   /**
-   * @generated from field: bool force = 2;
+   * @generated from field: bytes vault_id = 1;
    */
-  force = false;
+  vaultId = new Uint8Array(0);
 
   /**
-   * When true, the vault's data directory is also deleted from disk.
-   * Only meaningful for file vaults. Defaults to false (preserve data).
+   * "search", "follow", "export"
    *
-   * @generated from field: bool delete_data = 3;
+   * @generated from field: repeated string actions = 2;
    */
-  deleteData = false;
+  actions: string[] = [];
 
-  constructor(data?: PartialMessage<DeleteVaultRequest>) {
+  constructor(data?: PartialMessage<VaultGrant>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "gastrolog.v1.DeleteVaultRequest";
+  static readonly typeName = "gastrolog.v1.VaultGrant";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
-    { no: 2, name: "force", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 3, name: "delete_data", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "vault_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "actions", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteVaultRequest {
-    return new DeleteVaultRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VaultGrant {
+    return new VaultGrant().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteVaultRequest {
-    return new DeleteVaultRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VaultGrant {
+    return new VaultGrant().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteVaultRequest {
-    return new DeleteVaultRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VaultGrant {
+    return new VaultGrant().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteVaultRequest | PlainMessage<DeleteVaultRequest> | undefined, b: DeleteVaultRequest | PlainMessage<DeleteVaultRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteVaultRequest, a, b);
+  static equals(a: VaultGrant | PlainMessage<VaultGrant> | undefined, b: VaultGrant | PlainMessage<VaultGrant> | undefined): boolean {
+    return proto3.util.equals(VaultGrant, a, b);
   }
 }
 
 /**
- * @generated from message gastrolog.v1.DeleteVaultResponse
+ * RoleConfig is a custom role assigned to users by name.
+ *
+ * @generated from message gastrolog.v1.RoleConfig
  */
-export class DeleteVaultResponse extends Message<DeleteVaultResponse> {
+export class RoleConfig extends Message<RoleConfig> {
   /**
-   * @generated from field: gastrolog.v1.GetSystemResponse system = 1;
+   * @generated from field: bytes id = 1;
    */
-  system?: GetSystemResponse;
+  id = new Uint8Array(0);
 
-  constructor(data?: PartialMessage<DeleteVaultResponse>) {
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: repeated gastrolog.v1.VaultGrant grants = 3;
+   */
+  grants: VaultGrant[] = [];
+
+  constructor(data?: PartialMessage<RoleConfig>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "gastrolog.v1.DeleteVaultResponse";
+  static readonly typeName = "gastrolog.v1.RoleConfig";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "system", kind: "message", T: GetSystemResponse },
+    { no: 1, name: "id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "grants", kind: "message", T: VaultGrant, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteVaultResponse {
-    return new DeleteVaultResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoleConfig {
+    return new RoleConfig().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteVaultResponse {
-    return new DeleteVaultResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoleConfig {
+    return new RoleConfig().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteVaultResponse {
-    return new DeleteVaultResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoleConfig {
+    return new RoleConfig().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteVaultResponse | PlainMessage<DeleteVaultResponse> | undefined, b: DeleteVaultResponse | PlainMessage<DeleteVaultResponse> | undefined): boolean {
-    return proto3.util.equals(DeleteVaultResponse, a, b);
+  static equals(a: RoleConfig | PlainMessage<RoleConfig> | undefined, b: RoleConfig | PlainMessage<RoleConfig> | undefined): boolean {
+    return proto3.util.equals(RoleConfig, a, b);
   }
 }
 
 /**
- * @generated from message gastrolog.v1.PutRouteRequest
+ * @generated from message gastrolog.v1.PutRoleRequest
  */
-export class PutRouteRequest extends Message<PutRouteRequest> {
+export class PutRoleRequest extends Message<PutRoleRequest> {
   /**
-   * @generated from field: gastrolog.v1.RouteConfig config = 1;
+   * @generated from field: gastrolog.v1.RoleConfig config = 1;
    */
-  config?: RouteConfig;
+  config?: RoleConfig;
 
-  constructor(data?: PartialMessage<PutRouteRequest>) {
+  constructor(data?: PartialMessage<PutRoleRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "gastrolog.v1.PutRouteRequest";
+  static readonly typeName = "gastrolog.v1.PutRoleRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "config", kind: "message", T: RouteConfig },
+    { no: 1, name: "config", kind: "message", T: RoleConfig },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PutRouteRequest {
-    return new PutRouteRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PutRoleRequest {
+    return new PutRoleRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PutRouteRequest {
-    return new PutRouteRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PutRoleRequest {
+    return new PutRoleRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PutRouteRequest {
-    return new PutRouteRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PutRoleRequest {
+    return new PutRoleRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PutRouteRequest | PlainMessage<PutRouteRequest> | undefined, b: PutRouteRequest | PlainMessage<PutRouteRequest> | undefined): boolean {
-    return proto3.util.equals(PutRouteRequest, a, b);
+  static equals(a: PutRoleRequest | PlainMessage<PutRoleRequest> | undefined, b: PutRoleRequest | PlainMessage<PutRoleRequest> | undefined): boolean {
+    return proto3.util.equals(PutRoleRequest, a, b);
   }
 }
 
 /**
- * @generated from message gastrolog.v1.PutRouteResponse
+ * @generated from message gastrolog.v1.PutRoleResponse
  */
-export class PutRouteResponse extends Message<PutRouteResponse> {
+export class PutRoleResponse extends Message<PutRoleResponse> {
   /**
    * @generated from field: gastrolog.v1.GetSystemResponse system = 1;
    */
   system?: GetSystemResponse;
 
-  constructor(data?: PartialMessage<PutRouteResponse>) {
+  constructor(data?: PartialMessage<PutRoleResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "gastrolog.v1.PutRouteResponse";
+  static readonly typeName = "gastrolog.v1.PutRoleResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "system", kind: "message", T: GetSystemResponse },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PutRouteResponse {
-    return new PutRouteResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PutRoleResponse {
+    return new PutRoleResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PutRouteResponse {
-    return new PutRouteResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PutRoleResponse {
+    return new PutRoleResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PutRouteResponse {
-    return new PutRouteResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PutRoleResponse {
+    return new PutRoleResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PutRouteResponse | PlainMessage<PutRouteResponse> | undefined, b: PutRouteResponse | PlainMessage<PutRouteResponse> | undefined): boolean {
-    return proto3.util.equals(PutRouteResponse, a, b);
+  static equals(a: PutRoleResponse | PlainMessage<PutRoleResponse> | undefined, b: PutRoleResponse | PlainMessage<PutRoleResponse> | undefined): boolean {
+    return proto3.util.equals(PutRoleResponse, a, b);
   }
 }
 
 /**
- * @generated from message gastrolog.v1.DeleteRouteRequest
+ * @generated from message gastrolog.v1.DeleteRoleRequest
  */
-export class DeleteRouteRequest extends Message<DeleteRouteRequest> {
+export class DeleteRoleRequest extends Message<DeleteRoleRequest> {
   /**
    * @generated from field: bytes id = 1;
    */
   id = new Uint8Array(0);
 
-  constructor(data?: PartialMessage<DeleteRouteRequest>) {
+  constructor(data?: PartialMessage<DeleteRoleRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "gastrolog.v1.DeleteRouteRequest";
+  static readonly typeName = "gastrolog.v1.DeleteRoleRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRouteRequest {
-    return new DeleteRouteRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRoleRequest {
+    return new DeleteRoleRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRouteRequest {
-    return new DeleteRouteRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRoleRequest {
+    return new DeleteRoleRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRouteRequest {
-    return new DeleteRouteRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRoleRequest {
+    return new DeleteRoleRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteRouteRequest | PlainMessage<DeleteRouteRequest> | undefined, b: DeleteRouteRequest | PlainMessage<DeleteRouteRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteRouteRequest, a, b);
+  static equals(a: DeleteRoleRequest | PlainMessage<DeleteRoleRequest> | undefined, b: DeleteRoleRequest | PlainMessage<DeleteRoleRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteRoleRequest, a, b);
   }
 }
 
 /**
- * @generated from message gastrolog.v1.DeleteRouteResponse
+ * @generated from message gastrolog.v1.DeleteRoleResponse
  */
-export class DeleteRouteResponse extends Message<DeleteRouteResponse> {
+export class DeleteRoleResponse extends Message<DeleteRoleResponse> {
   /**
    * @generated from field: gastrolog.v1.GetSystemResponse system = 1;
    */
   system?: GetSystemResponse;
 
-  constructor(data?: PartialMessage<DeleteRouteResponse>) {
+  constructor(data?: PartialMessage<DeleteRoleResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "gastrolog.v1.DeleteRouteResponse";
+  static readonly typeName = "gastrolog.v1.DeleteRoleResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "system", kind: "message", T: GetSystemResponse },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRouteResponse {
-    return new DeleteRouteResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRoleResponse {
+    return new DeleteRoleResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRouteResponse {
-    return new DeleteRouteResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRoleResponse {
+    return new DeleteRoleResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRouteResponse {
-    return new DeleteRouteResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRoleResponse {
+    return new DeleteRoleResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteRouteResponse | PlainMessage<DeleteRouteResponse> | undefined, b: DeleteRouteResponse | PlainMessage<DeleteRouteResponse> | undefined): boolean {
-    return proto3.util.equals(DeleteRouteResponse, a, b);
+  static equals(a: DeleteRoleResponse | PlainMessage<DeleteRoleResponse> | undefined, b: DeleteRoleResponse | PlainMessage<DeleteRoleResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteRoleResponse, a, b);
   }
 }
 
@@ -2381,6 +3341,11 @@ export class AuthSettings extends Message<AuthSettings> {
    */
   passwordPolicy?: PasswordPolicySettings;
 
+  /**
+   * @generated from field: gastrolog.v1.OIDCSettings oidc = 5;
+   */
+  oidc?: OIDCSettings;
+
   constructor(data?: PartialMessage<AuthSettings>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2393,6 +3358,7 @@ export class AuthSettings extends Message<AuthSettings> {
     { no: 2, name: "jwt_secret_configured", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 3, name: "refresh_token_duration", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "password_policy", kind: "message", T: PasswordPolicySettings },
+    { no: 5, name: "oidc", kind: "message", T: OIDCSettings },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuthSettings {
@@ -2412,6 +3378,113 @@ export class AuthSettings extends Message<AuthSettings> {
   }
 }
 
+/**
+ * @generated from message gastrolog.v1.OIDCSettings
+ */
+export class OIDCSettings extends Message<OIDCSettings> {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled = false;
+
+  /**
+   * @generated from field: string issuer_url = 2;
+   */
+  issuerUrl = "";
+
+  /**
+   * @generated from field: string client_id = 3;
+   */
+  clientId = "";
+
+  /**
+   * read-only
+   *
+   * @generated from field: bool client_secret_configured = 4;
+   */
+  clientSecretConfigured = false;
+
+  /**
+   * @generated from field: string redirect_url = 5;
+   */
+  redirectUrl = "";
+
+  /**
+   * space-separated, requested in addition to "openid"
+   *
+   * @generated from field: string scopes = 6;
+   */
+  scopes = "";
+
+  /**
+   * @generated from field: string username_claim = 7;
+   */
+  usernameClaim = "";
+
+  /**
+   * @generated from field: string groups_claim = 8;
+   */
+  groupsClaim = "";
+
+  /**
+   * "group=role,group=role", first match wins
+   *
+   * @generated from field: string role_mappings = 9;
+   */
+  roleMappings = "";
+
+  /**
+   * role when no group matches; empty = deny login
+   *
+   * @generated from field: string default_role = 10;
+   */
+  defaultRole = "";
+
+  /**
+   * only populated when include_secrets
+   *
+   * @generated from field: string client_secret = 11;
+   */
+  clientSecret = "";
+
+  constructor(data?: PartialMessage<OIDCSettings>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.OIDCSettings";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "issuer_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "client_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "client_secret_configured", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "redirect_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "scopes", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "username_claim", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "groups_claim", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "role_mappings", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "default_role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "client_secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OIDCSettings {
+    return new OIDCSettings().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OIDCSettings {
+    return new OIDCSettings().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OIDCSettings {
+    return new OIDCSettings().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OIDCSettings | PlainMessage<OIDCSettings> | undefined, b: OIDCSettings | PlainMessage<OIDCSettings> | undefined): boolean {
+    return proto3.util.equals(OIDCSettings, a, b);
+  }
+}
+
 /**
  * @generated from message gastrolog.v1.QuerySettings
  */
@@ -3370,6 +4443,11 @@ export class PutAuthSettings extends Message<PutAuthSettings> {
    */
   passwordPolicy?: PutPasswordPolicySettings;
 
+  /**
+   * @generated from field: gastrolog.v1.PutOIDCSettings oidc = 4;
+   */
+  oidc?: PutOIDCSettings;
+
   constructor(data?: PartialMessage<PutAuthSettings>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3381,6 +4459,7 @@ export class PutAuthSettings extends Message<PutAuthSettings> {
     { no: 1, name: "token_duration", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 2, name: "refresh_token_duration", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 3, name: "password_policy", kind: "message", T: PutPasswordPolicySettings },
+    { no: 4, name: "oidc", kind: "message", T: PutOIDCSettings },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PutAuthSettings {
@@ -3400,6 +4479,101 @@ export class PutAuthSettings extends Message<PutAuthSettings> {
   }
 }
 
+/**
+ * @generated from message gastrolog.v1.PutOIDCSettings
+ */
+export class PutOIDCSettings extends Message<PutOIDCSettings> {
+  /**
+   * @generated from field: optional bool enabled = 1;
+   */
+  enabled?: boolean;
+
+  /**
+   * @generated from field: optional string issuer_url = 2;
+   */
+  issuerUrl?: string;
+
+  /**
+   * @generated from field: optional string client_id = 3;
+   */
+  clientId?: string;
+
+  /**
+   * @generated from field: optional string client_secret = 4;
+   */
+  clientSecret?: string;
+
+  /**
+   * @generated from field: optional string redirect_url = 5;
+   */
+  redirectUrl?: string;
+
+  /**
+   * space-separated
+   *
+   * @generated from field: optional string scopes = 6;
+   */
+  scopes?: string;
+
+  /**
+   * @generated from field: optional string username_claim = 7;
+   */
+  usernameClaim?: string;
+
+  /**
+   * @generated from field: optional string groups_claim = 8;
+   */
+  groupsClaim?: string;
+
+  /**
+   * "group=role,group=role"; replaces the list
+   *
+   * @generated from field: optional string role_mappings = 9;
+   */
+  roleMappings?: string;
+
+  /**
+   * @generated from field: optional string default_role = 10;
+   */
+  defaultRole?: string;
+
+  constructor(data?: PartialMessage<PutOIDCSettings>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gastrolog.v1.PutOIDCSettings";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 2, name: "issuer_url", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "client_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "client_secret", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "redirect_url", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "scopes", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "username_claim", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 8, name: "groups_claim", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 9, name: "role_mappings", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 10, name: "default_role", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PutOIDCSettings {
+    return new PutOIDCSettings().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PutOIDCSettings {
+    return new PutOIDCSettings().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PutOIDCSettings {
+    return new PutOIDCSettings().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PutOIDCSettings | PlainMessage<PutOIDCSettings> | undefined, b: PutOIDCSettings | PlainMessage<PutOIDCSettings> | undefined): boolean {
+    return proto3.util.equals(PutOIDCSettings, a, b);
+  }
+}
+
 /**
  * @generated from message gastrolog.v1.PutQuerySettings
  */
@@ -6203,6 +7377,13 @@ export class PerRouteStats extends Message<PerRouteStats> {
    */
   recordsForwarded = protoInt64.zero;
 
+  /**
+   * records discarded by the route's pipeline
+   *
+   * @generated from field: int64 records_dropped = 4;
+   */
+  recordsDropped = protoInt64.zero;
+
   constructor(data?: PartialMessage<PerRouteStats>) {
     super();
     proto3.util.initPartial(data, this);
@@ -6214,6 +7395,7 @@ export class PerRouteStats extends Message<PerRouteStats> {
     { no: 1, name: "route_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
     { no: 2, name: "records_matched", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 3, name: "records_forwarded", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "records_dropped", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PerRouteStats {
@@ -27,6 +27,8 @@ export { useCertificates } from "./useCertificates";
 export {
   useAuthStatus,
   useLogin,
+  useBeginOIDCLogin,
+  useCompleteOIDCLogin,
   useRegister,
   useLogout,
   useCurrentUser,
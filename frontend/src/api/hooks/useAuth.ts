@@ -35,6 +35,35 @@ export function useLogin() {
   });
 }
 
+/** Starts single sign-on: the browser is sent to the identity provider. */
+export function useBeginOIDCLogin() {
+  return useMutation({
+    mutationFn: async () => {
+      const res = await authClient.beginOIDCLogin({});
+      globalThis.location.assign(res.authorizationUrl);
+    },
+  });
+}
+
+/** Finishes single sign-on with the code and state from the IdP redirect. */
+export function useCompleteOIDCLogin() {
+  const qc = useQueryClient();
+  return useMutation({
+    mutationFn: async (args: { code: string; state: string }) => {
+      return authClient.completeOIDCLogin(args);
+    },
+    onSuccess: (data) => {
+      if (data.token) {
+        setToken(data.token.token);
+      }
+      setRefreshToken(data.refreshToken);
+      qc.invalidateQueries({ queryKey: ["authStatus"] });
+      qc.invalidateQueries({ queryKey: ["settings"] });
+      qc.invalidateQueries({ queryKey: ["system"] });
+    },
+  });
+}
+
 export function useRegister() {
   const qc = useQueryClient();
   return useMutation({
@@ -132,7 +132,7 @@ export function useTestIngester() {
  * Calls TestIngester on the server with debounce whenever params change.
  */
 export function useCheckListenAddrs(type: string, params: Record<string, string>, id: string) {
-  const LISTENER_TYPES = new Set(["syslog", "http", "fluentfwd", "otlp", "relp"]);
+  const LISTENER_TYPES = new Set(["syslog", "http", "fluentfwd", "otlp", "relp", "gelf"]);
   const isListener = LISTENER_TYPES.has(type);
 
   // Build a stable key from the address-relevant params.
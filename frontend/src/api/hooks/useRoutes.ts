@@ -1,6 +1,7 @@
 import { systemClient } from "../client";
 import { useSystemMutation } from "./useSystem";
 import { decode } from "../glid";
+import type { RouteStage } from "../gen/gastrolog/v1/system_pb";
 
 export function usePutRoute() {
   return useSystemMutation(
@@ -12,6 +13,7 @@ export function usePutRoute() {
       distribution: string;
       enabled: boolean;
       ejectOnly: boolean;
+      stages?: RouteStage[];
     }) => {
       return systemClient.putRoute({
         config: {
@@ -22,6 +24,7 @@ export function usePutRoute() {
           distribution: args.distribution,
           enabled: args.enabled,
           ejectOnly: args.ejectOnly,
+          stages: args.stages,
         },
       });
     },
@@ -26,6 +26,18 @@ type ServiceAuth = {
     maxConsecutiveRepeats?: number;
     forbidAnimalNoise?: boolean;
   };
+  oidc?: {
+    enabled?: boolean;
+    issuerUrl?: string;
+    clientId?: string;
+    clientSecret?: string;
+    redirectUrl?: string;
+    scopes?: string;
+    usernameClaim?: string;
+    groupsClaim?: string;
+    roleMappings?: string;
+    defaultRole?: string;
+  };
 };
 
 type ServiceQuery = {
@@ -115,6 +127,7 @@ function buildAuthReq(auth: ServiceAuth): Record<string, unknown> {
   if (auth.tokenDuration !== undefined) req.tokenDuration = auth.tokenDuration;
   if (auth.refreshTokenDuration !== undefined) req.refreshTokenDuration = auth.refreshTokenDuration;
   if (auth.passwordPolicy) req.passwordPolicy = auth.passwordPolicy;
+  if (auth.oidc) req.oidc = auth.oidc;
   return req;
 }
 
@@ -1,6 +1,7 @@
 import { useState } from "react";
 import type { TableResult, TableRow } from "../api/client";
 import { useThemeClass } from "../hooks/useThemeClass";
+import { chartTable } from "../utils/chartTable";
 import { tableResultToHistogramData } from "../utils/histogramData";
 import { AutoRefreshControls } from "./AutoRefreshControls";
 import { BarChart } from "./charts/BarChart";
@@ -144,14 +145,14 @@ function PipelineResultBody({
   if (resultType === "barchart" && viewMode === "chart") {
     return (
       <div className="px-5 py-4">
-        <BarChart columns={columns} rows={rowData} dark={dark} />
+        <BarChart {...chartTable(columns, rowData)} dark={dark} />
       </div>
     );
   }
   if (resultType === "donut" && viewMode === "chart") {
     return (
       <div className="px-5 py-4">
-        <DonutChart columns={columns} rows={rowData} dark={dark} />
+        <DonutChart {...chartTable(columns, rowData)} dark={dark} />
       </div>
     );
   }
@@ -2,7 +2,7 @@ import { useState, useEffect, useRef, useReducer } from "react";
 import { useNavigate } from "@tanstack/react-router";
 import { useThemeClass } from "../../hooks/useThemeClass";
 import { SpinnerIcon } from "../icons";
-import { useAuthStatus, useLogin, useRegister, useSettings } from "../../api/hooks";
+import { useAuthStatus, useBeginOIDCLogin, useLogin, useRegister, useSettings } from "../../api/hooks";
 import { setToken } from "../../api/client";
 import { AuthFormField } from "./AuthFormField";
 import { PasswordRules } from "./PasswordRules";
@@ -57,6 +57,7 @@ export function AuthPage({ mode }: Readonly<AuthPageProps>) {
   const authStatus = useAuthStatus();
   const login = useLogin();
   const register = useRegister();
+  const beginOIDC = useBeginOIDCLogin();
   const { data: settings } = useSettings();
 
   const [form, dispatch] = useReducer(authFormReducer, authFormInitial);
@@ -105,7 +106,8 @@ export function AuthPage({ mode }: Readonly<AuthPageProps>) {
 
   const c = useThemeClass(dark);
   const isRegister = mode === "register";
-  const isPending = login.isPending || register.isPending;
+  const isPending = login.isPending || register.isPending || beginOIDC.isPending;
+  const showSSO = !isRegister && authStatus.data?.oidcEnabled;
   const mismatch =
     isRegister && password.length > 0 && password !== confirmPassword;
 
@@ -135,6 +137,15 @@ export function AuthPage({ mode }: Readonly<AuthPageProps>) {
     }
   };
 
+  const handleSSO = async () => {
+    setError("");
+    try {
+      await beginOIDC.mutateAsync();
+    } catch (err) {
+      setError(connectMessage(err, "Single sign-on is unavailable."));
+    }
+  };
+
   // While loading auth status, show nothing (avoids flicker).
   if (authStatus.isLoading) {
     return <div className={`h-full ${c("bg-ink", "bg-light-bg")}`} />;
@@ -238,6 +249,23 @@ export function AuthPage({ mode }: Readonly<AuthPageProps>) {
             {isPending && <SpinnerIcon className="animate-spin h-4 w-4" />}
             {isRegister ? "Create Account" : "Sign In"}
           </button>
+
+          {showSSO && (
+            <button
+              type="button"
+              onClick={handleSSO}
+              disabled={isPending}
+              className={`px-4 py-2.5 text-[0.85em] font-medium rounded border transition-all duration-200 flex items-center justify-center gap-2 ${
+                isPending ? "opacity-60 cursor-not-allowed" : "hover:brightness-110 active:scale-[0.98]"
+              } ${c(
+                "border-ink-border text-text-bright",
+                "border-light-border text-light-text-bright",
+              )}`}
+            >
+              {beginOIDC.isPending && <SpinnerIcon className="animate-spin h-4 w-4" />}
+              Sign in with SSO
+            </button>
+          )}
         </form>
       </div>
     </div>
@@ -0,0 +1,76 @@
+import { useEffect, useRef, useState } from "react";
+import { useNavigate } from "@tanstack/react-router";
+import { useThemeClass } from "../../hooks/useThemeClass";
+import { SpinnerIcon } from "../icons";
+import { useCompleteOIDCLogin } from "../../api/hooks";
+import { connectMessage } from "../../utils/errors";
+
+function prefersDark(): boolean {
+  const saved = localStorage.getItem("gastrolog:theme");
+  if (saved === "dark") return true;
+  if (saved === "light") return false;
+  return globalThis.matchMedia("(prefers-color-scheme: dark)").matches;
+}
+
+/** Landing page for the identity provider's redirect after single sign-on. */
+export function OIDCCallback() {
+  const navigate = useNavigate();
+  const complete = useCompleteOIDCLogin();
+  const [error, setError] = useState("");
+  const started = useRef(false);
+  const c = useThemeClass(prefersDark());
+
+  useEffect(() => {
+    // The code is single-use; guard against StrictMode's double effect.
+    if (started.current) return;
+    started.current = true;
+
+    const params = new URLSearchParams(globalThis.location.search);
+    const idpError = params.get("error");
+    if (idpError) {
+      setError(params.get("error_description") ?? idpError);
+      return;
+    }
+    const code = params.get("code") ?? "";
+    const state = params.get("state") ?? "";
+    if (!code || !state) {
+      setError("The identity provider did not return a login code.");
+      return;
+    }
+    complete
+      .mutateAsync({ code, state })
+      .then(() =>
+        navigate({ to: "/search", search: { q: "", help: undefined, settings: undefined, inspector: undefined } }),
+      )
+      .catch((err: unknown) => setError(connectMessage(err, "Single sign-on failed.")));
+  }, [complete, navigate]);
+
+  return (
+    <div className={`grain h-full min-h-svh flex items-center justify-center px-4 ${c("bg-ink", "bg-light-bg")}`}>
+      <div
+        className={`w-full max-w-sm rounded-lg border p-6 flex flex-col items-center gap-4 text-[0.85em] ${c(
+          "bg-ink-surface border-ink-border text-text-muted",
+          "bg-light-surface border-light-border text-light-text-muted",
+        )}`}
+      >
+        {error ? (
+          <>
+            <div className="text-severity-error text-center leading-relaxed">{error}</div>
+            <button
+              type="button"
+              onClick={() => navigate({ to: "/login" })}
+              className="px-4 py-2 rounded font-medium bg-copper text-text-on-copper hover:brightness-110"
+            >
+              Back to sign in
+            </button>
+          </>
+        ) : (
+          <>
+            <SpinnerIcon className="animate-spin h-5 w-5" />
+            Signing in…
+          </>
+        )}
+      </div>
+    </div>
+  );
+}
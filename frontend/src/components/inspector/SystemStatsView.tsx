@@ -80,6 +80,14 @@ function CompactView({
               mono
               dark={dark}
             />
+            {stats.ingestDiskQueueMaxBytes > BigInt(0) && (
+              <CompactStatRow
+                label="On Disk"
+                value={`${formatBytes(Number(stats.ingestDiskQueueBytes))} / ${formatBytes(Number(stats.ingestDiskQueueMaxBytes))}`}
+                mono
+                dark={dark}
+              />
+            )}
             {stats.uptimeSeconds > BigInt(0) && (
               <CompactStatRow
                 label="Uptime"
@@ -23,7 +23,9 @@ const ingesterTypes = [
   { value: "chatterbox", label: "chatterbox" },
   { value: "docker", label: "docker" },
   { value: "fluentfwd", label: "fluentfwd" },
+  { value: "gelf", label: "gelf" },
   { value: "http", label: "http" },
+  { value: "journal", label: "journal" },
   { value: "kafka", label: "kafka" },
   { value: "mqtt", label: "mqtt" },
   { value: "metrics", label: "metrics" },
@@ -105,6 +105,8 @@ export function RoutesSettings({ dark, onNavigateTo: _onNavigateTo }: Readonly<{
       distribution: edit.distribution,
       enabled: edit.enabled,
       ejectOnly: edit.ejectOnly,
+      // Pipeline stages are managed via the CLI; keep them on save.
+      stages: routes.find((r) => encode(r.id) === id)?.stages,
     }),
   });
 
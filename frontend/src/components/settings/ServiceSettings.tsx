@@ -6,6 +6,7 @@ import { useSettings, usePutServiceSettings, useRegenerateJwtSecret } from "../.
 import { useCertificates } from "../../api/hooks/useCertificates";
 import { useToast } from "../Toast";
 import { FormField, TextInput, NumberInput } from "./FormField";
+import { PasswordInput } from "./lookup/FormHelpers";
 import { Button } from "./Buttons";
 import { Checkbox } from "./Checkbox";
 import { ExpandableCard } from "./ExpandableCard";
@@ -29,6 +30,16 @@ interface ServiceFormState {
   maxConsecutiveRepeats: string;
   forbidAnimalNoise: boolean;
   refreshTokenDuration: string;
+  oidcEnabled: boolean;
+  oidcIssuerUrl: string;
+  oidcClientId: string;
+  oidcClientSecret: string;
+  oidcRedirectUrl: string;
+  oidcScopes: string;
+  oidcUsernameClaim: string;
+  oidcGroupsClaim: string;
+  oidcRoleMappings: string;
+  oidcDefaultRole: string;
   maxFollowDuration: string;
   queryTimeout: string;
   maxResultCount: string;
@@ -45,6 +56,7 @@ type ServiceFormAction =
 function fieldsFromData(data: GetSettingsResponse): ServiceFormState {
   const auth = data.auth;
   const pp = auth?.passwordPolicy;
+  const oidc = auth?.oidc;
   const query = data.query;
   const sched = data.scheduler;
   const tls = data.tls;
@@ -62,6 +74,16 @@ function fieldsFromData(data: GetSettingsResponse): ServiceFormState {
     maxConsecutiveRepeats: pp?.maxConsecutiveRepeats ? String(pp.maxConsecutiveRepeats) : "0",
     forbidAnimalNoise: pp?.forbidAnimalNoise ?? false,
     refreshTokenDuration: auth?.refreshTokenDuration ?? "",
+    oidcEnabled: oidc?.enabled ?? false,
+    oidcIssuerUrl: oidc?.issuerUrl ?? "",
+    oidcClientId: oidc?.clientId ?? "",
+    oidcClientSecret: "",
+    oidcRedirectUrl: oidc?.redirectUrl ?? "",
+    oidcScopes: oidc?.scopes ?? "",
+    oidcUsernameClaim: oidc?.usernameClaim ?? "",
+    oidcGroupsClaim: oidc?.groupsClaim ?? "",
+    oidcRoleMappings: oidc?.roleMappings ?? "",
+    oidcDefaultRole: oidc?.defaultRole ?? "",
     maxFollowDuration: query?.maxFollowDuration ?? "",
     queryTimeout: query?.timeout ?? "",
     maxResultCount: query?.maxResultCount ? String(query.maxResultCount) : "10000",
@@ -76,7 +98,10 @@ const INITIAL_STATE: ServiceFormState = {
   tlsDefaultCert: "", tlsEnabled: false, httpToHttpsRedirect: false,
   httpsPort: "", requireMixedCase: false, requireDigit: false,
   requireSpecial: false, maxConsecutiveRepeats: "", forbidAnimalNoise: false,
-  refreshTokenDuration: "", maxFollowDuration: "", queryTimeout: "",
+  refreshTokenDuration: "", oidcEnabled: false, oidcIssuerUrl: "", oidcClientId: "",
+  oidcClientSecret: "", oidcRedirectUrl: "", oidcScopes: "", oidcUsernameClaim: "",
+  oidcGroupsClaim: "", oidcRoleMappings: "", oidcDefaultRole: "",
+  maxFollowDuration: "", queryTimeout: "",
   maxResultCount: "", broadcastInterval: "", heartbeatInterval: "", initialized: false,
 };
 
@@ -149,6 +174,16 @@ export function ServiceSettings({ dark, noAuth }: Readonly<{ dark: boolean; noAu
       s.maxConsecutiveRepeats !== String(data.auth?.passwordPolicy?.maxConsecutiveRepeats || 0) ||
       s.forbidAnimalNoise !== (data.auth?.passwordPolicy?.forbidAnimalNoise ?? false) ||
       s.refreshTokenDuration !== (data.auth?.refreshTokenDuration ?? "") ||
+      s.oidcEnabled !== (data.auth?.oidc?.enabled ?? false) ||
+      s.oidcIssuerUrl !== (data.auth?.oidc?.issuerUrl ?? "") ||
+      s.oidcClientId !== (data.auth?.oidc?.clientId ?? "") ||
+      s.oidcClientSecret !== "" ||
+      s.oidcRedirectUrl !== (data.auth?.oidc?.redirectUrl ?? "") ||
+      s.oidcScopes !== (data.auth?.oidc?.scopes ?? "") ||
+      s.oidcUsernameClaim !== (data.auth?.oidc?.usernameClaim ?? "") ||
+      s.oidcGroupsClaim !== (data.auth?.oidc?.groupsClaim ?? "") ||
+      s.oidcRoleMappings !== (data.auth?.oidc?.roleMappings ?? "") ||
+      s.oidcDefaultRole !== (data.auth?.oidc?.defaultRole ?? "") ||
       s.maxFollowDuration !== (data.query?.maxFollowDuration ?? "") ||
       s.queryTimeout !== (data.query?.timeout ?? "") ||
       s.maxResultCount !== String(data.query?.maxResultCount || 10000) ||
@@ -178,6 +213,19 @@ export function ServiceSettings({ dark, noAuth }: Readonly<{ dark: boolean; noAu
             maxConsecutiveRepeats: effectiveMaxRepeats,
             forbidAnimalNoise: s.forbidAnimalNoise,
           },
+          oidc: {
+            enabled: s.oidcEnabled,
+            issuerUrl: s.oidcIssuerUrl,
+            clientId: s.oidcClientId,
+            // Empty keeps the stored secret; it is never sent back to the browser.
+            clientSecret: s.oidcClientSecret || undefined,
+            redirectUrl: s.oidcRedirectUrl,
+            scopes: s.oidcScopes,
+            usernameClaim: s.oidcUsernameClaim,
+            groupsClaim: s.oidcGroupsClaim,
+            roleMappings: s.oidcRoleMappings,
+            defaultRole: s.oidcDefaultRole,
+          },
         },
         query: {
           timeout: s.queryTimeout,
@@ -198,6 +246,7 @@ export function ServiceSettings({ dark, noAuth }: Readonly<{ dark: boolean; noAu
           heartbeatInterval: effectiveHeartbeat,
         },
       });
+      dispatch({ type: "set", field: "oidcClientSecret", value: "" });
       addToast("Server configuration updated", "info");
     } catch (err: unknown) {
       addToast(extractMessage(err, "Failed to update server configuration"), "error");
@@ -211,6 +260,7 @@ export function ServiceSettings({ dark, noAuth }: Readonly<{ dark: boolean; noAu
   const { toggle, isExpanded } = useExpandedCards({
     auth: true,
     password: false,
+    sso: false,
     scheduler: false,
     query: false,
     tls: false,
@@ -374,6 +424,78 @@ export function ServiceSettings({ dark, noAuth }: Readonly<{ dark: boolean; noAu
             </div>
           </ExpandableCard>
 
+          <ExpandableCard
+            id="Single Sign-On"
+            dark={dark}
+            expanded={isExpanded("sso")}
+            onToggle={() => toggle("sso")}
+            monoTitle={false}
+            typeBadge={noAuth ? "disabled" : s.oidcEnabled ? "OIDC" : undefined}
+          >
+            <div className={`flex flex-col gap-4 ${noAuth ? "opacity-50" : ""}`} aria-disabled={noAuth || undefined}>
+              <Checkbox checked={s.oidcEnabled} onChange={set("oidcEnabled")} label="Offer “Sign in with SSO” on the login page" dark={dark} />
+
+              <FormField
+                label="Issuer URL"
+                description="The OpenID Connect issuer. Endpoints and signing keys are discovered from its .well-known/openid-configuration."
+                dark={dark}
+              >
+                <TextInput value={s.oidcIssuerUrl} onChange={set("oidcIssuerUrl")} placeholder="https://idp.example.com" dark={dark} mono />
+              </FormField>
+
+              <div className="flex items-baseline gap-4">
+                <FormField label="Client ID" dark={dark}>
+                  <TextInput value={s.oidcClientId} onChange={set("oidcClientId")} placeholder="gastrolog" dark={dark} mono />
+                </FormField>
+                <FormField label="Client Secret" dark={dark}>
+                  <PasswordInput
+                    value={s.oidcClientSecret}
+                    onChange={set("oidcClientSecret")}
+                    placeholder={data?.auth?.oidc?.clientSecretConfigured ? "(configured — leave blank to keep)" : ""}
+                    dark={dark}
+                  />
+                </FormField>
+              </div>
+
+              <FormField
+                label="Redirect URL"
+                description="Must be registered at the identity provider and end in /auth/callback."
+                dark={dark}
+              >
+                <TextInput value={s.oidcRedirectUrl} onChange={set("oidcRedirectUrl")} placeholder="https://logs.example.com/auth/callback" dark={dark} mono />
+              </FormField>
+
+              <FormField label="Scopes" description="Requested in addition to openid, separated by spaces." dark={dark}>
+                <TextInput value={s.oidcScopes} onChange={set("oidcScopes")} placeholder="profile groups" dark={dark} mono />
+              </FormField>
+
+              <div className="flex items-baseline gap-4">
+                <FormField label="Username claim" dark={dark}>
+                  <TextInput value={s.oidcUsernameClaim} onChange={set("oidcUsernameClaim")} placeholder="preferred_username" dark={dark} mono />
+                </FormField>
+                <FormField label="Groups claim" dark={dark}>
+                  <TextInput value={s.oidcGroupsClaim} onChange={set("oidcGroupsClaim")} placeholder="groups" dark={dark} mono />
+                </FormField>
+              </div>
+
+              <FormField
+                label="Role Mappings"
+                description="group=role pairs separated by commas. The first group the user belongs to decides the role, on every login."
+                dark={dark}
+              >
+                <TextInput value={s.oidcRoleMappings} onChange={set("oidcRoleMappings")} placeholder="ops=admin,dev=user" dark={dark} mono />
+              </FormField>
+
+              <FormField
+                label="Default Role"
+                description="Role for users in none of the mapped groups. Leave empty to refuse their login."
+                dark={dark}
+              >
+                <TextInput value={s.oidcDefaultRole} onChange={set("oidcDefaultRole")} placeholder="(deny)" dark={dark} mono />
+              </FormField>
+            </div>
+          </ExpandableCard>
+
           <ExpandableCard
             id="Scheduler"
             dark={dark}
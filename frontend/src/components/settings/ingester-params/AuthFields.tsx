@@ -0,0 +1,67 @@
+import { FormField, TextInput, SelectInput } from "../FormField";
+import type { SubFormProps } from "./types";
+
+const authOptions = [
+  { value: "", label: "None" },
+  { value: "bearer", label: "Bearer token" },
+  { value: "basic", label: "Basic (username/password)" },
+];
+
+// Shared auth/auth_* params for HTTP-based listener ingesters (HTTP, OTLP).
+// Mirrors backend/internal/ingester/httpauth.
+export function AuthFields({
+  params,
+  onChange,
+  dark,
+}: Readonly<Omit<SubFormProps, "defaults">>) {
+  const set = (key: string, value: string) =>
+    onChange({ ...params, [key]: value });
+  const mode = params["auth"] === "none" ? "" : (params["auth"] ?? "");
+
+  return (
+    <div className="flex flex-col gap-3">
+      <FormField
+        label="Client Authentication"
+        description="Credentials required in the Authorization header"
+        dark={dark}
+      >
+        <SelectInput
+          value={mode}
+          onChange={(v) => set("auth", v)}
+          options={authOptions}
+          dark={dark}
+        />
+      </FormField>
+      {mode === "bearer" && (
+        <FormField label="Token" dark={dark}>
+          <TextInput
+            value={params["auth_token"] ?? ""}
+            onChange={(v) => set("auth_token", v)}
+            dark={dark}
+            mono
+          />
+        </FormField>
+      )}
+      {mode === "basic" && (
+        <div className="grid grid-cols-2 gap-3">
+          <FormField label="Username" dark={dark}>
+            <TextInput
+              value={params["auth_username"] ?? ""}
+              onChange={(v) => set("auth_username", v)}
+              dark={dark}
+              mono
+            />
+          </FormField>
+          <FormField label="Password" dark={dark}>
+            <TextInput
+              value={params["auth_password"] ?? ""}
+              onChange={(v) => set("auth_password", v)}
+              dark={dark}
+              mono
+            />
+          </FormField>
+        </div>
+      )}
+    </div>
+  );
+}
@@ -3,6 +3,7 @@ import { FormField, TextInput, SelectInput } from "../FormField";
 import { useThemeClass } from "../../../hooks/useThemeClass";
 import { useCertificates } from "../../../api/hooks/useCertificates";
 import { Checkbox } from "../Checkbox";
+import { MultilineFields } from "./MultilineFields";
 import { TestConnectionButton } from "./TestConnectionButton";
 import type { SubFormProps } from "./types";
 
@@ -89,6 +90,8 @@ export function DockerForm({
         </div>
       </div>
 
+      <MultilineFields params={params} onChange={onChange} dark={dark} defaults={d} />
+
       {/* TLS */}
       <div className="flex flex-col gap-1">
         <Checkbox
@@ -1,4 +1,5 @@
 import { FormField, TextInput } from "../FormField";
+import { TlsFields } from "./TlsFields";
 import type { SubFormProps } from "./types";
 
 export function FluentfwdForm({
@@ -23,6 +24,35 @@ export function FluentfwdForm({
           examples={[":24224"]}
         />
       </FormField>
+      <TlsFields params={params} onChange={onChange} dark={dark} />
+      <div className="grid grid-cols-2 gap-3">
+        <FormField
+          label="Shared Key"
+          description="Require the shared_key handshake (leave empty to disable)"
+          dark={dark}
+        >
+          <TextInput
+            value={params["shared_key"] ?? ""}
+            onChange={(v) => onChange({ ...params, shared_key: v })}
+            dark={dark}
+            mono
+          />
+        </FormField>
+        {params["shared_key"] && (
+          <FormField
+            label="Self Hostname"
+            description="Server hostname sent to clients (defaults to the node hostname)"
+            dark={dark}
+          >
+            <TextInput
+              value={params["self_hostname"] ?? ""}
+              onChange={(v) => onChange({ ...params, self_hostname: v })}
+              dark={dark}
+              mono
+            />
+          </FormField>
+        )}
+      </div>
     </div>
   );
 }
@@ -0,0 +1,98 @@
+import { FormField, TextInput } from "../FormField";
+import { AuthFields } from "./AuthFields";
+import { TlsFields } from "./TlsFields";
+import type { SubFormProps } from "./types";
+
+export function GelfForm({
+  params,
+  onChange,
+  dark,
+  defaults: d,
+}: Readonly<SubFormProps>) {
+  return (
+    <div className="flex flex-col gap-3">
+      <div className="grid grid-cols-3 gap-3">
+        <FormField
+          label="UDP Address"
+          description="UDP listen address (chunked and compressed)"
+          dark={dark}
+        >
+          <TextInput
+            value={params["udp_addr"] ?? ""}
+            onChange={(v) => onChange({ ...params, udp_addr: v })}
+            placeholder={d["udp_addr"] ?? ""}
+            dark={dark}
+            mono
+            examples={[":12201"]}
+          />
+        </FormField>
+        <FormField
+          label="TCP Address"
+          description="Null-byte delimited (leave empty to disable)"
+          dark={dark}
+        >
+          <TextInput
+            value={params["tcp_addr"] ?? ""}
+            onChange={(v) => onChange({ ...params, tcp_addr: v })}
+            placeholder=""
+            dark={dark}
+            mono
+            examples={[":12201"]}
+          />
+        </FormField>
+        <FormField
+          label="HTTP Address"
+          description="POST /gelf (leave empty to disable)"
+          dark={dark}
+        >
+          <TextInput
+            value={params["http_addr"] ?? ""}
+            onChange={(v) => onChange({ ...params, http_addr: v })}
+            placeholder=""
+            dark={dark}
+            mono
+            examples={[":12202"]}
+          />
+        </FormField>
+      </div>
+      {params["udp_addr"] && (
+        <div className="grid grid-cols-2 gap-3">
+          <FormField
+            label="Chunk Timeout"
+            description="Wait for the remaining chunks of a UDP message"
+            dark={dark}
+          >
+            <TextInput
+              value={params["chunk_timeout"] ?? ""}
+              onChange={(v) => onChange({ ...params, chunk_timeout: v })}
+              placeholder={d["chunk_timeout"] ?? ""}
+              dark={dark}
+              mono
+              examples={["5s"]}
+            />
+          </FormField>
+          <FormField
+            label="Max Chunked Messages"
+            description="Incomplete messages held at once; the oldest is dropped"
+            dark={dark}
+          >
+            <TextInput
+              value={params["max_chunked_messages"] ?? ""}
+              onChange={(v) => onChange({ ...params, max_chunked_messages: v })}
+              placeholder={d["max_chunked_messages"] ?? ""}
+              dark={dark}
+              mono
+              examples={["1000"]}
+            />
+          </FormField>
+        </div>
+      )}
+      {(params["tcp_addr"] || params["http_addr"]) && (
+        <TlsFields params={params} onChange={onChange} dark={dark} />
+      )}
+      {params["http_addr"] && (
+        <AuthFields params={params} onChange={onChange} dark={dark} />
+      )}
+    </div>
+  );
+}
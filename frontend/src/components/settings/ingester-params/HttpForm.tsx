@@ -1,4 +1,6 @@
 import { FormField, TextInput } from "../FormField";
+import { AuthFields } from "./AuthFields";
+import { TlsFields } from "./TlsFields";
 import type { SubFormProps } from "./types";
 
 export function HttpForm({
@@ -11,7 +13,7 @@ export function HttpForm({
     <div className="flex flex-col gap-3">
       <FormField
         label="Listen Address"
-        description="TCP address for HTTP/Loki Push API"
+        description="TCP address for the Loki, Elasticsearch and Splunk HEC APIs"
         dark={dark}
       >
         <TextInput
@@ -23,6 +25,8 @@ export function HttpForm({
           examples={[":3100"]}
         />
       </FormField>
+      <TlsFields params={params} onChange={onChange} dark={dark} />
+      <AuthFields params={params} onChange={onChange} dark={dark} />
     </div>
   );
 }
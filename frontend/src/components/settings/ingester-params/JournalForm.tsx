@@ -0,0 +1,62 @@
+import { FormField, TextInput, SelectInput } from "../FormField";
+import type { SubFormProps } from "./types";
+
+export function JournalForm({
+  params,
+  onChange,
+  dark,
+  defaults: d,
+}: Readonly<SubFormProps>) {
+  const set = (key: string, value: string) =>
+    onChange({ ...params, [key]: value });
+
+  return (
+    <div className="flex flex-col gap-3">
+      <FormField
+        label="Journal Directory"
+        description="Directory holding the journal files, searched one level deep for machine ID subdirectories"
+        dark={dark}
+      >
+        <TextInput
+          value={params["dir"] ?? ""}
+          onChange={(v) => set("dir", v)}
+          placeholder={d["dir"] ?? ""}
+          dark={dark}
+          mono
+          examples={["/var/log/journal", "/run/log/journal"]}
+        />
+      </FormField>
+      <div className="grid grid-cols-2 gap-3">
+        <FormField
+          label="Poll Interval"
+          description="How often to check the journal files for new entries"
+          dark={dark}
+        >
+          <TextInput
+            value={params["poll_interval"] ?? ""}
+            onChange={(v) => set("poll_interval", v)}
+            placeholder={d["poll_interval"] ?? ""}
+            dark={dark}
+            mono
+            examples={["500ms", "1s", "5s"]}
+          />
+        </FormField>
+        <FormField
+          label="Read From"
+          description="Where to start when there is no saved cursor"
+          dark={dark}
+        >
+          <SelectInput
+            value={params["read_from"] ?? d["read_from"] ?? "end"}
+            onChange={(v) => set("read_from", v)}
+            options={[
+              { value: "end", label: "End (new entries only)" },
+              { value: "beginning", label: "Beginning (all retained entries)" },
+            ]}
+            dark={dark}
+          />
+        </FormField>
+      </div>
+    </div>
+  );
+}
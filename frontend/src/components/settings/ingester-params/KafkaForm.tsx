@@ -1,8 +1,18 @@
+import { encode } from "../../../api/glid";
+import { useConfig } from "../../../api/hooks/useSystem";
+import { useUploadManagedFile } from "../../../api/hooks/useUploadManagedFile";
+import { useToast } from "../../Toast";
 import { FormField, TextInput, SelectInput } from "../FormField";
 import { Checkbox } from "../Checkbox";
+import { FileDropZone } from "../FileDropZone";
 import { TestConnectionButton } from "./TestConnectionButton";
 import type { SubFormProps } from "./types";
 
+const schemaExtensions: Record<string, string> = {
+  avro: ".avsc,.json",
+  protobuf: ".binpb,.desc,.pb",
+};
+
 export function KafkaForm({
   params,
   onChange,
@@ -11,6 +21,14 @@ export function KafkaForm({
 }: Readonly<SubFormProps>) {
   const set = (key: string, value: string) =>
     onChange({ ...params, [key]: value });
+  const { data: config } = useConfig();
+  const uploadFile = useUploadManagedFile();
+  const { addToast } = useToast();
+
+  const format = params["format"] || d["format"] || "raw";
+  const schemaAccept = schemaExtensions[format];
+  const managedFiles = config?.managedFiles ?? [];
+  const schemaFile = params["schema_file"] ?? "";
 
   return (
     <div className="flex flex-col gap-3">
@@ -30,8 +48,8 @@ export function KafkaForm({
       </FormField>
       <div className="grid grid-cols-2 gap-3">
         <FormField
-          label="Topic"
-          description="Kafka topic to consume (required)"
+          label="Topics"
+          description="Comma-separated Kafka topics to consume (required)"
           dark={dark}
         >
           <TextInput
@@ -40,7 +58,7 @@ export function KafkaForm({
             placeholder=""
             dark={dark}
             mono
-            examples={["logs", "app-logs"]}
+            examples={["logs", "app-logs,audit"]}
           />
         </FormField>
         <FormField
@@ -58,6 +76,105 @@ export function KafkaForm({
           />
         </FormField>
       </div>
+      <Checkbox
+        checked={params["topic_regex"] === "true"}
+        onChange={(v) => set("topic_regex", v ? "true" : "false")}
+        label="Topics are regular expressions"
+        dark={dark}
+      />
+      <FormField
+        label="Payload Format"
+        description="How message values are decoded. JSON, Avro and Protobuf can promote fields to attributes."
+        dark={dark}
+      >
+        <SelectInput
+          value={format}
+          onChange={(v) => set("format", v)}
+          options={[
+            { value: "raw", label: "Raw" },
+            { value: "json", label: "JSON" },
+            { value: "avro", label: "Avro" },
+            { value: "protobuf", label: "Protobuf" },
+          ]}
+          dark={dark}
+        />
+      </FormField>
+      {schemaAccept && (
+        <>
+          <FormField
+            label="Schema File"
+            description={
+              format === "avro"
+                ? "Avro schema (.avsc) the messages were written with"
+                : "Descriptor set from protoc --include_imports --descriptor_set_out, or buf build -o"
+            }
+            dark={dark}
+          >
+            <FileDropZone
+              dark={dark}
+              inputId="kafka-schema-file"
+              accept={schemaAccept}
+              label={`${schemaAccept.split(",")[0]} file`}
+              currentFile={schemaFile ? managedFiles.find((f) => encode(f.id) === schemaFile) : undefined}
+              pickableFiles={managedFiles.filter((f) => schemaAccept.split(",").some((ext) => f.name.endsWith(ext)))}
+              uploadFile={uploadFile}
+              addToast={addToast}
+              onFileSelected={(id) => set("schema_file", id)}
+            />
+          </FormField>
+          {format === "protobuf" && (
+            <FormField
+              label="Message Type"
+              description="Full name of the message type (required)"
+              dark={dark}
+            >
+              <TextInput
+                value={params["message_type"] ?? ""}
+                onChange={(v) => set("message_type", v)}
+                dark={dark}
+                mono
+                examples={["acme.logs.v1.LogEvent"]}
+              />
+            </FormField>
+          )}
+          <Checkbox
+            checked={params["confluent_framing"] === "true"}
+            onChange={(v) => set("confluent_framing", v ? "true" : "false")}
+            label="Confluent Schema Registry framing"
+            dark={dark}
+          />
+        </>
+      )}
+      {format !== "raw" && (
+        <div className="grid grid-cols-2 gap-3">
+          <FormField
+            label="Promote Fields"
+            description="Comma-separated fields to copy into attributes; dots reach nested fields"
+            dark={dark}
+          >
+            <TextInput
+              value={params["promote_fields"] ?? ""}
+              onChange={(v) => set("promote_fields", v)}
+              dark={dark}
+              mono
+              examples={["level,service.name"]}
+            />
+          </FormField>
+          <FormField
+            label="Message Field"
+            description="Field to use as the log line instead of the whole payload"
+            dark={dark}
+          >
+            <TextInput
+              value={params["message_field"] ?? ""}
+              onChange={(v) => set("message_field", v)}
+              dark={dark}
+              mono
+              examples={["message", "msg"]}
+            />
+          </FormField>
+        </div>
+      )}
       <Checkbox
         checked={params["tls"] === "true"}
         onChange={(v) => set("tls", v ? "true" : "false")}
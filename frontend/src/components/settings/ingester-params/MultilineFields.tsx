@@ -0,0 +1,113 @@
+import { FormField, TextInput } from "../FormField";
+import { useThemeClass } from "../../../hooks/useThemeClass";
+import { Checkbox } from "../Checkbox";
+import type { SubFormProps } from "./types";
+
+// Shared multiline_* params for line-oriented ingesters (tail, syslog TCP,
+// docker). Mirrors backend/internal/ingester/multiline.
+export function MultilineFields({
+  params,
+  onChange,
+  dark,
+  defaults: d,
+}: Readonly<SubFormProps>) {
+  const c = useThemeClass(dark);
+  const set = (key: string, value: string) =>
+    onChange({ ...params, [key]: value });
+  const enabled =
+    !!params["multiline_start"] ||
+    !!params["multiline_continue"] ||
+    params["multiline_indent"] === "true";
+
+  return (
+    <div className="flex flex-col gap-3">
+      <div className="flex flex-col gap-1">
+        <span
+          className={`text-[0.8em] font-medium ${c("text-text-muted", "text-light-text-muted")}`}
+        >
+          Multiline Events
+        </span>
+        <p
+          className={`text-[0.7em] ${c("text-text-muted", "text-light-text-muted")}`}
+        >
+          Join consecutive lines, such as stack traces, into one record. A line
+          matching the start pattern begins a new event; lines matching the
+          continuation pattern or starting with whitespace extend it. Leave
+          all three rules empty to keep one record per line.
+        </p>
+      </div>
+      <div className="grid grid-cols-2 gap-3">
+        <FormField
+          label="Start Pattern"
+          description="Regex matching the first line of an event"
+          dark={dark}
+        >
+          <TextInput
+            value={params["multiline_start"] ?? ""}
+            onChange={(v) => set("multiline_start", v)}
+            placeholder=""
+            dark={dark}
+            mono
+            examples={[String.raw`^\d{4}-\d{2}-\d{2}`, "^Traceback", String.raw`^\[`]}
+          />
+        </FormField>
+        <FormField
+          label="Continuation Pattern"
+          description="Regex matching lines that continue an event"
+          dark={dark}
+        >
+          <TextInput
+            value={params["multiline_continue"] ?? ""}
+            onChange={(v) => set("multiline_continue", v)}
+            placeholder=""
+            dark={dark}
+            mono
+            examples={[String.raw`^\s+at `, "^Caused by:"]}
+          />
+        </FormField>
+      </div>
+      <Checkbox
+        checked={params["multiline_indent"] === "true"}
+        onChange={(v) => set("multiline_indent", v ? "true" : "")}
+        label="Indented lines continue the previous event"
+        dark={dark}
+      />
+      {enabled && (
+        <div className="grid grid-cols-3 gap-3">
+          <FormField label="Max Lines" description="Lines per event" dark={dark}>
+            <TextInput
+              value={params["multiline_max_lines"] ?? ""}
+              onChange={(v) => set("multiline_max_lines", v)}
+              placeholder={d["multiline_max_lines"] ?? ""}
+              dark={dark}
+              mono
+            />
+          </FormField>
+          <FormField label="Max Bytes" description="Bytes per event" dark={dark}>
+            <TextInput
+              value={params["multiline_max_bytes"] ?? ""}
+              onChange={(v) => set("multiline_max_bytes", v)}
+              placeholder={d["multiline_max_bytes"] ?? ""}
+              dark={dark}
+              mono
+            />
+          </FormField>
+          <FormField
+            label="Flush Timeout"
+            description="Emit a pending event after this long idle"
+            dark={dark}
+          >
+            <TextInput
+              value={params["multiline_flush_timeout"] ?? ""}
+              onChange={(v) => set("multiline_flush_timeout", v)}
+              placeholder={d["multiline_flush_timeout"] ?? ""}
+              dark={dark}
+              mono
+              examples={["500ms", "1s", "5s"]}
+            />
+          </FormField>
+        </div>
+      )}
+    </div>
+  );
+}
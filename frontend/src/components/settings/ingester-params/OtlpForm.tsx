@@ -1,4 +1,6 @@
 import { FormField, TextInput } from "../FormField";
+import { AuthFields } from "./AuthFields";
+import { TlsFields } from "./TlsFields";
 import type { SubFormProps } from "./types";
 
 export function OtlpForm({
@@ -12,7 +14,7 @@ export function OtlpForm({
       <div className="grid grid-cols-2 gap-3">
         <FormField
           label="HTTP Address"
-          description="OTLP/HTTP listen address (POST /v1/logs)"
+          description="OTLP/HTTP listen address (POST /v1/logs, /v1/traces)"
           dark={dark}
         >
           <TextInput
@@ -39,6 +41,8 @@ export function OtlpForm({
           />
         </FormField>
       </div>
+      <TlsFields params={params} onChange={onChange} dark={dark} />
+      <AuthFields params={params} onChange={onChange} dark={dark} />
     </div>
   );
 }
@@ -1,7 +1,5 @@
-import { encode } from "../../../api/glid";
-import { FormField, TextInput, SelectInput } from "../FormField";
-import { Checkbox } from "../Checkbox";
-import { useCertificates } from "../../../api/hooks/useCertificates";
+import { FormField, TextInput } from "../FormField";
+import { TlsFields } from "./TlsFields";
 import type { SubFormProps } from "./types";
 
 export function RelpForm({
@@ -10,20 +8,6 @@ export function RelpForm({
   dark,
   defaults: d,
 }: Readonly<SubFormProps>) {
-  const set = (key: string, value: string) =>
-    onChange({ ...params, [key]: value });
-
-  const tlsEnabled = params["tls"] === "true";
-  const { data: certsData } = useCertificates();
-  const certs = certsData?.certificates ?? [];
-
-  const certOptions = [
-    { value: "", label: "(none)" },
-    ...certs
-      .map((c) => ({ value: c.name || encode(c.id), label: c.name || encode(c.id) }))
-      .sort((a, b) => a.label.localeCompare(b.label)),
-  ];
-
   return (
     <div className="flex flex-col gap-3">
       <FormField
@@ -33,59 +17,14 @@ export function RelpForm({
       >
         <TextInput
           value={params["addr"] ?? ""}
-          onChange={(v) => set("addr", v)}
+          onChange={(v) => onChange({ ...params, addr: v })}
           placeholder={d["addr"] ?? ""}
           dark={dark}
           mono
           examples={[":2514"]}
         />
       </FormField>
-      <Checkbox
-        checked={tlsEnabled}
-        onChange={(v) => set("tls", v ? "true" : "false")}
-        label="Enable TLS"
-        dark={dark}
-      />
-      {tlsEnabled && (
-        <div className="flex flex-col gap-3">
-          <FormField
-            label="Certificate"
-            description="Server certificate from the certificate manager"
-            dark={dark}
-          >
-            <SelectInput
-              value={params["tls_cert"] ?? ""}
-              onChange={(v) => set("tls_cert", v)}
-              options={certOptions}
-              dark={dark}
-            />
-          </FormField>
-          <FormField
-            label="CA Certificate File"
-            description="Path to CA certificate for client verification (mutual TLS)"
-            dark={dark}
-          >
-            <TextInput
-              value={params["tls_ca"] ?? ""}
-              onChange={(v) => set("tls_ca", v)}
-              dark={dark}
-              mono
-            />
-          </FormField>
-          <FormField
-            label="Allowed Client CN"
-            description="Wildcard pattern to match client certificate Common Name"
-            dark={dark}
-          >
-            <TextInput
-              value={params["tls_allowed_cn"] ?? ""}
-              onChange={(v) => set("tls_allowed_cn", v)}
-              dark={dark}
-              mono
-            />
-          </FormField>
-        </div>
-      )}
+      <TlsFields params={params} onChange={onChange} dark={dark} />
     </div>
   );
 }
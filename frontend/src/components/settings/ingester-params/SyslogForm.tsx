@@ -1,4 +1,6 @@
 import { FormField, TextInput } from "../FormField";
+import { MultilineFields } from "./MultilineFields";
+import { TlsFields } from "./TlsFields";
 import type { SubFormProps } from "./types";
 
 export function SyslogForm({
@@ -39,6 +41,12 @@ export function SyslogForm({
           />
         </FormField>
       </div>
+      {params["tcp_addr"] && (
+        <>
+          <TlsFields params={params} onChange={onChange} dark={dark} />
+          <MultilineFields params={params} onChange={onChange} dark={dark} defaults={d} />
+        </>
+      )}
     </div>
   );
 }
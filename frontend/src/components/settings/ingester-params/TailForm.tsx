@@ -1,5 +1,7 @@
 import { FormField, TextInput, TextArea, ExampleValues } from "../FormField";
 import { useThemeClass } from "../../../hooks/useThemeClass";
+import { Checkbox } from "../Checkbox";
+import { MultilineFields } from "./MultilineFields";
 import type { SubFormProps } from "./types";
 
 export function TailForm({
@@ -72,6 +74,21 @@ export function TailForm({
           examples={["30s", "1m", "5m"]}
         />
       </FormField>
+
+      <div className="flex flex-col gap-1">
+        <Checkbox
+          checked={params["backfill"] === "true"}
+          onChange={(v) => onChange({ ...params, backfill: v ? "true" : "false" })}
+          label="Backfill"
+          dark={dark}
+        />
+        <p className={`text-[0.7em] ${c("text-text-muted", "text-light-text-muted")}`}>
+          Read existing files from the beginning instead of the end, and read
+          matching .gz and .zst archives once.
+        </p>
+      </div>
+
+      <MultilineFields params={params} onChange={onChange} dark={dark} defaults={d} />
     </div>
   );
 }
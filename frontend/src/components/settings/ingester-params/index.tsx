@@ -4,6 +4,8 @@ import { TailForm } from "./TailForm";
 import { DockerForm } from "./DockerForm";
 import { OtlpForm } from "./OtlpForm";
 import { FluentfwdForm } from "./FluentfwdForm";
+import { GelfForm } from "./GelfForm";
+import { JournalForm } from "./JournalForm";
 import { KafkaForm } from "./KafkaForm";
 import { MqttForm } from "./MqttForm";
 import { HttpForm } from "./HttpForm";
@@ -33,6 +35,8 @@ const FORM_MAP: Record<
   docker: DockerForm,
   otlp: OtlpForm,
   fluentfwd: FluentfwdForm,
+  gelf: GelfForm,
+  journal: JournalForm,
   kafka: KafkaForm,
   mqtt: MqttForm,
   http: HttpForm,
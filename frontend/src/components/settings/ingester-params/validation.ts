@@ -10,6 +10,7 @@ const VALID_MAP: Record<string, (params: Record<string, string>) => boolean> = {
   http: (p) => !!p["addr"],
   fluentfwd: (p) => !!p["addr"],
   otlp: (p) => !!p["http_addr"] || !!p["grpc_addr"],
+  gelf: (p) => !!p["udp_addr"] || !!p["tcp_addr"] || !!p["http_addr"],
 };
 
 export function isIngesterParamsValid(type: string, params: Record<string, string>): boolean {
@@ -45,6 +46,13 @@ const LISTEN_ADDRS: Record<string, AddrExtractor> = {
     if (http) addrs.push({ network: "tcp", address: http });
     return addrs;
   },
+  gelf: (p) => {
+    const addrs: ListenAddr[] = [];
+    if (p["udp_addr"]) addrs.push({ network: "udp", address: p["udp_addr"] });
+    if (p["tcp_addr"]) addrs.push({ network: "tcp", address: p["tcp_addr"] });
+    if (p["http_addr"]) addrs.push({ network: "tcp", address: p["http_addr"] });
+    return addrs;
+  },
 };
 
 function getListenAddrs(type: string, params: Record<string, string>, defaults: Record<string, string>): ListenAddr[] {
@@ -17,12 +17,14 @@ interface IngesterStepProps {
 
 const INGESTER_TYPES = [
   { id: "syslog", label: "Syslog", description: "RFC 3164/5424 UDP + TCP" },
-  { id: "http", label: "HTTP (Loki)", description: "Loki-compatible push API" },
-  { id: "otlp", label: "OTLP", description: "OpenTelemetry logs (HTTP + gRPC)" },
+  { id: "http", label: "HTTP", description: "Loki, Elasticsearch _bulk and Splunk HEC APIs" },
+  { id: "otlp", label: "OTLP", description: "OpenTelemetry logs and traces (HTTP + gRPC)" },
   { id: "fluentfwd", label: "Fluent Forward", description: "Fluentd / Fluent Bit protocol" },
+  { id: "gelf", label: "GELF", description: "Graylog / Docker gelf log driver" },
   { id: "kafka", label: "Kafka", description: "Kafka topic consumer" },
   { id: "docker", label: "Docker", description: "Container log streaming" },
   { id: "tail", label: "Tail", description: "File tailing with glob patterns" },
+  { id: "journal", label: "Journal", description: "systemd journal files" },
   { id: "relp", label: "RELP", description: "Reliable Event Logging Protocol" },
   { id: "metrics", label: "Metrics", description: "Self-monitoring system metrics" },
   { id: "chatterbox", label: "Chatterbox", description: "Test data generator" },
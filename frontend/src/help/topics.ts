@@ -18,18 +18,21 @@ export const helpTopics: HelpTopic[] = [
     id: 'ingestion', title: 'Ingestion', load: md(() => import('./ingestion.md?raw')),
     children: [
       { id: 'ingester-syslog', title: 'Syslog', load: md(() => import('./ingester-syslog.md?raw')) },
-      { id: 'ingester-http', title: 'HTTP (Loki)', load: md(() => import('./ingester-http.md?raw')) },
+      { id: 'ingester-http', title: 'HTTP (Loki, Elasticsearch, HEC)', load: md(() => import('./ingester-http.md?raw')) },
       { id: 'ingester-relp', title: 'RELP', load: md(() => import('./ingester-relp.md?raw')) },
       { id: 'ingester-otlp', title: 'OTLP', load: md(() => import('./ingester-otlp.md?raw')) },
       { id: 'ingester-fluentfwd', title: 'Fluent Forward', load: md(() => import('./ingester-fluentfwd.md?raw')) },
+      { id: 'ingester-gelf', title: 'GELF', load: md(() => import('./ingester-gelf.md?raw')) },
       { id: 'ingester-kafka', title: 'Kafka', load: md(() => import('./ingester-kafka.md?raw')) },
       { id: 'ingester-mqtt', title: 'MQTT', load: md(() => import('./ingester-mqtt.md?raw')) },
       { id: 'ingester-tail', title: 'Tail', load: md(() => import('./ingester-tail.md?raw')) },
+      { id: 'ingester-journal', title: 'Journal', load: md(() => import('./ingester-journal.md?raw')) },
       { id: 'ingester-docker', title: 'Docker', load: md(() => import('./ingester-docker.md?raw')) },
       { id: 'ingester-metrics', title: 'Metrics', load: md(() => import('./ingester-metrics.md?raw')) },
       { id: 'ingester-self', title: 'Self', load: md(() => import('./ingester-self.md?raw')) },
       { id: 'ingester-chatterbox', title: 'Chatterbox', load: md(() => import('./ingester-chatterbox.md?raw')) },
       { id: 'ingester-scatterbox', title: 'Scatterbox', load: md(() => import('./ingester-scatterbox.md?raw')) },
+      { id: 'ingester-multiline', title: 'Multiline Events', load: md(() => import('./ingester-multiline.md?raw')) },
     ],
   },
   {
@@ -102,6 +105,7 @@ export const helpTopics: HelpTopic[] = [
       { id: 'recipe-docker-mtls', title: 'Docker with mTLS', load: md(() => import('./recipe-docker-mtls.md?raw')) },
       { id: 'recipe-rsyslog', title: 'rsyslog', load: md(() => import('./recipe-rsyslog.md?raw')) },
       { id: 'recipe-promtail', title: 'Promtail / Grafana Agent', load: md(() => import('./recipe-promtail.md?raw')) },
+      { id: 'recipe-elastic-hec', title: 'Elasticsearch & Splunk Shippers', load: md(() => import('./recipe-elastic-hec.md?raw')) },
       { id: 'recipe-journald', title: 'systemd journal', load: md(() => import('./recipe-journald.md?raw')) },
     ],
   },
@@ -13,8 +13,8 @@ export const DEFAULT_SYNTAX: SyntaxSets = {
     "source_start", "source_end", "ingest_start", "ingest_end",
   ]),
   pipeKeywords: new Set([
-    "stats", "where", "eval", "sort", "head", "tail", "slice",
-    "rename", "fields", "timechart", "raw", "lookup",
+    "stats", "eventstats", "streamstats", "top", "rare", "where", "eval", "sort", "head", "tail", "slice",
+    "transaction", "rename", "fields", "timechart", "raw", "lookup", "parse", "rex",
     "barchart", "donut", "map",
   ]),
   pipeFunctions: new Set([
@@ -5,6 +5,7 @@ import { followRoute } from "./routes/follow";
 import { loginRoute } from "./routes/login";
 import { registerRoute } from "./routes/register";
 import { setupRoute } from "./routes/setup";
+import { oidcCallbackRoute } from "./routes/oidc-callback";
 
 const indexRoute = createRoute({
   getParentRoute: () => rootRoute,
@@ -22,6 +23,7 @@ const routeTree = rootRoute.addChildren([
   loginRoute,
   registerRoute,
   setupRoute,
+  oidcCallbackRoute,
 ]);
 
 export const router = createRouter({ routeTree });
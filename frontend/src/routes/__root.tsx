@@ -2,7 +2,7 @@ import { createRootRoute, redirect } from "@tanstack/react-router";
 import { getToken } from "../api/client";
 import { App } from "../App";
 
-const AUTH_PATHS = new Set(["/login", "/register"]);
+const AUTH_PATHS = new Set(["/login", "/register", "/auth/callback"]);
 
 export const rootRoute = createRootRoute({
   beforeLoad: ({ location }) => {
@@ -0,0 +1,11 @@
+import { lazy } from "react";
+import { createRoute } from "@tanstack/react-router";
+import { rootRoute } from "./__root";
+
+const OIDCCallback = lazy(() => import("../components/auth/OIDCCallback").then((m) => ({ default: m.OIDCCallback })));
+
+export const oidcCallbackRoute = createRoute({
+  getParentRoute: () => rootRoute,
+  path: "/auth/callback",
+  component: () => <OIDCCallback />,
+});
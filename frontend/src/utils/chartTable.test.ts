@@ -0,0 +1,27 @@
+import { describe, expect, test } from "bun:test";
+import { chartTable } from "./chartTable";
+
+describe("chartTable", () => {
+  test("drops percent from top/rare tables", () => {
+    const t = chartTable(
+      ["host", "count", "percent"],
+      [
+        ["a", "3", "75.00"],
+        ["b", "1", "25.00"],
+      ],
+    );
+    expect(t.columns).toEqual(["host", "count"]);
+    expect(t.rows).toEqual([
+      ["a", "3"],
+      ["b", "1"],
+    ]);
+  });
+
+  test("leaves other tables unchanged", () => {
+    const columns = ["host", "count"];
+    const rows = [["a", "3"]];
+    const t = chartTable(columns, rows);
+    expect(t.columns).toBe(columns);
+    expect(t.rows).toBe(rows);
+  });
+});
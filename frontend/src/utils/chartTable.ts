@@ -0,0 +1,18 @@
+/**
+ * Returns the columns and rows bar and donut charts should plot. Tables from
+ * `top` and `rare` end in `count, percent`; the charts plot the count, so the
+ * percent column is dropped. Other tables are returned unchanged.
+ */
+export function chartTable(
+  columns: string[],
+  rows: string[][],
+): { columns: string[]; rows: string[][] } {
+  const n = columns.length;
+  if (n >= 3 && columns[n - 2] === "count" && columns[n - 1] === "percent") {
+    return {
+      columns: columns.slice(0, -1),
+      rows: rows.map((row) => row.slice(0, -1)),
+    };
+  }
+  return { columns, rows };
+}